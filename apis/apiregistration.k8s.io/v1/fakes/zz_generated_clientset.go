@@ -0,0 +1,338 @@
+package fakes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rancher/norman/controller"
+	"github.com/rancher/norman/lifecycle"
+	"github.com/rancher/norman/objectclient"
+	v1a "github.com/rancher/types/apis/apiregistration.k8s.io/v1"
+	"github.com/rancher/types/fakeclient"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
+	v1 "k8s.io/kube-aggregator/pkg/apis/apiregistration/v1"
+)
+
+// Clientset is an in-memory v1a.Interface. Its objects are kept in a
+// fakeclient.Tracker, which can be shared with the clientsets of other groups
+// to run their controllers together.
+type Clientset struct {
+	*fakeclient.Tracker
+}
+
+var _ v1a.Interface = &Clientset{}
+
+// NewClientset returns a Clientset with a tracker of its own, holding
+// objects. It panics if an object is not a kind of the group.
+func NewClientset(objects ...runtime.Object) *Clientset {
+	return NewClientsetForTracker(fakeclient.NewTracker(), objects...)
+}
+
+// NewClientsetForTracker returns a Clientset storing its objects in tracker.
+// It panics if an object is not a kind of the group.
+func NewClientsetForTracker(tracker *fakeclient.Tracker, objects ...runtime.Object) *Clientset {
+	c := &Clientset{
+		Tracker: tracker,
+	}
+	if err := c.Add(objects...); err != nil {
+		panic(err)
+	}
+	return c
+}
+
+// RESTClient returns nil, the objects of a Clientset are not served.
+func (c *Clientset) RESTClient() rest.Interface {
+	return nil
+}
+
+// Add stores objects as they are, without the checks of Create.
+func (c *Clientset) Add(objects ...runtime.Object) error {
+	for _, obj := range objects {
+		var resource *fakeclient.Resource
+		switch obj.(type) {
+		case *v1.APIService:
+			resource = c.Resource(v1a.APIServiceGroupVersionKind, v1a.APIServiceResource)
+		default:
+			return fmt.Errorf("%T is not a kind of %s", obj, v1a.GroupName)
+		}
+		if err := resource.Add(obj); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *Clientset) APIServices(namespace string) v1a.APIServiceInterface {
+	resource := c.Resource(v1a.APIServiceGroupVersionKind, v1a.APIServiceResource)
+	return &apiServiceClient{
+		client: resource.Client(namespace),
+	}
+}
+
+type apiServiceClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *apiServiceClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *apiServiceClient) Create(o *v1.APIService) (*v1.APIService, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.APIService), nil
+}
+
+func (s *apiServiceClient) Get(name string, opts metav1.GetOptions) (*v1.APIService, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.APIService), nil
+}
+
+func (s *apiServiceClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v1.APIService, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.APIService), nil
+}
+
+func (s *apiServiceClient) Update(o *v1.APIService) (*v1.APIService, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.APIService), nil
+}
+
+func (s *apiServiceClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *apiServiceClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *apiServiceClient) List(opts metav1.ListOptions) (*v1a.APIServiceList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v1a.APIServiceList{}
+	list.APIVersion = v1a.APIServiceGroupVersionKind.GroupVersion().String()
+	list.Kind = v1a.APIServiceGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v1.APIService))
+	}
+	return list, nil
+}
+
+func (s *apiServiceClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *apiServiceClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *apiServiceClient) Controller() v1a.APIServiceController {
+	return &apiServiceController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *apiServiceClient) AddHandler(ctx context.Context, name string, sync v1a.APIServiceHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *apiServiceClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v1a.APIServiceHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *apiServiceClient) AddLifecycle(ctx context.Context, name string, lifecycle v1a.APIServiceLifecycle) {
+	sync := newAPIServiceLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *apiServiceClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v1a.APIServiceLifecycle) {
+	sync := newAPIServiceLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *apiServiceClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v1a.APIServiceHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *apiServiceClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v1a.APIServiceHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *apiServiceClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v1a.APIServiceLifecycle) {
+	sync := newAPIServiceLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *apiServiceClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v1a.APIServiceLifecycle) {
+	sync := newAPIServiceLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type apiServiceController struct {
+	controller.GenericController
+}
+
+func (c *apiServiceController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *apiServiceController) Lister() v1a.APIServiceLister {
+	return &apiServiceLister{
+		controller: c,
+	}
+}
+
+func (c *apiServiceController) AddHandler(ctx context.Context, name string, handler v1a.APIServiceHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.APIService); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *apiServiceController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v1a.APIServiceHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.APIService); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *apiServiceController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v1a.APIServiceHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.APIService); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *apiServiceController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v1a.APIServiceHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.APIService); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type apiServiceLister struct {
+	controller *apiServiceController
+}
+
+func (l *apiServiceLister) List(namespace string, selector labels.Selector) (ret []*v1.APIService, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v1.APIService))
+	})
+	return
+}
+
+func (l *apiServiceLister) Get(namespace, name string) (*v1.APIService, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v1a.APIServiceGroupVersionKind.Group,
+			Resource: "apiService",
+		}, key)
+	}
+	return obj.(*v1.APIService), nil
+}
+
+type apiServiceLifecycleAdapter struct {
+	lifecycle v1a.APIServiceLifecycle
+}
+
+func (w *apiServiceLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *apiServiceLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *apiServiceLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v1.APIService))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *apiServiceLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v1.APIService))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *apiServiceLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v1.APIService))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newAPIServiceLifecycleAdapter(name string, clusterScoped bool, client *apiServiceClient, l v1a.APIServiceLifecycle) v1a.APIServiceHandlerFunc {
+	adapter := &apiServiceLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v1.APIService) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
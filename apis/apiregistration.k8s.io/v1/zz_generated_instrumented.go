@@ -0,0 +1,79 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/rancher/norman/controller"
+	"github.com/rancher/types/scope"
+)
+
+// NewInstrumented returns client with the generic controllers of its kinds
+// passed through instrument, which can wrap them to observe their handlers.
+// Cluster scoped handlers are added with the cluster name in their context,
+// see scope.WithClusterName.
+func NewInstrumented(client Interface, instrument func(controllerName string, generic controller.GenericController) controller.GenericController) Interface {
+	return &instrumentedClient{
+		Interface:  client,
+		instrument: instrument,
+	}
+}
+
+type instrumentedClient struct {
+	Interface
+	instrument func(controllerName string, generic controller.GenericController) controller.GenericController
+}
+
+func (c *instrumentedClient) APIServices(namespace string) APIServiceInterface {
+	return &instrumentedAPIServiceClient{
+		APIServiceInterface: c.Interface.APIServices(namespace),
+		instrument:          c.instrument,
+	}
+}
+
+type instrumentedAPIServiceClient struct {
+	APIServiceInterface
+	instrument func(controllerName string, generic controller.GenericController) controller.GenericController
+}
+
+func (s *instrumentedAPIServiceClient) Controller() APIServiceController {
+	return &apiServiceController{
+		GenericController: s.instrument(APIServiceGroupVersionKind.Kind+"Controller",
+			s.APIServiceInterface.Controller().Generic()),
+	}
+}
+
+func (s *instrumentedAPIServiceClient) AddHandler(ctx context.Context, name string, sync APIServiceHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *instrumentedAPIServiceClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync APIServiceHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *instrumentedAPIServiceClient) AddLifecycle(ctx context.Context, name string, lifecycle APIServiceLifecycle) {
+	sync := NewAPIServiceLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *instrumentedAPIServiceClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle APIServiceLifecycle) {
+	sync := NewAPIServiceLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *instrumentedAPIServiceClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync APIServiceHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(scope.WithClusterName(ctx, clusterName), name, clusterName, sync)
+}
+
+func (s *instrumentedAPIServiceClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync APIServiceHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(scope.WithClusterName(ctx, clusterName), enabled, name, clusterName, sync)
+}
+
+func (s *instrumentedAPIServiceClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle APIServiceLifecycle) {
+	sync := NewAPIServiceLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(scope.WithClusterName(ctx, clusterName), name, clusterName, sync)
+}
+
+func (s *instrumentedAPIServiceClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle APIServiceLifecycle) {
+	sync := NewAPIServiceLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(scope.WithClusterName(ctx, clusterName), enabled, name, clusterName, sync)
+}
@@ -0,0 +1,1148 @@
+package fakes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rancher/norman/controller"
+	"github.com/rancher/norman/lifecycle"
+	"github.com/rancher/norman/objectclient"
+	v1a "github.com/rancher/types/apis/apps/v1"
+	"github.com/rancher/types/fakeclient"
+	v1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
+)
+
+// Clientset is an in-memory v1a.Interface. Its objects are kept in a
+// fakeclient.Tracker, which can be shared with the clientsets of other groups
+// to run their controllers together.
+type Clientset struct {
+	*fakeclient.Tracker
+}
+
+var _ v1a.Interface = &Clientset{}
+
+// NewClientset returns a Clientset with a tracker of its own, holding
+// objects. It panics if an object is not a kind of the group.
+func NewClientset(objects ...runtime.Object) *Clientset {
+	return NewClientsetForTracker(fakeclient.NewTracker(), objects...)
+}
+
+// NewClientsetForTracker returns a Clientset storing its objects in tracker.
+// It panics if an object is not a kind of the group.
+func NewClientsetForTracker(tracker *fakeclient.Tracker, objects ...runtime.Object) *Clientset {
+	c := &Clientset{
+		Tracker: tracker,
+	}
+	if err := c.Add(objects...); err != nil {
+		panic(err)
+	}
+	return c
+}
+
+// RESTClient returns nil, the objects of a Clientset are not served.
+func (c *Clientset) RESTClient() rest.Interface {
+	return nil
+}
+
+// Add stores objects as they are, without the checks of Create.
+func (c *Clientset) Add(objects ...runtime.Object) error {
+	for _, obj := range objects {
+		var resource *fakeclient.Resource
+		switch obj.(type) {
+		case *v1.Deployment:
+			resource = c.Resource(v1a.DeploymentGroupVersionKind, v1a.DeploymentResource)
+		case *v1.DaemonSet:
+			resource = c.Resource(v1a.DaemonSetGroupVersionKind, v1a.DaemonSetResource)
+		case *v1.StatefulSet:
+			resource = c.Resource(v1a.StatefulSetGroupVersionKind, v1a.StatefulSetResource)
+		case *v1.ReplicaSet:
+			resource = c.Resource(v1a.ReplicaSetGroupVersionKind, v1a.ReplicaSetResource)
+		default:
+			return fmt.Errorf("%T is not a kind of %s", obj, v1a.GroupName)
+		}
+		if err := resource.Add(obj); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *Clientset) Deployments(namespace string) v1a.DeploymentInterface {
+	resource := c.Resource(v1a.DeploymentGroupVersionKind, v1a.DeploymentResource)
+	return &deploymentClient{
+		client: resource.Client(namespace),
+	}
+}
+
+func (c *Clientset) DaemonSets(namespace string) v1a.DaemonSetInterface {
+	resource := c.Resource(v1a.DaemonSetGroupVersionKind, v1a.DaemonSetResource)
+	return &daemonSetClient{
+		client: resource.Client(namespace),
+	}
+}
+
+func (c *Clientset) StatefulSets(namespace string) v1a.StatefulSetInterface {
+	resource := c.Resource(v1a.StatefulSetGroupVersionKind, v1a.StatefulSetResource)
+	return &statefulSetClient{
+		client: resource.Client(namespace),
+	}
+}
+
+func (c *Clientset) ReplicaSets(namespace string) v1a.ReplicaSetInterface {
+	resource := c.Resource(v1a.ReplicaSetGroupVersionKind, v1a.ReplicaSetResource)
+	return &replicaSetClient{
+		client: resource.Client(namespace),
+	}
+}
+
+type deploymentClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *deploymentClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *deploymentClient) Create(o *v1.Deployment) (*v1.Deployment, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.Deployment), nil
+}
+
+func (s *deploymentClient) Get(name string, opts metav1.GetOptions) (*v1.Deployment, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.Deployment), nil
+}
+
+func (s *deploymentClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v1.Deployment, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.Deployment), nil
+}
+
+func (s *deploymentClient) Update(o *v1.Deployment) (*v1.Deployment, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.Deployment), nil
+}
+
+func (s *deploymentClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *deploymentClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *deploymentClient) List(opts metav1.ListOptions) (*v1a.DeploymentList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v1a.DeploymentList{}
+	list.APIVersion = v1a.DeploymentGroupVersionKind.GroupVersion().String()
+	list.Kind = v1a.DeploymentGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v1.Deployment))
+	}
+	return list, nil
+}
+
+func (s *deploymentClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *deploymentClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *deploymentClient) Controller() v1a.DeploymentController {
+	return &deploymentController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *deploymentClient) AddHandler(ctx context.Context, name string, sync v1a.DeploymentHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *deploymentClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v1a.DeploymentHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *deploymentClient) AddLifecycle(ctx context.Context, name string, lifecycle v1a.DeploymentLifecycle) {
+	sync := newDeploymentLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *deploymentClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v1a.DeploymentLifecycle) {
+	sync := newDeploymentLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *deploymentClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v1a.DeploymentHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *deploymentClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v1a.DeploymentHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *deploymentClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v1a.DeploymentLifecycle) {
+	sync := newDeploymentLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *deploymentClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v1a.DeploymentLifecycle) {
+	sync := newDeploymentLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type deploymentController struct {
+	controller.GenericController
+}
+
+func (c *deploymentController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *deploymentController) Lister() v1a.DeploymentLister {
+	return &deploymentLister{
+		controller: c,
+	}
+}
+
+func (c *deploymentController) AddHandler(ctx context.Context, name string, handler v1a.DeploymentHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.Deployment); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *deploymentController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v1a.DeploymentHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.Deployment); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *deploymentController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v1a.DeploymentHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.Deployment); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *deploymentController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v1a.DeploymentHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.Deployment); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type deploymentLister struct {
+	controller *deploymentController
+}
+
+func (l *deploymentLister) List(namespace string, selector labels.Selector) (ret []*v1.Deployment, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v1.Deployment))
+	})
+	return
+}
+
+func (l *deploymentLister) Get(namespace, name string) (*v1.Deployment, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v1a.DeploymentGroupVersionKind.Group,
+			Resource: "deployment",
+		}, key)
+	}
+	return obj.(*v1.Deployment), nil
+}
+
+type deploymentLifecycleAdapter struct {
+	lifecycle v1a.DeploymentLifecycle
+}
+
+func (w *deploymentLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *deploymentLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *deploymentLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v1.Deployment))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *deploymentLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v1.Deployment))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *deploymentLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v1.Deployment))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newDeploymentLifecycleAdapter(name string, clusterScoped bool, client *deploymentClient, l v1a.DeploymentLifecycle) v1a.DeploymentHandlerFunc {
+	adapter := &deploymentLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v1.Deployment) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+
+type daemonSetClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *daemonSetClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *daemonSetClient) Create(o *v1.DaemonSet) (*v1.DaemonSet, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.DaemonSet), nil
+}
+
+func (s *daemonSetClient) Get(name string, opts metav1.GetOptions) (*v1.DaemonSet, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.DaemonSet), nil
+}
+
+func (s *daemonSetClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v1.DaemonSet, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.DaemonSet), nil
+}
+
+func (s *daemonSetClient) Update(o *v1.DaemonSet) (*v1.DaemonSet, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.DaemonSet), nil
+}
+
+func (s *daemonSetClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *daemonSetClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *daemonSetClient) List(opts metav1.ListOptions) (*v1a.DaemonSetList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v1a.DaemonSetList{}
+	list.APIVersion = v1a.DaemonSetGroupVersionKind.GroupVersion().String()
+	list.Kind = v1a.DaemonSetGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v1.DaemonSet))
+	}
+	return list, nil
+}
+
+func (s *daemonSetClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *daemonSetClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *daemonSetClient) Controller() v1a.DaemonSetController {
+	return &daemonSetController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *daemonSetClient) AddHandler(ctx context.Context, name string, sync v1a.DaemonSetHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *daemonSetClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v1a.DaemonSetHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *daemonSetClient) AddLifecycle(ctx context.Context, name string, lifecycle v1a.DaemonSetLifecycle) {
+	sync := newDaemonSetLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *daemonSetClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v1a.DaemonSetLifecycle) {
+	sync := newDaemonSetLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *daemonSetClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v1a.DaemonSetHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *daemonSetClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v1a.DaemonSetHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *daemonSetClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v1a.DaemonSetLifecycle) {
+	sync := newDaemonSetLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *daemonSetClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v1a.DaemonSetLifecycle) {
+	sync := newDaemonSetLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type daemonSetController struct {
+	controller.GenericController
+}
+
+func (c *daemonSetController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *daemonSetController) Lister() v1a.DaemonSetLister {
+	return &daemonSetLister{
+		controller: c,
+	}
+}
+
+func (c *daemonSetController) AddHandler(ctx context.Context, name string, handler v1a.DaemonSetHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.DaemonSet); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *daemonSetController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v1a.DaemonSetHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.DaemonSet); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *daemonSetController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v1a.DaemonSetHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.DaemonSet); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *daemonSetController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v1a.DaemonSetHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.DaemonSet); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type daemonSetLister struct {
+	controller *daemonSetController
+}
+
+func (l *daemonSetLister) List(namespace string, selector labels.Selector) (ret []*v1.DaemonSet, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v1.DaemonSet))
+	})
+	return
+}
+
+func (l *daemonSetLister) Get(namespace, name string) (*v1.DaemonSet, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v1a.DaemonSetGroupVersionKind.Group,
+			Resource: "daemonSet",
+		}, key)
+	}
+	return obj.(*v1.DaemonSet), nil
+}
+
+type daemonSetLifecycleAdapter struct {
+	lifecycle v1a.DaemonSetLifecycle
+}
+
+func (w *daemonSetLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *daemonSetLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *daemonSetLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v1.DaemonSet))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *daemonSetLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v1.DaemonSet))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *daemonSetLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v1.DaemonSet))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newDaemonSetLifecycleAdapter(name string, clusterScoped bool, client *daemonSetClient, l v1a.DaemonSetLifecycle) v1a.DaemonSetHandlerFunc {
+	adapter := &daemonSetLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v1.DaemonSet) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+
+type statefulSetClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *statefulSetClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *statefulSetClient) Create(o *v1.StatefulSet) (*v1.StatefulSet, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.StatefulSet), nil
+}
+
+func (s *statefulSetClient) Get(name string, opts metav1.GetOptions) (*v1.StatefulSet, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.StatefulSet), nil
+}
+
+func (s *statefulSetClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v1.StatefulSet, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.StatefulSet), nil
+}
+
+func (s *statefulSetClient) Update(o *v1.StatefulSet) (*v1.StatefulSet, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.StatefulSet), nil
+}
+
+func (s *statefulSetClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *statefulSetClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *statefulSetClient) List(opts metav1.ListOptions) (*v1a.StatefulSetList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v1a.StatefulSetList{}
+	list.APIVersion = v1a.StatefulSetGroupVersionKind.GroupVersion().String()
+	list.Kind = v1a.StatefulSetGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v1.StatefulSet))
+	}
+	return list, nil
+}
+
+func (s *statefulSetClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *statefulSetClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *statefulSetClient) Controller() v1a.StatefulSetController {
+	return &statefulSetController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *statefulSetClient) AddHandler(ctx context.Context, name string, sync v1a.StatefulSetHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *statefulSetClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v1a.StatefulSetHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *statefulSetClient) AddLifecycle(ctx context.Context, name string, lifecycle v1a.StatefulSetLifecycle) {
+	sync := newStatefulSetLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *statefulSetClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v1a.StatefulSetLifecycle) {
+	sync := newStatefulSetLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *statefulSetClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v1a.StatefulSetHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *statefulSetClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v1a.StatefulSetHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *statefulSetClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v1a.StatefulSetLifecycle) {
+	sync := newStatefulSetLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *statefulSetClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v1a.StatefulSetLifecycle) {
+	sync := newStatefulSetLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type statefulSetController struct {
+	controller.GenericController
+}
+
+func (c *statefulSetController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *statefulSetController) Lister() v1a.StatefulSetLister {
+	return &statefulSetLister{
+		controller: c,
+	}
+}
+
+func (c *statefulSetController) AddHandler(ctx context.Context, name string, handler v1a.StatefulSetHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.StatefulSet); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *statefulSetController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v1a.StatefulSetHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.StatefulSet); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *statefulSetController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v1a.StatefulSetHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.StatefulSet); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *statefulSetController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v1a.StatefulSetHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.StatefulSet); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type statefulSetLister struct {
+	controller *statefulSetController
+}
+
+func (l *statefulSetLister) List(namespace string, selector labels.Selector) (ret []*v1.StatefulSet, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v1.StatefulSet))
+	})
+	return
+}
+
+func (l *statefulSetLister) Get(namespace, name string) (*v1.StatefulSet, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v1a.StatefulSetGroupVersionKind.Group,
+			Resource: "statefulSet",
+		}, key)
+	}
+	return obj.(*v1.StatefulSet), nil
+}
+
+type statefulSetLifecycleAdapter struct {
+	lifecycle v1a.StatefulSetLifecycle
+}
+
+func (w *statefulSetLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *statefulSetLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *statefulSetLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v1.StatefulSet))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *statefulSetLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v1.StatefulSet))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *statefulSetLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v1.StatefulSet))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newStatefulSetLifecycleAdapter(name string, clusterScoped bool, client *statefulSetClient, l v1a.StatefulSetLifecycle) v1a.StatefulSetHandlerFunc {
+	adapter := &statefulSetLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v1.StatefulSet) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+
+type replicaSetClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *replicaSetClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *replicaSetClient) Create(o *v1.ReplicaSet) (*v1.ReplicaSet, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.ReplicaSet), nil
+}
+
+func (s *replicaSetClient) Get(name string, opts metav1.GetOptions) (*v1.ReplicaSet, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.ReplicaSet), nil
+}
+
+func (s *replicaSetClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v1.ReplicaSet, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.ReplicaSet), nil
+}
+
+func (s *replicaSetClient) Update(o *v1.ReplicaSet) (*v1.ReplicaSet, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.ReplicaSet), nil
+}
+
+func (s *replicaSetClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *replicaSetClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *replicaSetClient) List(opts metav1.ListOptions) (*v1a.ReplicaSetList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v1a.ReplicaSetList{}
+	list.APIVersion = v1a.ReplicaSetGroupVersionKind.GroupVersion().String()
+	list.Kind = v1a.ReplicaSetGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v1.ReplicaSet))
+	}
+	return list, nil
+}
+
+func (s *replicaSetClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *replicaSetClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *replicaSetClient) Controller() v1a.ReplicaSetController {
+	return &replicaSetController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *replicaSetClient) AddHandler(ctx context.Context, name string, sync v1a.ReplicaSetHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *replicaSetClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v1a.ReplicaSetHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *replicaSetClient) AddLifecycle(ctx context.Context, name string, lifecycle v1a.ReplicaSetLifecycle) {
+	sync := newReplicaSetLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *replicaSetClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v1a.ReplicaSetLifecycle) {
+	sync := newReplicaSetLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *replicaSetClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v1a.ReplicaSetHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *replicaSetClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v1a.ReplicaSetHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *replicaSetClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v1a.ReplicaSetLifecycle) {
+	sync := newReplicaSetLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *replicaSetClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v1a.ReplicaSetLifecycle) {
+	sync := newReplicaSetLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type replicaSetController struct {
+	controller.GenericController
+}
+
+func (c *replicaSetController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *replicaSetController) Lister() v1a.ReplicaSetLister {
+	return &replicaSetLister{
+		controller: c,
+	}
+}
+
+func (c *replicaSetController) AddHandler(ctx context.Context, name string, handler v1a.ReplicaSetHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.ReplicaSet); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *replicaSetController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v1a.ReplicaSetHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.ReplicaSet); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *replicaSetController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v1a.ReplicaSetHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.ReplicaSet); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *replicaSetController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v1a.ReplicaSetHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.ReplicaSet); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type replicaSetLister struct {
+	controller *replicaSetController
+}
+
+func (l *replicaSetLister) List(namespace string, selector labels.Selector) (ret []*v1.ReplicaSet, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v1.ReplicaSet))
+	})
+	return
+}
+
+func (l *replicaSetLister) Get(namespace, name string) (*v1.ReplicaSet, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v1a.ReplicaSetGroupVersionKind.Group,
+			Resource: "replicaSet",
+		}, key)
+	}
+	return obj.(*v1.ReplicaSet), nil
+}
+
+type replicaSetLifecycleAdapter struct {
+	lifecycle v1a.ReplicaSetLifecycle
+}
+
+func (w *replicaSetLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *replicaSetLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *replicaSetLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v1.ReplicaSet))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *replicaSetLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v1.ReplicaSet))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *replicaSetLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v1.ReplicaSet))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newReplicaSetLifecycleAdapter(name string, clusterScoped bool, client *replicaSetClient, l v1a.ReplicaSetLifecycle) v1a.ReplicaSetHandlerFunc {
+	adapter := &replicaSetLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v1.ReplicaSet) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
@@ -0,0 +1,241 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/rancher/norman/controller"
+	"github.com/rancher/types/scope"
+)
+
+// NewInstrumented returns client with the generic controllers of its kinds
+// passed through instrument, which can wrap them to observe their handlers.
+// Cluster scoped handlers are added with the cluster name in their context,
+// see scope.WithClusterName.
+func NewInstrumented(client Interface, instrument func(controllerName string, generic controller.GenericController) controller.GenericController) Interface {
+	return &instrumentedClient{
+		Interface:  client,
+		instrument: instrument,
+	}
+}
+
+type instrumentedClient struct {
+	Interface
+	instrument func(controllerName string, generic controller.GenericController) controller.GenericController
+}
+
+func (c *instrumentedClient) Deployments(namespace string) DeploymentInterface {
+	return &instrumentedDeploymentClient{
+		DeploymentInterface: c.Interface.Deployments(namespace),
+		instrument:          c.instrument,
+	}
+}
+
+type instrumentedDeploymentClient struct {
+	DeploymentInterface
+	instrument func(controllerName string, generic controller.GenericController) controller.GenericController
+}
+
+func (s *instrumentedDeploymentClient) Controller() DeploymentController {
+	return &deploymentController{
+		GenericController: s.instrument(DeploymentGroupVersionKind.Kind+"Controller",
+			s.DeploymentInterface.Controller().Generic()),
+	}
+}
+
+func (s *instrumentedDeploymentClient) AddHandler(ctx context.Context, name string, sync DeploymentHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *instrumentedDeploymentClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync DeploymentHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *instrumentedDeploymentClient) AddLifecycle(ctx context.Context, name string, lifecycle DeploymentLifecycle) {
+	sync := NewDeploymentLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *instrumentedDeploymentClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle DeploymentLifecycle) {
+	sync := NewDeploymentLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *instrumentedDeploymentClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync DeploymentHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(scope.WithClusterName(ctx, clusterName), name, clusterName, sync)
+}
+
+func (s *instrumentedDeploymentClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync DeploymentHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(scope.WithClusterName(ctx, clusterName), enabled, name, clusterName, sync)
+}
+
+func (s *instrumentedDeploymentClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle DeploymentLifecycle) {
+	sync := NewDeploymentLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(scope.WithClusterName(ctx, clusterName), name, clusterName, sync)
+}
+
+func (s *instrumentedDeploymentClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle DeploymentLifecycle) {
+	sync := NewDeploymentLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(scope.WithClusterName(ctx, clusterName), enabled, name, clusterName, sync)
+}
+func (c *instrumentedClient) DaemonSets(namespace string) DaemonSetInterface {
+	return &instrumentedDaemonSetClient{
+		DaemonSetInterface: c.Interface.DaemonSets(namespace),
+		instrument:         c.instrument,
+	}
+}
+
+type instrumentedDaemonSetClient struct {
+	DaemonSetInterface
+	instrument func(controllerName string, generic controller.GenericController) controller.GenericController
+}
+
+func (s *instrumentedDaemonSetClient) Controller() DaemonSetController {
+	return &daemonSetController{
+		GenericController: s.instrument(DaemonSetGroupVersionKind.Kind+"Controller",
+			s.DaemonSetInterface.Controller().Generic()),
+	}
+}
+
+func (s *instrumentedDaemonSetClient) AddHandler(ctx context.Context, name string, sync DaemonSetHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *instrumentedDaemonSetClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync DaemonSetHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *instrumentedDaemonSetClient) AddLifecycle(ctx context.Context, name string, lifecycle DaemonSetLifecycle) {
+	sync := NewDaemonSetLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *instrumentedDaemonSetClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle DaemonSetLifecycle) {
+	sync := NewDaemonSetLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *instrumentedDaemonSetClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync DaemonSetHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(scope.WithClusterName(ctx, clusterName), name, clusterName, sync)
+}
+
+func (s *instrumentedDaemonSetClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync DaemonSetHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(scope.WithClusterName(ctx, clusterName), enabled, name, clusterName, sync)
+}
+
+func (s *instrumentedDaemonSetClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle DaemonSetLifecycle) {
+	sync := NewDaemonSetLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(scope.WithClusterName(ctx, clusterName), name, clusterName, sync)
+}
+
+func (s *instrumentedDaemonSetClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle DaemonSetLifecycle) {
+	sync := NewDaemonSetLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(scope.WithClusterName(ctx, clusterName), enabled, name, clusterName, sync)
+}
+func (c *instrumentedClient) StatefulSets(namespace string) StatefulSetInterface {
+	return &instrumentedStatefulSetClient{
+		StatefulSetInterface: c.Interface.StatefulSets(namespace),
+		instrument:           c.instrument,
+	}
+}
+
+type instrumentedStatefulSetClient struct {
+	StatefulSetInterface
+	instrument func(controllerName string, generic controller.GenericController) controller.GenericController
+}
+
+func (s *instrumentedStatefulSetClient) Controller() StatefulSetController {
+	return &statefulSetController{
+		GenericController: s.instrument(StatefulSetGroupVersionKind.Kind+"Controller",
+			s.StatefulSetInterface.Controller().Generic()),
+	}
+}
+
+func (s *instrumentedStatefulSetClient) AddHandler(ctx context.Context, name string, sync StatefulSetHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *instrumentedStatefulSetClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync StatefulSetHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *instrumentedStatefulSetClient) AddLifecycle(ctx context.Context, name string, lifecycle StatefulSetLifecycle) {
+	sync := NewStatefulSetLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *instrumentedStatefulSetClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle StatefulSetLifecycle) {
+	sync := NewStatefulSetLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *instrumentedStatefulSetClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync StatefulSetHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(scope.WithClusterName(ctx, clusterName), name, clusterName, sync)
+}
+
+func (s *instrumentedStatefulSetClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync StatefulSetHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(scope.WithClusterName(ctx, clusterName), enabled, name, clusterName, sync)
+}
+
+func (s *instrumentedStatefulSetClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle StatefulSetLifecycle) {
+	sync := NewStatefulSetLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(scope.WithClusterName(ctx, clusterName), name, clusterName, sync)
+}
+
+func (s *instrumentedStatefulSetClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle StatefulSetLifecycle) {
+	sync := NewStatefulSetLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(scope.WithClusterName(ctx, clusterName), enabled, name, clusterName, sync)
+}
+func (c *instrumentedClient) ReplicaSets(namespace string) ReplicaSetInterface {
+	return &instrumentedReplicaSetClient{
+		ReplicaSetInterface: c.Interface.ReplicaSets(namespace),
+		instrument:          c.instrument,
+	}
+}
+
+type instrumentedReplicaSetClient struct {
+	ReplicaSetInterface
+	instrument func(controllerName string, generic controller.GenericController) controller.GenericController
+}
+
+func (s *instrumentedReplicaSetClient) Controller() ReplicaSetController {
+	return &replicaSetController{
+		GenericController: s.instrument(ReplicaSetGroupVersionKind.Kind+"Controller",
+			s.ReplicaSetInterface.Controller().Generic()),
+	}
+}
+
+func (s *instrumentedReplicaSetClient) AddHandler(ctx context.Context, name string, sync ReplicaSetHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *instrumentedReplicaSetClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync ReplicaSetHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *instrumentedReplicaSetClient) AddLifecycle(ctx context.Context, name string, lifecycle ReplicaSetLifecycle) {
+	sync := NewReplicaSetLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *instrumentedReplicaSetClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle ReplicaSetLifecycle) {
+	sync := NewReplicaSetLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *instrumentedReplicaSetClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync ReplicaSetHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(scope.WithClusterName(ctx, clusterName), name, clusterName, sync)
+}
+
+func (s *instrumentedReplicaSetClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync ReplicaSetHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(scope.WithClusterName(ctx, clusterName), enabled, name, clusterName, sync)
+}
+
+func (s *instrumentedReplicaSetClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle ReplicaSetLifecycle) {
+	sync := NewReplicaSetLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(scope.WithClusterName(ctx, clusterName), name, clusterName, sync)
+}
+
+func (s *instrumentedReplicaSetClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle ReplicaSetLifecycle) {
+	sync := NewReplicaSetLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(scope.WithClusterName(ctx, clusterName), enabled, name, clusterName, sync)
+}
@@ -0,0 +1,338 @@
+package fakes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rancher/norman/controller"
+	"github.com/rancher/norman/lifecycle"
+	"github.com/rancher/norman/objectclient"
+	v2beta2a "github.com/rancher/types/apis/autoscaling/v2beta2"
+	"github.com/rancher/types/fakeclient"
+	v2beta2 "k8s.io/api/autoscaling/v2beta2"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
+)
+
+// Clientset is an in-memory v2beta2a.Interface. Its objects are kept in a
+// fakeclient.Tracker, which can be shared with the clientsets of other groups
+// to run their controllers together.
+type Clientset struct {
+	*fakeclient.Tracker
+}
+
+var _ v2beta2a.Interface = &Clientset{}
+
+// NewClientset returns a Clientset with a tracker of its own, holding
+// objects. It panics if an object is not a kind of the group.
+func NewClientset(objects ...runtime.Object) *Clientset {
+	return NewClientsetForTracker(fakeclient.NewTracker(), objects...)
+}
+
+// NewClientsetForTracker returns a Clientset storing its objects in tracker.
+// It panics if an object is not a kind of the group.
+func NewClientsetForTracker(tracker *fakeclient.Tracker, objects ...runtime.Object) *Clientset {
+	c := &Clientset{
+		Tracker: tracker,
+	}
+	if err := c.Add(objects...); err != nil {
+		panic(err)
+	}
+	return c
+}
+
+// RESTClient returns nil, the objects of a Clientset are not served.
+func (c *Clientset) RESTClient() rest.Interface {
+	return nil
+}
+
+// Add stores objects as they are, without the checks of Create.
+func (c *Clientset) Add(objects ...runtime.Object) error {
+	for _, obj := range objects {
+		var resource *fakeclient.Resource
+		switch obj.(type) {
+		case *v2beta2.HorizontalPodAutoscaler:
+			resource = c.Resource(v2beta2a.HorizontalPodAutoscalerGroupVersionKind, v2beta2a.HorizontalPodAutoscalerResource)
+		default:
+			return fmt.Errorf("%T is not a kind of %s", obj, v2beta2a.GroupName)
+		}
+		if err := resource.Add(obj); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *Clientset) HorizontalPodAutoscalers(namespace string) v2beta2a.HorizontalPodAutoscalerInterface {
+	resource := c.Resource(v2beta2a.HorizontalPodAutoscalerGroupVersionKind, v2beta2a.HorizontalPodAutoscalerResource)
+	return &horizontalPodAutoscalerClient{
+		client: resource.Client(namespace),
+	}
+}
+
+type horizontalPodAutoscalerClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *horizontalPodAutoscalerClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *horizontalPodAutoscalerClient) Create(o *v2beta2.HorizontalPodAutoscaler) (*v2beta2.HorizontalPodAutoscaler, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v2beta2.HorizontalPodAutoscaler), nil
+}
+
+func (s *horizontalPodAutoscalerClient) Get(name string, opts metav1.GetOptions) (*v2beta2.HorizontalPodAutoscaler, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v2beta2.HorizontalPodAutoscaler), nil
+}
+
+func (s *horizontalPodAutoscalerClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v2beta2.HorizontalPodAutoscaler, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v2beta2.HorizontalPodAutoscaler), nil
+}
+
+func (s *horizontalPodAutoscalerClient) Update(o *v2beta2.HorizontalPodAutoscaler) (*v2beta2.HorizontalPodAutoscaler, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v2beta2.HorizontalPodAutoscaler), nil
+}
+
+func (s *horizontalPodAutoscalerClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *horizontalPodAutoscalerClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *horizontalPodAutoscalerClient) List(opts metav1.ListOptions) (*v2beta2a.HorizontalPodAutoscalerList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v2beta2a.HorizontalPodAutoscalerList{}
+	list.APIVersion = v2beta2a.HorizontalPodAutoscalerGroupVersionKind.GroupVersion().String()
+	list.Kind = v2beta2a.HorizontalPodAutoscalerGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v2beta2.HorizontalPodAutoscaler))
+	}
+	return list, nil
+}
+
+func (s *horizontalPodAutoscalerClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *horizontalPodAutoscalerClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *horizontalPodAutoscalerClient) Controller() v2beta2a.HorizontalPodAutoscalerController {
+	return &horizontalPodAutoscalerController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *horizontalPodAutoscalerClient) AddHandler(ctx context.Context, name string, sync v2beta2a.HorizontalPodAutoscalerHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *horizontalPodAutoscalerClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v2beta2a.HorizontalPodAutoscalerHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *horizontalPodAutoscalerClient) AddLifecycle(ctx context.Context, name string, lifecycle v2beta2a.HorizontalPodAutoscalerLifecycle) {
+	sync := newHorizontalPodAutoscalerLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *horizontalPodAutoscalerClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v2beta2a.HorizontalPodAutoscalerLifecycle) {
+	sync := newHorizontalPodAutoscalerLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *horizontalPodAutoscalerClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v2beta2a.HorizontalPodAutoscalerHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *horizontalPodAutoscalerClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v2beta2a.HorizontalPodAutoscalerHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *horizontalPodAutoscalerClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v2beta2a.HorizontalPodAutoscalerLifecycle) {
+	sync := newHorizontalPodAutoscalerLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *horizontalPodAutoscalerClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v2beta2a.HorizontalPodAutoscalerLifecycle) {
+	sync := newHorizontalPodAutoscalerLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type horizontalPodAutoscalerController struct {
+	controller.GenericController
+}
+
+func (c *horizontalPodAutoscalerController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *horizontalPodAutoscalerController) Lister() v2beta2a.HorizontalPodAutoscalerLister {
+	return &horizontalPodAutoscalerLister{
+		controller: c,
+	}
+}
+
+func (c *horizontalPodAutoscalerController) AddHandler(ctx context.Context, name string, handler v2beta2a.HorizontalPodAutoscalerHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v2beta2.HorizontalPodAutoscaler); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *horizontalPodAutoscalerController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v2beta2a.HorizontalPodAutoscalerHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v2beta2.HorizontalPodAutoscaler); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *horizontalPodAutoscalerController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v2beta2a.HorizontalPodAutoscalerHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v2beta2.HorizontalPodAutoscaler); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *horizontalPodAutoscalerController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v2beta2a.HorizontalPodAutoscalerHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v2beta2.HorizontalPodAutoscaler); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type horizontalPodAutoscalerLister struct {
+	controller *horizontalPodAutoscalerController
+}
+
+func (l *horizontalPodAutoscalerLister) List(namespace string, selector labels.Selector) (ret []*v2beta2.HorizontalPodAutoscaler, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v2beta2.HorizontalPodAutoscaler))
+	})
+	return
+}
+
+func (l *horizontalPodAutoscalerLister) Get(namespace, name string) (*v2beta2.HorizontalPodAutoscaler, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v2beta2a.HorizontalPodAutoscalerGroupVersionKind.Group,
+			Resource: "horizontalPodAutoscaler",
+		}, key)
+	}
+	return obj.(*v2beta2.HorizontalPodAutoscaler), nil
+}
+
+type horizontalPodAutoscalerLifecycleAdapter struct {
+	lifecycle v2beta2a.HorizontalPodAutoscalerLifecycle
+}
+
+func (w *horizontalPodAutoscalerLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *horizontalPodAutoscalerLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *horizontalPodAutoscalerLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v2beta2.HorizontalPodAutoscaler))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *horizontalPodAutoscalerLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v2beta2.HorizontalPodAutoscaler))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *horizontalPodAutoscalerLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v2beta2.HorizontalPodAutoscaler))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newHorizontalPodAutoscalerLifecycleAdapter(name string, clusterScoped bool, client *horizontalPodAutoscalerClient, l v2beta2a.HorizontalPodAutoscalerLifecycle) v2beta2a.HorizontalPodAutoscalerHandlerFunc {
+	adapter := &horizontalPodAutoscalerLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v2beta2.HorizontalPodAutoscaler) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
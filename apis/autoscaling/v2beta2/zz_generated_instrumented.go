@@ -0,0 +1,79 @@
+package v2beta2
+
+import (
+	"context"
+
+	"github.com/rancher/norman/controller"
+	"github.com/rancher/types/scope"
+)
+
+// NewInstrumented returns client with the generic controllers of its kinds
+// passed through instrument, which can wrap them to observe their handlers.
+// Cluster scoped handlers are added with the cluster name in their context,
+// see scope.WithClusterName.
+func NewInstrumented(client Interface, instrument func(controllerName string, generic controller.GenericController) controller.GenericController) Interface {
+	return &instrumentedClient{
+		Interface:  client,
+		instrument: instrument,
+	}
+}
+
+type instrumentedClient struct {
+	Interface
+	instrument func(controllerName string, generic controller.GenericController) controller.GenericController
+}
+
+func (c *instrumentedClient) HorizontalPodAutoscalers(namespace string) HorizontalPodAutoscalerInterface {
+	return &instrumentedHorizontalPodAutoscalerClient{
+		HorizontalPodAutoscalerInterface: c.Interface.HorizontalPodAutoscalers(namespace),
+		instrument:                       c.instrument,
+	}
+}
+
+type instrumentedHorizontalPodAutoscalerClient struct {
+	HorizontalPodAutoscalerInterface
+	instrument func(controllerName string, generic controller.GenericController) controller.GenericController
+}
+
+func (s *instrumentedHorizontalPodAutoscalerClient) Controller() HorizontalPodAutoscalerController {
+	return &horizontalPodAutoscalerController{
+		GenericController: s.instrument(HorizontalPodAutoscalerGroupVersionKind.Kind+"Controller",
+			s.HorizontalPodAutoscalerInterface.Controller().Generic()),
+	}
+}
+
+func (s *instrumentedHorizontalPodAutoscalerClient) AddHandler(ctx context.Context, name string, sync HorizontalPodAutoscalerHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *instrumentedHorizontalPodAutoscalerClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync HorizontalPodAutoscalerHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *instrumentedHorizontalPodAutoscalerClient) AddLifecycle(ctx context.Context, name string, lifecycle HorizontalPodAutoscalerLifecycle) {
+	sync := NewHorizontalPodAutoscalerLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *instrumentedHorizontalPodAutoscalerClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle HorizontalPodAutoscalerLifecycle) {
+	sync := NewHorizontalPodAutoscalerLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *instrumentedHorizontalPodAutoscalerClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync HorizontalPodAutoscalerHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(scope.WithClusterName(ctx, clusterName), name, clusterName, sync)
+}
+
+func (s *instrumentedHorizontalPodAutoscalerClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync HorizontalPodAutoscalerHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(scope.WithClusterName(ctx, clusterName), enabled, name, clusterName, sync)
+}
+
+func (s *instrumentedHorizontalPodAutoscalerClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle HorizontalPodAutoscalerLifecycle) {
+	sync := NewHorizontalPodAutoscalerLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(scope.WithClusterName(ctx, clusterName), name, clusterName, sync)
+}
+
+func (s *instrumentedHorizontalPodAutoscalerClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle HorizontalPodAutoscalerLifecycle) {
+	sync := NewHorizontalPodAutoscalerLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(scope.WithClusterName(ctx, clusterName), enabled, name, clusterName, sync)
+}
@@ -0,0 +1,338 @@
+package fakes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rancher/norman/controller"
+	"github.com/rancher/norman/lifecycle"
+	"github.com/rancher/norman/objectclient"
+	v1a "github.com/rancher/types/apis/batch/v1"
+	"github.com/rancher/types/fakeclient"
+	v1 "k8s.io/api/batch/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
+)
+
+// Clientset is an in-memory v1a.Interface. Its objects are kept in a
+// fakeclient.Tracker, which can be shared with the clientsets of other groups
+// to run their controllers together.
+type Clientset struct {
+	*fakeclient.Tracker
+}
+
+var _ v1a.Interface = &Clientset{}
+
+// NewClientset returns a Clientset with a tracker of its own, holding
+// objects. It panics if an object is not a kind of the group.
+func NewClientset(objects ...runtime.Object) *Clientset {
+	return NewClientsetForTracker(fakeclient.NewTracker(), objects...)
+}
+
+// NewClientsetForTracker returns a Clientset storing its objects in tracker.
+// It panics if an object is not a kind of the group.
+func NewClientsetForTracker(tracker *fakeclient.Tracker, objects ...runtime.Object) *Clientset {
+	c := &Clientset{
+		Tracker: tracker,
+	}
+	if err := c.Add(objects...); err != nil {
+		panic(err)
+	}
+	return c
+}
+
+// RESTClient returns nil, the objects of a Clientset are not served.
+func (c *Clientset) RESTClient() rest.Interface {
+	return nil
+}
+
+// Add stores objects as they are, without the checks of Create.
+func (c *Clientset) Add(objects ...runtime.Object) error {
+	for _, obj := range objects {
+		var resource *fakeclient.Resource
+		switch obj.(type) {
+		case *v1.Job:
+			resource = c.Resource(v1a.JobGroupVersionKind, v1a.JobResource)
+		default:
+			return fmt.Errorf("%T is not a kind of %s", obj, v1a.GroupName)
+		}
+		if err := resource.Add(obj); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *Clientset) Jobs(namespace string) v1a.JobInterface {
+	resource := c.Resource(v1a.JobGroupVersionKind, v1a.JobResource)
+	return &jobClient{
+		client: resource.Client(namespace),
+	}
+}
+
+type jobClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *jobClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *jobClient) Create(o *v1.Job) (*v1.Job, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.Job), nil
+}
+
+func (s *jobClient) Get(name string, opts metav1.GetOptions) (*v1.Job, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.Job), nil
+}
+
+func (s *jobClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v1.Job, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.Job), nil
+}
+
+func (s *jobClient) Update(o *v1.Job) (*v1.Job, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.Job), nil
+}
+
+func (s *jobClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *jobClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *jobClient) List(opts metav1.ListOptions) (*v1a.JobList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v1a.JobList{}
+	list.APIVersion = v1a.JobGroupVersionKind.GroupVersion().String()
+	list.Kind = v1a.JobGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v1.Job))
+	}
+	return list, nil
+}
+
+func (s *jobClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *jobClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *jobClient) Controller() v1a.JobController {
+	return &jobController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *jobClient) AddHandler(ctx context.Context, name string, sync v1a.JobHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *jobClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v1a.JobHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *jobClient) AddLifecycle(ctx context.Context, name string, lifecycle v1a.JobLifecycle) {
+	sync := newJobLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *jobClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v1a.JobLifecycle) {
+	sync := newJobLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *jobClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v1a.JobHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *jobClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v1a.JobHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *jobClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v1a.JobLifecycle) {
+	sync := newJobLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *jobClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v1a.JobLifecycle) {
+	sync := newJobLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type jobController struct {
+	controller.GenericController
+}
+
+func (c *jobController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *jobController) Lister() v1a.JobLister {
+	return &jobLister{
+		controller: c,
+	}
+}
+
+func (c *jobController) AddHandler(ctx context.Context, name string, handler v1a.JobHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.Job); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *jobController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v1a.JobHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.Job); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *jobController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v1a.JobHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.Job); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *jobController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v1a.JobHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.Job); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type jobLister struct {
+	controller *jobController
+}
+
+func (l *jobLister) List(namespace string, selector labels.Selector) (ret []*v1.Job, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v1.Job))
+	})
+	return
+}
+
+func (l *jobLister) Get(namespace, name string) (*v1.Job, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v1a.JobGroupVersionKind.Group,
+			Resource: "job",
+		}, key)
+	}
+	return obj.(*v1.Job), nil
+}
+
+type jobLifecycleAdapter struct {
+	lifecycle v1a.JobLifecycle
+}
+
+func (w *jobLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *jobLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *jobLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v1.Job))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *jobLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v1.Job))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *jobLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v1.Job))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newJobLifecycleAdapter(name string, clusterScoped bool, client *jobClient, l v1a.JobLifecycle) v1a.JobHandlerFunc {
+	adapter := &jobLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v1.Job) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
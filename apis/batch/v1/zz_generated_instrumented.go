@@ -0,0 +1,79 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/rancher/norman/controller"
+	"github.com/rancher/types/scope"
+)
+
+// NewInstrumented returns client with the generic controllers of its kinds
+// passed through instrument, which can wrap them to observe their handlers.
+// Cluster scoped handlers are added with the cluster name in their context,
+// see scope.WithClusterName.
+func NewInstrumented(client Interface, instrument func(controllerName string, generic controller.GenericController) controller.GenericController) Interface {
+	return &instrumentedClient{
+		Interface:  client,
+		instrument: instrument,
+	}
+}
+
+type instrumentedClient struct {
+	Interface
+	instrument func(controllerName string, generic controller.GenericController) controller.GenericController
+}
+
+func (c *instrumentedClient) Jobs(namespace string) JobInterface {
+	return &instrumentedJobClient{
+		JobInterface: c.Interface.Jobs(namespace),
+		instrument:   c.instrument,
+	}
+}
+
+type instrumentedJobClient struct {
+	JobInterface
+	instrument func(controllerName string, generic controller.GenericController) controller.GenericController
+}
+
+func (s *instrumentedJobClient) Controller() JobController {
+	return &jobController{
+		GenericController: s.instrument(JobGroupVersionKind.Kind+"Controller",
+			s.JobInterface.Controller().Generic()),
+	}
+}
+
+func (s *instrumentedJobClient) AddHandler(ctx context.Context, name string, sync JobHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *instrumentedJobClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync JobHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *instrumentedJobClient) AddLifecycle(ctx context.Context, name string, lifecycle JobLifecycle) {
+	sync := NewJobLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *instrumentedJobClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle JobLifecycle) {
+	sync := NewJobLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *instrumentedJobClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync JobHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(scope.WithClusterName(ctx, clusterName), name, clusterName, sync)
+}
+
+func (s *instrumentedJobClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync JobHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(scope.WithClusterName(ctx, clusterName), enabled, name, clusterName, sync)
+}
+
+func (s *instrumentedJobClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle JobLifecycle) {
+	sync := NewJobLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(scope.WithClusterName(ctx, clusterName), name, clusterName, sync)
+}
+
+func (s *instrumentedJobClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle JobLifecycle) {
+	sync := NewJobLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(scope.WithClusterName(ctx, clusterName), enabled, name, clusterName, sync)
+}
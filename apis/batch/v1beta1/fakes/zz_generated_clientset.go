@@ -0,0 +1,338 @@
+package fakes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rancher/norman/controller"
+	"github.com/rancher/norman/lifecycle"
+	"github.com/rancher/norman/objectclient"
+	v1beta1a "github.com/rancher/types/apis/batch/v1beta1"
+	"github.com/rancher/types/fakeclient"
+	v1beta1 "k8s.io/api/batch/v1beta1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
+)
+
+// Clientset is an in-memory v1beta1a.Interface. Its objects are kept in a
+// fakeclient.Tracker, which can be shared with the clientsets of other groups
+// to run their controllers together.
+type Clientset struct {
+	*fakeclient.Tracker
+}
+
+var _ v1beta1a.Interface = &Clientset{}
+
+// NewClientset returns a Clientset with a tracker of its own, holding
+// objects. It panics if an object is not a kind of the group.
+func NewClientset(objects ...runtime.Object) *Clientset {
+	return NewClientsetForTracker(fakeclient.NewTracker(), objects...)
+}
+
+// NewClientsetForTracker returns a Clientset storing its objects in tracker.
+// It panics if an object is not a kind of the group.
+func NewClientsetForTracker(tracker *fakeclient.Tracker, objects ...runtime.Object) *Clientset {
+	c := &Clientset{
+		Tracker: tracker,
+	}
+	if err := c.Add(objects...); err != nil {
+		panic(err)
+	}
+	return c
+}
+
+// RESTClient returns nil, the objects of a Clientset are not served.
+func (c *Clientset) RESTClient() rest.Interface {
+	return nil
+}
+
+// Add stores objects as they are, without the checks of Create.
+func (c *Clientset) Add(objects ...runtime.Object) error {
+	for _, obj := range objects {
+		var resource *fakeclient.Resource
+		switch obj.(type) {
+		case *v1beta1.CronJob:
+			resource = c.Resource(v1beta1a.CronJobGroupVersionKind, v1beta1a.CronJobResource)
+		default:
+			return fmt.Errorf("%T is not a kind of %s", obj, v1beta1a.GroupName)
+		}
+		if err := resource.Add(obj); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *Clientset) CronJobs(namespace string) v1beta1a.CronJobInterface {
+	resource := c.Resource(v1beta1a.CronJobGroupVersionKind, v1beta1a.CronJobResource)
+	return &cronJobClient{
+		client: resource.Client(namespace),
+	}
+}
+
+type cronJobClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *cronJobClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *cronJobClient) Create(o *v1beta1.CronJob) (*v1beta1.CronJob, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.CronJob), nil
+}
+
+func (s *cronJobClient) Get(name string, opts metav1.GetOptions) (*v1beta1.CronJob, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.CronJob), nil
+}
+
+func (s *cronJobClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v1beta1.CronJob, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.CronJob), nil
+}
+
+func (s *cronJobClient) Update(o *v1beta1.CronJob) (*v1beta1.CronJob, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.CronJob), nil
+}
+
+func (s *cronJobClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *cronJobClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *cronJobClient) List(opts metav1.ListOptions) (*v1beta1a.CronJobList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v1beta1a.CronJobList{}
+	list.APIVersion = v1beta1a.CronJobGroupVersionKind.GroupVersion().String()
+	list.Kind = v1beta1a.CronJobGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v1beta1.CronJob))
+	}
+	return list, nil
+}
+
+func (s *cronJobClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *cronJobClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *cronJobClient) Controller() v1beta1a.CronJobController {
+	return &cronJobController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *cronJobClient) AddHandler(ctx context.Context, name string, sync v1beta1a.CronJobHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *cronJobClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v1beta1a.CronJobHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *cronJobClient) AddLifecycle(ctx context.Context, name string, lifecycle v1beta1a.CronJobLifecycle) {
+	sync := newCronJobLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *cronJobClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v1beta1a.CronJobLifecycle) {
+	sync := newCronJobLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *cronJobClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v1beta1a.CronJobHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *cronJobClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v1beta1a.CronJobHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *cronJobClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v1beta1a.CronJobLifecycle) {
+	sync := newCronJobLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *cronJobClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v1beta1a.CronJobLifecycle) {
+	sync := newCronJobLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type cronJobController struct {
+	controller.GenericController
+}
+
+func (c *cronJobController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *cronJobController) Lister() v1beta1a.CronJobLister {
+	return &cronJobLister{
+		controller: c,
+	}
+}
+
+func (c *cronJobController) AddHandler(ctx context.Context, name string, handler v1beta1a.CronJobHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1beta1.CronJob); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *cronJobController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v1beta1a.CronJobHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1beta1.CronJob); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *cronJobController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v1beta1a.CronJobHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1beta1.CronJob); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *cronJobController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v1beta1a.CronJobHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1beta1.CronJob); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type cronJobLister struct {
+	controller *cronJobController
+}
+
+func (l *cronJobLister) List(namespace string, selector labels.Selector) (ret []*v1beta1.CronJob, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v1beta1.CronJob))
+	})
+	return
+}
+
+func (l *cronJobLister) Get(namespace, name string) (*v1beta1.CronJob, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v1beta1a.CronJobGroupVersionKind.Group,
+			Resource: "cronJob",
+		}, key)
+	}
+	return obj.(*v1beta1.CronJob), nil
+}
+
+type cronJobLifecycleAdapter struct {
+	lifecycle v1beta1a.CronJobLifecycle
+}
+
+func (w *cronJobLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *cronJobLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *cronJobLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v1beta1.CronJob))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *cronJobLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v1beta1.CronJob))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *cronJobLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v1beta1.CronJob))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newCronJobLifecycleAdapter(name string, clusterScoped bool, client *cronJobClient, l v1beta1a.CronJobLifecycle) v1beta1a.CronJobHandlerFunc {
+	adapter := &cronJobLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v1beta1.CronJob) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
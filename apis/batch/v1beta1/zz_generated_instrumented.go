@@ -0,0 +1,79 @@
+package v1beta1
+
+import (
+	"context"
+
+	"github.com/rancher/norman/controller"
+	"github.com/rancher/types/scope"
+)
+
+// NewInstrumented returns client with the generic controllers of its kinds
+// passed through instrument, which can wrap them to observe their handlers.
+// Cluster scoped handlers are added with the cluster name in their context,
+// see scope.WithClusterName.
+func NewInstrumented(client Interface, instrument func(controllerName string, generic controller.GenericController) controller.GenericController) Interface {
+	return &instrumentedClient{
+		Interface:  client,
+		instrument: instrument,
+	}
+}
+
+type instrumentedClient struct {
+	Interface
+	instrument func(controllerName string, generic controller.GenericController) controller.GenericController
+}
+
+func (c *instrumentedClient) CronJobs(namespace string) CronJobInterface {
+	return &instrumentedCronJobClient{
+		CronJobInterface: c.Interface.CronJobs(namespace),
+		instrument:       c.instrument,
+	}
+}
+
+type instrumentedCronJobClient struct {
+	CronJobInterface
+	instrument func(controllerName string, generic controller.GenericController) controller.GenericController
+}
+
+func (s *instrumentedCronJobClient) Controller() CronJobController {
+	return &cronJobController{
+		GenericController: s.instrument(CronJobGroupVersionKind.Kind+"Controller",
+			s.CronJobInterface.Controller().Generic()),
+	}
+}
+
+func (s *instrumentedCronJobClient) AddHandler(ctx context.Context, name string, sync CronJobHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *instrumentedCronJobClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync CronJobHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *instrumentedCronJobClient) AddLifecycle(ctx context.Context, name string, lifecycle CronJobLifecycle) {
+	sync := NewCronJobLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *instrumentedCronJobClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle CronJobLifecycle) {
+	sync := NewCronJobLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *instrumentedCronJobClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync CronJobHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(scope.WithClusterName(ctx, clusterName), name, clusterName, sync)
+}
+
+func (s *instrumentedCronJobClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync CronJobHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(scope.WithClusterName(ctx, clusterName), enabled, name, clusterName, sync)
+}
+
+func (s *instrumentedCronJobClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle CronJobLifecycle) {
+	sync := NewCronJobLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(scope.WithClusterName(ctx, clusterName), name, clusterName, sync)
+}
+
+func (s *instrumentedCronJobClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle CronJobLifecycle) {
+	sync := NewCronJobLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(scope.WithClusterName(ctx, clusterName), enabled, name, clusterName, sync)
+}
@@ -0,0 +1,607 @@
+package fakes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rancher/norman/controller"
+	"github.com/rancher/norman/lifecycle"
+	"github.com/rancher/norman/objectclient"
+	v3 "github.com/rancher/types/apis/cluster.cattle.io/v3"
+	"github.com/rancher/types/fakeclient"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
+)
+
+// Clientset is an in-memory v3.Interface. Its objects are kept in a
+// fakeclient.Tracker, which can be shared with the clientsets of other groups
+// to run their controllers together.
+type Clientset struct {
+	*fakeclient.Tracker
+}
+
+var _ v3.Interface = &Clientset{}
+
+// NewClientset returns a Clientset with a tracker of its own, holding
+// objects. It panics if an object is not a kind of the group.
+func NewClientset(objects ...runtime.Object) *Clientset {
+	return NewClientsetForTracker(fakeclient.NewTracker(), objects...)
+}
+
+// NewClientsetForTracker returns a Clientset storing its objects in tracker.
+// It panics if an object is not a kind of the group.
+func NewClientsetForTracker(tracker *fakeclient.Tracker, objects ...runtime.Object) *Clientset {
+	c := &Clientset{
+		Tracker: tracker,
+	}
+	if err := c.Add(objects...); err != nil {
+		panic(err)
+	}
+	return c
+}
+
+// RESTClient returns nil, the objects of a Clientset are not served.
+func (c *Clientset) RESTClient() rest.Interface {
+	return nil
+}
+
+// Add stores objects as they are, without the checks of Create.
+func (c *Clientset) Add(objects ...runtime.Object) error {
+	for _, obj := range objects {
+		var resource *fakeclient.Resource
+		switch obj.(type) {
+		case *v3.ClusterAuthToken:
+			resource = c.Resource(v3.ClusterAuthTokenGroupVersionKind, v3.ClusterAuthTokenResource)
+		case *v3.ClusterUserAttribute:
+			resource = c.Resource(v3.ClusterUserAttributeGroupVersionKind, v3.ClusterUserAttributeResource)
+		default:
+			return fmt.Errorf("%T is not a kind of %s", obj, v3.GroupName)
+		}
+		if err := resource.Add(obj); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *Clientset) ClusterAuthTokens(namespace string) v3.ClusterAuthTokenInterface {
+	resource := c.Resource(v3.ClusterAuthTokenGroupVersionKind, v3.ClusterAuthTokenResource)
+	return &clusterAuthTokenClient{
+		client: resource.Client(namespace),
+	}
+}
+
+func (c *Clientset) ClusterUserAttributes(namespace string) v3.ClusterUserAttributeInterface {
+	resource := c.Resource(v3.ClusterUserAttributeGroupVersionKind, v3.ClusterUserAttributeResource)
+	return &clusterUserAttributeClient{
+		client: resource.Client(namespace),
+	}
+}
+
+type clusterAuthTokenClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *clusterAuthTokenClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *clusterAuthTokenClient) Create(o *v3.ClusterAuthToken) (*v3.ClusterAuthToken, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.ClusterAuthToken), nil
+}
+
+func (s *clusterAuthTokenClient) Get(name string, opts metav1.GetOptions) (*v3.ClusterAuthToken, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.ClusterAuthToken), nil
+}
+
+func (s *clusterAuthTokenClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.ClusterAuthToken, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.ClusterAuthToken), nil
+}
+
+func (s *clusterAuthTokenClient) Update(o *v3.ClusterAuthToken) (*v3.ClusterAuthToken, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.ClusterAuthToken), nil
+}
+
+func (s *clusterAuthTokenClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *clusterAuthTokenClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *clusterAuthTokenClient) List(opts metav1.ListOptions) (*v3.ClusterAuthTokenList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v3.ClusterAuthTokenList{}
+	list.APIVersion = v3.ClusterAuthTokenGroupVersionKind.GroupVersion().String()
+	list.Kind = v3.ClusterAuthTokenGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v3.ClusterAuthToken))
+	}
+	return list, nil
+}
+
+func (s *clusterAuthTokenClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *clusterAuthTokenClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *clusterAuthTokenClient) Controller() v3.ClusterAuthTokenController {
+	return &clusterAuthTokenController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *clusterAuthTokenClient) AddHandler(ctx context.Context, name string, sync v3.ClusterAuthTokenHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *clusterAuthTokenClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v3.ClusterAuthTokenHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *clusterAuthTokenClient) AddLifecycle(ctx context.Context, name string, lifecycle v3.ClusterAuthTokenLifecycle) {
+	sync := newClusterAuthTokenLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *clusterAuthTokenClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v3.ClusterAuthTokenLifecycle) {
+	sync := newClusterAuthTokenLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *clusterAuthTokenClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v3.ClusterAuthTokenHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *clusterAuthTokenClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v3.ClusterAuthTokenHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *clusterAuthTokenClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v3.ClusterAuthTokenLifecycle) {
+	sync := newClusterAuthTokenLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *clusterAuthTokenClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v3.ClusterAuthTokenLifecycle) {
+	sync := newClusterAuthTokenLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type clusterAuthTokenController struct {
+	controller.GenericController
+}
+
+func (c *clusterAuthTokenController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *clusterAuthTokenController) Lister() v3.ClusterAuthTokenLister {
+	return &clusterAuthTokenLister{
+		controller: c,
+	}
+}
+
+func (c *clusterAuthTokenController) AddHandler(ctx context.Context, name string, handler v3.ClusterAuthTokenHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ClusterAuthToken); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *clusterAuthTokenController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v3.ClusterAuthTokenHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ClusterAuthToken); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *clusterAuthTokenController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v3.ClusterAuthTokenHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ClusterAuthToken); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *clusterAuthTokenController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v3.ClusterAuthTokenHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ClusterAuthToken); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type clusterAuthTokenLister struct {
+	controller *clusterAuthTokenController
+}
+
+func (l *clusterAuthTokenLister) List(namespace string, selector labels.Selector) (ret []*v3.ClusterAuthToken, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v3.ClusterAuthToken))
+	})
+	return
+}
+
+func (l *clusterAuthTokenLister) Get(namespace, name string) (*v3.ClusterAuthToken, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v3.ClusterAuthTokenGroupVersionKind.Group,
+			Resource: "clusterAuthToken",
+		}, key)
+	}
+	return obj.(*v3.ClusterAuthToken), nil
+}
+
+type clusterAuthTokenLifecycleAdapter struct {
+	lifecycle v3.ClusterAuthTokenLifecycle
+}
+
+func (w *clusterAuthTokenLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *clusterAuthTokenLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *clusterAuthTokenLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v3.ClusterAuthToken))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *clusterAuthTokenLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v3.ClusterAuthToken))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *clusterAuthTokenLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v3.ClusterAuthToken))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newClusterAuthTokenLifecycleAdapter(name string, clusterScoped bool, client *clusterAuthTokenClient, l v3.ClusterAuthTokenLifecycle) v3.ClusterAuthTokenHandlerFunc {
+	adapter := &clusterAuthTokenLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v3.ClusterAuthToken) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+
+type clusterUserAttributeClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *clusterUserAttributeClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *clusterUserAttributeClient) Create(o *v3.ClusterUserAttribute) (*v3.ClusterUserAttribute, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.ClusterUserAttribute), nil
+}
+
+func (s *clusterUserAttributeClient) Get(name string, opts metav1.GetOptions) (*v3.ClusterUserAttribute, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.ClusterUserAttribute), nil
+}
+
+func (s *clusterUserAttributeClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.ClusterUserAttribute, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.ClusterUserAttribute), nil
+}
+
+func (s *clusterUserAttributeClient) Update(o *v3.ClusterUserAttribute) (*v3.ClusterUserAttribute, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.ClusterUserAttribute), nil
+}
+
+func (s *clusterUserAttributeClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *clusterUserAttributeClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *clusterUserAttributeClient) List(opts metav1.ListOptions) (*v3.ClusterUserAttributeList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v3.ClusterUserAttributeList{}
+	list.APIVersion = v3.ClusterUserAttributeGroupVersionKind.GroupVersion().String()
+	list.Kind = v3.ClusterUserAttributeGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v3.ClusterUserAttribute))
+	}
+	return list, nil
+}
+
+func (s *clusterUserAttributeClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *clusterUserAttributeClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *clusterUserAttributeClient) Controller() v3.ClusterUserAttributeController {
+	return &clusterUserAttributeController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *clusterUserAttributeClient) AddHandler(ctx context.Context, name string, sync v3.ClusterUserAttributeHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *clusterUserAttributeClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v3.ClusterUserAttributeHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *clusterUserAttributeClient) AddLifecycle(ctx context.Context, name string, lifecycle v3.ClusterUserAttributeLifecycle) {
+	sync := newClusterUserAttributeLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *clusterUserAttributeClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v3.ClusterUserAttributeLifecycle) {
+	sync := newClusterUserAttributeLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *clusterUserAttributeClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v3.ClusterUserAttributeHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *clusterUserAttributeClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v3.ClusterUserAttributeHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *clusterUserAttributeClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v3.ClusterUserAttributeLifecycle) {
+	sync := newClusterUserAttributeLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *clusterUserAttributeClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v3.ClusterUserAttributeLifecycle) {
+	sync := newClusterUserAttributeLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type clusterUserAttributeController struct {
+	controller.GenericController
+}
+
+func (c *clusterUserAttributeController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *clusterUserAttributeController) Lister() v3.ClusterUserAttributeLister {
+	return &clusterUserAttributeLister{
+		controller: c,
+	}
+}
+
+func (c *clusterUserAttributeController) AddHandler(ctx context.Context, name string, handler v3.ClusterUserAttributeHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ClusterUserAttribute); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *clusterUserAttributeController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v3.ClusterUserAttributeHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ClusterUserAttribute); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *clusterUserAttributeController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v3.ClusterUserAttributeHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ClusterUserAttribute); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *clusterUserAttributeController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v3.ClusterUserAttributeHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ClusterUserAttribute); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type clusterUserAttributeLister struct {
+	controller *clusterUserAttributeController
+}
+
+func (l *clusterUserAttributeLister) List(namespace string, selector labels.Selector) (ret []*v3.ClusterUserAttribute, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v3.ClusterUserAttribute))
+	})
+	return
+}
+
+func (l *clusterUserAttributeLister) Get(namespace, name string) (*v3.ClusterUserAttribute, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v3.ClusterUserAttributeGroupVersionKind.Group,
+			Resource: "clusterUserAttribute",
+		}, key)
+	}
+	return obj.(*v3.ClusterUserAttribute), nil
+}
+
+type clusterUserAttributeLifecycleAdapter struct {
+	lifecycle v3.ClusterUserAttributeLifecycle
+}
+
+func (w *clusterUserAttributeLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *clusterUserAttributeLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *clusterUserAttributeLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v3.ClusterUserAttribute))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *clusterUserAttributeLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v3.ClusterUserAttribute))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *clusterUserAttributeLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v3.ClusterUserAttribute))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newClusterUserAttributeLifecycleAdapter(name string, clusterScoped bool, client *clusterUserAttributeClient, l v3.ClusterUserAttributeLifecycle) v3.ClusterUserAttributeHandlerFunc {
+	adapter := &clusterUserAttributeLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v3.ClusterUserAttribute) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
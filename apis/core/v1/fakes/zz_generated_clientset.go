@@ -0,0 +1,3848 @@
+package fakes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rancher/norman/controller"
+	"github.com/rancher/norman/lifecycle"
+	"github.com/rancher/norman/objectclient"
+	v1a "github.com/rancher/types/apis/core/v1"
+	"github.com/rancher/types/fakeclient"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
+)
+
+// Clientset is an in-memory v1a.Interface. Its objects are kept in a
+// fakeclient.Tracker, which can be shared with the clientsets of other groups
+// to run their controllers together.
+type Clientset struct {
+	*fakeclient.Tracker
+}
+
+var _ v1a.Interface = &Clientset{}
+
+// NewClientset returns a Clientset with a tracker of its own, holding
+// objects. It panics if an object is not a kind of the group.
+func NewClientset(objects ...runtime.Object) *Clientset {
+	return NewClientsetForTracker(fakeclient.NewTracker(), objects...)
+}
+
+// NewClientsetForTracker returns a Clientset storing its objects in tracker.
+// It panics if an object is not a kind of the group.
+func NewClientsetForTracker(tracker *fakeclient.Tracker, objects ...runtime.Object) *Clientset {
+	c := &Clientset{
+		Tracker: tracker,
+	}
+	if err := c.Add(objects...); err != nil {
+		panic(err)
+	}
+	return c
+}
+
+// RESTClient returns nil, the objects of a Clientset are not served.
+func (c *Clientset) RESTClient() rest.Interface {
+	return nil
+}
+
+// Add stores objects as they are, without the checks of Create.
+func (c *Clientset) Add(objects ...runtime.Object) error {
+	for _, obj := range objects {
+		var resource *fakeclient.Resource
+		switch obj.(type) {
+		case *v1.Node:
+			resource = c.Resource(v1a.NodeGroupVersionKind, v1a.NodeResource)
+		case *v1.ComponentStatus:
+			resource = c.Resource(v1a.ComponentStatusGroupVersionKind, v1a.ComponentStatusResource)
+		case *v1.Namespace:
+			resource = c.Resource(v1a.NamespaceGroupVersionKind, v1a.NamespaceResource)
+		case *v1.Event:
+			resource = c.Resource(v1a.EventGroupVersionKind, v1a.EventResource)
+		case *v1.Endpoints:
+			resource = c.Resource(v1a.EndpointsGroupVersionKind, v1a.EndpointsResource)
+		case *v1.PersistentVolumeClaim:
+			resource = c.Resource(v1a.PersistentVolumeClaimGroupVersionKind, v1a.PersistentVolumeClaimResource)
+		case *v1.Pod:
+			resource = c.Resource(v1a.PodGroupVersionKind, v1a.PodResource)
+		case *v1.Service:
+			resource = c.Resource(v1a.ServiceGroupVersionKind, v1a.ServiceResource)
+		case *v1.Secret:
+			resource = c.Resource(v1a.SecretGroupVersionKind, v1a.SecretResource)
+		case *v1.ConfigMap:
+			resource = c.Resource(v1a.ConfigMapGroupVersionKind, v1a.ConfigMapResource)
+		case *v1.ServiceAccount:
+			resource = c.Resource(v1a.ServiceAccountGroupVersionKind, v1a.ServiceAccountResource)
+		case *v1.ReplicationController:
+			resource = c.Resource(v1a.ReplicationControllerGroupVersionKind, v1a.ReplicationControllerResource)
+		case *v1.ResourceQuota:
+			resource = c.Resource(v1a.ResourceQuotaGroupVersionKind, v1a.ResourceQuotaResource)
+		case *v1.LimitRange:
+			resource = c.Resource(v1a.LimitRangeGroupVersionKind, v1a.LimitRangeResource)
+		default:
+			return fmt.Errorf("%T is not a kind of %s", obj, v1a.GroupName)
+		}
+		if err := resource.Add(obj); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *Clientset) Nodes(namespace string) v1a.NodeInterface {
+	resource := c.Resource(v1a.NodeGroupVersionKind, v1a.NodeResource)
+	return &nodeClient{
+		client: resource.Client(namespace),
+	}
+}
+
+func (c *Clientset) ComponentStatuses(namespace string) v1a.ComponentStatusInterface {
+	resource := c.Resource(v1a.ComponentStatusGroupVersionKind, v1a.ComponentStatusResource)
+	return &componentStatusClient{
+		client: resource.Client(namespace),
+	}
+}
+
+func (c *Clientset) Namespaces(namespace string) v1a.NamespaceInterface {
+	resource := c.Resource(v1a.NamespaceGroupVersionKind, v1a.NamespaceResource)
+	return &namespaceClient{
+		client: resource.Client(namespace),
+	}
+}
+
+func (c *Clientset) Events(namespace string) v1a.EventInterface {
+	resource := c.Resource(v1a.EventGroupVersionKind, v1a.EventResource)
+	return &eventClient{
+		client: resource.Client(namespace),
+	}
+}
+
+func (c *Clientset) Endpoints(namespace string) v1a.EndpointsInterface {
+	resource := c.Resource(v1a.EndpointsGroupVersionKind, v1a.EndpointsResource)
+	return &endpointsClient{
+		client: resource.Client(namespace),
+	}
+}
+
+func (c *Clientset) PersistentVolumeClaims(namespace string) v1a.PersistentVolumeClaimInterface {
+	resource := c.Resource(v1a.PersistentVolumeClaimGroupVersionKind, v1a.PersistentVolumeClaimResource)
+	return &persistentVolumeClaimClient{
+		client: resource.Client(namespace),
+	}
+}
+
+func (c *Clientset) Pods(namespace string) v1a.PodInterface {
+	resource := c.Resource(v1a.PodGroupVersionKind, v1a.PodResource)
+	return &podClient{
+		client: resource.Client(namespace),
+	}
+}
+
+func (c *Clientset) Services(namespace string) v1a.ServiceInterface {
+	resource := c.Resource(v1a.ServiceGroupVersionKind, v1a.ServiceResource)
+	return &serviceClient{
+		client: resource.Client(namespace),
+	}
+}
+
+func (c *Clientset) Secrets(namespace string) v1a.SecretInterface {
+	resource := c.Resource(v1a.SecretGroupVersionKind, v1a.SecretResource)
+	return &secretClient{
+		client: resource.Client(namespace),
+	}
+}
+
+func (c *Clientset) ConfigMaps(namespace string) v1a.ConfigMapInterface {
+	resource := c.Resource(v1a.ConfigMapGroupVersionKind, v1a.ConfigMapResource)
+	return &configMapClient{
+		client: resource.Client(namespace),
+	}
+}
+
+func (c *Clientset) ServiceAccounts(namespace string) v1a.ServiceAccountInterface {
+	resource := c.Resource(v1a.ServiceAccountGroupVersionKind, v1a.ServiceAccountResource)
+	return &serviceAccountClient{
+		client: resource.Client(namespace),
+	}
+}
+
+func (c *Clientset) ReplicationControllers(namespace string) v1a.ReplicationControllerInterface {
+	resource := c.Resource(v1a.ReplicationControllerGroupVersionKind, v1a.ReplicationControllerResource)
+	return &replicationControllerClient{
+		client: resource.Client(namespace),
+	}
+}
+
+func (c *Clientset) ResourceQuotas(namespace string) v1a.ResourceQuotaInterface {
+	resource := c.Resource(v1a.ResourceQuotaGroupVersionKind, v1a.ResourceQuotaResource)
+	return &resourceQuotaClient{
+		client: resource.Client(namespace),
+	}
+}
+
+func (c *Clientset) LimitRanges(namespace string) v1a.LimitRangeInterface {
+	resource := c.Resource(v1a.LimitRangeGroupVersionKind, v1a.LimitRangeResource)
+	return &limitRangeClient{
+		client: resource.Client(namespace),
+	}
+}
+
+type nodeClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *nodeClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *nodeClient) Create(o *v1.Node) (*v1.Node, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.Node), nil
+}
+
+func (s *nodeClient) Get(name string, opts metav1.GetOptions) (*v1.Node, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.Node), nil
+}
+
+func (s *nodeClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v1.Node, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.Node), nil
+}
+
+func (s *nodeClient) Update(o *v1.Node) (*v1.Node, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.Node), nil
+}
+
+func (s *nodeClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *nodeClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *nodeClient) List(opts metav1.ListOptions) (*v1a.NodeList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v1a.NodeList{}
+	list.APIVersion = v1a.NodeGroupVersionKind.GroupVersion().String()
+	list.Kind = v1a.NodeGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v1.Node))
+	}
+	return list, nil
+}
+
+func (s *nodeClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *nodeClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *nodeClient) Controller() v1a.NodeController {
+	return &nodeController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *nodeClient) AddHandler(ctx context.Context, name string, sync v1a.NodeHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *nodeClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v1a.NodeHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *nodeClient) AddLifecycle(ctx context.Context, name string, lifecycle v1a.NodeLifecycle) {
+	sync := newNodeLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *nodeClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v1a.NodeLifecycle) {
+	sync := newNodeLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *nodeClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v1a.NodeHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *nodeClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v1a.NodeHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *nodeClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v1a.NodeLifecycle) {
+	sync := newNodeLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *nodeClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v1a.NodeLifecycle) {
+	sync := newNodeLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type nodeController struct {
+	controller.GenericController
+}
+
+func (c *nodeController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *nodeController) Lister() v1a.NodeLister {
+	return &nodeLister{
+		controller: c,
+	}
+}
+
+func (c *nodeController) AddHandler(ctx context.Context, name string, handler v1a.NodeHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.Node); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *nodeController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v1a.NodeHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.Node); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *nodeController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v1a.NodeHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.Node); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *nodeController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v1a.NodeHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.Node); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type nodeLister struct {
+	controller *nodeController
+}
+
+func (l *nodeLister) List(namespace string, selector labels.Selector) (ret []*v1.Node, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v1.Node))
+	})
+	return
+}
+
+func (l *nodeLister) Get(namespace, name string) (*v1.Node, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v1a.NodeGroupVersionKind.Group,
+			Resource: "node",
+		}, key)
+	}
+	return obj.(*v1.Node), nil
+}
+
+type nodeLifecycleAdapter struct {
+	lifecycle v1a.NodeLifecycle
+}
+
+func (w *nodeLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *nodeLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *nodeLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v1.Node))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *nodeLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v1.Node))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *nodeLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v1.Node))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newNodeLifecycleAdapter(name string, clusterScoped bool, client *nodeClient, l v1a.NodeLifecycle) v1a.NodeHandlerFunc {
+	adapter := &nodeLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v1.Node) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+
+type componentStatusClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *componentStatusClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *componentStatusClient) Create(o *v1.ComponentStatus) (*v1.ComponentStatus, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.ComponentStatus), nil
+}
+
+func (s *componentStatusClient) Get(name string, opts metav1.GetOptions) (*v1.ComponentStatus, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.ComponentStatus), nil
+}
+
+func (s *componentStatusClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v1.ComponentStatus, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.ComponentStatus), nil
+}
+
+func (s *componentStatusClient) Update(o *v1.ComponentStatus) (*v1.ComponentStatus, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.ComponentStatus), nil
+}
+
+func (s *componentStatusClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *componentStatusClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *componentStatusClient) List(opts metav1.ListOptions) (*v1a.ComponentStatusList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v1a.ComponentStatusList{}
+	list.APIVersion = v1a.ComponentStatusGroupVersionKind.GroupVersion().String()
+	list.Kind = v1a.ComponentStatusGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v1.ComponentStatus))
+	}
+	return list, nil
+}
+
+func (s *componentStatusClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *componentStatusClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *componentStatusClient) Controller() v1a.ComponentStatusController {
+	return &componentStatusController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *componentStatusClient) AddHandler(ctx context.Context, name string, sync v1a.ComponentStatusHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *componentStatusClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v1a.ComponentStatusHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *componentStatusClient) AddLifecycle(ctx context.Context, name string, lifecycle v1a.ComponentStatusLifecycle) {
+	sync := newComponentStatusLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *componentStatusClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v1a.ComponentStatusLifecycle) {
+	sync := newComponentStatusLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *componentStatusClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v1a.ComponentStatusHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *componentStatusClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v1a.ComponentStatusHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *componentStatusClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v1a.ComponentStatusLifecycle) {
+	sync := newComponentStatusLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *componentStatusClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v1a.ComponentStatusLifecycle) {
+	sync := newComponentStatusLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type componentStatusController struct {
+	controller.GenericController
+}
+
+func (c *componentStatusController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *componentStatusController) Lister() v1a.ComponentStatusLister {
+	return &componentStatusLister{
+		controller: c,
+	}
+}
+
+func (c *componentStatusController) AddHandler(ctx context.Context, name string, handler v1a.ComponentStatusHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.ComponentStatus); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *componentStatusController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v1a.ComponentStatusHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.ComponentStatus); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *componentStatusController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v1a.ComponentStatusHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.ComponentStatus); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *componentStatusController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v1a.ComponentStatusHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.ComponentStatus); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type componentStatusLister struct {
+	controller *componentStatusController
+}
+
+func (l *componentStatusLister) List(namespace string, selector labels.Selector) (ret []*v1.ComponentStatus, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v1.ComponentStatus))
+	})
+	return
+}
+
+func (l *componentStatusLister) Get(namespace, name string) (*v1.ComponentStatus, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v1a.ComponentStatusGroupVersionKind.Group,
+			Resource: "componentStatus",
+		}, key)
+	}
+	return obj.(*v1.ComponentStatus), nil
+}
+
+type componentStatusLifecycleAdapter struct {
+	lifecycle v1a.ComponentStatusLifecycle
+}
+
+func (w *componentStatusLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *componentStatusLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *componentStatusLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v1.ComponentStatus))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *componentStatusLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v1.ComponentStatus))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *componentStatusLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v1.ComponentStatus))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newComponentStatusLifecycleAdapter(name string, clusterScoped bool, client *componentStatusClient, l v1a.ComponentStatusLifecycle) v1a.ComponentStatusHandlerFunc {
+	adapter := &componentStatusLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v1.ComponentStatus) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+
+type namespaceClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *namespaceClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *namespaceClient) Create(o *v1.Namespace) (*v1.Namespace, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.Namespace), nil
+}
+
+func (s *namespaceClient) Get(name string, opts metav1.GetOptions) (*v1.Namespace, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.Namespace), nil
+}
+
+func (s *namespaceClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v1.Namespace, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.Namespace), nil
+}
+
+func (s *namespaceClient) Update(o *v1.Namespace) (*v1.Namespace, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.Namespace), nil
+}
+
+func (s *namespaceClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *namespaceClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *namespaceClient) List(opts metav1.ListOptions) (*v1a.NamespaceList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v1a.NamespaceList{}
+	list.APIVersion = v1a.NamespaceGroupVersionKind.GroupVersion().String()
+	list.Kind = v1a.NamespaceGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v1.Namespace))
+	}
+	return list, nil
+}
+
+func (s *namespaceClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *namespaceClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *namespaceClient) Controller() v1a.NamespaceController {
+	return &namespaceController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *namespaceClient) AddHandler(ctx context.Context, name string, sync v1a.NamespaceHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *namespaceClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v1a.NamespaceHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *namespaceClient) AddLifecycle(ctx context.Context, name string, lifecycle v1a.NamespaceLifecycle) {
+	sync := newNamespaceLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *namespaceClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v1a.NamespaceLifecycle) {
+	sync := newNamespaceLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *namespaceClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v1a.NamespaceHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *namespaceClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v1a.NamespaceHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *namespaceClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v1a.NamespaceLifecycle) {
+	sync := newNamespaceLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *namespaceClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v1a.NamespaceLifecycle) {
+	sync := newNamespaceLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type namespaceController struct {
+	controller.GenericController
+}
+
+func (c *namespaceController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *namespaceController) Lister() v1a.NamespaceLister {
+	return &namespaceLister{
+		controller: c,
+	}
+}
+
+func (c *namespaceController) AddHandler(ctx context.Context, name string, handler v1a.NamespaceHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.Namespace); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *namespaceController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v1a.NamespaceHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.Namespace); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *namespaceController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v1a.NamespaceHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.Namespace); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *namespaceController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v1a.NamespaceHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.Namespace); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type namespaceLister struct {
+	controller *namespaceController
+}
+
+func (l *namespaceLister) List(namespace string, selector labels.Selector) (ret []*v1.Namespace, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v1.Namespace))
+	})
+	return
+}
+
+func (l *namespaceLister) Get(namespace, name string) (*v1.Namespace, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v1a.NamespaceGroupVersionKind.Group,
+			Resource: "namespace",
+		}, key)
+	}
+	return obj.(*v1.Namespace), nil
+}
+
+type namespaceLifecycleAdapter struct {
+	lifecycle v1a.NamespaceLifecycle
+}
+
+func (w *namespaceLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *namespaceLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *namespaceLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v1.Namespace))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *namespaceLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v1.Namespace))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *namespaceLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v1.Namespace))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newNamespaceLifecycleAdapter(name string, clusterScoped bool, client *namespaceClient, l v1a.NamespaceLifecycle) v1a.NamespaceHandlerFunc {
+	adapter := &namespaceLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v1.Namespace) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+
+type eventClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *eventClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *eventClient) Create(o *v1.Event) (*v1.Event, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.Event), nil
+}
+
+func (s *eventClient) Get(name string, opts metav1.GetOptions) (*v1.Event, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.Event), nil
+}
+
+func (s *eventClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v1.Event, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.Event), nil
+}
+
+func (s *eventClient) Update(o *v1.Event) (*v1.Event, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.Event), nil
+}
+
+func (s *eventClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *eventClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *eventClient) List(opts metav1.ListOptions) (*v1a.EventList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v1a.EventList{}
+	list.APIVersion = v1a.EventGroupVersionKind.GroupVersion().String()
+	list.Kind = v1a.EventGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v1.Event))
+	}
+	return list, nil
+}
+
+func (s *eventClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *eventClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *eventClient) Controller() v1a.EventController {
+	return &eventController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *eventClient) AddHandler(ctx context.Context, name string, sync v1a.EventHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *eventClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v1a.EventHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *eventClient) AddLifecycle(ctx context.Context, name string, lifecycle v1a.EventLifecycle) {
+	sync := newEventLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *eventClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v1a.EventLifecycle) {
+	sync := newEventLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *eventClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v1a.EventHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *eventClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v1a.EventHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *eventClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v1a.EventLifecycle) {
+	sync := newEventLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *eventClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v1a.EventLifecycle) {
+	sync := newEventLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type eventController struct {
+	controller.GenericController
+}
+
+func (c *eventController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *eventController) Lister() v1a.EventLister {
+	return &eventLister{
+		controller: c,
+	}
+}
+
+func (c *eventController) AddHandler(ctx context.Context, name string, handler v1a.EventHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.Event); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *eventController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v1a.EventHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.Event); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *eventController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v1a.EventHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.Event); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *eventController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v1a.EventHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.Event); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type eventLister struct {
+	controller *eventController
+}
+
+func (l *eventLister) List(namespace string, selector labels.Selector) (ret []*v1.Event, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v1.Event))
+	})
+	return
+}
+
+func (l *eventLister) Get(namespace, name string) (*v1.Event, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v1a.EventGroupVersionKind.Group,
+			Resource: "event",
+		}, key)
+	}
+	return obj.(*v1.Event), nil
+}
+
+type eventLifecycleAdapter struct {
+	lifecycle v1a.EventLifecycle
+}
+
+func (w *eventLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *eventLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *eventLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v1.Event))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *eventLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v1.Event))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *eventLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v1.Event))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newEventLifecycleAdapter(name string, clusterScoped bool, client *eventClient, l v1a.EventLifecycle) v1a.EventHandlerFunc {
+	adapter := &eventLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v1.Event) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+
+type endpointsClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *endpointsClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *endpointsClient) Create(o *v1.Endpoints) (*v1.Endpoints, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.Endpoints), nil
+}
+
+func (s *endpointsClient) Get(name string, opts metav1.GetOptions) (*v1.Endpoints, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.Endpoints), nil
+}
+
+func (s *endpointsClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v1.Endpoints, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.Endpoints), nil
+}
+
+func (s *endpointsClient) Update(o *v1.Endpoints) (*v1.Endpoints, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.Endpoints), nil
+}
+
+func (s *endpointsClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *endpointsClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *endpointsClient) List(opts metav1.ListOptions) (*v1a.EndpointsList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v1a.EndpointsList{}
+	list.APIVersion = v1a.EndpointsGroupVersionKind.GroupVersion().String()
+	list.Kind = v1a.EndpointsGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v1.Endpoints))
+	}
+	return list, nil
+}
+
+func (s *endpointsClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *endpointsClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *endpointsClient) Controller() v1a.EndpointsController {
+	return &endpointsController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *endpointsClient) AddHandler(ctx context.Context, name string, sync v1a.EndpointsHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *endpointsClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v1a.EndpointsHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *endpointsClient) AddLifecycle(ctx context.Context, name string, lifecycle v1a.EndpointsLifecycle) {
+	sync := newEndpointsLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *endpointsClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v1a.EndpointsLifecycle) {
+	sync := newEndpointsLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *endpointsClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v1a.EndpointsHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *endpointsClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v1a.EndpointsHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *endpointsClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v1a.EndpointsLifecycle) {
+	sync := newEndpointsLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *endpointsClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v1a.EndpointsLifecycle) {
+	sync := newEndpointsLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type endpointsController struct {
+	controller.GenericController
+}
+
+func (c *endpointsController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *endpointsController) Lister() v1a.EndpointsLister {
+	return &endpointsLister{
+		controller: c,
+	}
+}
+
+func (c *endpointsController) AddHandler(ctx context.Context, name string, handler v1a.EndpointsHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.Endpoints); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *endpointsController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v1a.EndpointsHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.Endpoints); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *endpointsController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v1a.EndpointsHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.Endpoints); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *endpointsController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v1a.EndpointsHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.Endpoints); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type endpointsLister struct {
+	controller *endpointsController
+}
+
+func (l *endpointsLister) List(namespace string, selector labels.Selector) (ret []*v1.Endpoints, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v1.Endpoints))
+	})
+	return
+}
+
+func (l *endpointsLister) Get(namespace, name string) (*v1.Endpoints, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v1a.EndpointsGroupVersionKind.Group,
+			Resource: "endpoints",
+		}, key)
+	}
+	return obj.(*v1.Endpoints), nil
+}
+
+type endpointsLifecycleAdapter struct {
+	lifecycle v1a.EndpointsLifecycle
+}
+
+func (w *endpointsLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *endpointsLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *endpointsLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v1.Endpoints))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *endpointsLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v1.Endpoints))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *endpointsLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v1.Endpoints))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newEndpointsLifecycleAdapter(name string, clusterScoped bool, client *endpointsClient, l v1a.EndpointsLifecycle) v1a.EndpointsHandlerFunc {
+	adapter := &endpointsLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v1.Endpoints) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+
+type persistentVolumeClaimClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *persistentVolumeClaimClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *persistentVolumeClaimClient) Create(o *v1.PersistentVolumeClaim) (*v1.PersistentVolumeClaim, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.PersistentVolumeClaim), nil
+}
+
+func (s *persistentVolumeClaimClient) Get(name string, opts metav1.GetOptions) (*v1.PersistentVolumeClaim, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.PersistentVolumeClaim), nil
+}
+
+func (s *persistentVolumeClaimClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v1.PersistentVolumeClaim, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.PersistentVolumeClaim), nil
+}
+
+func (s *persistentVolumeClaimClient) Update(o *v1.PersistentVolumeClaim) (*v1.PersistentVolumeClaim, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.PersistentVolumeClaim), nil
+}
+
+func (s *persistentVolumeClaimClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *persistentVolumeClaimClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *persistentVolumeClaimClient) List(opts metav1.ListOptions) (*v1a.PersistentVolumeClaimList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v1a.PersistentVolumeClaimList{}
+	list.APIVersion = v1a.PersistentVolumeClaimGroupVersionKind.GroupVersion().String()
+	list.Kind = v1a.PersistentVolumeClaimGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v1.PersistentVolumeClaim))
+	}
+	return list, nil
+}
+
+func (s *persistentVolumeClaimClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *persistentVolumeClaimClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *persistentVolumeClaimClient) Controller() v1a.PersistentVolumeClaimController {
+	return &persistentVolumeClaimController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *persistentVolumeClaimClient) AddHandler(ctx context.Context, name string, sync v1a.PersistentVolumeClaimHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *persistentVolumeClaimClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v1a.PersistentVolumeClaimHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *persistentVolumeClaimClient) AddLifecycle(ctx context.Context, name string, lifecycle v1a.PersistentVolumeClaimLifecycle) {
+	sync := newPersistentVolumeClaimLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *persistentVolumeClaimClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v1a.PersistentVolumeClaimLifecycle) {
+	sync := newPersistentVolumeClaimLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *persistentVolumeClaimClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v1a.PersistentVolumeClaimHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *persistentVolumeClaimClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v1a.PersistentVolumeClaimHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *persistentVolumeClaimClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v1a.PersistentVolumeClaimLifecycle) {
+	sync := newPersistentVolumeClaimLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *persistentVolumeClaimClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v1a.PersistentVolumeClaimLifecycle) {
+	sync := newPersistentVolumeClaimLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type persistentVolumeClaimController struct {
+	controller.GenericController
+}
+
+func (c *persistentVolumeClaimController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *persistentVolumeClaimController) Lister() v1a.PersistentVolumeClaimLister {
+	return &persistentVolumeClaimLister{
+		controller: c,
+	}
+}
+
+func (c *persistentVolumeClaimController) AddHandler(ctx context.Context, name string, handler v1a.PersistentVolumeClaimHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.PersistentVolumeClaim); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *persistentVolumeClaimController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v1a.PersistentVolumeClaimHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.PersistentVolumeClaim); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *persistentVolumeClaimController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v1a.PersistentVolumeClaimHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.PersistentVolumeClaim); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *persistentVolumeClaimController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v1a.PersistentVolumeClaimHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.PersistentVolumeClaim); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type persistentVolumeClaimLister struct {
+	controller *persistentVolumeClaimController
+}
+
+func (l *persistentVolumeClaimLister) List(namespace string, selector labels.Selector) (ret []*v1.PersistentVolumeClaim, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v1.PersistentVolumeClaim))
+	})
+	return
+}
+
+func (l *persistentVolumeClaimLister) Get(namespace, name string) (*v1.PersistentVolumeClaim, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v1a.PersistentVolumeClaimGroupVersionKind.Group,
+			Resource: "persistentVolumeClaim",
+		}, key)
+	}
+	return obj.(*v1.PersistentVolumeClaim), nil
+}
+
+type persistentVolumeClaimLifecycleAdapter struct {
+	lifecycle v1a.PersistentVolumeClaimLifecycle
+}
+
+func (w *persistentVolumeClaimLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *persistentVolumeClaimLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *persistentVolumeClaimLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v1.PersistentVolumeClaim))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *persistentVolumeClaimLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v1.PersistentVolumeClaim))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *persistentVolumeClaimLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v1.PersistentVolumeClaim))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newPersistentVolumeClaimLifecycleAdapter(name string, clusterScoped bool, client *persistentVolumeClaimClient, l v1a.PersistentVolumeClaimLifecycle) v1a.PersistentVolumeClaimHandlerFunc {
+	adapter := &persistentVolumeClaimLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v1.PersistentVolumeClaim) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+
+type podClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *podClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *podClient) Create(o *v1.Pod) (*v1.Pod, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.Pod), nil
+}
+
+func (s *podClient) Get(name string, opts metav1.GetOptions) (*v1.Pod, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.Pod), nil
+}
+
+func (s *podClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v1.Pod, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.Pod), nil
+}
+
+func (s *podClient) Update(o *v1.Pod) (*v1.Pod, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.Pod), nil
+}
+
+func (s *podClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *podClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *podClient) List(opts metav1.ListOptions) (*v1a.PodList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v1a.PodList{}
+	list.APIVersion = v1a.PodGroupVersionKind.GroupVersion().String()
+	list.Kind = v1a.PodGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v1.Pod))
+	}
+	return list, nil
+}
+
+func (s *podClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *podClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *podClient) Controller() v1a.PodController {
+	return &podController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *podClient) AddHandler(ctx context.Context, name string, sync v1a.PodHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *podClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v1a.PodHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *podClient) AddLifecycle(ctx context.Context, name string, lifecycle v1a.PodLifecycle) {
+	sync := newPodLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *podClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v1a.PodLifecycle) {
+	sync := newPodLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *podClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v1a.PodHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *podClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v1a.PodHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *podClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v1a.PodLifecycle) {
+	sync := newPodLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *podClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v1a.PodLifecycle) {
+	sync := newPodLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type podController struct {
+	controller.GenericController
+}
+
+func (c *podController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *podController) Lister() v1a.PodLister {
+	return &podLister{
+		controller: c,
+	}
+}
+
+func (c *podController) AddHandler(ctx context.Context, name string, handler v1a.PodHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.Pod); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *podController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v1a.PodHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.Pod); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *podController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v1a.PodHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.Pod); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *podController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v1a.PodHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.Pod); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type podLister struct {
+	controller *podController
+}
+
+func (l *podLister) List(namespace string, selector labels.Selector) (ret []*v1.Pod, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v1.Pod))
+	})
+	return
+}
+
+func (l *podLister) Get(namespace, name string) (*v1.Pod, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v1a.PodGroupVersionKind.Group,
+			Resource: "pod",
+		}, key)
+	}
+	return obj.(*v1.Pod), nil
+}
+
+type podLifecycleAdapter struct {
+	lifecycle v1a.PodLifecycle
+}
+
+func (w *podLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *podLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *podLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v1.Pod))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *podLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v1.Pod))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *podLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v1.Pod))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newPodLifecycleAdapter(name string, clusterScoped bool, client *podClient, l v1a.PodLifecycle) v1a.PodHandlerFunc {
+	adapter := &podLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v1.Pod) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+
+type serviceClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *serviceClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *serviceClient) Create(o *v1.Service) (*v1.Service, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.Service), nil
+}
+
+func (s *serviceClient) Get(name string, opts metav1.GetOptions) (*v1.Service, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.Service), nil
+}
+
+func (s *serviceClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v1.Service, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.Service), nil
+}
+
+func (s *serviceClient) Update(o *v1.Service) (*v1.Service, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.Service), nil
+}
+
+func (s *serviceClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *serviceClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *serviceClient) List(opts metav1.ListOptions) (*v1a.ServiceList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v1a.ServiceList{}
+	list.APIVersion = v1a.ServiceGroupVersionKind.GroupVersion().String()
+	list.Kind = v1a.ServiceGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v1.Service))
+	}
+	return list, nil
+}
+
+func (s *serviceClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *serviceClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *serviceClient) Controller() v1a.ServiceController {
+	return &serviceController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *serviceClient) AddHandler(ctx context.Context, name string, sync v1a.ServiceHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *serviceClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v1a.ServiceHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *serviceClient) AddLifecycle(ctx context.Context, name string, lifecycle v1a.ServiceLifecycle) {
+	sync := newServiceLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *serviceClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v1a.ServiceLifecycle) {
+	sync := newServiceLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *serviceClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v1a.ServiceHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *serviceClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v1a.ServiceHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *serviceClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v1a.ServiceLifecycle) {
+	sync := newServiceLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *serviceClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v1a.ServiceLifecycle) {
+	sync := newServiceLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type serviceController struct {
+	controller.GenericController
+}
+
+func (c *serviceController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *serviceController) Lister() v1a.ServiceLister {
+	return &serviceLister{
+		controller: c,
+	}
+}
+
+func (c *serviceController) AddHandler(ctx context.Context, name string, handler v1a.ServiceHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.Service); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *serviceController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v1a.ServiceHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.Service); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *serviceController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v1a.ServiceHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.Service); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *serviceController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v1a.ServiceHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.Service); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type serviceLister struct {
+	controller *serviceController
+}
+
+func (l *serviceLister) List(namespace string, selector labels.Selector) (ret []*v1.Service, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v1.Service))
+	})
+	return
+}
+
+func (l *serviceLister) Get(namespace, name string) (*v1.Service, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v1a.ServiceGroupVersionKind.Group,
+			Resource: "service",
+		}, key)
+	}
+	return obj.(*v1.Service), nil
+}
+
+type serviceLifecycleAdapter struct {
+	lifecycle v1a.ServiceLifecycle
+}
+
+func (w *serviceLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *serviceLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *serviceLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v1.Service))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *serviceLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v1.Service))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *serviceLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v1.Service))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newServiceLifecycleAdapter(name string, clusterScoped bool, client *serviceClient, l v1a.ServiceLifecycle) v1a.ServiceHandlerFunc {
+	adapter := &serviceLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v1.Service) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+
+type secretClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *secretClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *secretClient) Create(o *v1.Secret) (*v1.Secret, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.Secret), nil
+}
+
+func (s *secretClient) Get(name string, opts metav1.GetOptions) (*v1.Secret, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.Secret), nil
+}
+
+func (s *secretClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v1.Secret, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.Secret), nil
+}
+
+func (s *secretClient) Update(o *v1.Secret) (*v1.Secret, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.Secret), nil
+}
+
+func (s *secretClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *secretClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *secretClient) List(opts metav1.ListOptions) (*v1a.SecretList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v1a.SecretList{}
+	list.APIVersion = v1a.SecretGroupVersionKind.GroupVersion().String()
+	list.Kind = v1a.SecretGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v1.Secret))
+	}
+	return list, nil
+}
+
+func (s *secretClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *secretClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *secretClient) Controller() v1a.SecretController {
+	return &secretController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *secretClient) AddHandler(ctx context.Context, name string, sync v1a.SecretHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *secretClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v1a.SecretHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *secretClient) AddLifecycle(ctx context.Context, name string, lifecycle v1a.SecretLifecycle) {
+	sync := newSecretLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *secretClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v1a.SecretLifecycle) {
+	sync := newSecretLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *secretClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v1a.SecretHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *secretClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v1a.SecretHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *secretClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v1a.SecretLifecycle) {
+	sync := newSecretLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *secretClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v1a.SecretLifecycle) {
+	sync := newSecretLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type secretController struct {
+	controller.GenericController
+}
+
+func (c *secretController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *secretController) Lister() v1a.SecretLister {
+	return &secretLister{
+		controller: c,
+	}
+}
+
+func (c *secretController) AddHandler(ctx context.Context, name string, handler v1a.SecretHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.Secret); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *secretController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v1a.SecretHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.Secret); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *secretController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v1a.SecretHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.Secret); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *secretController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v1a.SecretHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.Secret); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type secretLister struct {
+	controller *secretController
+}
+
+func (l *secretLister) List(namespace string, selector labels.Selector) (ret []*v1.Secret, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v1.Secret))
+	})
+	return
+}
+
+func (l *secretLister) Get(namespace, name string) (*v1.Secret, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v1a.SecretGroupVersionKind.Group,
+			Resource: "secret",
+		}, key)
+	}
+	return obj.(*v1.Secret), nil
+}
+
+type secretLifecycleAdapter struct {
+	lifecycle v1a.SecretLifecycle
+}
+
+func (w *secretLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *secretLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *secretLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v1.Secret))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *secretLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v1.Secret))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *secretLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v1.Secret))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newSecretLifecycleAdapter(name string, clusterScoped bool, client *secretClient, l v1a.SecretLifecycle) v1a.SecretHandlerFunc {
+	adapter := &secretLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v1.Secret) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+
+type configMapClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *configMapClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *configMapClient) Create(o *v1.ConfigMap) (*v1.ConfigMap, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.ConfigMap), nil
+}
+
+func (s *configMapClient) Get(name string, opts metav1.GetOptions) (*v1.ConfigMap, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.ConfigMap), nil
+}
+
+func (s *configMapClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v1.ConfigMap, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.ConfigMap), nil
+}
+
+func (s *configMapClient) Update(o *v1.ConfigMap) (*v1.ConfigMap, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.ConfigMap), nil
+}
+
+func (s *configMapClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *configMapClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *configMapClient) List(opts metav1.ListOptions) (*v1a.ConfigMapList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v1a.ConfigMapList{}
+	list.APIVersion = v1a.ConfigMapGroupVersionKind.GroupVersion().String()
+	list.Kind = v1a.ConfigMapGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v1.ConfigMap))
+	}
+	return list, nil
+}
+
+func (s *configMapClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *configMapClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *configMapClient) Controller() v1a.ConfigMapController {
+	return &configMapController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *configMapClient) AddHandler(ctx context.Context, name string, sync v1a.ConfigMapHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *configMapClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v1a.ConfigMapHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *configMapClient) AddLifecycle(ctx context.Context, name string, lifecycle v1a.ConfigMapLifecycle) {
+	sync := newConfigMapLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *configMapClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v1a.ConfigMapLifecycle) {
+	sync := newConfigMapLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *configMapClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v1a.ConfigMapHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *configMapClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v1a.ConfigMapHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *configMapClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v1a.ConfigMapLifecycle) {
+	sync := newConfigMapLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *configMapClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v1a.ConfigMapLifecycle) {
+	sync := newConfigMapLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type configMapController struct {
+	controller.GenericController
+}
+
+func (c *configMapController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *configMapController) Lister() v1a.ConfigMapLister {
+	return &configMapLister{
+		controller: c,
+	}
+}
+
+func (c *configMapController) AddHandler(ctx context.Context, name string, handler v1a.ConfigMapHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.ConfigMap); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *configMapController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v1a.ConfigMapHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.ConfigMap); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *configMapController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v1a.ConfigMapHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.ConfigMap); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *configMapController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v1a.ConfigMapHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.ConfigMap); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type configMapLister struct {
+	controller *configMapController
+}
+
+func (l *configMapLister) List(namespace string, selector labels.Selector) (ret []*v1.ConfigMap, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v1.ConfigMap))
+	})
+	return
+}
+
+func (l *configMapLister) Get(namespace, name string) (*v1.ConfigMap, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v1a.ConfigMapGroupVersionKind.Group,
+			Resource: "configMap",
+		}, key)
+	}
+	return obj.(*v1.ConfigMap), nil
+}
+
+type configMapLifecycleAdapter struct {
+	lifecycle v1a.ConfigMapLifecycle
+}
+
+func (w *configMapLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *configMapLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *configMapLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v1.ConfigMap))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *configMapLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v1.ConfigMap))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *configMapLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v1.ConfigMap))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newConfigMapLifecycleAdapter(name string, clusterScoped bool, client *configMapClient, l v1a.ConfigMapLifecycle) v1a.ConfigMapHandlerFunc {
+	adapter := &configMapLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v1.ConfigMap) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+
+type serviceAccountClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *serviceAccountClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *serviceAccountClient) Create(o *v1.ServiceAccount) (*v1.ServiceAccount, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.ServiceAccount), nil
+}
+
+func (s *serviceAccountClient) Get(name string, opts metav1.GetOptions) (*v1.ServiceAccount, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.ServiceAccount), nil
+}
+
+func (s *serviceAccountClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v1.ServiceAccount, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.ServiceAccount), nil
+}
+
+func (s *serviceAccountClient) Update(o *v1.ServiceAccount) (*v1.ServiceAccount, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.ServiceAccount), nil
+}
+
+func (s *serviceAccountClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *serviceAccountClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *serviceAccountClient) List(opts metav1.ListOptions) (*v1a.ServiceAccountList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v1a.ServiceAccountList{}
+	list.APIVersion = v1a.ServiceAccountGroupVersionKind.GroupVersion().String()
+	list.Kind = v1a.ServiceAccountGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v1.ServiceAccount))
+	}
+	return list, nil
+}
+
+func (s *serviceAccountClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *serviceAccountClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *serviceAccountClient) Controller() v1a.ServiceAccountController {
+	return &serviceAccountController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *serviceAccountClient) AddHandler(ctx context.Context, name string, sync v1a.ServiceAccountHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *serviceAccountClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v1a.ServiceAccountHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *serviceAccountClient) AddLifecycle(ctx context.Context, name string, lifecycle v1a.ServiceAccountLifecycle) {
+	sync := newServiceAccountLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *serviceAccountClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v1a.ServiceAccountLifecycle) {
+	sync := newServiceAccountLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *serviceAccountClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v1a.ServiceAccountHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *serviceAccountClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v1a.ServiceAccountHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *serviceAccountClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v1a.ServiceAccountLifecycle) {
+	sync := newServiceAccountLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *serviceAccountClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v1a.ServiceAccountLifecycle) {
+	sync := newServiceAccountLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type serviceAccountController struct {
+	controller.GenericController
+}
+
+func (c *serviceAccountController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *serviceAccountController) Lister() v1a.ServiceAccountLister {
+	return &serviceAccountLister{
+		controller: c,
+	}
+}
+
+func (c *serviceAccountController) AddHandler(ctx context.Context, name string, handler v1a.ServiceAccountHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.ServiceAccount); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *serviceAccountController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v1a.ServiceAccountHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.ServiceAccount); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *serviceAccountController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v1a.ServiceAccountHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.ServiceAccount); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *serviceAccountController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v1a.ServiceAccountHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.ServiceAccount); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type serviceAccountLister struct {
+	controller *serviceAccountController
+}
+
+func (l *serviceAccountLister) List(namespace string, selector labels.Selector) (ret []*v1.ServiceAccount, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v1.ServiceAccount))
+	})
+	return
+}
+
+func (l *serviceAccountLister) Get(namespace, name string) (*v1.ServiceAccount, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v1a.ServiceAccountGroupVersionKind.Group,
+			Resource: "serviceAccount",
+		}, key)
+	}
+	return obj.(*v1.ServiceAccount), nil
+}
+
+type serviceAccountLifecycleAdapter struct {
+	lifecycle v1a.ServiceAccountLifecycle
+}
+
+func (w *serviceAccountLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *serviceAccountLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *serviceAccountLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v1.ServiceAccount))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *serviceAccountLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v1.ServiceAccount))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *serviceAccountLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v1.ServiceAccount))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newServiceAccountLifecycleAdapter(name string, clusterScoped bool, client *serviceAccountClient, l v1a.ServiceAccountLifecycle) v1a.ServiceAccountHandlerFunc {
+	adapter := &serviceAccountLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v1.ServiceAccount) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+
+type replicationControllerClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *replicationControllerClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *replicationControllerClient) Create(o *v1.ReplicationController) (*v1.ReplicationController, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.ReplicationController), nil
+}
+
+func (s *replicationControllerClient) Get(name string, opts metav1.GetOptions) (*v1.ReplicationController, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.ReplicationController), nil
+}
+
+func (s *replicationControllerClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v1.ReplicationController, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.ReplicationController), nil
+}
+
+func (s *replicationControllerClient) Update(o *v1.ReplicationController) (*v1.ReplicationController, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.ReplicationController), nil
+}
+
+func (s *replicationControllerClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *replicationControllerClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *replicationControllerClient) List(opts metav1.ListOptions) (*v1a.ReplicationControllerList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v1a.ReplicationControllerList{}
+	list.APIVersion = v1a.ReplicationControllerGroupVersionKind.GroupVersion().String()
+	list.Kind = v1a.ReplicationControllerGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v1.ReplicationController))
+	}
+	return list, nil
+}
+
+func (s *replicationControllerClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *replicationControllerClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *replicationControllerClient) Controller() v1a.ReplicationControllerController {
+	return &replicationControllerController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *replicationControllerClient) AddHandler(ctx context.Context, name string, sync v1a.ReplicationControllerHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *replicationControllerClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v1a.ReplicationControllerHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *replicationControllerClient) AddLifecycle(ctx context.Context, name string, lifecycle v1a.ReplicationControllerLifecycle) {
+	sync := newReplicationControllerLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *replicationControllerClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v1a.ReplicationControllerLifecycle) {
+	sync := newReplicationControllerLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *replicationControllerClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v1a.ReplicationControllerHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *replicationControllerClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v1a.ReplicationControllerHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *replicationControllerClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v1a.ReplicationControllerLifecycle) {
+	sync := newReplicationControllerLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *replicationControllerClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v1a.ReplicationControllerLifecycle) {
+	sync := newReplicationControllerLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type replicationControllerController struct {
+	controller.GenericController
+}
+
+func (c *replicationControllerController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *replicationControllerController) Lister() v1a.ReplicationControllerLister {
+	return &replicationControllerLister{
+		controller: c,
+	}
+}
+
+func (c *replicationControllerController) AddHandler(ctx context.Context, name string, handler v1a.ReplicationControllerHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.ReplicationController); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *replicationControllerController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v1a.ReplicationControllerHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.ReplicationController); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *replicationControllerController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v1a.ReplicationControllerHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.ReplicationController); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *replicationControllerController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v1a.ReplicationControllerHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.ReplicationController); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type replicationControllerLister struct {
+	controller *replicationControllerController
+}
+
+func (l *replicationControllerLister) List(namespace string, selector labels.Selector) (ret []*v1.ReplicationController, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v1.ReplicationController))
+	})
+	return
+}
+
+func (l *replicationControllerLister) Get(namespace, name string) (*v1.ReplicationController, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v1a.ReplicationControllerGroupVersionKind.Group,
+			Resource: "replicationController",
+		}, key)
+	}
+	return obj.(*v1.ReplicationController), nil
+}
+
+type replicationControllerLifecycleAdapter struct {
+	lifecycle v1a.ReplicationControllerLifecycle
+}
+
+func (w *replicationControllerLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *replicationControllerLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *replicationControllerLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v1.ReplicationController))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *replicationControllerLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v1.ReplicationController))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *replicationControllerLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v1.ReplicationController))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newReplicationControllerLifecycleAdapter(name string, clusterScoped bool, client *replicationControllerClient, l v1a.ReplicationControllerLifecycle) v1a.ReplicationControllerHandlerFunc {
+	adapter := &replicationControllerLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v1.ReplicationController) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+
+type resourceQuotaClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *resourceQuotaClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *resourceQuotaClient) Create(o *v1.ResourceQuota) (*v1.ResourceQuota, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.ResourceQuota), nil
+}
+
+func (s *resourceQuotaClient) Get(name string, opts metav1.GetOptions) (*v1.ResourceQuota, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.ResourceQuota), nil
+}
+
+func (s *resourceQuotaClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v1.ResourceQuota, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.ResourceQuota), nil
+}
+
+func (s *resourceQuotaClient) Update(o *v1.ResourceQuota) (*v1.ResourceQuota, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.ResourceQuota), nil
+}
+
+func (s *resourceQuotaClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *resourceQuotaClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *resourceQuotaClient) List(opts metav1.ListOptions) (*v1a.ResourceQuotaList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v1a.ResourceQuotaList{}
+	list.APIVersion = v1a.ResourceQuotaGroupVersionKind.GroupVersion().String()
+	list.Kind = v1a.ResourceQuotaGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v1.ResourceQuota))
+	}
+	return list, nil
+}
+
+func (s *resourceQuotaClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *resourceQuotaClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *resourceQuotaClient) Controller() v1a.ResourceQuotaController {
+	return &resourceQuotaController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *resourceQuotaClient) AddHandler(ctx context.Context, name string, sync v1a.ResourceQuotaHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *resourceQuotaClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v1a.ResourceQuotaHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *resourceQuotaClient) AddLifecycle(ctx context.Context, name string, lifecycle v1a.ResourceQuotaLifecycle) {
+	sync := newResourceQuotaLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *resourceQuotaClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v1a.ResourceQuotaLifecycle) {
+	sync := newResourceQuotaLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *resourceQuotaClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v1a.ResourceQuotaHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *resourceQuotaClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v1a.ResourceQuotaHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *resourceQuotaClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v1a.ResourceQuotaLifecycle) {
+	sync := newResourceQuotaLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *resourceQuotaClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v1a.ResourceQuotaLifecycle) {
+	sync := newResourceQuotaLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type resourceQuotaController struct {
+	controller.GenericController
+}
+
+func (c *resourceQuotaController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *resourceQuotaController) Lister() v1a.ResourceQuotaLister {
+	return &resourceQuotaLister{
+		controller: c,
+	}
+}
+
+func (c *resourceQuotaController) AddHandler(ctx context.Context, name string, handler v1a.ResourceQuotaHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.ResourceQuota); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *resourceQuotaController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v1a.ResourceQuotaHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.ResourceQuota); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *resourceQuotaController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v1a.ResourceQuotaHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.ResourceQuota); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *resourceQuotaController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v1a.ResourceQuotaHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.ResourceQuota); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type resourceQuotaLister struct {
+	controller *resourceQuotaController
+}
+
+func (l *resourceQuotaLister) List(namespace string, selector labels.Selector) (ret []*v1.ResourceQuota, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v1.ResourceQuota))
+	})
+	return
+}
+
+func (l *resourceQuotaLister) Get(namespace, name string) (*v1.ResourceQuota, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v1a.ResourceQuotaGroupVersionKind.Group,
+			Resource: "resourceQuota",
+		}, key)
+	}
+	return obj.(*v1.ResourceQuota), nil
+}
+
+type resourceQuotaLifecycleAdapter struct {
+	lifecycle v1a.ResourceQuotaLifecycle
+}
+
+func (w *resourceQuotaLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *resourceQuotaLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *resourceQuotaLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v1.ResourceQuota))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *resourceQuotaLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v1.ResourceQuota))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *resourceQuotaLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v1.ResourceQuota))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newResourceQuotaLifecycleAdapter(name string, clusterScoped bool, client *resourceQuotaClient, l v1a.ResourceQuotaLifecycle) v1a.ResourceQuotaHandlerFunc {
+	adapter := &resourceQuotaLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v1.ResourceQuota) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+
+type limitRangeClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *limitRangeClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *limitRangeClient) Create(o *v1.LimitRange) (*v1.LimitRange, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.LimitRange), nil
+}
+
+func (s *limitRangeClient) Get(name string, opts metav1.GetOptions) (*v1.LimitRange, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.LimitRange), nil
+}
+
+func (s *limitRangeClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v1.LimitRange, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.LimitRange), nil
+}
+
+func (s *limitRangeClient) Update(o *v1.LimitRange) (*v1.LimitRange, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.LimitRange), nil
+}
+
+func (s *limitRangeClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *limitRangeClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *limitRangeClient) List(opts metav1.ListOptions) (*v1a.LimitRangeList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v1a.LimitRangeList{}
+	list.APIVersion = v1a.LimitRangeGroupVersionKind.GroupVersion().String()
+	list.Kind = v1a.LimitRangeGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v1.LimitRange))
+	}
+	return list, nil
+}
+
+func (s *limitRangeClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *limitRangeClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *limitRangeClient) Controller() v1a.LimitRangeController {
+	return &limitRangeController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *limitRangeClient) AddHandler(ctx context.Context, name string, sync v1a.LimitRangeHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *limitRangeClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v1a.LimitRangeHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *limitRangeClient) AddLifecycle(ctx context.Context, name string, lifecycle v1a.LimitRangeLifecycle) {
+	sync := newLimitRangeLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *limitRangeClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v1a.LimitRangeLifecycle) {
+	sync := newLimitRangeLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *limitRangeClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v1a.LimitRangeHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *limitRangeClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v1a.LimitRangeHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *limitRangeClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v1a.LimitRangeLifecycle) {
+	sync := newLimitRangeLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *limitRangeClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v1a.LimitRangeLifecycle) {
+	sync := newLimitRangeLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type limitRangeController struct {
+	controller.GenericController
+}
+
+func (c *limitRangeController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *limitRangeController) Lister() v1a.LimitRangeLister {
+	return &limitRangeLister{
+		controller: c,
+	}
+}
+
+func (c *limitRangeController) AddHandler(ctx context.Context, name string, handler v1a.LimitRangeHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.LimitRange); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *limitRangeController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v1a.LimitRangeHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.LimitRange); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *limitRangeController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v1a.LimitRangeHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.LimitRange); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *limitRangeController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v1a.LimitRangeHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.LimitRange); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type limitRangeLister struct {
+	controller *limitRangeController
+}
+
+func (l *limitRangeLister) List(namespace string, selector labels.Selector) (ret []*v1.LimitRange, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v1.LimitRange))
+	})
+	return
+}
+
+func (l *limitRangeLister) Get(namespace, name string) (*v1.LimitRange, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v1a.LimitRangeGroupVersionKind.Group,
+			Resource: "limitRange",
+		}, key)
+	}
+	return obj.(*v1.LimitRange), nil
+}
+
+type limitRangeLifecycleAdapter struct {
+	lifecycle v1a.LimitRangeLifecycle
+}
+
+func (w *limitRangeLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *limitRangeLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *limitRangeLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v1.LimitRange))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *limitRangeLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v1.LimitRange))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *limitRangeLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v1.LimitRange))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newLimitRangeLifecycleAdapter(name string, clusterScoped bool, client *limitRangeClient, l v1a.LimitRangeLifecycle) v1a.LimitRangeHandlerFunc {
+	adapter := &limitRangeLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v1.LimitRange) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
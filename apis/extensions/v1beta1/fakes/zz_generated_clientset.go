@@ -0,0 +1,338 @@
+package fakes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rancher/norman/controller"
+	"github.com/rancher/norman/lifecycle"
+	"github.com/rancher/norman/objectclient"
+	v1beta1a "github.com/rancher/types/apis/extensions/v1beta1"
+	"github.com/rancher/types/fakeclient"
+	v1beta1 "k8s.io/api/extensions/v1beta1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
+)
+
+// Clientset is an in-memory v1beta1a.Interface. Its objects are kept in a
+// fakeclient.Tracker, which can be shared with the clientsets of other groups
+// to run their controllers together.
+type Clientset struct {
+	*fakeclient.Tracker
+}
+
+var _ v1beta1a.Interface = &Clientset{}
+
+// NewClientset returns a Clientset with a tracker of its own, holding
+// objects. It panics if an object is not a kind of the group.
+func NewClientset(objects ...runtime.Object) *Clientset {
+	return NewClientsetForTracker(fakeclient.NewTracker(), objects...)
+}
+
+// NewClientsetForTracker returns a Clientset storing its objects in tracker.
+// It panics if an object is not a kind of the group.
+func NewClientsetForTracker(tracker *fakeclient.Tracker, objects ...runtime.Object) *Clientset {
+	c := &Clientset{
+		Tracker: tracker,
+	}
+	if err := c.Add(objects...); err != nil {
+		panic(err)
+	}
+	return c
+}
+
+// RESTClient returns nil, the objects of a Clientset are not served.
+func (c *Clientset) RESTClient() rest.Interface {
+	return nil
+}
+
+// Add stores objects as they are, without the checks of Create.
+func (c *Clientset) Add(objects ...runtime.Object) error {
+	for _, obj := range objects {
+		var resource *fakeclient.Resource
+		switch obj.(type) {
+		case *v1beta1.Ingress:
+			resource = c.Resource(v1beta1a.IngressGroupVersionKind, v1beta1a.IngressResource)
+		default:
+			return fmt.Errorf("%T is not a kind of %s", obj, v1beta1a.GroupName)
+		}
+		if err := resource.Add(obj); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *Clientset) Ingresses(namespace string) v1beta1a.IngressInterface {
+	resource := c.Resource(v1beta1a.IngressGroupVersionKind, v1beta1a.IngressResource)
+	return &ingressClient{
+		client: resource.Client(namespace),
+	}
+}
+
+type ingressClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *ingressClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *ingressClient) Create(o *v1beta1.Ingress) (*v1beta1.Ingress, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.Ingress), nil
+}
+
+func (s *ingressClient) Get(name string, opts metav1.GetOptions) (*v1beta1.Ingress, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.Ingress), nil
+}
+
+func (s *ingressClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v1beta1.Ingress, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.Ingress), nil
+}
+
+func (s *ingressClient) Update(o *v1beta1.Ingress) (*v1beta1.Ingress, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.Ingress), nil
+}
+
+func (s *ingressClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *ingressClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *ingressClient) List(opts metav1.ListOptions) (*v1beta1a.IngressList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v1beta1a.IngressList{}
+	list.APIVersion = v1beta1a.IngressGroupVersionKind.GroupVersion().String()
+	list.Kind = v1beta1a.IngressGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v1beta1.Ingress))
+	}
+	return list, nil
+}
+
+func (s *ingressClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *ingressClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *ingressClient) Controller() v1beta1a.IngressController {
+	return &ingressController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *ingressClient) AddHandler(ctx context.Context, name string, sync v1beta1a.IngressHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *ingressClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v1beta1a.IngressHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *ingressClient) AddLifecycle(ctx context.Context, name string, lifecycle v1beta1a.IngressLifecycle) {
+	sync := newIngressLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *ingressClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v1beta1a.IngressLifecycle) {
+	sync := newIngressLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *ingressClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v1beta1a.IngressHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *ingressClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v1beta1a.IngressHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *ingressClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v1beta1a.IngressLifecycle) {
+	sync := newIngressLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *ingressClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v1beta1a.IngressLifecycle) {
+	sync := newIngressLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type ingressController struct {
+	controller.GenericController
+}
+
+func (c *ingressController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *ingressController) Lister() v1beta1a.IngressLister {
+	return &ingressLister{
+		controller: c,
+	}
+}
+
+func (c *ingressController) AddHandler(ctx context.Context, name string, handler v1beta1a.IngressHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1beta1.Ingress); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *ingressController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v1beta1a.IngressHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1beta1.Ingress); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *ingressController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v1beta1a.IngressHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1beta1.Ingress); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *ingressController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v1beta1a.IngressHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1beta1.Ingress); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type ingressLister struct {
+	controller *ingressController
+}
+
+func (l *ingressLister) List(namespace string, selector labels.Selector) (ret []*v1beta1.Ingress, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v1beta1.Ingress))
+	})
+	return
+}
+
+func (l *ingressLister) Get(namespace, name string) (*v1beta1.Ingress, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v1beta1a.IngressGroupVersionKind.Group,
+			Resource: "ingress",
+		}, key)
+	}
+	return obj.(*v1beta1.Ingress), nil
+}
+
+type ingressLifecycleAdapter struct {
+	lifecycle v1beta1a.IngressLifecycle
+}
+
+func (w *ingressLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *ingressLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *ingressLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v1beta1.Ingress))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *ingressLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v1beta1.Ingress))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *ingressLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v1beta1.Ingress))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newIngressLifecycleAdapter(name string, clusterScoped bool, client *ingressClient, l v1beta1a.IngressLifecycle) v1beta1a.IngressHandlerFunc {
+	adapter := &ingressLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v1beta1.Ingress) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
@@ -0,0 +1,63 @@
+package v3
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestSchemeDefaults(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+
+	cluster := &Cluster{
+		Spec: ClusterSpec{
+			ClusterSpecBase: ClusterSpecBase{
+				RancherKubernetesEngineConfig: &RancherKubernetesEngineConfig{
+					Network: NetworkConfig{
+						Plugin: "flannel",
+					},
+					Services: RKEConfigServices{
+						Etcd: ETCDService{
+							BackupConfig: &BackupConfig{},
+						},
+					},
+				},
+			},
+		},
+	}
+	scheme.Default(cluster)
+
+	// omitempty fields are defaulted like the api does, their zero values
+	// are left out on the wire
+
+	rke := cluster.Spec.RancherKubernetesEngineConfig
+	if rke.Ingress.Provider != "nginx" {
+		t.Errorf("expected ingress provider nginx, got %s", rke.Ingress.Provider)
+	}
+	if rke.Network.Plugin != "flannel" {
+		t.Errorf("expected network plugin to be kept, got %s", rke.Network.Plugin)
+	}
+	if rke.AddonJobTimeout != 30 {
+		t.Errorf("expected addonJobTimeout 30, got %d", rke.AddonJobTimeout)
+	}
+	backup := rke.Services.Etcd.BackupConfig
+	if backup.Enabled == nil || !*backup.Enabled || backup.IntervalHours != 12 || backup.Retention != 6 {
+		t.Errorf("unexpected backup config %+v", backup)
+	}
+
+	notifier := &Notifier{
+		Spec: NotifierSpec{
+			SMTPConfig: &SMTPConfig{TLS: false},
+		},
+	}
+	scheme.Default(notifier)
+	if !notifier.Spec.SMTPConfig.TLS {
+		t.Error("expected tls to be defaulted, false is left out on the wire")
+	}
+	if notifier.Spec.SMTPConfig.Port != 587 {
+		t.Errorf("expected port 587, got %d", notifier.Spec.SMTPConfig.Port)
+	}
+}
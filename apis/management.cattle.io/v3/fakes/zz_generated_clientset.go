@@ -0,0 +1,17077 @@
+package fakes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rancher/norman/controller"
+	"github.com/rancher/norman/lifecycle"
+	"github.com/rancher/norman/objectclient"
+	v3 "github.com/rancher/types/apis/management.cattle.io/v3"
+	"github.com/rancher/types/fakeclient"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
+)
+
+// Clientset is an in-memory v3.Interface. Its objects are kept in a
+// fakeclient.Tracker, which can be shared with the clientsets of other groups
+// to run their controllers together.
+type Clientset struct {
+	*fakeclient.Tracker
+}
+
+var _ v3.Interface = &Clientset{}
+
+// NewClientset returns a Clientset with a tracker of its own, holding
+// objects. It panics if an object is not a kind of the group.
+func NewClientset(objects ...runtime.Object) *Clientset {
+	return NewClientsetForTracker(fakeclient.NewTracker(), objects...)
+}
+
+// NewClientsetForTracker returns a Clientset storing its objects in tracker.
+// It panics if an object is not a kind of the group.
+func NewClientsetForTracker(tracker *fakeclient.Tracker, objects ...runtime.Object) *Clientset {
+	c := &Clientset{
+		Tracker: tracker,
+	}
+	if err := c.Add(objects...); err != nil {
+		panic(err)
+	}
+	return c
+}
+
+// RESTClient returns nil, the objects of a Clientset are not served.
+func (c *Clientset) RESTClient() rest.Interface {
+	return nil
+}
+
+// Add stores objects as they are, without the checks of Create.
+func (c *Clientset) Add(objects ...runtime.Object) error {
+	for _, obj := range objects {
+		var resource *fakeclient.Resource
+		switch obj.(type) {
+		case *v3.NodePool:
+			resource = c.Resource(v3.NodePoolGroupVersionKind, v3.NodePoolResource)
+		case *v3.Node:
+			resource = c.Resource(v3.NodeGroupVersionKind, v3.NodeResource)
+		case *v3.NodeDriver:
+			resource = c.Resource(v3.NodeDriverGroupVersionKind, v3.NodeDriverResource)
+		case *v3.NodeTemplate:
+			resource = c.Resource(v3.NodeTemplateGroupVersionKind, v3.NodeTemplateResource)
+		case *v3.Project:
+			resource = c.Resource(v3.ProjectGroupVersionKind, v3.ProjectResource)
+		case *v3.GlobalRole:
+			resource = c.Resource(v3.GlobalRoleGroupVersionKind, v3.GlobalRoleResource)
+		case *v3.GlobalRoleBinding:
+			resource = c.Resource(v3.GlobalRoleBindingGroupVersionKind, v3.GlobalRoleBindingResource)
+		case *v3.RoleTemplate:
+			resource = c.Resource(v3.RoleTemplateGroupVersionKind, v3.RoleTemplateResource)
+		case *v3.PodSecurityPolicyTemplate:
+			resource = c.Resource(v3.PodSecurityPolicyTemplateGroupVersionKind, v3.PodSecurityPolicyTemplateResource)
+		case *v3.PodSecurityPolicyTemplateProjectBinding:
+			resource = c.Resource(v3.PodSecurityPolicyTemplateProjectBindingGroupVersionKind, v3.PodSecurityPolicyTemplateProjectBindingResource)
+		case *v3.ClusterRoleTemplateBinding:
+			resource = c.Resource(v3.ClusterRoleTemplateBindingGroupVersionKind, v3.ClusterRoleTemplateBindingResource)
+		case *v3.ProjectRoleTemplateBinding:
+			resource = c.Resource(v3.ProjectRoleTemplateBindingGroupVersionKind, v3.ProjectRoleTemplateBindingResource)
+		case *v3.Cluster:
+			resource = c.Resource(v3.ClusterGroupVersionKind, v3.ClusterResource)
+		case *v3.ClusterRegistrationToken:
+			resource = c.Resource(v3.ClusterRegistrationTokenGroupVersionKind, v3.ClusterRegistrationTokenResource)
+		case *v3.Catalog:
+			resource = c.Resource(v3.CatalogGroupVersionKind, v3.CatalogResource)
+		case *v3.Template:
+			resource = c.Resource(v3.TemplateGroupVersionKind, v3.TemplateResource)
+		case *v3.CatalogTemplate:
+			resource = c.Resource(v3.CatalogTemplateGroupVersionKind, v3.CatalogTemplateResource)
+		case *v3.CatalogTemplateVersion:
+			resource = c.Resource(v3.CatalogTemplateVersionGroupVersionKind, v3.CatalogTemplateVersionResource)
+		case *v3.TemplateVersion:
+			resource = c.Resource(v3.TemplateVersionGroupVersionKind, v3.TemplateVersionResource)
+		case *v3.TemplateContent:
+			resource = c.Resource(v3.TemplateContentGroupVersionKind, v3.TemplateContentResource)
+		case *v3.Group:
+			resource = c.Resource(v3.GroupGroupVersionKind, v3.GroupResource)
+		case *v3.GroupMember:
+			resource = c.Resource(v3.GroupMemberGroupVersionKind, v3.GroupMemberResource)
+		case *v3.Principal:
+			resource = c.Resource(v3.PrincipalGroupVersionKind, v3.PrincipalResource)
+		case *v3.User:
+			resource = c.Resource(v3.UserGroupVersionKind, v3.UserResource)
+		case *v3.AuthConfig:
+			resource = c.Resource(v3.AuthConfigGroupVersionKind, v3.AuthConfigResource)
+		case *v3.LdapConfig:
+			resource = c.Resource(v3.LdapConfigGroupVersionKind, v3.LdapConfigResource)
+		case *v3.Token:
+			resource = c.Resource(v3.TokenGroupVersionKind, v3.TokenResource)
+		case *v3.DynamicSchema:
+			resource = c.Resource(v3.DynamicSchemaGroupVersionKind, v3.DynamicSchemaResource)
+		case *v3.Preference:
+			resource = c.Resource(v3.PreferenceGroupVersionKind, v3.PreferenceResource)
+		case *v3.UserAttribute:
+			resource = c.Resource(v3.UserAttributeGroupVersionKind, v3.UserAttributeResource)
+		case *v3.ProjectNetworkPolicy:
+			resource = c.Resource(v3.ProjectNetworkPolicyGroupVersionKind, v3.ProjectNetworkPolicyResource)
+		case *v3.ClusterLogging:
+			resource = c.Resource(v3.ClusterLoggingGroupVersionKind, v3.ClusterLoggingResource)
+		case *v3.ProjectLogging:
+			resource = c.Resource(v3.ProjectLoggingGroupVersionKind, v3.ProjectLoggingResource)
+		case *v3.ListenConfig:
+			resource = c.Resource(v3.ListenConfigGroupVersionKind, v3.ListenConfigResource)
+		case *v3.Setting:
+			resource = c.Resource(v3.SettingGroupVersionKind, v3.SettingResource)
+		case *v3.Feature:
+			resource = c.Resource(v3.FeatureGroupVersionKind, v3.FeatureResource)
+		case *v3.ClusterAlert:
+			resource = c.Resource(v3.ClusterAlertGroupVersionKind, v3.ClusterAlertResource)
+		case *v3.ProjectAlert:
+			resource = c.Resource(v3.ProjectAlertGroupVersionKind, v3.ProjectAlertResource)
+		case *v3.Notifier:
+			resource = c.Resource(v3.NotifierGroupVersionKind, v3.NotifierResource)
+		case *v3.ClusterAlertGroup:
+			resource = c.Resource(v3.ClusterAlertGroupGroupVersionKind, v3.ClusterAlertGroupResource)
+		case *v3.ProjectAlertGroup:
+			resource = c.Resource(v3.ProjectAlertGroupGroupVersionKind, v3.ProjectAlertGroupResource)
+		case *v3.ClusterAlertRule:
+			resource = c.Resource(v3.ClusterAlertRuleGroupVersionKind, v3.ClusterAlertRuleResource)
+		case *v3.ProjectAlertRule:
+			resource = c.Resource(v3.ProjectAlertRuleGroupVersionKind, v3.ProjectAlertRuleResource)
+		case *v3.ComposeConfig:
+			resource = c.Resource(v3.ComposeConfigGroupVersionKind, v3.ComposeConfigResource)
+		case *v3.ProjectCatalog:
+			resource = c.Resource(v3.ProjectCatalogGroupVersionKind, v3.ProjectCatalogResource)
+		case *v3.ClusterCatalog:
+			resource = c.Resource(v3.ClusterCatalogGroupVersionKind, v3.ClusterCatalogResource)
+		case *v3.MultiClusterApp:
+			resource = c.Resource(v3.MultiClusterAppGroupVersionKind, v3.MultiClusterAppResource)
+		case *v3.MultiClusterAppRevision:
+			resource = c.Resource(v3.MultiClusterAppRevisionGroupVersionKind, v3.MultiClusterAppRevisionResource)
+		case *v3.GlobalDNS:
+			resource = c.Resource(v3.GlobalDNSGroupVersionKind, v3.GlobalDNSResource)
+		case *v3.GlobalDNSProvider:
+			resource = c.Resource(v3.GlobalDNSProviderGroupVersionKind, v3.GlobalDNSProviderResource)
+		case *v3.KontainerDriver:
+			resource = c.Resource(v3.KontainerDriverGroupVersionKind, v3.KontainerDriverResource)
+		case *v3.EtcdBackup:
+			resource = c.Resource(v3.EtcdBackupGroupVersionKind, v3.EtcdBackupResource)
+		case *v3.ClusterScan:
+			resource = c.Resource(v3.ClusterScanGroupVersionKind, v3.ClusterScanResource)
+		case *v3.MonitorMetric:
+			resource = c.Resource(v3.MonitorMetricGroupVersionKind, v3.MonitorMetricResource)
+		case *v3.ClusterMonitorGraph:
+			resource = c.Resource(v3.ClusterMonitorGraphGroupVersionKind, v3.ClusterMonitorGraphResource)
+		case *v3.ProjectMonitorGraph:
+			resource = c.Resource(v3.ProjectMonitorGraphGroupVersionKind, v3.ProjectMonitorGraphResource)
+		case *v3.CloudCredential:
+			resource = c.Resource(v3.CloudCredentialGroupVersionKind, v3.CloudCredentialResource)
+		case *v3.ClusterTemplate:
+			resource = c.Resource(v3.ClusterTemplateGroupVersionKind, v3.ClusterTemplateResource)
+		case *v3.ClusterTemplateRevision:
+			resource = c.Resource(v3.ClusterTemplateRevisionGroupVersionKind, v3.ClusterTemplateRevisionResource)
+		case *v3.RKEK8sSystemImage:
+			resource = c.Resource(v3.RKEK8sSystemImageGroupVersionKind, v3.RKEK8sSystemImageResource)
+		case *v3.RKEK8sServiceOption:
+			resource = c.Resource(v3.RKEK8sServiceOptionGroupVersionKind, v3.RKEK8sServiceOptionResource)
+		case *v3.RKEAddon:
+			resource = c.Resource(v3.RKEAddonGroupVersionKind, v3.RKEAddonResource)
+		case *v3.Example:
+			resource = c.Resource(v3.ExampleGroupVersionKind, v3.ExampleResource)
+		default:
+			return fmt.Errorf("%T is not a kind of %s", obj, v3.GroupName)
+		}
+		if err := resource.Add(obj); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *Clientset) NodePools(namespace string) v3.NodePoolInterface {
+	resource := c.Resource(v3.NodePoolGroupVersionKind, v3.NodePoolResource)
+	return &nodePoolClient{
+		client: resource.Client(namespace),
+	}
+}
+
+func (c *Clientset) Nodes(namespace string) v3.NodeInterface {
+	resource := c.Resource(v3.NodeGroupVersionKind, v3.NodeResource)
+	return &nodeClient{
+		client: resource.Client(namespace),
+	}
+}
+
+func (c *Clientset) NodeDrivers(namespace string) v3.NodeDriverInterface {
+	resource := c.Resource(v3.NodeDriverGroupVersionKind, v3.NodeDriverResource)
+	return &nodeDriverClient{
+		client: resource.Client(namespace),
+	}
+}
+
+func (c *Clientset) NodeTemplates(namespace string) v3.NodeTemplateInterface {
+	resource := c.Resource(v3.NodeTemplateGroupVersionKind, v3.NodeTemplateResource)
+	return &nodeTemplateClient{
+		client: resource.Client(namespace),
+	}
+}
+
+func (c *Clientset) Projects(namespace string) v3.ProjectInterface {
+	resource := c.Resource(v3.ProjectGroupVersionKind, v3.ProjectResource)
+	return &projectClient{
+		client: resource.Client(namespace),
+	}
+}
+
+func (c *Clientset) GlobalRoles(namespace string) v3.GlobalRoleInterface {
+	resource := c.Resource(v3.GlobalRoleGroupVersionKind, v3.GlobalRoleResource)
+	return &globalRoleClient{
+		client: resource.Client(namespace),
+	}
+}
+
+func (c *Clientset) GlobalRoleBindings(namespace string) v3.GlobalRoleBindingInterface {
+	resource := c.Resource(v3.GlobalRoleBindingGroupVersionKind, v3.GlobalRoleBindingResource)
+	return &globalRoleBindingClient{
+		client: resource.Client(namespace),
+	}
+}
+
+func (c *Clientset) RoleTemplates(namespace string) v3.RoleTemplateInterface {
+	resource := c.Resource(v3.RoleTemplateGroupVersionKind, v3.RoleTemplateResource)
+	return &roleTemplateClient{
+		client: resource.Client(namespace),
+	}
+}
+
+func (c *Clientset) PodSecurityPolicyTemplates(namespace string) v3.PodSecurityPolicyTemplateInterface {
+	resource := c.Resource(v3.PodSecurityPolicyTemplateGroupVersionKind, v3.PodSecurityPolicyTemplateResource)
+	return &podSecurityPolicyTemplateClient{
+		client: resource.Client(namespace),
+	}
+}
+
+func (c *Clientset) PodSecurityPolicyTemplateProjectBindings(namespace string) v3.PodSecurityPolicyTemplateProjectBindingInterface {
+	resource := c.Resource(v3.PodSecurityPolicyTemplateProjectBindingGroupVersionKind, v3.PodSecurityPolicyTemplateProjectBindingResource)
+	return &podSecurityPolicyTemplateProjectBindingClient{
+		client: resource.Client(namespace),
+	}
+}
+
+func (c *Clientset) ClusterRoleTemplateBindings(namespace string) v3.ClusterRoleTemplateBindingInterface {
+	resource := c.Resource(v3.ClusterRoleTemplateBindingGroupVersionKind, v3.ClusterRoleTemplateBindingResource)
+	return &clusterRoleTemplateBindingClient{
+		client: resource.Client(namespace),
+	}
+}
+
+func (c *Clientset) ProjectRoleTemplateBindings(namespace string) v3.ProjectRoleTemplateBindingInterface {
+	resource := c.Resource(v3.ProjectRoleTemplateBindingGroupVersionKind, v3.ProjectRoleTemplateBindingResource)
+	return &projectRoleTemplateBindingClient{
+		client: resource.Client(namespace),
+	}
+}
+
+func (c *Clientset) Clusters(namespace string) v3.ClusterInterface {
+	resource := c.Resource(v3.ClusterGroupVersionKind, v3.ClusterResource)
+	return &clusterClient{
+		client: resource.Client(namespace),
+	}
+}
+
+func (c *Clientset) ClusterRegistrationTokens(namespace string) v3.ClusterRegistrationTokenInterface {
+	resource := c.Resource(v3.ClusterRegistrationTokenGroupVersionKind, v3.ClusterRegistrationTokenResource)
+	return &clusterRegistrationTokenClient{
+		client: resource.Client(namespace),
+	}
+}
+
+func (c *Clientset) Catalogs(namespace string) v3.CatalogInterface {
+	resource := c.Resource(v3.CatalogGroupVersionKind, v3.CatalogResource)
+	return &catalogClient{
+		client: resource.Client(namespace),
+	}
+}
+
+func (c *Clientset) Templates(namespace string) v3.TemplateInterface {
+	resource := c.Resource(v3.TemplateGroupVersionKind, v3.TemplateResource)
+	return &templateClient{
+		client: resource.Client(namespace),
+	}
+}
+
+func (c *Clientset) CatalogTemplates(namespace string) v3.CatalogTemplateInterface {
+	resource := c.Resource(v3.CatalogTemplateGroupVersionKind, v3.CatalogTemplateResource)
+	return &catalogTemplateClient{
+		client: resource.Client(namespace),
+	}
+}
+
+func (c *Clientset) CatalogTemplateVersions(namespace string) v3.CatalogTemplateVersionInterface {
+	resource := c.Resource(v3.CatalogTemplateVersionGroupVersionKind, v3.CatalogTemplateVersionResource)
+	return &catalogTemplateVersionClient{
+		client: resource.Client(namespace),
+	}
+}
+
+func (c *Clientset) TemplateVersions(namespace string) v3.TemplateVersionInterface {
+	resource := c.Resource(v3.TemplateVersionGroupVersionKind, v3.TemplateVersionResource)
+	return &templateVersionClient{
+		client: resource.Client(namespace),
+	}
+}
+
+func (c *Clientset) TemplateContents(namespace string) v3.TemplateContentInterface {
+	resource := c.Resource(v3.TemplateContentGroupVersionKind, v3.TemplateContentResource)
+	return &templateContentClient{
+		client: resource.Client(namespace),
+	}
+}
+
+func (c *Clientset) Groups(namespace string) v3.GroupInterface {
+	resource := c.Resource(v3.GroupGroupVersionKind, v3.GroupResource)
+	return &groupClient{
+		client: resource.Client(namespace),
+	}
+}
+
+func (c *Clientset) GroupMembers(namespace string) v3.GroupMemberInterface {
+	resource := c.Resource(v3.GroupMemberGroupVersionKind, v3.GroupMemberResource)
+	return &groupMemberClient{
+		client: resource.Client(namespace),
+	}
+}
+
+func (c *Clientset) Principals(namespace string) v3.PrincipalInterface {
+	resource := c.Resource(v3.PrincipalGroupVersionKind, v3.PrincipalResource)
+	return &principalClient{
+		client: resource.Client(namespace),
+	}
+}
+
+func (c *Clientset) Users(namespace string) v3.UserInterface {
+	resource := c.Resource(v3.UserGroupVersionKind, v3.UserResource)
+	return &userClient{
+		client: resource.Client(namespace),
+	}
+}
+
+func (c *Clientset) AuthConfigs(namespace string) v3.AuthConfigInterface {
+	resource := c.Resource(v3.AuthConfigGroupVersionKind, v3.AuthConfigResource)
+	return &authConfigClient{
+		client: resource.Client(namespace),
+	}
+}
+
+func (c *Clientset) LdapConfigs(namespace string) v3.LdapConfigInterface {
+	resource := c.Resource(v3.LdapConfigGroupVersionKind, v3.LdapConfigResource)
+	return &ldapConfigClient{
+		client: resource.Client(namespace),
+	}
+}
+
+func (c *Clientset) Tokens(namespace string) v3.TokenInterface {
+	resource := c.Resource(v3.TokenGroupVersionKind, v3.TokenResource)
+	return &tokenClient{
+		client: resource.Client(namespace),
+	}
+}
+
+func (c *Clientset) DynamicSchemas(namespace string) v3.DynamicSchemaInterface {
+	resource := c.Resource(v3.DynamicSchemaGroupVersionKind, v3.DynamicSchemaResource)
+	return &dynamicSchemaClient{
+		client: resource.Client(namespace),
+	}
+}
+
+func (c *Clientset) Preferences(namespace string) v3.PreferenceInterface {
+	resource := c.Resource(v3.PreferenceGroupVersionKind, v3.PreferenceResource)
+	return &preferenceClient{
+		client: resource.Client(namespace),
+	}
+}
+
+func (c *Clientset) UserAttributes(namespace string) v3.UserAttributeInterface {
+	resource := c.Resource(v3.UserAttributeGroupVersionKind, v3.UserAttributeResource)
+	return &userAttributeClient{
+		client: resource.Client(namespace),
+	}
+}
+
+func (c *Clientset) ProjectNetworkPolicies(namespace string) v3.ProjectNetworkPolicyInterface {
+	resource := c.Resource(v3.ProjectNetworkPolicyGroupVersionKind, v3.ProjectNetworkPolicyResource)
+	return &projectNetworkPolicyClient{
+		client: resource.Client(namespace),
+	}
+}
+
+func (c *Clientset) ClusterLoggings(namespace string) v3.ClusterLoggingInterface {
+	resource := c.Resource(v3.ClusterLoggingGroupVersionKind, v3.ClusterLoggingResource)
+	return &clusterLoggingClient{
+		client: resource.Client(namespace),
+	}
+}
+
+func (c *Clientset) ProjectLoggings(namespace string) v3.ProjectLoggingInterface {
+	resource := c.Resource(v3.ProjectLoggingGroupVersionKind, v3.ProjectLoggingResource)
+	return &projectLoggingClient{
+		client: resource.Client(namespace),
+	}
+}
+
+func (c *Clientset) ListenConfigs(namespace string) v3.ListenConfigInterface {
+	resource := c.Resource(v3.ListenConfigGroupVersionKind, v3.ListenConfigResource)
+	return &listenConfigClient{
+		client: resource.Client(namespace),
+	}
+}
+
+func (c *Clientset) Settings(namespace string) v3.SettingInterface {
+	resource := c.Resource(v3.SettingGroupVersionKind, v3.SettingResource)
+	return &settingClient{
+		client: resource.Client(namespace),
+	}
+}
+
+func (c *Clientset) Features(namespace string) v3.FeatureInterface {
+	resource := c.Resource(v3.FeatureGroupVersionKind, v3.FeatureResource)
+	return &featureClient{
+		client: resource.Client(namespace),
+	}
+}
+
+func (c *Clientset) ClusterAlerts(namespace string) v3.ClusterAlertInterface {
+	resource := c.Resource(v3.ClusterAlertGroupVersionKind, v3.ClusterAlertResource)
+	return &clusterAlertClient{
+		client: resource.Client(namespace),
+	}
+}
+
+func (c *Clientset) ProjectAlerts(namespace string) v3.ProjectAlertInterface {
+	resource := c.Resource(v3.ProjectAlertGroupVersionKind, v3.ProjectAlertResource)
+	return &projectAlertClient{
+		client: resource.Client(namespace),
+	}
+}
+
+func (c *Clientset) Notifiers(namespace string) v3.NotifierInterface {
+	resource := c.Resource(v3.NotifierGroupVersionKind, v3.NotifierResource)
+	return &notifierClient{
+		client: resource.Client(namespace),
+	}
+}
+
+func (c *Clientset) ClusterAlertGroups(namespace string) v3.ClusterAlertGroupInterface {
+	resource := c.Resource(v3.ClusterAlertGroupGroupVersionKind, v3.ClusterAlertGroupResource)
+	return &clusterAlertGroupClient{
+		client: resource.Client(namespace),
+	}
+}
+
+func (c *Clientset) ProjectAlertGroups(namespace string) v3.ProjectAlertGroupInterface {
+	resource := c.Resource(v3.ProjectAlertGroupGroupVersionKind, v3.ProjectAlertGroupResource)
+	return &projectAlertGroupClient{
+		client: resource.Client(namespace),
+	}
+}
+
+func (c *Clientset) ClusterAlertRules(namespace string) v3.ClusterAlertRuleInterface {
+	resource := c.Resource(v3.ClusterAlertRuleGroupVersionKind, v3.ClusterAlertRuleResource)
+	return &clusterAlertRuleClient{
+		client: resource.Client(namespace),
+	}
+}
+
+func (c *Clientset) ProjectAlertRules(namespace string) v3.ProjectAlertRuleInterface {
+	resource := c.Resource(v3.ProjectAlertRuleGroupVersionKind, v3.ProjectAlertRuleResource)
+	return &projectAlertRuleClient{
+		client: resource.Client(namespace),
+	}
+}
+
+func (c *Clientset) ComposeConfigs(namespace string) v3.ComposeConfigInterface {
+	resource := c.Resource(v3.ComposeConfigGroupVersionKind, v3.ComposeConfigResource)
+	return &composeConfigClient{
+		client: resource.Client(namespace),
+	}
+}
+
+func (c *Clientset) ProjectCatalogs(namespace string) v3.ProjectCatalogInterface {
+	resource := c.Resource(v3.ProjectCatalogGroupVersionKind, v3.ProjectCatalogResource)
+	return &projectCatalogClient{
+		client: resource.Client(namespace),
+	}
+}
+
+func (c *Clientset) ClusterCatalogs(namespace string) v3.ClusterCatalogInterface {
+	resource := c.Resource(v3.ClusterCatalogGroupVersionKind, v3.ClusterCatalogResource)
+	return &clusterCatalogClient{
+		client: resource.Client(namespace),
+	}
+}
+
+func (c *Clientset) MultiClusterApps(namespace string) v3.MultiClusterAppInterface {
+	resource := c.Resource(v3.MultiClusterAppGroupVersionKind, v3.MultiClusterAppResource)
+	return &multiClusterAppClient{
+		client: resource.Client(namespace),
+	}
+}
+
+func (c *Clientset) MultiClusterAppRevisions(namespace string) v3.MultiClusterAppRevisionInterface {
+	resource := c.Resource(v3.MultiClusterAppRevisionGroupVersionKind, v3.MultiClusterAppRevisionResource)
+	return &multiClusterAppRevisionClient{
+		client: resource.Client(namespace),
+	}
+}
+
+func (c *Clientset) GlobalDNSs(namespace string) v3.GlobalDNSInterface {
+	resource := c.Resource(v3.GlobalDNSGroupVersionKind, v3.GlobalDNSResource)
+	return &globalDnsClient{
+		client: resource.Client(namespace),
+	}
+}
+
+func (c *Clientset) GlobalDNSProviders(namespace string) v3.GlobalDNSProviderInterface {
+	resource := c.Resource(v3.GlobalDNSProviderGroupVersionKind, v3.GlobalDNSProviderResource)
+	return &globalDnsProviderClient{
+		client: resource.Client(namespace),
+	}
+}
+
+func (c *Clientset) KontainerDrivers(namespace string) v3.KontainerDriverInterface {
+	resource := c.Resource(v3.KontainerDriverGroupVersionKind, v3.KontainerDriverResource)
+	return &kontainerDriverClient{
+		client: resource.Client(namespace),
+	}
+}
+
+func (c *Clientset) EtcdBackups(namespace string) v3.EtcdBackupInterface {
+	resource := c.Resource(v3.EtcdBackupGroupVersionKind, v3.EtcdBackupResource)
+	return &etcdBackupClient{
+		client: resource.Client(namespace),
+	}
+}
+
+func (c *Clientset) ClusterScans(namespace string) v3.ClusterScanInterface {
+	resource := c.Resource(v3.ClusterScanGroupVersionKind, v3.ClusterScanResource)
+	return &clusterScanClient{
+		client: resource.Client(namespace),
+	}
+}
+
+func (c *Clientset) MonitorMetrics(namespace string) v3.MonitorMetricInterface {
+	resource := c.Resource(v3.MonitorMetricGroupVersionKind, v3.MonitorMetricResource)
+	return &monitorMetricClient{
+		client: resource.Client(namespace),
+	}
+}
+
+func (c *Clientset) ClusterMonitorGraphs(namespace string) v3.ClusterMonitorGraphInterface {
+	resource := c.Resource(v3.ClusterMonitorGraphGroupVersionKind, v3.ClusterMonitorGraphResource)
+	return &clusterMonitorGraphClient{
+		client: resource.Client(namespace),
+	}
+}
+
+func (c *Clientset) ProjectMonitorGraphs(namespace string) v3.ProjectMonitorGraphInterface {
+	resource := c.Resource(v3.ProjectMonitorGraphGroupVersionKind, v3.ProjectMonitorGraphResource)
+	return &projectMonitorGraphClient{
+		client: resource.Client(namespace),
+	}
+}
+
+func (c *Clientset) CloudCredentials(namespace string) v3.CloudCredentialInterface {
+	resource := c.Resource(v3.CloudCredentialGroupVersionKind, v3.CloudCredentialResource)
+	return &cloudCredentialClient{
+		client: resource.Client(namespace),
+	}
+}
+
+func (c *Clientset) ClusterTemplates(namespace string) v3.ClusterTemplateInterface {
+	resource := c.Resource(v3.ClusterTemplateGroupVersionKind, v3.ClusterTemplateResource)
+	return &clusterTemplateClient{
+		client: resource.Client(namespace),
+	}
+}
+
+func (c *Clientset) ClusterTemplateRevisions(namespace string) v3.ClusterTemplateRevisionInterface {
+	resource := c.Resource(v3.ClusterTemplateRevisionGroupVersionKind, v3.ClusterTemplateRevisionResource)
+	return &clusterTemplateRevisionClient{
+		client: resource.Client(namespace),
+	}
+}
+
+func (c *Clientset) RKEK8sSystemImages(namespace string) v3.RKEK8sSystemImageInterface {
+	resource := c.Resource(v3.RKEK8sSystemImageGroupVersionKind, v3.RKEK8sSystemImageResource)
+	return &rkeK8sSystemImageClient{
+		client: resource.Client(namespace),
+	}
+}
+
+func (c *Clientset) RKEK8sServiceOptions(namespace string) v3.RKEK8sServiceOptionInterface {
+	resource := c.Resource(v3.RKEK8sServiceOptionGroupVersionKind, v3.RKEK8sServiceOptionResource)
+	return &rkeK8sServiceOptionClient{
+		client: resource.Client(namespace),
+	}
+}
+
+func (c *Clientset) RKEAddons(namespace string) v3.RKEAddonInterface {
+	resource := c.Resource(v3.RKEAddonGroupVersionKind, v3.RKEAddonResource)
+	return &rkeAddonClient{
+		client: resource.Client(namespace),
+	}
+}
+
+func (c *Clientset) Examples(namespace string) v3.ExampleInterface {
+	resource := c.Resource(v3.ExampleGroupVersionKind, v3.ExampleResource)
+	return &exampleClient{
+		client: resource.Client(namespace),
+	}
+}
+
+type nodePoolClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *nodePoolClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *nodePoolClient) Create(o *v3.NodePool) (*v3.NodePool, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.NodePool), nil
+}
+
+func (s *nodePoolClient) Get(name string, opts metav1.GetOptions) (*v3.NodePool, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.NodePool), nil
+}
+
+func (s *nodePoolClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.NodePool, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.NodePool), nil
+}
+
+func (s *nodePoolClient) Update(o *v3.NodePool) (*v3.NodePool, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.NodePool), nil
+}
+
+func (s *nodePoolClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *nodePoolClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *nodePoolClient) List(opts metav1.ListOptions) (*v3.NodePoolList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v3.NodePoolList{}
+	list.APIVersion = v3.NodePoolGroupVersionKind.GroupVersion().String()
+	list.Kind = v3.NodePoolGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v3.NodePool))
+	}
+	return list, nil
+}
+
+func (s *nodePoolClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *nodePoolClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *nodePoolClient) Controller() v3.NodePoolController {
+	return &nodePoolController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *nodePoolClient) AddHandler(ctx context.Context, name string, sync v3.NodePoolHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *nodePoolClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v3.NodePoolHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *nodePoolClient) AddLifecycle(ctx context.Context, name string, lifecycle v3.NodePoolLifecycle) {
+	sync := newNodePoolLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *nodePoolClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v3.NodePoolLifecycle) {
+	sync := newNodePoolLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *nodePoolClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v3.NodePoolHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *nodePoolClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v3.NodePoolHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *nodePoolClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v3.NodePoolLifecycle) {
+	sync := newNodePoolLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *nodePoolClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v3.NodePoolLifecycle) {
+	sync := newNodePoolLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type nodePoolController struct {
+	controller.GenericController
+}
+
+func (c *nodePoolController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *nodePoolController) Lister() v3.NodePoolLister {
+	return &nodePoolLister{
+		controller: c,
+	}
+}
+
+func (c *nodePoolController) AddHandler(ctx context.Context, name string, handler v3.NodePoolHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.NodePool); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *nodePoolController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v3.NodePoolHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.NodePool); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *nodePoolController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v3.NodePoolHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.NodePool); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *nodePoolController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v3.NodePoolHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.NodePool); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type nodePoolLister struct {
+	controller *nodePoolController
+}
+
+func (l *nodePoolLister) List(namespace string, selector labels.Selector) (ret []*v3.NodePool, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v3.NodePool))
+	})
+	return
+}
+
+func (l *nodePoolLister) Get(namespace, name string) (*v3.NodePool, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v3.NodePoolGroupVersionKind.Group,
+			Resource: "nodePool",
+		}, key)
+	}
+	return obj.(*v3.NodePool), nil
+}
+
+type nodePoolLifecycleAdapter struct {
+	lifecycle v3.NodePoolLifecycle
+}
+
+func (w *nodePoolLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *nodePoolLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *nodePoolLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v3.NodePool))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *nodePoolLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v3.NodePool))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *nodePoolLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v3.NodePool))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newNodePoolLifecycleAdapter(name string, clusterScoped bool, client *nodePoolClient, l v3.NodePoolLifecycle) v3.NodePoolHandlerFunc {
+	adapter := &nodePoolLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v3.NodePool) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+
+type nodeClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *nodeClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *nodeClient) Create(o *v3.Node) (*v3.Node, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.Node), nil
+}
+
+func (s *nodeClient) Get(name string, opts metav1.GetOptions) (*v3.Node, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.Node), nil
+}
+
+func (s *nodeClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.Node, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.Node), nil
+}
+
+func (s *nodeClient) Update(o *v3.Node) (*v3.Node, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.Node), nil
+}
+
+func (s *nodeClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *nodeClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *nodeClient) List(opts metav1.ListOptions) (*v3.NodeList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v3.NodeList{}
+	list.APIVersion = v3.NodeGroupVersionKind.GroupVersion().String()
+	list.Kind = v3.NodeGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v3.Node))
+	}
+	return list, nil
+}
+
+func (s *nodeClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *nodeClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *nodeClient) Controller() v3.NodeController {
+	return &nodeController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *nodeClient) AddHandler(ctx context.Context, name string, sync v3.NodeHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *nodeClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v3.NodeHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *nodeClient) AddLifecycle(ctx context.Context, name string, lifecycle v3.NodeLifecycle) {
+	sync := newNodeLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *nodeClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v3.NodeLifecycle) {
+	sync := newNodeLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *nodeClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v3.NodeHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *nodeClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v3.NodeHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *nodeClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v3.NodeLifecycle) {
+	sync := newNodeLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *nodeClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v3.NodeLifecycle) {
+	sync := newNodeLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type nodeController struct {
+	controller.GenericController
+}
+
+func (c *nodeController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *nodeController) Lister() v3.NodeLister {
+	return &nodeLister{
+		controller: c,
+	}
+}
+
+func (c *nodeController) AddHandler(ctx context.Context, name string, handler v3.NodeHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.Node); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *nodeController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v3.NodeHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.Node); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *nodeController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v3.NodeHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.Node); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *nodeController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v3.NodeHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.Node); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type nodeLister struct {
+	controller *nodeController
+}
+
+func (l *nodeLister) List(namespace string, selector labels.Selector) (ret []*v3.Node, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v3.Node))
+	})
+	return
+}
+
+func (l *nodeLister) Get(namespace, name string) (*v3.Node, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v3.NodeGroupVersionKind.Group,
+			Resource: "node",
+		}, key)
+	}
+	return obj.(*v3.Node), nil
+}
+
+type nodeLifecycleAdapter struct {
+	lifecycle v3.NodeLifecycle
+}
+
+func (w *nodeLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *nodeLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *nodeLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v3.Node))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *nodeLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v3.Node))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *nodeLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v3.Node))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newNodeLifecycleAdapter(name string, clusterScoped bool, client *nodeClient, l v3.NodeLifecycle) v3.NodeHandlerFunc {
+	adapter := &nodeLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v3.Node) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+
+type nodeDriverClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *nodeDriverClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *nodeDriverClient) Create(o *v3.NodeDriver) (*v3.NodeDriver, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.NodeDriver), nil
+}
+
+func (s *nodeDriverClient) Get(name string, opts metav1.GetOptions) (*v3.NodeDriver, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.NodeDriver), nil
+}
+
+func (s *nodeDriverClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.NodeDriver, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.NodeDriver), nil
+}
+
+func (s *nodeDriverClient) Update(o *v3.NodeDriver) (*v3.NodeDriver, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.NodeDriver), nil
+}
+
+func (s *nodeDriverClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *nodeDriverClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *nodeDriverClient) List(opts metav1.ListOptions) (*v3.NodeDriverList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v3.NodeDriverList{}
+	list.APIVersion = v3.NodeDriverGroupVersionKind.GroupVersion().String()
+	list.Kind = v3.NodeDriverGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v3.NodeDriver))
+	}
+	return list, nil
+}
+
+func (s *nodeDriverClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *nodeDriverClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *nodeDriverClient) Controller() v3.NodeDriverController {
+	return &nodeDriverController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *nodeDriverClient) AddHandler(ctx context.Context, name string, sync v3.NodeDriverHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *nodeDriverClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v3.NodeDriverHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *nodeDriverClient) AddLifecycle(ctx context.Context, name string, lifecycle v3.NodeDriverLifecycle) {
+	sync := newNodeDriverLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *nodeDriverClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v3.NodeDriverLifecycle) {
+	sync := newNodeDriverLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *nodeDriverClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v3.NodeDriverHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *nodeDriverClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v3.NodeDriverHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *nodeDriverClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v3.NodeDriverLifecycle) {
+	sync := newNodeDriverLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *nodeDriverClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v3.NodeDriverLifecycle) {
+	sync := newNodeDriverLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type nodeDriverController struct {
+	controller.GenericController
+}
+
+func (c *nodeDriverController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *nodeDriverController) Lister() v3.NodeDriverLister {
+	return &nodeDriverLister{
+		controller: c,
+	}
+}
+
+func (c *nodeDriverController) AddHandler(ctx context.Context, name string, handler v3.NodeDriverHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.NodeDriver); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *nodeDriverController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v3.NodeDriverHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.NodeDriver); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *nodeDriverController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v3.NodeDriverHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.NodeDriver); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *nodeDriverController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v3.NodeDriverHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.NodeDriver); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type nodeDriverLister struct {
+	controller *nodeDriverController
+}
+
+func (l *nodeDriverLister) List(namespace string, selector labels.Selector) (ret []*v3.NodeDriver, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v3.NodeDriver))
+	})
+	return
+}
+
+func (l *nodeDriverLister) Get(namespace, name string) (*v3.NodeDriver, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v3.NodeDriverGroupVersionKind.Group,
+			Resource: "nodeDriver",
+		}, key)
+	}
+	return obj.(*v3.NodeDriver), nil
+}
+
+type nodeDriverLifecycleAdapter struct {
+	lifecycle v3.NodeDriverLifecycle
+}
+
+func (w *nodeDriverLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *nodeDriverLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *nodeDriverLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v3.NodeDriver))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *nodeDriverLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v3.NodeDriver))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *nodeDriverLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v3.NodeDriver))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newNodeDriverLifecycleAdapter(name string, clusterScoped bool, client *nodeDriverClient, l v3.NodeDriverLifecycle) v3.NodeDriverHandlerFunc {
+	adapter := &nodeDriverLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v3.NodeDriver) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+
+type nodeTemplateClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *nodeTemplateClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *nodeTemplateClient) Create(o *v3.NodeTemplate) (*v3.NodeTemplate, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.NodeTemplate), nil
+}
+
+func (s *nodeTemplateClient) Get(name string, opts metav1.GetOptions) (*v3.NodeTemplate, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.NodeTemplate), nil
+}
+
+func (s *nodeTemplateClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.NodeTemplate, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.NodeTemplate), nil
+}
+
+func (s *nodeTemplateClient) Update(o *v3.NodeTemplate) (*v3.NodeTemplate, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.NodeTemplate), nil
+}
+
+func (s *nodeTemplateClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *nodeTemplateClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *nodeTemplateClient) List(opts metav1.ListOptions) (*v3.NodeTemplateList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v3.NodeTemplateList{}
+	list.APIVersion = v3.NodeTemplateGroupVersionKind.GroupVersion().String()
+	list.Kind = v3.NodeTemplateGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v3.NodeTemplate))
+	}
+	return list, nil
+}
+
+func (s *nodeTemplateClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *nodeTemplateClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *nodeTemplateClient) Controller() v3.NodeTemplateController {
+	return &nodeTemplateController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *nodeTemplateClient) AddHandler(ctx context.Context, name string, sync v3.NodeTemplateHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *nodeTemplateClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v3.NodeTemplateHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *nodeTemplateClient) AddLifecycle(ctx context.Context, name string, lifecycle v3.NodeTemplateLifecycle) {
+	sync := newNodeTemplateLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *nodeTemplateClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v3.NodeTemplateLifecycle) {
+	sync := newNodeTemplateLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *nodeTemplateClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v3.NodeTemplateHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *nodeTemplateClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v3.NodeTemplateHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *nodeTemplateClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v3.NodeTemplateLifecycle) {
+	sync := newNodeTemplateLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *nodeTemplateClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v3.NodeTemplateLifecycle) {
+	sync := newNodeTemplateLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type nodeTemplateController struct {
+	controller.GenericController
+}
+
+func (c *nodeTemplateController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *nodeTemplateController) Lister() v3.NodeTemplateLister {
+	return &nodeTemplateLister{
+		controller: c,
+	}
+}
+
+func (c *nodeTemplateController) AddHandler(ctx context.Context, name string, handler v3.NodeTemplateHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.NodeTemplate); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *nodeTemplateController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v3.NodeTemplateHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.NodeTemplate); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *nodeTemplateController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v3.NodeTemplateHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.NodeTemplate); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *nodeTemplateController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v3.NodeTemplateHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.NodeTemplate); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type nodeTemplateLister struct {
+	controller *nodeTemplateController
+}
+
+func (l *nodeTemplateLister) List(namespace string, selector labels.Selector) (ret []*v3.NodeTemplate, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v3.NodeTemplate))
+	})
+	return
+}
+
+func (l *nodeTemplateLister) Get(namespace, name string) (*v3.NodeTemplate, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v3.NodeTemplateGroupVersionKind.Group,
+			Resource: "nodeTemplate",
+		}, key)
+	}
+	return obj.(*v3.NodeTemplate), nil
+}
+
+type nodeTemplateLifecycleAdapter struct {
+	lifecycle v3.NodeTemplateLifecycle
+}
+
+func (w *nodeTemplateLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *nodeTemplateLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *nodeTemplateLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v3.NodeTemplate))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *nodeTemplateLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v3.NodeTemplate))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *nodeTemplateLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v3.NodeTemplate))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newNodeTemplateLifecycleAdapter(name string, clusterScoped bool, client *nodeTemplateClient, l v3.NodeTemplateLifecycle) v3.NodeTemplateHandlerFunc {
+	adapter := &nodeTemplateLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v3.NodeTemplate) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+
+type projectClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *projectClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *projectClient) Create(o *v3.Project) (*v3.Project, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.Project), nil
+}
+
+func (s *projectClient) Get(name string, opts metav1.GetOptions) (*v3.Project, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.Project), nil
+}
+
+func (s *projectClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.Project, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.Project), nil
+}
+
+func (s *projectClient) Update(o *v3.Project) (*v3.Project, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.Project), nil
+}
+
+func (s *projectClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *projectClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *projectClient) List(opts metav1.ListOptions) (*v3.ProjectList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v3.ProjectList{}
+	list.APIVersion = v3.ProjectGroupVersionKind.GroupVersion().String()
+	list.Kind = v3.ProjectGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v3.Project))
+	}
+	return list, nil
+}
+
+func (s *projectClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *projectClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *projectClient) Controller() v3.ProjectController {
+	return &projectController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *projectClient) AddHandler(ctx context.Context, name string, sync v3.ProjectHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *projectClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v3.ProjectHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *projectClient) AddLifecycle(ctx context.Context, name string, lifecycle v3.ProjectLifecycle) {
+	sync := newProjectLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *projectClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v3.ProjectLifecycle) {
+	sync := newProjectLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *projectClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v3.ProjectHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *projectClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v3.ProjectHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *projectClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v3.ProjectLifecycle) {
+	sync := newProjectLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *projectClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v3.ProjectLifecycle) {
+	sync := newProjectLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type projectController struct {
+	controller.GenericController
+}
+
+func (c *projectController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *projectController) Lister() v3.ProjectLister {
+	return &projectLister{
+		controller: c,
+	}
+}
+
+func (c *projectController) AddHandler(ctx context.Context, name string, handler v3.ProjectHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.Project); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *projectController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v3.ProjectHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.Project); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *projectController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v3.ProjectHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.Project); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *projectController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v3.ProjectHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.Project); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type projectLister struct {
+	controller *projectController
+}
+
+func (l *projectLister) List(namespace string, selector labels.Selector) (ret []*v3.Project, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v3.Project))
+	})
+	return
+}
+
+func (l *projectLister) Get(namespace, name string) (*v3.Project, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v3.ProjectGroupVersionKind.Group,
+			Resource: "project",
+		}, key)
+	}
+	return obj.(*v3.Project), nil
+}
+
+type projectLifecycleAdapter struct {
+	lifecycle v3.ProjectLifecycle
+}
+
+func (w *projectLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *projectLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *projectLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v3.Project))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *projectLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v3.Project))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *projectLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v3.Project))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newProjectLifecycleAdapter(name string, clusterScoped bool, client *projectClient, l v3.ProjectLifecycle) v3.ProjectHandlerFunc {
+	adapter := &projectLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v3.Project) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+
+type globalRoleClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *globalRoleClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *globalRoleClient) Create(o *v3.GlobalRole) (*v3.GlobalRole, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.GlobalRole), nil
+}
+
+func (s *globalRoleClient) Get(name string, opts metav1.GetOptions) (*v3.GlobalRole, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.GlobalRole), nil
+}
+
+func (s *globalRoleClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.GlobalRole, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.GlobalRole), nil
+}
+
+func (s *globalRoleClient) Update(o *v3.GlobalRole) (*v3.GlobalRole, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.GlobalRole), nil
+}
+
+func (s *globalRoleClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *globalRoleClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *globalRoleClient) List(opts metav1.ListOptions) (*v3.GlobalRoleList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v3.GlobalRoleList{}
+	list.APIVersion = v3.GlobalRoleGroupVersionKind.GroupVersion().String()
+	list.Kind = v3.GlobalRoleGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v3.GlobalRole))
+	}
+	return list, nil
+}
+
+func (s *globalRoleClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *globalRoleClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *globalRoleClient) Controller() v3.GlobalRoleController {
+	return &globalRoleController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *globalRoleClient) AddHandler(ctx context.Context, name string, sync v3.GlobalRoleHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *globalRoleClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v3.GlobalRoleHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *globalRoleClient) AddLifecycle(ctx context.Context, name string, lifecycle v3.GlobalRoleLifecycle) {
+	sync := newGlobalRoleLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *globalRoleClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v3.GlobalRoleLifecycle) {
+	sync := newGlobalRoleLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *globalRoleClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v3.GlobalRoleHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *globalRoleClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v3.GlobalRoleHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *globalRoleClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v3.GlobalRoleLifecycle) {
+	sync := newGlobalRoleLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *globalRoleClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v3.GlobalRoleLifecycle) {
+	sync := newGlobalRoleLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type globalRoleController struct {
+	controller.GenericController
+}
+
+func (c *globalRoleController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *globalRoleController) Lister() v3.GlobalRoleLister {
+	return &globalRoleLister{
+		controller: c,
+	}
+}
+
+func (c *globalRoleController) AddHandler(ctx context.Context, name string, handler v3.GlobalRoleHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.GlobalRole); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *globalRoleController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v3.GlobalRoleHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.GlobalRole); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *globalRoleController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v3.GlobalRoleHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.GlobalRole); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *globalRoleController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v3.GlobalRoleHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.GlobalRole); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type globalRoleLister struct {
+	controller *globalRoleController
+}
+
+func (l *globalRoleLister) List(namespace string, selector labels.Selector) (ret []*v3.GlobalRole, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v3.GlobalRole))
+	})
+	return
+}
+
+func (l *globalRoleLister) Get(namespace, name string) (*v3.GlobalRole, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v3.GlobalRoleGroupVersionKind.Group,
+			Resource: "globalRole",
+		}, key)
+	}
+	return obj.(*v3.GlobalRole), nil
+}
+
+type globalRoleLifecycleAdapter struct {
+	lifecycle v3.GlobalRoleLifecycle
+}
+
+func (w *globalRoleLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *globalRoleLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *globalRoleLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v3.GlobalRole))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *globalRoleLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v3.GlobalRole))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *globalRoleLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v3.GlobalRole))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newGlobalRoleLifecycleAdapter(name string, clusterScoped bool, client *globalRoleClient, l v3.GlobalRoleLifecycle) v3.GlobalRoleHandlerFunc {
+	adapter := &globalRoleLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v3.GlobalRole) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+
+type globalRoleBindingClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *globalRoleBindingClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *globalRoleBindingClient) Create(o *v3.GlobalRoleBinding) (*v3.GlobalRoleBinding, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.GlobalRoleBinding), nil
+}
+
+func (s *globalRoleBindingClient) Get(name string, opts metav1.GetOptions) (*v3.GlobalRoleBinding, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.GlobalRoleBinding), nil
+}
+
+func (s *globalRoleBindingClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.GlobalRoleBinding, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.GlobalRoleBinding), nil
+}
+
+func (s *globalRoleBindingClient) Update(o *v3.GlobalRoleBinding) (*v3.GlobalRoleBinding, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.GlobalRoleBinding), nil
+}
+
+func (s *globalRoleBindingClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *globalRoleBindingClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *globalRoleBindingClient) List(opts metav1.ListOptions) (*v3.GlobalRoleBindingList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v3.GlobalRoleBindingList{}
+	list.APIVersion = v3.GlobalRoleBindingGroupVersionKind.GroupVersion().String()
+	list.Kind = v3.GlobalRoleBindingGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v3.GlobalRoleBinding))
+	}
+	return list, nil
+}
+
+func (s *globalRoleBindingClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *globalRoleBindingClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *globalRoleBindingClient) Controller() v3.GlobalRoleBindingController {
+	return &globalRoleBindingController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *globalRoleBindingClient) AddHandler(ctx context.Context, name string, sync v3.GlobalRoleBindingHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *globalRoleBindingClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v3.GlobalRoleBindingHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *globalRoleBindingClient) AddLifecycle(ctx context.Context, name string, lifecycle v3.GlobalRoleBindingLifecycle) {
+	sync := newGlobalRoleBindingLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *globalRoleBindingClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v3.GlobalRoleBindingLifecycle) {
+	sync := newGlobalRoleBindingLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *globalRoleBindingClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v3.GlobalRoleBindingHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *globalRoleBindingClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v3.GlobalRoleBindingHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *globalRoleBindingClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v3.GlobalRoleBindingLifecycle) {
+	sync := newGlobalRoleBindingLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *globalRoleBindingClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v3.GlobalRoleBindingLifecycle) {
+	sync := newGlobalRoleBindingLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type globalRoleBindingController struct {
+	controller.GenericController
+}
+
+func (c *globalRoleBindingController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *globalRoleBindingController) Lister() v3.GlobalRoleBindingLister {
+	return &globalRoleBindingLister{
+		controller: c,
+	}
+}
+
+func (c *globalRoleBindingController) AddHandler(ctx context.Context, name string, handler v3.GlobalRoleBindingHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.GlobalRoleBinding); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *globalRoleBindingController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v3.GlobalRoleBindingHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.GlobalRoleBinding); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *globalRoleBindingController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v3.GlobalRoleBindingHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.GlobalRoleBinding); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *globalRoleBindingController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v3.GlobalRoleBindingHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.GlobalRoleBinding); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type globalRoleBindingLister struct {
+	controller *globalRoleBindingController
+}
+
+func (l *globalRoleBindingLister) List(namespace string, selector labels.Selector) (ret []*v3.GlobalRoleBinding, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v3.GlobalRoleBinding))
+	})
+	return
+}
+
+func (l *globalRoleBindingLister) Get(namespace, name string) (*v3.GlobalRoleBinding, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v3.GlobalRoleBindingGroupVersionKind.Group,
+			Resource: "globalRoleBinding",
+		}, key)
+	}
+	return obj.(*v3.GlobalRoleBinding), nil
+}
+
+type globalRoleBindingLifecycleAdapter struct {
+	lifecycle v3.GlobalRoleBindingLifecycle
+}
+
+func (w *globalRoleBindingLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *globalRoleBindingLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *globalRoleBindingLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v3.GlobalRoleBinding))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *globalRoleBindingLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v3.GlobalRoleBinding))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *globalRoleBindingLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v3.GlobalRoleBinding))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newGlobalRoleBindingLifecycleAdapter(name string, clusterScoped bool, client *globalRoleBindingClient, l v3.GlobalRoleBindingLifecycle) v3.GlobalRoleBindingHandlerFunc {
+	adapter := &globalRoleBindingLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v3.GlobalRoleBinding) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+
+type roleTemplateClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *roleTemplateClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *roleTemplateClient) Create(o *v3.RoleTemplate) (*v3.RoleTemplate, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.RoleTemplate), nil
+}
+
+func (s *roleTemplateClient) Get(name string, opts metav1.GetOptions) (*v3.RoleTemplate, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.RoleTemplate), nil
+}
+
+func (s *roleTemplateClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.RoleTemplate, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.RoleTemplate), nil
+}
+
+func (s *roleTemplateClient) Update(o *v3.RoleTemplate) (*v3.RoleTemplate, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.RoleTemplate), nil
+}
+
+func (s *roleTemplateClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *roleTemplateClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *roleTemplateClient) List(opts metav1.ListOptions) (*v3.RoleTemplateList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v3.RoleTemplateList{}
+	list.APIVersion = v3.RoleTemplateGroupVersionKind.GroupVersion().String()
+	list.Kind = v3.RoleTemplateGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v3.RoleTemplate))
+	}
+	return list, nil
+}
+
+func (s *roleTemplateClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *roleTemplateClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *roleTemplateClient) Controller() v3.RoleTemplateController {
+	return &roleTemplateController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *roleTemplateClient) AddHandler(ctx context.Context, name string, sync v3.RoleTemplateHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *roleTemplateClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v3.RoleTemplateHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *roleTemplateClient) AddLifecycle(ctx context.Context, name string, lifecycle v3.RoleTemplateLifecycle) {
+	sync := newRoleTemplateLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *roleTemplateClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v3.RoleTemplateLifecycle) {
+	sync := newRoleTemplateLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *roleTemplateClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v3.RoleTemplateHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *roleTemplateClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v3.RoleTemplateHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *roleTemplateClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v3.RoleTemplateLifecycle) {
+	sync := newRoleTemplateLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *roleTemplateClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v3.RoleTemplateLifecycle) {
+	sync := newRoleTemplateLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type roleTemplateController struct {
+	controller.GenericController
+}
+
+func (c *roleTemplateController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *roleTemplateController) Lister() v3.RoleTemplateLister {
+	return &roleTemplateLister{
+		controller: c,
+	}
+}
+
+func (c *roleTemplateController) AddHandler(ctx context.Context, name string, handler v3.RoleTemplateHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.RoleTemplate); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *roleTemplateController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v3.RoleTemplateHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.RoleTemplate); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *roleTemplateController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v3.RoleTemplateHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.RoleTemplate); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *roleTemplateController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v3.RoleTemplateHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.RoleTemplate); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type roleTemplateLister struct {
+	controller *roleTemplateController
+}
+
+func (l *roleTemplateLister) List(namespace string, selector labels.Selector) (ret []*v3.RoleTemplate, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v3.RoleTemplate))
+	})
+	return
+}
+
+func (l *roleTemplateLister) Get(namespace, name string) (*v3.RoleTemplate, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v3.RoleTemplateGroupVersionKind.Group,
+			Resource: "roleTemplate",
+		}, key)
+	}
+	return obj.(*v3.RoleTemplate), nil
+}
+
+type roleTemplateLifecycleAdapter struct {
+	lifecycle v3.RoleTemplateLifecycle
+}
+
+func (w *roleTemplateLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *roleTemplateLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *roleTemplateLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v3.RoleTemplate))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *roleTemplateLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v3.RoleTemplate))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *roleTemplateLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v3.RoleTemplate))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newRoleTemplateLifecycleAdapter(name string, clusterScoped bool, client *roleTemplateClient, l v3.RoleTemplateLifecycle) v3.RoleTemplateHandlerFunc {
+	adapter := &roleTemplateLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v3.RoleTemplate) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+
+type podSecurityPolicyTemplateClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *podSecurityPolicyTemplateClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *podSecurityPolicyTemplateClient) Create(o *v3.PodSecurityPolicyTemplate) (*v3.PodSecurityPolicyTemplate, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.PodSecurityPolicyTemplate), nil
+}
+
+func (s *podSecurityPolicyTemplateClient) Get(name string, opts metav1.GetOptions) (*v3.PodSecurityPolicyTemplate, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.PodSecurityPolicyTemplate), nil
+}
+
+func (s *podSecurityPolicyTemplateClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.PodSecurityPolicyTemplate, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.PodSecurityPolicyTemplate), nil
+}
+
+func (s *podSecurityPolicyTemplateClient) Update(o *v3.PodSecurityPolicyTemplate) (*v3.PodSecurityPolicyTemplate, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.PodSecurityPolicyTemplate), nil
+}
+
+func (s *podSecurityPolicyTemplateClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *podSecurityPolicyTemplateClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *podSecurityPolicyTemplateClient) List(opts metav1.ListOptions) (*v3.PodSecurityPolicyTemplateList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v3.PodSecurityPolicyTemplateList{}
+	list.APIVersion = v3.PodSecurityPolicyTemplateGroupVersionKind.GroupVersion().String()
+	list.Kind = v3.PodSecurityPolicyTemplateGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v3.PodSecurityPolicyTemplate))
+	}
+	return list, nil
+}
+
+func (s *podSecurityPolicyTemplateClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *podSecurityPolicyTemplateClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *podSecurityPolicyTemplateClient) Controller() v3.PodSecurityPolicyTemplateController {
+	return &podSecurityPolicyTemplateController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *podSecurityPolicyTemplateClient) AddHandler(ctx context.Context, name string, sync v3.PodSecurityPolicyTemplateHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *podSecurityPolicyTemplateClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v3.PodSecurityPolicyTemplateHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *podSecurityPolicyTemplateClient) AddLifecycle(ctx context.Context, name string, lifecycle v3.PodSecurityPolicyTemplateLifecycle) {
+	sync := newPodSecurityPolicyTemplateLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *podSecurityPolicyTemplateClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v3.PodSecurityPolicyTemplateLifecycle) {
+	sync := newPodSecurityPolicyTemplateLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *podSecurityPolicyTemplateClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v3.PodSecurityPolicyTemplateHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *podSecurityPolicyTemplateClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v3.PodSecurityPolicyTemplateHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *podSecurityPolicyTemplateClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v3.PodSecurityPolicyTemplateLifecycle) {
+	sync := newPodSecurityPolicyTemplateLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *podSecurityPolicyTemplateClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v3.PodSecurityPolicyTemplateLifecycle) {
+	sync := newPodSecurityPolicyTemplateLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type podSecurityPolicyTemplateController struct {
+	controller.GenericController
+}
+
+func (c *podSecurityPolicyTemplateController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *podSecurityPolicyTemplateController) Lister() v3.PodSecurityPolicyTemplateLister {
+	return &podSecurityPolicyTemplateLister{
+		controller: c,
+	}
+}
+
+func (c *podSecurityPolicyTemplateController) AddHandler(ctx context.Context, name string, handler v3.PodSecurityPolicyTemplateHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.PodSecurityPolicyTemplate); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *podSecurityPolicyTemplateController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v3.PodSecurityPolicyTemplateHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.PodSecurityPolicyTemplate); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *podSecurityPolicyTemplateController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v3.PodSecurityPolicyTemplateHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.PodSecurityPolicyTemplate); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *podSecurityPolicyTemplateController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v3.PodSecurityPolicyTemplateHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.PodSecurityPolicyTemplate); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type podSecurityPolicyTemplateLister struct {
+	controller *podSecurityPolicyTemplateController
+}
+
+func (l *podSecurityPolicyTemplateLister) List(namespace string, selector labels.Selector) (ret []*v3.PodSecurityPolicyTemplate, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v3.PodSecurityPolicyTemplate))
+	})
+	return
+}
+
+func (l *podSecurityPolicyTemplateLister) Get(namespace, name string) (*v3.PodSecurityPolicyTemplate, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v3.PodSecurityPolicyTemplateGroupVersionKind.Group,
+			Resource: "podSecurityPolicyTemplate",
+		}, key)
+	}
+	return obj.(*v3.PodSecurityPolicyTemplate), nil
+}
+
+type podSecurityPolicyTemplateLifecycleAdapter struct {
+	lifecycle v3.PodSecurityPolicyTemplateLifecycle
+}
+
+func (w *podSecurityPolicyTemplateLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *podSecurityPolicyTemplateLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *podSecurityPolicyTemplateLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v3.PodSecurityPolicyTemplate))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *podSecurityPolicyTemplateLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v3.PodSecurityPolicyTemplate))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *podSecurityPolicyTemplateLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v3.PodSecurityPolicyTemplate))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newPodSecurityPolicyTemplateLifecycleAdapter(name string, clusterScoped bool, client *podSecurityPolicyTemplateClient, l v3.PodSecurityPolicyTemplateLifecycle) v3.PodSecurityPolicyTemplateHandlerFunc {
+	adapter := &podSecurityPolicyTemplateLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v3.PodSecurityPolicyTemplate) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+
+type podSecurityPolicyTemplateProjectBindingClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *podSecurityPolicyTemplateProjectBindingClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *podSecurityPolicyTemplateProjectBindingClient) Create(o *v3.PodSecurityPolicyTemplateProjectBinding) (*v3.PodSecurityPolicyTemplateProjectBinding, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.PodSecurityPolicyTemplateProjectBinding), nil
+}
+
+func (s *podSecurityPolicyTemplateProjectBindingClient) Get(name string, opts metav1.GetOptions) (*v3.PodSecurityPolicyTemplateProjectBinding, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.PodSecurityPolicyTemplateProjectBinding), nil
+}
+
+func (s *podSecurityPolicyTemplateProjectBindingClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.PodSecurityPolicyTemplateProjectBinding, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.PodSecurityPolicyTemplateProjectBinding), nil
+}
+
+func (s *podSecurityPolicyTemplateProjectBindingClient) Update(o *v3.PodSecurityPolicyTemplateProjectBinding) (*v3.PodSecurityPolicyTemplateProjectBinding, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.PodSecurityPolicyTemplateProjectBinding), nil
+}
+
+func (s *podSecurityPolicyTemplateProjectBindingClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *podSecurityPolicyTemplateProjectBindingClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *podSecurityPolicyTemplateProjectBindingClient) List(opts metav1.ListOptions) (*v3.PodSecurityPolicyTemplateProjectBindingList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v3.PodSecurityPolicyTemplateProjectBindingList{}
+	list.APIVersion = v3.PodSecurityPolicyTemplateProjectBindingGroupVersionKind.GroupVersion().String()
+	list.Kind = v3.PodSecurityPolicyTemplateProjectBindingGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v3.PodSecurityPolicyTemplateProjectBinding))
+	}
+	return list, nil
+}
+
+func (s *podSecurityPolicyTemplateProjectBindingClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *podSecurityPolicyTemplateProjectBindingClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *podSecurityPolicyTemplateProjectBindingClient) Controller() v3.PodSecurityPolicyTemplateProjectBindingController {
+	return &podSecurityPolicyTemplateProjectBindingController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *podSecurityPolicyTemplateProjectBindingClient) AddHandler(ctx context.Context, name string, sync v3.PodSecurityPolicyTemplateProjectBindingHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *podSecurityPolicyTemplateProjectBindingClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v3.PodSecurityPolicyTemplateProjectBindingHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *podSecurityPolicyTemplateProjectBindingClient) AddLifecycle(ctx context.Context, name string, lifecycle v3.PodSecurityPolicyTemplateProjectBindingLifecycle) {
+	sync := newPodSecurityPolicyTemplateProjectBindingLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *podSecurityPolicyTemplateProjectBindingClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v3.PodSecurityPolicyTemplateProjectBindingLifecycle) {
+	sync := newPodSecurityPolicyTemplateProjectBindingLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *podSecurityPolicyTemplateProjectBindingClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v3.PodSecurityPolicyTemplateProjectBindingHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *podSecurityPolicyTemplateProjectBindingClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v3.PodSecurityPolicyTemplateProjectBindingHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *podSecurityPolicyTemplateProjectBindingClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v3.PodSecurityPolicyTemplateProjectBindingLifecycle) {
+	sync := newPodSecurityPolicyTemplateProjectBindingLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *podSecurityPolicyTemplateProjectBindingClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v3.PodSecurityPolicyTemplateProjectBindingLifecycle) {
+	sync := newPodSecurityPolicyTemplateProjectBindingLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type podSecurityPolicyTemplateProjectBindingController struct {
+	controller.GenericController
+}
+
+func (c *podSecurityPolicyTemplateProjectBindingController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *podSecurityPolicyTemplateProjectBindingController) Lister() v3.PodSecurityPolicyTemplateProjectBindingLister {
+	return &podSecurityPolicyTemplateProjectBindingLister{
+		controller: c,
+	}
+}
+
+func (c *podSecurityPolicyTemplateProjectBindingController) AddHandler(ctx context.Context, name string, handler v3.PodSecurityPolicyTemplateProjectBindingHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.PodSecurityPolicyTemplateProjectBinding); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *podSecurityPolicyTemplateProjectBindingController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v3.PodSecurityPolicyTemplateProjectBindingHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.PodSecurityPolicyTemplateProjectBinding); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *podSecurityPolicyTemplateProjectBindingController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v3.PodSecurityPolicyTemplateProjectBindingHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.PodSecurityPolicyTemplateProjectBinding); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *podSecurityPolicyTemplateProjectBindingController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v3.PodSecurityPolicyTemplateProjectBindingHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.PodSecurityPolicyTemplateProjectBinding); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type podSecurityPolicyTemplateProjectBindingLister struct {
+	controller *podSecurityPolicyTemplateProjectBindingController
+}
+
+func (l *podSecurityPolicyTemplateProjectBindingLister) List(namespace string, selector labels.Selector) (ret []*v3.PodSecurityPolicyTemplateProjectBinding, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v3.PodSecurityPolicyTemplateProjectBinding))
+	})
+	return
+}
+
+func (l *podSecurityPolicyTemplateProjectBindingLister) Get(namespace, name string) (*v3.PodSecurityPolicyTemplateProjectBinding, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v3.PodSecurityPolicyTemplateProjectBindingGroupVersionKind.Group,
+			Resource: "podSecurityPolicyTemplateProjectBinding",
+		}, key)
+	}
+	return obj.(*v3.PodSecurityPolicyTemplateProjectBinding), nil
+}
+
+type podSecurityPolicyTemplateProjectBindingLifecycleAdapter struct {
+	lifecycle v3.PodSecurityPolicyTemplateProjectBindingLifecycle
+}
+
+func (w *podSecurityPolicyTemplateProjectBindingLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *podSecurityPolicyTemplateProjectBindingLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *podSecurityPolicyTemplateProjectBindingLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v3.PodSecurityPolicyTemplateProjectBinding))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *podSecurityPolicyTemplateProjectBindingLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v3.PodSecurityPolicyTemplateProjectBinding))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *podSecurityPolicyTemplateProjectBindingLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v3.PodSecurityPolicyTemplateProjectBinding))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newPodSecurityPolicyTemplateProjectBindingLifecycleAdapter(name string, clusterScoped bool, client *podSecurityPolicyTemplateProjectBindingClient, l v3.PodSecurityPolicyTemplateProjectBindingLifecycle) v3.PodSecurityPolicyTemplateProjectBindingHandlerFunc {
+	adapter := &podSecurityPolicyTemplateProjectBindingLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v3.PodSecurityPolicyTemplateProjectBinding) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+
+type clusterRoleTemplateBindingClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *clusterRoleTemplateBindingClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *clusterRoleTemplateBindingClient) Create(o *v3.ClusterRoleTemplateBinding) (*v3.ClusterRoleTemplateBinding, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.ClusterRoleTemplateBinding), nil
+}
+
+func (s *clusterRoleTemplateBindingClient) Get(name string, opts metav1.GetOptions) (*v3.ClusterRoleTemplateBinding, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.ClusterRoleTemplateBinding), nil
+}
+
+func (s *clusterRoleTemplateBindingClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.ClusterRoleTemplateBinding, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.ClusterRoleTemplateBinding), nil
+}
+
+func (s *clusterRoleTemplateBindingClient) Update(o *v3.ClusterRoleTemplateBinding) (*v3.ClusterRoleTemplateBinding, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.ClusterRoleTemplateBinding), nil
+}
+
+func (s *clusterRoleTemplateBindingClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *clusterRoleTemplateBindingClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *clusterRoleTemplateBindingClient) List(opts metav1.ListOptions) (*v3.ClusterRoleTemplateBindingList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v3.ClusterRoleTemplateBindingList{}
+	list.APIVersion = v3.ClusterRoleTemplateBindingGroupVersionKind.GroupVersion().String()
+	list.Kind = v3.ClusterRoleTemplateBindingGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v3.ClusterRoleTemplateBinding))
+	}
+	return list, nil
+}
+
+func (s *clusterRoleTemplateBindingClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *clusterRoleTemplateBindingClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *clusterRoleTemplateBindingClient) Controller() v3.ClusterRoleTemplateBindingController {
+	return &clusterRoleTemplateBindingController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *clusterRoleTemplateBindingClient) AddHandler(ctx context.Context, name string, sync v3.ClusterRoleTemplateBindingHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *clusterRoleTemplateBindingClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v3.ClusterRoleTemplateBindingHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *clusterRoleTemplateBindingClient) AddLifecycle(ctx context.Context, name string, lifecycle v3.ClusterRoleTemplateBindingLifecycle) {
+	sync := newClusterRoleTemplateBindingLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *clusterRoleTemplateBindingClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v3.ClusterRoleTemplateBindingLifecycle) {
+	sync := newClusterRoleTemplateBindingLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *clusterRoleTemplateBindingClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v3.ClusterRoleTemplateBindingHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *clusterRoleTemplateBindingClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v3.ClusterRoleTemplateBindingHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *clusterRoleTemplateBindingClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v3.ClusterRoleTemplateBindingLifecycle) {
+	sync := newClusterRoleTemplateBindingLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *clusterRoleTemplateBindingClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v3.ClusterRoleTemplateBindingLifecycle) {
+	sync := newClusterRoleTemplateBindingLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type clusterRoleTemplateBindingController struct {
+	controller.GenericController
+}
+
+func (c *clusterRoleTemplateBindingController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *clusterRoleTemplateBindingController) Lister() v3.ClusterRoleTemplateBindingLister {
+	return &clusterRoleTemplateBindingLister{
+		controller: c,
+	}
+}
+
+func (c *clusterRoleTemplateBindingController) AddHandler(ctx context.Context, name string, handler v3.ClusterRoleTemplateBindingHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ClusterRoleTemplateBinding); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *clusterRoleTemplateBindingController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v3.ClusterRoleTemplateBindingHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ClusterRoleTemplateBinding); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *clusterRoleTemplateBindingController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v3.ClusterRoleTemplateBindingHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ClusterRoleTemplateBinding); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *clusterRoleTemplateBindingController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v3.ClusterRoleTemplateBindingHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ClusterRoleTemplateBinding); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type clusterRoleTemplateBindingLister struct {
+	controller *clusterRoleTemplateBindingController
+}
+
+func (l *clusterRoleTemplateBindingLister) List(namespace string, selector labels.Selector) (ret []*v3.ClusterRoleTemplateBinding, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v3.ClusterRoleTemplateBinding))
+	})
+	return
+}
+
+func (l *clusterRoleTemplateBindingLister) Get(namespace, name string) (*v3.ClusterRoleTemplateBinding, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v3.ClusterRoleTemplateBindingGroupVersionKind.Group,
+			Resource: "clusterRoleTemplateBinding",
+		}, key)
+	}
+	return obj.(*v3.ClusterRoleTemplateBinding), nil
+}
+
+type clusterRoleTemplateBindingLifecycleAdapter struct {
+	lifecycle v3.ClusterRoleTemplateBindingLifecycle
+}
+
+func (w *clusterRoleTemplateBindingLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *clusterRoleTemplateBindingLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *clusterRoleTemplateBindingLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v3.ClusterRoleTemplateBinding))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *clusterRoleTemplateBindingLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v3.ClusterRoleTemplateBinding))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *clusterRoleTemplateBindingLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v3.ClusterRoleTemplateBinding))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newClusterRoleTemplateBindingLifecycleAdapter(name string, clusterScoped bool, client *clusterRoleTemplateBindingClient, l v3.ClusterRoleTemplateBindingLifecycle) v3.ClusterRoleTemplateBindingHandlerFunc {
+	adapter := &clusterRoleTemplateBindingLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v3.ClusterRoleTemplateBinding) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+
+type projectRoleTemplateBindingClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *projectRoleTemplateBindingClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *projectRoleTemplateBindingClient) Create(o *v3.ProjectRoleTemplateBinding) (*v3.ProjectRoleTemplateBinding, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.ProjectRoleTemplateBinding), nil
+}
+
+func (s *projectRoleTemplateBindingClient) Get(name string, opts metav1.GetOptions) (*v3.ProjectRoleTemplateBinding, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.ProjectRoleTemplateBinding), nil
+}
+
+func (s *projectRoleTemplateBindingClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.ProjectRoleTemplateBinding, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.ProjectRoleTemplateBinding), nil
+}
+
+func (s *projectRoleTemplateBindingClient) Update(o *v3.ProjectRoleTemplateBinding) (*v3.ProjectRoleTemplateBinding, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.ProjectRoleTemplateBinding), nil
+}
+
+func (s *projectRoleTemplateBindingClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *projectRoleTemplateBindingClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *projectRoleTemplateBindingClient) List(opts metav1.ListOptions) (*v3.ProjectRoleTemplateBindingList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v3.ProjectRoleTemplateBindingList{}
+	list.APIVersion = v3.ProjectRoleTemplateBindingGroupVersionKind.GroupVersion().String()
+	list.Kind = v3.ProjectRoleTemplateBindingGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v3.ProjectRoleTemplateBinding))
+	}
+	return list, nil
+}
+
+func (s *projectRoleTemplateBindingClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *projectRoleTemplateBindingClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *projectRoleTemplateBindingClient) Controller() v3.ProjectRoleTemplateBindingController {
+	return &projectRoleTemplateBindingController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *projectRoleTemplateBindingClient) AddHandler(ctx context.Context, name string, sync v3.ProjectRoleTemplateBindingHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *projectRoleTemplateBindingClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v3.ProjectRoleTemplateBindingHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *projectRoleTemplateBindingClient) AddLifecycle(ctx context.Context, name string, lifecycle v3.ProjectRoleTemplateBindingLifecycle) {
+	sync := newProjectRoleTemplateBindingLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *projectRoleTemplateBindingClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v3.ProjectRoleTemplateBindingLifecycle) {
+	sync := newProjectRoleTemplateBindingLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *projectRoleTemplateBindingClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v3.ProjectRoleTemplateBindingHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *projectRoleTemplateBindingClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v3.ProjectRoleTemplateBindingHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *projectRoleTemplateBindingClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v3.ProjectRoleTemplateBindingLifecycle) {
+	sync := newProjectRoleTemplateBindingLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *projectRoleTemplateBindingClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v3.ProjectRoleTemplateBindingLifecycle) {
+	sync := newProjectRoleTemplateBindingLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type projectRoleTemplateBindingController struct {
+	controller.GenericController
+}
+
+func (c *projectRoleTemplateBindingController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *projectRoleTemplateBindingController) Lister() v3.ProjectRoleTemplateBindingLister {
+	return &projectRoleTemplateBindingLister{
+		controller: c,
+	}
+}
+
+func (c *projectRoleTemplateBindingController) AddHandler(ctx context.Context, name string, handler v3.ProjectRoleTemplateBindingHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ProjectRoleTemplateBinding); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *projectRoleTemplateBindingController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v3.ProjectRoleTemplateBindingHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ProjectRoleTemplateBinding); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *projectRoleTemplateBindingController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v3.ProjectRoleTemplateBindingHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ProjectRoleTemplateBinding); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *projectRoleTemplateBindingController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v3.ProjectRoleTemplateBindingHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ProjectRoleTemplateBinding); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type projectRoleTemplateBindingLister struct {
+	controller *projectRoleTemplateBindingController
+}
+
+func (l *projectRoleTemplateBindingLister) List(namespace string, selector labels.Selector) (ret []*v3.ProjectRoleTemplateBinding, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v3.ProjectRoleTemplateBinding))
+	})
+	return
+}
+
+func (l *projectRoleTemplateBindingLister) Get(namespace, name string) (*v3.ProjectRoleTemplateBinding, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v3.ProjectRoleTemplateBindingGroupVersionKind.Group,
+			Resource: "projectRoleTemplateBinding",
+		}, key)
+	}
+	return obj.(*v3.ProjectRoleTemplateBinding), nil
+}
+
+type projectRoleTemplateBindingLifecycleAdapter struct {
+	lifecycle v3.ProjectRoleTemplateBindingLifecycle
+}
+
+func (w *projectRoleTemplateBindingLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *projectRoleTemplateBindingLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *projectRoleTemplateBindingLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v3.ProjectRoleTemplateBinding))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *projectRoleTemplateBindingLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v3.ProjectRoleTemplateBinding))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *projectRoleTemplateBindingLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v3.ProjectRoleTemplateBinding))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newProjectRoleTemplateBindingLifecycleAdapter(name string, clusterScoped bool, client *projectRoleTemplateBindingClient, l v3.ProjectRoleTemplateBindingLifecycle) v3.ProjectRoleTemplateBindingHandlerFunc {
+	adapter := &projectRoleTemplateBindingLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v3.ProjectRoleTemplateBinding) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+
+type clusterClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *clusterClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *clusterClient) Create(o *v3.Cluster) (*v3.Cluster, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.Cluster), nil
+}
+
+func (s *clusterClient) Get(name string, opts metav1.GetOptions) (*v3.Cluster, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.Cluster), nil
+}
+
+func (s *clusterClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.Cluster, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.Cluster), nil
+}
+
+func (s *clusterClient) Update(o *v3.Cluster) (*v3.Cluster, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.Cluster), nil
+}
+
+func (s *clusterClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *clusterClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *clusterClient) List(opts metav1.ListOptions) (*v3.ClusterList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v3.ClusterList{}
+	list.APIVersion = v3.ClusterGroupVersionKind.GroupVersion().String()
+	list.Kind = v3.ClusterGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v3.Cluster))
+	}
+	return list, nil
+}
+
+func (s *clusterClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *clusterClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *clusterClient) Controller() v3.ClusterController {
+	return &clusterController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *clusterClient) AddHandler(ctx context.Context, name string, sync v3.ClusterHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *clusterClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v3.ClusterHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *clusterClient) AddLifecycle(ctx context.Context, name string, lifecycle v3.ClusterLifecycle) {
+	sync := newClusterLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *clusterClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v3.ClusterLifecycle) {
+	sync := newClusterLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *clusterClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v3.ClusterHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *clusterClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v3.ClusterHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *clusterClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v3.ClusterLifecycle) {
+	sync := newClusterLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *clusterClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v3.ClusterLifecycle) {
+	sync := newClusterLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type clusterController struct {
+	controller.GenericController
+}
+
+func (c *clusterController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *clusterController) Lister() v3.ClusterLister {
+	return &clusterLister{
+		controller: c,
+	}
+}
+
+func (c *clusterController) AddHandler(ctx context.Context, name string, handler v3.ClusterHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.Cluster); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *clusterController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v3.ClusterHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.Cluster); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *clusterController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v3.ClusterHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.Cluster); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *clusterController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v3.ClusterHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.Cluster); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type clusterLister struct {
+	controller *clusterController
+}
+
+func (l *clusterLister) List(namespace string, selector labels.Selector) (ret []*v3.Cluster, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v3.Cluster))
+	})
+	return
+}
+
+func (l *clusterLister) Get(namespace, name string) (*v3.Cluster, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v3.ClusterGroupVersionKind.Group,
+			Resource: "cluster",
+		}, key)
+	}
+	return obj.(*v3.Cluster), nil
+}
+
+type clusterLifecycleAdapter struct {
+	lifecycle v3.ClusterLifecycle
+}
+
+func (w *clusterLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *clusterLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *clusterLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v3.Cluster))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *clusterLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v3.Cluster))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *clusterLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v3.Cluster))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newClusterLifecycleAdapter(name string, clusterScoped bool, client *clusterClient, l v3.ClusterLifecycle) v3.ClusterHandlerFunc {
+	adapter := &clusterLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v3.Cluster) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+
+type clusterRegistrationTokenClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *clusterRegistrationTokenClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *clusterRegistrationTokenClient) Create(o *v3.ClusterRegistrationToken) (*v3.ClusterRegistrationToken, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.ClusterRegistrationToken), nil
+}
+
+func (s *clusterRegistrationTokenClient) Get(name string, opts metav1.GetOptions) (*v3.ClusterRegistrationToken, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.ClusterRegistrationToken), nil
+}
+
+func (s *clusterRegistrationTokenClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.ClusterRegistrationToken, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.ClusterRegistrationToken), nil
+}
+
+func (s *clusterRegistrationTokenClient) Update(o *v3.ClusterRegistrationToken) (*v3.ClusterRegistrationToken, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.ClusterRegistrationToken), nil
+}
+
+func (s *clusterRegistrationTokenClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *clusterRegistrationTokenClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *clusterRegistrationTokenClient) List(opts metav1.ListOptions) (*v3.ClusterRegistrationTokenList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v3.ClusterRegistrationTokenList{}
+	list.APIVersion = v3.ClusterRegistrationTokenGroupVersionKind.GroupVersion().String()
+	list.Kind = v3.ClusterRegistrationTokenGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v3.ClusterRegistrationToken))
+	}
+	return list, nil
+}
+
+func (s *clusterRegistrationTokenClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *clusterRegistrationTokenClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *clusterRegistrationTokenClient) Controller() v3.ClusterRegistrationTokenController {
+	return &clusterRegistrationTokenController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *clusterRegistrationTokenClient) AddHandler(ctx context.Context, name string, sync v3.ClusterRegistrationTokenHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *clusterRegistrationTokenClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v3.ClusterRegistrationTokenHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *clusterRegistrationTokenClient) AddLifecycle(ctx context.Context, name string, lifecycle v3.ClusterRegistrationTokenLifecycle) {
+	sync := newClusterRegistrationTokenLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *clusterRegistrationTokenClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v3.ClusterRegistrationTokenLifecycle) {
+	sync := newClusterRegistrationTokenLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *clusterRegistrationTokenClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v3.ClusterRegistrationTokenHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *clusterRegistrationTokenClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v3.ClusterRegistrationTokenHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *clusterRegistrationTokenClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v3.ClusterRegistrationTokenLifecycle) {
+	sync := newClusterRegistrationTokenLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *clusterRegistrationTokenClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v3.ClusterRegistrationTokenLifecycle) {
+	sync := newClusterRegistrationTokenLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type clusterRegistrationTokenController struct {
+	controller.GenericController
+}
+
+func (c *clusterRegistrationTokenController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *clusterRegistrationTokenController) Lister() v3.ClusterRegistrationTokenLister {
+	return &clusterRegistrationTokenLister{
+		controller: c,
+	}
+}
+
+func (c *clusterRegistrationTokenController) AddHandler(ctx context.Context, name string, handler v3.ClusterRegistrationTokenHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ClusterRegistrationToken); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *clusterRegistrationTokenController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v3.ClusterRegistrationTokenHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ClusterRegistrationToken); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *clusterRegistrationTokenController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v3.ClusterRegistrationTokenHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ClusterRegistrationToken); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *clusterRegistrationTokenController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v3.ClusterRegistrationTokenHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ClusterRegistrationToken); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type clusterRegistrationTokenLister struct {
+	controller *clusterRegistrationTokenController
+}
+
+func (l *clusterRegistrationTokenLister) List(namespace string, selector labels.Selector) (ret []*v3.ClusterRegistrationToken, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v3.ClusterRegistrationToken))
+	})
+	return
+}
+
+func (l *clusterRegistrationTokenLister) Get(namespace, name string) (*v3.ClusterRegistrationToken, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v3.ClusterRegistrationTokenGroupVersionKind.Group,
+			Resource: "clusterRegistrationToken",
+		}, key)
+	}
+	return obj.(*v3.ClusterRegistrationToken), nil
+}
+
+type clusterRegistrationTokenLifecycleAdapter struct {
+	lifecycle v3.ClusterRegistrationTokenLifecycle
+}
+
+func (w *clusterRegistrationTokenLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *clusterRegistrationTokenLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *clusterRegistrationTokenLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v3.ClusterRegistrationToken))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *clusterRegistrationTokenLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v3.ClusterRegistrationToken))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *clusterRegistrationTokenLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v3.ClusterRegistrationToken))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newClusterRegistrationTokenLifecycleAdapter(name string, clusterScoped bool, client *clusterRegistrationTokenClient, l v3.ClusterRegistrationTokenLifecycle) v3.ClusterRegistrationTokenHandlerFunc {
+	adapter := &clusterRegistrationTokenLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v3.ClusterRegistrationToken) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+
+type catalogClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *catalogClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *catalogClient) Create(o *v3.Catalog) (*v3.Catalog, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.Catalog), nil
+}
+
+func (s *catalogClient) Get(name string, opts metav1.GetOptions) (*v3.Catalog, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.Catalog), nil
+}
+
+func (s *catalogClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.Catalog, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.Catalog), nil
+}
+
+func (s *catalogClient) Update(o *v3.Catalog) (*v3.Catalog, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.Catalog), nil
+}
+
+func (s *catalogClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *catalogClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *catalogClient) List(opts metav1.ListOptions) (*v3.CatalogList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v3.CatalogList{}
+	list.APIVersion = v3.CatalogGroupVersionKind.GroupVersion().String()
+	list.Kind = v3.CatalogGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v3.Catalog))
+	}
+	return list, nil
+}
+
+func (s *catalogClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *catalogClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *catalogClient) Controller() v3.CatalogController {
+	return &catalogController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *catalogClient) AddHandler(ctx context.Context, name string, sync v3.CatalogHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *catalogClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v3.CatalogHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *catalogClient) AddLifecycle(ctx context.Context, name string, lifecycle v3.CatalogLifecycle) {
+	sync := newCatalogLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *catalogClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v3.CatalogLifecycle) {
+	sync := newCatalogLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *catalogClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v3.CatalogHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *catalogClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v3.CatalogHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *catalogClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v3.CatalogLifecycle) {
+	sync := newCatalogLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *catalogClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v3.CatalogLifecycle) {
+	sync := newCatalogLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type catalogController struct {
+	controller.GenericController
+}
+
+func (c *catalogController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *catalogController) Lister() v3.CatalogLister {
+	return &catalogLister{
+		controller: c,
+	}
+}
+
+func (c *catalogController) AddHandler(ctx context.Context, name string, handler v3.CatalogHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.Catalog); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *catalogController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v3.CatalogHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.Catalog); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *catalogController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v3.CatalogHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.Catalog); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *catalogController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v3.CatalogHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.Catalog); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type catalogLister struct {
+	controller *catalogController
+}
+
+func (l *catalogLister) List(namespace string, selector labels.Selector) (ret []*v3.Catalog, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v3.Catalog))
+	})
+	return
+}
+
+func (l *catalogLister) Get(namespace, name string) (*v3.Catalog, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v3.CatalogGroupVersionKind.Group,
+			Resource: "catalog",
+		}, key)
+	}
+	return obj.(*v3.Catalog), nil
+}
+
+type catalogLifecycleAdapter struct {
+	lifecycle v3.CatalogLifecycle
+}
+
+func (w *catalogLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *catalogLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *catalogLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v3.Catalog))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *catalogLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v3.Catalog))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *catalogLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v3.Catalog))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newCatalogLifecycleAdapter(name string, clusterScoped bool, client *catalogClient, l v3.CatalogLifecycle) v3.CatalogHandlerFunc {
+	adapter := &catalogLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v3.Catalog) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+
+type templateClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *templateClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *templateClient) Create(o *v3.Template) (*v3.Template, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.Template), nil
+}
+
+func (s *templateClient) Get(name string, opts metav1.GetOptions) (*v3.Template, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.Template), nil
+}
+
+func (s *templateClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.Template, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.Template), nil
+}
+
+func (s *templateClient) Update(o *v3.Template) (*v3.Template, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.Template), nil
+}
+
+func (s *templateClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *templateClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *templateClient) List(opts metav1.ListOptions) (*v3.TemplateList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v3.TemplateList{}
+	list.APIVersion = v3.TemplateGroupVersionKind.GroupVersion().String()
+	list.Kind = v3.TemplateGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v3.Template))
+	}
+	return list, nil
+}
+
+func (s *templateClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *templateClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *templateClient) Controller() v3.TemplateController {
+	return &templateController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *templateClient) AddHandler(ctx context.Context, name string, sync v3.TemplateHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *templateClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v3.TemplateHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *templateClient) AddLifecycle(ctx context.Context, name string, lifecycle v3.TemplateLifecycle) {
+	sync := newTemplateLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *templateClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v3.TemplateLifecycle) {
+	sync := newTemplateLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *templateClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v3.TemplateHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *templateClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v3.TemplateHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *templateClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v3.TemplateLifecycle) {
+	sync := newTemplateLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *templateClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v3.TemplateLifecycle) {
+	sync := newTemplateLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type templateController struct {
+	controller.GenericController
+}
+
+func (c *templateController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *templateController) Lister() v3.TemplateLister {
+	return &templateLister{
+		controller: c,
+	}
+}
+
+func (c *templateController) AddHandler(ctx context.Context, name string, handler v3.TemplateHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.Template); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *templateController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v3.TemplateHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.Template); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *templateController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v3.TemplateHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.Template); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *templateController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v3.TemplateHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.Template); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type templateLister struct {
+	controller *templateController
+}
+
+func (l *templateLister) List(namespace string, selector labels.Selector) (ret []*v3.Template, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v3.Template))
+	})
+	return
+}
+
+func (l *templateLister) Get(namespace, name string) (*v3.Template, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v3.TemplateGroupVersionKind.Group,
+			Resource: "template",
+		}, key)
+	}
+	return obj.(*v3.Template), nil
+}
+
+type templateLifecycleAdapter struct {
+	lifecycle v3.TemplateLifecycle
+}
+
+func (w *templateLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *templateLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *templateLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v3.Template))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *templateLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v3.Template))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *templateLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v3.Template))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newTemplateLifecycleAdapter(name string, clusterScoped bool, client *templateClient, l v3.TemplateLifecycle) v3.TemplateHandlerFunc {
+	adapter := &templateLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v3.Template) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+
+type catalogTemplateClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *catalogTemplateClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *catalogTemplateClient) Create(o *v3.CatalogTemplate) (*v3.CatalogTemplate, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.CatalogTemplate), nil
+}
+
+func (s *catalogTemplateClient) Get(name string, opts metav1.GetOptions) (*v3.CatalogTemplate, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.CatalogTemplate), nil
+}
+
+func (s *catalogTemplateClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.CatalogTemplate, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.CatalogTemplate), nil
+}
+
+func (s *catalogTemplateClient) Update(o *v3.CatalogTemplate) (*v3.CatalogTemplate, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.CatalogTemplate), nil
+}
+
+func (s *catalogTemplateClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *catalogTemplateClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *catalogTemplateClient) List(opts metav1.ListOptions) (*v3.CatalogTemplateList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v3.CatalogTemplateList{}
+	list.APIVersion = v3.CatalogTemplateGroupVersionKind.GroupVersion().String()
+	list.Kind = v3.CatalogTemplateGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v3.CatalogTemplate))
+	}
+	return list, nil
+}
+
+func (s *catalogTemplateClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *catalogTemplateClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *catalogTemplateClient) Controller() v3.CatalogTemplateController {
+	return &catalogTemplateController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *catalogTemplateClient) AddHandler(ctx context.Context, name string, sync v3.CatalogTemplateHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *catalogTemplateClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v3.CatalogTemplateHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *catalogTemplateClient) AddLifecycle(ctx context.Context, name string, lifecycle v3.CatalogTemplateLifecycle) {
+	sync := newCatalogTemplateLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *catalogTemplateClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v3.CatalogTemplateLifecycle) {
+	sync := newCatalogTemplateLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *catalogTemplateClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v3.CatalogTemplateHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *catalogTemplateClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v3.CatalogTemplateHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *catalogTemplateClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v3.CatalogTemplateLifecycle) {
+	sync := newCatalogTemplateLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *catalogTemplateClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v3.CatalogTemplateLifecycle) {
+	sync := newCatalogTemplateLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type catalogTemplateController struct {
+	controller.GenericController
+}
+
+func (c *catalogTemplateController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *catalogTemplateController) Lister() v3.CatalogTemplateLister {
+	return &catalogTemplateLister{
+		controller: c,
+	}
+}
+
+func (c *catalogTemplateController) AddHandler(ctx context.Context, name string, handler v3.CatalogTemplateHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.CatalogTemplate); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *catalogTemplateController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v3.CatalogTemplateHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.CatalogTemplate); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *catalogTemplateController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v3.CatalogTemplateHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.CatalogTemplate); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *catalogTemplateController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v3.CatalogTemplateHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.CatalogTemplate); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type catalogTemplateLister struct {
+	controller *catalogTemplateController
+}
+
+func (l *catalogTemplateLister) List(namespace string, selector labels.Selector) (ret []*v3.CatalogTemplate, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v3.CatalogTemplate))
+	})
+	return
+}
+
+func (l *catalogTemplateLister) Get(namespace, name string) (*v3.CatalogTemplate, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v3.CatalogTemplateGroupVersionKind.Group,
+			Resource: "catalogTemplate",
+		}, key)
+	}
+	return obj.(*v3.CatalogTemplate), nil
+}
+
+type catalogTemplateLifecycleAdapter struct {
+	lifecycle v3.CatalogTemplateLifecycle
+}
+
+func (w *catalogTemplateLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *catalogTemplateLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *catalogTemplateLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v3.CatalogTemplate))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *catalogTemplateLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v3.CatalogTemplate))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *catalogTemplateLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v3.CatalogTemplate))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newCatalogTemplateLifecycleAdapter(name string, clusterScoped bool, client *catalogTemplateClient, l v3.CatalogTemplateLifecycle) v3.CatalogTemplateHandlerFunc {
+	adapter := &catalogTemplateLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v3.CatalogTemplate) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+
+type catalogTemplateVersionClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *catalogTemplateVersionClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *catalogTemplateVersionClient) Create(o *v3.CatalogTemplateVersion) (*v3.CatalogTemplateVersion, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.CatalogTemplateVersion), nil
+}
+
+func (s *catalogTemplateVersionClient) Get(name string, opts metav1.GetOptions) (*v3.CatalogTemplateVersion, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.CatalogTemplateVersion), nil
+}
+
+func (s *catalogTemplateVersionClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.CatalogTemplateVersion, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.CatalogTemplateVersion), nil
+}
+
+func (s *catalogTemplateVersionClient) Update(o *v3.CatalogTemplateVersion) (*v3.CatalogTemplateVersion, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.CatalogTemplateVersion), nil
+}
+
+func (s *catalogTemplateVersionClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *catalogTemplateVersionClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *catalogTemplateVersionClient) List(opts metav1.ListOptions) (*v3.CatalogTemplateVersionList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v3.CatalogTemplateVersionList{}
+	list.APIVersion = v3.CatalogTemplateVersionGroupVersionKind.GroupVersion().String()
+	list.Kind = v3.CatalogTemplateVersionGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v3.CatalogTemplateVersion))
+	}
+	return list, nil
+}
+
+func (s *catalogTemplateVersionClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *catalogTemplateVersionClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *catalogTemplateVersionClient) Controller() v3.CatalogTemplateVersionController {
+	return &catalogTemplateVersionController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *catalogTemplateVersionClient) AddHandler(ctx context.Context, name string, sync v3.CatalogTemplateVersionHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *catalogTemplateVersionClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v3.CatalogTemplateVersionHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *catalogTemplateVersionClient) AddLifecycle(ctx context.Context, name string, lifecycle v3.CatalogTemplateVersionLifecycle) {
+	sync := newCatalogTemplateVersionLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *catalogTemplateVersionClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v3.CatalogTemplateVersionLifecycle) {
+	sync := newCatalogTemplateVersionLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *catalogTemplateVersionClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v3.CatalogTemplateVersionHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *catalogTemplateVersionClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v3.CatalogTemplateVersionHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *catalogTemplateVersionClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v3.CatalogTemplateVersionLifecycle) {
+	sync := newCatalogTemplateVersionLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *catalogTemplateVersionClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v3.CatalogTemplateVersionLifecycle) {
+	sync := newCatalogTemplateVersionLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type catalogTemplateVersionController struct {
+	controller.GenericController
+}
+
+func (c *catalogTemplateVersionController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *catalogTemplateVersionController) Lister() v3.CatalogTemplateVersionLister {
+	return &catalogTemplateVersionLister{
+		controller: c,
+	}
+}
+
+func (c *catalogTemplateVersionController) AddHandler(ctx context.Context, name string, handler v3.CatalogTemplateVersionHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.CatalogTemplateVersion); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *catalogTemplateVersionController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v3.CatalogTemplateVersionHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.CatalogTemplateVersion); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *catalogTemplateVersionController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v3.CatalogTemplateVersionHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.CatalogTemplateVersion); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *catalogTemplateVersionController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v3.CatalogTemplateVersionHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.CatalogTemplateVersion); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type catalogTemplateVersionLister struct {
+	controller *catalogTemplateVersionController
+}
+
+func (l *catalogTemplateVersionLister) List(namespace string, selector labels.Selector) (ret []*v3.CatalogTemplateVersion, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v3.CatalogTemplateVersion))
+	})
+	return
+}
+
+func (l *catalogTemplateVersionLister) Get(namespace, name string) (*v3.CatalogTemplateVersion, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v3.CatalogTemplateVersionGroupVersionKind.Group,
+			Resource: "catalogTemplateVersion",
+		}, key)
+	}
+	return obj.(*v3.CatalogTemplateVersion), nil
+}
+
+type catalogTemplateVersionLifecycleAdapter struct {
+	lifecycle v3.CatalogTemplateVersionLifecycle
+}
+
+func (w *catalogTemplateVersionLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *catalogTemplateVersionLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *catalogTemplateVersionLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v3.CatalogTemplateVersion))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *catalogTemplateVersionLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v3.CatalogTemplateVersion))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *catalogTemplateVersionLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v3.CatalogTemplateVersion))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newCatalogTemplateVersionLifecycleAdapter(name string, clusterScoped bool, client *catalogTemplateVersionClient, l v3.CatalogTemplateVersionLifecycle) v3.CatalogTemplateVersionHandlerFunc {
+	adapter := &catalogTemplateVersionLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v3.CatalogTemplateVersion) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+
+type templateVersionClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *templateVersionClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *templateVersionClient) Create(o *v3.TemplateVersion) (*v3.TemplateVersion, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.TemplateVersion), nil
+}
+
+func (s *templateVersionClient) Get(name string, opts metav1.GetOptions) (*v3.TemplateVersion, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.TemplateVersion), nil
+}
+
+func (s *templateVersionClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.TemplateVersion, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.TemplateVersion), nil
+}
+
+func (s *templateVersionClient) Update(o *v3.TemplateVersion) (*v3.TemplateVersion, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.TemplateVersion), nil
+}
+
+func (s *templateVersionClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *templateVersionClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *templateVersionClient) List(opts metav1.ListOptions) (*v3.TemplateVersionList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v3.TemplateVersionList{}
+	list.APIVersion = v3.TemplateVersionGroupVersionKind.GroupVersion().String()
+	list.Kind = v3.TemplateVersionGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v3.TemplateVersion))
+	}
+	return list, nil
+}
+
+func (s *templateVersionClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *templateVersionClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *templateVersionClient) Controller() v3.TemplateVersionController {
+	return &templateVersionController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *templateVersionClient) AddHandler(ctx context.Context, name string, sync v3.TemplateVersionHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *templateVersionClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v3.TemplateVersionHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *templateVersionClient) AddLifecycle(ctx context.Context, name string, lifecycle v3.TemplateVersionLifecycle) {
+	sync := newTemplateVersionLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *templateVersionClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v3.TemplateVersionLifecycle) {
+	sync := newTemplateVersionLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *templateVersionClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v3.TemplateVersionHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *templateVersionClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v3.TemplateVersionHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *templateVersionClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v3.TemplateVersionLifecycle) {
+	sync := newTemplateVersionLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *templateVersionClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v3.TemplateVersionLifecycle) {
+	sync := newTemplateVersionLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type templateVersionController struct {
+	controller.GenericController
+}
+
+func (c *templateVersionController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *templateVersionController) Lister() v3.TemplateVersionLister {
+	return &templateVersionLister{
+		controller: c,
+	}
+}
+
+func (c *templateVersionController) AddHandler(ctx context.Context, name string, handler v3.TemplateVersionHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.TemplateVersion); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *templateVersionController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v3.TemplateVersionHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.TemplateVersion); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *templateVersionController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v3.TemplateVersionHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.TemplateVersion); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *templateVersionController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v3.TemplateVersionHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.TemplateVersion); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type templateVersionLister struct {
+	controller *templateVersionController
+}
+
+func (l *templateVersionLister) List(namespace string, selector labels.Selector) (ret []*v3.TemplateVersion, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v3.TemplateVersion))
+	})
+	return
+}
+
+func (l *templateVersionLister) Get(namespace, name string) (*v3.TemplateVersion, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v3.TemplateVersionGroupVersionKind.Group,
+			Resource: "templateVersion",
+		}, key)
+	}
+	return obj.(*v3.TemplateVersion), nil
+}
+
+type templateVersionLifecycleAdapter struct {
+	lifecycle v3.TemplateVersionLifecycle
+}
+
+func (w *templateVersionLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *templateVersionLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *templateVersionLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v3.TemplateVersion))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *templateVersionLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v3.TemplateVersion))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *templateVersionLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v3.TemplateVersion))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newTemplateVersionLifecycleAdapter(name string, clusterScoped bool, client *templateVersionClient, l v3.TemplateVersionLifecycle) v3.TemplateVersionHandlerFunc {
+	adapter := &templateVersionLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v3.TemplateVersion) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+
+type templateContentClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *templateContentClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *templateContentClient) Create(o *v3.TemplateContent) (*v3.TemplateContent, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.TemplateContent), nil
+}
+
+func (s *templateContentClient) Get(name string, opts metav1.GetOptions) (*v3.TemplateContent, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.TemplateContent), nil
+}
+
+func (s *templateContentClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.TemplateContent, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.TemplateContent), nil
+}
+
+func (s *templateContentClient) Update(o *v3.TemplateContent) (*v3.TemplateContent, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.TemplateContent), nil
+}
+
+func (s *templateContentClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *templateContentClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *templateContentClient) List(opts metav1.ListOptions) (*v3.TemplateContentList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v3.TemplateContentList{}
+	list.APIVersion = v3.TemplateContentGroupVersionKind.GroupVersion().String()
+	list.Kind = v3.TemplateContentGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v3.TemplateContent))
+	}
+	return list, nil
+}
+
+func (s *templateContentClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *templateContentClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *templateContentClient) Controller() v3.TemplateContentController {
+	return &templateContentController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *templateContentClient) AddHandler(ctx context.Context, name string, sync v3.TemplateContentHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *templateContentClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v3.TemplateContentHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *templateContentClient) AddLifecycle(ctx context.Context, name string, lifecycle v3.TemplateContentLifecycle) {
+	sync := newTemplateContentLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *templateContentClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v3.TemplateContentLifecycle) {
+	sync := newTemplateContentLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *templateContentClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v3.TemplateContentHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *templateContentClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v3.TemplateContentHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *templateContentClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v3.TemplateContentLifecycle) {
+	sync := newTemplateContentLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *templateContentClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v3.TemplateContentLifecycle) {
+	sync := newTemplateContentLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type templateContentController struct {
+	controller.GenericController
+}
+
+func (c *templateContentController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *templateContentController) Lister() v3.TemplateContentLister {
+	return &templateContentLister{
+		controller: c,
+	}
+}
+
+func (c *templateContentController) AddHandler(ctx context.Context, name string, handler v3.TemplateContentHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.TemplateContent); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *templateContentController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v3.TemplateContentHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.TemplateContent); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *templateContentController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v3.TemplateContentHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.TemplateContent); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *templateContentController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v3.TemplateContentHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.TemplateContent); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type templateContentLister struct {
+	controller *templateContentController
+}
+
+func (l *templateContentLister) List(namespace string, selector labels.Selector) (ret []*v3.TemplateContent, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v3.TemplateContent))
+	})
+	return
+}
+
+func (l *templateContentLister) Get(namespace, name string) (*v3.TemplateContent, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v3.TemplateContentGroupVersionKind.Group,
+			Resource: "templateContent",
+		}, key)
+	}
+	return obj.(*v3.TemplateContent), nil
+}
+
+type templateContentLifecycleAdapter struct {
+	lifecycle v3.TemplateContentLifecycle
+}
+
+func (w *templateContentLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *templateContentLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *templateContentLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v3.TemplateContent))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *templateContentLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v3.TemplateContent))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *templateContentLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v3.TemplateContent))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newTemplateContentLifecycleAdapter(name string, clusterScoped bool, client *templateContentClient, l v3.TemplateContentLifecycle) v3.TemplateContentHandlerFunc {
+	adapter := &templateContentLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v3.TemplateContent) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+
+type groupClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *groupClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *groupClient) Create(o *v3.Group) (*v3.Group, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.Group), nil
+}
+
+func (s *groupClient) Get(name string, opts metav1.GetOptions) (*v3.Group, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.Group), nil
+}
+
+func (s *groupClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.Group, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.Group), nil
+}
+
+func (s *groupClient) Update(o *v3.Group) (*v3.Group, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.Group), nil
+}
+
+func (s *groupClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *groupClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *groupClient) List(opts metav1.ListOptions) (*v3.GroupList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v3.GroupList{}
+	list.APIVersion = v3.GroupGroupVersionKind.GroupVersion().String()
+	list.Kind = v3.GroupGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v3.Group))
+	}
+	return list, nil
+}
+
+func (s *groupClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *groupClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *groupClient) Controller() v3.GroupController {
+	return &groupController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *groupClient) AddHandler(ctx context.Context, name string, sync v3.GroupHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *groupClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v3.GroupHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *groupClient) AddLifecycle(ctx context.Context, name string, lifecycle v3.GroupLifecycle) {
+	sync := newGroupLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *groupClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v3.GroupLifecycle) {
+	sync := newGroupLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *groupClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v3.GroupHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *groupClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v3.GroupHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *groupClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v3.GroupLifecycle) {
+	sync := newGroupLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *groupClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v3.GroupLifecycle) {
+	sync := newGroupLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type groupController struct {
+	controller.GenericController
+}
+
+func (c *groupController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *groupController) Lister() v3.GroupLister {
+	return &groupLister{
+		controller: c,
+	}
+}
+
+func (c *groupController) AddHandler(ctx context.Context, name string, handler v3.GroupHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.Group); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *groupController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v3.GroupHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.Group); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *groupController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v3.GroupHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.Group); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *groupController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v3.GroupHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.Group); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type groupLister struct {
+	controller *groupController
+}
+
+func (l *groupLister) List(namespace string, selector labels.Selector) (ret []*v3.Group, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v3.Group))
+	})
+	return
+}
+
+func (l *groupLister) Get(namespace, name string) (*v3.Group, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v3.GroupGroupVersionKind.Group,
+			Resource: "group",
+		}, key)
+	}
+	return obj.(*v3.Group), nil
+}
+
+type groupLifecycleAdapter struct {
+	lifecycle v3.GroupLifecycle
+}
+
+func (w *groupLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *groupLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *groupLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v3.Group))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *groupLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v3.Group))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *groupLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v3.Group))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newGroupLifecycleAdapter(name string, clusterScoped bool, client *groupClient, l v3.GroupLifecycle) v3.GroupHandlerFunc {
+	adapter := &groupLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v3.Group) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+
+type groupMemberClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *groupMemberClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *groupMemberClient) Create(o *v3.GroupMember) (*v3.GroupMember, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.GroupMember), nil
+}
+
+func (s *groupMemberClient) Get(name string, opts metav1.GetOptions) (*v3.GroupMember, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.GroupMember), nil
+}
+
+func (s *groupMemberClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.GroupMember, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.GroupMember), nil
+}
+
+func (s *groupMemberClient) Update(o *v3.GroupMember) (*v3.GroupMember, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.GroupMember), nil
+}
+
+func (s *groupMemberClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *groupMemberClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *groupMemberClient) List(opts metav1.ListOptions) (*v3.GroupMemberList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v3.GroupMemberList{}
+	list.APIVersion = v3.GroupMemberGroupVersionKind.GroupVersion().String()
+	list.Kind = v3.GroupMemberGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v3.GroupMember))
+	}
+	return list, nil
+}
+
+func (s *groupMemberClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *groupMemberClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *groupMemberClient) Controller() v3.GroupMemberController {
+	return &groupMemberController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *groupMemberClient) AddHandler(ctx context.Context, name string, sync v3.GroupMemberHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *groupMemberClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v3.GroupMemberHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *groupMemberClient) AddLifecycle(ctx context.Context, name string, lifecycle v3.GroupMemberLifecycle) {
+	sync := newGroupMemberLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *groupMemberClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v3.GroupMemberLifecycle) {
+	sync := newGroupMemberLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *groupMemberClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v3.GroupMemberHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *groupMemberClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v3.GroupMemberHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *groupMemberClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v3.GroupMemberLifecycle) {
+	sync := newGroupMemberLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *groupMemberClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v3.GroupMemberLifecycle) {
+	sync := newGroupMemberLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type groupMemberController struct {
+	controller.GenericController
+}
+
+func (c *groupMemberController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *groupMemberController) Lister() v3.GroupMemberLister {
+	return &groupMemberLister{
+		controller: c,
+	}
+}
+
+func (c *groupMemberController) AddHandler(ctx context.Context, name string, handler v3.GroupMemberHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.GroupMember); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *groupMemberController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v3.GroupMemberHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.GroupMember); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *groupMemberController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v3.GroupMemberHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.GroupMember); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *groupMemberController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v3.GroupMemberHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.GroupMember); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type groupMemberLister struct {
+	controller *groupMemberController
+}
+
+func (l *groupMemberLister) List(namespace string, selector labels.Selector) (ret []*v3.GroupMember, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v3.GroupMember))
+	})
+	return
+}
+
+func (l *groupMemberLister) Get(namespace, name string) (*v3.GroupMember, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v3.GroupMemberGroupVersionKind.Group,
+			Resource: "groupMember",
+		}, key)
+	}
+	return obj.(*v3.GroupMember), nil
+}
+
+type groupMemberLifecycleAdapter struct {
+	lifecycle v3.GroupMemberLifecycle
+}
+
+func (w *groupMemberLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *groupMemberLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *groupMemberLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v3.GroupMember))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *groupMemberLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v3.GroupMember))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *groupMemberLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v3.GroupMember))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newGroupMemberLifecycleAdapter(name string, clusterScoped bool, client *groupMemberClient, l v3.GroupMemberLifecycle) v3.GroupMemberHandlerFunc {
+	adapter := &groupMemberLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v3.GroupMember) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+
+type principalClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *principalClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *principalClient) Create(o *v3.Principal) (*v3.Principal, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.Principal), nil
+}
+
+func (s *principalClient) Get(name string, opts metav1.GetOptions) (*v3.Principal, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.Principal), nil
+}
+
+func (s *principalClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.Principal, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.Principal), nil
+}
+
+func (s *principalClient) Update(o *v3.Principal) (*v3.Principal, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.Principal), nil
+}
+
+func (s *principalClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *principalClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *principalClient) List(opts metav1.ListOptions) (*v3.PrincipalList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v3.PrincipalList{}
+	list.APIVersion = v3.PrincipalGroupVersionKind.GroupVersion().String()
+	list.Kind = v3.PrincipalGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v3.Principal))
+	}
+	return list, nil
+}
+
+func (s *principalClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *principalClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *principalClient) Controller() v3.PrincipalController {
+	return &principalController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *principalClient) AddHandler(ctx context.Context, name string, sync v3.PrincipalHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *principalClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v3.PrincipalHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *principalClient) AddLifecycle(ctx context.Context, name string, lifecycle v3.PrincipalLifecycle) {
+	sync := newPrincipalLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *principalClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v3.PrincipalLifecycle) {
+	sync := newPrincipalLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *principalClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v3.PrincipalHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *principalClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v3.PrincipalHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *principalClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v3.PrincipalLifecycle) {
+	sync := newPrincipalLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *principalClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v3.PrincipalLifecycle) {
+	sync := newPrincipalLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type principalController struct {
+	controller.GenericController
+}
+
+func (c *principalController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *principalController) Lister() v3.PrincipalLister {
+	return &principalLister{
+		controller: c,
+	}
+}
+
+func (c *principalController) AddHandler(ctx context.Context, name string, handler v3.PrincipalHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.Principal); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *principalController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v3.PrincipalHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.Principal); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *principalController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v3.PrincipalHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.Principal); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *principalController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v3.PrincipalHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.Principal); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type principalLister struct {
+	controller *principalController
+}
+
+func (l *principalLister) List(namespace string, selector labels.Selector) (ret []*v3.Principal, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v3.Principal))
+	})
+	return
+}
+
+func (l *principalLister) Get(namespace, name string) (*v3.Principal, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v3.PrincipalGroupVersionKind.Group,
+			Resource: "principal",
+		}, key)
+	}
+	return obj.(*v3.Principal), nil
+}
+
+type principalLifecycleAdapter struct {
+	lifecycle v3.PrincipalLifecycle
+}
+
+func (w *principalLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *principalLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *principalLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v3.Principal))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *principalLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v3.Principal))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *principalLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v3.Principal))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newPrincipalLifecycleAdapter(name string, clusterScoped bool, client *principalClient, l v3.PrincipalLifecycle) v3.PrincipalHandlerFunc {
+	adapter := &principalLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v3.Principal) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+
+type userClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *userClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *userClient) Create(o *v3.User) (*v3.User, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.User), nil
+}
+
+func (s *userClient) Get(name string, opts metav1.GetOptions) (*v3.User, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.User), nil
+}
+
+func (s *userClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.User, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.User), nil
+}
+
+func (s *userClient) Update(o *v3.User) (*v3.User, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.User), nil
+}
+
+func (s *userClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *userClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *userClient) List(opts metav1.ListOptions) (*v3.UserList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v3.UserList{}
+	list.APIVersion = v3.UserGroupVersionKind.GroupVersion().String()
+	list.Kind = v3.UserGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v3.User))
+	}
+	return list, nil
+}
+
+func (s *userClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *userClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *userClient) Controller() v3.UserController {
+	return &userController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *userClient) AddHandler(ctx context.Context, name string, sync v3.UserHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *userClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v3.UserHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *userClient) AddLifecycle(ctx context.Context, name string, lifecycle v3.UserLifecycle) {
+	sync := newUserLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *userClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v3.UserLifecycle) {
+	sync := newUserLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *userClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v3.UserHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *userClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v3.UserHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *userClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v3.UserLifecycle) {
+	sync := newUserLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *userClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v3.UserLifecycle) {
+	sync := newUserLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type userController struct {
+	controller.GenericController
+}
+
+func (c *userController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *userController) Lister() v3.UserLister {
+	return &userLister{
+		controller: c,
+	}
+}
+
+func (c *userController) AddHandler(ctx context.Context, name string, handler v3.UserHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.User); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *userController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v3.UserHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.User); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *userController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v3.UserHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.User); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *userController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v3.UserHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.User); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type userLister struct {
+	controller *userController
+}
+
+func (l *userLister) List(namespace string, selector labels.Selector) (ret []*v3.User, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v3.User))
+	})
+	return
+}
+
+func (l *userLister) Get(namespace, name string) (*v3.User, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v3.UserGroupVersionKind.Group,
+			Resource: "user",
+		}, key)
+	}
+	return obj.(*v3.User), nil
+}
+
+type userLifecycleAdapter struct {
+	lifecycle v3.UserLifecycle
+}
+
+func (w *userLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *userLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *userLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v3.User))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *userLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v3.User))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *userLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v3.User))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newUserLifecycleAdapter(name string, clusterScoped bool, client *userClient, l v3.UserLifecycle) v3.UserHandlerFunc {
+	adapter := &userLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v3.User) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+
+type authConfigClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *authConfigClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *authConfigClient) Create(o *v3.AuthConfig) (*v3.AuthConfig, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.AuthConfig), nil
+}
+
+func (s *authConfigClient) Get(name string, opts metav1.GetOptions) (*v3.AuthConfig, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.AuthConfig), nil
+}
+
+func (s *authConfigClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.AuthConfig, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.AuthConfig), nil
+}
+
+func (s *authConfigClient) Update(o *v3.AuthConfig) (*v3.AuthConfig, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.AuthConfig), nil
+}
+
+func (s *authConfigClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *authConfigClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *authConfigClient) List(opts metav1.ListOptions) (*v3.AuthConfigList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v3.AuthConfigList{}
+	list.APIVersion = v3.AuthConfigGroupVersionKind.GroupVersion().String()
+	list.Kind = v3.AuthConfigGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v3.AuthConfig))
+	}
+	return list, nil
+}
+
+func (s *authConfigClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *authConfigClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *authConfigClient) Controller() v3.AuthConfigController {
+	return &authConfigController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *authConfigClient) AddHandler(ctx context.Context, name string, sync v3.AuthConfigHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *authConfigClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v3.AuthConfigHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *authConfigClient) AddLifecycle(ctx context.Context, name string, lifecycle v3.AuthConfigLifecycle) {
+	sync := newAuthConfigLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *authConfigClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v3.AuthConfigLifecycle) {
+	sync := newAuthConfigLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *authConfigClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v3.AuthConfigHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *authConfigClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v3.AuthConfigHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *authConfigClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v3.AuthConfigLifecycle) {
+	sync := newAuthConfigLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *authConfigClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v3.AuthConfigLifecycle) {
+	sync := newAuthConfigLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type authConfigController struct {
+	controller.GenericController
+}
+
+func (c *authConfigController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *authConfigController) Lister() v3.AuthConfigLister {
+	return &authConfigLister{
+		controller: c,
+	}
+}
+
+func (c *authConfigController) AddHandler(ctx context.Context, name string, handler v3.AuthConfigHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.AuthConfig); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *authConfigController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v3.AuthConfigHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.AuthConfig); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *authConfigController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v3.AuthConfigHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.AuthConfig); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *authConfigController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v3.AuthConfigHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.AuthConfig); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type authConfigLister struct {
+	controller *authConfigController
+}
+
+func (l *authConfigLister) List(namespace string, selector labels.Selector) (ret []*v3.AuthConfig, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v3.AuthConfig))
+	})
+	return
+}
+
+func (l *authConfigLister) Get(namespace, name string) (*v3.AuthConfig, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v3.AuthConfigGroupVersionKind.Group,
+			Resource: "authConfig",
+		}, key)
+	}
+	return obj.(*v3.AuthConfig), nil
+}
+
+type authConfigLifecycleAdapter struct {
+	lifecycle v3.AuthConfigLifecycle
+}
+
+func (w *authConfigLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *authConfigLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *authConfigLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v3.AuthConfig))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *authConfigLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v3.AuthConfig))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *authConfigLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v3.AuthConfig))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newAuthConfigLifecycleAdapter(name string, clusterScoped bool, client *authConfigClient, l v3.AuthConfigLifecycle) v3.AuthConfigHandlerFunc {
+	adapter := &authConfigLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v3.AuthConfig) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+
+type ldapConfigClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *ldapConfigClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *ldapConfigClient) Create(o *v3.LdapConfig) (*v3.LdapConfig, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.LdapConfig), nil
+}
+
+func (s *ldapConfigClient) Get(name string, opts metav1.GetOptions) (*v3.LdapConfig, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.LdapConfig), nil
+}
+
+func (s *ldapConfigClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.LdapConfig, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.LdapConfig), nil
+}
+
+func (s *ldapConfigClient) Update(o *v3.LdapConfig) (*v3.LdapConfig, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.LdapConfig), nil
+}
+
+func (s *ldapConfigClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *ldapConfigClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *ldapConfigClient) List(opts metav1.ListOptions) (*v3.LdapConfigList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v3.LdapConfigList{}
+	list.APIVersion = v3.LdapConfigGroupVersionKind.GroupVersion().String()
+	list.Kind = v3.LdapConfigGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v3.LdapConfig))
+	}
+	return list, nil
+}
+
+func (s *ldapConfigClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *ldapConfigClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *ldapConfigClient) Controller() v3.LdapConfigController {
+	return &ldapConfigController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *ldapConfigClient) AddHandler(ctx context.Context, name string, sync v3.LdapConfigHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *ldapConfigClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v3.LdapConfigHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *ldapConfigClient) AddLifecycle(ctx context.Context, name string, lifecycle v3.LdapConfigLifecycle) {
+	sync := newLdapConfigLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *ldapConfigClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v3.LdapConfigLifecycle) {
+	sync := newLdapConfigLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *ldapConfigClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v3.LdapConfigHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *ldapConfigClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v3.LdapConfigHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *ldapConfigClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v3.LdapConfigLifecycle) {
+	sync := newLdapConfigLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *ldapConfigClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v3.LdapConfigLifecycle) {
+	sync := newLdapConfigLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type ldapConfigController struct {
+	controller.GenericController
+}
+
+func (c *ldapConfigController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *ldapConfigController) Lister() v3.LdapConfigLister {
+	return &ldapConfigLister{
+		controller: c,
+	}
+}
+
+func (c *ldapConfigController) AddHandler(ctx context.Context, name string, handler v3.LdapConfigHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.LdapConfig); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *ldapConfigController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v3.LdapConfigHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.LdapConfig); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *ldapConfigController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v3.LdapConfigHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.LdapConfig); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *ldapConfigController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v3.LdapConfigHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.LdapConfig); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type ldapConfigLister struct {
+	controller *ldapConfigController
+}
+
+func (l *ldapConfigLister) List(namespace string, selector labels.Selector) (ret []*v3.LdapConfig, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v3.LdapConfig))
+	})
+	return
+}
+
+func (l *ldapConfigLister) Get(namespace, name string) (*v3.LdapConfig, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v3.LdapConfigGroupVersionKind.Group,
+			Resource: "ldapConfig",
+		}, key)
+	}
+	return obj.(*v3.LdapConfig), nil
+}
+
+type ldapConfigLifecycleAdapter struct {
+	lifecycle v3.LdapConfigLifecycle
+}
+
+func (w *ldapConfigLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *ldapConfigLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *ldapConfigLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v3.LdapConfig))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *ldapConfigLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v3.LdapConfig))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *ldapConfigLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v3.LdapConfig))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newLdapConfigLifecycleAdapter(name string, clusterScoped bool, client *ldapConfigClient, l v3.LdapConfigLifecycle) v3.LdapConfigHandlerFunc {
+	adapter := &ldapConfigLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v3.LdapConfig) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+
+type tokenClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *tokenClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *tokenClient) Create(o *v3.Token) (*v3.Token, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.Token), nil
+}
+
+func (s *tokenClient) Get(name string, opts metav1.GetOptions) (*v3.Token, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.Token), nil
+}
+
+func (s *tokenClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.Token, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.Token), nil
+}
+
+func (s *tokenClient) Update(o *v3.Token) (*v3.Token, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.Token), nil
+}
+
+func (s *tokenClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *tokenClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *tokenClient) List(opts metav1.ListOptions) (*v3.TokenList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v3.TokenList{}
+	list.APIVersion = v3.TokenGroupVersionKind.GroupVersion().String()
+	list.Kind = v3.TokenGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v3.Token))
+	}
+	return list, nil
+}
+
+func (s *tokenClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *tokenClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *tokenClient) Controller() v3.TokenController {
+	return &tokenController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *tokenClient) AddHandler(ctx context.Context, name string, sync v3.TokenHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *tokenClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v3.TokenHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *tokenClient) AddLifecycle(ctx context.Context, name string, lifecycle v3.TokenLifecycle) {
+	sync := newTokenLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *tokenClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v3.TokenLifecycle) {
+	sync := newTokenLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *tokenClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v3.TokenHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *tokenClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v3.TokenHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *tokenClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v3.TokenLifecycle) {
+	sync := newTokenLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *tokenClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v3.TokenLifecycle) {
+	sync := newTokenLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type tokenController struct {
+	controller.GenericController
+}
+
+func (c *tokenController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *tokenController) Lister() v3.TokenLister {
+	return &tokenLister{
+		controller: c,
+	}
+}
+
+func (c *tokenController) AddHandler(ctx context.Context, name string, handler v3.TokenHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.Token); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *tokenController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v3.TokenHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.Token); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *tokenController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v3.TokenHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.Token); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *tokenController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v3.TokenHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.Token); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type tokenLister struct {
+	controller *tokenController
+}
+
+func (l *tokenLister) List(namespace string, selector labels.Selector) (ret []*v3.Token, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v3.Token))
+	})
+	return
+}
+
+func (l *tokenLister) Get(namespace, name string) (*v3.Token, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v3.TokenGroupVersionKind.Group,
+			Resource: "token",
+		}, key)
+	}
+	return obj.(*v3.Token), nil
+}
+
+type tokenLifecycleAdapter struct {
+	lifecycle v3.TokenLifecycle
+}
+
+func (w *tokenLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *tokenLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *tokenLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v3.Token))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *tokenLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v3.Token))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *tokenLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v3.Token))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newTokenLifecycleAdapter(name string, clusterScoped bool, client *tokenClient, l v3.TokenLifecycle) v3.TokenHandlerFunc {
+	adapter := &tokenLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v3.Token) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+
+type dynamicSchemaClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *dynamicSchemaClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *dynamicSchemaClient) Create(o *v3.DynamicSchema) (*v3.DynamicSchema, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.DynamicSchema), nil
+}
+
+func (s *dynamicSchemaClient) Get(name string, opts metav1.GetOptions) (*v3.DynamicSchema, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.DynamicSchema), nil
+}
+
+func (s *dynamicSchemaClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.DynamicSchema, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.DynamicSchema), nil
+}
+
+func (s *dynamicSchemaClient) Update(o *v3.DynamicSchema) (*v3.DynamicSchema, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.DynamicSchema), nil
+}
+
+func (s *dynamicSchemaClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *dynamicSchemaClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *dynamicSchemaClient) List(opts metav1.ListOptions) (*v3.DynamicSchemaList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v3.DynamicSchemaList{}
+	list.APIVersion = v3.DynamicSchemaGroupVersionKind.GroupVersion().String()
+	list.Kind = v3.DynamicSchemaGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v3.DynamicSchema))
+	}
+	return list, nil
+}
+
+func (s *dynamicSchemaClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *dynamicSchemaClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *dynamicSchemaClient) Controller() v3.DynamicSchemaController {
+	return &dynamicSchemaController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *dynamicSchemaClient) AddHandler(ctx context.Context, name string, sync v3.DynamicSchemaHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *dynamicSchemaClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v3.DynamicSchemaHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *dynamicSchemaClient) AddLifecycle(ctx context.Context, name string, lifecycle v3.DynamicSchemaLifecycle) {
+	sync := newDynamicSchemaLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *dynamicSchemaClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v3.DynamicSchemaLifecycle) {
+	sync := newDynamicSchemaLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *dynamicSchemaClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v3.DynamicSchemaHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *dynamicSchemaClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v3.DynamicSchemaHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *dynamicSchemaClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v3.DynamicSchemaLifecycle) {
+	sync := newDynamicSchemaLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *dynamicSchemaClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v3.DynamicSchemaLifecycle) {
+	sync := newDynamicSchemaLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type dynamicSchemaController struct {
+	controller.GenericController
+}
+
+func (c *dynamicSchemaController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *dynamicSchemaController) Lister() v3.DynamicSchemaLister {
+	return &dynamicSchemaLister{
+		controller: c,
+	}
+}
+
+func (c *dynamicSchemaController) AddHandler(ctx context.Context, name string, handler v3.DynamicSchemaHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.DynamicSchema); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *dynamicSchemaController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v3.DynamicSchemaHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.DynamicSchema); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *dynamicSchemaController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v3.DynamicSchemaHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.DynamicSchema); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *dynamicSchemaController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v3.DynamicSchemaHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.DynamicSchema); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type dynamicSchemaLister struct {
+	controller *dynamicSchemaController
+}
+
+func (l *dynamicSchemaLister) List(namespace string, selector labels.Selector) (ret []*v3.DynamicSchema, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v3.DynamicSchema))
+	})
+	return
+}
+
+func (l *dynamicSchemaLister) Get(namespace, name string) (*v3.DynamicSchema, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v3.DynamicSchemaGroupVersionKind.Group,
+			Resource: "dynamicSchema",
+		}, key)
+	}
+	return obj.(*v3.DynamicSchema), nil
+}
+
+type dynamicSchemaLifecycleAdapter struct {
+	lifecycle v3.DynamicSchemaLifecycle
+}
+
+func (w *dynamicSchemaLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *dynamicSchemaLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *dynamicSchemaLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v3.DynamicSchema))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *dynamicSchemaLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v3.DynamicSchema))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *dynamicSchemaLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v3.DynamicSchema))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newDynamicSchemaLifecycleAdapter(name string, clusterScoped bool, client *dynamicSchemaClient, l v3.DynamicSchemaLifecycle) v3.DynamicSchemaHandlerFunc {
+	adapter := &dynamicSchemaLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v3.DynamicSchema) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+
+type preferenceClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *preferenceClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *preferenceClient) Create(o *v3.Preference) (*v3.Preference, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.Preference), nil
+}
+
+func (s *preferenceClient) Get(name string, opts metav1.GetOptions) (*v3.Preference, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.Preference), nil
+}
+
+func (s *preferenceClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.Preference, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.Preference), nil
+}
+
+func (s *preferenceClient) Update(o *v3.Preference) (*v3.Preference, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.Preference), nil
+}
+
+func (s *preferenceClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *preferenceClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *preferenceClient) List(opts metav1.ListOptions) (*v3.PreferenceList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v3.PreferenceList{}
+	list.APIVersion = v3.PreferenceGroupVersionKind.GroupVersion().String()
+	list.Kind = v3.PreferenceGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v3.Preference))
+	}
+	return list, nil
+}
+
+func (s *preferenceClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *preferenceClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *preferenceClient) Controller() v3.PreferenceController {
+	return &preferenceController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *preferenceClient) AddHandler(ctx context.Context, name string, sync v3.PreferenceHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *preferenceClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v3.PreferenceHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *preferenceClient) AddLifecycle(ctx context.Context, name string, lifecycle v3.PreferenceLifecycle) {
+	sync := newPreferenceLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *preferenceClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v3.PreferenceLifecycle) {
+	sync := newPreferenceLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *preferenceClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v3.PreferenceHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *preferenceClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v3.PreferenceHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *preferenceClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v3.PreferenceLifecycle) {
+	sync := newPreferenceLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *preferenceClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v3.PreferenceLifecycle) {
+	sync := newPreferenceLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type preferenceController struct {
+	controller.GenericController
+}
+
+func (c *preferenceController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *preferenceController) Lister() v3.PreferenceLister {
+	return &preferenceLister{
+		controller: c,
+	}
+}
+
+func (c *preferenceController) AddHandler(ctx context.Context, name string, handler v3.PreferenceHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.Preference); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *preferenceController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v3.PreferenceHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.Preference); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *preferenceController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v3.PreferenceHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.Preference); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *preferenceController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v3.PreferenceHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.Preference); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type preferenceLister struct {
+	controller *preferenceController
+}
+
+func (l *preferenceLister) List(namespace string, selector labels.Selector) (ret []*v3.Preference, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v3.Preference))
+	})
+	return
+}
+
+func (l *preferenceLister) Get(namespace, name string) (*v3.Preference, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v3.PreferenceGroupVersionKind.Group,
+			Resource: "preference",
+		}, key)
+	}
+	return obj.(*v3.Preference), nil
+}
+
+type preferenceLifecycleAdapter struct {
+	lifecycle v3.PreferenceLifecycle
+}
+
+func (w *preferenceLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *preferenceLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *preferenceLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v3.Preference))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *preferenceLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v3.Preference))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *preferenceLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v3.Preference))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newPreferenceLifecycleAdapter(name string, clusterScoped bool, client *preferenceClient, l v3.PreferenceLifecycle) v3.PreferenceHandlerFunc {
+	adapter := &preferenceLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v3.Preference) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+
+type userAttributeClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *userAttributeClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *userAttributeClient) Create(o *v3.UserAttribute) (*v3.UserAttribute, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.UserAttribute), nil
+}
+
+func (s *userAttributeClient) Get(name string, opts metav1.GetOptions) (*v3.UserAttribute, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.UserAttribute), nil
+}
+
+func (s *userAttributeClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.UserAttribute, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.UserAttribute), nil
+}
+
+func (s *userAttributeClient) Update(o *v3.UserAttribute) (*v3.UserAttribute, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.UserAttribute), nil
+}
+
+func (s *userAttributeClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *userAttributeClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *userAttributeClient) List(opts metav1.ListOptions) (*v3.UserAttributeList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v3.UserAttributeList{}
+	list.APIVersion = v3.UserAttributeGroupVersionKind.GroupVersion().String()
+	list.Kind = v3.UserAttributeGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v3.UserAttribute))
+	}
+	return list, nil
+}
+
+func (s *userAttributeClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *userAttributeClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *userAttributeClient) Controller() v3.UserAttributeController {
+	return &userAttributeController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *userAttributeClient) AddHandler(ctx context.Context, name string, sync v3.UserAttributeHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *userAttributeClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v3.UserAttributeHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *userAttributeClient) AddLifecycle(ctx context.Context, name string, lifecycle v3.UserAttributeLifecycle) {
+	sync := newUserAttributeLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *userAttributeClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v3.UserAttributeLifecycle) {
+	sync := newUserAttributeLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *userAttributeClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v3.UserAttributeHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *userAttributeClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v3.UserAttributeHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *userAttributeClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v3.UserAttributeLifecycle) {
+	sync := newUserAttributeLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *userAttributeClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v3.UserAttributeLifecycle) {
+	sync := newUserAttributeLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type userAttributeController struct {
+	controller.GenericController
+}
+
+func (c *userAttributeController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *userAttributeController) Lister() v3.UserAttributeLister {
+	return &userAttributeLister{
+		controller: c,
+	}
+}
+
+func (c *userAttributeController) AddHandler(ctx context.Context, name string, handler v3.UserAttributeHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.UserAttribute); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *userAttributeController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v3.UserAttributeHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.UserAttribute); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *userAttributeController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v3.UserAttributeHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.UserAttribute); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *userAttributeController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v3.UserAttributeHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.UserAttribute); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type userAttributeLister struct {
+	controller *userAttributeController
+}
+
+func (l *userAttributeLister) List(namespace string, selector labels.Selector) (ret []*v3.UserAttribute, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v3.UserAttribute))
+	})
+	return
+}
+
+func (l *userAttributeLister) Get(namespace, name string) (*v3.UserAttribute, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v3.UserAttributeGroupVersionKind.Group,
+			Resource: "userAttribute",
+		}, key)
+	}
+	return obj.(*v3.UserAttribute), nil
+}
+
+type userAttributeLifecycleAdapter struct {
+	lifecycle v3.UserAttributeLifecycle
+}
+
+func (w *userAttributeLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *userAttributeLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *userAttributeLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v3.UserAttribute))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *userAttributeLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v3.UserAttribute))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *userAttributeLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v3.UserAttribute))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newUserAttributeLifecycleAdapter(name string, clusterScoped bool, client *userAttributeClient, l v3.UserAttributeLifecycle) v3.UserAttributeHandlerFunc {
+	adapter := &userAttributeLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v3.UserAttribute) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+
+type projectNetworkPolicyClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *projectNetworkPolicyClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *projectNetworkPolicyClient) Create(o *v3.ProjectNetworkPolicy) (*v3.ProjectNetworkPolicy, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.ProjectNetworkPolicy), nil
+}
+
+func (s *projectNetworkPolicyClient) Get(name string, opts metav1.GetOptions) (*v3.ProjectNetworkPolicy, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.ProjectNetworkPolicy), nil
+}
+
+func (s *projectNetworkPolicyClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.ProjectNetworkPolicy, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.ProjectNetworkPolicy), nil
+}
+
+func (s *projectNetworkPolicyClient) Update(o *v3.ProjectNetworkPolicy) (*v3.ProjectNetworkPolicy, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.ProjectNetworkPolicy), nil
+}
+
+func (s *projectNetworkPolicyClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *projectNetworkPolicyClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *projectNetworkPolicyClient) List(opts metav1.ListOptions) (*v3.ProjectNetworkPolicyList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v3.ProjectNetworkPolicyList{}
+	list.APIVersion = v3.ProjectNetworkPolicyGroupVersionKind.GroupVersion().String()
+	list.Kind = v3.ProjectNetworkPolicyGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v3.ProjectNetworkPolicy))
+	}
+	return list, nil
+}
+
+func (s *projectNetworkPolicyClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *projectNetworkPolicyClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *projectNetworkPolicyClient) Controller() v3.ProjectNetworkPolicyController {
+	return &projectNetworkPolicyController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *projectNetworkPolicyClient) AddHandler(ctx context.Context, name string, sync v3.ProjectNetworkPolicyHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *projectNetworkPolicyClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v3.ProjectNetworkPolicyHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *projectNetworkPolicyClient) AddLifecycle(ctx context.Context, name string, lifecycle v3.ProjectNetworkPolicyLifecycle) {
+	sync := newProjectNetworkPolicyLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *projectNetworkPolicyClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v3.ProjectNetworkPolicyLifecycle) {
+	sync := newProjectNetworkPolicyLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *projectNetworkPolicyClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v3.ProjectNetworkPolicyHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *projectNetworkPolicyClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v3.ProjectNetworkPolicyHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *projectNetworkPolicyClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v3.ProjectNetworkPolicyLifecycle) {
+	sync := newProjectNetworkPolicyLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *projectNetworkPolicyClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v3.ProjectNetworkPolicyLifecycle) {
+	sync := newProjectNetworkPolicyLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type projectNetworkPolicyController struct {
+	controller.GenericController
+}
+
+func (c *projectNetworkPolicyController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *projectNetworkPolicyController) Lister() v3.ProjectNetworkPolicyLister {
+	return &projectNetworkPolicyLister{
+		controller: c,
+	}
+}
+
+func (c *projectNetworkPolicyController) AddHandler(ctx context.Context, name string, handler v3.ProjectNetworkPolicyHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ProjectNetworkPolicy); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *projectNetworkPolicyController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v3.ProjectNetworkPolicyHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ProjectNetworkPolicy); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *projectNetworkPolicyController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v3.ProjectNetworkPolicyHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ProjectNetworkPolicy); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *projectNetworkPolicyController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v3.ProjectNetworkPolicyHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ProjectNetworkPolicy); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type projectNetworkPolicyLister struct {
+	controller *projectNetworkPolicyController
+}
+
+func (l *projectNetworkPolicyLister) List(namespace string, selector labels.Selector) (ret []*v3.ProjectNetworkPolicy, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v3.ProjectNetworkPolicy))
+	})
+	return
+}
+
+func (l *projectNetworkPolicyLister) Get(namespace, name string) (*v3.ProjectNetworkPolicy, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v3.ProjectNetworkPolicyGroupVersionKind.Group,
+			Resource: "projectNetworkPolicy",
+		}, key)
+	}
+	return obj.(*v3.ProjectNetworkPolicy), nil
+}
+
+type projectNetworkPolicyLifecycleAdapter struct {
+	lifecycle v3.ProjectNetworkPolicyLifecycle
+}
+
+func (w *projectNetworkPolicyLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *projectNetworkPolicyLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *projectNetworkPolicyLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v3.ProjectNetworkPolicy))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *projectNetworkPolicyLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v3.ProjectNetworkPolicy))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *projectNetworkPolicyLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v3.ProjectNetworkPolicy))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newProjectNetworkPolicyLifecycleAdapter(name string, clusterScoped bool, client *projectNetworkPolicyClient, l v3.ProjectNetworkPolicyLifecycle) v3.ProjectNetworkPolicyHandlerFunc {
+	adapter := &projectNetworkPolicyLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v3.ProjectNetworkPolicy) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+
+type clusterLoggingClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *clusterLoggingClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *clusterLoggingClient) Create(o *v3.ClusterLogging) (*v3.ClusterLogging, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.ClusterLogging), nil
+}
+
+func (s *clusterLoggingClient) Get(name string, opts metav1.GetOptions) (*v3.ClusterLogging, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.ClusterLogging), nil
+}
+
+func (s *clusterLoggingClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.ClusterLogging, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.ClusterLogging), nil
+}
+
+func (s *clusterLoggingClient) Update(o *v3.ClusterLogging) (*v3.ClusterLogging, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.ClusterLogging), nil
+}
+
+func (s *clusterLoggingClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *clusterLoggingClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *clusterLoggingClient) List(opts metav1.ListOptions) (*v3.ClusterLoggingList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v3.ClusterLoggingList{}
+	list.APIVersion = v3.ClusterLoggingGroupVersionKind.GroupVersion().String()
+	list.Kind = v3.ClusterLoggingGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v3.ClusterLogging))
+	}
+	return list, nil
+}
+
+func (s *clusterLoggingClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *clusterLoggingClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *clusterLoggingClient) Controller() v3.ClusterLoggingController {
+	return &clusterLoggingController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *clusterLoggingClient) AddHandler(ctx context.Context, name string, sync v3.ClusterLoggingHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *clusterLoggingClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v3.ClusterLoggingHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *clusterLoggingClient) AddLifecycle(ctx context.Context, name string, lifecycle v3.ClusterLoggingLifecycle) {
+	sync := newClusterLoggingLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *clusterLoggingClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v3.ClusterLoggingLifecycle) {
+	sync := newClusterLoggingLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *clusterLoggingClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v3.ClusterLoggingHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *clusterLoggingClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v3.ClusterLoggingHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *clusterLoggingClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v3.ClusterLoggingLifecycle) {
+	sync := newClusterLoggingLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *clusterLoggingClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v3.ClusterLoggingLifecycle) {
+	sync := newClusterLoggingLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type clusterLoggingController struct {
+	controller.GenericController
+}
+
+func (c *clusterLoggingController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *clusterLoggingController) Lister() v3.ClusterLoggingLister {
+	return &clusterLoggingLister{
+		controller: c,
+	}
+}
+
+func (c *clusterLoggingController) AddHandler(ctx context.Context, name string, handler v3.ClusterLoggingHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ClusterLogging); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *clusterLoggingController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v3.ClusterLoggingHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ClusterLogging); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *clusterLoggingController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v3.ClusterLoggingHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ClusterLogging); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *clusterLoggingController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v3.ClusterLoggingHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ClusterLogging); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type clusterLoggingLister struct {
+	controller *clusterLoggingController
+}
+
+func (l *clusterLoggingLister) List(namespace string, selector labels.Selector) (ret []*v3.ClusterLogging, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v3.ClusterLogging))
+	})
+	return
+}
+
+func (l *clusterLoggingLister) Get(namespace, name string) (*v3.ClusterLogging, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v3.ClusterLoggingGroupVersionKind.Group,
+			Resource: "clusterLogging",
+		}, key)
+	}
+	return obj.(*v3.ClusterLogging), nil
+}
+
+type clusterLoggingLifecycleAdapter struct {
+	lifecycle v3.ClusterLoggingLifecycle
+}
+
+func (w *clusterLoggingLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *clusterLoggingLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *clusterLoggingLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v3.ClusterLogging))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *clusterLoggingLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v3.ClusterLogging))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *clusterLoggingLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v3.ClusterLogging))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newClusterLoggingLifecycleAdapter(name string, clusterScoped bool, client *clusterLoggingClient, l v3.ClusterLoggingLifecycle) v3.ClusterLoggingHandlerFunc {
+	adapter := &clusterLoggingLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v3.ClusterLogging) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+
+type projectLoggingClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *projectLoggingClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *projectLoggingClient) Create(o *v3.ProjectLogging) (*v3.ProjectLogging, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.ProjectLogging), nil
+}
+
+func (s *projectLoggingClient) Get(name string, opts metav1.GetOptions) (*v3.ProjectLogging, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.ProjectLogging), nil
+}
+
+func (s *projectLoggingClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.ProjectLogging, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.ProjectLogging), nil
+}
+
+func (s *projectLoggingClient) Update(o *v3.ProjectLogging) (*v3.ProjectLogging, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.ProjectLogging), nil
+}
+
+func (s *projectLoggingClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *projectLoggingClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *projectLoggingClient) List(opts metav1.ListOptions) (*v3.ProjectLoggingList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v3.ProjectLoggingList{}
+	list.APIVersion = v3.ProjectLoggingGroupVersionKind.GroupVersion().String()
+	list.Kind = v3.ProjectLoggingGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v3.ProjectLogging))
+	}
+	return list, nil
+}
+
+func (s *projectLoggingClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *projectLoggingClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *projectLoggingClient) Controller() v3.ProjectLoggingController {
+	return &projectLoggingController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *projectLoggingClient) AddHandler(ctx context.Context, name string, sync v3.ProjectLoggingHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *projectLoggingClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v3.ProjectLoggingHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *projectLoggingClient) AddLifecycle(ctx context.Context, name string, lifecycle v3.ProjectLoggingLifecycle) {
+	sync := newProjectLoggingLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *projectLoggingClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v3.ProjectLoggingLifecycle) {
+	sync := newProjectLoggingLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *projectLoggingClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v3.ProjectLoggingHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *projectLoggingClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v3.ProjectLoggingHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *projectLoggingClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v3.ProjectLoggingLifecycle) {
+	sync := newProjectLoggingLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *projectLoggingClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v3.ProjectLoggingLifecycle) {
+	sync := newProjectLoggingLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type projectLoggingController struct {
+	controller.GenericController
+}
+
+func (c *projectLoggingController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *projectLoggingController) Lister() v3.ProjectLoggingLister {
+	return &projectLoggingLister{
+		controller: c,
+	}
+}
+
+func (c *projectLoggingController) AddHandler(ctx context.Context, name string, handler v3.ProjectLoggingHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ProjectLogging); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *projectLoggingController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v3.ProjectLoggingHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ProjectLogging); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *projectLoggingController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v3.ProjectLoggingHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ProjectLogging); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *projectLoggingController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v3.ProjectLoggingHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ProjectLogging); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type projectLoggingLister struct {
+	controller *projectLoggingController
+}
+
+func (l *projectLoggingLister) List(namespace string, selector labels.Selector) (ret []*v3.ProjectLogging, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v3.ProjectLogging))
+	})
+	return
+}
+
+func (l *projectLoggingLister) Get(namespace, name string) (*v3.ProjectLogging, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v3.ProjectLoggingGroupVersionKind.Group,
+			Resource: "projectLogging",
+		}, key)
+	}
+	return obj.(*v3.ProjectLogging), nil
+}
+
+type projectLoggingLifecycleAdapter struct {
+	lifecycle v3.ProjectLoggingLifecycle
+}
+
+func (w *projectLoggingLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *projectLoggingLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *projectLoggingLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v3.ProjectLogging))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *projectLoggingLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v3.ProjectLogging))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *projectLoggingLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v3.ProjectLogging))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newProjectLoggingLifecycleAdapter(name string, clusterScoped bool, client *projectLoggingClient, l v3.ProjectLoggingLifecycle) v3.ProjectLoggingHandlerFunc {
+	adapter := &projectLoggingLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v3.ProjectLogging) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+
+type listenConfigClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *listenConfigClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *listenConfigClient) Create(o *v3.ListenConfig) (*v3.ListenConfig, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.ListenConfig), nil
+}
+
+func (s *listenConfigClient) Get(name string, opts metav1.GetOptions) (*v3.ListenConfig, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.ListenConfig), nil
+}
+
+func (s *listenConfigClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.ListenConfig, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.ListenConfig), nil
+}
+
+func (s *listenConfigClient) Update(o *v3.ListenConfig) (*v3.ListenConfig, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.ListenConfig), nil
+}
+
+func (s *listenConfigClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *listenConfigClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *listenConfigClient) List(opts metav1.ListOptions) (*v3.ListenConfigList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v3.ListenConfigList{}
+	list.APIVersion = v3.ListenConfigGroupVersionKind.GroupVersion().String()
+	list.Kind = v3.ListenConfigGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v3.ListenConfig))
+	}
+	return list, nil
+}
+
+func (s *listenConfigClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *listenConfigClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *listenConfigClient) Controller() v3.ListenConfigController {
+	return &listenConfigController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *listenConfigClient) AddHandler(ctx context.Context, name string, sync v3.ListenConfigHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *listenConfigClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v3.ListenConfigHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *listenConfigClient) AddLifecycle(ctx context.Context, name string, lifecycle v3.ListenConfigLifecycle) {
+	sync := newListenConfigLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *listenConfigClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v3.ListenConfigLifecycle) {
+	sync := newListenConfigLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *listenConfigClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v3.ListenConfigHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *listenConfigClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v3.ListenConfigHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *listenConfigClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v3.ListenConfigLifecycle) {
+	sync := newListenConfigLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *listenConfigClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v3.ListenConfigLifecycle) {
+	sync := newListenConfigLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type listenConfigController struct {
+	controller.GenericController
+}
+
+func (c *listenConfigController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *listenConfigController) Lister() v3.ListenConfigLister {
+	return &listenConfigLister{
+		controller: c,
+	}
+}
+
+func (c *listenConfigController) AddHandler(ctx context.Context, name string, handler v3.ListenConfigHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ListenConfig); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *listenConfigController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v3.ListenConfigHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ListenConfig); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *listenConfigController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v3.ListenConfigHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ListenConfig); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *listenConfigController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v3.ListenConfigHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ListenConfig); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type listenConfigLister struct {
+	controller *listenConfigController
+}
+
+func (l *listenConfigLister) List(namespace string, selector labels.Selector) (ret []*v3.ListenConfig, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v3.ListenConfig))
+	})
+	return
+}
+
+func (l *listenConfigLister) Get(namespace, name string) (*v3.ListenConfig, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v3.ListenConfigGroupVersionKind.Group,
+			Resource: "listenConfig",
+		}, key)
+	}
+	return obj.(*v3.ListenConfig), nil
+}
+
+type listenConfigLifecycleAdapter struct {
+	lifecycle v3.ListenConfigLifecycle
+}
+
+func (w *listenConfigLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *listenConfigLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *listenConfigLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v3.ListenConfig))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *listenConfigLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v3.ListenConfig))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *listenConfigLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v3.ListenConfig))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newListenConfigLifecycleAdapter(name string, clusterScoped bool, client *listenConfigClient, l v3.ListenConfigLifecycle) v3.ListenConfigHandlerFunc {
+	adapter := &listenConfigLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v3.ListenConfig) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+
+type settingClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *settingClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *settingClient) Create(o *v3.Setting) (*v3.Setting, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.Setting), nil
+}
+
+func (s *settingClient) Get(name string, opts metav1.GetOptions) (*v3.Setting, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.Setting), nil
+}
+
+func (s *settingClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.Setting, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.Setting), nil
+}
+
+func (s *settingClient) Update(o *v3.Setting) (*v3.Setting, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.Setting), nil
+}
+
+func (s *settingClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *settingClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *settingClient) List(opts metav1.ListOptions) (*v3.SettingList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v3.SettingList{}
+	list.APIVersion = v3.SettingGroupVersionKind.GroupVersion().String()
+	list.Kind = v3.SettingGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v3.Setting))
+	}
+	return list, nil
+}
+
+func (s *settingClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *settingClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *settingClient) Controller() v3.SettingController {
+	return &settingController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *settingClient) AddHandler(ctx context.Context, name string, sync v3.SettingHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *settingClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v3.SettingHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *settingClient) AddLifecycle(ctx context.Context, name string, lifecycle v3.SettingLifecycle) {
+	sync := newSettingLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *settingClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v3.SettingLifecycle) {
+	sync := newSettingLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *settingClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v3.SettingHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *settingClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v3.SettingHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *settingClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v3.SettingLifecycle) {
+	sync := newSettingLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *settingClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v3.SettingLifecycle) {
+	sync := newSettingLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type settingController struct {
+	controller.GenericController
+}
+
+func (c *settingController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *settingController) Lister() v3.SettingLister {
+	return &settingLister{
+		controller: c,
+	}
+}
+
+func (c *settingController) AddHandler(ctx context.Context, name string, handler v3.SettingHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.Setting); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *settingController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v3.SettingHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.Setting); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *settingController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v3.SettingHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.Setting); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *settingController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v3.SettingHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.Setting); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type settingLister struct {
+	controller *settingController
+}
+
+func (l *settingLister) List(namespace string, selector labels.Selector) (ret []*v3.Setting, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v3.Setting))
+	})
+	return
+}
+
+func (l *settingLister) Get(namespace, name string) (*v3.Setting, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v3.SettingGroupVersionKind.Group,
+			Resource: "setting",
+		}, key)
+	}
+	return obj.(*v3.Setting), nil
+}
+
+type settingLifecycleAdapter struct {
+	lifecycle v3.SettingLifecycle
+}
+
+func (w *settingLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *settingLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *settingLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v3.Setting))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *settingLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v3.Setting))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *settingLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v3.Setting))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newSettingLifecycleAdapter(name string, clusterScoped bool, client *settingClient, l v3.SettingLifecycle) v3.SettingHandlerFunc {
+	adapter := &settingLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v3.Setting) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+
+type featureClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *featureClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *featureClient) Create(o *v3.Feature) (*v3.Feature, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.Feature), nil
+}
+
+func (s *featureClient) Get(name string, opts metav1.GetOptions) (*v3.Feature, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.Feature), nil
+}
+
+func (s *featureClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.Feature, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.Feature), nil
+}
+
+func (s *featureClient) Update(o *v3.Feature) (*v3.Feature, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.Feature), nil
+}
+
+func (s *featureClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *featureClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *featureClient) List(opts metav1.ListOptions) (*v3.FeatureList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v3.FeatureList{}
+	list.APIVersion = v3.FeatureGroupVersionKind.GroupVersion().String()
+	list.Kind = v3.FeatureGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v3.Feature))
+	}
+	return list, nil
+}
+
+func (s *featureClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *featureClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *featureClient) Controller() v3.FeatureController {
+	return &featureController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *featureClient) AddHandler(ctx context.Context, name string, sync v3.FeatureHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *featureClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v3.FeatureHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *featureClient) AddLifecycle(ctx context.Context, name string, lifecycle v3.FeatureLifecycle) {
+	sync := newFeatureLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *featureClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v3.FeatureLifecycle) {
+	sync := newFeatureLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *featureClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v3.FeatureHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *featureClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v3.FeatureHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *featureClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v3.FeatureLifecycle) {
+	sync := newFeatureLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *featureClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v3.FeatureLifecycle) {
+	sync := newFeatureLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type featureController struct {
+	controller.GenericController
+}
+
+func (c *featureController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *featureController) Lister() v3.FeatureLister {
+	return &featureLister{
+		controller: c,
+	}
+}
+
+func (c *featureController) AddHandler(ctx context.Context, name string, handler v3.FeatureHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.Feature); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *featureController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v3.FeatureHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.Feature); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *featureController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v3.FeatureHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.Feature); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *featureController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v3.FeatureHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.Feature); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type featureLister struct {
+	controller *featureController
+}
+
+func (l *featureLister) List(namespace string, selector labels.Selector) (ret []*v3.Feature, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v3.Feature))
+	})
+	return
+}
+
+func (l *featureLister) Get(namespace, name string) (*v3.Feature, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v3.FeatureGroupVersionKind.Group,
+			Resource: "feature",
+		}, key)
+	}
+	return obj.(*v3.Feature), nil
+}
+
+type featureLifecycleAdapter struct {
+	lifecycle v3.FeatureLifecycle
+}
+
+func (w *featureLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *featureLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *featureLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v3.Feature))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *featureLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v3.Feature))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *featureLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v3.Feature))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newFeatureLifecycleAdapter(name string, clusterScoped bool, client *featureClient, l v3.FeatureLifecycle) v3.FeatureHandlerFunc {
+	adapter := &featureLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v3.Feature) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+
+type clusterAlertClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *clusterAlertClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *clusterAlertClient) Create(o *v3.ClusterAlert) (*v3.ClusterAlert, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.ClusterAlert), nil
+}
+
+func (s *clusterAlertClient) Get(name string, opts metav1.GetOptions) (*v3.ClusterAlert, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.ClusterAlert), nil
+}
+
+func (s *clusterAlertClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.ClusterAlert, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.ClusterAlert), nil
+}
+
+func (s *clusterAlertClient) Update(o *v3.ClusterAlert) (*v3.ClusterAlert, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.ClusterAlert), nil
+}
+
+func (s *clusterAlertClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *clusterAlertClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *clusterAlertClient) List(opts metav1.ListOptions) (*v3.ClusterAlertList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v3.ClusterAlertList{}
+	list.APIVersion = v3.ClusterAlertGroupVersionKind.GroupVersion().String()
+	list.Kind = v3.ClusterAlertGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v3.ClusterAlert))
+	}
+	return list, nil
+}
+
+func (s *clusterAlertClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *clusterAlertClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *clusterAlertClient) Controller() v3.ClusterAlertController {
+	return &clusterAlertController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *clusterAlertClient) AddHandler(ctx context.Context, name string, sync v3.ClusterAlertHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *clusterAlertClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v3.ClusterAlertHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *clusterAlertClient) AddLifecycle(ctx context.Context, name string, lifecycle v3.ClusterAlertLifecycle) {
+	sync := newClusterAlertLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *clusterAlertClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v3.ClusterAlertLifecycle) {
+	sync := newClusterAlertLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *clusterAlertClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v3.ClusterAlertHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *clusterAlertClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v3.ClusterAlertHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *clusterAlertClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v3.ClusterAlertLifecycle) {
+	sync := newClusterAlertLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *clusterAlertClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v3.ClusterAlertLifecycle) {
+	sync := newClusterAlertLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type clusterAlertController struct {
+	controller.GenericController
+}
+
+func (c *clusterAlertController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *clusterAlertController) Lister() v3.ClusterAlertLister {
+	return &clusterAlertLister{
+		controller: c,
+	}
+}
+
+func (c *clusterAlertController) AddHandler(ctx context.Context, name string, handler v3.ClusterAlertHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ClusterAlert); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *clusterAlertController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v3.ClusterAlertHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ClusterAlert); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *clusterAlertController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v3.ClusterAlertHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ClusterAlert); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *clusterAlertController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v3.ClusterAlertHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ClusterAlert); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type clusterAlertLister struct {
+	controller *clusterAlertController
+}
+
+func (l *clusterAlertLister) List(namespace string, selector labels.Selector) (ret []*v3.ClusterAlert, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v3.ClusterAlert))
+	})
+	return
+}
+
+func (l *clusterAlertLister) Get(namespace, name string) (*v3.ClusterAlert, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v3.ClusterAlertGroupVersionKind.Group,
+			Resource: "clusterAlert",
+		}, key)
+	}
+	return obj.(*v3.ClusterAlert), nil
+}
+
+type clusterAlertLifecycleAdapter struct {
+	lifecycle v3.ClusterAlertLifecycle
+}
+
+func (w *clusterAlertLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *clusterAlertLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *clusterAlertLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v3.ClusterAlert))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *clusterAlertLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v3.ClusterAlert))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *clusterAlertLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v3.ClusterAlert))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newClusterAlertLifecycleAdapter(name string, clusterScoped bool, client *clusterAlertClient, l v3.ClusterAlertLifecycle) v3.ClusterAlertHandlerFunc {
+	adapter := &clusterAlertLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v3.ClusterAlert) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+
+type projectAlertClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *projectAlertClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *projectAlertClient) Create(o *v3.ProjectAlert) (*v3.ProjectAlert, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.ProjectAlert), nil
+}
+
+func (s *projectAlertClient) Get(name string, opts metav1.GetOptions) (*v3.ProjectAlert, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.ProjectAlert), nil
+}
+
+func (s *projectAlertClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.ProjectAlert, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.ProjectAlert), nil
+}
+
+func (s *projectAlertClient) Update(o *v3.ProjectAlert) (*v3.ProjectAlert, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.ProjectAlert), nil
+}
+
+func (s *projectAlertClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *projectAlertClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *projectAlertClient) List(opts metav1.ListOptions) (*v3.ProjectAlertList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v3.ProjectAlertList{}
+	list.APIVersion = v3.ProjectAlertGroupVersionKind.GroupVersion().String()
+	list.Kind = v3.ProjectAlertGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v3.ProjectAlert))
+	}
+	return list, nil
+}
+
+func (s *projectAlertClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *projectAlertClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *projectAlertClient) Controller() v3.ProjectAlertController {
+	return &projectAlertController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *projectAlertClient) AddHandler(ctx context.Context, name string, sync v3.ProjectAlertHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *projectAlertClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v3.ProjectAlertHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *projectAlertClient) AddLifecycle(ctx context.Context, name string, lifecycle v3.ProjectAlertLifecycle) {
+	sync := newProjectAlertLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *projectAlertClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v3.ProjectAlertLifecycle) {
+	sync := newProjectAlertLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *projectAlertClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v3.ProjectAlertHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *projectAlertClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v3.ProjectAlertHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *projectAlertClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v3.ProjectAlertLifecycle) {
+	sync := newProjectAlertLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *projectAlertClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v3.ProjectAlertLifecycle) {
+	sync := newProjectAlertLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type projectAlertController struct {
+	controller.GenericController
+}
+
+func (c *projectAlertController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *projectAlertController) Lister() v3.ProjectAlertLister {
+	return &projectAlertLister{
+		controller: c,
+	}
+}
+
+func (c *projectAlertController) AddHandler(ctx context.Context, name string, handler v3.ProjectAlertHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ProjectAlert); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *projectAlertController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v3.ProjectAlertHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ProjectAlert); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *projectAlertController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v3.ProjectAlertHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ProjectAlert); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *projectAlertController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v3.ProjectAlertHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ProjectAlert); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type projectAlertLister struct {
+	controller *projectAlertController
+}
+
+func (l *projectAlertLister) List(namespace string, selector labels.Selector) (ret []*v3.ProjectAlert, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v3.ProjectAlert))
+	})
+	return
+}
+
+func (l *projectAlertLister) Get(namespace, name string) (*v3.ProjectAlert, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v3.ProjectAlertGroupVersionKind.Group,
+			Resource: "projectAlert",
+		}, key)
+	}
+	return obj.(*v3.ProjectAlert), nil
+}
+
+type projectAlertLifecycleAdapter struct {
+	lifecycle v3.ProjectAlertLifecycle
+}
+
+func (w *projectAlertLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *projectAlertLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *projectAlertLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v3.ProjectAlert))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *projectAlertLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v3.ProjectAlert))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *projectAlertLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v3.ProjectAlert))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newProjectAlertLifecycleAdapter(name string, clusterScoped bool, client *projectAlertClient, l v3.ProjectAlertLifecycle) v3.ProjectAlertHandlerFunc {
+	adapter := &projectAlertLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v3.ProjectAlert) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+
+type notifierClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *notifierClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *notifierClient) Create(o *v3.Notifier) (*v3.Notifier, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.Notifier), nil
+}
+
+func (s *notifierClient) Get(name string, opts metav1.GetOptions) (*v3.Notifier, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.Notifier), nil
+}
+
+func (s *notifierClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.Notifier, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.Notifier), nil
+}
+
+func (s *notifierClient) Update(o *v3.Notifier) (*v3.Notifier, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.Notifier), nil
+}
+
+func (s *notifierClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *notifierClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *notifierClient) List(opts metav1.ListOptions) (*v3.NotifierList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v3.NotifierList{}
+	list.APIVersion = v3.NotifierGroupVersionKind.GroupVersion().String()
+	list.Kind = v3.NotifierGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v3.Notifier))
+	}
+	return list, nil
+}
+
+func (s *notifierClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *notifierClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *notifierClient) Controller() v3.NotifierController {
+	return &notifierController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *notifierClient) AddHandler(ctx context.Context, name string, sync v3.NotifierHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *notifierClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v3.NotifierHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *notifierClient) AddLifecycle(ctx context.Context, name string, lifecycle v3.NotifierLifecycle) {
+	sync := newNotifierLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *notifierClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v3.NotifierLifecycle) {
+	sync := newNotifierLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *notifierClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v3.NotifierHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *notifierClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v3.NotifierHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *notifierClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v3.NotifierLifecycle) {
+	sync := newNotifierLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *notifierClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v3.NotifierLifecycle) {
+	sync := newNotifierLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type notifierController struct {
+	controller.GenericController
+}
+
+func (c *notifierController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *notifierController) Lister() v3.NotifierLister {
+	return &notifierLister{
+		controller: c,
+	}
+}
+
+func (c *notifierController) AddHandler(ctx context.Context, name string, handler v3.NotifierHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.Notifier); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *notifierController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v3.NotifierHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.Notifier); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *notifierController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v3.NotifierHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.Notifier); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *notifierController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v3.NotifierHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.Notifier); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type notifierLister struct {
+	controller *notifierController
+}
+
+func (l *notifierLister) List(namespace string, selector labels.Selector) (ret []*v3.Notifier, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v3.Notifier))
+	})
+	return
+}
+
+func (l *notifierLister) Get(namespace, name string) (*v3.Notifier, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v3.NotifierGroupVersionKind.Group,
+			Resource: "notifier",
+		}, key)
+	}
+	return obj.(*v3.Notifier), nil
+}
+
+type notifierLifecycleAdapter struct {
+	lifecycle v3.NotifierLifecycle
+}
+
+func (w *notifierLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *notifierLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *notifierLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v3.Notifier))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *notifierLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v3.Notifier))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *notifierLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v3.Notifier))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newNotifierLifecycleAdapter(name string, clusterScoped bool, client *notifierClient, l v3.NotifierLifecycle) v3.NotifierHandlerFunc {
+	adapter := &notifierLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v3.Notifier) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+
+type clusterAlertGroupClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *clusterAlertGroupClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *clusterAlertGroupClient) Create(o *v3.ClusterAlertGroup) (*v3.ClusterAlertGroup, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.ClusterAlertGroup), nil
+}
+
+func (s *clusterAlertGroupClient) Get(name string, opts metav1.GetOptions) (*v3.ClusterAlertGroup, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.ClusterAlertGroup), nil
+}
+
+func (s *clusterAlertGroupClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.ClusterAlertGroup, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.ClusterAlertGroup), nil
+}
+
+func (s *clusterAlertGroupClient) Update(o *v3.ClusterAlertGroup) (*v3.ClusterAlertGroup, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.ClusterAlertGroup), nil
+}
+
+func (s *clusterAlertGroupClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *clusterAlertGroupClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *clusterAlertGroupClient) List(opts metav1.ListOptions) (*v3.ClusterAlertGroupList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v3.ClusterAlertGroupList{}
+	list.APIVersion = v3.ClusterAlertGroupGroupVersionKind.GroupVersion().String()
+	list.Kind = v3.ClusterAlertGroupGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v3.ClusterAlertGroup))
+	}
+	return list, nil
+}
+
+func (s *clusterAlertGroupClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *clusterAlertGroupClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *clusterAlertGroupClient) Controller() v3.ClusterAlertGroupController {
+	return &clusterAlertGroupController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *clusterAlertGroupClient) AddHandler(ctx context.Context, name string, sync v3.ClusterAlertGroupHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *clusterAlertGroupClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v3.ClusterAlertGroupHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *clusterAlertGroupClient) AddLifecycle(ctx context.Context, name string, lifecycle v3.ClusterAlertGroupLifecycle) {
+	sync := newClusterAlertGroupLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *clusterAlertGroupClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v3.ClusterAlertGroupLifecycle) {
+	sync := newClusterAlertGroupLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *clusterAlertGroupClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v3.ClusterAlertGroupHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *clusterAlertGroupClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v3.ClusterAlertGroupHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *clusterAlertGroupClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v3.ClusterAlertGroupLifecycle) {
+	sync := newClusterAlertGroupLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *clusterAlertGroupClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v3.ClusterAlertGroupLifecycle) {
+	sync := newClusterAlertGroupLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type clusterAlertGroupController struct {
+	controller.GenericController
+}
+
+func (c *clusterAlertGroupController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *clusterAlertGroupController) Lister() v3.ClusterAlertGroupLister {
+	return &clusterAlertGroupLister{
+		controller: c,
+	}
+}
+
+func (c *clusterAlertGroupController) AddHandler(ctx context.Context, name string, handler v3.ClusterAlertGroupHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ClusterAlertGroup); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *clusterAlertGroupController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v3.ClusterAlertGroupHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ClusterAlertGroup); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *clusterAlertGroupController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v3.ClusterAlertGroupHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ClusterAlertGroup); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *clusterAlertGroupController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v3.ClusterAlertGroupHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ClusterAlertGroup); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type clusterAlertGroupLister struct {
+	controller *clusterAlertGroupController
+}
+
+func (l *clusterAlertGroupLister) List(namespace string, selector labels.Selector) (ret []*v3.ClusterAlertGroup, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v3.ClusterAlertGroup))
+	})
+	return
+}
+
+func (l *clusterAlertGroupLister) Get(namespace, name string) (*v3.ClusterAlertGroup, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v3.ClusterAlertGroupGroupVersionKind.Group,
+			Resource: "clusterAlertGroup",
+		}, key)
+	}
+	return obj.(*v3.ClusterAlertGroup), nil
+}
+
+type clusterAlertGroupLifecycleAdapter struct {
+	lifecycle v3.ClusterAlertGroupLifecycle
+}
+
+func (w *clusterAlertGroupLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *clusterAlertGroupLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *clusterAlertGroupLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v3.ClusterAlertGroup))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *clusterAlertGroupLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v3.ClusterAlertGroup))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *clusterAlertGroupLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v3.ClusterAlertGroup))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newClusterAlertGroupLifecycleAdapter(name string, clusterScoped bool, client *clusterAlertGroupClient, l v3.ClusterAlertGroupLifecycle) v3.ClusterAlertGroupHandlerFunc {
+	adapter := &clusterAlertGroupLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v3.ClusterAlertGroup) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+
+type projectAlertGroupClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *projectAlertGroupClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *projectAlertGroupClient) Create(o *v3.ProjectAlertGroup) (*v3.ProjectAlertGroup, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.ProjectAlertGroup), nil
+}
+
+func (s *projectAlertGroupClient) Get(name string, opts metav1.GetOptions) (*v3.ProjectAlertGroup, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.ProjectAlertGroup), nil
+}
+
+func (s *projectAlertGroupClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.ProjectAlertGroup, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.ProjectAlertGroup), nil
+}
+
+func (s *projectAlertGroupClient) Update(o *v3.ProjectAlertGroup) (*v3.ProjectAlertGroup, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.ProjectAlertGroup), nil
+}
+
+func (s *projectAlertGroupClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *projectAlertGroupClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *projectAlertGroupClient) List(opts metav1.ListOptions) (*v3.ProjectAlertGroupList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v3.ProjectAlertGroupList{}
+	list.APIVersion = v3.ProjectAlertGroupGroupVersionKind.GroupVersion().String()
+	list.Kind = v3.ProjectAlertGroupGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v3.ProjectAlertGroup))
+	}
+	return list, nil
+}
+
+func (s *projectAlertGroupClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *projectAlertGroupClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *projectAlertGroupClient) Controller() v3.ProjectAlertGroupController {
+	return &projectAlertGroupController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *projectAlertGroupClient) AddHandler(ctx context.Context, name string, sync v3.ProjectAlertGroupHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *projectAlertGroupClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v3.ProjectAlertGroupHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *projectAlertGroupClient) AddLifecycle(ctx context.Context, name string, lifecycle v3.ProjectAlertGroupLifecycle) {
+	sync := newProjectAlertGroupLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *projectAlertGroupClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v3.ProjectAlertGroupLifecycle) {
+	sync := newProjectAlertGroupLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *projectAlertGroupClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v3.ProjectAlertGroupHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *projectAlertGroupClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v3.ProjectAlertGroupHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *projectAlertGroupClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v3.ProjectAlertGroupLifecycle) {
+	sync := newProjectAlertGroupLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *projectAlertGroupClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v3.ProjectAlertGroupLifecycle) {
+	sync := newProjectAlertGroupLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type projectAlertGroupController struct {
+	controller.GenericController
+}
+
+func (c *projectAlertGroupController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *projectAlertGroupController) Lister() v3.ProjectAlertGroupLister {
+	return &projectAlertGroupLister{
+		controller: c,
+	}
+}
+
+func (c *projectAlertGroupController) AddHandler(ctx context.Context, name string, handler v3.ProjectAlertGroupHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ProjectAlertGroup); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *projectAlertGroupController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v3.ProjectAlertGroupHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ProjectAlertGroup); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *projectAlertGroupController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v3.ProjectAlertGroupHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ProjectAlertGroup); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *projectAlertGroupController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v3.ProjectAlertGroupHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ProjectAlertGroup); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type projectAlertGroupLister struct {
+	controller *projectAlertGroupController
+}
+
+func (l *projectAlertGroupLister) List(namespace string, selector labels.Selector) (ret []*v3.ProjectAlertGroup, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v3.ProjectAlertGroup))
+	})
+	return
+}
+
+func (l *projectAlertGroupLister) Get(namespace, name string) (*v3.ProjectAlertGroup, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v3.ProjectAlertGroupGroupVersionKind.Group,
+			Resource: "projectAlertGroup",
+		}, key)
+	}
+	return obj.(*v3.ProjectAlertGroup), nil
+}
+
+type projectAlertGroupLifecycleAdapter struct {
+	lifecycle v3.ProjectAlertGroupLifecycle
+}
+
+func (w *projectAlertGroupLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *projectAlertGroupLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *projectAlertGroupLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v3.ProjectAlertGroup))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *projectAlertGroupLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v3.ProjectAlertGroup))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *projectAlertGroupLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v3.ProjectAlertGroup))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newProjectAlertGroupLifecycleAdapter(name string, clusterScoped bool, client *projectAlertGroupClient, l v3.ProjectAlertGroupLifecycle) v3.ProjectAlertGroupHandlerFunc {
+	adapter := &projectAlertGroupLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v3.ProjectAlertGroup) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+
+type clusterAlertRuleClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *clusterAlertRuleClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *clusterAlertRuleClient) Create(o *v3.ClusterAlertRule) (*v3.ClusterAlertRule, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.ClusterAlertRule), nil
+}
+
+func (s *clusterAlertRuleClient) Get(name string, opts metav1.GetOptions) (*v3.ClusterAlertRule, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.ClusterAlertRule), nil
+}
+
+func (s *clusterAlertRuleClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.ClusterAlertRule, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.ClusterAlertRule), nil
+}
+
+func (s *clusterAlertRuleClient) Update(o *v3.ClusterAlertRule) (*v3.ClusterAlertRule, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.ClusterAlertRule), nil
+}
+
+func (s *clusterAlertRuleClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *clusterAlertRuleClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *clusterAlertRuleClient) List(opts metav1.ListOptions) (*v3.ClusterAlertRuleList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v3.ClusterAlertRuleList{}
+	list.APIVersion = v3.ClusterAlertRuleGroupVersionKind.GroupVersion().String()
+	list.Kind = v3.ClusterAlertRuleGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v3.ClusterAlertRule))
+	}
+	return list, nil
+}
+
+func (s *clusterAlertRuleClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *clusterAlertRuleClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *clusterAlertRuleClient) Controller() v3.ClusterAlertRuleController {
+	return &clusterAlertRuleController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *clusterAlertRuleClient) AddHandler(ctx context.Context, name string, sync v3.ClusterAlertRuleHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *clusterAlertRuleClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v3.ClusterAlertRuleHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *clusterAlertRuleClient) AddLifecycle(ctx context.Context, name string, lifecycle v3.ClusterAlertRuleLifecycle) {
+	sync := newClusterAlertRuleLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *clusterAlertRuleClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v3.ClusterAlertRuleLifecycle) {
+	sync := newClusterAlertRuleLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *clusterAlertRuleClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v3.ClusterAlertRuleHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *clusterAlertRuleClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v3.ClusterAlertRuleHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *clusterAlertRuleClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v3.ClusterAlertRuleLifecycle) {
+	sync := newClusterAlertRuleLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *clusterAlertRuleClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v3.ClusterAlertRuleLifecycle) {
+	sync := newClusterAlertRuleLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type clusterAlertRuleController struct {
+	controller.GenericController
+}
+
+func (c *clusterAlertRuleController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *clusterAlertRuleController) Lister() v3.ClusterAlertRuleLister {
+	return &clusterAlertRuleLister{
+		controller: c,
+	}
+}
+
+func (c *clusterAlertRuleController) AddHandler(ctx context.Context, name string, handler v3.ClusterAlertRuleHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ClusterAlertRule); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *clusterAlertRuleController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v3.ClusterAlertRuleHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ClusterAlertRule); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *clusterAlertRuleController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v3.ClusterAlertRuleHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ClusterAlertRule); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *clusterAlertRuleController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v3.ClusterAlertRuleHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ClusterAlertRule); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type clusterAlertRuleLister struct {
+	controller *clusterAlertRuleController
+}
+
+func (l *clusterAlertRuleLister) List(namespace string, selector labels.Selector) (ret []*v3.ClusterAlertRule, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v3.ClusterAlertRule))
+	})
+	return
+}
+
+func (l *clusterAlertRuleLister) Get(namespace, name string) (*v3.ClusterAlertRule, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v3.ClusterAlertRuleGroupVersionKind.Group,
+			Resource: "clusterAlertRule",
+		}, key)
+	}
+	return obj.(*v3.ClusterAlertRule), nil
+}
+
+type clusterAlertRuleLifecycleAdapter struct {
+	lifecycle v3.ClusterAlertRuleLifecycle
+}
+
+func (w *clusterAlertRuleLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *clusterAlertRuleLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *clusterAlertRuleLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v3.ClusterAlertRule))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *clusterAlertRuleLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v3.ClusterAlertRule))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *clusterAlertRuleLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v3.ClusterAlertRule))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newClusterAlertRuleLifecycleAdapter(name string, clusterScoped bool, client *clusterAlertRuleClient, l v3.ClusterAlertRuleLifecycle) v3.ClusterAlertRuleHandlerFunc {
+	adapter := &clusterAlertRuleLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v3.ClusterAlertRule) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+
+type projectAlertRuleClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *projectAlertRuleClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *projectAlertRuleClient) Create(o *v3.ProjectAlertRule) (*v3.ProjectAlertRule, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.ProjectAlertRule), nil
+}
+
+func (s *projectAlertRuleClient) Get(name string, opts metav1.GetOptions) (*v3.ProjectAlertRule, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.ProjectAlertRule), nil
+}
+
+func (s *projectAlertRuleClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.ProjectAlertRule, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.ProjectAlertRule), nil
+}
+
+func (s *projectAlertRuleClient) Update(o *v3.ProjectAlertRule) (*v3.ProjectAlertRule, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.ProjectAlertRule), nil
+}
+
+func (s *projectAlertRuleClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *projectAlertRuleClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *projectAlertRuleClient) List(opts metav1.ListOptions) (*v3.ProjectAlertRuleList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v3.ProjectAlertRuleList{}
+	list.APIVersion = v3.ProjectAlertRuleGroupVersionKind.GroupVersion().String()
+	list.Kind = v3.ProjectAlertRuleGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v3.ProjectAlertRule))
+	}
+	return list, nil
+}
+
+func (s *projectAlertRuleClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *projectAlertRuleClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *projectAlertRuleClient) Controller() v3.ProjectAlertRuleController {
+	return &projectAlertRuleController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *projectAlertRuleClient) AddHandler(ctx context.Context, name string, sync v3.ProjectAlertRuleHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *projectAlertRuleClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v3.ProjectAlertRuleHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *projectAlertRuleClient) AddLifecycle(ctx context.Context, name string, lifecycle v3.ProjectAlertRuleLifecycle) {
+	sync := newProjectAlertRuleLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *projectAlertRuleClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v3.ProjectAlertRuleLifecycle) {
+	sync := newProjectAlertRuleLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *projectAlertRuleClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v3.ProjectAlertRuleHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *projectAlertRuleClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v3.ProjectAlertRuleHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *projectAlertRuleClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v3.ProjectAlertRuleLifecycle) {
+	sync := newProjectAlertRuleLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *projectAlertRuleClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v3.ProjectAlertRuleLifecycle) {
+	sync := newProjectAlertRuleLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type projectAlertRuleController struct {
+	controller.GenericController
+}
+
+func (c *projectAlertRuleController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *projectAlertRuleController) Lister() v3.ProjectAlertRuleLister {
+	return &projectAlertRuleLister{
+		controller: c,
+	}
+}
+
+func (c *projectAlertRuleController) AddHandler(ctx context.Context, name string, handler v3.ProjectAlertRuleHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ProjectAlertRule); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *projectAlertRuleController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v3.ProjectAlertRuleHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ProjectAlertRule); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *projectAlertRuleController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v3.ProjectAlertRuleHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ProjectAlertRule); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *projectAlertRuleController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v3.ProjectAlertRuleHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ProjectAlertRule); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type projectAlertRuleLister struct {
+	controller *projectAlertRuleController
+}
+
+func (l *projectAlertRuleLister) List(namespace string, selector labels.Selector) (ret []*v3.ProjectAlertRule, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v3.ProjectAlertRule))
+	})
+	return
+}
+
+func (l *projectAlertRuleLister) Get(namespace, name string) (*v3.ProjectAlertRule, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v3.ProjectAlertRuleGroupVersionKind.Group,
+			Resource: "projectAlertRule",
+		}, key)
+	}
+	return obj.(*v3.ProjectAlertRule), nil
+}
+
+type projectAlertRuleLifecycleAdapter struct {
+	lifecycle v3.ProjectAlertRuleLifecycle
+}
+
+func (w *projectAlertRuleLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *projectAlertRuleLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *projectAlertRuleLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v3.ProjectAlertRule))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *projectAlertRuleLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v3.ProjectAlertRule))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *projectAlertRuleLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v3.ProjectAlertRule))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newProjectAlertRuleLifecycleAdapter(name string, clusterScoped bool, client *projectAlertRuleClient, l v3.ProjectAlertRuleLifecycle) v3.ProjectAlertRuleHandlerFunc {
+	adapter := &projectAlertRuleLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v3.ProjectAlertRule) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+
+type composeConfigClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *composeConfigClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *composeConfigClient) Create(o *v3.ComposeConfig) (*v3.ComposeConfig, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.ComposeConfig), nil
+}
+
+func (s *composeConfigClient) Get(name string, opts metav1.GetOptions) (*v3.ComposeConfig, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.ComposeConfig), nil
+}
+
+func (s *composeConfigClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.ComposeConfig, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.ComposeConfig), nil
+}
+
+func (s *composeConfigClient) Update(o *v3.ComposeConfig) (*v3.ComposeConfig, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.ComposeConfig), nil
+}
+
+func (s *composeConfigClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *composeConfigClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *composeConfigClient) List(opts metav1.ListOptions) (*v3.ComposeConfigList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v3.ComposeConfigList{}
+	list.APIVersion = v3.ComposeConfigGroupVersionKind.GroupVersion().String()
+	list.Kind = v3.ComposeConfigGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v3.ComposeConfig))
+	}
+	return list, nil
+}
+
+func (s *composeConfigClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *composeConfigClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *composeConfigClient) Controller() v3.ComposeConfigController {
+	return &composeConfigController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *composeConfigClient) AddHandler(ctx context.Context, name string, sync v3.ComposeConfigHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *composeConfigClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v3.ComposeConfigHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *composeConfigClient) AddLifecycle(ctx context.Context, name string, lifecycle v3.ComposeConfigLifecycle) {
+	sync := newComposeConfigLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *composeConfigClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v3.ComposeConfigLifecycle) {
+	sync := newComposeConfigLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *composeConfigClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v3.ComposeConfigHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *composeConfigClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v3.ComposeConfigHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *composeConfigClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v3.ComposeConfigLifecycle) {
+	sync := newComposeConfigLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *composeConfigClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v3.ComposeConfigLifecycle) {
+	sync := newComposeConfigLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type composeConfigController struct {
+	controller.GenericController
+}
+
+func (c *composeConfigController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *composeConfigController) Lister() v3.ComposeConfigLister {
+	return &composeConfigLister{
+		controller: c,
+	}
+}
+
+func (c *composeConfigController) AddHandler(ctx context.Context, name string, handler v3.ComposeConfigHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ComposeConfig); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *composeConfigController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v3.ComposeConfigHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ComposeConfig); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *composeConfigController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v3.ComposeConfigHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ComposeConfig); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *composeConfigController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v3.ComposeConfigHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ComposeConfig); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type composeConfigLister struct {
+	controller *composeConfigController
+}
+
+func (l *composeConfigLister) List(namespace string, selector labels.Selector) (ret []*v3.ComposeConfig, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v3.ComposeConfig))
+	})
+	return
+}
+
+func (l *composeConfigLister) Get(namespace, name string) (*v3.ComposeConfig, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v3.ComposeConfigGroupVersionKind.Group,
+			Resource: "composeConfig",
+		}, key)
+	}
+	return obj.(*v3.ComposeConfig), nil
+}
+
+type composeConfigLifecycleAdapter struct {
+	lifecycle v3.ComposeConfigLifecycle
+}
+
+func (w *composeConfigLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *composeConfigLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *composeConfigLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v3.ComposeConfig))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *composeConfigLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v3.ComposeConfig))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *composeConfigLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v3.ComposeConfig))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newComposeConfigLifecycleAdapter(name string, clusterScoped bool, client *composeConfigClient, l v3.ComposeConfigLifecycle) v3.ComposeConfigHandlerFunc {
+	adapter := &composeConfigLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v3.ComposeConfig) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+
+type projectCatalogClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *projectCatalogClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *projectCatalogClient) Create(o *v3.ProjectCatalog) (*v3.ProjectCatalog, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.ProjectCatalog), nil
+}
+
+func (s *projectCatalogClient) Get(name string, opts metav1.GetOptions) (*v3.ProjectCatalog, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.ProjectCatalog), nil
+}
+
+func (s *projectCatalogClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.ProjectCatalog, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.ProjectCatalog), nil
+}
+
+func (s *projectCatalogClient) Update(o *v3.ProjectCatalog) (*v3.ProjectCatalog, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.ProjectCatalog), nil
+}
+
+func (s *projectCatalogClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *projectCatalogClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *projectCatalogClient) List(opts metav1.ListOptions) (*v3.ProjectCatalogList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v3.ProjectCatalogList{}
+	list.APIVersion = v3.ProjectCatalogGroupVersionKind.GroupVersion().String()
+	list.Kind = v3.ProjectCatalogGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v3.ProjectCatalog))
+	}
+	return list, nil
+}
+
+func (s *projectCatalogClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *projectCatalogClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *projectCatalogClient) Controller() v3.ProjectCatalogController {
+	return &projectCatalogController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *projectCatalogClient) AddHandler(ctx context.Context, name string, sync v3.ProjectCatalogHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *projectCatalogClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v3.ProjectCatalogHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *projectCatalogClient) AddLifecycle(ctx context.Context, name string, lifecycle v3.ProjectCatalogLifecycle) {
+	sync := newProjectCatalogLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *projectCatalogClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v3.ProjectCatalogLifecycle) {
+	sync := newProjectCatalogLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *projectCatalogClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v3.ProjectCatalogHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *projectCatalogClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v3.ProjectCatalogHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *projectCatalogClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v3.ProjectCatalogLifecycle) {
+	sync := newProjectCatalogLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *projectCatalogClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v3.ProjectCatalogLifecycle) {
+	sync := newProjectCatalogLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type projectCatalogController struct {
+	controller.GenericController
+}
+
+func (c *projectCatalogController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *projectCatalogController) Lister() v3.ProjectCatalogLister {
+	return &projectCatalogLister{
+		controller: c,
+	}
+}
+
+func (c *projectCatalogController) AddHandler(ctx context.Context, name string, handler v3.ProjectCatalogHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ProjectCatalog); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *projectCatalogController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v3.ProjectCatalogHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ProjectCatalog); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *projectCatalogController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v3.ProjectCatalogHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ProjectCatalog); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *projectCatalogController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v3.ProjectCatalogHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ProjectCatalog); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type projectCatalogLister struct {
+	controller *projectCatalogController
+}
+
+func (l *projectCatalogLister) List(namespace string, selector labels.Selector) (ret []*v3.ProjectCatalog, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v3.ProjectCatalog))
+	})
+	return
+}
+
+func (l *projectCatalogLister) Get(namespace, name string) (*v3.ProjectCatalog, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v3.ProjectCatalogGroupVersionKind.Group,
+			Resource: "projectCatalog",
+		}, key)
+	}
+	return obj.(*v3.ProjectCatalog), nil
+}
+
+type projectCatalogLifecycleAdapter struct {
+	lifecycle v3.ProjectCatalogLifecycle
+}
+
+func (w *projectCatalogLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *projectCatalogLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *projectCatalogLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v3.ProjectCatalog))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *projectCatalogLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v3.ProjectCatalog))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *projectCatalogLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v3.ProjectCatalog))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newProjectCatalogLifecycleAdapter(name string, clusterScoped bool, client *projectCatalogClient, l v3.ProjectCatalogLifecycle) v3.ProjectCatalogHandlerFunc {
+	adapter := &projectCatalogLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v3.ProjectCatalog) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+
+type clusterCatalogClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *clusterCatalogClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *clusterCatalogClient) Create(o *v3.ClusterCatalog) (*v3.ClusterCatalog, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.ClusterCatalog), nil
+}
+
+func (s *clusterCatalogClient) Get(name string, opts metav1.GetOptions) (*v3.ClusterCatalog, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.ClusterCatalog), nil
+}
+
+func (s *clusterCatalogClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.ClusterCatalog, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.ClusterCatalog), nil
+}
+
+func (s *clusterCatalogClient) Update(o *v3.ClusterCatalog) (*v3.ClusterCatalog, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.ClusterCatalog), nil
+}
+
+func (s *clusterCatalogClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *clusterCatalogClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *clusterCatalogClient) List(opts metav1.ListOptions) (*v3.ClusterCatalogList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v3.ClusterCatalogList{}
+	list.APIVersion = v3.ClusterCatalogGroupVersionKind.GroupVersion().String()
+	list.Kind = v3.ClusterCatalogGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v3.ClusterCatalog))
+	}
+	return list, nil
+}
+
+func (s *clusterCatalogClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *clusterCatalogClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *clusterCatalogClient) Controller() v3.ClusterCatalogController {
+	return &clusterCatalogController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *clusterCatalogClient) AddHandler(ctx context.Context, name string, sync v3.ClusterCatalogHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *clusterCatalogClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v3.ClusterCatalogHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *clusterCatalogClient) AddLifecycle(ctx context.Context, name string, lifecycle v3.ClusterCatalogLifecycle) {
+	sync := newClusterCatalogLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *clusterCatalogClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v3.ClusterCatalogLifecycle) {
+	sync := newClusterCatalogLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *clusterCatalogClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v3.ClusterCatalogHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *clusterCatalogClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v3.ClusterCatalogHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *clusterCatalogClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v3.ClusterCatalogLifecycle) {
+	sync := newClusterCatalogLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *clusterCatalogClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v3.ClusterCatalogLifecycle) {
+	sync := newClusterCatalogLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type clusterCatalogController struct {
+	controller.GenericController
+}
+
+func (c *clusterCatalogController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *clusterCatalogController) Lister() v3.ClusterCatalogLister {
+	return &clusterCatalogLister{
+		controller: c,
+	}
+}
+
+func (c *clusterCatalogController) AddHandler(ctx context.Context, name string, handler v3.ClusterCatalogHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ClusterCatalog); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *clusterCatalogController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v3.ClusterCatalogHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ClusterCatalog); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *clusterCatalogController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v3.ClusterCatalogHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ClusterCatalog); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *clusterCatalogController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v3.ClusterCatalogHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ClusterCatalog); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type clusterCatalogLister struct {
+	controller *clusterCatalogController
+}
+
+func (l *clusterCatalogLister) List(namespace string, selector labels.Selector) (ret []*v3.ClusterCatalog, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v3.ClusterCatalog))
+	})
+	return
+}
+
+func (l *clusterCatalogLister) Get(namespace, name string) (*v3.ClusterCatalog, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v3.ClusterCatalogGroupVersionKind.Group,
+			Resource: "clusterCatalog",
+		}, key)
+	}
+	return obj.(*v3.ClusterCatalog), nil
+}
+
+type clusterCatalogLifecycleAdapter struct {
+	lifecycle v3.ClusterCatalogLifecycle
+}
+
+func (w *clusterCatalogLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *clusterCatalogLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *clusterCatalogLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v3.ClusterCatalog))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *clusterCatalogLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v3.ClusterCatalog))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *clusterCatalogLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v3.ClusterCatalog))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newClusterCatalogLifecycleAdapter(name string, clusterScoped bool, client *clusterCatalogClient, l v3.ClusterCatalogLifecycle) v3.ClusterCatalogHandlerFunc {
+	adapter := &clusterCatalogLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v3.ClusterCatalog) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+
+type multiClusterAppClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *multiClusterAppClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *multiClusterAppClient) Create(o *v3.MultiClusterApp) (*v3.MultiClusterApp, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.MultiClusterApp), nil
+}
+
+func (s *multiClusterAppClient) Get(name string, opts metav1.GetOptions) (*v3.MultiClusterApp, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.MultiClusterApp), nil
+}
+
+func (s *multiClusterAppClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.MultiClusterApp, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.MultiClusterApp), nil
+}
+
+func (s *multiClusterAppClient) Update(o *v3.MultiClusterApp) (*v3.MultiClusterApp, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.MultiClusterApp), nil
+}
+
+func (s *multiClusterAppClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *multiClusterAppClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *multiClusterAppClient) List(opts metav1.ListOptions) (*v3.MultiClusterAppList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v3.MultiClusterAppList{}
+	list.APIVersion = v3.MultiClusterAppGroupVersionKind.GroupVersion().String()
+	list.Kind = v3.MultiClusterAppGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v3.MultiClusterApp))
+	}
+	return list, nil
+}
+
+func (s *multiClusterAppClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *multiClusterAppClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *multiClusterAppClient) Controller() v3.MultiClusterAppController {
+	return &multiClusterAppController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *multiClusterAppClient) AddHandler(ctx context.Context, name string, sync v3.MultiClusterAppHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *multiClusterAppClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v3.MultiClusterAppHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *multiClusterAppClient) AddLifecycle(ctx context.Context, name string, lifecycle v3.MultiClusterAppLifecycle) {
+	sync := newMultiClusterAppLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *multiClusterAppClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v3.MultiClusterAppLifecycle) {
+	sync := newMultiClusterAppLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *multiClusterAppClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v3.MultiClusterAppHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *multiClusterAppClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v3.MultiClusterAppHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *multiClusterAppClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v3.MultiClusterAppLifecycle) {
+	sync := newMultiClusterAppLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *multiClusterAppClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v3.MultiClusterAppLifecycle) {
+	sync := newMultiClusterAppLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type multiClusterAppController struct {
+	controller.GenericController
+}
+
+func (c *multiClusterAppController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *multiClusterAppController) Lister() v3.MultiClusterAppLister {
+	return &multiClusterAppLister{
+		controller: c,
+	}
+}
+
+func (c *multiClusterAppController) AddHandler(ctx context.Context, name string, handler v3.MultiClusterAppHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.MultiClusterApp); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *multiClusterAppController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v3.MultiClusterAppHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.MultiClusterApp); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *multiClusterAppController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v3.MultiClusterAppHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.MultiClusterApp); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *multiClusterAppController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v3.MultiClusterAppHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.MultiClusterApp); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type multiClusterAppLister struct {
+	controller *multiClusterAppController
+}
+
+func (l *multiClusterAppLister) List(namespace string, selector labels.Selector) (ret []*v3.MultiClusterApp, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v3.MultiClusterApp))
+	})
+	return
+}
+
+func (l *multiClusterAppLister) Get(namespace, name string) (*v3.MultiClusterApp, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v3.MultiClusterAppGroupVersionKind.Group,
+			Resource: "multiClusterApp",
+		}, key)
+	}
+	return obj.(*v3.MultiClusterApp), nil
+}
+
+type multiClusterAppLifecycleAdapter struct {
+	lifecycle v3.MultiClusterAppLifecycle
+}
+
+func (w *multiClusterAppLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *multiClusterAppLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *multiClusterAppLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v3.MultiClusterApp))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *multiClusterAppLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v3.MultiClusterApp))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *multiClusterAppLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v3.MultiClusterApp))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newMultiClusterAppLifecycleAdapter(name string, clusterScoped bool, client *multiClusterAppClient, l v3.MultiClusterAppLifecycle) v3.MultiClusterAppHandlerFunc {
+	adapter := &multiClusterAppLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v3.MultiClusterApp) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+
+type multiClusterAppRevisionClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *multiClusterAppRevisionClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *multiClusterAppRevisionClient) Create(o *v3.MultiClusterAppRevision) (*v3.MultiClusterAppRevision, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.MultiClusterAppRevision), nil
+}
+
+func (s *multiClusterAppRevisionClient) Get(name string, opts metav1.GetOptions) (*v3.MultiClusterAppRevision, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.MultiClusterAppRevision), nil
+}
+
+func (s *multiClusterAppRevisionClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.MultiClusterAppRevision, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.MultiClusterAppRevision), nil
+}
+
+func (s *multiClusterAppRevisionClient) Update(o *v3.MultiClusterAppRevision) (*v3.MultiClusterAppRevision, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.MultiClusterAppRevision), nil
+}
+
+func (s *multiClusterAppRevisionClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *multiClusterAppRevisionClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *multiClusterAppRevisionClient) List(opts metav1.ListOptions) (*v3.MultiClusterAppRevisionList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v3.MultiClusterAppRevisionList{}
+	list.APIVersion = v3.MultiClusterAppRevisionGroupVersionKind.GroupVersion().String()
+	list.Kind = v3.MultiClusterAppRevisionGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v3.MultiClusterAppRevision))
+	}
+	return list, nil
+}
+
+func (s *multiClusterAppRevisionClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *multiClusterAppRevisionClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *multiClusterAppRevisionClient) Controller() v3.MultiClusterAppRevisionController {
+	return &multiClusterAppRevisionController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *multiClusterAppRevisionClient) AddHandler(ctx context.Context, name string, sync v3.MultiClusterAppRevisionHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *multiClusterAppRevisionClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v3.MultiClusterAppRevisionHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *multiClusterAppRevisionClient) AddLifecycle(ctx context.Context, name string, lifecycle v3.MultiClusterAppRevisionLifecycle) {
+	sync := newMultiClusterAppRevisionLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *multiClusterAppRevisionClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v3.MultiClusterAppRevisionLifecycle) {
+	sync := newMultiClusterAppRevisionLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *multiClusterAppRevisionClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v3.MultiClusterAppRevisionHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *multiClusterAppRevisionClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v3.MultiClusterAppRevisionHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *multiClusterAppRevisionClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v3.MultiClusterAppRevisionLifecycle) {
+	sync := newMultiClusterAppRevisionLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *multiClusterAppRevisionClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v3.MultiClusterAppRevisionLifecycle) {
+	sync := newMultiClusterAppRevisionLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type multiClusterAppRevisionController struct {
+	controller.GenericController
+}
+
+func (c *multiClusterAppRevisionController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *multiClusterAppRevisionController) Lister() v3.MultiClusterAppRevisionLister {
+	return &multiClusterAppRevisionLister{
+		controller: c,
+	}
+}
+
+func (c *multiClusterAppRevisionController) AddHandler(ctx context.Context, name string, handler v3.MultiClusterAppRevisionHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.MultiClusterAppRevision); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *multiClusterAppRevisionController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v3.MultiClusterAppRevisionHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.MultiClusterAppRevision); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *multiClusterAppRevisionController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v3.MultiClusterAppRevisionHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.MultiClusterAppRevision); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *multiClusterAppRevisionController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v3.MultiClusterAppRevisionHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.MultiClusterAppRevision); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type multiClusterAppRevisionLister struct {
+	controller *multiClusterAppRevisionController
+}
+
+func (l *multiClusterAppRevisionLister) List(namespace string, selector labels.Selector) (ret []*v3.MultiClusterAppRevision, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v3.MultiClusterAppRevision))
+	})
+	return
+}
+
+func (l *multiClusterAppRevisionLister) Get(namespace, name string) (*v3.MultiClusterAppRevision, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v3.MultiClusterAppRevisionGroupVersionKind.Group,
+			Resource: "multiClusterAppRevision",
+		}, key)
+	}
+	return obj.(*v3.MultiClusterAppRevision), nil
+}
+
+type multiClusterAppRevisionLifecycleAdapter struct {
+	lifecycle v3.MultiClusterAppRevisionLifecycle
+}
+
+func (w *multiClusterAppRevisionLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *multiClusterAppRevisionLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *multiClusterAppRevisionLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v3.MultiClusterAppRevision))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *multiClusterAppRevisionLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v3.MultiClusterAppRevision))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *multiClusterAppRevisionLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v3.MultiClusterAppRevision))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newMultiClusterAppRevisionLifecycleAdapter(name string, clusterScoped bool, client *multiClusterAppRevisionClient, l v3.MultiClusterAppRevisionLifecycle) v3.MultiClusterAppRevisionHandlerFunc {
+	adapter := &multiClusterAppRevisionLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v3.MultiClusterAppRevision) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+
+type globalDnsClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *globalDnsClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *globalDnsClient) Create(o *v3.GlobalDNS) (*v3.GlobalDNS, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.GlobalDNS), nil
+}
+
+func (s *globalDnsClient) Get(name string, opts metav1.GetOptions) (*v3.GlobalDNS, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.GlobalDNS), nil
+}
+
+func (s *globalDnsClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.GlobalDNS, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.GlobalDNS), nil
+}
+
+func (s *globalDnsClient) Update(o *v3.GlobalDNS) (*v3.GlobalDNS, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.GlobalDNS), nil
+}
+
+func (s *globalDnsClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *globalDnsClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *globalDnsClient) List(opts metav1.ListOptions) (*v3.GlobalDNSList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v3.GlobalDNSList{}
+	list.APIVersion = v3.GlobalDNSGroupVersionKind.GroupVersion().String()
+	list.Kind = v3.GlobalDNSGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v3.GlobalDNS))
+	}
+	return list, nil
+}
+
+func (s *globalDnsClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *globalDnsClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *globalDnsClient) Controller() v3.GlobalDNSController {
+	return &globalDnsController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *globalDnsClient) AddHandler(ctx context.Context, name string, sync v3.GlobalDNSHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *globalDnsClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v3.GlobalDNSHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *globalDnsClient) AddLifecycle(ctx context.Context, name string, lifecycle v3.GlobalDNSLifecycle) {
+	sync := newGlobalDNSLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *globalDnsClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v3.GlobalDNSLifecycle) {
+	sync := newGlobalDNSLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *globalDnsClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v3.GlobalDNSHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *globalDnsClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v3.GlobalDNSHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *globalDnsClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v3.GlobalDNSLifecycle) {
+	sync := newGlobalDNSLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *globalDnsClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v3.GlobalDNSLifecycle) {
+	sync := newGlobalDNSLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type globalDnsController struct {
+	controller.GenericController
+}
+
+func (c *globalDnsController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *globalDnsController) Lister() v3.GlobalDNSLister {
+	return &globalDnsLister{
+		controller: c,
+	}
+}
+
+func (c *globalDnsController) AddHandler(ctx context.Context, name string, handler v3.GlobalDNSHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.GlobalDNS); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *globalDnsController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v3.GlobalDNSHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.GlobalDNS); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *globalDnsController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v3.GlobalDNSHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.GlobalDNS); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *globalDnsController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v3.GlobalDNSHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.GlobalDNS); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type globalDnsLister struct {
+	controller *globalDnsController
+}
+
+func (l *globalDnsLister) List(namespace string, selector labels.Selector) (ret []*v3.GlobalDNS, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v3.GlobalDNS))
+	})
+	return
+}
+
+func (l *globalDnsLister) Get(namespace, name string) (*v3.GlobalDNS, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v3.GlobalDNSGroupVersionKind.Group,
+			Resource: "globalDns",
+		}, key)
+	}
+	return obj.(*v3.GlobalDNS), nil
+}
+
+type globalDnsLifecycleAdapter struct {
+	lifecycle v3.GlobalDNSLifecycle
+}
+
+func (w *globalDnsLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *globalDnsLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *globalDnsLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v3.GlobalDNS))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *globalDnsLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v3.GlobalDNS))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *globalDnsLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v3.GlobalDNS))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newGlobalDNSLifecycleAdapter(name string, clusterScoped bool, client *globalDnsClient, l v3.GlobalDNSLifecycle) v3.GlobalDNSHandlerFunc {
+	adapter := &globalDnsLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v3.GlobalDNS) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+
+type globalDnsProviderClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *globalDnsProviderClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *globalDnsProviderClient) Create(o *v3.GlobalDNSProvider) (*v3.GlobalDNSProvider, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.GlobalDNSProvider), nil
+}
+
+func (s *globalDnsProviderClient) Get(name string, opts metav1.GetOptions) (*v3.GlobalDNSProvider, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.GlobalDNSProvider), nil
+}
+
+func (s *globalDnsProviderClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.GlobalDNSProvider, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.GlobalDNSProvider), nil
+}
+
+func (s *globalDnsProviderClient) Update(o *v3.GlobalDNSProvider) (*v3.GlobalDNSProvider, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.GlobalDNSProvider), nil
+}
+
+func (s *globalDnsProviderClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *globalDnsProviderClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *globalDnsProviderClient) List(opts metav1.ListOptions) (*v3.GlobalDNSProviderList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v3.GlobalDNSProviderList{}
+	list.APIVersion = v3.GlobalDNSProviderGroupVersionKind.GroupVersion().String()
+	list.Kind = v3.GlobalDNSProviderGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v3.GlobalDNSProvider))
+	}
+	return list, nil
+}
+
+func (s *globalDnsProviderClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *globalDnsProviderClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *globalDnsProviderClient) Controller() v3.GlobalDNSProviderController {
+	return &globalDnsProviderController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *globalDnsProviderClient) AddHandler(ctx context.Context, name string, sync v3.GlobalDNSProviderHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *globalDnsProviderClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v3.GlobalDNSProviderHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *globalDnsProviderClient) AddLifecycle(ctx context.Context, name string, lifecycle v3.GlobalDNSProviderLifecycle) {
+	sync := newGlobalDNSProviderLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *globalDnsProviderClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v3.GlobalDNSProviderLifecycle) {
+	sync := newGlobalDNSProviderLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *globalDnsProviderClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v3.GlobalDNSProviderHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *globalDnsProviderClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v3.GlobalDNSProviderHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *globalDnsProviderClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v3.GlobalDNSProviderLifecycle) {
+	sync := newGlobalDNSProviderLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *globalDnsProviderClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v3.GlobalDNSProviderLifecycle) {
+	sync := newGlobalDNSProviderLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type globalDnsProviderController struct {
+	controller.GenericController
+}
+
+func (c *globalDnsProviderController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *globalDnsProviderController) Lister() v3.GlobalDNSProviderLister {
+	return &globalDnsProviderLister{
+		controller: c,
+	}
+}
+
+func (c *globalDnsProviderController) AddHandler(ctx context.Context, name string, handler v3.GlobalDNSProviderHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.GlobalDNSProvider); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *globalDnsProviderController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v3.GlobalDNSProviderHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.GlobalDNSProvider); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *globalDnsProviderController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v3.GlobalDNSProviderHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.GlobalDNSProvider); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *globalDnsProviderController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v3.GlobalDNSProviderHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.GlobalDNSProvider); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type globalDnsProviderLister struct {
+	controller *globalDnsProviderController
+}
+
+func (l *globalDnsProviderLister) List(namespace string, selector labels.Selector) (ret []*v3.GlobalDNSProvider, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v3.GlobalDNSProvider))
+	})
+	return
+}
+
+func (l *globalDnsProviderLister) Get(namespace, name string) (*v3.GlobalDNSProvider, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v3.GlobalDNSProviderGroupVersionKind.Group,
+			Resource: "globalDnsProvider",
+		}, key)
+	}
+	return obj.(*v3.GlobalDNSProvider), nil
+}
+
+type globalDnsProviderLifecycleAdapter struct {
+	lifecycle v3.GlobalDNSProviderLifecycle
+}
+
+func (w *globalDnsProviderLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *globalDnsProviderLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *globalDnsProviderLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v3.GlobalDNSProvider))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *globalDnsProviderLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v3.GlobalDNSProvider))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *globalDnsProviderLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v3.GlobalDNSProvider))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newGlobalDNSProviderLifecycleAdapter(name string, clusterScoped bool, client *globalDnsProviderClient, l v3.GlobalDNSProviderLifecycle) v3.GlobalDNSProviderHandlerFunc {
+	adapter := &globalDnsProviderLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v3.GlobalDNSProvider) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+
+type kontainerDriverClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *kontainerDriverClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *kontainerDriverClient) Create(o *v3.KontainerDriver) (*v3.KontainerDriver, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.KontainerDriver), nil
+}
+
+func (s *kontainerDriverClient) Get(name string, opts metav1.GetOptions) (*v3.KontainerDriver, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.KontainerDriver), nil
+}
+
+func (s *kontainerDriverClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.KontainerDriver, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.KontainerDriver), nil
+}
+
+func (s *kontainerDriverClient) Update(o *v3.KontainerDriver) (*v3.KontainerDriver, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.KontainerDriver), nil
+}
+
+func (s *kontainerDriverClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *kontainerDriverClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *kontainerDriverClient) List(opts metav1.ListOptions) (*v3.KontainerDriverList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v3.KontainerDriverList{}
+	list.APIVersion = v3.KontainerDriverGroupVersionKind.GroupVersion().String()
+	list.Kind = v3.KontainerDriverGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v3.KontainerDriver))
+	}
+	return list, nil
+}
+
+func (s *kontainerDriverClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *kontainerDriverClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *kontainerDriverClient) Controller() v3.KontainerDriverController {
+	return &kontainerDriverController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *kontainerDriverClient) AddHandler(ctx context.Context, name string, sync v3.KontainerDriverHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *kontainerDriverClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v3.KontainerDriverHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *kontainerDriverClient) AddLifecycle(ctx context.Context, name string, lifecycle v3.KontainerDriverLifecycle) {
+	sync := newKontainerDriverLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *kontainerDriverClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v3.KontainerDriverLifecycle) {
+	sync := newKontainerDriverLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *kontainerDriverClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v3.KontainerDriverHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *kontainerDriverClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v3.KontainerDriverHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *kontainerDriverClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v3.KontainerDriverLifecycle) {
+	sync := newKontainerDriverLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *kontainerDriverClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v3.KontainerDriverLifecycle) {
+	sync := newKontainerDriverLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type kontainerDriverController struct {
+	controller.GenericController
+}
+
+func (c *kontainerDriverController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *kontainerDriverController) Lister() v3.KontainerDriverLister {
+	return &kontainerDriverLister{
+		controller: c,
+	}
+}
+
+func (c *kontainerDriverController) AddHandler(ctx context.Context, name string, handler v3.KontainerDriverHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.KontainerDriver); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *kontainerDriverController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v3.KontainerDriverHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.KontainerDriver); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *kontainerDriverController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v3.KontainerDriverHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.KontainerDriver); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *kontainerDriverController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v3.KontainerDriverHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.KontainerDriver); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type kontainerDriverLister struct {
+	controller *kontainerDriverController
+}
+
+func (l *kontainerDriverLister) List(namespace string, selector labels.Selector) (ret []*v3.KontainerDriver, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v3.KontainerDriver))
+	})
+	return
+}
+
+func (l *kontainerDriverLister) Get(namespace, name string) (*v3.KontainerDriver, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v3.KontainerDriverGroupVersionKind.Group,
+			Resource: "kontainerDriver",
+		}, key)
+	}
+	return obj.(*v3.KontainerDriver), nil
+}
+
+type kontainerDriverLifecycleAdapter struct {
+	lifecycle v3.KontainerDriverLifecycle
+}
+
+func (w *kontainerDriverLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *kontainerDriverLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *kontainerDriverLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v3.KontainerDriver))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *kontainerDriverLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v3.KontainerDriver))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *kontainerDriverLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v3.KontainerDriver))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newKontainerDriverLifecycleAdapter(name string, clusterScoped bool, client *kontainerDriverClient, l v3.KontainerDriverLifecycle) v3.KontainerDriverHandlerFunc {
+	adapter := &kontainerDriverLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v3.KontainerDriver) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+
+type etcdBackupClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *etcdBackupClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *etcdBackupClient) Create(o *v3.EtcdBackup) (*v3.EtcdBackup, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.EtcdBackup), nil
+}
+
+func (s *etcdBackupClient) Get(name string, opts metav1.GetOptions) (*v3.EtcdBackup, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.EtcdBackup), nil
+}
+
+func (s *etcdBackupClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.EtcdBackup, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.EtcdBackup), nil
+}
+
+func (s *etcdBackupClient) Update(o *v3.EtcdBackup) (*v3.EtcdBackup, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.EtcdBackup), nil
+}
+
+func (s *etcdBackupClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *etcdBackupClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *etcdBackupClient) List(opts metav1.ListOptions) (*v3.EtcdBackupList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v3.EtcdBackupList{}
+	list.APIVersion = v3.EtcdBackupGroupVersionKind.GroupVersion().String()
+	list.Kind = v3.EtcdBackupGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v3.EtcdBackup))
+	}
+	return list, nil
+}
+
+func (s *etcdBackupClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *etcdBackupClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *etcdBackupClient) Controller() v3.EtcdBackupController {
+	return &etcdBackupController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *etcdBackupClient) AddHandler(ctx context.Context, name string, sync v3.EtcdBackupHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *etcdBackupClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v3.EtcdBackupHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *etcdBackupClient) AddLifecycle(ctx context.Context, name string, lifecycle v3.EtcdBackupLifecycle) {
+	sync := newEtcdBackupLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *etcdBackupClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v3.EtcdBackupLifecycle) {
+	sync := newEtcdBackupLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *etcdBackupClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v3.EtcdBackupHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *etcdBackupClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v3.EtcdBackupHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *etcdBackupClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v3.EtcdBackupLifecycle) {
+	sync := newEtcdBackupLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *etcdBackupClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v3.EtcdBackupLifecycle) {
+	sync := newEtcdBackupLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type etcdBackupController struct {
+	controller.GenericController
+}
+
+func (c *etcdBackupController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *etcdBackupController) Lister() v3.EtcdBackupLister {
+	return &etcdBackupLister{
+		controller: c,
+	}
+}
+
+func (c *etcdBackupController) AddHandler(ctx context.Context, name string, handler v3.EtcdBackupHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.EtcdBackup); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *etcdBackupController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v3.EtcdBackupHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.EtcdBackup); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *etcdBackupController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v3.EtcdBackupHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.EtcdBackup); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *etcdBackupController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v3.EtcdBackupHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.EtcdBackup); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type etcdBackupLister struct {
+	controller *etcdBackupController
+}
+
+func (l *etcdBackupLister) List(namespace string, selector labels.Selector) (ret []*v3.EtcdBackup, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v3.EtcdBackup))
+	})
+	return
+}
+
+func (l *etcdBackupLister) Get(namespace, name string) (*v3.EtcdBackup, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v3.EtcdBackupGroupVersionKind.Group,
+			Resource: "etcdBackup",
+		}, key)
+	}
+	return obj.(*v3.EtcdBackup), nil
+}
+
+type etcdBackupLifecycleAdapter struct {
+	lifecycle v3.EtcdBackupLifecycle
+}
+
+func (w *etcdBackupLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *etcdBackupLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *etcdBackupLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v3.EtcdBackup))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *etcdBackupLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v3.EtcdBackup))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *etcdBackupLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v3.EtcdBackup))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newEtcdBackupLifecycleAdapter(name string, clusterScoped bool, client *etcdBackupClient, l v3.EtcdBackupLifecycle) v3.EtcdBackupHandlerFunc {
+	adapter := &etcdBackupLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v3.EtcdBackup) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+
+type clusterScanClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *clusterScanClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *clusterScanClient) Create(o *v3.ClusterScan) (*v3.ClusterScan, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.ClusterScan), nil
+}
+
+func (s *clusterScanClient) Get(name string, opts metav1.GetOptions) (*v3.ClusterScan, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.ClusterScan), nil
+}
+
+func (s *clusterScanClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.ClusterScan, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.ClusterScan), nil
+}
+
+func (s *clusterScanClient) Update(o *v3.ClusterScan) (*v3.ClusterScan, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.ClusterScan), nil
+}
+
+func (s *clusterScanClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *clusterScanClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *clusterScanClient) List(opts metav1.ListOptions) (*v3.ClusterScanList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v3.ClusterScanList{}
+	list.APIVersion = v3.ClusterScanGroupVersionKind.GroupVersion().String()
+	list.Kind = v3.ClusterScanGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v3.ClusterScan))
+	}
+	return list, nil
+}
+
+func (s *clusterScanClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *clusterScanClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *clusterScanClient) Controller() v3.ClusterScanController {
+	return &clusterScanController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *clusterScanClient) AddHandler(ctx context.Context, name string, sync v3.ClusterScanHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *clusterScanClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v3.ClusterScanHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *clusterScanClient) AddLifecycle(ctx context.Context, name string, lifecycle v3.ClusterScanLifecycle) {
+	sync := newClusterScanLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *clusterScanClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v3.ClusterScanLifecycle) {
+	sync := newClusterScanLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *clusterScanClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v3.ClusterScanHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *clusterScanClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v3.ClusterScanHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *clusterScanClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v3.ClusterScanLifecycle) {
+	sync := newClusterScanLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *clusterScanClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v3.ClusterScanLifecycle) {
+	sync := newClusterScanLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type clusterScanController struct {
+	controller.GenericController
+}
+
+func (c *clusterScanController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *clusterScanController) Lister() v3.ClusterScanLister {
+	return &clusterScanLister{
+		controller: c,
+	}
+}
+
+func (c *clusterScanController) AddHandler(ctx context.Context, name string, handler v3.ClusterScanHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ClusterScan); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *clusterScanController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v3.ClusterScanHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ClusterScan); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *clusterScanController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v3.ClusterScanHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ClusterScan); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *clusterScanController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v3.ClusterScanHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ClusterScan); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type clusterScanLister struct {
+	controller *clusterScanController
+}
+
+func (l *clusterScanLister) List(namespace string, selector labels.Selector) (ret []*v3.ClusterScan, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v3.ClusterScan))
+	})
+	return
+}
+
+func (l *clusterScanLister) Get(namespace, name string) (*v3.ClusterScan, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v3.ClusterScanGroupVersionKind.Group,
+			Resource: "clusterScan",
+		}, key)
+	}
+	return obj.(*v3.ClusterScan), nil
+}
+
+type clusterScanLifecycleAdapter struct {
+	lifecycle v3.ClusterScanLifecycle
+}
+
+func (w *clusterScanLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *clusterScanLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *clusterScanLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v3.ClusterScan))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *clusterScanLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v3.ClusterScan))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *clusterScanLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v3.ClusterScan))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newClusterScanLifecycleAdapter(name string, clusterScoped bool, client *clusterScanClient, l v3.ClusterScanLifecycle) v3.ClusterScanHandlerFunc {
+	adapter := &clusterScanLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v3.ClusterScan) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+
+type monitorMetricClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *monitorMetricClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *monitorMetricClient) Create(o *v3.MonitorMetric) (*v3.MonitorMetric, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.MonitorMetric), nil
+}
+
+func (s *monitorMetricClient) Get(name string, opts metav1.GetOptions) (*v3.MonitorMetric, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.MonitorMetric), nil
+}
+
+func (s *monitorMetricClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.MonitorMetric, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.MonitorMetric), nil
+}
+
+func (s *monitorMetricClient) Update(o *v3.MonitorMetric) (*v3.MonitorMetric, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.MonitorMetric), nil
+}
+
+func (s *monitorMetricClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *monitorMetricClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *monitorMetricClient) List(opts metav1.ListOptions) (*v3.MonitorMetricList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v3.MonitorMetricList{}
+	list.APIVersion = v3.MonitorMetricGroupVersionKind.GroupVersion().String()
+	list.Kind = v3.MonitorMetricGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v3.MonitorMetric))
+	}
+	return list, nil
+}
+
+func (s *monitorMetricClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *monitorMetricClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *monitorMetricClient) Controller() v3.MonitorMetricController {
+	return &monitorMetricController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *monitorMetricClient) AddHandler(ctx context.Context, name string, sync v3.MonitorMetricHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *monitorMetricClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v3.MonitorMetricHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *monitorMetricClient) AddLifecycle(ctx context.Context, name string, lifecycle v3.MonitorMetricLifecycle) {
+	sync := newMonitorMetricLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *monitorMetricClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v3.MonitorMetricLifecycle) {
+	sync := newMonitorMetricLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *monitorMetricClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v3.MonitorMetricHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *monitorMetricClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v3.MonitorMetricHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *monitorMetricClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v3.MonitorMetricLifecycle) {
+	sync := newMonitorMetricLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *monitorMetricClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v3.MonitorMetricLifecycle) {
+	sync := newMonitorMetricLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type monitorMetricController struct {
+	controller.GenericController
+}
+
+func (c *monitorMetricController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *monitorMetricController) Lister() v3.MonitorMetricLister {
+	return &monitorMetricLister{
+		controller: c,
+	}
+}
+
+func (c *monitorMetricController) AddHandler(ctx context.Context, name string, handler v3.MonitorMetricHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.MonitorMetric); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *monitorMetricController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v3.MonitorMetricHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.MonitorMetric); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *monitorMetricController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v3.MonitorMetricHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.MonitorMetric); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *monitorMetricController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v3.MonitorMetricHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.MonitorMetric); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type monitorMetricLister struct {
+	controller *monitorMetricController
+}
+
+func (l *monitorMetricLister) List(namespace string, selector labels.Selector) (ret []*v3.MonitorMetric, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v3.MonitorMetric))
+	})
+	return
+}
+
+func (l *monitorMetricLister) Get(namespace, name string) (*v3.MonitorMetric, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v3.MonitorMetricGroupVersionKind.Group,
+			Resource: "monitorMetric",
+		}, key)
+	}
+	return obj.(*v3.MonitorMetric), nil
+}
+
+type monitorMetricLifecycleAdapter struct {
+	lifecycle v3.MonitorMetricLifecycle
+}
+
+func (w *monitorMetricLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *monitorMetricLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *monitorMetricLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v3.MonitorMetric))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *monitorMetricLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v3.MonitorMetric))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *monitorMetricLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v3.MonitorMetric))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newMonitorMetricLifecycleAdapter(name string, clusterScoped bool, client *monitorMetricClient, l v3.MonitorMetricLifecycle) v3.MonitorMetricHandlerFunc {
+	adapter := &monitorMetricLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v3.MonitorMetric) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+
+type clusterMonitorGraphClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *clusterMonitorGraphClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *clusterMonitorGraphClient) Create(o *v3.ClusterMonitorGraph) (*v3.ClusterMonitorGraph, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.ClusterMonitorGraph), nil
+}
+
+func (s *clusterMonitorGraphClient) Get(name string, opts metav1.GetOptions) (*v3.ClusterMonitorGraph, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.ClusterMonitorGraph), nil
+}
+
+func (s *clusterMonitorGraphClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.ClusterMonitorGraph, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.ClusterMonitorGraph), nil
+}
+
+func (s *clusterMonitorGraphClient) Update(o *v3.ClusterMonitorGraph) (*v3.ClusterMonitorGraph, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.ClusterMonitorGraph), nil
+}
+
+func (s *clusterMonitorGraphClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *clusterMonitorGraphClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *clusterMonitorGraphClient) List(opts metav1.ListOptions) (*v3.ClusterMonitorGraphList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v3.ClusterMonitorGraphList{}
+	list.APIVersion = v3.ClusterMonitorGraphGroupVersionKind.GroupVersion().String()
+	list.Kind = v3.ClusterMonitorGraphGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v3.ClusterMonitorGraph))
+	}
+	return list, nil
+}
+
+func (s *clusterMonitorGraphClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *clusterMonitorGraphClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *clusterMonitorGraphClient) Controller() v3.ClusterMonitorGraphController {
+	return &clusterMonitorGraphController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *clusterMonitorGraphClient) AddHandler(ctx context.Context, name string, sync v3.ClusterMonitorGraphHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *clusterMonitorGraphClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v3.ClusterMonitorGraphHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *clusterMonitorGraphClient) AddLifecycle(ctx context.Context, name string, lifecycle v3.ClusterMonitorGraphLifecycle) {
+	sync := newClusterMonitorGraphLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *clusterMonitorGraphClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v3.ClusterMonitorGraphLifecycle) {
+	sync := newClusterMonitorGraphLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *clusterMonitorGraphClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v3.ClusterMonitorGraphHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *clusterMonitorGraphClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v3.ClusterMonitorGraphHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *clusterMonitorGraphClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v3.ClusterMonitorGraphLifecycle) {
+	sync := newClusterMonitorGraphLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *clusterMonitorGraphClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v3.ClusterMonitorGraphLifecycle) {
+	sync := newClusterMonitorGraphLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type clusterMonitorGraphController struct {
+	controller.GenericController
+}
+
+func (c *clusterMonitorGraphController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *clusterMonitorGraphController) Lister() v3.ClusterMonitorGraphLister {
+	return &clusterMonitorGraphLister{
+		controller: c,
+	}
+}
+
+func (c *clusterMonitorGraphController) AddHandler(ctx context.Context, name string, handler v3.ClusterMonitorGraphHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ClusterMonitorGraph); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *clusterMonitorGraphController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v3.ClusterMonitorGraphHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ClusterMonitorGraph); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *clusterMonitorGraphController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v3.ClusterMonitorGraphHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ClusterMonitorGraph); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *clusterMonitorGraphController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v3.ClusterMonitorGraphHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ClusterMonitorGraph); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type clusterMonitorGraphLister struct {
+	controller *clusterMonitorGraphController
+}
+
+func (l *clusterMonitorGraphLister) List(namespace string, selector labels.Selector) (ret []*v3.ClusterMonitorGraph, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v3.ClusterMonitorGraph))
+	})
+	return
+}
+
+func (l *clusterMonitorGraphLister) Get(namespace, name string) (*v3.ClusterMonitorGraph, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v3.ClusterMonitorGraphGroupVersionKind.Group,
+			Resource: "clusterMonitorGraph",
+		}, key)
+	}
+	return obj.(*v3.ClusterMonitorGraph), nil
+}
+
+type clusterMonitorGraphLifecycleAdapter struct {
+	lifecycle v3.ClusterMonitorGraphLifecycle
+}
+
+func (w *clusterMonitorGraphLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *clusterMonitorGraphLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *clusterMonitorGraphLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v3.ClusterMonitorGraph))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *clusterMonitorGraphLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v3.ClusterMonitorGraph))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *clusterMonitorGraphLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v3.ClusterMonitorGraph))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newClusterMonitorGraphLifecycleAdapter(name string, clusterScoped bool, client *clusterMonitorGraphClient, l v3.ClusterMonitorGraphLifecycle) v3.ClusterMonitorGraphHandlerFunc {
+	adapter := &clusterMonitorGraphLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v3.ClusterMonitorGraph) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+
+type projectMonitorGraphClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *projectMonitorGraphClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *projectMonitorGraphClient) Create(o *v3.ProjectMonitorGraph) (*v3.ProjectMonitorGraph, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.ProjectMonitorGraph), nil
+}
+
+func (s *projectMonitorGraphClient) Get(name string, opts metav1.GetOptions) (*v3.ProjectMonitorGraph, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.ProjectMonitorGraph), nil
+}
+
+func (s *projectMonitorGraphClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.ProjectMonitorGraph, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.ProjectMonitorGraph), nil
+}
+
+func (s *projectMonitorGraphClient) Update(o *v3.ProjectMonitorGraph) (*v3.ProjectMonitorGraph, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.ProjectMonitorGraph), nil
+}
+
+func (s *projectMonitorGraphClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *projectMonitorGraphClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *projectMonitorGraphClient) List(opts metav1.ListOptions) (*v3.ProjectMonitorGraphList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v3.ProjectMonitorGraphList{}
+	list.APIVersion = v3.ProjectMonitorGraphGroupVersionKind.GroupVersion().String()
+	list.Kind = v3.ProjectMonitorGraphGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v3.ProjectMonitorGraph))
+	}
+	return list, nil
+}
+
+func (s *projectMonitorGraphClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *projectMonitorGraphClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *projectMonitorGraphClient) Controller() v3.ProjectMonitorGraphController {
+	return &projectMonitorGraphController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *projectMonitorGraphClient) AddHandler(ctx context.Context, name string, sync v3.ProjectMonitorGraphHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *projectMonitorGraphClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v3.ProjectMonitorGraphHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *projectMonitorGraphClient) AddLifecycle(ctx context.Context, name string, lifecycle v3.ProjectMonitorGraphLifecycle) {
+	sync := newProjectMonitorGraphLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *projectMonitorGraphClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v3.ProjectMonitorGraphLifecycle) {
+	sync := newProjectMonitorGraphLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *projectMonitorGraphClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v3.ProjectMonitorGraphHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *projectMonitorGraphClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v3.ProjectMonitorGraphHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *projectMonitorGraphClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v3.ProjectMonitorGraphLifecycle) {
+	sync := newProjectMonitorGraphLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *projectMonitorGraphClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v3.ProjectMonitorGraphLifecycle) {
+	sync := newProjectMonitorGraphLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type projectMonitorGraphController struct {
+	controller.GenericController
+}
+
+func (c *projectMonitorGraphController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *projectMonitorGraphController) Lister() v3.ProjectMonitorGraphLister {
+	return &projectMonitorGraphLister{
+		controller: c,
+	}
+}
+
+func (c *projectMonitorGraphController) AddHandler(ctx context.Context, name string, handler v3.ProjectMonitorGraphHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ProjectMonitorGraph); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *projectMonitorGraphController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v3.ProjectMonitorGraphHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ProjectMonitorGraph); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *projectMonitorGraphController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v3.ProjectMonitorGraphHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ProjectMonitorGraph); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *projectMonitorGraphController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v3.ProjectMonitorGraphHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ProjectMonitorGraph); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type projectMonitorGraphLister struct {
+	controller *projectMonitorGraphController
+}
+
+func (l *projectMonitorGraphLister) List(namespace string, selector labels.Selector) (ret []*v3.ProjectMonitorGraph, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v3.ProjectMonitorGraph))
+	})
+	return
+}
+
+func (l *projectMonitorGraphLister) Get(namespace, name string) (*v3.ProjectMonitorGraph, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v3.ProjectMonitorGraphGroupVersionKind.Group,
+			Resource: "projectMonitorGraph",
+		}, key)
+	}
+	return obj.(*v3.ProjectMonitorGraph), nil
+}
+
+type projectMonitorGraphLifecycleAdapter struct {
+	lifecycle v3.ProjectMonitorGraphLifecycle
+}
+
+func (w *projectMonitorGraphLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *projectMonitorGraphLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *projectMonitorGraphLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v3.ProjectMonitorGraph))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *projectMonitorGraphLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v3.ProjectMonitorGraph))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *projectMonitorGraphLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v3.ProjectMonitorGraph))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newProjectMonitorGraphLifecycleAdapter(name string, clusterScoped bool, client *projectMonitorGraphClient, l v3.ProjectMonitorGraphLifecycle) v3.ProjectMonitorGraphHandlerFunc {
+	adapter := &projectMonitorGraphLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v3.ProjectMonitorGraph) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+
+type cloudCredentialClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *cloudCredentialClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *cloudCredentialClient) Create(o *v3.CloudCredential) (*v3.CloudCredential, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.CloudCredential), nil
+}
+
+func (s *cloudCredentialClient) Get(name string, opts metav1.GetOptions) (*v3.CloudCredential, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.CloudCredential), nil
+}
+
+func (s *cloudCredentialClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.CloudCredential, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.CloudCredential), nil
+}
+
+func (s *cloudCredentialClient) Update(o *v3.CloudCredential) (*v3.CloudCredential, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.CloudCredential), nil
+}
+
+func (s *cloudCredentialClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *cloudCredentialClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *cloudCredentialClient) List(opts metav1.ListOptions) (*v3.CloudCredentialList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v3.CloudCredentialList{}
+	list.APIVersion = v3.CloudCredentialGroupVersionKind.GroupVersion().String()
+	list.Kind = v3.CloudCredentialGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v3.CloudCredential))
+	}
+	return list, nil
+}
+
+func (s *cloudCredentialClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *cloudCredentialClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *cloudCredentialClient) Controller() v3.CloudCredentialController {
+	return &cloudCredentialController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *cloudCredentialClient) AddHandler(ctx context.Context, name string, sync v3.CloudCredentialHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *cloudCredentialClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v3.CloudCredentialHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *cloudCredentialClient) AddLifecycle(ctx context.Context, name string, lifecycle v3.CloudCredentialLifecycle) {
+	sync := newCloudCredentialLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *cloudCredentialClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v3.CloudCredentialLifecycle) {
+	sync := newCloudCredentialLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *cloudCredentialClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v3.CloudCredentialHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *cloudCredentialClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v3.CloudCredentialHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *cloudCredentialClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v3.CloudCredentialLifecycle) {
+	sync := newCloudCredentialLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *cloudCredentialClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v3.CloudCredentialLifecycle) {
+	sync := newCloudCredentialLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type cloudCredentialController struct {
+	controller.GenericController
+}
+
+func (c *cloudCredentialController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *cloudCredentialController) Lister() v3.CloudCredentialLister {
+	return &cloudCredentialLister{
+		controller: c,
+	}
+}
+
+func (c *cloudCredentialController) AddHandler(ctx context.Context, name string, handler v3.CloudCredentialHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.CloudCredential); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *cloudCredentialController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v3.CloudCredentialHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.CloudCredential); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *cloudCredentialController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v3.CloudCredentialHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.CloudCredential); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *cloudCredentialController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v3.CloudCredentialHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.CloudCredential); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type cloudCredentialLister struct {
+	controller *cloudCredentialController
+}
+
+func (l *cloudCredentialLister) List(namespace string, selector labels.Selector) (ret []*v3.CloudCredential, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v3.CloudCredential))
+	})
+	return
+}
+
+func (l *cloudCredentialLister) Get(namespace, name string) (*v3.CloudCredential, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v3.CloudCredentialGroupVersionKind.Group,
+			Resource: "cloudCredential",
+		}, key)
+	}
+	return obj.(*v3.CloudCredential), nil
+}
+
+type cloudCredentialLifecycleAdapter struct {
+	lifecycle v3.CloudCredentialLifecycle
+}
+
+func (w *cloudCredentialLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *cloudCredentialLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *cloudCredentialLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v3.CloudCredential))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *cloudCredentialLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v3.CloudCredential))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *cloudCredentialLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v3.CloudCredential))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newCloudCredentialLifecycleAdapter(name string, clusterScoped bool, client *cloudCredentialClient, l v3.CloudCredentialLifecycle) v3.CloudCredentialHandlerFunc {
+	adapter := &cloudCredentialLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v3.CloudCredential) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+
+type clusterTemplateClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *clusterTemplateClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *clusterTemplateClient) Create(o *v3.ClusterTemplate) (*v3.ClusterTemplate, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.ClusterTemplate), nil
+}
+
+func (s *clusterTemplateClient) Get(name string, opts metav1.GetOptions) (*v3.ClusterTemplate, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.ClusterTemplate), nil
+}
+
+func (s *clusterTemplateClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.ClusterTemplate, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.ClusterTemplate), nil
+}
+
+func (s *clusterTemplateClient) Update(o *v3.ClusterTemplate) (*v3.ClusterTemplate, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.ClusterTemplate), nil
+}
+
+func (s *clusterTemplateClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *clusterTemplateClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *clusterTemplateClient) List(opts metav1.ListOptions) (*v3.ClusterTemplateList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v3.ClusterTemplateList{}
+	list.APIVersion = v3.ClusterTemplateGroupVersionKind.GroupVersion().String()
+	list.Kind = v3.ClusterTemplateGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v3.ClusterTemplate))
+	}
+	return list, nil
+}
+
+func (s *clusterTemplateClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *clusterTemplateClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *clusterTemplateClient) Controller() v3.ClusterTemplateController {
+	return &clusterTemplateController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *clusterTemplateClient) AddHandler(ctx context.Context, name string, sync v3.ClusterTemplateHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *clusterTemplateClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v3.ClusterTemplateHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *clusterTemplateClient) AddLifecycle(ctx context.Context, name string, lifecycle v3.ClusterTemplateLifecycle) {
+	sync := newClusterTemplateLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *clusterTemplateClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v3.ClusterTemplateLifecycle) {
+	sync := newClusterTemplateLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *clusterTemplateClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v3.ClusterTemplateHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *clusterTemplateClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v3.ClusterTemplateHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *clusterTemplateClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v3.ClusterTemplateLifecycle) {
+	sync := newClusterTemplateLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *clusterTemplateClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v3.ClusterTemplateLifecycle) {
+	sync := newClusterTemplateLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type clusterTemplateController struct {
+	controller.GenericController
+}
+
+func (c *clusterTemplateController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *clusterTemplateController) Lister() v3.ClusterTemplateLister {
+	return &clusterTemplateLister{
+		controller: c,
+	}
+}
+
+func (c *clusterTemplateController) AddHandler(ctx context.Context, name string, handler v3.ClusterTemplateHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ClusterTemplate); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *clusterTemplateController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v3.ClusterTemplateHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ClusterTemplate); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *clusterTemplateController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v3.ClusterTemplateHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ClusterTemplate); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *clusterTemplateController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v3.ClusterTemplateHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ClusterTemplate); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type clusterTemplateLister struct {
+	controller *clusterTemplateController
+}
+
+func (l *clusterTemplateLister) List(namespace string, selector labels.Selector) (ret []*v3.ClusterTemplate, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v3.ClusterTemplate))
+	})
+	return
+}
+
+func (l *clusterTemplateLister) Get(namespace, name string) (*v3.ClusterTemplate, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v3.ClusterTemplateGroupVersionKind.Group,
+			Resource: "clusterTemplate",
+		}, key)
+	}
+	return obj.(*v3.ClusterTemplate), nil
+}
+
+type clusterTemplateLifecycleAdapter struct {
+	lifecycle v3.ClusterTemplateLifecycle
+}
+
+func (w *clusterTemplateLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *clusterTemplateLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *clusterTemplateLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v3.ClusterTemplate))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *clusterTemplateLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v3.ClusterTemplate))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *clusterTemplateLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v3.ClusterTemplate))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newClusterTemplateLifecycleAdapter(name string, clusterScoped bool, client *clusterTemplateClient, l v3.ClusterTemplateLifecycle) v3.ClusterTemplateHandlerFunc {
+	adapter := &clusterTemplateLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v3.ClusterTemplate) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+
+type clusterTemplateRevisionClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *clusterTemplateRevisionClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *clusterTemplateRevisionClient) Create(o *v3.ClusterTemplateRevision) (*v3.ClusterTemplateRevision, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.ClusterTemplateRevision), nil
+}
+
+func (s *clusterTemplateRevisionClient) Get(name string, opts metav1.GetOptions) (*v3.ClusterTemplateRevision, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.ClusterTemplateRevision), nil
+}
+
+func (s *clusterTemplateRevisionClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.ClusterTemplateRevision, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.ClusterTemplateRevision), nil
+}
+
+func (s *clusterTemplateRevisionClient) Update(o *v3.ClusterTemplateRevision) (*v3.ClusterTemplateRevision, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.ClusterTemplateRevision), nil
+}
+
+func (s *clusterTemplateRevisionClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *clusterTemplateRevisionClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *clusterTemplateRevisionClient) List(opts metav1.ListOptions) (*v3.ClusterTemplateRevisionList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v3.ClusterTemplateRevisionList{}
+	list.APIVersion = v3.ClusterTemplateRevisionGroupVersionKind.GroupVersion().String()
+	list.Kind = v3.ClusterTemplateRevisionGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v3.ClusterTemplateRevision))
+	}
+	return list, nil
+}
+
+func (s *clusterTemplateRevisionClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *clusterTemplateRevisionClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *clusterTemplateRevisionClient) Controller() v3.ClusterTemplateRevisionController {
+	return &clusterTemplateRevisionController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *clusterTemplateRevisionClient) AddHandler(ctx context.Context, name string, sync v3.ClusterTemplateRevisionHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *clusterTemplateRevisionClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v3.ClusterTemplateRevisionHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *clusterTemplateRevisionClient) AddLifecycle(ctx context.Context, name string, lifecycle v3.ClusterTemplateRevisionLifecycle) {
+	sync := newClusterTemplateRevisionLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *clusterTemplateRevisionClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v3.ClusterTemplateRevisionLifecycle) {
+	sync := newClusterTemplateRevisionLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *clusterTemplateRevisionClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v3.ClusterTemplateRevisionHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *clusterTemplateRevisionClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v3.ClusterTemplateRevisionHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *clusterTemplateRevisionClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v3.ClusterTemplateRevisionLifecycle) {
+	sync := newClusterTemplateRevisionLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *clusterTemplateRevisionClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v3.ClusterTemplateRevisionLifecycle) {
+	sync := newClusterTemplateRevisionLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type clusterTemplateRevisionController struct {
+	controller.GenericController
+}
+
+func (c *clusterTemplateRevisionController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *clusterTemplateRevisionController) Lister() v3.ClusterTemplateRevisionLister {
+	return &clusterTemplateRevisionLister{
+		controller: c,
+	}
+}
+
+func (c *clusterTemplateRevisionController) AddHandler(ctx context.Context, name string, handler v3.ClusterTemplateRevisionHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ClusterTemplateRevision); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *clusterTemplateRevisionController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v3.ClusterTemplateRevisionHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ClusterTemplateRevision); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *clusterTemplateRevisionController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v3.ClusterTemplateRevisionHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ClusterTemplateRevision); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *clusterTemplateRevisionController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v3.ClusterTemplateRevisionHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ClusterTemplateRevision); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type clusterTemplateRevisionLister struct {
+	controller *clusterTemplateRevisionController
+}
+
+func (l *clusterTemplateRevisionLister) List(namespace string, selector labels.Selector) (ret []*v3.ClusterTemplateRevision, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v3.ClusterTemplateRevision))
+	})
+	return
+}
+
+func (l *clusterTemplateRevisionLister) Get(namespace, name string) (*v3.ClusterTemplateRevision, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v3.ClusterTemplateRevisionGroupVersionKind.Group,
+			Resource: "clusterTemplateRevision",
+		}, key)
+	}
+	return obj.(*v3.ClusterTemplateRevision), nil
+}
+
+type clusterTemplateRevisionLifecycleAdapter struct {
+	lifecycle v3.ClusterTemplateRevisionLifecycle
+}
+
+func (w *clusterTemplateRevisionLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *clusterTemplateRevisionLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *clusterTemplateRevisionLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v3.ClusterTemplateRevision))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *clusterTemplateRevisionLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v3.ClusterTemplateRevision))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *clusterTemplateRevisionLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v3.ClusterTemplateRevision))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newClusterTemplateRevisionLifecycleAdapter(name string, clusterScoped bool, client *clusterTemplateRevisionClient, l v3.ClusterTemplateRevisionLifecycle) v3.ClusterTemplateRevisionHandlerFunc {
+	adapter := &clusterTemplateRevisionLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v3.ClusterTemplateRevision) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+
+type rkeK8sSystemImageClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *rkeK8sSystemImageClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *rkeK8sSystemImageClient) Create(o *v3.RKEK8sSystemImage) (*v3.RKEK8sSystemImage, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.RKEK8sSystemImage), nil
+}
+
+func (s *rkeK8sSystemImageClient) Get(name string, opts metav1.GetOptions) (*v3.RKEK8sSystemImage, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.RKEK8sSystemImage), nil
+}
+
+func (s *rkeK8sSystemImageClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.RKEK8sSystemImage, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.RKEK8sSystemImage), nil
+}
+
+func (s *rkeK8sSystemImageClient) Update(o *v3.RKEK8sSystemImage) (*v3.RKEK8sSystemImage, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.RKEK8sSystemImage), nil
+}
+
+func (s *rkeK8sSystemImageClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *rkeK8sSystemImageClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *rkeK8sSystemImageClient) List(opts metav1.ListOptions) (*v3.RKEK8sSystemImageList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v3.RKEK8sSystemImageList{}
+	list.APIVersion = v3.RKEK8sSystemImageGroupVersionKind.GroupVersion().String()
+	list.Kind = v3.RKEK8sSystemImageGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v3.RKEK8sSystemImage))
+	}
+	return list, nil
+}
+
+func (s *rkeK8sSystemImageClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *rkeK8sSystemImageClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *rkeK8sSystemImageClient) Controller() v3.RKEK8sSystemImageController {
+	return &rkeK8sSystemImageController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *rkeK8sSystemImageClient) AddHandler(ctx context.Context, name string, sync v3.RKEK8sSystemImageHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *rkeK8sSystemImageClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v3.RKEK8sSystemImageHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *rkeK8sSystemImageClient) AddLifecycle(ctx context.Context, name string, lifecycle v3.RKEK8sSystemImageLifecycle) {
+	sync := newRKEK8sSystemImageLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *rkeK8sSystemImageClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v3.RKEK8sSystemImageLifecycle) {
+	sync := newRKEK8sSystemImageLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *rkeK8sSystemImageClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v3.RKEK8sSystemImageHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *rkeK8sSystemImageClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v3.RKEK8sSystemImageHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *rkeK8sSystemImageClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v3.RKEK8sSystemImageLifecycle) {
+	sync := newRKEK8sSystemImageLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *rkeK8sSystemImageClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v3.RKEK8sSystemImageLifecycle) {
+	sync := newRKEK8sSystemImageLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type rkeK8sSystemImageController struct {
+	controller.GenericController
+}
+
+func (c *rkeK8sSystemImageController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *rkeK8sSystemImageController) Lister() v3.RKEK8sSystemImageLister {
+	return &rkeK8sSystemImageLister{
+		controller: c,
+	}
+}
+
+func (c *rkeK8sSystemImageController) AddHandler(ctx context.Context, name string, handler v3.RKEK8sSystemImageHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.RKEK8sSystemImage); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *rkeK8sSystemImageController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v3.RKEK8sSystemImageHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.RKEK8sSystemImage); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *rkeK8sSystemImageController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v3.RKEK8sSystemImageHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.RKEK8sSystemImage); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *rkeK8sSystemImageController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v3.RKEK8sSystemImageHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.RKEK8sSystemImage); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type rkeK8sSystemImageLister struct {
+	controller *rkeK8sSystemImageController
+}
+
+func (l *rkeK8sSystemImageLister) List(namespace string, selector labels.Selector) (ret []*v3.RKEK8sSystemImage, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v3.RKEK8sSystemImage))
+	})
+	return
+}
+
+func (l *rkeK8sSystemImageLister) Get(namespace, name string) (*v3.RKEK8sSystemImage, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v3.RKEK8sSystemImageGroupVersionKind.Group,
+			Resource: "rkeK8sSystemImage",
+		}, key)
+	}
+	return obj.(*v3.RKEK8sSystemImage), nil
+}
+
+type rkeK8sSystemImageLifecycleAdapter struct {
+	lifecycle v3.RKEK8sSystemImageLifecycle
+}
+
+func (w *rkeK8sSystemImageLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *rkeK8sSystemImageLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *rkeK8sSystemImageLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v3.RKEK8sSystemImage))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *rkeK8sSystemImageLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v3.RKEK8sSystemImage))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *rkeK8sSystemImageLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v3.RKEK8sSystemImage))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newRKEK8sSystemImageLifecycleAdapter(name string, clusterScoped bool, client *rkeK8sSystemImageClient, l v3.RKEK8sSystemImageLifecycle) v3.RKEK8sSystemImageHandlerFunc {
+	adapter := &rkeK8sSystemImageLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v3.RKEK8sSystemImage) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+
+type rkeK8sServiceOptionClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *rkeK8sServiceOptionClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *rkeK8sServiceOptionClient) Create(o *v3.RKEK8sServiceOption) (*v3.RKEK8sServiceOption, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.RKEK8sServiceOption), nil
+}
+
+func (s *rkeK8sServiceOptionClient) Get(name string, opts metav1.GetOptions) (*v3.RKEK8sServiceOption, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.RKEK8sServiceOption), nil
+}
+
+func (s *rkeK8sServiceOptionClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.RKEK8sServiceOption, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.RKEK8sServiceOption), nil
+}
+
+func (s *rkeK8sServiceOptionClient) Update(o *v3.RKEK8sServiceOption) (*v3.RKEK8sServiceOption, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.RKEK8sServiceOption), nil
+}
+
+func (s *rkeK8sServiceOptionClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *rkeK8sServiceOptionClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *rkeK8sServiceOptionClient) List(opts metav1.ListOptions) (*v3.RKEK8sServiceOptionList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v3.RKEK8sServiceOptionList{}
+	list.APIVersion = v3.RKEK8sServiceOptionGroupVersionKind.GroupVersion().String()
+	list.Kind = v3.RKEK8sServiceOptionGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v3.RKEK8sServiceOption))
+	}
+	return list, nil
+}
+
+func (s *rkeK8sServiceOptionClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *rkeK8sServiceOptionClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *rkeK8sServiceOptionClient) Controller() v3.RKEK8sServiceOptionController {
+	return &rkeK8sServiceOptionController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *rkeK8sServiceOptionClient) AddHandler(ctx context.Context, name string, sync v3.RKEK8sServiceOptionHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *rkeK8sServiceOptionClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v3.RKEK8sServiceOptionHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *rkeK8sServiceOptionClient) AddLifecycle(ctx context.Context, name string, lifecycle v3.RKEK8sServiceOptionLifecycle) {
+	sync := newRKEK8sServiceOptionLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *rkeK8sServiceOptionClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v3.RKEK8sServiceOptionLifecycle) {
+	sync := newRKEK8sServiceOptionLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *rkeK8sServiceOptionClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v3.RKEK8sServiceOptionHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *rkeK8sServiceOptionClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v3.RKEK8sServiceOptionHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *rkeK8sServiceOptionClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v3.RKEK8sServiceOptionLifecycle) {
+	sync := newRKEK8sServiceOptionLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *rkeK8sServiceOptionClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v3.RKEK8sServiceOptionLifecycle) {
+	sync := newRKEK8sServiceOptionLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type rkeK8sServiceOptionController struct {
+	controller.GenericController
+}
+
+func (c *rkeK8sServiceOptionController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *rkeK8sServiceOptionController) Lister() v3.RKEK8sServiceOptionLister {
+	return &rkeK8sServiceOptionLister{
+		controller: c,
+	}
+}
+
+func (c *rkeK8sServiceOptionController) AddHandler(ctx context.Context, name string, handler v3.RKEK8sServiceOptionHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.RKEK8sServiceOption); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *rkeK8sServiceOptionController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v3.RKEK8sServiceOptionHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.RKEK8sServiceOption); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *rkeK8sServiceOptionController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v3.RKEK8sServiceOptionHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.RKEK8sServiceOption); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *rkeK8sServiceOptionController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v3.RKEK8sServiceOptionHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.RKEK8sServiceOption); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type rkeK8sServiceOptionLister struct {
+	controller *rkeK8sServiceOptionController
+}
+
+func (l *rkeK8sServiceOptionLister) List(namespace string, selector labels.Selector) (ret []*v3.RKEK8sServiceOption, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v3.RKEK8sServiceOption))
+	})
+	return
+}
+
+func (l *rkeK8sServiceOptionLister) Get(namespace, name string) (*v3.RKEK8sServiceOption, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v3.RKEK8sServiceOptionGroupVersionKind.Group,
+			Resource: "rkeK8sServiceOption",
+		}, key)
+	}
+	return obj.(*v3.RKEK8sServiceOption), nil
+}
+
+type rkeK8sServiceOptionLifecycleAdapter struct {
+	lifecycle v3.RKEK8sServiceOptionLifecycle
+}
+
+func (w *rkeK8sServiceOptionLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *rkeK8sServiceOptionLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *rkeK8sServiceOptionLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v3.RKEK8sServiceOption))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *rkeK8sServiceOptionLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v3.RKEK8sServiceOption))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *rkeK8sServiceOptionLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v3.RKEK8sServiceOption))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newRKEK8sServiceOptionLifecycleAdapter(name string, clusterScoped bool, client *rkeK8sServiceOptionClient, l v3.RKEK8sServiceOptionLifecycle) v3.RKEK8sServiceOptionHandlerFunc {
+	adapter := &rkeK8sServiceOptionLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v3.RKEK8sServiceOption) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+
+type rkeAddonClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *rkeAddonClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *rkeAddonClient) Create(o *v3.RKEAddon) (*v3.RKEAddon, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.RKEAddon), nil
+}
+
+func (s *rkeAddonClient) Get(name string, opts metav1.GetOptions) (*v3.RKEAddon, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.RKEAddon), nil
+}
+
+func (s *rkeAddonClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.RKEAddon, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.RKEAddon), nil
+}
+
+func (s *rkeAddonClient) Update(o *v3.RKEAddon) (*v3.RKEAddon, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.RKEAddon), nil
+}
+
+func (s *rkeAddonClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *rkeAddonClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *rkeAddonClient) List(opts metav1.ListOptions) (*v3.RKEAddonList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v3.RKEAddonList{}
+	list.APIVersion = v3.RKEAddonGroupVersionKind.GroupVersion().String()
+	list.Kind = v3.RKEAddonGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v3.RKEAddon))
+	}
+	return list, nil
+}
+
+func (s *rkeAddonClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *rkeAddonClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *rkeAddonClient) Controller() v3.RKEAddonController {
+	return &rkeAddonController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *rkeAddonClient) AddHandler(ctx context.Context, name string, sync v3.RKEAddonHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *rkeAddonClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v3.RKEAddonHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *rkeAddonClient) AddLifecycle(ctx context.Context, name string, lifecycle v3.RKEAddonLifecycle) {
+	sync := newRKEAddonLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *rkeAddonClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v3.RKEAddonLifecycle) {
+	sync := newRKEAddonLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *rkeAddonClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v3.RKEAddonHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *rkeAddonClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v3.RKEAddonHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *rkeAddonClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v3.RKEAddonLifecycle) {
+	sync := newRKEAddonLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *rkeAddonClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v3.RKEAddonLifecycle) {
+	sync := newRKEAddonLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type rkeAddonController struct {
+	controller.GenericController
+}
+
+func (c *rkeAddonController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *rkeAddonController) Lister() v3.RKEAddonLister {
+	return &rkeAddonLister{
+		controller: c,
+	}
+}
+
+func (c *rkeAddonController) AddHandler(ctx context.Context, name string, handler v3.RKEAddonHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.RKEAddon); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *rkeAddonController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v3.RKEAddonHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.RKEAddon); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *rkeAddonController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v3.RKEAddonHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.RKEAddon); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *rkeAddonController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v3.RKEAddonHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.RKEAddon); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type rkeAddonLister struct {
+	controller *rkeAddonController
+}
+
+func (l *rkeAddonLister) List(namespace string, selector labels.Selector) (ret []*v3.RKEAddon, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v3.RKEAddon))
+	})
+	return
+}
+
+func (l *rkeAddonLister) Get(namespace, name string) (*v3.RKEAddon, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v3.RKEAddonGroupVersionKind.Group,
+			Resource: "rkeAddon",
+		}, key)
+	}
+	return obj.(*v3.RKEAddon), nil
+}
+
+type rkeAddonLifecycleAdapter struct {
+	lifecycle v3.RKEAddonLifecycle
+}
+
+func (w *rkeAddonLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *rkeAddonLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *rkeAddonLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v3.RKEAddon))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *rkeAddonLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v3.RKEAddon))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *rkeAddonLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v3.RKEAddon))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newRKEAddonLifecycleAdapter(name string, clusterScoped bool, client *rkeAddonClient, l v3.RKEAddonLifecycle) v3.RKEAddonHandlerFunc {
+	adapter := &rkeAddonLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v3.RKEAddon) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+
+type exampleClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *exampleClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *exampleClient) Create(o *v3.Example) (*v3.Example, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.Example), nil
+}
+
+func (s *exampleClient) Get(name string, opts metav1.GetOptions) (*v3.Example, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.Example), nil
+}
+
+func (s *exampleClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.Example, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.Example), nil
+}
+
+func (s *exampleClient) Update(o *v3.Example) (*v3.Example, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.Example), nil
+}
+
+func (s *exampleClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *exampleClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *exampleClient) List(opts metav1.ListOptions) (*v3.ExampleList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v3.ExampleList{}
+	list.APIVersion = v3.ExampleGroupVersionKind.GroupVersion().String()
+	list.Kind = v3.ExampleGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v3.Example))
+	}
+	return list, nil
+}
+
+func (s *exampleClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *exampleClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *exampleClient) Controller() v3.ExampleController {
+	return &exampleController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *exampleClient) AddHandler(ctx context.Context, name string, sync v3.ExampleHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *exampleClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v3.ExampleHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *exampleClient) AddLifecycle(ctx context.Context, name string, lifecycle v3.ExampleLifecycle) {
+	sync := newExampleLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *exampleClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v3.ExampleLifecycle) {
+	sync := newExampleLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *exampleClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v3.ExampleHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *exampleClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v3.ExampleHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *exampleClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v3.ExampleLifecycle) {
+	sync := newExampleLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *exampleClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v3.ExampleLifecycle) {
+	sync := newExampleLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type exampleController struct {
+	controller.GenericController
+}
+
+func (c *exampleController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *exampleController) Lister() v3.ExampleLister {
+	return &exampleLister{
+		controller: c,
+	}
+}
+
+func (c *exampleController) AddHandler(ctx context.Context, name string, handler v3.ExampleHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.Example); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *exampleController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v3.ExampleHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.Example); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *exampleController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v3.ExampleHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.Example); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *exampleController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v3.ExampleHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.Example); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type exampleLister struct {
+	controller *exampleController
+}
+
+func (l *exampleLister) List(namespace string, selector labels.Selector) (ret []*v3.Example, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v3.Example))
+	})
+	return
+}
+
+func (l *exampleLister) Get(namespace, name string) (*v3.Example, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v3.ExampleGroupVersionKind.Group,
+			Resource: "example",
+		}, key)
+	}
+	return obj.(*v3.Example), nil
+}
+
+type exampleLifecycleAdapter struct {
+	lifecycle v3.ExampleLifecycle
+}
+
+func (w *exampleLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *exampleLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *exampleLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v3.Example))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *exampleLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v3.Example))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *exampleLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v3.Example))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newExampleLifecycleAdapter(name string, clusterScoped bool, client *exampleClient, l v3.ExampleLifecycle) v3.ExampleHandlerFunc {
+	adapter := &exampleLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v3.Example) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
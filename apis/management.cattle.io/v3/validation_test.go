@@ -0,0 +1,43 @@
+package v3
+
+import (
+	"testing"
+)
+
+func TestValidateSMTPConfig(t *testing.T) {
+	config := &SMTPConfig{
+		Host:             "smtp.example.com",
+		Port:             587,
+		Sender:           "rancher@example.com",
+		DefaultRecipient: "ops@example.com",
+	}
+	if errs := config.Validate(); len(errs) != 0 {
+		t.Fatalf("unexpected errors %v", errs)
+	}
+
+	config.Host = "smtp_example"
+	config.Port = 70000
+	config.Sender = ""
+	errs := config.Validate()
+	if len(errs) != 3 {
+		t.Fatalf("expected 3 errors, got %v", errs)
+	}
+	for i, field := range []string{"host", "port", "sender"} {
+		if errs[i].Field != field {
+			t.Errorf("expected error %d on %s, got %s", i, field, errs[i].Field)
+		}
+	}
+}
+
+func TestValidateNested(t *testing.T) {
+	notifier := &Notifier{
+		Spec: NotifierSpec{
+			DisplayName: "mail",
+			SMTPConfig:  &SMTPConfig{},
+		},
+	}
+	errs := Validate_Notifier(notifier, nil)
+	if len(errs) == 0 || errs[0].Field != "spec.smtpConfig.host" {
+		t.Fatalf("unexpected errors %v", errs)
+	}
+}
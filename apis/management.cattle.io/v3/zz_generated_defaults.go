@@ -0,0 +1,934 @@
+package v3
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func init() {
+	SchemeBuilder.Register(RegisterDefaults)
+}
+
+// RegisterDefaults adds defaulters functions to the given scheme.
+// Public to allow building arbitrary schemes.
+// All generated defaulters are covering - they call all nested defaulters.
+func RegisterDefaults(scheme *runtime.Scheme) error {
+	scheme.AddTypeDefaultingFunc(&Cluster{}, func(obj interface{}) { SetDefaults_Cluster(obj.(*Cluster)) })
+	scheme.AddTypeDefaultingFunc(&ClusterAlert{}, func(obj interface{}) { SetDefaults_ClusterAlert(obj.(*ClusterAlert)) })
+	scheme.AddTypeDefaultingFunc(&ClusterAlertGroup{}, func(obj interface{}) { SetDefaults_ClusterAlertGroup(obj.(*ClusterAlertGroup)) })
+	scheme.AddTypeDefaultingFunc(&ClusterAlertGroupList{}, func(obj interface{}) { SetDefaults_ClusterAlertGroupList(obj.(*ClusterAlertGroupList)) })
+	scheme.AddTypeDefaultingFunc(&ClusterAlertList{}, func(obj interface{}) { SetDefaults_ClusterAlertList(obj.(*ClusterAlertList)) })
+	scheme.AddTypeDefaultingFunc(&ClusterAlertRule{}, func(obj interface{}) { SetDefaults_ClusterAlertRule(obj.(*ClusterAlertRule)) })
+	scheme.AddTypeDefaultingFunc(&ClusterAlertRuleList{}, func(obj interface{}) { SetDefaults_ClusterAlertRuleList(obj.(*ClusterAlertRuleList)) })
+	scheme.AddTypeDefaultingFunc(&ClusterList{}, func(obj interface{}) { SetDefaults_ClusterList(obj.(*ClusterList)) })
+	scheme.AddTypeDefaultingFunc(&ClusterLogging{}, func(obj interface{}) { SetDefaults_ClusterLogging(obj.(*ClusterLogging)) })
+	scheme.AddTypeDefaultingFunc(&ClusterLoggingList{}, func(obj interface{}) { SetDefaults_ClusterLoggingList(obj.(*ClusterLoggingList)) })
+	scheme.AddTypeDefaultingFunc(&ClusterTemplateRevision{}, func(obj interface{}) { SetDefaults_ClusterTemplateRevision(obj.(*ClusterTemplateRevision)) })
+	scheme.AddTypeDefaultingFunc(&ClusterTemplateRevisionList{}, func(obj interface{}) { SetDefaults_ClusterTemplateRevisionList(obj.(*ClusterTemplateRevisionList)) })
+	scheme.AddTypeDefaultingFunc(&EtcdBackup{}, func(obj interface{}) { SetDefaults_EtcdBackup(obj.(*EtcdBackup)) })
+	scheme.AddTypeDefaultingFunc(&EtcdBackupList{}, func(obj interface{}) { SetDefaults_EtcdBackupList(obj.(*EtcdBackupList)) })
+	scheme.AddTypeDefaultingFunc(&GlobalDNS{}, func(obj interface{}) { SetDefaults_GlobalDNS(obj.(*GlobalDNS)) })
+	scheme.AddTypeDefaultingFunc(&GlobalDNSList{}, func(obj interface{}) { SetDefaults_GlobalDNSList(obj.(*GlobalDNSList)) })
+	scheme.AddTypeDefaultingFunc(&GlobalDNSProvider{}, func(obj interface{}) { SetDefaults_GlobalDNSProvider(obj.(*GlobalDNSProvider)) })
+	scheme.AddTypeDefaultingFunc(&GlobalDNSProviderList{}, func(obj interface{}) { SetDefaults_GlobalDNSProviderList(obj.(*GlobalDNSProviderList)) })
+	scheme.AddTypeDefaultingFunc(&LdapConfig{}, func(obj interface{}) { SetDefaults_LdapConfig(obj.(*LdapConfig)) })
+	scheme.AddTypeDefaultingFunc(&LdapConfigList{}, func(obj interface{}) { SetDefaults_LdapConfigList(obj.(*LdapConfigList)) })
+	scheme.AddTypeDefaultingFunc(&ListenConfig{}, func(obj interface{}) { SetDefaults_ListenConfig(obj.(*ListenConfig)) })
+	scheme.AddTypeDefaultingFunc(&ListenConfigList{}, func(obj interface{}) { SetDefaults_ListenConfigList(obj.(*ListenConfigList)) })
+	scheme.AddTypeDefaultingFunc(&MultiClusterApp{}, func(obj interface{}) { SetDefaults_MultiClusterApp(obj.(*MultiClusterApp)) })
+	scheme.AddTypeDefaultingFunc(&MultiClusterAppList{}, func(obj interface{}) { SetDefaults_MultiClusterAppList(obj.(*MultiClusterAppList)) })
+	scheme.AddTypeDefaultingFunc(&Node{}, func(obj interface{}) { SetDefaults_Node(obj.(*Node)) })
+	scheme.AddTypeDefaultingFunc(&NodeList{}, func(obj interface{}) { SetDefaults_NodeList(obj.(*NodeList)) })
+	scheme.AddTypeDefaultingFunc(&NodePool{}, func(obj interface{}) { SetDefaults_NodePool(obj.(*NodePool)) })
+	scheme.AddTypeDefaultingFunc(&NodePoolList{}, func(obj interface{}) { SetDefaults_NodePoolList(obj.(*NodePoolList)) })
+	scheme.AddTypeDefaultingFunc(&NodeTemplate{}, func(obj interface{}) { SetDefaults_NodeTemplate(obj.(*NodeTemplate)) })
+	scheme.AddTypeDefaultingFunc(&NodeTemplateList{}, func(obj interface{}) { SetDefaults_NodeTemplateList(obj.(*NodeTemplateList)) })
+	scheme.AddTypeDefaultingFunc(&Notifier{}, func(obj interface{}) { SetDefaults_Notifier(obj.(*Notifier)) })
+	scheme.AddTypeDefaultingFunc(&NotifierList{}, func(obj interface{}) { SetDefaults_NotifierList(obj.(*NotifierList)) })
+	scheme.AddTypeDefaultingFunc(&ProjectAlert{}, func(obj interface{}) { SetDefaults_ProjectAlert(obj.(*ProjectAlert)) })
+	scheme.AddTypeDefaultingFunc(&ProjectAlertGroup{}, func(obj interface{}) { SetDefaults_ProjectAlertGroup(obj.(*ProjectAlertGroup)) })
+	scheme.AddTypeDefaultingFunc(&ProjectAlertGroupList{}, func(obj interface{}) { SetDefaults_ProjectAlertGroupList(obj.(*ProjectAlertGroupList)) })
+	scheme.AddTypeDefaultingFunc(&ProjectAlertList{}, func(obj interface{}) { SetDefaults_ProjectAlertList(obj.(*ProjectAlertList)) })
+	scheme.AddTypeDefaultingFunc(&ProjectAlertRule{}, func(obj interface{}) { SetDefaults_ProjectAlertRule(obj.(*ProjectAlertRule)) })
+	scheme.AddTypeDefaultingFunc(&ProjectAlertRuleList{}, func(obj interface{}) { SetDefaults_ProjectAlertRuleList(obj.(*ProjectAlertRuleList)) })
+	scheme.AddTypeDefaultingFunc(&ProjectLogging{}, func(obj interface{}) { SetDefaults_ProjectLogging(obj.(*ProjectLogging)) })
+	scheme.AddTypeDefaultingFunc(&ProjectLoggingList{}, func(obj interface{}) { SetDefaults_ProjectLoggingList(obj.(*ProjectLoggingList)) })
+	scheme.AddTypeDefaultingFunc(&Token{}, func(obj interface{}) { SetDefaults_Token(obj.(*Token)) })
+	scheme.AddTypeDefaultingFunc(&TokenList{}, func(obj interface{}) { SetDefaults_TokenList(obj.(*TokenList)) })
+	scheme.AddTypeDefaultingFunc(&User{}, func(obj interface{}) { SetDefaults_User(obj.(*User)) })
+	scheme.AddTypeDefaultingFunc(&UserList{}, func(obj interface{}) { SetDefaults_UserList(obj.(*UserList)) })
+	return nil
+}
+
+// SetDefaults_AlertCommonSpec is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_AlertCommonSpec(in *AlertCommonSpec) {
+	if in.Severity == "" {
+		in.Severity = "critical"
+	}
+	if in.InitialWaitSeconds == 0 {
+		in.InitialWaitSeconds = 180
+	}
+	if in.RepeatIntervalSeconds == 0 {
+		in.RepeatIntervalSeconds = 3600
+	}
+}
+
+// SetDefaults_AlertStatus is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_AlertStatus(in *AlertStatus) {
+	if in.AlertState == "" {
+		in.AlertState = "active"
+	}
+}
+
+// SetDefaults_AuthnConfig is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_AuthnConfig(in *AuthnConfig) {
+	if in.Strategy == "" {
+		in.Strategy = "x509"
+	}
+}
+
+// SetDefaults_BackupConfig is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_BackupConfig(in *BackupConfig) {
+	if in.Enabled == nil {
+		v := true
+		in.Enabled = &v
+	}
+	if in.IntervalHours == 0 {
+		in.IntervalHours = 12
+	}
+	if in.Retention == 0 {
+		in.Retention = 6
+	}
+}
+
+// SetDefaults_CloudflareProviderConfig is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_CloudflareProviderConfig(in *CloudflareProviderConfig) {
+	if in.ProxySetting == nil {
+		v := true
+		in.ProxySetting = &v
+	}
+}
+
+// SetDefaults_Cluster is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_Cluster(in *Cluster) {
+	SetDefaults_ClusterSpec(&in.Spec)
+	SetDefaults_ClusterStatus(&in.Status)
+}
+
+// SetDefaults_ClusterAlert is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_ClusterAlert(in *ClusterAlert) {
+	SetDefaults_ClusterAlertSpec(&in.Spec)
+	SetDefaults_AlertStatus(&in.Status)
+}
+
+// SetDefaults_ClusterAlertGroup is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_ClusterAlertGroup(in *ClusterAlertGroup) {
+	SetDefaults_ClusterGroupSpec(&in.Spec)
+	SetDefaults_AlertStatus(&in.Status)
+}
+
+// SetDefaults_ClusterAlertGroupList is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_ClusterAlertGroupList(in *ClusterAlertGroupList) {
+	for i := range in.Items {
+		SetDefaults_ClusterAlertGroup(&in.Items[i])
+	}
+}
+
+// SetDefaults_ClusterAlertList is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_ClusterAlertList(in *ClusterAlertList) {
+	for i := range in.Items {
+		SetDefaults_ClusterAlert(&in.Items[i])
+	}
+}
+
+// SetDefaults_ClusterAlertRule is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_ClusterAlertRule(in *ClusterAlertRule) {
+	SetDefaults_ClusterAlertRuleSpec(&in.Spec)
+	SetDefaults_AlertStatus(&in.Status)
+}
+
+// SetDefaults_ClusterAlertRuleList is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_ClusterAlertRuleList(in *ClusterAlertRuleList) {
+	for i := range in.Items {
+		SetDefaults_ClusterAlertRule(&in.Items[i])
+	}
+}
+
+// SetDefaults_ClusterAlertRuleSpec is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_ClusterAlertRuleSpec(in *ClusterAlertRuleSpec) {
+	SetDefaults_CommonRuleField(&in.CommonRuleField)
+	if in.NodeRule != nil {
+		SetDefaults_NodeRule(in.NodeRule)
+	}
+	if in.EventRule != nil {
+		SetDefaults_EventRule(in.EventRule)
+	}
+	if in.SystemServiceRule != nil {
+		SetDefaults_SystemServiceRule(in.SystemServiceRule)
+	}
+	if in.MetricRule != nil {
+		SetDefaults_MetricRule(in.MetricRule)
+	}
+}
+
+// SetDefaults_ClusterAlertSpec is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_ClusterAlertSpec(in *ClusterAlertSpec) {
+	SetDefaults_AlertCommonSpec(&in.AlertCommonSpec)
+	if in.TargetNode != nil {
+		SetDefaults_TargetNode(in.TargetNode)
+	}
+	if in.TargetSystemService != nil {
+		SetDefaults_TargetSystemService(in.TargetSystemService)
+	}
+	if in.TargetEvent != nil {
+		SetDefaults_TargetEvent(in.TargetEvent)
+	}
+}
+
+// SetDefaults_ClusterGroupSpec is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_ClusterGroupSpec(in *ClusterGroupSpec) {
+	SetDefaults_CommonGroupField(&in.CommonGroupField)
+}
+
+// SetDefaults_ClusterList is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_ClusterList(in *ClusterList) {
+	for i := range in.Items {
+		SetDefaults_Cluster(&in.Items[i])
+	}
+}
+
+// SetDefaults_ClusterLogging is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_ClusterLogging(in *ClusterLogging) {
+	SetDefaults_ClusterLoggingSpec(&in.Spec)
+	SetDefaults_ClusterLoggingStatus(&in.Status)
+}
+
+// SetDefaults_ClusterLoggingList is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_ClusterLoggingList(in *ClusterLoggingList) {
+	for i := range in.Items {
+		SetDefaults_ClusterLogging(&in.Items[i])
+	}
+}
+
+// SetDefaults_ClusterLoggingSpec is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_ClusterLoggingSpec(in *ClusterLoggingSpec) {
+	SetDefaults_LoggingTargets(&in.LoggingTargets)
+	SetDefaults_LoggingCommonField(&in.LoggingCommonField)
+	if in.IncludeSystemComponent == nil {
+		v := true
+		in.IncludeSystemComponent = &v
+	}
+}
+
+// SetDefaults_ClusterLoggingStatus is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_ClusterLoggingStatus(in *ClusterLoggingStatus) {
+	SetDefaults_ClusterLoggingSpec(&in.AppliedSpec)
+	if in.FailedSpec != nil {
+		SetDefaults_ClusterLoggingSpec(in.FailedSpec)
+	}
+}
+
+// SetDefaults_ClusterSpec is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_ClusterSpec(in *ClusterSpec) {
+	SetDefaults_ClusterSpecBase(&in.ClusterSpecBase)
+}
+
+// SetDefaults_ClusterSpecBase is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_ClusterSpecBase(in *ClusterSpecBase) {
+	if in.RancherKubernetesEngineConfig != nil {
+		SetDefaults_RancherKubernetesEngineConfig(in.RancherKubernetesEngineConfig)
+	}
+	if in.DockerRootDir == "" {
+		in.DockerRootDir = "/var/lib/docker"
+	}
+	if in.EnableNetworkPolicy == nil {
+		v := false
+		in.EnableNetworkPolicy = &v
+	}
+}
+
+// SetDefaults_ClusterStatus is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_ClusterStatus(in *ClusterStatus) {
+	SetDefaults_ClusterSpec(&in.AppliedSpec)
+	if in.FailedSpec != nil {
+		SetDefaults_ClusterSpec(in.FailedSpec)
+	}
+}
+
+// SetDefaults_ClusterTemplateRevision is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_ClusterTemplateRevision(in *ClusterTemplateRevision) {
+	SetDefaults_ClusterTemplateRevisionSpec(&in.Spec)
+}
+
+// SetDefaults_ClusterTemplateRevisionList is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_ClusterTemplateRevisionList(in *ClusterTemplateRevisionList) {
+	for i := range in.Items {
+		SetDefaults_ClusterTemplateRevision(&in.Items[i])
+	}
+}
+
+// SetDefaults_ClusterTemplateRevisionSpec is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_ClusterTemplateRevisionSpec(in *ClusterTemplateRevisionSpec) {
+	if in.Enabled == nil {
+		v := true
+		in.Enabled = &v
+	}
+	if in.ClusterConfig != nil {
+		SetDefaults_ClusterSpecBase(in.ClusterConfig)
+	}
+}
+
+// SetDefaults_CommonGroupField is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_CommonGroupField(in *CommonGroupField) {
+	SetDefaults_TimingField(&in.TimingField)
+}
+
+// SetDefaults_CommonRuleField is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_CommonRuleField(in *CommonRuleField) {
+	if in.Severity == "" {
+		in.Severity = "critical"
+	}
+	if in.Inherited == nil {
+		v := true
+		in.Inherited = &v
+	}
+	SetDefaults_TimingField(&in.TimingField)
+}
+
+// SetDefaults_ETCDService is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_ETCDService(in *ETCDService) {
+	if in.Snapshot == nil {
+		v := false
+		in.Snapshot = &v
+	}
+	if in.Retention == "" {
+		in.Retention = "72h"
+	}
+	if in.Creation == "" {
+		in.Creation = "12h"
+	}
+	if in.BackupConfig != nil {
+		SetDefaults_BackupConfig(in.BackupConfig)
+	}
+}
+
+// SetDefaults_ElasticsearchConfig is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_ElasticsearchConfig(in *ElasticsearchConfig) {
+	if in.DateFormat == "" {
+		in.DateFormat = "YYYY-MM-DD"
+	}
+	if in.SSLVersion == "" {
+		in.SSLVersion = "TLSv1_2"
+	}
+}
+
+// SetDefaults_EtcdBackup is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_EtcdBackup(in *EtcdBackup) {
+	SetDefaults_EtcdBackupSpec(&in.Spec)
+}
+
+// SetDefaults_EtcdBackupList is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_EtcdBackupList(in *EtcdBackupList) {
+	for i := range in.Items {
+		SetDefaults_EtcdBackup(&in.Items[i])
+	}
+}
+
+// SetDefaults_EtcdBackupSpec is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_EtcdBackupSpec(in *EtcdBackupSpec) {
+	SetDefaults_BackupConfig(&in.BackupConfig)
+}
+
+// SetDefaults_EventRule is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_EventRule(in *EventRule) {
+	if in.EventType == "" {
+		in.EventType = "Warning"
+	}
+}
+
+// SetDefaults_FluentForwarderConfig is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_FluentForwarderConfig(in *FluentForwarderConfig) {
+	if !in.Compress {
+		in.Compress = true
+	}
+	for i := range in.FluentServers {
+		SetDefaults_FluentServer(&in.FluentServers[i])
+	}
+}
+
+// SetDefaults_FluentServer is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_FluentServer(in *FluentServer) {
+	if in.Weight == 0 {
+		in.Weight = 100
+	}
+}
+
+// SetDefaults_GlobalDNS is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_GlobalDNS(in *GlobalDNS) {
+	SetDefaults_GlobalDNSSpec(&in.Spec)
+}
+
+// SetDefaults_GlobalDNSList is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_GlobalDNSList(in *GlobalDNSList) {
+	for i := range in.Items {
+		SetDefaults_GlobalDNS(&in.Items[i])
+	}
+}
+
+// SetDefaults_GlobalDNSProvider is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_GlobalDNSProvider(in *GlobalDNSProvider) {
+	SetDefaults_GlobalDNSProviderSpec(&in.Spec)
+}
+
+// SetDefaults_GlobalDNSProviderList is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_GlobalDNSProviderList(in *GlobalDNSProviderList) {
+	for i := range in.Items {
+		SetDefaults_GlobalDNSProvider(&in.Items[i])
+	}
+}
+
+// SetDefaults_GlobalDNSProviderSpec is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_GlobalDNSProviderSpec(in *GlobalDNSProviderSpec) {
+	if in.Route53ProviderConfig != nil {
+		SetDefaults_Route53ProviderConfig(in.Route53ProviderConfig)
+	}
+	if in.CloudflareProviderConfig != nil {
+		SetDefaults_CloudflareProviderConfig(in.CloudflareProviderConfig)
+	}
+}
+
+// SetDefaults_GlobalDNSSpec is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_GlobalDNSSpec(in *GlobalDNSSpec) {
+	if in.TTL == 0 {
+		in.TTL = 300
+	}
+}
+
+// SetDefaults_IngressConfig is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_IngressConfig(in *IngressConfig) {
+	if in.Provider == "" {
+		in.Provider = "nginx"
+	}
+}
+
+// SetDefaults_KubeAPIService is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_KubeAPIService(in *KubeAPIService) {
+	if in.ServiceNodePortRange == "" {
+		in.ServiceNodePortRange = "30000-32767"
+	}
+}
+
+// SetDefaults_LdapConfig is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_LdapConfig(in *LdapConfig) {
+	if in.Port == 0 {
+		in.Port = 389
+	}
+	if in.UserSearchAttribute == "" {
+		in.UserSearchAttribute = "uid|sn|givenName"
+	}
+	if in.UserLoginAttribute == "" {
+		in.UserLoginAttribute = "uid"
+	}
+	if in.UserObjectClass == "" {
+		in.UserObjectClass = "inetOrgPerson"
+	}
+	if in.UserNameAttribute == "" {
+		in.UserNameAttribute = "cn"
+	}
+	if in.UserMemberAttribute == "" {
+		in.UserMemberAttribute = "memberOf"
+	}
+	if in.GroupSearchAttribute == "" {
+		in.GroupSearchAttribute = "cn"
+	}
+	if in.GroupObjectClass == "" {
+		in.GroupObjectClass = "groupOfNames"
+	}
+	if in.GroupNameAttribute == "" {
+		in.GroupNameAttribute = "cn"
+	}
+	if in.GroupDNAttribute == "" {
+		in.GroupDNAttribute = "entryDN"
+	}
+	if in.GroupMemberUserAttribute == "" {
+		in.GroupMemberUserAttribute = "entryDN"
+	}
+	if in.GroupMemberMappingAttribute == "" {
+		in.GroupMemberMappingAttribute = "member"
+	}
+	if in.ConnectionTimeout == 0 {
+		in.ConnectionTimeout = 5000
+	}
+}
+
+// SetDefaults_LdapConfigList is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_LdapConfigList(in *LdapConfigList) {
+	for i := range in.Items {
+		SetDefaults_LdapConfig(&in.Items[i])
+	}
+}
+
+// SetDefaults_ListenConfig is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_ListenConfig(in *ListenConfig) {
+	if len(in.TOS) == 0 {
+		in.TOS = []string{"auto"}
+	}
+	if !in.Enabled {
+		in.Enabled = true
+	}
+}
+
+// SetDefaults_ListenConfigList is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_ListenConfigList(in *ListenConfigList) {
+	for i := range in.Items {
+		SetDefaults_ListenConfig(&in.Items[i])
+	}
+}
+
+// SetDefaults_LoggingCommonField is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_LoggingCommonField(in *LoggingCommonField) {
+	if in.OutputFlushInterval == 0 {
+		in.OutputFlushInterval = 60
+	}
+}
+
+// SetDefaults_LoggingTargets is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_LoggingTargets(in *LoggingTargets) {
+	if in.ElasticsearchConfig != nil {
+		SetDefaults_ElasticsearchConfig(in.ElasticsearchConfig)
+	}
+	if in.SyslogConfig != nil {
+		SetDefaults_SyslogConfig(in.SyslogConfig)
+	}
+	if in.FluentForwarderConfig != nil {
+		SetDefaults_FluentForwarderConfig(in.FluentForwarderConfig)
+	}
+}
+
+// SetDefaults_MetricRule is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_MetricRule(in *MetricRule) {
+	if in.Comparison == "" {
+		in.Comparison = "equal"
+	}
+}
+
+// SetDefaults_MonitoringConfig is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_MonitoringConfig(in *MonitoringConfig) {
+	if in.Provider == "" {
+		in.Provider = "metrics-server"
+	}
+}
+
+// SetDefaults_MultiClusterApp is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_MultiClusterApp(in *MultiClusterApp) {
+	SetDefaults_MultiClusterAppSpec(&in.Spec)
+}
+
+// SetDefaults_MultiClusterAppList is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_MultiClusterAppList(in *MultiClusterAppList) {
+	for i := range in.Items {
+		SetDefaults_MultiClusterApp(&in.Items[i])
+	}
+}
+
+// SetDefaults_MultiClusterAppSpec is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_MultiClusterAppSpec(in *MultiClusterAppSpec) {
+	if in.Timeout == 0 {
+		in.Timeout = 300
+	}
+	if in.RevisionHistoryLimit == 0 {
+		in.RevisionHistoryLimit = 10
+	}
+}
+
+// SetDefaults_NetworkConfig is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_NetworkConfig(in *NetworkConfig) {
+	if in.Plugin == "" {
+		in.Plugin = "canal"
+	}
+}
+
+// SetDefaults_Node is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_Node(in *Node) {
+	SetDefaults_NodeSpec(&in.Spec)
+	SetDefaults_NodeStatus(&in.Status)
+}
+
+// SetDefaults_NodeCommonParams is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_NodeCommonParams(in *NodeCommonParams) {
+	if !in.UseInternalIPAddress {
+		in.UseInternalIPAddress = true
+	}
+}
+
+// SetDefaults_NodeDrainInput is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_NodeDrainInput(in *NodeDrainInput) {
+	if !in.IgnoreDaemonSets {
+		in.IgnoreDaemonSets = true
+	}
+	if in.GracePeriod == 0 {
+		in.GracePeriod = -1
+	}
+	if in.Timeout == 0 {
+		in.Timeout = 60
+	}
+}
+
+// SetDefaults_NodeList is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_NodeList(in *NodeList) {
+	for i := range in.Items {
+		SetDefaults_Node(&in.Items[i])
+	}
+}
+
+// SetDefaults_NodePool is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_NodePool(in *NodePool) {
+	SetDefaults_NodePoolSpec(&in.Spec)
+}
+
+// SetDefaults_NodePoolList is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_NodePoolList(in *NodePoolList) {
+	for i := range in.Items {
+		SetDefaults_NodePool(&in.Items[i])
+	}
+}
+
+// SetDefaults_NodePoolSpec is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_NodePoolSpec(in *NodePoolSpec) {
+	if in.Quantity == 0 {
+		in.Quantity = 1
+	}
+}
+
+// SetDefaults_NodeRule is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_NodeRule(in *NodeRule) {
+	if in.Condition == "" {
+		in.Condition = "notready"
+	}
+	if in.MemThreshold == 0 {
+		in.MemThreshold = 70
+	}
+	if in.CPUThreshold == 0 {
+		in.CPUThreshold = 70
+	}
+}
+
+// SetDefaults_NodeSpec is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_NodeSpec(in *NodeSpec) {
+	if in.NodeDrainInput != nil {
+		SetDefaults_NodeDrainInput(in.NodeDrainInput)
+	}
+}
+
+// SetDefaults_NodeStatus is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_NodeStatus(in *NodeStatus) {
+	if in.NodeTemplateSpec != nil {
+		SetDefaults_NodeTemplateSpec(in.NodeTemplateSpec)
+	}
+}
+
+// SetDefaults_NodeTemplate is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_NodeTemplate(in *NodeTemplate) {
+	SetDefaults_NodeTemplateSpec(&in.Spec)
+}
+
+// SetDefaults_NodeTemplateList is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_NodeTemplateList(in *NodeTemplateList) {
+	for i := range in.Items {
+		SetDefaults_NodeTemplate(&in.Items[i])
+	}
+}
+
+// SetDefaults_NodeTemplateSpec is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_NodeTemplateSpec(in *NodeTemplateSpec) {
+	SetDefaults_NodeCommonParams(&in.NodeCommonParams)
+}
+
+// SetDefaults_Notifier is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_Notifier(in *Notifier) {
+	SetDefaults_NotifierSpec(&in.Spec)
+}
+
+// SetDefaults_NotifierList is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_NotifierList(in *NotifierList) {
+	for i := range in.Items {
+		SetDefaults_Notifier(&in.Items[i])
+	}
+}
+
+// SetDefaults_NotifierSpec is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_NotifierSpec(in *NotifierSpec) {
+	if in.SMTPConfig != nil {
+		SetDefaults_SMTPConfig(in.SMTPConfig)
+	}
+	if in.WechatConfig != nil {
+		SetDefaults_WechatConfig(in.WechatConfig)
+	}
+}
+
+// SetDefaults_PodRule is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_PodRule(in *PodRule) {
+	if in.Condition == "" {
+		in.Condition = "notrunning"
+	}
+	if in.RestartTimes == 0 {
+		in.RestartTimes = 3
+	}
+	if in.RestartIntervalSeconds == 0 {
+		in.RestartIntervalSeconds = 300
+	}
+}
+
+// SetDefaults_ProjectAlert is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_ProjectAlert(in *ProjectAlert) {
+	SetDefaults_ProjectAlertSpec(&in.Spec)
+	SetDefaults_AlertStatus(&in.Status)
+}
+
+// SetDefaults_ProjectAlertGroup is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_ProjectAlertGroup(in *ProjectAlertGroup) {
+	SetDefaults_ProjectGroupSpec(&in.Spec)
+	SetDefaults_AlertStatus(&in.Status)
+}
+
+// SetDefaults_ProjectAlertGroupList is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_ProjectAlertGroupList(in *ProjectAlertGroupList) {
+	for i := range in.Items {
+		SetDefaults_ProjectAlertGroup(&in.Items[i])
+	}
+}
+
+// SetDefaults_ProjectAlertList is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_ProjectAlertList(in *ProjectAlertList) {
+	for i := range in.Items {
+		SetDefaults_ProjectAlert(&in.Items[i])
+	}
+}
+
+// SetDefaults_ProjectAlertRule is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_ProjectAlertRule(in *ProjectAlertRule) {
+	SetDefaults_ProjectAlertRuleSpec(&in.Spec)
+	SetDefaults_AlertStatus(&in.Status)
+}
+
+// SetDefaults_ProjectAlertRuleList is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_ProjectAlertRuleList(in *ProjectAlertRuleList) {
+	for i := range in.Items {
+		SetDefaults_ProjectAlertRule(&in.Items[i])
+	}
+}
+
+// SetDefaults_ProjectAlertRuleSpec is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_ProjectAlertRuleSpec(in *ProjectAlertRuleSpec) {
+	SetDefaults_CommonRuleField(&in.CommonRuleField)
+	if in.PodRule != nil {
+		SetDefaults_PodRule(in.PodRule)
+	}
+	if in.WorkloadRule != nil {
+		SetDefaults_WorkloadRule(in.WorkloadRule)
+	}
+	if in.MetricRule != nil {
+		SetDefaults_MetricRule(in.MetricRule)
+	}
+}
+
+// SetDefaults_ProjectAlertSpec is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_ProjectAlertSpec(in *ProjectAlertSpec) {
+	SetDefaults_AlertCommonSpec(&in.AlertCommonSpec)
+	if in.TargetWorkload != nil {
+		SetDefaults_TargetWorkload(in.TargetWorkload)
+	}
+	if in.TargetPod != nil {
+		SetDefaults_TargetPod(in.TargetPod)
+	}
+}
+
+// SetDefaults_ProjectGroupSpec is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_ProjectGroupSpec(in *ProjectGroupSpec) {
+	SetDefaults_CommonGroupField(&in.CommonGroupField)
+}
+
+// SetDefaults_ProjectLogging is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_ProjectLogging(in *ProjectLogging) {
+	SetDefaults_ProjectLoggingSpec(&in.Spec)
+	SetDefaults_ProjectLoggingStatus(&in.Status)
+}
+
+// SetDefaults_ProjectLoggingList is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_ProjectLoggingList(in *ProjectLoggingList) {
+	for i := range in.Items {
+		SetDefaults_ProjectLogging(&in.Items[i])
+	}
+}
+
+// SetDefaults_ProjectLoggingSpec is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_ProjectLoggingSpec(in *ProjectLoggingSpec) {
+	SetDefaults_LoggingTargets(&in.LoggingTargets)
+	SetDefaults_LoggingCommonField(&in.LoggingCommonField)
+}
+
+// SetDefaults_ProjectLoggingStatus is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_ProjectLoggingStatus(in *ProjectLoggingStatus) {
+	SetDefaults_ProjectLoggingSpec(&in.AppliedSpec)
+}
+
+// SetDefaults_RKEConfigServices is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_RKEConfigServices(in *RKEConfigServices) {
+	SetDefaults_ETCDService(&in.Etcd)
+	SetDefaults_KubeAPIService(&in.KubeAPI)
+}
+
+// SetDefaults_RancherKubernetesEngineConfig is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_RancherKubernetesEngineConfig(in *RancherKubernetesEngineConfig) {
+	SetDefaults_RKEConfigServices(&in.Services)
+	SetDefaults_NetworkConfig(&in.Network)
+	SetDefaults_AuthnConfig(&in.Authentication)
+	SetDefaults_IngressConfig(&in.Ingress)
+	if in.AddonJobTimeout == 0 {
+		in.AddonJobTimeout = 30
+	}
+	SetDefaults_MonitoringConfig(&in.Monitoring)
+}
+
+// SetDefaults_Route53ProviderConfig is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_Route53ProviderConfig(in *Route53ProviderConfig) {
+	if in.CredentialsPath == "" {
+		in.CredentialsPath = "/.aws"
+	}
+	if in.Region == "" {
+		in.Region = "us-east-1"
+	}
+	if in.ZoneType == "" {
+		in.ZoneType = "public"
+	}
+}
+
+// SetDefaults_SMTPConfig is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_SMTPConfig(in *SMTPConfig) {
+	if in.Port == 0 {
+		in.Port = 587
+	}
+	if !in.TLS {
+		in.TLS = true
+	}
+}
+
+// SetDefaults_SyslogConfig is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_SyslogConfig(in *SyslogConfig) {
+	if in.Severity == "" {
+		in.Severity = "notice"
+	}
+	if in.Protocol == "" {
+		in.Protocol = "udp"
+	}
+}
+
+// SetDefaults_SystemServiceRule is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_SystemServiceRule(in *SystemServiceRule) {
+	if in.Condition == "" {
+		in.Condition = "scheduler"
+	}
+}
+
+// SetDefaults_TargetEvent is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_TargetEvent(in *TargetEvent) {
+	if in.EventType == "" {
+		in.EventType = "Warning"
+	}
+}
+
+// SetDefaults_TargetNode is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_TargetNode(in *TargetNode) {
+	if in.Condition == "" {
+		in.Condition = "notready"
+	}
+	if in.MemThreshold == 0 {
+		in.MemThreshold = 70
+	}
+	if in.CPUThreshold == 0 {
+		in.CPUThreshold = 70
+	}
+}
+
+// SetDefaults_TargetPod is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_TargetPod(in *TargetPod) {
+	if in.Condition == "" {
+		in.Condition = "notrunning"
+	}
+	if in.RestartTimes == 0 {
+		in.RestartTimes = 3
+	}
+	if in.RestartIntervalSeconds == 0 {
+		in.RestartIntervalSeconds = 300
+	}
+}
+
+// SetDefaults_TargetSystemService is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_TargetSystemService(in *TargetSystemService) {
+	if in.Condition == "" {
+		in.Condition = "scheduler"
+	}
+}
+
+// SetDefaults_TargetWorkload is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_TargetWorkload(in *TargetWorkload) {
+	if in.AvailablePercentage == 0 {
+		in.AvailablePercentage = 70
+	}
+}
+
+// SetDefaults_TimingField is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_TimingField(in *TimingField) {
+	if in.GroupWaitSeconds == 0 {
+		in.GroupWaitSeconds = 30
+	}
+	if in.GroupIntervalSeconds == 0 {
+		in.GroupIntervalSeconds = 180
+	}
+	if in.RepeatIntervalSeconds == 0 {
+		in.RepeatIntervalSeconds = 3600
+	}
+}
+
+// SetDefaults_Token is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_Token(in *Token) {
+	if in.Enabled == nil {
+		v := true
+		in.Enabled = &v
+	}
+}
+
+// SetDefaults_TokenList is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_TokenList(in *TokenList) {
+	for i := range in.Items {
+		SetDefaults_Token(&in.Items[i])
+	}
+}
+
+// SetDefaults_User is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_User(in *User) {
+	if in.Enabled == nil {
+		v := true
+		in.Enabled = &v
+	}
+}
+
+// SetDefaults_UserList is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_UserList(in *UserList) {
+	for i := range in.Items {
+		SetDefaults_User(&in.Items[i])
+	}
+}
+
+// SetDefaults_WechatConfig is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_WechatConfig(in *WechatConfig) {
+	if in.RecipientType == "" {
+		in.RecipientType = "party"
+	}
+}
+
+// SetDefaults_WorkloadRule is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_WorkloadRule(in *WorkloadRule) {
+	if in.AvailablePercentage == 0 {
+		in.AvailablePercentage = 70
+	}
+}
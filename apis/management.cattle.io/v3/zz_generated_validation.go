@@ -0,0 +1,3061 @@
+package v3
+
+import (
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// Validate_AlertCommonSpec is an autogenerated validation function that checks AlertCommonSpec
+// values against the rules of their norman tags.
+func Validate_AlertCommonSpec(in *AlertCommonSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if in.DisplayName == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("displayName"), ""))
+	}
+	if in.Severity == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("severity"), ""))
+	} else {
+		switch in.Severity {
+		case "info", "critical", "warning":
+		default:
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("severity"), in.Severity, []string{"info", "critical", "warning"}))
+		}
+	}
+	if len(in.Recipients) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("recipients"), ""))
+	}
+	for i := range in.Recipients {
+		allErrs = append(allErrs, Validate_Recipient(&in.Recipients[i], fldPath.Child("recipients").Index(i))...)
+	}
+	if in.InitialWaitSeconds != 0 {
+		if in.InitialWaitSeconds < 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("initialWaitSeconds"), in.InitialWaitSeconds, "must be greater than or equal to 0"))
+		}
+	}
+	if in.RepeatIntervalSeconds != 0 {
+		if in.RepeatIntervalSeconds < 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("repeatIntervalSeconds"), in.RepeatIntervalSeconds, "must be greater than or equal to 0"))
+		}
+	}
+	return allErrs
+}
+
+// Validate checks the AlertCommonSpec against the rules of its norman tags.
+func (in *AlertCommonSpec) Validate() field.ErrorList {
+	return Validate_AlertCommonSpec(in, nil)
+}
+
+// Validate_AlertStatus is an autogenerated validation function that checks AlertStatus
+// values against the rules of their norman tags.
+func Validate_AlertStatus(in *AlertStatus, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if in.AlertState != "" {
+		switch in.AlertState {
+		case "active", "inactive", "alerting", "muted":
+		default:
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("alertState"), in.AlertState, []string{"active", "inactive", "alerting", "muted"}))
+		}
+	}
+	return allErrs
+}
+
+// Validate checks the AlertStatus against the rules of its norman tags.
+func (in *AlertStatus) Validate() field.ErrorList {
+	return Validate_AlertStatus(in, nil)
+}
+
+// Validate_AlidnsProviderConfig is an autogenerated validation function that checks AlidnsProviderConfig
+// values against the rules of their norman tags.
+func Validate_AlidnsProviderConfig(in *AlidnsProviderConfig, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if in.AccessKey == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("accessKey"), ""))
+	} else {
+		if len(in.AccessKey) < 1 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("accessKey"), in.AccessKey, "must be at least 1 characters"))
+		}
+	}
+	if in.SecretKey == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("secretKey"), ""))
+	} else {
+		if len(in.SecretKey) < 1 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("secretKey"), "", "must be at least 1 characters"))
+		}
+	}
+	return allErrs
+}
+
+// Validate checks the AlidnsProviderConfig against the rules of its norman tags.
+func (in *AlidnsProviderConfig) Validate() field.ErrorList {
+	return Validate_AlidnsProviderConfig(in, nil)
+}
+
+// Validate_Answer is an autogenerated validation function that checks Answer
+// values against the rules of their norman tags.
+func Validate_Answer(in *Answer, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if len(in.Values) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("values"), ""))
+	}
+	return allErrs
+}
+
+// Validate checks the Answer against the rules of its norman tags.
+func (in *Answer) Validate() field.ErrorList {
+	return Validate_Answer(in, nil)
+}
+
+// Validate_AuthConfig is an autogenerated validation function that checks AuthConfig
+// values against the rules of their norman tags.
+func Validate_AuthConfig(in *AuthConfig, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if in.AccessMode == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("accessMode"), ""))
+	} else {
+		switch in.AccessMode {
+		case "required", "restricted", "unrestricted":
+		default:
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("accessMode"), in.AccessMode, []string{"required", "restricted", "unrestricted"}))
+		}
+	}
+	for i, v := range in.AllowedPrincipalIDs {
+		if v == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("allowedPrincipalIds").Index(i), ""))
+		}
+	}
+	return allErrs
+}
+
+// Validate checks the AuthConfig against the rules of its norman tags.
+func (in *AuthConfig) Validate() field.ErrorList {
+	return Validate_AuthConfig(in, nil)
+}
+
+// Validate_AuthConfigList is an autogenerated validation function that checks AuthConfigList
+// values against the rules of their norman tags.
+func Validate_AuthConfigList(in *AuthConfigList, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for i := range in.Items {
+		allErrs = append(allErrs, Validate_AuthConfig(&in.Items[i], fldPath.Child("items").Index(i))...)
+	}
+	return allErrs
+}
+
+// Validate checks the AuthConfigList against the rules of its norman tags.
+func (in *AuthConfigList) Validate() field.ErrorList {
+	return Validate_AuthConfigList(in, nil)
+}
+
+// Validate_Catalog is an autogenerated validation function that checks Catalog
+// values against the rules of their norman tags.
+func Validate_Catalog(in *Catalog, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, Validate_CatalogSpec(&in.Spec, fldPath.Child("spec"))...)
+	return allErrs
+}
+
+// Validate checks the Catalog against the rules of its norman tags.
+func (in *Catalog) Validate() field.ErrorList {
+	return Validate_Catalog(in, nil)
+}
+
+// Validate_CatalogList is an autogenerated validation function that checks CatalogList
+// values against the rules of their norman tags.
+func Validate_CatalogList(in *CatalogList, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for i := range in.Items {
+		allErrs = append(allErrs, Validate_Catalog(&in.Items[i], fldPath.Child("items").Index(i))...)
+	}
+	return allErrs
+}
+
+// Validate checks the CatalogList against the rules of its norman tags.
+func (in *CatalogList) Validate() field.ErrorList {
+	return Validate_CatalogList(in, nil)
+}
+
+// Validate_CatalogSpec is an autogenerated validation function that checks CatalogSpec
+// values against the rules of their norman tags.
+func Validate_CatalogSpec(in *CatalogSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if in.URL == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("url"), ""))
+	}
+	return allErrs
+}
+
+// Validate checks the CatalogSpec against the rules of its norman tags.
+func (in *CatalogSpec) Validate() field.ErrorList {
+	return Validate_CatalogSpec(in, nil)
+}
+
+// Validate_CatalogTemplate is an autogenerated validation function that checks CatalogTemplate
+// values against the rules of their norman tags.
+func Validate_CatalogTemplate(in *CatalogTemplate, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, Validate_Template(&in.Template, fldPath)...)
+	return allErrs
+}
+
+// Validate checks the CatalogTemplate against the rules of its norman tags.
+func (in *CatalogTemplate) Validate() field.ErrorList {
+	return Validate_CatalogTemplate(in, nil)
+}
+
+// Validate_CatalogTemplateList is an autogenerated validation function that checks CatalogTemplateList
+// values against the rules of their norman tags.
+func Validate_CatalogTemplateList(in *CatalogTemplateList, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for i := range in.Items {
+		allErrs = append(allErrs, Validate_CatalogTemplate(&in.Items[i], fldPath.Child("items").Index(i))...)
+	}
+	return allErrs
+}
+
+// Validate checks the CatalogTemplateList against the rules of its norman tags.
+func (in *CatalogTemplateList) Validate() field.ErrorList {
+	return Validate_CatalogTemplateList(in, nil)
+}
+
+// Validate_CloudflareProviderConfig is an autogenerated validation function that checks CloudflareProviderConfig
+// values against the rules of their norman tags.
+func Validate_CloudflareProviderConfig(in *CloudflareProviderConfig, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if in.APIKey == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("apiKey"), ""))
+	} else {
+		if len(in.APIKey) < 1 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("apiKey"), "", "must be at least 1 characters"))
+		}
+	}
+	if in.APIEmail == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("apiEmail"), ""))
+	} else {
+		if len(in.APIEmail) < 1 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("apiEmail"), in.APIEmail, "must be at least 1 characters"))
+		}
+	}
+	return allErrs
+}
+
+// Validate checks the CloudflareProviderConfig against the rules of its norman tags.
+func (in *CloudflareProviderConfig) Validate() field.ErrorList {
+	return Validate_CloudflareProviderConfig(in, nil)
+}
+
+// Validate_Cluster is an autogenerated validation function that checks Cluster
+// values against the rules of their norman tags.
+func Validate_Cluster(in *Cluster, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, Validate_ClusterSpec(&in.Spec, fldPath.Child("spec"))...)
+	allErrs = append(allErrs, Validate_ClusterStatus(&in.Status, fldPath.Child("status"))...)
+	return allErrs
+}
+
+// Validate checks the Cluster against the rules of its norman tags.
+func (in *Cluster) Validate() field.ErrorList {
+	return Validate_Cluster(in, nil)
+}
+
+// Validate_ClusterAlert is an autogenerated validation function that checks ClusterAlert
+// values against the rules of their norman tags.
+func Validate_ClusterAlert(in *ClusterAlert, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, Validate_ClusterAlertSpec(&in.Spec, fldPath.Child("spec"))...)
+	allErrs = append(allErrs, Validate_AlertStatus(&in.Status, fldPath.Child("status"))...)
+	return allErrs
+}
+
+// Validate checks the ClusterAlert against the rules of its norman tags.
+func (in *ClusterAlert) Validate() field.ErrorList {
+	return Validate_ClusterAlert(in, nil)
+}
+
+// Validate_ClusterAlertGroup is an autogenerated validation function that checks ClusterAlertGroup
+// values against the rules of their norman tags.
+func Validate_ClusterAlertGroup(in *ClusterAlertGroup, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, Validate_ClusterGroupSpec(&in.Spec, fldPath.Child("spec"))...)
+	allErrs = append(allErrs, Validate_AlertStatus(&in.Status, fldPath.Child("status"))...)
+	return allErrs
+}
+
+// Validate checks the ClusterAlertGroup against the rules of its norman tags.
+func (in *ClusterAlertGroup) Validate() field.ErrorList {
+	return Validate_ClusterAlertGroup(in, nil)
+}
+
+// Validate_ClusterAlertGroupList is an autogenerated validation function that checks ClusterAlertGroupList
+// values against the rules of their norman tags.
+func Validate_ClusterAlertGroupList(in *ClusterAlertGroupList, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for i := range in.Items {
+		allErrs = append(allErrs, Validate_ClusterAlertGroup(&in.Items[i], fldPath.Child("items").Index(i))...)
+	}
+	return allErrs
+}
+
+// Validate checks the ClusterAlertGroupList against the rules of its norman tags.
+func (in *ClusterAlertGroupList) Validate() field.ErrorList {
+	return Validate_ClusterAlertGroupList(in, nil)
+}
+
+// Validate_ClusterAlertList is an autogenerated validation function that checks ClusterAlertList
+// values against the rules of their norman tags.
+func Validate_ClusterAlertList(in *ClusterAlertList, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for i := range in.Items {
+		allErrs = append(allErrs, Validate_ClusterAlert(&in.Items[i], fldPath.Child("items").Index(i))...)
+	}
+	return allErrs
+}
+
+// Validate checks the ClusterAlertList against the rules of its norman tags.
+func (in *ClusterAlertList) Validate() field.ErrorList {
+	return Validate_ClusterAlertList(in, nil)
+}
+
+// Validate_ClusterAlertRule is an autogenerated validation function that checks ClusterAlertRule
+// values against the rules of their norman tags.
+func Validate_ClusterAlertRule(in *ClusterAlertRule, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, Validate_ClusterAlertRuleSpec(&in.Spec, fldPath.Child("spec"))...)
+	allErrs = append(allErrs, Validate_AlertStatus(&in.Status, fldPath.Child("status"))...)
+	return allErrs
+}
+
+// Validate checks the ClusterAlertRule against the rules of its norman tags.
+func (in *ClusterAlertRule) Validate() field.ErrorList {
+	return Validate_ClusterAlertRule(in, nil)
+}
+
+// Validate_ClusterAlertRuleList is an autogenerated validation function that checks ClusterAlertRuleList
+// values against the rules of their norman tags.
+func Validate_ClusterAlertRuleList(in *ClusterAlertRuleList, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for i := range in.Items {
+		allErrs = append(allErrs, Validate_ClusterAlertRule(&in.Items[i], fldPath.Child("items").Index(i))...)
+	}
+	return allErrs
+}
+
+// Validate checks the ClusterAlertRuleList against the rules of its norman tags.
+func (in *ClusterAlertRuleList) Validate() field.ErrorList {
+	return Validate_ClusterAlertRuleList(in, nil)
+}
+
+// Validate_ClusterAlertRuleSpec is an autogenerated validation function that checks ClusterAlertRuleSpec
+// values against the rules of their norman tags.
+func Validate_ClusterAlertRuleSpec(in *ClusterAlertRuleSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, Validate_CommonRuleField(&in.CommonRuleField, fldPath)...)
+	if in.NodeRule != nil {
+		allErrs = append(allErrs, Validate_NodeRule(in.NodeRule, fldPath.Child("nodeRule"))...)
+	}
+	if in.EventRule != nil {
+		allErrs = append(allErrs, Validate_EventRule(in.EventRule, fldPath.Child("eventRule"))...)
+	}
+	if in.SystemServiceRule != nil {
+		allErrs = append(allErrs, Validate_SystemServiceRule(in.SystemServiceRule, fldPath.Child("systemServiceRule"))...)
+	}
+	if in.MetricRule != nil {
+		allErrs = append(allErrs, Validate_MetricRule(in.MetricRule, fldPath.Child("metricRule"))...)
+	}
+	return allErrs
+}
+
+// Validate checks the ClusterAlertRuleSpec against the rules of its norman tags.
+func (in *ClusterAlertRuleSpec) Validate() field.ErrorList {
+	return Validate_ClusterAlertRuleSpec(in, nil)
+}
+
+// Validate_ClusterAlertSpec is an autogenerated validation function that checks ClusterAlertSpec
+// values against the rules of their norman tags.
+func Validate_ClusterAlertSpec(in *ClusterAlertSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, Validate_AlertCommonSpec(&in.AlertCommonSpec, fldPath)...)
+	if in.TargetNode != nil {
+		allErrs = append(allErrs, Validate_TargetNode(in.TargetNode, fldPath.Child("targetNode"))...)
+	}
+	if in.TargetSystemService != nil {
+		allErrs = append(allErrs, Validate_TargetSystemService(in.TargetSystemService, fldPath.Child("targetSystemService"))...)
+	}
+	if in.TargetEvent != nil {
+		allErrs = append(allErrs, Validate_TargetEvent(in.TargetEvent, fldPath.Child("targetEvent"))...)
+	}
+	return allErrs
+}
+
+// Validate checks the ClusterAlertSpec against the rules of its norman tags.
+func (in *ClusterAlertSpec) Validate() field.ErrorList {
+	return Validate_ClusterAlertSpec(in, nil)
+}
+
+// Validate_ClusterCatalog is an autogenerated validation function that checks ClusterCatalog
+// values against the rules of their norman tags.
+func Validate_ClusterCatalog(in *ClusterCatalog, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, Validate_Catalog(&in.Catalog, fldPath)...)
+	if in.ClusterName == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("clusterName"), ""))
+	}
+	return allErrs
+}
+
+// Validate checks the ClusterCatalog against the rules of its norman tags.
+func (in *ClusterCatalog) Validate() field.ErrorList {
+	return Validate_ClusterCatalog(in, nil)
+}
+
+// Validate_ClusterCatalogList is an autogenerated validation function that checks ClusterCatalogList
+// values against the rules of their norman tags.
+func Validate_ClusterCatalogList(in *ClusterCatalogList, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for i := range in.Items {
+		allErrs = append(allErrs, Validate_ClusterCatalog(&in.Items[i], fldPath.Child("items").Index(i))...)
+	}
+	return allErrs
+}
+
+// Validate checks the ClusterCatalogList against the rules of its norman tags.
+func (in *ClusterCatalogList) Validate() field.ErrorList {
+	return Validate_ClusterCatalogList(in, nil)
+}
+
+// Validate_ClusterGroupSpec is an autogenerated validation function that checks ClusterGroupSpec
+// values against the rules of their norman tags.
+func Validate_ClusterGroupSpec(in *ClusterGroupSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for i := range in.Recipients {
+		allErrs = append(allErrs, Validate_Recipient(&in.Recipients[i], fldPath.Child("recipients").Index(i))...)
+	}
+	allErrs = append(allErrs, Validate_CommonGroupField(&in.CommonGroupField, fldPath)...)
+	return allErrs
+}
+
+// Validate checks the ClusterGroupSpec against the rules of its norman tags.
+func (in *ClusterGroupSpec) Validate() field.ErrorList {
+	return Validate_ClusterGroupSpec(in, nil)
+}
+
+// Validate_ClusterList is an autogenerated validation function that checks ClusterList
+// values against the rules of their norman tags.
+func Validate_ClusterList(in *ClusterList, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for i := range in.Items {
+		allErrs = append(allErrs, Validate_Cluster(&in.Items[i], fldPath.Child("items").Index(i))...)
+	}
+	return allErrs
+}
+
+// Validate checks the ClusterList against the rules of its norman tags.
+func (in *ClusterList) Validate() field.ErrorList {
+	return Validate_ClusterList(in, nil)
+}
+
+// Validate_ClusterLogging is an autogenerated validation function that checks ClusterLogging
+// values against the rules of their norman tags.
+func Validate_ClusterLogging(in *ClusterLogging, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, Validate_ClusterLoggingSpec(&in.Spec, fldPath.Child("spec"))...)
+	allErrs = append(allErrs, Validate_ClusterLoggingStatus(&in.Status, fldPath.Child("status"))...)
+	return allErrs
+}
+
+// Validate checks the ClusterLogging against the rules of its norman tags.
+func (in *ClusterLogging) Validate() field.ErrorList {
+	return Validate_ClusterLogging(in, nil)
+}
+
+// Validate_ClusterLoggingList is an autogenerated validation function that checks ClusterLoggingList
+// values against the rules of their norman tags.
+func Validate_ClusterLoggingList(in *ClusterLoggingList, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for i := range in.Items {
+		allErrs = append(allErrs, Validate_ClusterLogging(&in.Items[i], fldPath.Child("items").Index(i))...)
+	}
+	return allErrs
+}
+
+// Validate checks the ClusterLoggingList against the rules of its norman tags.
+func (in *ClusterLoggingList) Validate() field.ErrorList {
+	return Validate_ClusterLoggingList(in, nil)
+}
+
+// Validate_ClusterLoggingSpec is an autogenerated validation function that checks ClusterLoggingSpec
+// values against the rules of their norman tags.
+func Validate_ClusterLoggingSpec(in *ClusterLoggingSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, Validate_LoggingTargets(&in.LoggingTargets, fldPath)...)
+	return allErrs
+}
+
+// Validate checks the ClusterLoggingSpec against the rules of its norman tags.
+func (in *ClusterLoggingSpec) Validate() field.ErrorList {
+	return Validate_ClusterLoggingSpec(in, nil)
+}
+
+// Validate_ClusterLoggingStatus is an autogenerated validation function that checks ClusterLoggingStatus
+// values against the rules of their norman tags.
+func Validate_ClusterLoggingStatus(in *ClusterLoggingStatus, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, Validate_ClusterLoggingSpec(&in.AppliedSpec, fldPath.Child("appliedSpec"))...)
+	if in.FailedSpec != nil {
+		allErrs = append(allErrs, Validate_ClusterLoggingSpec(in.FailedSpec, fldPath.Child("failedSpec"))...)
+	}
+	return allErrs
+}
+
+// Validate checks the ClusterLoggingStatus against the rules of its norman tags.
+func (in *ClusterLoggingStatus) Validate() field.ErrorList {
+	return Validate_ClusterLoggingStatus(in, nil)
+}
+
+// Validate_ClusterMonitorGraph is an autogenerated validation function that checks ClusterMonitorGraph
+// values against the rules of their norman tags.
+func Validate_ClusterMonitorGraph(in *ClusterMonitorGraph, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, Validate_ClusterMonitorGraphSpec(&in.Spec, fldPath.Child("spec"))...)
+	return allErrs
+}
+
+// Validate checks the ClusterMonitorGraph against the rules of its norman tags.
+func (in *ClusterMonitorGraph) Validate() field.ErrorList {
+	return Validate_ClusterMonitorGraph(in, nil)
+}
+
+// Validate_ClusterMonitorGraphList is an autogenerated validation function that checks ClusterMonitorGraphList
+// values against the rules of their norman tags.
+func Validate_ClusterMonitorGraphList(in *ClusterMonitorGraphList, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for i := range in.Items {
+		allErrs = append(allErrs, Validate_ClusterMonitorGraph(&in.Items[i], fldPath.Child("items").Index(i))...)
+	}
+	return allErrs
+}
+
+// Validate checks the ClusterMonitorGraphList against the rules of its norman tags.
+func (in *ClusterMonitorGraphList) Validate() field.ErrorList {
+	return Validate_ClusterMonitorGraphList(in, nil)
+}
+
+// Validate_ClusterMonitorGraphSpec is an autogenerated validation function that checks ClusterMonitorGraphSpec
+// values against the rules of their norman tags.
+func Validate_ClusterMonitorGraphSpec(in *ClusterMonitorGraphSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if in.ResourceType != "" {
+		switch in.ResourceType {
+		case "node", "cluster", "etcd", "apiserver", "scheduler", "controllermanager", "fluentd", "istiocluster", "istioproject":
+		default:
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("resourceType"), in.ResourceType, []string{"node", "cluster", "etcd", "apiserver", "scheduler", "controllermanager", "fluentd", "istiocluster", "istioproject"}))
+		}
+	}
+	if in.DisplayResourceType != "" {
+		switch in.DisplayResourceType {
+		case "node", "cluster", "etcd", "kube-component", "rancher-component":
+		default:
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("displayResourceType"), in.DisplayResourceType, []string{"node", "cluster", "etcd", "kube-component", "rancher-component"}))
+		}
+	}
+	allErrs = append(allErrs, Validate_CommonMonitorGraphSpec(&in.CommonMonitorGraphSpec, fldPath)...)
+	return allErrs
+}
+
+// Validate checks the ClusterMonitorGraphSpec against the rules of its norman tags.
+func (in *ClusterMonitorGraphSpec) Validate() field.ErrorList {
+	return Validate_ClusterMonitorGraphSpec(in, nil)
+}
+
+// Validate_ClusterRegistrationToken is an autogenerated validation function that checks ClusterRegistrationToken
+// values against the rules of their norman tags.
+func Validate_ClusterRegistrationToken(in *ClusterRegistrationToken, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, Validate_ClusterRegistrationTokenSpec(&in.Spec, fldPath.Child("spec"))...)
+	return allErrs
+}
+
+// Validate checks the ClusterRegistrationToken against the rules of its norman tags.
+func (in *ClusterRegistrationToken) Validate() field.ErrorList {
+	return Validate_ClusterRegistrationToken(in, nil)
+}
+
+// Validate_ClusterRegistrationTokenList is an autogenerated validation function that checks ClusterRegistrationTokenList
+// values against the rules of their norman tags.
+func Validate_ClusterRegistrationTokenList(in *ClusterRegistrationTokenList, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for i := range in.Items {
+		allErrs = append(allErrs, Validate_ClusterRegistrationToken(&in.Items[i], fldPath.Child("items").Index(i))...)
+	}
+	return allErrs
+}
+
+// Validate checks the ClusterRegistrationTokenList against the rules of its norman tags.
+func (in *ClusterRegistrationTokenList) Validate() field.ErrorList {
+	return Validate_ClusterRegistrationTokenList(in, nil)
+}
+
+// Validate_ClusterRegistrationTokenSpec is an autogenerated validation function that checks ClusterRegistrationTokenSpec
+// values against the rules of their norman tags.
+func Validate_ClusterRegistrationTokenSpec(in *ClusterRegistrationTokenSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if in.ClusterName == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("clusterName"), ""))
+	}
+	return allErrs
+}
+
+// Validate checks the ClusterRegistrationTokenSpec against the rules of its norman tags.
+func (in *ClusterRegistrationTokenSpec) Validate() field.ErrorList {
+	return Validate_ClusterRegistrationTokenSpec(in, nil)
+}
+
+// Validate_ClusterRoleTemplateBinding is an autogenerated validation function that checks ClusterRoleTemplateBinding
+// values against the rules of their norman tags.
+func Validate_ClusterRoleTemplateBinding(in *ClusterRoleTemplateBinding, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if in.ClusterName == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("clusterName"), ""))
+	}
+	if in.RoleTemplateName == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("roleTemplateName"), ""))
+	}
+	return allErrs
+}
+
+// Validate checks the ClusterRoleTemplateBinding against the rules of its norman tags.
+func (in *ClusterRoleTemplateBinding) Validate() field.ErrorList {
+	return Validate_ClusterRoleTemplateBinding(in, nil)
+}
+
+// Validate_ClusterRoleTemplateBindingList is an autogenerated validation function that checks ClusterRoleTemplateBindingList
+// values against the rules of their norman tags.
+func Validate_ClusterRoleTemplateBindingList(in *ClusterRoleTemplateBindingList, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for i := range in.Items {
+		allErrs = append(allErrs, Validate_ClusterRoleTemplateBinding(&in.Items[i], fldPath.Child("items").Index(i))...)
+	}
+	return allErrs
+}
+
+// Validate checks the ClusterRoleTemplateBindingList against the rules of its norman tags.
+func (in *ClusterRoleTemplateBindingList) Validate() field.ErrorList {
+	return Validate_ClusterRoleTemplateBindingList(in, nil)
+}
+
+// Validate_ClusterScan is an autogenerated validation function that checks ClusterScan
+// values against the rules of their norman tags.
+func Validate_ClusterScan(in *ClusterScan, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, Validate_ClusterScanSpec(&in.Spec, fldPath.Child("spec"))...)
+	return allErrs
+}
+
+// Validate checks the ClusterScan against the rules of its norman tags.
+func (in *ClusterScan) Validate() field.ErrorList {
+	return Validate_ClusterScan(in, nil)
+}
+
+// Validate_ClusterScanList is an autogenerated validation function that checks ClusterScanList
+// values against the rules of their norman tags.
+func Validate_ClusterScanList(in *ClusterScanList, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for i := range in.Items {
+		allErrs = append(allErrs, Validate_ClusterScan(&in.Items[i], fldPath.Child("items").Index(i))...)
+	}
+	return allErrs
+}
+
+// Validate checks the ClusterScanList against the rules of its norman tags.
+func (in *ClusterScanList) Validate() field.ErrorList {
+	return Validate_ClusterScanList(in, nil)
+}
+
+// Validate_ClusterScanSpec is an autogenerated validation function that checks ClusterScanSpec
+// values against the rules of their norman tags.
+func Validate_ClusterScanSpec(in *ClusterScanSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if in.ClusterID == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("clusterId"), ""))
+	}
+	return allErrs
+}
+
+// Validate checks the ClusterScanSpec against the rules of its norman tags.
+func (in *ClusterScanSpec) Validate() field.ErrorList {
+	return Validate_ClusterScanSpec(in, nil)
+}
+
+// Validate_ClusterSpec is an autogenerated validation function that checks ClusterSpec
+// values against the rules of their norman tags.
+func Validate_ClusterSpec(in *ClusterSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, Validate_ClusterSpecBase(&in.ClusterSpecBase, fldPath)...)
+	if in.DisplayName == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("displayName"), ""))
+	}
+	allErrs = append(allErrs, Validate_Answer(&in.ClusterTemplateAnswers, fldPath.Child("answers"))...)
+	return allErrs
+}
+
+// Validate checks the ClusterSpec against the rules of its norman tags.
+func (in *ClusterSpec) Validate() field.ErrorList {
+	return Validate_ClusterSpec(in, nil)
+}
+
+// Validate_ClusterSpecBase is an autogenerated validation function that checks ClusterSpecBase
+// values against the rules of their norman tags.
+func Validate_ClusterSpecBase(in *ClusterSpecBase, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if in.RancherKubernetesEngineConfig != nil {
+		allErrs = append(allErrs, Validate_RancherKubernetesEngineConfig(in.RancherKubernetesEngineConfig, fldPath.Child("rancherKubernetesEngineConfig"))...)
+	}
+	return allErrs
+}
+
+// Validate checks the ClusterSpecBase against the rules of its norman tags.
+func (in *ClusterSpecBase) Validate() field.ErrorList {
+	return Validate_ClusterSpecBase(in, nil)
+}
+
+// Validate_ClusterStatus is an autogenerated validation function that checks ClusterStatus
+// values against the rules of their norman tags.
+func Validate_ClusterStatus(in *ClusterStatus, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, Validate_ClusterSpec(&in.AppliedSpec, fldPath.Child("appliedSpec"))...)
+	if in.FailedSpec != nil {
+		allErrs = append(allErrs, Validate_ClusterSpec(in.FailedSpec, fldPath.Child("failedSpec"))...)
+	}
+	return allErrs
+}
+
+// Validate checks the ClusterStatus against the rules of its norman tags.
+func (in *ClusterStatus) Validate() field.ErrorList {
+	return Validate_ClusterStatus(in, nil)
+}
+
+// Validate_ClusterTemplate is an autogenerated validation function that checks ClusterTemplate
+// values against the rules of their norman tags.
+func Validate_ClusterTemplate(in *ClusterTemplate, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, Validate_ClusterTemplateSpec(&in.Spec, fldPath.Child("spec"))...)
+	return allErrs
+}
+
+// Validate checks the ClusterTemplate against the rules of its norman tags.
+func (in *ClusterTemplate) Validate() field.ErrorList {
+	return Validate_ClusterTemplate(in, nil)
+}
+
+// Validate_ClusterTemplateList is an autogenerated validation function that checks ClusterTemplateList
+// values against the rules of their norman tags.
+func Validate_ClusterTemplateList(in *ClusterTemplateList, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for i := range in.Items {
+		allErrs = append(allErrs, Validate_ClusterTemplate(&in.Items[i], fldPath.Child("items").Index(i))...)
+	}
+	return allErrs
+}
+
+// Validate checks the ClusterTemplateList against the rules of its norman tags.
+func (in *ClusterTemplateList) Validate() field.ErrorList {
+	return Validate_ClusterTemplateList(in, nil)
+}
+
+// Validate_ClusterTemplateRevision is an autogenerated validation function that checks ClusterTemplateRevision
+// values against the rules of their norman tags.
+func Validate_ClusterTemplateRevision(in *ClusterTemplateRevision, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, Validate_ClusterTemplateRevisionSpec(&in.Spec, fldPath.Child("spec"))...)
+	return allErrs
+}
+
+// Validate checks the ClusterTemplateRevision against the rules of its norman tags.
+func (in *ClusterTemplateRevision) Validate() field.ErrorList {
+	return Validate_ClusterTemplateRevision(in, nil)
+}
+
+// Validate_ClusterTemplateRevisionList is an autogenerated validation function that checks ClusterTemplateRevisionList
+// values against the rules of their norman tags.
+func Validate_ClusterTemplateRevisionList(in *ClusterTemplateRevisionList, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for i := range in.Items {
+		allErrs = append(allErrs, Validate_ClusterTemplateRevision(&in.Items[i], fldPath.Child("items").Index(i))...)
+	}
+	return allErrs
+}
+
+// Validate checks the ClusterTemplateRevisionList against the rules of its norman tags.
+func (in *ClusterTemplateRevisionList) Validate() field.ErrorList {
+	return Validate_ClusterTemplateRevisionList(in, nil)
+}
+
+// Validate_ClusterTemplateRevisionSpec is an autogenerated validation function that checks ClusterTemplateRevisionSpec
+// values against the rules of their norman tags.
+func Validate_ClusterTemplateRevisionSpec(in *ClusterTemplateRevisionSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if in.DisplayName == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("displayName"), ""))
+	}
+	if in.ClusterTemplateName == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("clusterTemplateName"), ""))
+	}
+	if in.ClusterConfig == nil {
+		allErrs = append(allErrs, field.Required(fldPath.Child("clusterConfig"), ""))
+	}
+	if in.ClusterConfig != nil {
+		allErrs = append(allErrs, Validate_ClusterSpecBase(in.ClusterConfig, fldPath.Child("clusterConfig"))...)
+	}
+	return allErrs
+}
+
+// Validate checks the ClusterTemplateRevisionSpec against the rules of its norman tags.
+func (in *ClusterTemplateRevisionSpec) Validate() field.ErrorList {
+	return Validate_ClusterTemplateRevisionSpec(in, nil)
+}
+
+// Validate_ClusterTemplateSpec is an autogenerated validation function that checks ClusterTemplateSpec
+// values against the rules of their norman tags.
+func Validate_ClusterTemplateSpec(in *ClusterTemplateSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if in.DisplayName == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("displayName"), ""))
+	}
+	for i := range in.Members {
+		allErrs = append(allErrs, Validate_Member(&in.Members[i], fldPath.Child("members").Index(i))...)
+	}
+	return allErrs
+}
+
+// Validate checks the ClusterTemplateSpec against the rules of its norman tags.
+func (in *ClusterTemplateSpec) Validate() field.ErrorList {
+	return Validate_ClusterTemplateSpec(in, nil)
+}
+
+// Validate_CommonGroupField is an autogenerated validation function that checks CommonGroupField
+// values against the rules of their norman tags.
+func Validate_CommonGroupField(in *CommonGroupField, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if in.DisplayName == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("displayName"), ""))
+	}
+	allErrs = append(allErrs, Validate_TimingField(&in.TimingField, fldPath)...)
+	return allErrs
+}
+
+// Validate checks the CommonGroupField against the rules of its norman tags.
+func (in *CommonGroupField) Validate() field.ErrorList {
+	return Validate_CommonGroupField(in, nil)
+}
+
+// Validate_CommonMonitorGraphSpec is an autogenerated validation function that checks CommonMonitorGraphSpec
+// values against the rules of their norman tags.
+func Validate_CommonMonitorGraphSpec(in *CommonMonitorGraphSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if in.GraphType != "" {
+		switch in.GraphType {
+		case "graph", "singlestat":
+		default:
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("graphType"), in.GraphType, []string{"graph", "singlestat"}))
+		}
+	}
+	return allErrs
+}
+
+// Validate checks the CommonMonitorGraphSpec against the rules of its norman tags.
+func (in *CommonMonitorGraphSpec) Validate() field.ErrorList {
+	return Validate_CommonMonitorGraphSpec(in, nil)
+}
+
+// Validate_CommonRuleField is an autogenerated validation function that checks CommonRuleField
+// values against the rules of their norman tags.
+func Validate_CommonRuleField(in *CommonRuleField, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if in.Severity == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("severity"), ""))
+	} else {
+		switch in.Severity {
+		case "info", "critical", "warning":
+		default:
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("severity"), in.Severity, []string{"info", "critical", "warning"}))
+		}
+	}
+	allErrs = append(allErrs, Validate_TimingField(&in.TimingField, fldPath)...)
+	return allErrs
+}
+
+// Validate checks the CommonRuleField against the rules of its norman tags.
+func (in *CommonRuleField) Validate() field.ErrorList {
+	return Validate_CommonRuleField(in, nil)
+}
+
+// Validate_ElasticsearchConfig is an autogenerated validation function that checks ElasticsearchConfig
+// values against the rules of their norman tags.
+func Validate_ElasticsearchConfig(in *ElasticsearchConfig, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if in.Endpoint == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("endpoint"), ""))
+	}
+	if in.IndexPrefix == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("indexPrefix"), ""))
+	}
+	if in.DateFormat == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("dateFormat"), ""))
+	} else {
+		switch in.DateFormat {
+		case "YYYY-MM-DD", "YYYY-MM", "YYYY":
+		default:
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("dateFormat"), in.DateFormat, []string{"YYYY-MM-DD", "YYYY-MM", "YYYY"}))
+		}
+	}
+	if in.SSLVersion != "" {
+		switch in.SSLVersion {
+		case "SSLv23", "TLSv1", "TLSv1_1", "TLSv1_2":
+		default:
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("sslVersion"), in.SSLVersion, []string{"SSLv23", "TLSv1", "TLSv1_1", "TLSv1_2"}))
+		}
+	}
+	return allErrs
+}
+
+// Validate checks the ElasticsearchConfig against the rules of its norman tags.
+func (in *ElasticsearchConfig) Validate() field.ErrorList {
+	return Validate_ElasticsearchConfig(in, nil)
+}
+
+// Validate_EtcdBackup is an autogenerated validation function that checks EtcdBackup
+// values against the rules of their norman tags.
+func Validate_EtcdBackup(in *EtcdBackup, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, Validate_EtcdBackupSpec(&in.Spec, fldPath.Child("spec"))...)
+	return allErrs
+}
+
+// Validate checks the EtcdBackup against the rules of its norman tags.
+func (in *EtcdBackup) Validate() field.ErrorList {
+	return Validate_EtcdBackup(in, nil)
+}
+
+// Validate_EtcdBackupList is an autogenerated validation function that checks EtcdBackupList
+// values against the rules of their norman tags.
+func Validate_EtcdBackupList(in *EtcdBackupList, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for i := range in.Items {
+		allErrs = append(allErrs, Validate_EtcdBackup(&in.Items[i], fldPath.Child("items").Index(i))...)
+	}
+	return allErrs
+}
+
+// Validate checks the EtcdBackupList against the rules of its norman tags.
+func (in *EtcdBackupList) Validate() field.ErrorList {
+	return Validate_EtcdBackupList(in, nil)
+}
+
+// Validate_EtcdBackupSpec is an autogenerated validation function that checks EtcdBackupSpec
+// values against the rules of their norman tags.
+func Validate_EtcdBackupSpec(in *EtcdBackupSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if in.ClusterID == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("clusterId"), ""))
+	}
+	return allErrs
+}
+
+// Validate checks the EtcdBackupSpec against the rules of its norman tags.
+func (in *EtcdBackupSpec) Validate() field.ErrorList {
+	return Validate_EtcdBackupSpec(in, nil)
+}
+
+// Validate_EventRule is an autogenerated validation function that checks EventRule
+// values against the rules of their norman tags.
+func Validate_EventRule(in *EventRule, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if in.EventType == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("eventType"), ""))
+	} else {
+		switch in.EventType {
+		case "Normal", "Warning":
+		default:
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("eventType"), in.EventType, []string{"Normal", "Warning"}))
+		}
+	}
+	if in.ResourceKind == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("resourceKind"), ""))
+	} else {
+		switch in.ResourceKind {
+		case "Pod", "Node", "Deployment", "StatefulSet", "DaemonSet":
+		default:
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("resourceKind"), in.ResourceKind, []string{"Pod", "Node", "Deployment", "StatefulSet", "DaemonSet"}))
+		}
+	}
+	return allErrs
+}
+
+// Validate checks the EventRule against the rules of its norman tags.
+func (in *EventRule) Validate() field.ErrorList {
+	return Validate_EventRule(in, nil)
+}
+
+// Validate_Feature is an autogenerated validation function that checks Feature
+// values against the rules of their norman tags.
+func Validate_Feature(in *Feature, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if in.Value == nil {
+		allErrs = append(allErrs, field.Required(fldPath.Child("value"), ""))
+	}
+	return allErrs
+}
+
+// Validate checks the Feature against the rules of its norman tags.
+func (in *Feature) Validate() field.ErrorList {
+	return Validate_Feature(in, nil)
+}
+
+// Validate_FeatureList is an autogenerated validation function that checks FeatureList
+// values against the rules of their norman tags.
+func Validate_FeatureList(in *FeatureList, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for i := range in.Items {
+		allErrs = append(allErrs, Validate_Feature(&in.Items[i], fldPath.Child("items").Index(i))...)
+	}
+	return allErrs
+}
+
+// Validate checks the FeatureList against the rules of its norman tags.
+func (in *FeatureList) Validate() field.ErrorList {
+	return Validate_FeatureList(in, nil)
+}
+
+// Validate_FluentForwarderConfig is an autogenerated validation function that checks FluentForwarderConfig
+// values against the rules of their norman tags.
+func Validate_FluentForwarderConfig(in *FluentForwarderConfig, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if len(in.FluentServers) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("fluentServers"), ""))
+	}
+	for i := range in.FluentServers {
+		allErrs = append(allErrs, Validate_FluentServer(&in.FluentServers[i], fldPath.Child("fluentServers").Index(i))...)
+	}
+	return allErrs
+}
+
+// Validate checks the FluentForwarderConfig against the rules of its norman tags.
+func (in *FluentForwarderConfig) Validate() field.ErrorList {
+	return Validate_FluentForwarderConfig(in, nil)
+}
+
+// Validate_FluentServer is an autogenerated validation function that checks FluentServer
+// values against the rules of their norman tags.
+func Validate_FluentServer(in *FluentServer, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if in.Endpoint == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("endpoint"), ""))
+	}
+	return allErrs
+}
+
+// Validate checks the FluentServer against the rules of its norman tags.
+func (in *FluentServer) Validate() field.ErrorList {
+	return Validate_FluentServer(in, nil)
+}
+
+// Validate_GlobalDNS is an autogenerated validation function that checks GlobalDNS
+// values against the rules of their norman tags.
+func Validate_GlobalDNS(in *GlobalDNS, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, Validate_GlobalDNSSpec(&in.Spec, fldPath.Child("spec"))...)
+	return allErrs
+}
+
+// Validate checks the GlobalDNS against the rules of its norman tags.
+func (in *GlobalDNS) Validate() field.ErrorList {
+	return Validate_GlobalDNS(in, nil)
+}
+
+// Validate_GlobalDNSList is an autogenerated validation function that checks GlobalDNSList
+// values against the rules of their norman tags.
+func Validate_GlobalDNSList(in *GlobalDNSList, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for i := range in.Items {
+		allErrs = append(allErrs, Validate_GlobalDNS(&in.Items[i], fldPath.Child("items").Index(i))...)
+	}
+	return allErrs
+}
+
+// Validate checks the GlobalDNSList against the rules of its norman tags.
+func (in *GlobalDNSList) Validate() field.ErrorList {
+	return Validate_GlobalDNSList(in, nil)
+}
+
+// Validate_GlobalDNSProvider is an autogenerated validation function that checks GlobalDNSProvider
+// values against the rules of their norman tags.
+func Validate_GlobalDNSProvider(in *GlobalDNSProvider, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, Validate_GlobalDNSProviderSpec(&in.Spec, fldPath.Child("spec"))...)
+	return allErrs
+}
+
+// Validate checks the GlobalDNSProvider against the rules of its norman tags.
+func (in *GlobalDNSProvider) Validate() field.ErrorList {
+	return Validate_GlobalDNSProvider(in, nil)
+}
+
+// Validate_GlobalDNSProviderList is an autogenerated validation function that checks GlobalDNSProviderList
+// values against the rules of their norman tags.
+func Validate_GlobalDNSProviderList(in *GlobalDNSProviderList, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for i := range in.Items {
+		allErrs = append(allErrs, Validate_GlobalDNSProvider(&in.Items[i], fldPath.Child("items").Index(i))...)
+	}
+	return allErrs
+}
+
+// Validate checks the GlobalDNSProviderList against the rules of its norman tags.
+func (in *GlobalDNSProviderList) Validate() field.ErrorList {
+	return Validate_GlobalDNSProviderList(in, nil)
+}
+
+// Validate_GlobalDNSProviderSpec is an autogenerated validation function that checks GlobalDNSProviderSpec
+// values against the rules of their norman tags.
+func Validate_GlobalDNSProviderSpec(in *GlobalDNSProviderSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if in.Route53ProviderConfig != nil {
+		allErrs = append(allErrs, Validate_Route53ProviderConfig(in.Route53ProviderConfig, fldPath.Child("route53ProviderConfig"))...)
+	}
+	if in.CloudflareProviderConfig != nil {
+		allErrs = append(allErrs, Validate_CloudflareProviderConfig(in.CloudflareProviderConfig, fldPath.Child("cloudflareProviderConfig"))...)
+	}
+	if in.AlidnsProviderConfig != nil {
+		allErrs = append(allErrs, Validate_AlidnsProviderConfig(in.AlidnsProviderConfig, fldPath.Child("alidnsProviderConfig"))...)
+	}
+	for i := range in.Members {
+		allErrs = append(allErrs, Validate_Member(&in.Members[i], fldPath.Child("members").Index(i))...)
+	}
+	return allErrs
+}
+
+// Validate checks the GlobalDNSProviderSpec against the rules of its norman tags.
+func (in *GlobalDNSProviderSpec) Validate() field.ErrorList {
+	return Validate_GlobalDNSProviderSpec(in, nil)
+}
+
+// Validate_GlobalDNSSpec is an autogenerated validation function that checks GlobalDNSSpec
+// values against the rules of their norman tags.
+func Validate_GlobalDNSSpec(in *GlobalDNSSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if in.FQDN == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("fqdn"), ""))
+	} else {
+		for _, msg := range validation.IsDNS1123Subdomain(strings.ToLower(in.FQDN)) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("fqdn"), in.FQDN, msg))
+		}
+	}
+	for i, v := range in.ProjectNames {
+		if v == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("projectNames").Index(i), ""))
+		}
+	}
+	if in.ProviderName == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("providerName"), ""))
+	}
+	for i := range in.Members {
+		allErrs = append(allErrs, Validate_Member(&in.Members[i], fldPath.Child("members").Index(i))...)
+	}
+	return allErrs
+}
+
+// Validate checks the GlobalDNSSpec against the rules of its norman tags.
+func (in *GlobalDNSSpec) Validate() field.ErrorList {
+	return Validate_GlobalDNSSpec(in, nil)
+}
+
+// Validate_GlobalRole is an autogenerated validation function that checks GlobalRole
+// values against the rules of their norman tags.
+func Validate_GlobalRole(in *GlobalRole, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if in.DisplayName == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("displayName"), ""))
+	}
+	return allErrs
+}
+
+// Validate checks the GlobalRole against the rules of its norman tags.
+func (in *GlobalRole) Validate() field.ErrorList {
+	return Validate_GlobalRole(in, nil)
+}
+
+// Validate_GlobalRoleBinding is an autogenerated validation function that checks GlobalRoleBinding
+// values against the rules of their norman tags.
+func Validate_GlobalRoleBinding(in *GlobalRoleBinding, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if in.UserName == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("userName"), ""))
+	}
+	if in.GlobalRoleName == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("globalRoleName"), ""))
+	}
+	return allErrs
+}
+
+// Validate checks the GlobalRoleBinding against the rules of its norman tags.
+func (in *GlobalRoleBinding) Validate() field.ErrorList {
+	return Validate_GlobalRoleBinding(in, nil)
+}
+
+// Validate_GlobalRoleBindingList is an autogenerated validation function that checks GlobalRoleBindingList
+// values against the rules of their norman tags.
+func Validate_GlobalRoleBindingList(in *GlobalRoleBindingList, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for i := range in.Items {
+		allErrs = append(allErrs, Validate_GlobalRoleBinding(&in.Items[i], fldPath.Child("items").Index(i))...)
+	}
+	return allErrs
+}
+
+// Validate checks the GlobalRoleBindingList against the rules of its norman tags.
+func (in *GlobalRoleBindingList) Validate() field.ErrorList {
+	return Validate_GlobalRoleBindingList(in, nil)
+}
+
+// Validate_GlobalRoleList is an autogenerated validation function that checks GlobalRoleList
+// values against the rules of their norman tags.
+func Validate_GlobalRoleList(in *GlobalRoleList, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for i := range in.Items {
+		allErrs = append(allErrs, Validate_GlobalRole(&in.Items[i], fldPath.Child("items").Index(i))...)
+	}
+	return allErrs
+}
+
+// Validate checks the GlobalRoleList against the rules of its norman tags.
+func (in *GlobalRoleList) Validate() field.ErrorList {
+	return Validate_GlobalRoleList(in, nil)
+}
+
+// Validate_KafkaConfig is an autogenerated validation function that checks KafkaConfig
+// values against the rules of their norman tags.
+func Validate_KafkaConfig(in *KafkaConfig, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if in.Topic == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("topic"), ""))
+	}
+	if in.SaslScramMechanism != "" {
+		switch in.SaslScramMechanism {
+		case "sha256", "sha512":
+		default:
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("saslScramMechanism"), in.SaslScramMechanism, []string{"sha256", "sha512"}))
+		}
+	}
+	if in.SaslType != "" {
+		switch in.SaslType {
+		case "plain", "scram":
+		default:
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("saslType"), in.SaslType, []string{"plain", "scram"}))
+		}
+	}
+	return allErrs
+}
+
+// Validate checks the KafkaConfig against the rules of its norman tags.
+func (in *KafkaConfig) Validate() field.ErrorList {
+	return Validate_KafkaConfig(in, nil)
+}
+
+// Validate_KontainerDriver is an autogenerated validation function that checks KontainerDriver
+// values against the rules of their norman tags.
+func Validate_KontainerDriver(in *KontainerDriver, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, Validate_KontainerDriverSpec(&in.Spec, fldPath.Child("spec"))...)
+	return allErrs
+}
+
+// Validate checks the KontainerDriver against the rules of its norman tags.
+func (in *KontainerDriver) Validate() field.ErrorList {
+	return Validate_KontainerDriver(in, nil)
+}
+
+// Validate_KontainerDriverList is an autogenerated validation function that checks KontainerDriverList
+// values against the rules of their norman tags.
+func Validate_KontainerDriverList(in *KontainerDriverList, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for i := range in.Items {
+		allErrs = append(allErrs, Validate_KontainerDriver(&in.Items[i], fldPath.Child("items").Index(i))...)
+	}
+	return allErrs
+}
+
+// Validate checks the KontainerDriverList against the rules of its norman tags.
+func (in *KontainerDriverList) Validate() field.ErrorList {
+	return Validate_KontainerDriverList(in, nil)
+}
+
+// Validate_KontainerDriverSpec is an autogenerated validation function that checks KontainerDriverSpec
+// values against the rules of their norman tags.
+func Validate_KontainerDriverSpec(in *KontainerDriverSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if in.URL == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("url"), ""))
+	}
+	return allErrs
+}
+
+// Validate checks the KontainerDriverSpec against the rules of its norman tags.
+func (in *KontainerDriverSpec) Validate() field.ErrorList {
+	return Validate_KontainerDriverSpec(in, nil)
+}
+
+// Validate_LdapConfig is an autogenerated validation function that checks LdapConfig
+// values against the rules of their norman tags.
+func Validate_LdapConfig(in *LdapConfig, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, Validate_AuthConfig(&in.AuthConfig, fldPath)...)
+	if len(in.Servers) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("servers"), ""))
+	}
+	if in.ServiceAccountDistinguishedName == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("serviceAccountDistinguishedName"), ""))
+	}
+	if in.ServiceAccountPassword == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("serviceAccountPassword"), ""))
+	}
+	if in.UserSearchBase == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("userSearchBase"), ""))
+	}
+	if in.UserSearchAttribute == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("userSearchAttribute"), ""))
+	}
+	if in.UserLoginAttribute == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("userLoginAttribute"), ""))
+	}
+	if in.UserObjectClass == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("userObjectClass"), ""))
+	}
+	if in.UserNameAttribute == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("userNameAttribute"), ""))
+	}
+	if in.UserMemberAttribute == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("userMemberAttribute"), ""))
+	}
+	if in.GroupSearchAttribute == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("groupSearchAttribute"), ""))
+	}
+	if in.GroupObjectClass == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("groupObjectClass"), ""))
+	}
+	if in.GroupNameAttribute == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("groupNameAttribute"), ""))
+	}
+	if in.GroupMemberMappingAttribute == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("groupMemberMappingAttribute"), ""))
+	}
+	return allErrs
+}
+
+// Validate checks the LdapConfig against the rules of its norman tags.
+func (in *LdapConfig) Validate() field.ErrorList {
+	return Validate_LdapConfig(in, nil)
+}
+
+// Validate_LdapConfigList is an autogenerated validation function that checks LdapConfigList
+// values against the rules of their norman tags.
+func Validate_LdapConfigList(in *LdapConfigList, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for i := range in.Items {
+		allErrs = append(allErrs, Validate_LdapConfig(&in.Items[i], fldPath.Child("items").Index(i))...)
+	}
+	return allErrs
+}
+
+// Validate checks the LdapConfigList against the rules of its norman tags.
+func (in *LdapConfigList) Validate() field.ErrorList {
+	return Validate_LdapConfigList(in, nil)
+}
+
+// Validate_ListenConfig is an autogenerated validation function that checks ListenConfig
+// values against the rules of their norman tags.
+func Validate_ListenConfig(in *ListenConfig, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if in.Mode != "" {
+		switch in.Mode {
+		case "https", "http", "acme":
+		default:
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("mode"), in.Mode, []string{"https", "http", "acme"}))
+		}
+	}
+	return allErrs
+}
+
+// Validate checks the ListenConfig against the rules of its norman tags.
+func (in *ListenConfig) Validate() field.ErrorList {
+	return Validate_ListenConfig(in, nil)
+}
+
+// Validate_ListenConfigList is an autogenerated validation function that checks ListenConfigList
+// values against the rules of their norman tags.
+func Validate_ListenConfigList(in *ListenConfigList, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for i := range in.Items {
+		allErrs = append(allErrs, Validate_ListenConfig(&in.Items[i], fldPath.Child("items").Index(i))...)
+	}
+	return allErrs
+}
+
+// Validate checks the ListenConfigList against the rules of its norman tags.
+func (in *ListenConfigList) Validate() field.ErrorList {
+	return Validate_ListenConfigList(in, nil)
+}
+
+// Validate_LoggingTargets is an autogenerated validation function that checks LoggingTargets
+// values against the rules of their norman tags.
+func Validate_LoggingTargets(in *LoggingTargets, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if in.ElasticsearchConfig != nil {
+		allErrs = append(allErrs, Validate_ElasticsearchConfig(in.ElasticsearchConfig, fldPath.Child("elasticsearchConfig"))...)
+	}
+	if in.SplunkConfig != nil {
+		allErrs = append(allErrs, Validate_SplunkConfig(in.SplunkConfig, fldPath.Child("splunkConfig"))...)
+	}
+	if in.KafkaConfig != nil {
+		allErrs = append(allErrs, Validate_KafkaConfig(in.KafkaConfig, fldPath.Child("kafkaConfig"))...)
+	}
+	if in.SyslogConfig != nil {
+		allErrs = append(allErrs, Validate_SyslogConfig(in.SyslogConfig, fldPath.Child("syslogConfig"))...)
+	}
+	if in.FluentForwarderConfig != nil {
+		allErrs = append(allErrs, Validate_FluentForwarderConfig(in.FluentForwarderConfig, fldPath.Child("fluentForwarderConfig"))...)
+	}
+	return allErrs
+}
+
+// Validate checks the LoggingTargets against the rules of its norman tags.
+func (in *LoggingTargets) Validate() field.ErrorList {
+	return Validate_LoggingTargets(in, nil)
+}
+
+// Validate_Member is an autogenerated validation function that checks Member
+// values against the rules of their norman tags.
+func Validate_Member(in *Member, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if in.AccessType != "" {
+		switch in.AccessType {
+		case "owner", "member", "read-only":
+		default:
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("accessType"), in.AccessType, []string{"owner", "member", "read-only"}))
+		}
+	}
+	return allErrs
+}
+
+// Validate checks the Member against the rules of its norman tags.
+func (in *Member) Validate() field.ErrorList {
+	return Validate_Member(in, nil)
+}
+
+// Validate_MetricRule is an autogenerated validation function that checks MetricRule
+// values against the rules of their norman tags.
+func Validate_MetricRule(in *MetricRule, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if in.Expression == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("expression"), ""))
+	}
+	if in.Duration == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("duration"), ""))
+	}
+	if in.Comparison != "" {
+		switch in.Comparison {
+		case "equal", "not-equal", "greater-than", "less-than", "greater-or-equal", "less-or-equal", "has-value":
+		default:
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("comparison"), in.Comparison, []string{"equal", "not-equal", "greater-than", "less-than", "greater-or-equal", "less-or-equal", "has-value"}))
+		}
+	}
+	return allErrs
+}
+
+// Validate checks the MetricRule against the rules of its norman tags.
+func (in *MetricRule) Validate() field.ErrorList {
+	return Validate_MetricRule(in, nil)
+}
+
+// Validate_MonitorMetric is an autogenerated validation function that checks MonitorMetric
+// values against the rules of their norman tags.
+func Validate_MonitorMetric(in *MonitorMetric, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, Validate_MonitorMetricSpec(&in.Spec, fldPath.Child("spec"))...)
+	return allErrs
+}
+
+// Validate checks the MonitorMetric against the rules of its norman tags.
+func (in *MonitorMetric) Validate() field.ErrorList {
+	return Validate_MonitorMetric(in, nil)
+}
+
+// Validate_MonitorMetricList is an autogenerated validation function that checks MonitorMetricList
+// values against the rules of their norman tags.
+func Validate_MonitorMetricList(in *MonitorMetricList, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for i := range in.Items {
+		allErrs = append(allErrs, Validate_MonitorMetric(&in.Items[i], fldPath.Child("items").Index(i))...)
+	}
+	return allErrs
+}
+
+// Validate checks the MonitorMetricList against the rules of its norman tags.
+func (in *MonitorMetricList) Validate() field.ErrorList {
+	return Validate_MonitorMetricList(in, nil)
+}
+
+// Validate_MonitorMetricSpec is an autogenerated validation function that checks MonitorMetricSpec
+// values against the rules of their norman tags.
+func Validate_MonitorMetricSpec(in *MonitorMetricSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if in.Expression == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("expression"), ""))
+	}
+	return allErrs
+}
+
+// Validate checks the MonitorMetricSpec against the rules of its norman tags.
+func (in *MonitorMetricSpec) Validate() field.ErrorList {
+	return Validate_MonitorMetricSpec(in, nil)
+}
+
+// Validate_MultiClusterApp is an autogenerated validation function that checks MultiClusterApp
+// values against the rules of their norman tags.
+func Validate_MultiClusterApp(in *MultiClusterApp, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, Validate_MultiClusterAppSpec(&in.Spec, fldPath.Child("spec"))...)
+	allErrs = append(allErrs, Validate_MultiClusterAppStatus(&in.Status, fldPath.Child("status"))...)
+	return allErrs
+}
+
+// Validate checks the MultiClusterApp against the rules of its norman tags.
+func (in *MultiClusterApp) Validate() field.ErrorList {
+	return Validate_MultiClusterApp(in, nil)
+}
+
+// Validate_MultiClusterAppList is an autogenerated validation function that checks MultiClusterAppList
+// values against the rules of their norman tags.
+func Validate_MultiClusterAppList(in *MultiClusterAppList, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for i := range in.Items {
+		allErrs = append(allErrs, Validate_MultiClusterApp(&in.Items[i], fldPath.Child("items").Index(i))...)
+	}
+	return allErrs
+}
+
+// Validate checks the MultiClusterAppList against the rules of its norman tags.
+func (in *MultiClusterAppList) Validate() field.ErrorList {
+	return Validate_MultiClusterAppList(in, nil)
+}
+
+// Validate_MultiClusterAppRevision is an autogenerated validation function that checks MultiClusterAppRevision
+// values against the rules of their norman tags.
+func Validate_MultiClusterAppRevision(in *MultiClusterAppRevision, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for i := range in.Answers {
+		allErrs = append(allErrs, Validate_Answer(&in.Answers[i], fldPath.Child("answers").Index(i))...)
+	}
+	return allErrs
+}
+
+// Validate checks the MultiClusterAppRevision against the rules of its norman tags.
+func (in *MultiClusterAppRevision) Validate() field.ErrorList {
+	return Validate_MultiClusterAppRevision(in, nil)
+}
+
+// Validate_MultiClusterAppRevisionList is an autogenerated validation function that checks MultiClusterAppRevisionList
+// values against the rules of their norman tags.
+func Validate_MultiClusterAppRevisionList(in *MultiClusterAppRevisionList, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for i := range in.Items {
+		allErrs = append(allErrs, Validate_MultiClusterAppRevision(&in.Items[i], fldPath.Child("items").Index(i))...)
+	}
+	return allErrs
+}
+
+// Validate checks the MultiClusterAppRevisionList against the rules of its norman tags.
+func (in *MultiClusterAppRevisionList) Validate() field.ErrorList {
+	return Validate_MultiClusterAppRevisionList(in, nil)
+}
+
+// Validate_MultiClusterAppSpec is an autogenerated validation function that checks MultiClusterAppSpec
+// values against the rules of their norman tags.
+func Validate_MultiClusterAppSpec(in *MultiClusterAppSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if in.TemplateVersionName == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("templateVersionName"), ""))
+	}
+	for i := range in.Answers {
+		allErrs = append(allErrs, Validate_Answer(&in.Answers[i], fldPath.Child("answers").Index(i))...)
+	}
+	if in.Timeout != 0 {
+		if in.Timeout < 1 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("timeout"), in.Timeout, "must be greater than or equal to 1"))
+		}
+	}
+	if len(in.Targets) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("targets"), ""))
+	}
+	for i := range in.Targets {
+		allErrs = append(allErrs, Validate_Target(&in.Targets[i], fldPath.Child("targets").Index(i))...)
+	}
+	for i := range in.Members {
+		allErrs = append(allErrs, Validate_Member(&in.Members[i], fldPath.Child("members").Index(i))...)
+	}
+	if len(in.Roles) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("roles"), ""))
+	}
+	for i, v := range in.Roles {
+		if v == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("roles").Index(i), ""))
+		}
+	}
+	return allErrs
+}
+
+// Validate checks the MultiClusterAppSpec against the rules of its norman tags.
+func (in *MultiClusterAppSpec) Validate() field.ErrorList {
+	return Validate_MultiClusterAppSpec(in, nil)
+}
+
+// Validate_MultiClusterAppStatus is an autogenerated validation function that checks MultiClusterAppStatus
+// values against the rules of their norman tags.
+func Validate_MultiClusterAppStatus(in *MultiClusterAppStatus, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if in.RevisionName == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("revisionName"), ""))
+	}
+	return allErrs
+}
+
+// Validate checks the MultiClusterAppStatus against the rules of its norman tags.
+func (in *MultiClusterAppStatus) Validate() field.ErrorList {
+	return Validate_MultiClusterAppStatus(in, nil)
+}
+
+// Validate_Node is an autogenerated validation function that checks Node
+// values against the rules of their norman tags.
+func Validate_Node(in *Node, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, Validate_NodeSpec(&in.Spec, fldPath.Child("spec"))...)
+	allErrs = append(allErrs, Validate_NodeStatus(&in.Status, fldPath.Child("status"))...)
+	return allErrs
+}
+
+// Validate checks the Node against the rules of its norman tags.
+func (in *Node) Validate() field.ErrorList {
+	return Validate_Node(in, nil)
+}
+
+// Validate_NodeDrainInput is an autogenerated validation function that checks NodeDrainInput
+// values against the rules of their norman tags.
+func Validate_NodeDrainInput(in *NodeDrainInput, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if in.Timeout != 0 {
+		if in.Timeout < 1 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("timeout"), in.Timeout, "must be greater than or equal to 1"))
+		}
+		if in.Timeout > 10800 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("timeout"), in.Timeout, "must be less than or equal to 10800"))
+		}
+	}
+	return allErrs
+}
+
+// Validate checks the NodeDrainInput against the rules of its norman tags.
+func (in *NodeDrainInput) Validate() field.ErrorList {
+	return Validate_NodeDrainInput(in, nil)
+}
+
+// Validate_NodeDriver is an autogenerated validation function that checks NodeDriver
+// values against the rules of their norman tags.
+func Validate_NodeDriver(in *NodeDriver, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, Validate_NodeDriverSpec(&in.Spec, fldPath.Child("spec"))...)
+	return allErrs
+}
+
+// Validate checks the NodeDriver against the rules of its norman tags.
+func (in *NodeDriver) Validate() field.ErrorList {
+	return Validate_NodeDriver(in, nil)
+}
+
+// Validate_NodeDriverList is an autogenerated validation function that checks NodeDriverList
+// values against the rules of their norman tags.
+func Validate_NodeDriverList(in *NodeDriverList, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for i := range in.Items {
+		allErrs = append(allErrs, Validate_NodeDriver(&in.Items[i], fldPath.Child("items").Index(i))...)
+	}
+	return allErrs
+}
+
+// Validate checks the NodeDriverList against the rules of its norman tags.
+func (in *NodeDriverList) Validate() field.ErrorList {
+	return Validate_NodeDriverList(in, nil)
+}
+
+// Validate_NodeDriverSpec is an autogenerated validation function that checks NodeDriverSpec
+// values against the rules of their norman tags.
+func Validate_NodeDriverSpec(in *NodeDriverSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if in.URL == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("url"), ""))
+	}
+	return allErrs
+}
+
+// Validate checks the NodeDriverSpec against the rules of its norman tags.
+func (in *NodeDriverSpec) Validate() field.ErrorList {
+	return Validate_NodeDriverSpec(in, nil)
+}
+
+// Validate_NodeList is an autogenerated validation function that checks NodeList
+// values against the rules of their norman tags.
+func Validate_NodeList(in *NodeList, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for i := range in.Items {
+		allErrs = append(allErrs, Validate_Node(&in.Items[i], fldPath.Child("items").Index(i))...)
+	}
+	return allErrs
+}
+
+// Validate checks the NodeList against the rules of its norman tags.
+func (in *NodeList) Validate() field.ErrorList {
+	return Validate_NodeList(in, nil)
+}
+
+// Validate_NodePool is an autogenerated validation function that checks NodePool
+// values against the rules of their norman tags.
+func Validate_NodePool(in *NodePool, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, Validate_NodePoolSpec(&in.Spec, fldPath.Child("spec"))...)
+	return allErrs
+}
+
+// Validate checks the NodePool against the rules of its norman tags.
+func (in *NodePool) Validate() field.ErrorList {
+	return Validate_NodePool(in, nil)
+}
+
+// Validate_NodePoolList is an autogenerated validation function that checks NodePoolList
+// values against the rules of their norman tags.
+func Validate_NodePoolList(in *NodePoolList, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for i := range in.Items {
+		allErrs = append(allErrs, Validate_NodePool(&in.Items[i], fldPath.Child("items").Index(i))...)
+	}
+	return allErrs
+}
+
+// Validate checks the NodePoolList against the rules of its norman tags.
+func (in *NodePoolList) Validate() field.ErrorList {
+	return Validate_NodePoolList(in, nil)
+}
+
+// Validate_NodePoolSpec is an autogenerated validation function that checks NodePoolSpec
+// values against the rules of their norman tags.
+func Validate_NodePoolSpec(in *NodePoolSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if in.NodeTemplateName == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("nodeTemplateName"), ""))
+	}
+	if in.HostnamePrefix == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("hostnamePrefix"), ""))
+	}
+	if in.ClusterName == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("clusterName"), ""))
+	}
+	if in.DeleteNotReadyAfterSecs != 0 {
+		if in.DeleteNotReadyAfterSecs < 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("deleteNotReadyAfterSecs"), in.DeleteNotReadyAfterSecs, "must be greater than or equal to 0"))
+		}
+		if in.DeleteNotReadyAfterSecs > 31540000 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("deleteNotReadyAfterSecs"), in.DeleteNotReadyAfterSecs, "must be less than or equal to 31540000"))
+		}
+	}
+	return allErrs
+}
+
+// Validate checks the NodePoolSpec against the rules of its norman tags.
+func (in *NodePoolSpec) Validate() field.ErrorList {
+	return Validate_NodePoolSpec(in, nil)
+}
+
+// Validate_NodeRule is an autogenerated validation function that checks NodeRule
+// values against the rules of their norman tags.
+func Validate_NodeRule(in *NodeRule, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if in.Condition == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("condition"), ""))
+	} else {
+		switch in.Condition {
+		case "notready", "mem", "cpu":
+		default:
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("condition"), in.Condition, []string{"notready", "mem", "cpu"}))
+		}
+	}
+	if in.MemThreshold != 0 {
+		if in.MemThreshold < 1 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("memThreshold"), in.MemThreshold, "must be greater than or equal to 1"))
+		}
+		if in.MemThreshold > 100 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("memThreshold"), in.MemThreshold, "must be less than or equal to 100"))
+		}
+	}
+	if in.CPUThreshold != 0 {
+		if in.CPUThreshold < 1 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("cpuThreshold"), in.CPUThreshold, "must be greater than or equal to 1"))
+		}
+	}
+	return allErrs
+}
+
+// Validate checks the NodeRule against the rules of its norman tags.
+func (in *NodeRule) Validate() field.ErrorList {
+	return Validate_NodeRule(in, nil)
+}
+
+// Validate_NodeSpec is an autogenerated validation function that checks NodeSpec
+// values against the rules of their norman tags.
+func Validate_NodeSpec(in *NodeSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if in.RequestedHostname == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("requestedHostname"), ""))
+	} else {
+		for _, msg := range validation.IsDNS1123Subdomain(strings.ToLower(in.RequestedHostname)) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("requestedHostname"), in.RequestedHostname, msg))
+		}
+	}
+	if in.NodeDrainInput != nil {
+		allErrs = append(allErrs, Validate_NodeDrainInput(in.NodeDrainInput, fldPath.Child("nodeDrainInput"))...)
+	}
+	return allErrs
+}
+
+// Validate checks the NodeSpec against the rules of its norman tags.
+func (in *NodeSpec) Validate() field.ErrorList {
+	return Validate_NodeSpec(in, nil)
+}
+
+// Validate_NodeStatus is an autogenerated validation function that checks NodeStatus
+// values against the rules of their norman tags.
+func Validate_NodeStatus(in *NodeStatus, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if in.NodeConfig != nil {
+		allErrs = append(allErrs, Validate_RKEConfigNode(in.NodeConfig, fldPath.Child("rkeNode"))...)
+	}
+	return allErrs
+}
+
+// Validate checks the NodeStatus against the rules of its norman tags.
+func (in *NodeStatus) Validate() field.ErrorList {
+	return Validate_NodeStatus(in, nil)
+}
+
+// Validate_Notifier is an autogenerated validation function that checks Notifier
+// values against the rules of their norman tags.
+func Validate_Notifier(in *Notifier, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, Validate_NotifierSpec(&in.Spec, fldPath.Child("spec"))...)
+	return allErrs
+}
+
+// Validate checks the Notifier against the rules of its norman tags.
+func (in *Notifier) Validate() field.ErrorList {
+	return Validate_Notifier(in, nil)
+}
+
+// Validate_NotifierList is an autogenerated validation function that checks NotifierList
+// values against the rules of their norman tags.
+func Validate_NotifierList(in *NotifierList, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for i := range in.Items {
+		allErrs = append(allErrs, Validate_Notifier(&in.Items[i], fldPath.Child("items").Index(i))...)
+	}
+	return allErrs
+}
+
+// Validate checks the NotifierList against the rules of its norman tags.
+func (in *NotifierList) Validate() field.ErrorList {
+	return Validate_NotifierList(in, nil)
+}
+
+// Validate_NotifierSpec is an autogenerated validation function that checks NotifierSpec
+// values against the rules of their norman tags.
+func Validate_NotifierSpec(in *NotifierSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if in.DisplayName == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("displayName"), ""))
+	}
+	if in.SMTPConfig != nil {
+		allErrs = append(allErrs, Validate_SMTPConfig(in.SMTPConfig, fldPath.Child("smtpConfig"))...)
+	}
+	if in.SlackConfig != nil {
+		allErrs = append(allErrs, Validate_SlackConfig(in.SlackConfig, fldPath.Child("slackConfig"))...)
+	}
+	if in.PagerdutyConfig != nil {
+		allErrs = append(allErrs, Validate_PagerdutyConfig(in.PagerdutyConfig, fldPath.Child("pagerdutyConfig"))...)
+	}
+	if in.WebhookConfig != nil {
+		allErrs = append(allErrs, Validate_WebhookConfig(in.WebhookConfig, fldPath.Child("webhookConfig"))...)
+	}
+	if in.WechatConfig != nil {
+		allErrs = append(allErrs, Validate_WechatConfig(in.WechatConfig, fldPath.Child("wechatConfig"))...)
+	}
+	return allErrs
+}
+
+// Validate checks the NotifierSpec against the rules of its norman tags.
+func (in *NotifierSpec) Validate() field.ErrorList {
+	return Validate_NotifierSpec(in, nil)
+}
+
+// Validate_PagerdutyConfig is an autogenerated validation function that checks PagerdutyConfig
+// values against the rules of their norman tags.
+func Validate_PagerdutyConfig(in *PagerdutyConfig, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if in.ServiceKey == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("serviceKey"), ""))
+	}
+	return allErrs
+}
+
+// Validate checks the PagerdutyConfig against the rules of its norman tags.
+func (in *PagerdutyConfig) Validate() field.ErrorList {
+	return Validate_PagerdutyConfig(in, nil)
+}
+
+// Validate_PodRule is an autogenerated validation function that checks PodRule
+// values against the rules of their norman tags.
+func Validate_PodRule(in *PodRule, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if in.PodName == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("podName"), ""))
+	}
+	if in.Condition == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("condition"), ""))
+	} else {
+		switch in.Condition {
+		case "notrunning", "notscheduled", "restarts":
+		default:
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("condition"), in.Condition, []string{"notrunning", "notscheduled", "restarts"}))
+		}
+	}
+	if in.RestartTimes != 0 {
+		if in.RestartTimes < 1 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("restartTimes"), in.RestartTimes, "must be greater than or equal to 1"))
+		}
+	}
+	if in.RestartIntervalSeconds != 0 {
+		if in.RestartIntervalSeconds < 1 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("restartIntervalSeconds"), in.RestartIntervalSeconds, "must be greater than or equal to 1"))
+		}
+	}
+	return allErrs
+}
+
+// Validate checks the PodRule against the rules of its norman tags.
+func (in *PodRule) Validate() field.ErrorList {
+	return Validate_PodRule(in, nil)
+}
+
+// Validate_PodSecurityPolicyTemplateProjectBinding is an autogenerated validation function that checks PodSecurityPolicyTemplateProjectBinding
+// values against the rules of their norman tags.
+func Validate_PodSecurityPolicyTemplateProjectBinding(in *PodSecurityPolicyTemplateProjectBinding, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if in.PodSecurityPolicyTemplateName == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("podSecurityPolicyTemplateId"), ""))
+	}
+	if in.TargetProjectName == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("targetProjectId"), ""))
+	}
+	return allErrs
+}
+
+// Validate checks the PodSecurityPolicyTemplateProjectBinding against the rules of its norman tags.
+func (in *PodSecurityPolicyTemplateProjectBinding) Validate() field.ErrorList {
+	return Validate_PodSecurityPolicyTemplateProjectBinding(in, nil)
+}
+
+// Validate_PodSecurityPolicyTemplateProjectBindingList is an autogenerated validation function that checks PodSecurityPolicyTemplateProjectBindingList
+// values against the rules of their norman tags.
+func Validate_PodSecurityPolicyTemplateProjectBindingList(in *PodSecurityPolicyTemplateProjectBindingList, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for i := range in.Items {
+		allErrs = append(allErrs, Validate_PodSecurityPolicyTemplateProjectBinding(&in.Items[i], fldPath.Child("items").Index(i))...)
+	}
+	return allErrs
+}
+
+// Validate checks the PodSecurityPolicyTemplateProjectBindingList against the rules of its norman tags.
+func (in *PodSecurityPolicyTemplateProjectBindingList) Validate() field.ErrorList {
+	return Validate_PodSecurityPolicyTemplateProjectBindingList(in, nil)
+}
+
+// Validate_Preference is an autogenerated validation function that checks Preference
+// values against the rules of their norman tags.
+func Validate_Preference(in *Preference, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if in.Value == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("value"), ""))
+	}
+	return allErrs
+}
+
+// Validate checks the Preference against the rules of its norman tags.
+func (in *Preference) Validate() field.ErrorList {
+	return Validate_Preference(in, nil)
+}
+
+// Validate_PreferenceList is an autogenerated validation function that checks PreferenceList
+// values against the rules of their norman tags.
+func Validate_PreferenceList(in *PreferenceList, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for i := range in.Items {
+		allErrs = append(allErrs, Validate_Preference(&in.Items[i], fldPath.Child("items").Index(i))...)
+	}
+	return allErrs
+}
+
+// Validate checks the PreferenceList against the rules of its norman tags.
+func (in *PreferenceList) Validate() field.ErrorList {
+	return Validate_PreferenceList(in, nil)
+}
+
+// Validate_Project is an autogenerated validation function that checks Project
+// values against the rules of their norman tags.
+func Validate_Project(in *Project, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, Validate_ProjectSpec(&in.Spec, fldPath.Child("spec"))...)
+	return allErrs
+}
+
+// Validate checks the Project against the rules of its norman tags.
+func (in *Project) Validate() field.ErrorList {
+	return Validate_Project(in, nil)
+}
+
+// Validate_ProjectAlert is an autogenerated validation function that checks ProjectAlert
+// values against the rules of their norman tags.
+func Validate_ProjectAlert(in *ProjectAlert, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, Validate_ProjectAlertSpec(&in.Spec, fldPath.Child("spec"))...)
+	allErrs = append(allErrs, Validate_AlertStatus(&in.Status, fldPath.Child("status"))...)
+	return allErrs
+}
+
+// Validate checks the ProjectAlert against the rules of its norman tags.
+func (in *ProjectAlert) Validate() field.ErrorList {
+	return Validate_ProjectAlert(in, nil)
+}
+
+// Validate_ProjectAlertGroup is an autogenerated validation function that checks ProjectAlertGroup
+// values against the rules of their norman tags.
+func Validate_ProjectAlertGroup(in *ProjectAlertGroup, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, Validate_ProjectGroupSpec(&in.Spec, fldPath.Child("spec"))...)
+	allErrs = append(allErrs, Validate_AlertStatus(&in.Status, fldPath.Child("status"))...)
+	return allErrs
+}
+
+// Validate checks the ProjectAlertGroup against the rules of its norman tags.
+func (in *ProjectAlertGroup) Validate() field.ErrorList {
+	return Validate_ProjectAlertGroup(in, nil)
+}
+
+// Validate_ProjectAlertGroupList is an autogenerated validation function that checks ProjectAlertGroupList
+// values against the rules of their norman tags.
+func Validate_ProjectAlertGroupList(in *ProjectAlertGroupList, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for i := range in.Items {
+		allErrs = append(allErrs, Validate_ProjectAlertGroup(&in.Items[i], fldPath.Child("items").Index(i))...)
+	}
+	return allErrs
+}
+
+// Validate checks the ProjectAlertGroupList against the rules of its norman tags.
+func (in *ProjectAlertGroupList) Validate() field.ErrorList {
+	return Validate_ProjectAlertGroupList(in, nil)
+}
+
+// Validate_ProjectAlertList is an autogenerated validation function that checks ProjectAlertList
+// values against the rules of their norman tags.
+func Validate_ProjectAlertList(in *ProjectAlertList, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for i := range in.Items {
+		allErrs = append(allErrs, Validate_ProjectAlert(&in.Items[i], fldPath.Child("items").Index(i))...)
+	}
+	return allErrs
+}
+
+// Validate checks the ProjectAlertList against the rules of its norman tags.
+func (in *ProjectAlertList) Validate() field.ErrorList {
+	return Validate_ProjectAlertList(in, nil)
+}
+
+// Validate_ProjectAlertRule is an autogenerated validation function that checks ProjectAlertRule
+// values against the rules of their norman tags.
+func Validate_ProjectAlertRule(in *ProjectAlertRule, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, Validate_ProjectAlertRuleSpec(&in.Spec, fldPath.Child("spec"))...)
+	allErrs = append(allErrs, Validate_AlertStatus(&in.Status, fldPath.Child("status"))...)
+	return allErrs
+}
+
+// Validate checks the ProjectAlertRule against the rules of its norman tags.
+func (in *ProjectAlertRule) Validate() field.ErrorList {
+	return Validate_ProjectAlertRule(in, nil)
+}
+
+// Validate_ProjectAlertRuleList is an autogenerated validation function that checks ProjectAlertRuleList
+// values against the rules of their norman tags.
+func Validate_ProjectAlertRuleList(in *ProjectAlertRuleList, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for i := range in.Items {
+		allErrs = append(allErrs, Validate_ProjectAlertRule(&in.Items[i], fldPath.Child("items").Index(i))...)
+	}
+	return allErrs
+}
+
+// Validate checks the ProjectAlertRuleList against the rules of its norman tags.
+func (in *ProjectAlertRuleList) Validate() field.ErrorList {
+	return Validate_ProjectAlertRuleList(in, nil)
+}
+
+// Validate_ProjectAlertRuleSpec is an autogenerated validation function that checks ProjectAlertRuleSpec
+// values against the rules of their norman tags.
+func Validate_ProjectAlertRuleSpec(in *ProjectAlertRuleSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, Validate_CommonRuleField(&in.CommonRuleField, fldPath)...)
+	if in.PodRule != nil {
+		allErrs = append(allErrs, Validate_PodRule(in.PodRule, fldPath.Child("podRule"))...)
+	}
+	if in.WorkloadRule != nil {
+		allErrs = append(allErrs, Validate_WorkloadRule(in.WorkloadRule, fldPath.Child("workloadRule"))...)
+	}
+	if in.MetricRule != nil {
+		allErrs = append(allErrs, Validate_MetricRule(in.MetricRule, fldPath.Child("metricRule"))...)
+	}
+	return allErrs
+}
+
+// Validate checks the ProjectAlertRuleSpec against the rules of its norman tags.
+func (in *ProjectAlertRuleSpec) Validate() field.ErrorList {
+	return Validate_ProjectAlertRuleSpec(in, nil)
+}
+
+// Validate_ProjectAlertSpec is an autogenerated validation function that checks ProjectAlertSpec
+// values against the rules of their norman tags.
+func Validate_ProjectAlertSpec(in *ProjectAlertSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, Validate_AlertCommonSpec(&in.AlertCommonSpec, fldPath)...)
+	if in.TargetWorkload != nil {
+		allErrs = append(allErrs, Validate_TargetWorkload(in.TargetWorkload, fldPath.Child("targetWorkload"))...)
+	}
+	if in.TargetPod != nil {
+		allErrs = append(allErrs, Validate_TargetPod(in.TargetPod, fldPath.Child("targetPod"))...)
+	}
+	return allErrs
+}
+
+// Validate checks the ProjectAlertSpec against the rules of its norman tags.
+func (in *ProjectAlertSpec) Validate() field.ErrorList {
+	return Validate_ProjectAlertSpec(in, nil)
+}
+
+// Validate_ProjectCatalog is an autogenerated validation function that checks ProjectCatalog
+// values against the rules of their norman tags.
+func Validate_ProjectCatalog(in *ProjectCatalog, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, Validate_Catalog(&in.Catalog, fldPath)...)
+	return allErrs
+}
+
+// Validate checks the ProjectCatalog against the rules of its norman tags.
+func (in *ProjectCatalog) Validate() field.ErrorList {
+	return Validate_ProjectCatalog(in, nil)
+}
+
+// Validate_ProjectCatalogList is an autogenerated validation function that checks ProjectCatalogList
+// values against the rules of their norman tags.
+func Validate_ProjectCatalogList(in *ProjectCatalogList, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for i := range in.Items {
+		allErrs = append(allErrs, Validate_ProjectCatalog(&in.Items[i], fldPath.Child("items").Index(i))...)
+	}
+	return allErrs
+}
+
+// Validate checks the ProjectCatalogList against the rules of its norman tags.
+func (in *ProjectCatalogList) Validate() field.ErrorList {
+	return Validate_ProjectCatalogList(in, nil)
+}
+
+// Validate_ProjectGroupSpec is an autogenerated validation function that checks ProjectGroupSpec
+// values against the rules of their norman tags.
+func Validate_ProjectGroupSpec(in *ProjectGroupSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for i := range in.Recipients {
+		allErrs = append(allErrs, Validate_Recipient(&in.Recipients[i], fldPath.Child("recipients").Index(i))...)
+	}
+	allErrs = append(allErrs, Validate_CommonGroupField(&in.CommonGroupField, fldPath)...)
+	return allErrs
+}
+
+// Validate checks the ProjectGroupSpec against the rules of its norman tags.
+func (in *ProjectGroupSpec) Validate() field.ErrorList {
+	return Validate_ProjectGroupSpec(in, nil)
+}
+
+// Validate_ProjectList is an autogenerated validation function that checks ProjectList
+// values against the rules of their norman tags.
+func Validate_ProjectList(in *ProjectList, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for i := range in.Items {
+		allErrs = append(allErrs, Validate_Project(&in.Items[i], fldPath.Child("items").Index(i))...)
+	}
+	return allErrs
+}
+
+// Validate checks the ProjectList against the rules of its norman tags.
+func (in *ProjectList) Validate() field.ErrorList {
+	return Validate_ProjectList(in, nil)
+}
+
+// Validate_ProjectLogging is an autogenerated validation function that checks ProjectLogging
+// values against the rules of their norman tags.
+func Validate_ProjectLogging(in *ProjectLogging, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, Validate_ProjectLoggingSpec(&in.Spec, fldPath.Child("spec"))...)
+	allErrs = append(allErrs, Validate_ProjectLoggingStatus(&in.Status, fldPath.Child("status"))...)
+	return allErrs
+}
+
+// Validate checks the ProjectLogging against the rules of its norman tags.
+func (in *ProjectLogging) Validate() field.ErrorList {
+	return Validate_ProjectLogging(in, nil)
+}
+
+// Validate_ProjectLoggingList is an autogenerated validation function that checks ProjectLoggingList
+// values against the rules of their norman tags.
+func Validate_ProjectLoggingList(in *ProjectLoggingList, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for i := range in.Items {
+		allErrs = append(allErrs, Validate_ProjectLogging(&in.Items[i], fldPath.Child("items").Index(i))...)
+	}
+	return allErrs
+}
+
+// Validate checks the ProjectLoggingList against the rules of its norman tags.
+func (in *ProjectLoggingList) Validate() field.ErrorList {
+	return Validate_ProjectLoggingList(in, nil)
+}
+
+// Validate_ProjectLoggingSpec is an autogenerated validation function that checks ProjectLoggingSpec
+// values against the rules of their norman tags.
+func Validate_ProjectLoggingSpec(in *ProjectLoggingSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, Validate_LoggingTargets(&in.LoggingTargets, fldPath)...)
+	return allErrs
+}
+
+// Validate checks the ProjectLoggingSpec against the rules of its norman tags.
+func (in *ProjectLoggingSpec) Validate() field.ErrorList {
+	return Validate_ProjectLoggingSpec(in, nil)
+}
+
+// Validate_ProjectLoggingStatus is an autogenerated validation function that checks ProjectLoggingStatus
+// values against the rules of their norman tags.
+func Validate_ProjectLoggingStatus(in *ProjectLoggingStatus, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, Validate_ProjectLoggingSpec(&in.AppliedSpec, fldPath.Child("appliedSpec"))...)
+	return allErrs
+}
+
+// Validate checks the ProjectLoggingStatus against the rules of its norman tags.
+func (in *ProjectLoggingStatus) Validate() field.ErrorList {
+	return Validate_ProjectLoggingStatus(in, nil)
+}
+
+// Validate_ProjectMonitorGraph is an autogenerated validation function that checks ProjectMonitorGraph
+// values against the rules of their norman tags.
+func Validate_ProjectMonitorGraph(in *ProjectMonitorGraph, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, Validate_ProjectMonitorGraphSpec(&in.Spec, fldPath.Child("spec"))...)
+	return allErrs
+}
+
+// Validate checks the ProjectMonitorGraph against the rules of its norman tags.
+func (in *ProjectMonitorGraph) Validate() field.ErrorList {
+	return Validate_ProjectMonitorGraph(in, nil)
+}
+
+// Validate_ProjectMonitorGraphList is an autogenerated validation function that checks ProjectMonitorGraphList
+// values against the rules of their norman tags.
+func Validate_ProjectMonitorGraphList(in *ProjectMonitorGraphList, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for i := range in.Items {
+		allErrs = append(allErrs, Validate_ProjectMonitorGraph(&in.Items[i], fldPath.Child("items").Index(i))...)
+	}
+	return allErrs
+}
+
+// Validate checks the ProjectMonitorGraphList against the rules of its norman tags.
+func (in *ProjectMonitorGraphList) Validate() field.ErrorList {
+	return Validate_ProjectMonitorGraphList(in, nil)
+}
+
+// Validate_ProjectMonitorGraphSpec is an autogenerated validation function that checks ProjectMonitorGraphSpec
+// values against the rules of their norman tags.
+func Validate_ProjectMonitorGraphSpec(in *ProjectMonitorGraphSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if in.ResourceType != "" {
+		switch in.ResourceType {
+		case "workload", "pod", "container":
+		default:
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("resourceType"), in.ResourceType, []string{"workload", "pod", "container"}))
+		}
+	}
+	if in.DisplayResourceType != "" {
+		switch in.DisplayResourceType {
+		case "workload", "pod", "container":
+		default:
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("displayResourceType"), in.DisplayResourceType, []string{"workload", "pod", "container"}))
+		}
+	}
+	allErrs = append(allErrs, Validate_CommonMonitorGraphSpec(&in.CommonMonitorGraphSpec, fldPath)...)
+	return allErrs
+}
+
+// Validate checks the ProjectMonitorGraphSpec against the rules of its norman tags.
+func (in *ProjectMonitorGraphSpec) Validate() field.ErrorList {
+	return Validate_ProjectMonitorGraphSpec(in, nil)
+}
+
+// Validate_ProjectNetworkPolicy is an autogenerated validation function that checks ProjectNetworkPolicy
+// values against the rules of their norman tags.
+func Validate_ProjectNetworkPolicy(in *ProjectNetworkPolicy, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, Validate_ProjectNetworkPolicySpec(&in.Spec, fldPath.Child("spec"))...)
+	return allErrs
+}
+
+// Validate checks the ProjectNetworkPolicy against the rules of its norman tags.
+func (in *ProjectNetworkPolicy) Validate() field.ErrorList {
+	return Validate_ProjectNetworkPolicy(in, nil)
+}
+
+// Validate_ProjectNetworkPolicyList is an autogenerated validation function that checks ProjectNetworkPolicyList
+// values against the rules of their norman tags.
+func Validate_ProjectNetworkPolicyList(in *ProjectNetworkPolicyList, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for i := range in.Items {
+		allErrs = append(allErrs, Validate_ProjectNetworkPolicy(&in.Items[i], fldPath.Child("items").Index(i))...)
+	}
+	return allErrs
+}
+
+// Validate checks the ProjectNetworkPolicyList against the rules of its norman tags.
+func (in *ProjectNetworkPolicyList) Validate() field.ErrorList {
+	return Validate_ProjectNetworkPolicyList(in, nil)
+}
+
+// Validate_ProjectNetworkPolicySpec is an autogenerated validation function that checks ProjectNetworkPolicySpec
+// values against the rules of their norman tags.
+func Validate_ProjectNetworkPolicySpec(in *ProjectNetworkPolicySpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if in.ProjectName == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("projectName"), ""))
+	}
+	return allErrs
+}
+
+// Validate checks the ProjectNetworkPolicySpec against the rules of its norman tags.
+func (in *ProjectNetworkPolicySpec) Validate() field.ErrorList {
+	return Validate_ProjectNetworkPolicySpec(in, nil)
+}
+
+// Validate_ProjectRoleTemplateBinding is an autogenerated validation function that checks ProjectRoleTemplateBinding
+// values against the rules of their norman tags.
+func Validate_ProjectRoleTemplateBinding(in *ProjectRoleTemplateBinding, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if in.ProjectName == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("projectName"), ""))
+	}
+	if in.RoleTemplateName == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("roleTemplateName"), ""))
+	}
+	return allErrs
+}
+
+// Validate checks the ProjectRoleTemplateBinding against the rules of its norman tags.
+func (in *ProjectRoleTemplateBinding) Validate() field.ErrorList {
+	return Validate_ProjectRoleTemplateBinding(in, nil)
+}
+
+// Validate_ProjectRoleTemplateBindingList is an autogenerated validation function that checks ProjectRoleTemplateBindingList
+// values against the rules of their norman tags.
+func Validate_ProjectRoleTemplateBindingList(in *ProjectRoleTemplateBindingList, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for i := range in.Items {
+		allErrs = append(allErrs, Validate_ProjectRoleTemplateBinding(&in.Items[i], fldPath.Child("items").Index(i))...)
+	}
+	return allErrs
+}
+
+// Validate checks the ProjectRoleTemplateBindingList against the rules of its norman tags.
+func (in *ProjectRoleTemplateBindingList) Validate() field.ErrorList {
+	return Validate_ProjectRoleTemplateBindingList(in, nil)
+}
+
+// Validate_ProjectSpec is an autogenerated validation function that checks ProjectSpec
+// values against the rules of their norman tags.
+func Validate_ProjectSpec(in *ProjectSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if in.DisplayName == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("displayName"), ""))
+	}
+	if in.ClusterName == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("clusterName"), ""))
+	}
+	return allErrs
+}
+
+// Validate checks the ProjectSpec against the rules of its norman tags.
+func (in *ProjectSpec) Validate() field.ErrorList {
+	return Validate_ProjectSpec(in, nil)
+}
+
+// Validate_RKEConfigNode is an autogenerated validation function that checks RKEConfigNode
+// values against the rules of their norman tags.
+func Validate_RKEConfigNode(in *RKEConfigNode, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for i, v := range in.Role {
+		if v != "" {
+			switch v {
+			case "etcd", "worker", "controlplane":
+			default:
+				allErrs = append(allErrs, field.NotSupported(fldPath.Child("role").Index(i), v, []string{"etcd", "worker", "controlplane"}))
+			}
+		}
+	}
+	return allErrs
+}
+
+// Validate checks the RKEConfigNode against the rules of its norman tags.
+func (in *RKEConfigNode) Validate() field.ErrorList {
+	return Validate_RKEConfigNode(in, nil)
+}
+
+// Validate_RancherKubernetesEngineConfig is an autogenerated validation function that checks RancherKubernetesEngineConfig
+// values against the rules of their norman tags.
+func Validate_RancherKubernetesEngineConfig(in *RancherKubernetesEngineConfig, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for i := range in.Nodes {
+		allErrs = append(allErrs, Validate_RKEConfigNode(&in.Nodes[i], fldPath.Child("nodes").Index(i))...)
+	}
+	if in.RotateCertificates != nil {
+		allErrs = append(allErrs, Validate_RotateCertificates(in.RotateCertificates, fldPath.Child("rotateCertificates"))...)
+	}
+	return allErrs
+}
+
+// Validate checks the RancherKubernetesEngineConfig against the rules of its norman tags.
+func (in *RancherKubernetesEngineConfig) Validate() field.ErrorList {
+	return Validate_RancherKubernetesEngineConfig(in, nil)
+}
+
+// Validate_Recipient is an autogenerated validation function that checks Recipient
+// values against the rules of their norman tags.
+func Validate_Recipient(in *Recipient, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if in.NotifierName == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("notifierName"), ""))
+	}
+	if in.NotifierType == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("notifierType"), ""))
+	} else {
+		switch in.NotifierType {
+		case "slack", "email", "pagerduty", "webhook", "wechat":
+		default:
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("notifierType"), in.NotifierType, []string{"slack", "email", "pagerduty", "webhook", "wechat"}))
+		}
+	}
+	return allErrs
+}
+
+// Validate checks the Recipient against the rules of its norman tags.
+func (in *Recipient) Validate() field.ErrorList {
+	return Validate_Recipient(in, nil)
+}
+
+// Validate_RoleTemplate is an autogenerated validation function that checks RoleTemplate
+// values against the rules of their norman tags.
+func Validate_RoleTemplate(in *RoleTemplate, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if in.DisplayName == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("displayName"), ""))
+	}
+	if in.Context != "" {
+		switch in.Context {
+		case "project", "cluster":
+		default:
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("context"), in.Context, []string{"project", "cluster"}))
+		}
+	}
+	for i, v := range in.RoleTemplateNames {
+		if v == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("roleTemplateNames").Index(i), ""))
+		}
+	}
+	return allErrs
+}
+
+// Validate checks the RoleTemplate against the rules of its norman tags.
+func (in *RoleTemplate) Validate() field.ErrorList {
+	return Validate_RoleTemplate(in, nil)
+}
+
+// Validate_RoleTemplateList is an autogenerated validation function that checks RoleTemplateList
+// values against the rules of their norman tags.
+func Validate_RoleTemplateList(in *RoleTemplateList, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for i := range in.Items {
+		allErrs = append(allErrs, Validate_RoleTemplate(&in.Items[i], fldPath.Child("items").Index(i))...)
+	}
+	return allErrs
+}
+
+// Validate checks the RoleTemplateList against the rules of its norman tags.
+func (in *RoleTemplateList) Validate() field.ErrorList {
+	return Validate_RoleTemplateList(in, nil)
+}
+
+// Validate_RotateCertificates is an autogenerated validation function that checks RotateCertificates
+// values against the rules of their norman tags.
+func Validate_RotateCertificates(in *RotateCertificates, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for i, v := range in.Services {
+		if v != "" {
+			switch v {
+			case "etcd", "kubelet", "kube-apiserver", "kube-proxy", "kube-scheduler", "kube-controller-manager":
+			default:
+				allErrs = append(allErrs, field.NotSupported(fldPath.Child("services").Index(i), v, []string{"etcd", "kubelet", "kube-apiserver", "kube-proxy", "kube-scheduler", "kube-controller-manager"}))
+			}
+		}
+	}
+	return allErrs
+}
+
+// Validate checks the RotateCertificates against the rules of its norman tags.
+func (in *RotateCertificates) Validate() field.ErrorList {
+	return Validate_RotateCertificates(in, nil)
+}
+
+// Validate_Route53ProviderConfig is an autogenerated validation function that checks Route53ProviderConfig
+// values against the rules of their norman tags.
+func Validate_Route53ProviderConfig(in *Route53ProviderConfig, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if in.AccessKey == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("accessKey"), ""))
+	} else {
+		if len(in.AccessKey) < 1 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("accessKey"), in.AccessKey, "must be at least 1 characters"))
+		}
+	}
+	if in.SecretKey == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("secretKey"), ""))
+	} else {
+		if len(in.SecretKey) < 1 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("secretKey"), "", "must be at least 1 characters"))
+		}
+	}
+	return allErrs
+}
+
+// Validate checks the Route53ProviderConfig against the rules of its norman tags.
+func (in *Route53ProviderConfig) Validate() field.ErrorList {
+	return Validate_Route53ProviderConfig(in, nil)
+}
+
+// Validate_SMTPConfig is an autogenerated validation function that checks SMTPConfig
+// values against the rules of their norman tags.
+func Validate_SMTPConfig(in *SMTPConfig, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if in.Host == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("host"), ""))
+	} else {
+		for _, msg := range validation.IsDNS1123Subdomain(strings.ToLower(in.Host)) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("host"), in.Host, msg))
+		}
+	}
+	if in.Port != 0 {
+		if in.Port < 1 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("port"), in.Port, "must be greater than or equal to 1"))
+		}
+		if in.Port > 65535 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("port"), in.Port, "must be less than or equal to 65535"))
+		}
+	}
+	if in.Sender == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("sender"), ""))
+	}
+	if in.DefaultRecipient == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("defaultRecipient"), ""))
+	}
+	return allErrs
+}
+
+// Validate checks the SMTPConfig against the rules of its norman tags.
+func (in *SMTPConfig) Validate() field.ErrorList {
+	return Validate_SMTPConfig(in, nil)
+}
+
+// Validate_Setting is an autogenerated validation function that checks Setting
+// values against the rules of their norman tags.
+func Validate_Setting(in *Setting, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if in.Value == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("value"), ""))
+	}
+	if in.Source != "" {
+		switch in.Source {
+		case "db", "default", "env":
+		default:
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("source"), in.Source, []string{"db", "default", "env"}))
+		}
+	}
+	return allErrs
+}
+
+// Validate checks the Setting against the rules of its norman tags.
+func (in *Setting) Validate() field.ErrorList {
+	return Validate_Setting(in, nil)
+}
+
+// Validate_SettingList is an autogenerated validation function that checks SettingList
+// values against the rules of their norman tags.
+func Validate_SettingList(in *SettingList, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for i := range in.Items {
+		allErrs = append(allErrs, Validate_Setting(&in.Items[i], fldPath.Child("items").Index(i))...)
+	}
+	return allErrs
+}
+
+// Validate checks the SettingList against the rules of its norman tags.
+func (in *SettingList) Validate() field.ErrorList {
+	return Validate_SettingList(in, nil)
+}
+
+// Validate_SlackConfig is an autogenerated validation function that checks SlackConfig
+// values against the rules of their norman tags.
+func Validate_SlackConfig(in *SlackConfig, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if in.URL == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("url"), ""))
+	}
+	return allErrs
+}
+
+// Validate checks the SlackConfig against the rules of its norman tags.
+func (in *SlackConfig) Validate() field.ErrorList {
+	return Validate_SlackConfig(in, nil)
+}
+
+// Validate_SplunkConfig is an autogenerated validation function that checks SplunkConfig
+// values against the rules of their norman tags.
+func Validate_SplunkConfig(in *SplunkConfig, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if in.Endpoint == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("endpoint"), ""))
+	}
+	if in.Token == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("token"), ""))
+	}
+	return allErrs
+}
+
+// Validate checks the SplunkConfig against the rules of its norman tags.
+func (in *SplunkConfig) Validate() field.ErrorList {
+	return Validate_SplunkConfig(in, nil)
+}
+
+// Validate_SyslogConfig is an autogenerated validation function that checks SyslogConfig
+// values against the rules of their norman tags.
+func Validate_SyslogConfig(in *SyslogConfig, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if in.Endpoint == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("endpoint"), ""))
+	}
+	if in.Severity != "" {
+		switch in.Severity {
+		case "emerg", "alert", "crit", "err", "warning", "notice", "info", "debug":
+		default:
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("severity"), in.Severity, []string{"emerg", "alert", "crit", "err", "warning", "notice", "info", "debug"}))
+		}
+	}
+	if in.Protocol != "" {
+		switch in.Protocol {
+		case "udp", "tcp":
+		default:
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("protocol"), in.Protocol, []string{"udp", "tcp"}))
+		}
+	}
+	return allErrs
+}
+
+// Validate checks the SyslogConfig against the rules of its norman tags.
+func (in *SyslogConfig) Validate() field.ErrorList {
+	return Validate_SyslogConfig(in, nil)
+}
+
+// Validate_SystemServiceRule is an autogenerated validation function that checks SystemServiceRule
+// values against the rules of their norman tags.
+func Validate_SystemServiceRule(in *SystemServiceRule, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if in.Condition == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("condition"), ""))
+	} else {
+		switch in.Condition {
+		case "etcd", "controller-manager", "scheduler":
+		default:
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("condition"), in.Condition, []string{"etcd", "controller-manager", "scheduler"}))
+		}
+	}
+	return allErrs
+}
+
+// Validate checks the SystemServiceRule against the rules of its norman tags.
+func (in *SystemServiceRule) Validate() field.ErrorList {
+	return Validate_SystemServiceRule(in, nil)
+}
+
+// Validate_Target is an autogenerated validation function that checks Target
+// values against the rules of their norman tags.
+func Validate_Target(in *Target, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if in.ProjectName == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("projectName"), ""))
+	}
+	return allErrs
+}
+
+// Validate checks the Target against the rules of its norman tags.
+func (in *Target) Validate() field.ErrorList {
+	return Validate_Target(in, nil)
+}
+
+// Validate_TargetEvent is an autogenerated validation function that checks TargetEvent
+// values against the rules of their norman tags.
+func Validate_TargetEvent(in *TargetEvent, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if in.EventType == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("eventType"), ""))
+	} else {
+		switch in.EventType {
+		case "Normal", "Warning":
+		default:
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("eventType"), in.EventType, []string{"Normal", "Warning"}))
+		}
+	}
+	if in.ResourceKind == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("resourceKind"), ""))
+	} else {
+		switch in.ResourceKind {
+		case "Pod", "Node", "Deployment", "StatefulSet", "DaemonSet":
+		default:
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("resourceKind"), in.ResourceKind, []string{"Pod", "Node", "Deployment", "StatefulSet", "DaemonSet"}))
+		}
+	}
+	return allErrs
+}
+
+// Validate checks the TargetEvent against the rules of its norman tags.
+func (in *TargetEvent) Validate() field.ErrorList {
+	return Validate_TargetEvent(in, nil)
+}
+
+// Validate_TargetNode is an autogenerated validation function that checks TargetNode
+// values against the rules of their norman tags.
+func Validate_TargetNode(in *TargetNode, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if in.Condition == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("condition"), ""))
+	} else {
+		switch in.Condition {
+		case "notready", "mem", "cpu":
+		default:
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("condition"), in.Condition, []string{"notready", "mem", "cpu"}))
+		}
+	}
+	if in.MemThreshold != 0 {
+		if in.MemThreshold < 1 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("memThreshold"), in.MemThreshold, "must be greater than or equal to 1"))
+		}
+		if in.MemThreshold > 100 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("memThreshold"), in.MemThreshold, "must be less than or equal to 100"))
+		}
+	}
+	if in.CPUThreshold != 0 {
+		if in.CPUThreshold < 1 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("cpuThreshold"), in.CPUThreshold, "must be greater than or equal to 1"))
+		}
+	}
+	return allErrs
+}
+
+// Validate checks the TargetNode against the rules of its norman tags.
+func (in *TargetNode) Validate() field.ErrorList {
+	return Validate_TargetNode(in, nil)
+}
+
+// Validate_TargetPod is an autogenerated validation function that checks TargetPod
+// values against the rules of their norman tags.
+func Validate_TargetPod(in *TargetPod, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if in.PodName == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("podName"), ""))
+	}
+	if in.Condition == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("condition"), ""))
+	} else {
+		switch in.Condition {
+		case "notrunning", "notscheduled", "restarts":
+		default:
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("condition"), in.Condition, []string{"notrunning", "notscheduled", "restarts"}))
+		}
+	}
+	if in.RestartTimes != 0 {
+		if in.RestartTimes < 1 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("restartTimes"), in.RestartTimes, "must be greater than or equal to 1"))
+		}
+	}
+	if in.RestartIntervalSeconds != 0 {
+		if in.RestartIntervalSeconds < 1 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("restartIntervalSeconds"), in.RestartIntervalSeconds, "must be greater than or equal to 1"))
+		}
+	}
+	return allErrs
+}
+
+// Validate checks the TargetPod against the rules of its norman tags.
+func (in *TargetPod) Validate() field.ErrorList {
+	return Validate_TargetPod(in, nil)
+}
+
+// Validate_TargetSystemService is an autogenerated validation function that checks TargetSystemService
+// values against the rules of their norman tags.
+func Validate_TargetSystemService(in *TargetSystemService, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if in.Condition == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("condition"), ""))
+	} else {
+		switch in.Condition {
+		case "etcd", "controller-manager", "scheduler":
+		default:
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("condition"), in.Condition, []string{"etcd", "controller-manager", "scheduler"}))
+		}
+	}
+	return allErrs
+}
+
+// Validate checks the TargetSystemService against the rules of its norman tags.
+func (in *TargetSystemService) Validate() field.ErrorList {
+	return Validate_TargetSystemService(in, nil)
+}
+
+// Validate_TargetWorkload is an autogenerated validation function that checks TargetWorkload
+// values against the rules of their norman tags.
+func Validate_TargetWorkload(in *TargetWorkload, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if in.AvailablePercentage != 0 {
+		if in.AvailablePercentage < 1 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("availablePercentage"), in.AvailablePercentage, "must be greater than or equal to 1"))
+		}
+		if in.AvailablePercentage > 100 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("availablePercentage"), in.AvailablePercentage, "must be less than or equal to 100"))
+		}
+	}
+	return allErrs
+}
+
+// Validate checks the TargetWorkload against the rules of its norman tags.
+func (in *TargetWorkload) Validate() field.ErrorList {
+	return Validate_TargetWorkload(in, nil)
+}
+
+// Validate_Template is an autogenerated validation function that checks Template
+// values against the rules of their norman tags.
+func Validate_Template(in *Template, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, Validate_TemplateSpec(&in.Spec, fldPath.Child("spec"))...)
+	return allErrs
+}
+
+// Validate checks the Template against the rules of its norman tags.
+func (in *Template) Validate() field.ErrorList {
+	return Validate_Template(in, nil)
+}
+
+// Validate_TemplateList is an autogenerated validation function that checks TemplateList
+// values against the rules of their norman tags.
+func Validate_TemplateList(in *TemplateList, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for i := range in.Items {
+		allErrs = append(allErrs, Validate_Template(&in.Items[i], fldPath.Child("items").Index(i))...)
+	}
+	return allErrs
+}
+
+// Validate checks the TemplateList against the rules of its norman tags.
+func (in *TemplateList) Validate() field.ErrorList {
+	return Validate_TemplateList(in, nil)
+}
+
+// Validate_TemplateSpec is an autogenerated validation function that checks TemplateSpec
+// values against the rules of their norman tags.
+func Validate_TemplateSpec(in *TemplateSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if in.ProjectID == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("projectId"), ""))
+	}
+	if in.ClusterID == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("clusterId"), ""))
+	}
+	return allErrs
+}
+
+// Validate checks the TemplateSpec against the rules of its norman tags.
+func (in *TemplateSpec) Validate() field.ErrorList {
+	return Validate_TemplateSpec(in, nil)
+}
+
+// Validate_TimingField is an autogenerated validation function that checks TimingField
+// values against the rules of their norman tags.
+func Validate_TimingField(in *TimingField, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if in.GroupWaitSeconds != 0 {
+		if in.GroupWaitSeconds < 1 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("groupWaitSeconds"), in.GroupWaitSeconds, "must be greater than or equal to 1"))
+		}
+	}
+	if in.GroupIntervalSeconds != 0 {
+		if in.GroupIntervalSeconds < 1 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("groupIntervalSeconds"), in.GroupIntervalSeconds, "must be greater than or equal to 1"))
+		}
+	}
+	if in.RepeatIntervalSeconds != 0 {
+		if in.RepeatIntervalSeconds < 1 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("repeatIntervalSeconds"), in.RepeatIntervalSeconds, "must be greater than or equal to 1"))
+		}
+	}
+	return allErrs
+}
+
+// Validate checks the TimingField against the rules of its norman tags.
+func (in *TimingField) Validate() field.ErrorList {
+	return Validate_TimingField(in, nil)
+}
+
+// Validate_User is an autogenerated validation function that checks User
+// values against the rules of their norman tags.
+func Validate_User(in *User, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for i, v := range in.PrincipalIDs {
+		if v == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("principalIds").Index(i), ""))
+		}
+	}
+	return allErrs
+}
+
+// Validate checks the User against the rules of its norman tags.
+func (in *User) Validate() field.ErrorList {
+	return Validate_User(in, nil)
+}
+
+// Validate_UserList is an autogenerated validation function that checks UserList
+// values against the rules of their norman tags.
+func Validate_UserList(in *UserList, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for i := range in.Items {
+		allErrs = append(allErrs, Validate_User(&in.Items[i], fldPath.Child("items").Index(i))...)
+	}
+	return allErrs
+}
+
+// Validate checks the UserList against the rules of its norman tags.
+func (in *UserList) Validate() field.ErrorList {
+	return Validate_UserList(in, nil)
+}
+
+// Validate_WebhookConfig is an autogenerated validation function that checks WebhookConfig
+// values against the rules of their norman tags.
+func Validate_WebhookConfig(in *WebhookConfig, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if in.URL == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("url"), ""))
+	}
+	return allErrs
+}
+
+// Validate checks the WebhookConfig against the rules of its norman tags.
+func (in *WebhookConfig) Validate() field.ErrorList {
+	return Validate_WebhookConfig(in, nil)
+}
+
+// Validate_WechatConfig is an autogenerated validation function that checks WechatConfig
+// values against the rules of their norman tags.
+func Validate_WechatConfig(in *WechatConfig, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if in.DefaultRecipient == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("defaultRecipient"), ""))
+	}
+	if in.Secret == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("secret"), ""))
+	}
+	if in.Agent == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("agent"), ""))
+	}
+	if in.Corp == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("corp"), ""))
+	}
+	if in.RecipientType == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("recipientType"), ""))
+	} else {
+		switch in.RecipientType {
+		case "tag", "party", "user":
+		default:
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("recipientType"), in.RecipientType, []string{"tag", "party", "user"}))
+		}
+	}
+	return allErrs
+}
+
+// Validate checks the WechatConfig against the rules of its norman tags.
+func (in *WechatConfig) Validate() field.ErrorList {
+	return Validate_WechatConfig(in, nil)
+}
+
+// Validate_WorkloadRule is an autogenerated validation function that checks WorkloadRule
+// values against the rules of their norman tags.
+func Validate_WorkloadRule(in *WorkloadRule, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if in.AvailablePercentage != 0 {
+		if in.AvailablePercentage < 1 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("availablePercentage"), in.AvailablePercentage, "must be greater than or equal to 1"))
+		}
+		if in.AvailablePercentage > 100 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("availablePercentage"), in.AvailablePercentage, "must be less than or equal to 100"))
+		}
+	}
+	return allErrs
+}
+
+// Validate checks the WorkloadRule against the rules of its norman tags.
+func (in *WorkloadRule) Validate() field.ErrorList {
+	return Validate_WorkloadRule(in, nil)
+}
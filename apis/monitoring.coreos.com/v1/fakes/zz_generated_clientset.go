@@ -0,0 +1,1148 @@
+package fakes
+
+import (
+	"context"
+	"fmt"
+
+	v1 "github.com/coreos/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/rancher/norman/controller"
+	"github.com/rancher/norman/lifecycle"
+	"github.com/rancher/norman/objectclient"
+	v1a "github.com/rancher/types/apis/monitoring.coreos.com/v1"
+	"github.com/rancher/types/fakeclient"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
+)
+
+// Clientset is an in-memory v1a.Interface. Its objects are kept in a
+// fakeclient.Tracker, which can be shared with the clientsets of other groups
+// to run their controllers together.
+type Clientset struct {
+	*fakeclient.Tracker
+}
+
+var _ v1a.Interface = &Clientset{}
+
+// NewClientset returns a Clientset with a tracker of its own, holding
+// objects. It panics if an object is not a kind of the group.
+func NewClientset(objects ...runtime.Object) *Clientset {
+	return NewClientsetForTracker(fakeclient.NewTracker(), objects...)
+}
+
+// NewClientsetForTracker returns a Clientset storing its objects in tracker.
+// It panics if an object is not a kind of the group.
+func NewClientsetForTracker(tracker *fakeclient.Tracker, objects ...runtime.Object) *Clientset {
+	c := &Clientset{
+		Tracker: tracker,
+	}
+	if err := c.Add(objects...); err != nil {
+		panic(err)
+	}
+	return c
+}
+
+// RESTClient returns nil, the objects of a Clientset are not served.
+func (c *Clientset) RESTClient() rest.Interface {
+	return nil
+}
+
+// Add stores objects as they are, without the checks of Create.
+func (c *Clientset) Add(objects ...runtime.Object) error {
+	for _, obj := range objects {
+		var resource *fakeclient.Resource
+		switch obj.(type) {
+		case *v1.Prometheus:
+			resource = c.Resource(v1a.PrometheusGroupVersionKind, v1a.PrometheusResource)
+		case *v1.Alertmanager:
+			resource = c.Resource(v1a.AlertmanagerGroupVersionKind, v1a.AlertmanagerResource)
+		case *v1.PrometheusRule:
+			resource = c.Resource(v1a.PrometheusRuleGroupVersionKind, v1a.PrometheusRuleResource)
+		case *v1.ServiceMonitor:
+			resource = c.Resource(v1a.ServiceMonitorGroupVersionKind, v1a.ServiceMonitorResource)
+		default:
+			return fmt.Errorf("%T is not a kind of %s", obj, v1a.GroupName)
+		}
+		if err := resource.Add(obj); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *Clientset) Prometheuses(namespace string) v1a.PrometheusInterface {
+	resource := c.Resource(v1a.PrometheusGroupVersionKind, v1a.PrometheusResource)
+	return &prometheusClient{
+		client: resource.Client(namespace),
+	}
+}
+
+func (c *Clientset) Alertmanagers(namespace string) v1a.AlertmanagerInterface {
+	resource := c.Resource(v1a.AlertmanagerGroupVersionKind, v1a.AlertmanagerResource)
+	return &alertmanagerClient{
+		client: resource.Client(namespace),
+	}
+}
+
+func (c *Clientset) PrometheusRules(namespace string) v1a.PrometheusRuleInterface {
+	resource := c.Resource(v1a.PrometheusRuleGroupVersionKind, v1a.PrometheusRuleResource)
+	return &prometheusRuleClient{
+		client: resource.Client(namespace),
+	}
+}
+
+func (c *Clientset) ServiceMonitors(namespace string) v1a.ServiceMonitorInterface {
+	resource := c.Resource(v1a.ServiceMonitorGroupVersionKind, v1a.ServiceMonitorResource)
+	return &serviceMonitorClient{
+		client: resource.Client(namespace),
+	}
+}
+
+type prometheusClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *prometheusClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *prometheusClient) Create(o *v1.Prometheus) (*v1.Prometheus, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.Prometheus), nil
+}
+
+func (s *prometheusClient) Get(name string, opts metav1.GetOptions) (*v1.Prometheus, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.Prometheus), nil
+}
+
+func (s *prometheusClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v1.Prometheus, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.Prometheus), nil
+}
+
+func (s *prometheusClient) Update(o *v1.Prometheus) (*v1.Prometheus, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.Prometheus), nil
+}
+
+func (s *prometheusClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *prometheusClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *prometheusClient) List(opts metav1.ListOptions) (*v1a.PrometheusList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v1a.PrometheusList{}
+	list.APIVersion = v1a.PrometheusGroupVersionKind.GroupVersion().String()
+	list.Kind = v1a.PrometheusGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v1.Prometheus))
+	}
+	return list, nil
+}
+
+func (s *prometheusClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *prometheusClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *prometheusClient) Controller() v1a.PrometheusController {
+	return &prometheusController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *prometheusClient) AddHandler(ctx context.Context, name string, sync v1a.PrometheusHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *prometheusClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v1a.PrometheusHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *prometheusClient) AddLifecycle(ctx context.Context, name string, lifecycle v1a.PrometheusLifecycle) {
+	sync := newPrometheusLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *prometheusClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v1a.PrometheusLifecycle) {
+	sync := newPrometheusLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *prometheusClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v1a.PrometheusHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *prometheusClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v1a.PrometheusHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *prometheusClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v1a.PrometheusLifecycle) {
+	sync := newPrometheusLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *prometheusClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v1a.PrometheusLifecycle) {
+	sync := newPrometheusLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type prometheusController struct {
+	controller.GenericController
+}
+
+func (c *prometheusController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *prometheusController) Lister() v1a.PrometheusLister {
+	return &prometheusLister{
+		controller: c,
+	}
+}
+
+func (c *prometheusController) AddHandler(ctx context.Context, name string, handler v1a.PrometheusHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.Prometheus); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *prometheusController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v1a.PrometheusHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.Prometheus); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *prometheusController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v1a.PrometheusHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.Prometheus); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *prometheusController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v1a.PrometheusHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.Prometheus); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type prometheusLister struct {
+	controller *prometheusController
+}
+
+func (l *prometheusLister) List(namespace string, selector labels.Selector) (ret []*v1.Prometheus, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v1.Prometheus))
+	})
+	return
+}
+
+func (l *prometheusLister) Get(namespace, name string) (*v1.Prometheus, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v1a.PrometheusGroupVersionKind.Group,
+			Resource: "prometheus",
+		}, key)
+	}
+	return obj.(*v1.Prometheus), nil
+}
+
+type prometheusLifecycleAdapter struct {
+	lifecycle v1a.PrometheusLifecycle
+}
+
+func (w *prometheusLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *prometheusLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *prometheusLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v1.Prometheus))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *prometheusLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v1.Prometheus))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *prometheusLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v1.Prometheus))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newPrometheusLifecycleAdapter(name string, clusterScoped bool, client *prometheusClient, l v1a.PrometheusLifecycle) v1a.PrometheusHandlerFunc {
+	adapter := &prometheusLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v1.Prometheus) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+
+type alertmanagerClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *alertmanagerClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *alertmanagerClient) Create(o *v1.Alertmanager) (*v1.Alertmanager, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.Alertmanager), nil
+}
+
+func (s *alertmanagerClient) Get(name string, opts metav1.GetOptions) (*v1.Alertmanager, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.Alertmanager), nil
+}
+
+func (s *alertmanagerClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v1.Alertmanager, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.Alertmanager), nil
+}
+
+func (s *alertmanagerClient) Update(o *v1.Alertmanager) (*v1.Alertmanager, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.Alertmanager), nil
+}
+
+func (s *alertmanagerClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *alertmanagerClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *alertmanagerClient) List(opts metav1.ListOptions) (*v1a.AlertmanagerList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v1a.AlertmanagerList{}
+	list.APIVersion = v1a.AlertmanagerGroupVersionKind.GroupVersion().String()
+	list.Kind = v1a.AlertmanagerGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v1.Alertmanager))
+	}
+	return list, nil
+}
+
+func (s *alertmanagerClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *alertmanagerClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *alertmanagerClient) Controller() v1a.AlertmanagerController {
+	return &alertmanagerController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *alertmanagerClient) AddHandler(ctx context.Context, name string, sync v1a.AlertmanagerHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *alertmanagerClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v1a.AlertmanagerHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *alertmanagerClient) AddLifecycle(ctx context.Context, name string, lifecycle v1a.AlertmanagerLifecycle) {
+	sync := newAlertmanagerLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *alertmanagerClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v1a.AlertmanagerLifecycle) {
+	sync := newAlertmanagerLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *alertmanagerClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v1a.AlertmanagerHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *alertmanagerClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v1a.AlertmanagerHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *alertmanagerClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v1a.AlertmanagerLifecycle) {
+	sync := newAlertmanagerLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *alertmanagerClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v1a.AlertmanagerLifecycle) {
+	sync := newAlertmanagerLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type alertmanagerController struct {
+	controller.GenericController
+}
+
+func (c *alertmanagerController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *alertmanagerController) Lister() v1a.AlertmanagerLister {
+	return &alertmanagerLister{
+		controller: c,
+	}
+}
+
+func (c *alertmanagerController) AddHandler(ctx context.Context, name string, handler v1a.AlertmanagerHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.Alertmanager); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *alertmanagerController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v1a.AlertmanagerHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.Alertmanager); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *alertmanagerController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v1a.AlertmanagerHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.Alertmanager); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *alertmanagerController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v1a.AlertmanagerHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.Alertmanager); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type alertmanagerLister struct {
+	controller *alertmanagerController
+}
+
+func (l *alertmanagerLister) List(namespace string, selector labels.Selector) (ret []*v1.Alertmanager, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v1.Alertmanager))
+	})
+	return
+}
+
+func (l *alertmanagerLister) Get(namespace, name string) (*v1.Alertmanager, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v1a.AlertmanagerGroupVersionKind.Group,
+			Resource: "alertmanager",
+		}, key)
+	}
+	return obj.(*v1.Alertmanager), nil
+}
+
+type alertmanagerLifecycleAdapter struct {
+	lifecycle v1a.AlertmanagerLifecycle
+}
+
+func (w *alertmanagerLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *alertmanagerLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *alertmanagerLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v1.Alertmanager))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *alertmanagerLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v1.Alertmanager))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *alertmanagerLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v1.Alertmanager))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newAlertmanagerLifecycleAdapter(name string, clusterScoped bool, client *alertmanagerClient, l v1a.AlertmanagerLifecycle) v1a.AlertmanagerHandlerFunc {
+	adapter := &alertmanagerLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v1.Alertmanager) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+
+type prometheusRuleClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *prometheusRuleClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *prometheusRuleClient) Create(o *v1.PrometheusRule) (*v1.PrometheusRule, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.PrometheusRule), nil
+}
+
+func (s *prometheusRuleClient) Get(name string, opts metav1.GetOptions) (*v1.PrometheusRule, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.PrometheusRule), nil
+}
+
+func (s *prometheusRuleClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v1.PrometheusRule, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.PrometheusRule), nil
+}
+
+func (s *prometheusRuleClient) Update(o *v1.PrometheusRule) (*v1.PrometheusRule, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.PrometheusRule), nil
+}
+
+func (s *prometheusRuleClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *prometheusRuleClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *prometheusRuleClient) List(opts metav1.ListOptions) (*v1a.PrometheusRuleList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v1a.PrometheusRuleList{}
+	list.APIVersion = v1a.PrometheusRuleGroupVersionKind.GroupVersion().String()
+	list.Kind = v1a.PrometheusRuleGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v1.PrometheusRule))
+	}
+	return list, nil
+}
+
+func (s *prometheusRuleClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *prometheusRuleClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *prometheusRuleClient) Controller() v1a.PrometheusRuleController {
+	return &prometheusRuleController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *prometheusRuleClient) AddHandler(ctx context.Context, name string, sync v1a.PrometheusRuleHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *prometheusRuleClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v1a.PrometheusRuleHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *prometheusRuleClient) AddLifecycle(ctx context.Context, name string, lifecycle v1a.PrometheusRuleLifecycle) {
+	sync := newPrometheusRuleLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *prometheusRuleClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v1a.PrometheusRuleLifecycle) {
+	sync := newPrometheusRuleLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *prometheusRuleClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v1a.PrometheusRuleHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *prometheusRuleClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v1a.PrometheusRuleHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *prometheusRuleClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v1a.PrometheusRuleLifecycle) {
+	sync := newPrometheusRuleLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *prometheusRuleClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v1a.PrometheusRuleLifecycle) {
+	sync := newPrometheusRuleLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type prometheusRuleController struct {
+	controller.GenericController
+}
+
+func (c *prometheusRuleController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *prometheusRuleController) Lister() v1a.PrometheusRuleLister {
+	return &prometheusRuleLister{
+		controller: c,
+	}
+}
+
+func (c *prometheusRuleController) AddHandler(ctx context.Context, name string, handler v1a.PrometheusRuleHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.PrometheusRule); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *prometheusRuleController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v1a.PrometheusRuleHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.PrometheusRule); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *prometheusRuleController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v1a.PrometheusRuleHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.PrometheusRule); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *prometheusRuleController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v1a.PrometheusRuleHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.PrometheusRule); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type prometheusRuleLister struct {
+	controller *prometheusRuleController
+}
+
+func (l *prometheusRuleLister) List(namespace string, selector labels.Selector) (ret []*v1.PrometheusRule, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v1.PrometheusRule))
+	})
+	return
+}
+
+func (l *prometheusRuleLister) Get(namespace, name string) (*v1.PrometheusRule, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v1a.PrometheusRuleGroupVersionKind.Group,
+			Resource: "prometheusRule",
+		}, key)
+	}
+	return obj.(*v1.PrometheusRule), nil
+}
+
+type prometheusRuleLifecycleAdapter struct {
+	lifecycle v1a.PrometheusRuleLifecycle
+}
+
+func (w *prometheusRuleLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *prometheusRuleLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *prometheusRuleLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v1.PrometheusRule))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *prometheusRuleLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v1.PrometheusRule))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *prometheusRuleLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v1.PrometheusRule))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newPrometheusRuleLifecycleAdapter(name string, clusterScoped bool, client *prometheusRuleClient, l v1a.PrometheusRuleLifecycle) v1a.PrometheusRuleHandlerFunc {
+	adapter := &prometheusRuleLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v1.PrometheusRule) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+
+type serviceMonitorClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *serviceMonitorClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *serviceMonitorClient) Create(o *v1.ServiceMonitor) (*v1.ServiceMonitor, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.ServiceMonitor), nil
+}
+
+func (s *serviceMonitorClient) Get(name string, opts metav1.GetOptions) (*v1.ServiceMonitor, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.ServiceMonitor), nil
+}
+
+func (s *serviceMonitorClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v1.ServiceMonitor, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.ServiceMonitor), nil
+}
+
+func (s *serviceMonitorClient) Update(o *v1.ServiceMonitor) (*v1.ServiceMonitor, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.ServiceMonitor), nil
+}
+
+func (s *serviceMonitorClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *serviceMonitorClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *serviceMonitorClient) List(opts metav1.ListOptions) (*v1a.ServiceMonitorList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v1a.ServiceMonitorList{}
+	list.APIVersion = v1a.ServiceMonitorGroupVersionKind.GroupVersion().String()
+	list.Kind = v1a.ServiceMonitorGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v1.ServiceMonitor))
+	}
+	return list, nil
+}
+
+func (s *serviceMonitorClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *serviceMonitorClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *serviceMonitorClient) Controller() v1a.ServiceMonitorController {
+	return &serviceMonitorController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *serviceMonitorClient) AddHandler(ctx context.Context, name string, sync v1a.ServiceMonitorHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *serviceMonitorClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v1a.ServiceMonitorHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *serviceMonitorClient) AddLifecycle(ctx context.Context, name string, lifecycle v1a.ServiceMonitorLifecycle) {
+	sync := newServiceMonitorLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *serviceMonitorClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v1a.ServiceMonitorLifecycle) {
+	sync := newServiceMonitorLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *serviceMonitorClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v1a.ServiceMonitorHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *serviceMonitorClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v1a.ServiceMonitorHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *serviceMonitorClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v1a.ServiceMonitorLifecycle) {
+	sync := newServiceMonitorLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *serviceMonitorClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v1a.ServiceMonitorLifecycle) {
+	sync := newServiceMonitorLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type serviceMonitorController struct {
+	controller.GenericController
+}
+
+func (c *serviceMonitorController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *serviceMonitorController) Lister() v1a.ServiceMonitorLister {
+	return &serviceMonitorLister{
+		controller: c,
+	}
+}
+
+func (c *serviceMonitorController) AddHandler(ctx context.Context, name string, handler v1a.ServiceMonitorHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.ServiceMonitor); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *serviceMonitorController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v1a.ServiceMonitorHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.ServiceMonitor); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *serviceMonitorController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v1a.ServiceMonitorHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.ServiceMonitor); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *serviceMonitorController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v1a.ServiceMonitorHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.ServiceMonitor); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type serviceMonitorLister struct {
+	controller *serviceMonitorController
+}
+
+func (l *serviceMonitorLister) List(namespace string, selector labels.Selector) (ret []*v1.ServiceMonitor, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v1.ServiceMonitor))
+	})
+	return
+}
+
+func (l *serviceMonitorLister) Get(namespace, name string) (*v1.ServiceMonitor, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v1a.ServiceMonitorGroupVersionKind.Group,
+			Resource: "serviceMonitor",
+		}, key)
+	}
+	return obj.(*v1.ServiceMonitor), nil
+}
+
+type serviceMonitorLifecycleAdapter struct {
+	lifecycle v1a.ServiceMonitorLifecycle
+}
+
+func (w *serviceMonitorLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *serviceMonitorLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *serviceMonitorLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v1.ServiceMonitor))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *serviceMonitorLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v1.ServiceMonitor))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *serviceMonitorLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v1.ServiceMonitor))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newServiceMonitorLifecycleAdapter(name string, clusterScoped bool, client *serviceMonitorClient, l v1a.ServiceMonitorLifecycle) v1a.ServiceMonitorHandlerFunc {
+	adapter := &serviceMonitorLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v1.ServiceMonitor) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
@@ -0,0 +1,608 @@
+package fakes
+
+import (
+	"context"
+	"fmt"
+
+	v1alpha3 "github.com/knative/pkg/apis/istio/v1alpha3"
+	"github.com/rancher/norman/controller"
+	"github.com/rancher/norman/lifecycle"
+	"github.com/rancher/norman/objectclient"
+	v1alpha3a "github.com/rancher/types/apis/networking.istio.io/v1alpha3"
+	"github.com/rancher/types/fakeclient"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
+)
+
+// Clientset is an in-memory v1alpha3a.Interface. Its objects are kept in a
+// fakeclient.Tracker, which can be shared with the clientsets of other groups
+// to run their controllers together.
+type Clientset struct {
+	*fakeclient.Tracker
+}
+
+var _ v1alpha3a.Interface = &Clientset{}
+
+// NewClientset returns a Clientset with a tracker of its own, holding
+// objects. It panics if an object is not a kind of the group.
+func NewClientset(objects ...runtime.Object) *Clientset {
+	return NewClientsetForTracker(fakeclient.NewTracker(), objects...)
+}
+
+// NewClientsetForTracker returns a Clientset storing its objects in tracker.
+// It panics if an object is not a kind of the group.
+func NewClientsetForTracker(tracker *fakeclient.Tracker, objects ...runtime.Object) *Clientset {
+	c := &Clientset{
+		Tracker: tracker,
+	}
+	if err := c.Add(objects...); err != nil {
+		panic(err)
+	}
+	return c
+}
+
+// RESTClient returns nil, the objects of a Clientset are not served.
+func (c *Clientset) RESTClient() rest.Interface {
+	return nil
+}
+
+// Add stores objects as they are, without the checks of Create.
+func (c *Clientset) Add(objects ...runtime.Object) error {
+	for _, obj := range objects {
+		var resource *fakeclient.Resource
+		switch obj.(type) {
+		case *v1alpha3.VirtualService:
+			resource = c.Resource(v1alpha3a.VirtualServiceGroupVersionKind, v1alpha3a.VirtualServiceResource)
+		case *v1alpha3.DestinationRule:
+			resource = c.Resource(v1alpha3a.DestinationRuleGroupVersionKind, v1alpha3a.DestinationRuleResource)
+		default:
+			return fmt.Errorf("%T is not a kind of %s", obj, v1alpha3a.GroupName)
+		}
+		if err := resource.Add(obj); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *Clientset) VirtualServices(namespace string) v1alpha3a.VirtualServiceInterface {
+	resource := c.Resource(v1alpha3a.VirtualServiceGroupVersionKind, v1alpha3a.VirtualServiceResource)
+	return &virtualServiceClient{
+		client: resource.Client(namespace),
+	}
+}
+
+func (c *Clientset) DestinationRules(namespace string) v1alpha3a.DestinationRuleInterface {
+	resource := c.Resource(v1alpha3a.DestinationRuleGroupVersionKind, v1alpha3a.DestinationRuleResource)
+	return &destinationRuleClient{
+		client: resource.Client(namespace),
+	}
+}
+
+type virtualServiceClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *virtualServiceClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *virtualServiceClient) Create(o *v1alpha3.VirtualService) (*v1alpha3.VirtualService, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1alpha3.VirtualService), nil
+}
+
+func (s *virtualServiceClient) Get(name string, opts metav1.GetOptions) (*v1alpha3.VirtualService, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1alpha3.VirtualService), nil
+}
+
+func (s *virtualServiceClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v1alpha3.VirtualService, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1alpha3.VirtualService), nil
+}
+
+func (s *virtualServiceClient) Update(o *v1alpha3.VirtualService) (*v1alpha3.VirtualService, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1alpha3.VirtualService), nil
+}
+
+func (s *virtualServiceClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *virtualServiceClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *virtualServiceClient) List(opts metav1.ListOptions) (*v1alpha3a.VirtualServiceList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v1alpha3a.VirtualServiceList{}
+	list.APIVersion = v1alpha3a.VirtualServiceGroupVersionKind.GroupVersion().String()
+	list.Kind = v1alpha3a.VirtualServiceGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v1alpha3.VirtualService))
+	}
+	return list, nil
+}
+
+func (s *virtualServiceClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *virtualServiceClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *virtualServiceClient) Controller() v1alpha3a.VirtualServiceController {
+	return &virtualServiceController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *virtualServiceClient) AddHandler(ctx context.Context, name string, sync v1alpha3a.VirtualServiceHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *virtualServiceClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v1alpha3a.VirtualServiceHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *virtualServiceClient) AddLifecycle(ctx context.Context, name string, lifecycle v1alpha3a.VirtualServiceLifecycle) {
+	sync := newVirtualServiceLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *virtualServiceClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v1alpha3a.VirtualServiceLifecycle) {
+	sync := newVirtualServiceLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *virtualServiceClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v1alpha3a.VirtualServiceHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *virtualServiceClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v1alpha3a.VirtualServiceHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *virtualServiceClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v1alpha3a.VirtualServiceLifecycle) {
+	sync := newVirtualServiceLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *virtualServiceClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v1alpha3a.VirtualServiceLifecycle) {
+	sync := newVirtualServiceLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type virtualServiceController struct {
+	controller.GenericController
+}
+
+func (c *virtualServiceController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *virtualServiceController) Lister() v1alpha3a.VirtualServiceLister {
+	return &virtualServiceLister{
+		controller: c,
+	}
+}
+
+func (c *virtualServiceController) AddHandler(ctx context.Context, name string, handler v1alpha3a.VirtualServiceHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1alpha3.VirtualService); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *virtualServiceController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v1alpha3a.VirtualServiceHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1alpha3.VirtualService); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *virtualServiceController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v1alpha3a.VirtualServiceHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1alpha3.VirtualService); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *virtualServiceController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v1alpha3a.VirtualServiceHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1alpha3.VirtualService); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type virtualServiceLister struct {
+	controller *virtualServiceController
+}
+
+func (l *virtualServiceLister) List(namespace string, selector labels.Selector) (ret []*v1alpha3.VirtualService, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v1alpha3.VirtualService))
+	})
+	return
+}
+
+func (l *virtualServiceLister) Get(namespace, name string) (*v1alpha3.VirtualService, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v1alpha3a.VirtualServiceGroupVersionKind.Group,
+			Resource: "virtualService",
+		}, key)
+	}
+	return obj.(*v1alpha3.VirtualService), nil
+}
+
+type virtualServiceLifecycleAdapter struct {
+	lifecycle v1alpha3a.VirtualServiceLifecycle
+}
+
+func (w *virtualServiceLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *virtualServiceLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *virtualServiceLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v1alpha3.VirtualService))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *virtualServiceLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v1alpha3.VirtualService))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *virtualServiceLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v1alpha3.VirtualService))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newVirtualServiceLifecycleAdapter(name string, clusterScoped bool, client *virtualServiceClient, l v1alpha3a.VirtualServiceLifecycle) v1alpha3a.VirtualServiceHandlerFunc {
+	adapter := &virtualServiceLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v1alpha3.VirtualService) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+
+type destinationRuleClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *destinationRuleClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *destinationRuleClient) Create(o *v1alpha3.DestinationRule) (*v1alpha3.DestinationRule, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1alpha3.DestinationRule), nil
+}
+
+func (s *destinationRuleClient) Get(name string, opts metav1.GetOptions) (*v1alpha3.DestinationRule, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1alpha3.DestinationRule), nil
+}
+
+func (s *destinationRuleClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v1alpha3.DestinationRule, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1alpha3.DestinationRule), nil
+}
+
+func (s *destinationRuleClient) Update(o *v1alpha3.DestinationRule) (*v1alpha3.DestinationRule, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1alpha3.DestinationRule), nil
+}
+
+func (s *destinationRuleClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *destinationRuleClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *destinationRuleClient) List(opts metav1.ListOptions) (*v1alpha3a.DestinationRuleList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v1alpha3a.DestinationRuleList{}
+	list.APIVersion = v1alpha3a.DestinationRuleGroupVersionKind.GroupVersion().String()
+	list.Kind = v1alpha3a.DestinationRuleGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v1alpha3.DestinationRule))
+	}
+	return list, nil
+}
+
+func (s *destinationRuleClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *destinationRuleClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *destinationRuleClient) Controller() v1alpha3a.DestinationRuleController {
+	return &destinationRuleController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *destinationRuleClient) AddHandler(ctx context.Context, name string, sync v1alpha3a.DestinationRuleHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *destinationRuleClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v1alpha3a.DestinationRuleHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *destinationRuleClient) AddLifecycle(ctx context.Context, name string, lifecycle v1alpha3a.DestinationRuleLifecycle) {
+	sync := newDestinationRuleLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *destinationRuleClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v1alpha3a.DestinationRuleLifecycle) {
+	sync := newDestinationRuleLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *destinationRuleClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v1alpha3a.DestinationRuleHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *destinationRuleClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v1alpha3a.DestinationRuleHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *destinationRuleClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v1alpha3a.DestinationRuleLifecycle) {
+	sync := newDestinationRuleLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *destinationRuleClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v1alpha3a.DestinationRuleLifecycle) {
+	sync := newDestinationRuleLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type destinationRuleController struct {
+	controller.GenericController
+}
+
+func (c *destinationRuleController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *destinationRuleController) Lister() v1alpha3a.DestinationRuleLister {
+	return &destinationRuleLister{
+		controller: c,
+	}
+}
+
+func (c *destinationRuleController) AddHandler(ctx context.Context, name string, handler v1alpha3a.DestinationRuleHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1alpha3.DestinationRule); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *destinationRuleController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v1alpha3a.DestinationRuleHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1alpha3.DestinationRule); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *destinationRuleController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v1alpha3a.DestinationRuleHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1alpha3.DestinationRule); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *destinationRuleController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v1alpha3a.DestinationRuleHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1alpha3.DestinationRule); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type destinationRuleLister struct {
+	controller *destinationRuleController
+}
+
+func (l *destinationRuleLister) List(namespace string, selector labels.Selector) (ret []*v1alpha3.DestinationRule, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v1alpha3.DestinationRule))
+	})
+	return
+}
+
+func (l *destinationRuleLister) Get(namespace, name string) (*v1alpha3.DestinationRule, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v1alpha3a.DestinationRuleGroupVersionKind.Group,
+			Resource: "destinationRule",
+		}, key)
+	}
+	return obj.(*v1alpha3.DestinationRule), nil
+}
+
+type destinationRuleLifecycleAdapter struct {
+	lifecycle v1alpha3a.DestinationRuleLifecycle
+}
+
+func (w *destinationRuleLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *destinationRuleLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *destinationRuleLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v1alpha3.DestinationRule))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *destinationRuleLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v1alpha3.DestinationRule))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *destinationRuleLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v1alpha3.DestinationRule))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newDestinationRuleLifecycleAdapter(name string, clusterScoped bool, client *destinationRuleClient, l v1alpha3a.DestinationRuleLifecycle) v1alpha3a.DestinationRuleHandlerFunc {
+	adapter := &destinationRuleLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v1alpha3.DestinationRule) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
@@ -0,0 +1,338 @@
+package fakes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rancher/norman/controller"
+	"github.com/rancher/norman/lifecycle"
+	"github.com/rancher/norman/objectclient"
+	v1beta1a "github.com/rancher/types/apis/policy/v1beta1"
+	"github.com/rancher/types/fakeclient"
+	v1beta1 "k8s.io/api/policy/v1beta1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
+)
+
+// Clientset is an in-memory v1beta1a.Interface. Its objects are kept in a
+// fakeclient.Tracker, which can be shared with the clientsets of other groups
+// to run their controllers together.
+type Clientset struct {
+	*fakeclient.Tracker
+}
+
+var _ v1beta1a.Interface = &Clientset{}
+
+// NewClientset returns a Clientset with a tracker of its own, holding
+// objects. It panics if an object is not a kind of the group.
+func NewClientset(objects ...runtime.Object) *Clientset {
+	return NewClientsetForTracker(fakeclient.NewTracker(), objects...)
+}
+
+// NewClientsetForTracker returns a Clientset storing its objects in tracker.
+// It panics if an object is not a kind of the group.
+func NewClientsetForTracker(tracker *fakeclient.Tracker, objects ...runtime.Object) *Clientset {
+	c := &Clientset{
+		Tracker: tracker,
+	}
+	if err := c.Add(objects...); err != nil {
+		panic(err)
+	}
+	return c
+}
+
+// RESTClient returns nil, the objects of a Clientset are not served.
+func (c *Clientset) RESTClient() rest.Interface {
+	return nil
+}
+
+// Add stores objects as they are, without the checks of Create.
+func (c *Clientset) Add(objects ...runtime.Object) error {
+	for _, obj := range objects {
+		var resource *fakeclient.Resource
+		switch obj.(type) {
+		case *v1beta1.PodSecurityPolicy:
+			resource = c.Resource(v1beta1a.PodSecurityPolicyGroupVersionKind, v1beta1a.PodSecurityPolicyResource)
+		default:
+			return fmt.Errorf("%T is not a kind of %s", obj, v1beta1a.GroupName)
+		}
+		if err := resource.Add(obj); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *Clientset) PodSecurityPolicies(namespace string) v1beta1a.PodSecurityPolicyInterface {
+	resource := c.Resource(v1beta1a.PodSecurityPolicyGroupVersionKind, v1beta1a.PodSecurityPolicyResource)
+	return &podSecurityPolicyClient{
+		client: resource.Client(namespace),
+	}
+}
+
+type podSecurityPolicyClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *podSecurityPolicyClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *podSecurityPolicyClient) Create(o *v1beta1.PodSecurityPolicy) (*v1beta1.PodSecurityPolicy, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.PodSecurityPolicy), nil
+}
+
+func (s *podSecurityPolicyClient) Get(name string, opts metav1.GetOptions) (*v1beta1.PodSecurityPolicy, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.PodSecurityPolicy), nil
+}
+
+func (s *podSecurityPolicyClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v1beta1.PodSecurityPolicy, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.PodSecurityPolicy), nil
+}
+
+func (s *podSecurityPolicyClient) Update(o *v1beta1.PodSecurityPolicy) (*v1beta1.PodSecurityPolicy, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.PodSecurityPolicy), nil
+}
+
+func (s *podSecurityPolicyClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *podSecurityPolicyClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *podSecurityPolicyClient) List(opts metav1.ListOptions) (*v1beta1a.PodSecurityPolicyList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v1beta1a.PodSecurityPolicyList{}
+	list.APIVersion = v1beta1a.PodSecurityPolicyGroupVersionKind.GroupVersion().String()
+	list.Kind = v1beta1a.PodSecurityPolicyGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v1beta1.PodSecurityPolicy))
+	}
+	return list, nil
+}
+
+func (s *podSecurityPolicyClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *podSecurityPolicyClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *podSecurityPolicyClient) Controller() v1beta1a.PodSecurityPolicyController {
+	return &podSecurityPolicyController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *podSecurityPolicyClient) AddHandler(ctx context.Context, name string, sync v1beta1a.PodSecurityPolicyHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *podSecurityPolicyClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v1beta1a.PodSecurityPolicyHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *podSecurityPolicyClient) AddLifecycle(ctx context.Context, name string, lifecycle v1beta1a.PodSecurityPolicyLifecycle) {
+	sync := newPodSecurityPolicyLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *podSecurityPolicyClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v1beta1a.PodSecurityPolicyLifecycle) {
+	sync := newPodSecurityPolicyLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *podSecurityPolicyClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v1beta1a.PodSecurityPolicyHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *podSecurityPolicyClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v1beta1a.PodSecurityPolicyHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *podSecurityPolicyClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v1beta1a.PodSecurityPolicyLifecycle) {
+	sync := newPodSecurityPolicyLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *podSecurityPolicyClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v1beta1a.PodSecurityPolicyLifecycle) {
+	sync := newPodSecurityPolicyLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type podSecurityPolicyController struct {
+	controller.GenericController
+}
+
+func (c *podSecurityPolicyController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *podSecurityPolicyController) Lister() v1beta1a.PodSecurityPolicyLister {
+	return &podSecurityPolicyLister{
+		controller: c,
+	}
+}
+
+func (c *podSecurityPolicyController) AddHandler(ctx context.Context, name string, handler v1beta1a.PodSecurityPolicyHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1beta1.PodSecurityPolicy); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *podSecurityPolicyController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v1beta1a.PodSecurityPolicyHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1beta1.PodSecurityPolicy); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *podSecurityPolicyController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v1beta1a.PodSecurityPolicyHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1beta1.PodSecurityPolicy); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *podSecurityPolicyController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v1beta1a.PodSecurityPolicyHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1beta1.PodSecurityPolicy); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type podSecurityPolicyLister struct {
+	controller *podSecurityPolicyController
+}
+
+func (l *podSecurityPolicyLister) List(namespace string, selector labels.Selector) (ret []*v1beta1.PodSecurityPolicy, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v1beta1.PodSecurityPolicy))
+	})
+	return
+}
+
+func (l *podSecurityPolicyLister) Get(namespace, name string) (*v1beta1.PodSecurityPolicy, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v1beta1a.PodSecurityPolicyGroupVersionKind.Group,
+			Resource: "podSecurityPolicy",
+		}, key)
+	}
+	return obj.(*v1beta1.PodSecurityPolicy), nil
+}
+
+type podSecurityPolicyLifecycleAdapter struct {
+	lifecycle v1beta1a.PodSecurityPolicyLifecycle
+}
+
+func (w *podSecurityPolicyLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *podSecurityPolicyLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *podSecurityPolicyLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v1beta1.PodSecurityPolicy))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *podSecurityPolicyLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v1beta1.PodSecurityPolicy))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *podSecurityPolicyLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v1beta1.PodSecurityPolicy))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newPodSecurityPolicyLifecycleAdapter(name string, clusterScoped bool, client *podSecurityPolicyClient, l v1beta1a.PodSecurityPolicyLifecycle) v1beta1a.PodSecurityPolicyHandlerFunc {
+	adapter := &podSecurityPolicyLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v1beta1.PodSecurityPolicy) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
@@ -0,0 +1,117 @@
+package fakes
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"testing"
+
+	v3 "github.com/rancher/types/apis/project.cattle.io/v3"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+type appLifecycle struct {
+	created, removed []string
+}
+
+func (l *appLifecycle) Create(obj *v3.App) (runtime.Object, error) {
+	l.created = append(l.created, obj.Name)
+	obj.Spec.Description = "created"
+	return obj, nil
+}
+
+func (l *appLifecycle) Remove(obj *v3.App) (runtime.Object, error) {
+	l.removed = append(l.removed, obj.Name)
+	return obj, nil
+}
+
+func (l *appLifecycle) Updated(obj *v3.App) (runtime.Object, error) {
+	return obj, nil
+}
+
+func TestClientset(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	cs := NewClientset(&v3.App{
+		ObjectMeta: metav1.ObjectMeta{Name: "existing", Namespace: "p-1", Labels: map[string]string{"tier": "db"}},
+	})
+	apps := cs.Apps("p-1")
+
+	w, err := apps.Watch(metav1.ListOptions{LabelSelector: "tier=web"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer w.Stop()
+
+	lifecycle := &appLifecycle{}
+	apps.AddLifecycle(ctx, "app-controller", lifecycle)
+
+	created, err := apps.Create(&v3.App{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Labels: map[string]string{"tier": "web"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if created.Namespace != "p-1" || created.UID == "" {
+		t.Errorf("expected the namespace and uid to be set, got %v", created.ObjectMeta)
+	}
+	if _, err := apps.Create(created); !errors.IsAlreadyExists(err) {
+		t.Errorf("expected already exists, got %v", err)
+	}
+	if _, err := cs.Apps("p-2").Get("web", metav1.GetOptions{}); !errors.IsNotFound(err) {
+		t.Errorf("expected not found in another namespace, got %v", err)
+	}
+	if event := <-w.ResultChan(); event.Type != watch.Added || event.Object.(*v3.App).Name != "web" {
+		t.Errorf("expected web to be added, got %v", event)
+	}
+
+	if err := cs.Drain(); err != nil {
+		t.Fatal(err)
+	}
+	sort.Strings(lifecycle.created)
+	if !reflect.DeepEqual(lifecycle.created, []string{"existing", "web"}) {
+		t.Errorf("expected both apps to be created, got %v", lifecycle.created)
+	}
+	web, err := apps.Get("web", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if web.Spec.Description != "created" ||
+		web.Annotations["lifecycle.cattle.io/create.app-controller"] != "true" ||
+		!reflect.DeepEqual(web.Finalizers, []string{"controller.cattle.io/app-controller"}) {
+		t.Errorf("expected the lifecycle to be recorded, got %v", web)
+	}
+
+	listed, err := apps.Controller().Lister().List("p-1", labels.SelectorFromSet(labels.Set{"tier": "db"}))
+	if err != nil || len(listed) != 1 || listed[0].Name != "existing" {
+		t.Errorf("expected the lister to select existing, got %v %v", listed, err)
+	}
+	list, err := apps.List(metav1.ListOptions{LabelSelector: "tier in (db,web)"})
+	if err != nil || len(list.Items) != 2 {
+		t.Errorf("expected to list both apps, got %v %v", list, err)
+	}
+	if _, err := apps.Update(created); !errors.IsConflict(err) {
+		t.Errorf("expected a conflict updating a stale app, got %v", err)
+	}
+
+	if err := apps.Delete("web", nil); err != nil {
+		t.Fatal(err)
+	}
+	if web, err := apps.Get("web", metav1.GetOptions{}); err != nil || web.DeletionTimestamp == nil {
+		t.Errorf("expected web to wait for its finalizer, got %v %v", web, err)
+	}
+	if err := cs.Drain(); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(lifecycle.removed, []string{"web"}) {
+		t.Errorf("expected web to be removed, got %v", lifecycle.removed)
+	}
+	if _, err := apps.Controller().Lister().Get("p-1", "web"); !errors.IsNotFound(err) {
+		t.Errorf("expected web to be gone, got %v", err)
+	}
+}
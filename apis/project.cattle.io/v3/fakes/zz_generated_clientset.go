@@ -0,0 +1,5467 @@
+package fakes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rancher/norman/controller"
+	"github.com/rancher/norman/lifecycle"
+	"github.com/rancher/norman/objectclient"
+	v3 "github.com/rancher/types/apis/project.cattle.io/v3"
+	"github.com/rancher/types/fakeclient"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
+)
+
+// Clientset is an in-memory v3.Interface. Its objects are kept in a
+// fakeclient.Tracker, which can be shared with the clientsets of other groups
+// to run their controllers together.
+type Clientset struct {
+	*fakeclient.Tracker
+}
+
+var _ v3.Interface = &Clientset{}
+
+// NewClientset returns a Clientset with a tracker of its own, holding
+// objects. It panics if an object is not a kind of the group.
+func NewClientset(objects ...runtime.Object) *Clientset {
+	return NewClientsetForTracker(fakeclient.NewTracker(), objects...)
+}
+
+// NewClientsetForTracker returns a Clientset storing its objects in tracker.
+// It panics if an object is not a kind of the group.
+func NewClientsetForTracker(tracker *fakeclient.Tracker, objects ...runtime.Object) *Clientset {
+	c := &Clientset{
+		Tracker: tracker,
+	}
+	if err := c.Add(objects...); err != nil {
+		panic(err)
+	}
+	return c
+}
+
+// RESTClient returns nil, the objects of a Clientset are not served.
+func (c *Clientset) RESTClient() rest.Interface {
+	return nil
+}
+
+// Add stores objects as they are, without the checks of Create.
+func (c *Clientset) Add(objects ...runtime.Object) error {
+	for _, obj := range objects {
+		var resource *fakeclient.Resource
+		switch obj.(type) {
+		case *v3.ServiceAccountToken:
+			resource = c.Resource(v3.ServiceAccountTokenGroupVersionKind, v3.ServiceAccountTokenResource)
+		case *v3.DockerCredential:
+			resource = c.Resource(v3.DockerCredentialGroupVersionKind, v3.DockerCredentialResource)
+		case *v3.Certificate:
+			resource = c.Resource(v3.CertificateGroupVersionKind, v3.CertificateResource)
+		case *v3.BasicAuth:
+			resource = c.Resource(v3.BasicAuthGroupVersionKind, v3.BasicAuthResource)
+		case *v3.SSHAuth:
+			resource = c.Resource(v3.SSHAuthGroupVersionKind, v3.SSHAuthResource)
+		case *v3.NamespacedServiceAccountToken:
+			resource = c.Resource(v3.NamespacedServiceAccountTokenGroupVersionKind, v3.NamespacedServiceAccountTokenResource)
+		case *v3.NamespacedDockerCredential:
+			resource = c.Resource(v3.NamespacedDockerCredentialGroupVersionKind, v3.NamespacedDockerCredentialResource)
+		case *v3.NamespacedCertificate:
+			resource = c.Resource(v3.NamespacedCertificateGroupVersionKind, v3.NamespacedCertificateResource)
+		case *v3.NamespacedBasicAuth:
+			resource = c.Resource(v3.NamespacedBasicAuthGroupVersionKind, v3.NamespacedBasicAuthResource)
+		case *v3.NamespacedSSHAuth:
+			resource = c.Resource(v3.NamespacedSSHAuthGroupVersionKind, v3.NamespacedSSHAuthResource)
+		case *v3.Workload:
+			resource = c.Resource(v3.WorkloadGroupVersionKind, v3.WorkloadResource)
+		case *v3.App:
+			resource = c.Resource(v3.AppGroupVersionKind, v3.AppResource)
+		case *v3.AppRevision:
+			resource = c.Resource(v3.AppRevisionGroupVersionKind, v3.AppRevisionResource)
+		case *v3.SourceCodeProvider:
+			resource = c.Resource(v3.SourceCodeProviderGroupVersionKind, v3.SourceCodeProviderResource)
+		case *v3.SourceCodeProviderConfig:
+			resource = c.Resource(v3.SourceCodeProviderConfigGroupVersionKind, v3.SourceCodeProviderConfigResource)
+		case *v3.SourceCodeCredential:
+			resource = c.Resource(v3.SourceCodeCredentialGroupVersionKind, v3.SourceCodeCredentialResource)
+		case *v3.Pipeline:
+			resource = c.Resource(v3.PipelineGroupVersionKind, v3.PipelineResource)
+		case *v3.PipelineExecution:
+			resource = c.Resource(v3.PipelineExecutionGroupVersionKind, v3.PipelineExecutionResource)
+		case *v3.PipelineSetting:
+			resource = c.Resource(v3.PipelineSettingGroupVersionKind, v3.PipelineSettingResource)
+		case *v3.SourceCodeRepository:
+			resource = c.Resource(v3.SourceCodeRepositoryGroupVersionKind, v3.SourceCodeRepositoryResource)
+		default:
+			return fmt.Errorf("%T is not a kind of %s", obj, v3.GroupName)
+		}
+		if err := resource.Add(obj); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *Clientset) ServiceAccountTokens(namespace string) v3.ServiceAccountTokenInterface {
+	resource := c.Resource(v3.ServiceAccountTokenGroupVersionKind, v3.ServiceAccountTokenResource)
+	return &serviceAccountTokenClient{
+		client: resource.Client(namespace),
+	}
+}
+
+func (c *Clientset) DockerCredentials(namespace string) v3.DockerCredentialInterface {
+	resource := c.Resource(v3.DockerCredentialGroupVersionKind, v3.DockerCredentialResource)
+	return &dockerCredentialClient{
+		client: resource.Client(namespace),
+	}
+}
+
+func (c *Clientset) Certificates(namespace string) v3.CertificateInterface {
+	resource := c.Resource(v3.CertificateGroupVersionKind, v3.CertificateResource)
+	return &certificateClient{
+		client: resource.Client(namespace),
+	}
+}
+
+func (c *Clientset) BasicAuths(namespace string) v3.BasicAuthInterface {
+	resource := c.Resource(v3.BasicAuthGroupVersionKind, v3.BasicAuthResource)
+	return &basicAuthClient{
+		client: resource.Client(namespace),
+	}
+}
+
+func (c *Clientset) SSHAuths(namespace string) v3.SSHAuthInterface {
+	resource := c.Resource(v3.SSHAuthGroupVersionKind, v3.SSHAuthResource)
+	return &sshAuthClient{
+		client: resource.Client(namespace),
+	}
+}
+
+func (c *Clientset) NamespacedServiceAccountTokens(namespace string) v3.NamespacedServiceAccountTokenInterface {
+	resource := c.Resource(v3.NamespacedServiceAccountTokenGroupVersionKind, v3.NamespacedServiceAccountTokenResource)
+	return &namespacedServiceAccountTokenClient{
+		client: resource.Client(namespace),
+	}
+}
+
+func (c *Clientset) NamespacedDockerCredentials(namespace string) v3.NamespacedDockerCredentialInterface {
+	resource := c.Resource(v3.NamespacedDockerCredentialGroupVersionKind, v3.NamespacedDockerCredentialResource)
+	return &namespacedDockerCredentialClient{
+		client: resource.Client(namespace),
+	}
+}
+
+func (c *Clientset) NamespacedCertificates(namespace string) v3.NamespacedCertificateInterface {
+	resource := c.Resource(v3.NamespacedCertificateGroupVersionKind, v3.NamespacedCertificateResource)
+	return &namespacedCertificateClient{
+		client: resource.Client(namespace),
+	}
+}
+
+func (c *Clientset) NamespacedBasicAuths(namespace string) v3.NamespacedBasicAuthInterface {
+	resource := c.Resource(v3.NamespacedBasicAuthGroupVersionKind, v3.NamespacedBasicAuthResource)
+	return &namespacedBasicAuthClient{
+		client: resource.Client(namespace),
+	}
+}
+
+func (c *Clientset) NamespacedSSHAuths(namespace string) v3.NamespacedSSHAuthInterface {
+	resource := c.Resource(v3.NamespacedSSHAuthGroupVersionKind, v3.NamespacedSSHAuthResource)
+	return &namespacedSshAuthClient{
+		client: resource.Client(namespace),
+	}
+}
+
+func (c *Clientset) Workloads(namespace string) v3.WorkloadInterface {
+	resource := c.Resource(v3.WorkloadGroupVersionKind, v3.WorkloadResource)
+	return &workloadClient{
+		client: resource.Client(namespace),
+	}
+}
+
+func (c *Clientset) Apps(namespace string) v3.AppInterface {
+	resource := c.Resource(v3.AppGroupVersionKind, v3.AppResource)
+	return &appClient{
+		client: resource.Client(namespace),
+	}
+}
+
+func (c *Clientset) AppRevisions(namespace string) v3.AppRevisionInterface {
+	resource := c.Resource(v3.AppRevisionGroupVersionKind, v3.AppRevisionResource)
+	return &appRevisionClient{
+		client: resource.Client(namespace),
+	}
+}
+
+func (c *Clientset) SourceCodeProviders(namespace string) v3.SourceCodeProviderInterface {
+	resource := c.Resource(v3.SourceCodeProviderGroupVersionKind, v3.SourceCodeProviderResource)
+	return &sourceCodeProviderClient{
+		client: resource.Client(namespace),
+	}
+}
+
+func (c *Clientset) SourceCodeProviderConfigs(namespace string) v3.SourceCodeProviderConfigInterface {
+	resource := c.Resource(v3.SourceCodeProviderConfigGroupVersionKind, v3.SourceCodeProviderConfigResource)
+	return &sourceCodeProviderConfigClient{
+		client: resource.Client(namespace),
+	}
+}
+
+func (c *Clientset) SourceCodeCredentials(namespace string) v3.SourceCodeCredentialInterface {
+	resource := c.Resource(v3.SourceCodeCredentialGroupVersionKind, v3.SourceCodeCredentialResource)
+	return &sourceCodeCredentialClient{
+		client: resource.Client(namespace),
+	}
+}
+
+func (c *Clientset) Pipelines(namespace string) v3.PipelineInterface {
+	resource := c.Resource(v3.PipelineGroupVersionKind, v3.PipelineResource)
+	return &pipelineClient{
+		client: resource.Client(namespace),
+	}
+}
+
+func (c *Clientset) PipelineExecutions(namespace string) v3.PipelineExecutionInterface {
+	resource := c.Resource(v3.PipelineExecutionGroupVersionKind, v3.PipelineExecutionResource)
+	return &pipelineExecutionClient{
+		client: resource.Client(namespace),
+	}
+}
+
+func (c *Clientset) PipelineSettings(namespace string) v3.PipelineSettingInterface {
+	resource := c.Resource(v3.PipelineSettingGroupVersionKind, v3.PipelineSettingResource)
+	return &pipelineSettingClient{
+		client: resource.Client(namespace),
+	}
+}
+
+func (c *Clientset) SourceCodeRepositories(namespace string) v3.SourceCodeRepositoryInterface {
+	resource := c.Resource(v3.SourceCodeRepositoryGroupVersionKind, v3.SourceCodeRepositoryResource)
+	return &sourceCodeRepositoryClient{
+		client: resource.Client(namespace),
+	}
+}
+
+type serviceAccountTokenClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *serviceAccountTokenClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *serviceAccountTokenClient) Create(o *v3.ServiceAccountToken) (*v3.ServiceAccountToken, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.ServiceAccountToken), nil
+}
+
+func (s *serviceAccountTokenClient) Get(name string, opts metav1.GetOptions) (*v3.ServiceAccountToken, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.ServiceAccountToken), nil
+}
+
+func (s *serviceAccountTokenClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.ServiceAccountToken, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.ServiceAccountToken), nil
+}
+
+func (s *serviceAccountTokenClient) Update(o *v3.ServiceAccountToken) (*v3.ServiceAccountToken, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.ServiceAccountToken), nil
+}
+
+func (s *serviceAccountTokenClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *serviceAccountTokenClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *serviceAccountTokenClient) List(opts metav1.ListOptions) (*v3.ServiceAccountTokenList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v3.ServiceAccountTokenList{}
+	list.APIVersion = v3.ServiceAccountTokenGroupVersionKind.GroupVersion().String()
+	list.Kind = v3.ServiceAccountTokenGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v3.ServiceAccountToken))
+	}
+	return list, nil
+}
+
+func (s *serviceAccountTokenClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *serviceAccountTokenClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *serviceAccountTokenClient) Controller() v3.ServiceAccountTokenController {
+	return &serviceAccountTokenController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *serviceAccountTokenClient) AddHandler(ctx context.Context, name string, sync v3.ServiceAccountTokenHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *serviceAccountTokenClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v3.ServiceAccountTokenHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *serviceAccountTokenClient) AddLifecycle(ctx context.Context, name string, lifecycle v3.ServiceAccountTokenLifecycle) {
+	sync := newServiceAccountTokenLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *serviceAccountTokenClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v3.ServiceAccountTokenLifecycle) {
+	sync := newServiceAccountTokenLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *serviceAccountTokenClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v3.ServiceAccountTokenHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *serviceAccountTokenClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v3.ServiceAccountTokenHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *serviceAccountTokenClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v3.ServiceAccountTokenLifecycle) {
+	sync := newServiceAccountTokenLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *serviceAccountTokenClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v3.ServiceAccountTokenLifecycle) {
+	sync := newServiceAccountTokenLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type serviceAccountTokenController struct {
+	controller.GenericController
+}
+
+func (c *serviceAccountTokenController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *serviceAccountTokenController) Lister() v3.ServiceAccountTokenLister {
+	return &serviceAccountTokenLister{
+		controller: c,
+	}
+}
+
+func (c *serviceAccountTokenController) AddHandler(ctx context.Context, name string, handler v3.ServiceAccountTokenHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ServiceAccountToken); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *serviceAccountTokenController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v3.ServiceAccountTokenHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ServiceAccountToken); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *serviceAccountTokenController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v3.ServiceAccountTokenHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ServiceAccountToken); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *serviceAccountTokenController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v3.ServiceAccountTokenHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.ServiceAccountToken); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type serviceAccountTokenLister struct {
+	controller *serviceAccountTokenController
+}
+
+func (l *serviceAccountTokenLister) List(namespace string, selector labels.Selector) (ret []*v3.ServiceAccountToken, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v3.ServiceAccountToken))
+	})
+	return
+}
+
+func (l *serviceAccountTokenLister) Get(namespace, name string) (*v3.ServiceAccountToken, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v3.ServiceAccountTokenGroupVersionKind.Group,
+			Resource: "serviceAccountToken",
+		}, key)
+	}
+	return obj.(*v3.ServiceAccountToken), nil
+}
+
+type serviceAccountTokenLifecycleAdapter struct {
+	lifecycle v3.ServiceAccountTokenLifecycle
+}
+
+func (w *serviceAccountTokenLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *serviceAccountTokenLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *serviceAccountTokenLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v3.ServiceAccountToken))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *serviceAccountTokenLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v3.ServiceAccountToken))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *serviceAccountTokenLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v3.ServiceAccountToken))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newServiceAccountTokenLifecycleAdapter(name string, clusterScoped bool, client *serviceAccountTokenClient, l v3.ServiceAccountTokenLifecycle) v3.ServiceAccountTokenHandlerFunc {
+	adapter := &serviceAccountTokenLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v3.ServiceAccountToken) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+
+type dockerCredentialClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *dockerCredentialClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *dockerCredentialClient) Create(o *v3.DockerCredential) (*v3.DockerCredential, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.DockerCredential), nil
+}
+
+func (s *dockerCredentialClient) Get(name string, opts metav1.GetOptions) (*v3.DockerCredential, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.DockerCredential), nil
+}
+
+func (s *dockerCredentialClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.DockerCredential, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.DockerCredential), nil
+}
+
+func (s *dockerCredentialClient) Update(o *v3.DockerCredential) (*v3.DockerCredential, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.DockerCredential), nil
+}
+
+func (s *dockerCredentialClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *dockerCredentialClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *dockerCredentialClient) List(opts metav1.ListOptions) (*v3.DockerCredentialList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v3.DockerCredentialList{}
+	list.APIVersion = v3.DockerCredentialGroupVersionKind.GroupVersion().String()
+	list.Kind = v3.DockerCredentialGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v3.DockerCredential))
+	}
+	return list, nil
+}
+
+func (s *dockerCredentialClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *dockerCredentialClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *dockerCredentialClient) Controller() v3.DockerCredentialController {
+	return &dockerCredentialController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *dockerCredentialClient) AddHandler(ctx context.Context, name string, sync v3.DockerCredentialHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *dockerCredentialClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v3.DockerCredentialHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *dockerCredentialClient) AddLifecycle(ctx context.Context, name string, lifecycle v3.DockerCredentialLifecycle) {
+	sync := newDockerCredentialLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *dockerCredentialClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v3.DockerCredentialLifecycle) {
+	sync := newDockerCredentialLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *dockerCredentialClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v3.DockerCredentialHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *dockerCredentialClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v3.DockerCredentialHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *dockerCredentialClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v3.DockerCredentialLifecycle) {
+	sync := newDockerCredentialLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *dockerCredentialClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v3.DockerCredentialLifecycle) {
+	sync := newDockerCredentialLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type dockerCredentialController struct {
+	controller.GenericController
+}
+
+func (c *dockerCredentialController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *dockerCredentialController) Lister() v3.DockerCredentialLister {
+	return &dockerCredentialLister{
+		controller: c,
+	}
+}
+
+func (c *dockerCredentialController) AddHandler(ctx context.Context, name string, handler v3.DockerCredentialHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.DockerCredential); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *dockerCredentialController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v3.DockerCredentialHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.DockerCredential); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *dockerCredentialController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v3.DockerCredentialHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.DockerCredential); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *dockerCredentialController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v3.DockerCredentialHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.DockerCredential); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type dockerCredentialLister struct {
+	controller *dockerCredentialController
+}
+
+func (l *dockerCredentialLister) List(namespace string, selector labels.Selector) (ret []*v3.DockerCredential, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v3.DockerCredential))
+	})
+	return
+}
+
+func (l *dockerCredentialLister) Get(namespace, name string) (*v3.DockerCredential, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v3.DockerCredentialGroupVersionKind.Group,
+			Resource: "dockerCredential",
+		}, key)
+	}
+	return obj.(*v3.DockerCredential), nil
+}
+
+type dockerCredentialLifecycleAdapter struct {
+	lifecycle v3.DockerCredentialLifecycle
+}
+
+func (w *dockerCredentialLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *dockerCredentialLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *dockerCredentialLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v3.DockerCredential))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *dockerCredentialLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v3.DockerCredential))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *dockerCredentialLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v3.DockerCredential))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newDockerCredentialLifecycleAdapter(name string, clusterScoped bool, client *dockerCredentialClient, l v3.DockerCredentialLifecycle) v3.DockerCredentialHandlerFunc {
+	adapter := &dockerCredentialLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v3.DockerCredential) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+
+type certificateClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *certificateClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *certificateClient) Create(o *v3.Certificate) (*v3.Certificate, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.Certificate), nil
+}
+
+func (s *certificateClient) Get(name string, opts metav1.GetOptions) (*v3.Certificate, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.Certificate), nil
+}
+
+func (s *certificateClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.Certificate, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.Certificate), nil
+}
+
+func (s *certificateClient) Update(o *v3.Certificate) (*v3.Certificate, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.Certificate), nil
+}
+
+func (s *certificateClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *certificateClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *certificateClient) List(opts metav1.ListOptions) (*v3.CertificateList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v3.CertificateList{}
+	list.APIVersion = v3.CertificateGroupVersionKind.GroupVersion().String()
+	list.Kind = v3.CertificateGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v3.Certificate))
+	}
+	return list, nil
+}
+
+func (s *certificateClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *certificateClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *certificateClient) Controller() v3.CertificateController {
+	return &certificateController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *certificateClient) AddHandler(ctx context.Context, name string, sync v3.CertificateHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *certificateClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v3.CertificateHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *certificateClient) AddLifecycle(ctx context.Context, name string, lifecycle v3.CertificateLifecycle) {
+	sync := newCertificateLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *certificateClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v3.CertificateLifecycle) {
+	sync := newCertificateLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *certificateClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v3.CertificateHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *certificateClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v3.CertificateHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *certificateClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v3.CertificateLifecycle) {
+	sync := newCertificateLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *certificateClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v3.CertificateLifecycle) {
+	sync := newCertificateLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type certificateController struct {
+	controller.GenericController
+}
+
+func (c *certificateController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *certificateController) Lister() v3.CertificateLister {
+	return &certificateLister{
+		controller: c,
+	}
+}
+
+func (c *certificateController) AddHandler(ctx context.Context, name string, handler v3.CertificateHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.Certificate); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *certificateController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v3.CertificateHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.Certificate); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *certificateController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v3.CertificateHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.Certificate); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *certificateController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v3.CertificateHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.Certificate); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type certificateLister struct {
+	controller *certificateController
+}
+
+func (l *certificateLister) List(namespace string, selector labels.Selector) (ret []*v3.Certificate, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v3.Certificate))
+	})
+	return
+}
+
+func (l *certificateLister) Get(namespace, name string) (*v3.Certificate, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v3.CertificateGroupVersionKind.Group,
+			Resource: "certificate",
+		}, key)
+	}
+	return obj.(*v3.Certificate), nil
+}
+
+type certificateLifecycleAdapter struct {
+	lifecycle v3.CertificateLifecycle
+}
+
+func (w *certificateLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *certificateLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *certificateLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v3.Certificate))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *certificateLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v3.Certificate))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *certificateLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v3.Certificate))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newCertificateLifecycleAdapter(name string, clusterScoped bool, client *certificateClient, l v3.CertificateLifecycle) v3.CertificateHandlerFunc {
+	adapter := &certificateLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v3.Certificate) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+
+type basicAuthClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *basicAuthClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *basicAuthClient) Create(o *v3.BasicAuth) (*v3.BasicAuth, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.BasicAuth), nil
+}
+
+func (s *basicAuthClient) Get(name string, opts metav1.GetOptions) (*v3.BasicAuth, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.BasicAuth), nil
+}
+
+func (s *basicAuthClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.BasicAuth, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.BasicAuth), nil
+}
+
+func (s *basicAuthClient) Update(o *v3.BasicAuth) (*v3.BasicAuth, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.BasicAuth), nil
+}
+
+func (s *basicAuthClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *basicAuthClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *basicAuthClient) List(opts metav1.ListOptions) (*v3.BasicAuthList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v3.BasicAuthList{}
+	list.APIVersion = v3.BasicAuthGroupVersionKind.GroupVersion().String()
+	list.Kind = v3.BasicAuthGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v3.BasicAuth))
+	}
+	return list, nil
+}
+
+func (s *basicAuthClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *basicAuthClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *basicAuthClient) Controller() v3.BasicAuthController {
+	return &basicAuthController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *basicAuthClient) AddHandler(ctx context.Context, name string, sync v3.BasicAuthHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *basicAuthClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v3.BasicAuthHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *basicAuthClient) AddLifecycle(ctx context.Context, name string, lifecycle v3.BasicAuthLifecycle) {
+	sync := newBasicAuthLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *basicAuthClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v3.BasicAuthLifecycle) {
+	sync := newBasicAuthLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *basicAuthClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v3.BasicAuthHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *basicAuthClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v3.BasicAuthHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *basicAuthClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v3.BasicAuthLifecycle) {
+	sync := newBasicAuthLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *basicAuthClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v3.BasicAuthLifecycle) {
+	sync := newBasicAuthLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type basicAuthController struct {
+	controller.GenericController
+}
+
+func (c *basicAuthController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *basicAuthController) Lister() v3.BasicAuthLister {
+	return &basicAuthLister{
+		controller: c,
+	}
+}
+
+func (c *basicAuthController) AddHandler(ctx context.Context, name string, handler v3.BasicAuthHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.BasicAuth); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *basicAuthController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v3.BasicAuthHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.BasicAuth); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *basicAuthController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v3.BasicAuthHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.BasicAuth); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *basicAuthController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v3.BasicAuthHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.BasicAuth); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type basicAuthLister struct {
+	controller *basicAuthController
+}
+
+func (l *basicAuthLister) List(namespace string, selector labels.Selector) (ret []*v3.BasicAuth, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v3.BasicAuth))
+	})
+	return
+}
+
+func (l *basicAuthLister) Get(namespace, name string) (*v3.BasicAuth, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v3.BasicAuthGroupVersionKind.Group,
+			Resource: "basicAuth",
+		}, key)
+	}
+	return obj.(*v3.BasicAuth), nil
+}
+
+type basicAuthLifecycleAdapter struct {
+	lifecycle v3.BasicAuthLifecycle
+}
+
+func (w *basicAuthLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *basicAuthLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *basicAuthLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v3.BasicAuth))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *basicAuthLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v3.BasicAuth))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *basicAuthLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v3.BasicAuth))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newBasicAuthLifecycleAdapter(name string, clusterScoped bool, client *basicAuthClient, l v3.BasicAuthLifecycle) v3.BasicAuthHandlerFunc {
+	adapter := &basicAuthLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v3.BasicAuth) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+
+type sshAuthClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *sshAuthClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *sshAuthClient) Create(o *v3.SSHAuth) (*v3.SSHAuth, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.SSHAuth), nil
+}
+
+func (s *sshAuthClient) Get(name string, opts metav1.GetOptions) (*v3.SSHAuth, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.SSHAuth), nil
+}
+
+func (s *sshAuthClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.SSHAuth, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.SSHAuth), nil
+}
+
+func (s *sshAuthClient) Update(o *v3.SSHAuth) (*v3.SSHAuth, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.SSHAuth), nil
+}
+
+func (s *sshAuthClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *sshAuthClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *sshAuthClient) List(opts metav1.ListOptions) (*v3.SSHAuthList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v3.SSHAuthList{}
+	list.APIVersion = v3.SSHAuthGroupVersionKind.GroupVersion().String()
+	list.Kind = v3.SSHAuthGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v3.SSHAuth))
+	}
+	return list, nil
+}
+
+func (s *sshAuthClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *sshAuthClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *sshAuthClient) Controller() v3.SSHAuthController {
+	return &sshAuthController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *sshAuthClient) AddHandler(ctx context.Context, name string, sync v3.SSHAuthHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *sshAuthClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v3.SSHAuthHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *sshAuthClient) AddLifecycle(ctx context.Context, name string, lifecycle v3.SSHAuthLifecycle) {
+	sync := newSSHAuthLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *sshAuthClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v3.SSHAuthLifecycle) {
+	sync := newSSHAuthLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *sshAuthClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v3.SSHAuthHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *sshAuthClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v3.SSHAuthHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *sshAuthClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v3.SSHAuthLifecycle) {
+	sync := newSSHAuthLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *sshAuthClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v3.SSHAuthLifecycle) {
+	sync := newSSHAuthLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type sshAuthController struct {
+	controller.GenericController
+}
+
+func (c *sshAuthController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *sshAuthController) Lister() v3.SSHAuthLister {
+	return &sshAuthLister{
+		controller: c,
+	}
+}
+
+func (c *sshAuthController) AddHandler(ctx context.Context, name string, handler v3.SSHAuthHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.SSHAuth); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *sshAuthController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v3.SSHAuthHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.SSHAuth); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *sshAuthController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v3.SSHAuthHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.SSHAuth); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *sshAuthController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v3.SSHAuthHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.SSHAuth); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type sshAuthLister struct {
+	controller *sshAuthController
+}
+
+func (l *sshAuthLister) List(namespace string, selector labels.Selector) (ret []*v3.SSHAuth, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v3.SSHAuth))
+	})
+	return
+}
+
+func (l *sshAuthLister) Get(namespace, name string) (*v3.SSHAuth, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v3.SSHAuthGroupVersionKind.Group,
+			Resource: "sshAuth",
+		}, key)
+	}
+	return obj.(*v3.SSHAuth), nil
+}
+
+type sshAuthLifecycleAdapter struct {
+	lifecycle v3.SSHAuthLifecycle
+}
+
+func (w *sshAuthLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *sshAuthLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *sshAuthLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v3.SSHAuth))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *sshAuthLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v3.SSHAuth))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *sshAuthLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v3.SSHAuth))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newSSHAuthLifecycleAdapter(name string, clusterScoped bool, client *sshAuthClient, l v3.SSHAuthLifecycle) v3.SSHAuthHandlerFunc {
+	adapter := &sshAuthLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v3.SSHAuth) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+
+type namespacedServiceAccountTokenClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *namespacedServiceAccountTokenClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *namespacedServiceAccountTokenClient) Create(o *v3.NamespacedServiceAccountToken) (*v3.NamespacedServiceAccountToken, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.NamespacedServiceAccountToken), nil
+}
+
+func (s *namespacedServiceAccountTokenClient) Get(name string, opts metav1.GetOptions) (*v3.NamespacedServiceAccountToken, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.NamespacedServiceAccountToken), nil
+}
+
+func (s *namespacedServiceAccountTokenClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.NamespacedServiceAccountToken, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.NamespacedServiceAccountToken), nil
+}
+
+func (s *namespacedServiceAccountTokenClient) Update(o *v3.NamespacedServiceAccountToken) (*v3.NamespacedServiceAccountToken, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.NamespacedServiceAccountToken), nil
+}
+
+func (s *namespacedServiceAccountTokenClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *namespacedServiceAccountTokenClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *namespacedServiceAccountTokenClient) List(opts metav1.ListOptions) (*v3.NamespacedServiceAccountTokenList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v3.NamespacedServiceAccountTokenList{}
+	list.APIVersion = v3.NamespacedServiceAccountTokenGroupVersionKind.GroupVersion().String()
+	list.Kind = v3.NamespacedServiceAccountTokenGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v3.NamespacedServiceAccountToken))
+	}
+	return list, nil
+}
+
+func (s *namespacedServiceAccountTokenClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *namespacedServiceAccountTokenClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *namespacedServiceAccountTokenClient) Controller() v3.NamespacedServiceAccountTokenController {
+	return &namespacedServiceAccountTokenController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *namespacedServiceAccountTokenClient) AddHandler(ctx context.Context, name string, sync v3.NamespacedServiceAccountTokenHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *namespacedServiceAccountTokenClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v3.NamespacedServiceAccountTokenHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *namespacedServiceAccountTokenClient) AddLifecycle(ctx context.Context, name string, lifecycle v3.NamespacedServiceAccountTokenLifecycle) {
+	sync := newNamespacedServiceAccountTokenLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *namespacedServiceAccountTokenClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v3.NamespacedServiceAccountTokenLifecycle) {
+	sync := newNamespacedServiceAccountTokenLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *namespacedServiceAccountTokenClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v3.NamespacedServiceAccountTokenHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *namespacedServiceAccountTokenClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v3.NamespacedServiceAccountTokenHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *namespacedServiceAccountTokenClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v3.NamespacedServiceAccountTokenLifecycle) {
+	sync := newNamespacedServiceAccountTokenLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *namespacedServiceAccountTokenClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v3.NamespacedServiceAccountTokenLifecycle) {
+	sync := newNamespacedServiceAccountTokenLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type namespacedServiceAccountTokenController struct {
+	controller.GenericController
+}
+
+func (c *namespacedServiceAccountTokenController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *namespacedServiceAccountTokenController) Lister() v3.NamespacedServiceAccountTokenLister {
+	return &namespacedServiceAccountTokenLister{
+		controller: c,
+	}
+}
+
+func (c *namespacedServiceAccountTokenController) AddHandler(ctx context.Context, name string, handler v3.NamespacedServiceAccountTokenHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.NamespacedServiceAccountToken); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *namespacedServiceAccountTokenController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v3.NamespacedServiceAccountTokenHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.NamespacedServiceAccountToken); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *namespacedServiceAccountTokenController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v3.NamespacedServiceAccountTokenHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.NamespacedServiceAccountToken); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *namespacedServiceAccountTokenController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v3.NamespacedServiceAccountTokenHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.NamespacedServiceAccountToken); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type namespacedServiceAccountTokenLister struct {
+	controller *namespacedServiceAccountTokenController
+}
+
+func (l *namespacedServiceAccountTokenLister) List(namespace string, selector labels.Selector) (ret []*v3.NamespacedServiceAccountToken, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v3.NamespacedServiceAccountToken))
+	})
+	return
+}
+
+func (l *namespacedServiceAccountTokenLister) Get(namespace, name string) (*v3.NamespacedServiceAccountToken, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v3.NamespacedServiceAccountTokenGroupVersionKind.Group,
+			Resource: "namespacedServiceAccountToken",
+		}, key)
+	}
+	return obj.(*v3.NamespacedServiceAccountToken), nil
+}
+
+type namespacedServiceAccountTokenLifecycleAdapter struct {
+	lifecycle v3.NamespacedServiceAccountTokenLifecycle
+}
+
+func (w *namespacedServiceAccountTokenLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *namespacedServiceAccountTokenLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *namespacedServiceAccountTokenLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v3.NamespacedServiceAccountToken))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *namespacedServiceAccountTokenLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v3.NamespacedServiceAccountToken))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *namespacedServiceAccountTokenLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v3.NamespacedServiceAccountToken))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newNamespacedServiceAccountTokenLifecycleAdapter(name string, clusterScoped bool, client *namespacedServiceAccountTokenClient, l v3.NamespacedServiceAccountTokenLifecycle) v3.NamespacedServiceAccountTokenHandlerFunc {
+	adapter := &namespacedServiceAccountTokenLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v3.NamespacedServiceAccountToken) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+
+type namespacedDockerCredentialClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *namespacedDockerCredentialClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *namespacedDockerCredentialClient) Create(o *v3.NamespacedDockerCredential) (*v3.NamespacedDockerCredential, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.NamespacedDockerCredential), nil
+}
+
+func (s *namespacedDockerCredentialClient) Get(name string, opts metav1.GetOptions) (*v3.NamespacedDockerCredential, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.NamespacedDockerCredential), nil
+}
+
+func (s *namespacedDockerCredentialClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.NamespacedDockerCredential, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.NamespacedDockerCredential), nil
+}
+
+func (s *namespacedDockerCredentialClient) Update(o *v3.NamespacedDockerCredential) (*v3.NamespacedDockerCredential, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.NamespacedDockerCredential), nil
+}
+
+func (s *namespacedDockerCredentialClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *namespacedDockerCredentialClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *namespacedDockerCredentialClient) List(opts metav1.ListOptions) (*v3.NamespacedDockerCredentialList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v3.NamespacedDockerCredentialList{}
+	list.APIVersion = v3.NamespacedDockerCredentialGroupVersionKind.GroupVersion().String()
+	list.Kind = v3.NamespacedDockerCredentialGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v3.NamespacedDockerCredential))
+	}
+	return list, nil
+}
+
+func (s *namespacedDockerCredentialClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *namespacedDockerCredentialClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *namespacedDockerCredentialClient) Controller() v3.NamespacedDockerCredentialController {
+	return &namespacedDockerCredentialController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *namespacedDockerCredentialClient) AddHandler(ctx context.Context, name string, sync v3.NamespacedDockerCredentialHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *namespacedDockerCredentialClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v3.NamespacedDockerCredentialHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *namespacedDockerCredentialClient) AddLifecycle(ctx context.Context, name string, lifecycle v3.NamespacedDockerCredentialLifecycle) {
+	sync := newNamespacedDockerCredentialLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *namespacedDockerCredentialClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v3.NamespacedDockerCredentialLifecycle) {
+	sync := newNamespacedDockerCredentialLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *namespacedDockerCredentialClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v3.NamespacedDockerCredentialHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *namespacedDockerCredentialClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v3.NamespacedDockerCredentialHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *namespacedDockerCredentialClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v3.NamespacedDockerCredentialLifecycle) {
+	sync := newNamespacedDockerCredentialLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *namespacedDockerCredentialClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v3.NamespacedDockerCredentialLifecycle) {
+	sync := newNamespacedDockerCredentialLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type namespacedDockerCredentialController struct {
+	controller.GenericController
+}
+
+func (c *namespacedDockerCredentialController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *namespacedDockerCredentialController) Lister() v3.NamespacedDockerCredentialLister {
+	return &namespacedDockerCredentialLister{
+		controller: c,
+	}
+}
+
+func (c *namespacedDockerCredentialController) AddHandler(ctx context.Context, name string, handler v3.NamespacedDockerCredentialHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.NamespacedDockerCredential); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *namespacedDockerCredentialController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v3.NamespacedDockerCredentialHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.NamespacedDockerCredential); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *namespacedDockerCredentialController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v3.NamespacedDockerCredentialHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.NamespacedDockerCredential); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *namespacedDockerCredentialController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v3.NamespacedDockerCredentialHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.NamespacedDockerCredential); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type namespacedDockerCredentialLister struct {
+	controller *namespacedDockerCredentialController
+}
+
+func (l *namespacedDockerCredentialLister) List(namespace string, selector labels.Selector) (ret []*v3.NamespacedDockerCredential, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v3.NamespacedDockerCredential))
+	})
+	return
+}
+
+func (l *namespacedDockerCredentialLister) Get(namespace, name string) (*v3.NamespacedDockerCredential, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v3.NamespacedDockerCredentialGroupVersionKind.Group,
+			Resource: "namespacedDockerCredential",
+		}, key)
+	}
+	return obj.(*v3.NamespacedDockerCredential), nil
+}
+
+type namespacedDockerCredentialLifecycleAdapter struct {
+	lifecycle v3.NamespacedDockerCredentialLifecycle
+}
+
+func (w *namespacedDockerCredentialLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *namespacedDockerCredentialLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *namespacedDockerCredentialLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v3.NamespacedDockerCredential))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *namespacedDockerCredentialLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v3.NamespacedDockerCredential))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *namespacedDockerCredentialLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v3.NamespacedDockerCredential))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newNamespacedDockerCredentialLifecycleAdapter(name string, clusterScoped bool, client *namespacedDockerCredentialClient, l v3.NamespacedDockerCredentialLifecycle) v3.NamespacedDockerCredentialHandlerFunc {
+	adapter := &namespacedDockerCredentialLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v3.NamespacedDockerCredential) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+
+type namespacedCertificateClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *namespacedCertificateClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *namespacedCertificateClient) Create(o *v3.NamespacedCertificate) (*v3.NamespacedCertificate, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.NamespacedCertificate), nil
+}
+
+func (s *namespacedCertificateClient) Get(name string, opts metav1.GetOptions) (*v3.NamespacedCertificate, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.NamespacedCertificate), nil
+}
+
+func (s *namespacedCertificateClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.NamespacedCertificate, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.NamespacedCertificate), nil
+}
+
+func (s *namespacedCertificateClient) Update(o *v3.NamespacedCertificate) (*v3.NamespacedCertificate, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.NamespacedCertificate), nil
+}
+
+func (s *namespacedCertificateClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *namespacedCertificateClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *namespacedCertificateClient) List(opts metav1.ListOptions) (*v3.NamespacedCertificateList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v3.NamespacedCertificateList{}
+	list.APIVersion = v3.NamespacedCertificateGroupVersionKind.GroupVersion().String()
+	list.Kind = v3.NamespacedCertificateGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v3.NamespacedCertificate))
+	}
+	return list, nil
+}
+
+func (s *namespacedCertificateClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *namespacedCertificateClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *namespacedCertificateClient) Controller() v3.NamespacedCertificateController {
+	return &namespacedCertificateController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *namespacedCertificateClient) AddHandler(ctx context.Context, name string, sync v3.NamespacedCertificateHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *namespacedCertificateClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v3.NamespacedCertificateHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *namespacedCertificateClient) AddLifecycle(ctx context.Context, name string, lifecycle v3.NamespacedCertificateLifecycle) {
+	sync := newNamespacedCertificateLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *namespacedCertificateClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v3.NamespacedCertificateLifecycle) {
+	sync := newNamespacedCertificateLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *namespacedCertificateClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v3.NamespacedCertificateHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *namespacedCertificateClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v3.NamespacedCertificateHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *namespacedCertificateClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v3.NamespacedCertificateLifecycle) {
+	sync := newNamespacedCertificateLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *namespacedCertificateClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v3.NamespacedCertificateLifecycle) {
+	sync := newNamespacedCertificateLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type namespacedCertificateController struct {
+	controller.GenericController
+}
+
+func (c *namespacedCertificateController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *namespacedCertificateController) Lister() v3.NamespacedCertificateLister {
+	return &namespacedCertificateLister{
+		controller: c,
+	}
+}
+
+func (c *namespacedCertificateController) AddHandler(ctx context.Context, name string, handler v3.NamespacedCertificateHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.NamespacedCertificate); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *namespacedCertificateController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v3.NamespacedCertificateHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.NamespacedCertificate); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *namespacedCertificateController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v3.NamespacedCertificateHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.NamespacedCertificate); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *namespacedCertificateController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v3.NamespacedCertificateHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.NamespacedCertificate); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type namespacedCertificateLister struct {
+	controller *namespacedCertificateController
+}
+
+func (l *namespacedCertificateLister) List(namespace string, selector labels.Selector) (ret []*v3.NamespacedCertificate, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v3.NamespacedCertificate))
+	})
+	return
+}
+
+func (l *namespacedCertificateLister) Get(namespace, name string) (*v3.NamespacedCertificate, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v3.NamespacedCertificateGroupVersionKind.Group,
+			Resource: "namespacedCertificate",
+		}, key)
+	}
+	return obj.(*v3.NamespacedCertificate), nil
+}
+
+type namespacedCertificateLifecycleAdapter struct {
+	lifecycle v3.NamespacedCertificateLifecycle
+}
+
+func (w *namespacedCertificateLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *namespacedCertificateLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *namespacedCertificateLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v3.NamespacedCertificate))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *namespacedCertificateLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v3.NamespacedCertificate))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *namespacedCertificateLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v3.NamespacedCertificate))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newNamespacedCertificateLifecycleAdapter(name string, clusterScoped bool, client *namespacedCertificateClient, l v3.NamespacedCertificateLifecycle) v3.NamespacedCertificateHandlerFunc {
+	adapter := &namespacedCertificateLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v3.NamespacedCertificate) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+
+type namespacedBasicAuthClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *namespacedBasicAuthClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *namespacedBasicAuthClient) Create(o *v3.NamespacedBasicAuth) (*v3.NamespacedBasicAuth, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.NamespacedBasicAuth), nil
+}
+
+func (s *namespacedBasicAuthClient) Get(name string, opts metav1.GetOptions) (*v3.NamespacedBasicAuth, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.NamespacedBasicAuth), nil
+}
+
+func (s *namespacedBasicAuthClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.NamespacedBasicAuth, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.NamespacedBasicAuth), nil
+}
+
+func (s *namespacedBasicAuthClient) Update(o *v3.NamespacedBasicAuth) (*v3.NamespacedBasicAuth, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.NamespacedBasicAuth), nil
+}
+
+func (s *namespacedBasicAuthClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *namespacedBasicAuthClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *namespacedBasicAuthClient) List(opts metav1.ListOptions) (*v3.NamespacedBasicAuthList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v3.NamespacedBasicAuthList{}
+	list.APIVersion = v3.NamespacedBasicAuthGroupVersionKind.GroupVersion().String()
+	list.Kind = v3.NamespacedBasicAuthGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v3.NamespacedBasicAuth))
+	}
+	return list, nil
+}
+
+func (s *namespacedBasicAuthClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *namespacedBasicAuthClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *namespacedBasicAuthClient) Controller() v3.NamespacedBasicAuthController {
+	return &namespacedBasicAuthController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *namespacedBasicAuthClient) AddHandler(ctx context.Context, name string, sync v3.NamespacedBasicAuthHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *namespacedBasicAuthClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v3.NamespacedBasicAuthHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *namespacedBasicAuthClient) AddLifecycle(ctx context.Context, name string, lifecycle v3.NamespacedBasicAuthLifecycle) {
+	sync := newNamespacedBasicAuthLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *namespacedBasicAuthClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v3.NamespacedBasicAuthLifecycle) {
+	sync := newNamespacedBasicAuthLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *namespacedBasicAuthClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v3.NamespacedBasicAuthHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *namespacedBasicAuthClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v3.NamespacedBasicAuthHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *namespacedBasicAuthClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v3.NamespacedBasicAuthLifecycle) {
+	sync := newNamespacedBasicAuthLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *namespacedBasicAuthClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v3.NamespacedBasicAuthLifecycle) {
+	sync := newNamespacedBasicAuthLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type namespacedBasicAuthController struct {
+	controller.GenericController
+}
+
+func (c *namespacedBasicAuthController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *namespacedBasicAuthController) Lister() v3.NamespacedBasicAuthLister {
+	return &namespacedBasicAuthLister{
+		controller: c,
+	}
+}
+
+func (c *namespacedBasicAuthController) AddHandler(ctx context.Context, name string, handler v3.NamespacedBasicAuthHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.NamespacedBasicAuth); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *namespacedBasicAuthController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v3.NamespacedBasicAuthHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.NamespacedBasicAuth); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *namespacedBasicAuthController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v3.NamespacedBasicAuthHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.NamespacedBasicAuth); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *namespacedBasicAuthController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v3.NamespacedBasicAuthHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.NamespacedBasicAuth); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type namespacedBasicAuthLister struct {
+	controller *namespacedBasicAuthController
+}
+
+func (l *namespacedBasicAuthLister) List(namespace string, selector labels.Selector) (ret []*v3.NamespacedBasicAuth, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v3.NamespacedBasicAuth))
+	})
+	return
+}
+
+func (l *namespacedBasicAuthLister) Get(namespace, name string) (*v3.NamespacedBasicAuth, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v3.NamespacedBasicAuthGroupVersionKind.Group,
+			Resource: "namespacedBasicAuth",
+		}, key)
+	}
+	return obj.(*v3.NamespacedBasicAuth), nil
+}
+
+type namespacedBasicAuthLifecycleAdapter struct {
+	lifecycle v3.NamespacedBasicAuthLifecycle
+}
+
+func (w *namespacedBasicAuthLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *namespacedBasicAuthLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *namespacedBasicAuthLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v3.NamespacedBasicAuth))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *namespacedBasicAuthLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v3.NamespacedBasicAuth))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *namespacedBasicAuthLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v3.NamespacedBasicAuth))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newNamespacedBasicAuthLifecycleAdapter(name string, clusterScoped bool, client *namespacedBasicAuthClient, l v3.NamespacedBasicAuthLifecycle) v3.NamespacedBasicAuthHandlerFunc {
+	adapter := &namespacedBasicAuthLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v3.NamespacedBasicAuth) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+
+type namespacedSshAuthClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *namespacedSshAuthClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *namespacedSshAuthClient) Create(o *v3.NamespacedSSHAuth) (*v3.NamespacedSSHAuth, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.NamespacedSSHAuth), nil
+}
+
+func (s *namespacedSshAuthClient) Get(name string, opts metav1.GetOptions) (*v3.NamespacedSSHAuth, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.NamespacedSSHAuth), nil
+}
+
+func (s *namespacedSshAuthClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.NamespacedSSHAuth, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.NamespacedSSHAuth), nil
+}
+
+func (s *namespacedSshAuthClient) Update(o *v3.NamespacedSSHAuth) (*v3.NamespacedSSHAuth, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.NamespacedSSHAuth), nil
+}
+
+func (s *namespacedSshAuthClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *namespacedSshAuthClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *namespacedSshAuthClient) List(opts metav1.ListOptions) (*v3.NamespacedSSHAuthList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v3.NamespacedSSHAuthList{}
+	list.APIVersion = v3.NamespacedSSHAuthGroupVersionKind.GroupVersion().String()
+	list.Kind = v3.NamespacedSSHAuthGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v3.NamespacedSSHAuth))
+	}
+	return list, nil
+}
+
+func (s *namespacedSshAuthClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *namespacedSshAuthClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *namespacedSshAuthClient) Controller() v3.NamespacedSSHAuthController {
+	return &namespacedSshAuthController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *namespacedSshAuthClient) AddHandler(ctx context.Context, name string, sync v3.NamespacedSSHAuthHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *namespacedSshAuthClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v3.NamespacedSSHAuthHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *namespacedSshAuthClient) AddLifecycle(ctx context.Context, name string, lifecycle v3.NamespacedSSHAuthLifecycle) {
+	sync := newNamespacedSSHAuthLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *namespacedSshAuthClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v3.NamespacedSSHAuthLifecycle) {
+	sync := newNamespacedSSHAuthLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *namespacedSshAuthClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v3.NamespacedSSHAuthHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *namespacedSshAuthClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v3.NamespacedSSHAuthHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *namespacedSshAuthClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v3.NamespacedSSHAuthLifecycle) {
+	sync := newNamespacedSSHAuthLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *namespacedSshAuthClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v3.NamespacedSSHAuthLifecycle) {
+	sync := newNamespacedSSHAuthLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type namespacedSshAuthController struct {
+	controller.GenericController
+}
+
+func (c *namespacedSshAuthController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *namespacedSshAuthController) Lister() v3.NamespacedSSHAuthLister {
+	return &namespacedSshAuthLister{
+		controller: c,
+	}
+}
+
+func (c *namespacedSshAuthController) AddHandler(ctx context.Context, name string, handler v3.NamespacedSSHAuthHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.NamespacedSSHAuth); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *namespacedSshAuthController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v3.NamespacedSSHAuthHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.NamespacedSSHAuth); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *namespacedSshAuthController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v3.NamespacedSSHAuthHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.NamespacedSSHAuth); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *namespacedSshAuthController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v3.NamespacedSSHAuthHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.NamespacedSSHAuth); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type namespacedSshAuthLister struct {
+	controller *namespacedSshAuthController
+}
+
+func (l *namespacedSshAuthLister) List(namespace string, selector labels.Selector) (ret []*v3.NamespacedSSHAuth, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v3.NamespacedSSHAuth))
+	})
+	return
+}
+
+func (l *namespacedSshAuthLister) Get(namespace, name string) (*v3.NamespacedSSHAuth, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v3.NamespacedSSHAuthGroupVersionKind.Group,
+			Resource: "namespacedSshAuth",
+		}, key)
+	}
+	return obj.(*v3.NamespacedSSHAuth), nil
+}
+
+type namespacedSshAuthLifecycleAdapter struct {
+	lifecycle v3.NamespacedSSHAuthLifecycle
+}
+
+func (w *namespacedSshAuthLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *namespacedSshAuthLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *namespacedSshAuthLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v3.NamespacedSSHAuth))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *namespacedSshAuthLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v3.NamespacedSSHAuth))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *namespacedSshAuthLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v3.NamespacedSSHAuth))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newNamespacedSSHAuthLifecycleAdapter(name string, clusterScoped bool, client *namespacedSshAuthClient, l v3.NamespacedSSHAuthLifecycle) v3.NamespacedSSHAuthHandlerFunc {
+	adapter := &namespacedSshAuthLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v3.NamespacedSSHAuth) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+
+type workloadClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *workloadClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *workloadClient) Create(o *v3.Workload) (*v3.Workload, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.Workload), nil
+}
+
+func (s *workloadClient) Get(name string, opts metav1.GetOptions) (*v3.Workload, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.Workload), nil
+}
+
+func (s *workloadClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.Workload, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.Workload), nil
+}
+
+func (s *workloadClient) Update(o *v3.Workload) (*v3.Workload, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.Workload), nil
+}
+
+func (s *workloadClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *workloadClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *workloadClient) List(opts metav1.ListOptions) (*v3.WorkloadList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v3.WorkloadList{}
+	list.APIVersion = v3.WorkloadGroupVersionKind.GroupVersion().String()
+	list.Kind = v3.WorkloadGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v3.Workload))
+	}
+	return list, nil
+}
+
+func (s *workloadClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *workloadClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *workloadClient) Controller() v3.WorkloadController {
+	return &workloadController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *workloadClient) AddHandler(ctx context.Context, name string, sync v3.WorkloadHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *workloadClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v3.WorkloadHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *workloadClient) AddLifecycle(ctx context.Context, name string, lifecycle v3.WorkloadLifecycle) {
+	sync := newWorkloadLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *workloadClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v3.WorkloadLifecycle) {
+	sync := newWorkloadLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *workloadClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v3.WorkloadHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *workloadClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v3.WorkloadHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *workloadClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v3.WorkloadLifecycle) {
+	sync := newWorkloadLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *workloadClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v3.WorkloadLifecycle) {
+	sync := newWorkloadLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type workloadController struct {
+	controller.GenericController
+}
+
+func (c *workloadController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *workloadController) Lister() v3.WorkloadLister {
+	return &workloadLister{
+		controller: c,
+	}
+}
+
+func (c *workloadController) AddHandler(ctx context.Context, name string, handler v3.WorkloadHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.Workload); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *workloadController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v3.WorkloadHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.Workload); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *workloadController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v3.WorkloadHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.Workload); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *workloadController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v3.WorkloadHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.Workload); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type workloadLister struct {
+	controller *workloadController
+}
+
+func (l *workloadLister) List(namespace string, selector labels.Selector) (ret []*v3.Workload, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v3.Workload))
+	})
+	return
+}
+
+func (l *workloadLister) Get(namespace, name string) (*v3.Workload, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v3.WorkloadGroupVersionKind.Group,
+			Resource: "workload",
+		}, key)
+	}
+	return obj.(*v3.Workload), nil
+}
+
+type workloadLifecycleAdapter struct {
+	lifecycle v3.WorkloadLifecycle
+}
+
+func (w *workloadLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *workloadLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *workloadLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v3.Workload))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *workloadLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v3.Workload))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *workloadLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v3.Workload))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newWorkloadLifecycleAdapter(name string, clusterScoped bool, client *workloadClient, l v3.WorkloadLifecycle) v3.WorkloadHandlerFunc {
+	adapter := &workloadLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v3.Workload) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+
+type appClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *appClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *appClient) Create(o *v3.App) (*v3.App, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.App), nil
+}
+
+func (s *appClient) Get(name string, opts metav1.GetOptions) (*v3.App, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.App), nil
+}
+
+func (s *appClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.App, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.App), nil
+}
+
+func (s *appClient) Update(o *v3.App) (*v3.App, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.App), nil
+}
+
+func (s *appClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *appClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *appClient) List(opts metav1.ListOptions) (*v3.AppList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v3.AppList{}
+	list.APIVersion = v3.AppGroupVersionKind.GroupVersion().String()
+	list.Kind = v3.AppGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v3.App))
+	}
+	return list, nil
+}
+
+func (s *appClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *appClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *appClient) Controller() v3.AppController {
+	return &appController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *appClient) AddHandler(ctx context.Context, name string, sync v3.AppHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *appClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v3.AppHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *appClient) AddLifecycle(ctx context.Context, name string, lifecycle v3.AppLifecycle) {
+	sync := newAppLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *appClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v3.AppLifecycle) {
+	sync := newAppLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *appClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v3.AppHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *appClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v3.AppHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *appClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v3.AppLifecycle) {
+	sync := newAppLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *appClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v3.AppLifecycle) {
+	sync := newAppLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type appController struct {
+	controller.GenericController
+}
+
+func (c *appController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *appController) Lister() v3.AppLister {
+	return &appLister{
+		controller: c,
+	}
+}
+
+func (c *appController) AddHandler(ctx context.Context, name string, handler v3.AppHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.App); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *appController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v3.AppHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.App); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *appController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v3.AppHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.App); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *appController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v3.AppHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.App); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type appLister struct {
+	controller *appController
+}
+
+func (l *appLister) List(namespace string, selector labels.Selector) (ret []*v3.App, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v3.App))
+	})
+	return
+}
+
+func (l *appLister) Get(namespace, name string) (*v3.App, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v3.AppGroupVersionKind.Group,
+			Resource: "app",
+		}, key)
+	}
+	return obj.(*v3.App), nil
+}
+
+type appLifecycleAdapter struct {
+	lifecycle v3.AppLifecycle
+}
+
+func (w *appLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *appLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *appLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v3.App))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *appLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v3.App))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *appLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v3.App))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newAppLifecycleAdapter(name string, clusterScoped bool, client *appClient, l v3.AppLifecycle) v3.AppHandlerFunc {
+	adapter := &appLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v3.App) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+
+type appRevisionClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *appRevisionClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *appRevisionClient) Create(o *v3.AppRevision) (*v3.AppRevision, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.AppRevision), nil
+}
+
+func (s *appRevisionClient) Get(name string, opts metav1.GetOptions) (*v3.AppRevision, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.AppRevision), nil
+}
+
+func (s *appRevisionClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.AppRevision, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.AppRevision), nil
+}
+
+func (s *appRevisionClient) Update(o *v3.AppRevision) (*v3.AppRevision, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.AppRevision), nil
+}
+
+func (s *appRevisionClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *appRevisionClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *appRevisionClient) List(opts metav1.ListOptions) (*v3.AppRevisionList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v3.AppRevisionList{}
+	list.APIVersion = v3.AppRevisionGroupVersionKind.GroupVersion().String()
+	list.Kind = v3.AppRevisionGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v3.AppRevision))
+	}
+	return list, nil
+}
+
+func (s *appRevisionClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *appRevisionClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *appRevisionClient) Controller() v3.AppRevisionController {
+	return &appRevisionController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *appRevisionClient) AddHandler(ctx context.Context, name string, sync v3.AppRevisionHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *appRevisionClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v3.AppRevisionHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *appRevisionClient) AddLifecycle(ctx context.Context, name string, lifecycle v3.AppRevisionLifecycle) {
+	sync := newAppRevisionLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *appRevisionClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v3.AppRevisionLifecycle) {
+	sync := newAppRevisionLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *appRevisionClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v3.AppRevisionHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *appRevisionClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v3.AppRevisionHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *appRevisionClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v3.AppRevisionLifecycle) {
+	sync := newAppRevisionLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *appRevisionClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v3.AppRevisionLifecycle) {
+	sync := newAppRevisionLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type appRevisionController struct {
+	controller.GenericController
+}
+
+func (c *appRevisionController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *appRevisionController) Lister() v3.AppRevisionLister {
+	return &appRevisionLister{
+		controller: c,
+	}
+}
+
+func (c *appRevisionController) AddHandler(ctx context.Context, name string, handler v3.AppRevisionHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.AppRevision); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *appRevisionController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v3.AppRevisionHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.AppRevision); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *appRevisionController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v3.AppRevisionHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.AppRevision); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *appRevisionController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v3.AppRevisionHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.AppRevision); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type appRevisionLister struct {
+	controller *appRevisionController
+}
+
+func (l *appRevisionLister) List(namespace string, selector labels.Selector) (ret []*v3.AppRevision, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v3.AppRevision))
+	})
+	return
+}
+
+func (l *appRevisionLister) Get(namespace, name string) (*v3.AppRevision, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v3.AppRevisionGroupVersionKind.Group,
+			Resource: "appRevision",
+		}, key)
+	}
+	return obj.(*v3.AppRevision), nil
+}
+
+type appRevisionLifecycleAdapter struct {
+	lifecycle v3.AppRevisionLifecycle
+}
+
+func (w *appRevisionLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *appRevisionLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *appRevisionLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v3.AppRevision))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *appRevisionLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v3.AppRevision))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *appRevisionLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v3.AppRevision))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newAppRevisionLifecycleAdapter(name string, clusterScoped bool, client *appRevisionClient, l v3.AppRevisionLifecycle) v3.AppRevisionHandlerFunc {
+	adapter := &appRevisionLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v3.AppRevision) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+
+type sourceCodeProviderClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *sourceCodeProviderClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *sourceCodeProviderClient) Create(o *v3.SourceCodeProvider) (*v3.SourceCodeProvider, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.SourceCodeProvider), nil
+}
+
+func (s *sourceCodeProviderClient) Get(name string, opts metav1.GetOptions) (*v3.SourceCodeProvider, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.SourceCodeProvider), nil
+}
+
+func (s *sourceCodeProviderClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.SourceCodeProvider, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.SourceCodeProvider), nil
+}
+
+func (s *sourceCodeProviderClient) Update(o *v3.SourceCodeProvider) (*v3.SourceCodeProvider, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.SourceCodeProvider), nil
+}
+
+func (s *sourceCodeProviderClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *sourceCodeProviderClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *sourceCodeProviderClient) List(opts metav1.ListOptions) (*v3.SourceCodeProviderList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v3.SourceCodeProviderList{}
+	list.APIVersion = v3.SourceCodeProviderGroupVersionKind.GroupVersion().String()
+	list.Kind = v3.SourceCodeProviderGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v3.SourceCodeProvider))
+	}
+	return list, nil
+}
+
+func (s *sourceCodeProviderClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *sourceCodeProviderClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *sourceCodeProviderClient) Controller() v3.SourceCodeProviderController {
+	return &sourceCodeProviderController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *sourceCodeProviderClient) AddHandler(ctx context.Context, name string, sync v3.SourceCodeProviderHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *sourceCodeProviderClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v3.SourceCodeProviderHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *sourceCodeProviderClient) AddLifecycle(ctx context.Context, name string, lifecycle v3.SourceCodeProviderLifecycle) {
+	sync := newSourceCodeProviderLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *sourceCodeProviderClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v3.SourceCodeProviderLifecycle) {
+	sync := newSourceCodeProviderLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *sourceCodeProviderClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v3.SourceCodeProviderHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *sourceCodeProviderClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v3.SourceCodeProviderHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *sourceCodeProviderClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v3.SourceCodeProviderLifecycle) {
+	sync := newSourceCodeProviderLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *sourceCodeProviderClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v3.SourceCodeProviderLifecycle) {
+	sync := newSourceCodeProviderLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type sourceCodeProviderController struct {
+	controller.GenericController
+}
+
+func (c *sourceCodeProviderController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *sourceCodeProviderController) Lister() v3.SourceCodeProviderLister {
+	return &sourceCodeProviderLister{
+		controller: c,
+	}
+}
+
+func (c *sourceCodeProviderController) AddHandler(ctx context.Context, name string, handler v3.SourceCodeProviderHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.SourceCodeProvider); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *sourceCodeProviderController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v3.SourceCodeProviderHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.SourceCodeProvider); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *sourceCodeProviderController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v3.SourceCodeProviderHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.SourceCodeProvider); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *sourceCodeProviderController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v3.SourceCodeProviderHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.SourceCodeProvider); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type sourceCodeProviderLister struct {
+	controller *sourceCodeProviderController
+}
+
+func (l *sourceCodeProviderLister) List(namespace string, selector labels.Selector) (ret []*v3.SourceCodeProvider, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v3.SourceCodeProvider))
+	})
+	return
+}
+
+func (l *sourceCodeProviderLister) Get(namespace, name string) (*v3.SourceCodeProvider, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v3.SourceCodeProviderGroupVersionKind.Group,
+			Resource: "sourceCodeProvider",
+		}, key)
+	}
+	return obj.(*v3.SourceCodeProvider), nil
+}
+
+type sourceCodeProviderLifecycleAdapter struct {
+	lifecycle v3.SourceCodeProviderLifecycle
+}
+
+func (w *sourceCodeProviderLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *sourceCodeProviderLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *sourceCodeProviderLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v3.SourceCodeProvider))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *sourceCodeProviderLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v3.SourceCodeProvider))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *sourceCodeProviderLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v3.SourceCodeProvider))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newSourceCodeProviderLifecycleAdapter(name string, clusterScoped bool, client *sourceCodeProviderClient, l v3.SourceCodeProviderLifecycle) v3.SourceCodeProviderHandlerFunc {
+	adapter := &sourceCodeProviderLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v3.SourceCodeProvider) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+
+type sourceCodeProviderConfigClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *sourceCodeProviderConfigClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *sourceCodeProviderConfigClient) Create(o *v3.SourceCodeProviderConfig) (*v3.SourceCodeProviderConfig, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.SourceCodeProviderConfig), nil
+}
+
+func (s *sourceCodeProviderConfigClient) Get(name string, opts metav1.GetOptions) (*v3.SourceCodeProviderConfig, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.SourceCodeProviderConfig), nil
+}
+
+func (s *sourceCodeProviderConfigClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.SourceCodeProviderConfig, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.SourceCodeProviderConfig), nil
+}
+
+func (s *sourceCodeProviderConfigClient) Update(o *v3.SourceCodeProviderConfig) (*v3.SourceCodeProviderConfig, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.SourceCodeProviderConfig), nil
+}
+
+func (s *sourceCodeProviderConfigClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *sourceCodeProviderConfigClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *sourceCodeProviderConfigClient) List(opts metav1.ListOptions) (*v3.SourceCodeProviderConfigList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v3.SourceCodeProviderConfigList{}
+	list.APIVersion = v3.SourceCodeProviderConfigGroupVersionKind.GroupVersion().String()
+	list.Kind = v3.SourceCodeProviderConfigGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v3.SourceCodeProviderConfig))
+	}
+	return list, nil
+}
+
+func (s *sourceCodeProviderConfigClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *sourceCodeProviderConfigClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *sourceCodeProviderConfigClient) Controller() v3.SourceCodeProviderConfigController {
+	return &sourceCodeProviderConfigController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *sourceCodeProviderConfigClient) AddHandler(ctx context.Context, name string, sync v3.SourceCodeProviderConfigHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *sourceCodeProviderConfigClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v3.SourceCodeProviderConfigHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *sourceCodeProviderConfigClient) AddLifecycle(ctx context.Context, name string, lifecycle v3.SourceCodeProviderConfigLifecycle) {
+	sync := newSourceCodeProviderConfigLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *sourceCodeProviderConfigClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v3.SourceCodeProviderConfigLifecycle) {
+	sync := newSourceCodeProviderConfigLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *sourceCodeProviderConfigClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v3.SourceCodeProviderConfigHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *sourceCodeProviderConfigClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v3.SourceCodeProviderConfigHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *sourceCodeProviderConfigClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v3.SourceCodeProviderConfigLifecycle) {
+	sync := newSourceCodeProviderConfigLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *sourceCodeProviderConfigClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v3.SourceCodeProviderConfigLifecycle) {
+	sync := newSourceCodeProviderConfigLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type sourceCodeProviderConfigController struct {
+	controller.GenericController
+}
+
+func (c *sourceCodeProviderConfigController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *sourceCodeProviderConfigController) Lister() v3.SourceCodeProviderConfigLister {
+	return &sourceCodeProviderConfigLister{
+		controller: c,
+	}
+}
+
+func (c *sourceCodeProviderConfigController) AddHandler(ctx context.Context, name string, handler v3.SourceCodeProviderConfigHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.SourceCodeProviderConfig); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *sourceCodeProviderConfigController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v3.SourceCodeProviderConfigHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.SourceCodeProviderConfig); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *sourceCodeProviderConfigController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v3.SourceCodeProviderConfigHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.SourceCodeProviderConfig); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *sourceCodeProviderConfigController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v3.SourceCodeProviderConfigHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.SourceCodeProviderConfig); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type sourceCodeProviderConfigLister struct {
+	controller *sourceCodeProviderConfigController
+}
+
+func (l *sourceCodeProviderConfigLister) List(namespace string, selector labels.Selector) (ret []*v3.SourceCodeProviderConfig, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v3.SourceCodeProviderConfig))
+	})
+	return
+}
+
+func (l *sourceCodeProviderConfigLister) Get(namespace, name string) (*v3.SourceCodeProviderConfig, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v3.SourceCodeProviderConfigGroupVersionKind.Group,
+			Resource: "sourceCodeProviderConfig",
+		}, key)
+	}
+	return obj.(*v3.SourceCodeProviderConfig), nil
+}
+
+type sourceCodeProviderConfigLifecycleAdapter struct {
+	lifecycle v3.SourceCodeProviderConfigLifecycle
+}
+
+func (w *sourceCodeProviderConfigLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *sourceCodeProviderConfigLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *sourceCodeProviderConfigLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v3.SourceCodeProviderConfig))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *sourceCodeProviderConfigLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v3.SourceCodeProviderConfig))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *sourceCodeProviderConfigLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v3.SourceCodeProviderConfig))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newSourceCodeProviderConfigLifecycleAdapter(name string, clusterScoped bool, client *sourceCodeProviderConfigClient, l v3.SourceCodeProviderConfigLifecycle) v3.SourceCodeProviderConfigHandlerFunc {
+	adapter := &sourceCodeProviderConfigLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v3.SourceCodeProviderConfig) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+
+type sourceCodeCredentialClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *sourceCodeCredentialClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *sourceCodeCredentialClient) Create(o *v3.SourceCodeCredential) (*v3.SourceCodeCredential, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.SourceCodeCredential), nil
+}
+
+func (s *sourceCodeCredentialClient) Get(name string, opts metav1.GetOptions) (*v3.SourceCodeCredential, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.SourceCodeCredential), nil
+}
+
+func (s *sourceCodeCredentialClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.SourceCodeCredential, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.SourceCodeCredential), nil
+}
+
+func (s *sourceCodeCredentialClient) Update(o *v3.SourceCodeCredential) (*v3.SourceCodeCredential, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.SourceCodeCredential), nil
+}
+
+func (s *sourceCodeCredentialClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *sourceCodeCredentialClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *sourceCodeCredentialClient) List(opts metav1.ListOptions) (*v3.SourceCodeCredentialList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v3.SourceCodeCredentialList{}
+	list.APIVersion = v3.SourceCodeCredentialGroupVersionKind.GroupVersion().String()
+	list.Kind = v3.SourceCodeCredentialGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v3.SourceCodeCredential))
+	}
+	return list, nil
+}
+
+func (s *sourceCodeCredentialClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *sourceCodeCredentialClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *sourceCodeCredentialClient) Controller() v3.SourceCodeCredentialController {
+	return &sourceCodeCredentialController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *sourceCodeCredentialClient) AddHandler(ctx context.Context, name string, sync v3.SourceCodeCredentialHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *sourceCodeCredentialClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v3.SourceCodeCredentialHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *sourceCodeCredentialClient) AddLifecycle(ctx context.Context, name string, lifecycle v3.SourceCodeCredentialLifecycle) {
+	sync := newSourceCodeCredentialLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *sourceCodeCredentialClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v3.SourceCodeCredentialLifecycle) {
+	sync := newSourceCodeCredentialLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *sourceCodeCredentialClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v3.SourceCodeCredentialHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *sourceCodeCredentialClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v3.SourceCodeCredentialHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *sourceCodeCredentialClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v3.SourceCodeCredentialLifecycle) {
+	sync := newSourceCodeCredentialLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *sourceCodeCredentialClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v3.SourceCodeCredentialLifecycle) {
+	sync := newSourceCodeCredentialLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type sourceCodeCredentialController struct {
+	controller.GenericController
+}
+
+func (c *sourceCodeCredentialController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *sourceCodeCredentialController) Lister() v3.SourceCodeCredentialLister {
+	return &sourceCodeCredentialLister{
+		controller: c,
+	}
+}
+
+func (c *sourceCodeCredentialController) AddHandler(ctx context.Context, name string, handler v3.SourceCodeCredentialHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.SourceCodeCredential); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *sourceCodeCredentialController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v3.SourceCodeCredentialHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.SourceCodeCredential); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *sourceCodeCredentialController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v3.SourceCodeCredentialHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.SourceCodeCredential); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *sourceCodeCredentialController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v3.SourceCodeCredentialHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.SourceCodeCredential); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type sourceCodeCredentialLister struct {
+	controller *sourceCodeCredentialController
+}
+
+func (l *sourceCodeCredentialLister) List(namespace string, selector labels.Selector) (ret []*v3.SourceCodeCredential, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v3.SourceCodeCredential))
+	})
+	return
+}
+
+func (l *sourceCodeCredentialLister) Get(namespace, name string) (*v3.SourceCodeCredential, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v3.SourceCodeCredentialGroupVersionKind.Group,
+			Resource: "sourceCodeCredential",
+		}, key)
+	}
+	return obj.(*v3.SourceCodeCredential), nil
+}
+
+type sourceCodeCredentialLifecycleAdapter struct {
+	lifecycle v3.SourceCodeCredentialLifecycle
+}
+
+func (w *sourceCodeCredentialLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *sourceCodeCredentialLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *sourceCodeCredentialLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v3.SourceCodeCredential))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *sourceCodeCredentialLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v3.SourceCodeCredential))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *sourceCodeCredentialLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v3.SourceCodeCredential))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newSourceCodeCredentialLifecycleAdapter(name string, clusterScoped bool, client *sourceCodeCredentialClient, l v3.SourceCodeCredentialLifecycle) v3.SourceCodeCredentialHandlerFunc {
+	adapter := &sourceCodeCredentialLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v3.SourceCodeCredential) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+
+type pipelineClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *pipelineClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *pipelineClient) Create(o *v3.Pipeline) (*v3.Pipeline, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.Pipeline), nil
+}
+
+func (s *pipelineClient) Get(name string, opts metav1.GetOptions) (*v3.Pipeline, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.Pipeline), nil
+}
+
+func (s *pipelineClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.Pipeline, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.Pipeline), nil
+}
+
+func (s *pipelineClient) Update(o *v3.Pipeline) (*v3.Pipeline, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.Pipeline), nil
+}
+
+func (s *pipelineClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *pipelineClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *pipelineClient) List(opts metav1.ListOptions) (*v3.PipelineList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v3.PipelineList{}
+	list.APIVersion = v3.PipelineGroupVersionKind.GroupVersion().String()
+	list.Kind = v3.PipelineGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v3.Pipeline))
+	}
+	return list, nil
+}
+
+func (s *pipelineClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *pipelineClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *pipelineClient) Controller() v3.PipelineController {
+	return &pipelineController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *pipelineClient) AddHandler(ctx context.Context, name string, sync v3.PipelineHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *pipelineClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v3.PipelineHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *pipelineClient) AddLifecycle(ctx context.Context, name string, lifecycle v3.PipelineLifecycle) {
+	sync := newPipelineLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *pipelineClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v3.PipelineLifecycle) {
+	sync := newPipelineLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *pipelineClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v3.PipelineHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *pipelineClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v3.PipelineHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *pipelineClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v3.PipelineLifecycle) {
+	sync := newPipelineLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *pipelineClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v3.PipelineLifecycle) {
+	sync := newPipelineLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type pipelineController struct {
+	controller.GenericController
+}
+
+func (c *pipelineController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *pipelineController) Lister() v3.PipelineLister {
+	return &pipelineLister{
+		controller: c,
+	}
+}
+
+func (c *pipelineController) AddHandler(ctx context.Context, name string, handler v3.PipelineHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.Pipeline); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *pipelineController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v3.PipelineHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.Pipeline); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *pipelineController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v3.PipelineHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.Pipeline); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *pipelineController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v3.PipelineHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.Pipeline); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type pipelineLister struct {
+	controller *pipelineController
+}
+
+func (l *pipelineLister) List(namespace string, selector labels.Selector) (ret []*v3.Pipeline, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v3.Pipeline))
+	})
+	return
+}
+
+func (l *pipelineLister) Get(namespace, name string) (*v3.Pipeline, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v3.PipelineGroupVersionKind.Group,
+			Resource: "pipeline",
+		}, key)
+	}
+	return obj.(*v3.Pipeline), nil
+}
+
+type pipelineLifecycleAdapter struct {
+	lifecycle v3.PipelineLifecycle
+}
+
+func (w *pipelineLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *pipelineLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *pipelineLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v3.Pipeline))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *pipelineLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v3.Pipeline))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *pipelineLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v3.Pipeline))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newPipelineLifecycleAdapter(name string, clusterScoped bool, client *pipelineClient, l v3.PipelineLifecycle) v3.PipelineHandlerFunc {
+	adapter := &pipelineLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v3.Pipeline) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+
+type pipelineExecutionClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *pipelineExecutionClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *pipelineExecutionClient) Create(o *v3.PipelineExecution) (*v3.PipelineExecution, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.PipelineExecution), nil
+}
+
+func (s *pipelineExecutionClient) Get(name string, opts metav1.GetOptions) (*v3.PipelineExecution, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.PipelineExecution), nil
+}
+
+func (s *pipelineExecutionClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.PipelineExecution, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.PipelineExecution), nil
+}
+
+func (s *pipelineExecutionClient) Update(o *v3.PipelineExecution) (*v3.PipelineExecution, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.PipelineExecution), nil
+}
+
+func (s *pipelineExecutionClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *pipelineExecutionClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *pipelineExecutionClient) List(opts metav1.ListOptions) (*v3.PipelineExecutionList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v3.PipelineExecutionList{}
+	list.APIVersion = v3.PipelineExecutionGroupVersionKind.GroupVersion().String()
+	list.Kind = v3.PipelineExecutionGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v3.PipelineExecution))
+	}
+	return list, nil
+}
+
+func (s *pipelineExecutionClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *pipelineExecutionClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *pipelineExecutionClient) Controller() v3.PipelineExecutionController {
+	return &pipelineExecutionController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *pipelineExecutionClient) AddHandler(ctx context.Context, name string, sync v3.PipelineExecutionHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *pipelineExecutionClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v3.PipelineExecutionHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *pipelineExecutionClient) AddLifecycle(ctx context.Context, name string, lifecycle v3.PipelineExecutionLifecycle) {
+	sync := newPipelineExecutionLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *pipelineExecutionClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v3.PipelineExecutionLifecycle) {
+	sync := newPipelineExecutionLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *pipelineExecutionClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v3.PipelineExecutionHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *pipelineExecutionClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v3.PipelineExecutionHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *pipelineExecutionClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v3.PipelineExecutionLifecycle) {
+	sync := newPipelineExecutionLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *pipelineExecutionClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v3.PipelineExecutionLifecycle) {
+	sync := newPipelineExecutionLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type pipelineExecutionController struct {
+	controller.GenericController
+}
+
+func (c *pipelineExecutionController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *pipelineExecutionController) Lister() v3.PipelineExecutionLister {
+	return &pipelineExecutionLister{
+		controller: c,
+	}
+}
+
+func (c *pipelineExecutionController) AddHandler(ctx context.Context, name string, handler v3.PipelineExecutionHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.PipelineExecution); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *pipelineExecutionController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v3.PipelineExecutionHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.PipelineExecution); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *pipelineExecutionController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v3.PipelineExecutionHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.PipelineExecution); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *pipelineExecutionController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v3.PipelineExecutionHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.PipelineExecution); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type pipelineExecutionLister struct {
+	controller *pipelineExecutionController
+}
+
+func (l *pipelineExecutionLister) List(namespace string, selector labels.Selector) (ret []*v3.PipelineExecution, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v3.PipelineExecution))
+	})
+	return
+}
+
+func (l *pipelineExecutionLister) Get(namespace, name string) (*v3.PipelineExecution, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v3.PipelineExecutionGroupVersionKind.Group,
+			Resource: "pipelineExecution",
+		}, key)
+	}
+	return obj.(*v3.PipelineExecution), nil
+}
+
+type pipelineExecutionLifecycleAdapter struct {
+	lifecycle v3.PipelineExecutionLifecycle
+}
+
+func (w *pipelineExecutionLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *pipelineExecutionLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *pipelineExecutionLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v3.PipelineExecution))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *pipelineExecutionLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v3.PipelineExecution))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *pipelineExecutionLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v3.PipelineExecution))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newPipelineExecutionLifecycleAdapter(name string, clusterScoped bool, client *pipelineExecutionClient, l v3.PipelineExecutionLifecycle) v3.PipelineExecutionHandlerFunc {
+	adapter := &pipelineExecutionLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v3.PipelineExecution) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+
+type pipelineSettingClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *pipelineSettingClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *pipelineSettingClient) Create(o *v3.PipelineSetting) (*v3.PipelineSetting, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.PipelineSetting), nil
+}
+
+func (s *pipelineSettingClient) Get(name string, opts metav1.GetOptions) (*v3.PipelineSetting, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.PipelineSetting), nil
+}
+
+func (s *pipelineSettingClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.PipelineSetting, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.PipelineSetting), nil
+}
+
+func (s *pipelineSettingClient) Update(o *v3.PipelineSetting) (*v3.PipelineSetting, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.PipelineSetting), nil
+}
+
+func (s *pipelineSettingClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *pipelineSettingClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *pipelineSettingClient) List(opts metav1.ListOptions) (*v3.PipelineSettingList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v3.PipelineSettingList{}
+	list.APIVersion = v3.PipelineSettingGroupVersionKind.GroupVersion().String()
+	list.Kind = v3.PipelineSettingGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v3.PipelineSetting))
+	}
+	return list, nil
+}
+
+func (s *pipelineSettingClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *pipelineSettingClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *pipelineSettingClient) Controller() v3.PipelineSettingController {
+	return &pipelineSettingController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *pipelineSettingClient) AddHandler(ctx context.Context, name string, sync v3.PipelineSettingHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *pipelineSettingClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v3.PipelineSettingHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *pipelineSettingClient) AddLifecycle(ctx context.Context, name string, lifecycle v3.PipelineSettingLifecycle) {
+	sync := newPipelineSettingLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *pipelineSettingClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v3.PipelineSettingLifecycle) {
+	sync := newPipelineSettingLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *pipelineSettingClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v3.PipelineSettingHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *pipelineSettingClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v3.PipelineSettingHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *pipelineSettingClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v3.PipelineSettingLifecycle) {
+	sync := newPipelineSettingLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *pipelineSettingClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v3.PipelineSettingLifecycle) {
+	sync := newPipelineSettingLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type pipelineSettingController struct {
+	controller.GenericController
+}
+
+func (c *pipelineSettingController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *pipelineSettingController) Lister() v3.PipelineSettingLister {
+	return &pipelineSettingLister{
+		controller: c,
+	}
+}
+
+func (c *pipelineSettingController) AddHandler(ctx context.Context, name string, handler v3.PipelineSettingHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.PipelineSetting); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *pipelineSettingController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v3.PipelineSettingHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.PipelineSetting); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *pipelineSettingController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v3.PipelineSettingHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.PipelineSetting); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *pipelineSettingController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v3.PipelineSettingHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.PipelineSetting); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type pipelineSettingLister struct {
+	controller *pipelineSettingController
+}
+
+func (l *pipelineSettingLister) List(namespace string, selector labels.Selector) (ret []*v3.PipelineSetting, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v3.PipelineSetting))
+	})
+	return
+}
+
+func (l *pipelineSettingLister) Get(namespace, name string) (*v3.PipelineSetting, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v3.PipelineSettingGroupVersionKind.Group,
+			Resource: "pipelineSetting",
+		}, key)
+	}
+	return obj.(*v3.PipelineSetting), nil
+}
+
+type pipelineSettingLifecycleAdapter struct {
+	lifecycle v3.PipelineSettingLifecycle
+}
+
+func (w *pipelineSettingLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *pipelineSettingLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *pipelineSettingLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v3.PipelineSetting))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *pipelineSettingLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v3.PipelineSetting))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *pipelineSettingLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v3.PipelineSetting))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newPipelineSettingLifecycleAdapter(name string, clusterScoped bool, client *pipelineSettingClient, l v3.PipelineSettingLifecycle) v3.PipelineSettingHandlerFunc {
+	adapter := &pipelineSettingLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v3.PipelineSetting) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+
+type sourceCodeRepositoryClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *sourceCodeRepositoryClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *sourceCodeRepositoryClient) Create(o *v3.SourceCodeRepository) (*v3.SourceCodeRepository, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.SourceCodeRepository), nil
+}
+
+func (s *sourceCodeRepositoryClient) Get(name string, opts metav1.GetOptions) (*v3.SourceCodeRepository, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.SourceCodeRepository), nil
+}
+
+func (s *sourceCodeRepositoryClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v3.SourceCodeRepository, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.SourceCodeRepository), nil
+}
+
+func (s *sourceCodeRepositoryClient) Update(o *v3.SourceCodeRepository) (*v3.SourceCodeRepository, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v3.SourceCodeRepository), nil
+}
+
+func (s *sourceCodeRepositoryClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *sourceCodeRepositoryClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *sourceCodeRepositoryClient) List(opts metav1.ListOptions) (*v3.SourceCodeRepositoryList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v3.SourceCodeRepositoryList{}
+	list.APIVersion = v3.SourceCodeRepositoryGroupVersionKind.GroupVersion().String()
+	list.Kind = v3.SourceCodeRepositoryGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v3.SourceCodeRepository))
+	}
+	return list, nil
+}
+
+func (s *sourceCodeRepositoryClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *sourceCodeRepositoryClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *sourceCodeRepositoryClient) Controller() v3.SourceCodeRepositoryController {
+	return &sourceCodeRepositoryController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *sourceCodeRepositoryClient) AddHandler(ctx context.Context, name string, sync v3.SourceCodeRepositoryHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *sourceCodeRepositoryClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v3.SourceCodeRepositoryHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *sourceCodeRepositoryClient) AddLifecycle(ctx context.Context, name string, lifecycle v3.SourceCodeRepositoryLifecycle) {
+	sync := newSourceCodeRepositoryLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *sourceCodeRepositoryClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v3.SourceCodeRepositoryLifecycle) {
+	sync := newSourceCodeRepositoryLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *sourceCodeRepositoryClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v3.SourceCodeRepositoryHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *sourceCodeRepositoryClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v3.SourceCodeRepositoryHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *sourceCodeRepositoryClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v3.SourceCodeRepositoryLifecycle) {
+	sync := newSourceCodeRepositoryLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *sourceCodeRepositoryClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v3.SourceCodeRepositoryLifecycle) {
+	sync := newSourceCodeRepositoryLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type sourceCodeRepositoryController struct {
+	controller.GenericController
+}
+
+func (c *sourceCodeRepositoryController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *sourceCodeRepositoryController) Lister() v3.SourceCodeRepositoryLister {
+	return &sourceCodeRepositoryLister{
+		controller: c,
+	}
+}
+
+func (c *sourceCodeRepositoryController) AddHandler(ctx context.Context, name string, handler v3.SourceCodeRepositoryHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.SourceCodeRepository); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *sourceCodeRepositoryController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v3.SourceCodeRepositoryHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.SourceCodeRepository); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *sourceCodeRepositoryController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v3.SourceCodeRepositoryHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.SourceCodeRepository); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *sourceCodeRepositoryController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v3.SourceCodeRepositoryHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v3.SourceCodeRepository); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type sourceCodeRepositoryLister struct {
+	controller *sourceCodeRepositoryController
+}
+
+func (l *sourceCodeRepositoryLister) List(namespace string, selector labels.Selector) (ret []*v3.SourceCodeRepository, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v3.SourceCodeRepository))
+	})
+	return
+}
+
+func (l *sourceCodeRepositoryLister) Get(namespace, name string) (*v3.SourceCodeRepository, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v3.SourceCodeRepositoryGroupVersionKind.Group,
+			Resource: "sourceCodeRepository",
+		}, key)
+	}
+	return obj.(*v3.SourceCodeRepository), nil
+}
+
+type sourceCodeRepositoryLifecycleAdapter struct {
+	lifecycle v3.SourceCodeRepositoryLifecycle
+}
+
+func (w *sourceCodeRepositoryLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *sourceCodeRepositoryLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *sourceCodeRepositoryLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v3.SourceCodeRepository))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *sourceCodeRepositoryLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v3.SourceCodeRepository))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *sourceCodeRepositoryLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v3.SourceCodeRepository))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newSourceCodeRepositoryLifecycleAdapter(name string, clusterScoped bool, client *sourceCodeRepositoryClient, l v3.SourceCodeRepositoryLifecycle) v3.SourceCodeRepositoryHandlerFunc {
+	adapter := &sourceCodeRepositoryLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v3.SourceCodeRepository) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
@@ -0,0 +1,114 @@
+package v3
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func init() {
+	SchemeBuilder.Register(RegisterDefaults)
+}
+
+// RegisterDefaults adds defaulters functions to the given scheme.
+// Public to allow building arbitrary schemes.
+// All generated defaulters are covering - they call all nested defaulters.
+func RegisterDefaults(scheme *runtime.Scheme) error {
+	scheme.AddTypeDefaultingFunc(&App{}, func(obj interface{}) { SetDefaults_App(obj.(*App)) })
+	scheme.AddTypeDefaultingFunc(&AppList{}, func(obj interface{}) { SetDefaults_AppList(obj.(*AppList)) })
+	scheme.AddTypeDefaultingFunc(&Pipeline{}, func(obj interface{}) { SetDefaults_Pipeline(obj.(*Pipeline)) })
+	scheme.AddTypeDefaultingFunc(&PipelineExecution{}, func(obj interface{}) { SetDefaults_PipelineExecution(obj.(*PipelineExecution)) })
+	scheme.AddTypeDefaultingFunc(&PipelineExecutionList{}, func(obj interface{}) { SetDefaults_PipelineExecutionList(obj.(*PipelineExecutionList)) })
+	scheme.AddTypeDefaultingFunc(&PipelineList{}, func(obj interface{}) { SetDefaults_PipelineList(obj.(*PipelineList)) })
+	return nil
+}
+
+// SetDefaults_App is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_App(in *App) {
+	SetDefaults_AppSpec(&in.Spec)
+}
+
+// SetDefaults_AppList is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_AppList(in *AppList) {
+	for i := range in.Items {
+		SetDefaults_App(&in.Items[i])
+	}
+}
+
+// SetDefaults_AppSpec is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_AppSpec(in *AppSpec) {
+	if in.Timeout == 0 {
+		in.Timeout = 300
+	}
+}
+
+// SetDefaults_Pipeline is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_Pipeline(in *Pipeline) {
+	SetDefaults_PipelineStatus(&in.Status)
+}
+
+// SetDefaults_PipelineConfig is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_PipelineConfig(in *PipelineConfig) {
+	for i := range in.Stages {
+		SetDefaults_Stage(&in.Stages[i])
+	}
+}
+
+// SetDefaults_PipelineExecution is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_PipelineExecution(in *PipelineExecution) {
+	SetDefaults_PipelineExecutionSpec(&in.Spec)
+}
+
+// SetDefaults_PipelineExecutionList is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_PipelineExecutionList(in *PipelineExecutionList) {
+	for i := range in.Items {
+		SetDefaults_PipelineExecution(&in.Items[i])
+	}
+}
+
+// SetDefaults_PipelineExecutionSpec is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_PipelineExecutionSpec(in *PipelineExecutionSpec) {
+	SetDefaults_PipelineConfig(&in.PipelineConfig)
+}
+
+// SetDefaults_PipelineList is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_PipelineList(in *PipelineList) {
+	for i := range in.Items {
+		SetDefaults_Pipeline(&in.Items[i])
+	}
+}
+
+// SetDefaults_PipelineStatus is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_PipelineStatus(in *PipelineStatus) {
+	if in.PipelineState == "" {
+		in.PipelineState = "active"
+	}
+	if in.NextRun == 0 {
+		in.NextRun = 1
+	}
+}
+
+// SetDefaults_PublishImageConfig is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_PublishImageConfig(in *PublishImageConfig) {
+	if in.DockerfilePath == "" {
+		in.DockerfilePath = "./Dockerfile"
+	}
+	if in.BuildContext == "" {
+		in.BuildContext = "."
+	}
+	if in.Tag == "" {
+		in.Tag = "${CICD_GIT_REPOSITORY_NAME}:${CICD_GIT_BRANCH}"
+	}
+}
+
+// SetDefaults_Stage is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_Stage(in *Stage) {
+	for i := range in.Steps {
+		SetDefaults_Step(&in.Steps[i])
+	}
+}
+
+// SetDefaults_Step is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.
+func SetDefaults_Step(in *Step) {
+	if in.PublishImageConfig != nil {
+		SetDefaults_PublishImageConfig(in.PublishImageConfig)
+	}
+}
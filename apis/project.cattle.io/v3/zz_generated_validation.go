@@ -0,0 +1,506 @@
+package v3
+
+import (
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// Validate_App is an autogenerated validation function that checks App
+// values against the rules of their norman tags.
+func Validate_App(in *App, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, Validate_AppSpec(&in.Spec, fldPath.Child("spec"))...)
+	return allErrs
+}
+
+// Validate checks the App against the rules of its norman tags.
+func (in *App) Validate() field.ErrorList {
+	return Validate_App(in, nil)
+}
+
+// Validate_AppList is an autogenerated validation function that checks AppList
+// values against the rules of their norman tags.
+func Validate_AppList(in *AppList, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for i := range in.Items {
+		allErrs = append(allErrs, Validate_App(&in.Items[i], fldPath.Child("items").Index(i))...)
+	}
+	return allErrs
+}
+
+// Validate checks the AppList against the rules of its norman tags.
+func (in *AppList) Validate() field.ErrorList {
+	return Validate_AppList(in, nil)
+}
+
+// Validate_AppSpec is an autogenerated validation function that checks AppSpec
+// values against the rules of their norman tags.
+func Validate_AppSpec(in *AppSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if in.Timeout != 0 {
+		if in.Timeout < 1 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("timeout"), in.Timeout, "must be greater than or equal to 1"))
+		}
+	}
+	return allErrs
+}
+
+// Validate checks the AppSpec against the rules of its norman tags.
+func (in *AppSpec) Validate() field.ErrorList {
+	return Validate_AppSpec(in, nil)
+}
+
+// Validate_EnvFrom is an autogenerated validation function that checks EnvFrom
+// values against the rules of their norman tags.
+func Validate_EnvFrom(in *EnvFrom, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if in.SourceName == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("sourceName"), ""))
+	}
+	if in.SourceKey == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("sourceKey"), ""))
+	}
+	return allErrs
+}
+
+// Validate checks the EnvFrom against the rules of its norman tags.
+func (in *EnvFrom) Validate() field.ErrorList {
+	return Validate_EnvFrom(in, nil)
+}
+
+// Validate_Pipeline is an autogenerated validation function that checks Pipeline
+// values against the rules of their norman tags.
+func Validate_Pipeline(in *Pipeline, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, Validate_PipelineSpec(&in.Spec, fldPath.Child("spec"))...)
+	allErrs = append(allErrs, Validate_PipelineStatus(&in.Status, fldPath.Child("status"))...)
+	return allErrs
+}
+
+// Validate checks the Pipeline against the rules of its norman tags.
+func (in *Pipeline) Validate() field.ErrorList {
+	return Validate_Pipeline(in, nil)
+}
+
+// Validate_PipelineConfig is an autogenerated validation function that checks PipelineConfig
+// values against the rules of their norman tags.
+func Validate_PipelineConfig(in *PipelineConfig, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for i := range in.Stages {
+		allErrs = append(allErrs, Validate_Stage(&in.Stages[i], fldPath.Child("stages").Index(i))...)
+	}
+	return allErrs
+}
+
+// Validate checks the PipelineConfig against the rules of its norman tags.
+func (in *PipelineConfig) Validate() field.ErrorList {
+	return Validate_PipelineConfig(in, nil)
+}
+
+// Validate_PipelineExecution is an autogenerated validation function that checks PipelineExecution
+// values against the rules of their norman tags.
+func Validate_PipelineExecution(in *PipelineExecution, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, Validate_PipelineExecutionSpec(&in.Spec, fldPath.Child("spec"))...)
+	return allErrs
+}
+
+// Validate checks the PipelineExecution against the rules of its norman tags.
+func (in *PipelineExecution) Validate() field.ErrorList {
+	return Validate_PipelineExecution(in, nil)
+}
+
+// Validate_PipelineExecutionList is an autogenerated validation function that checks PipelineExecutionList
+// values against the rules of their norman tags.
+func Validate_PipelineExecutionList(in *PipelineExecutionList, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for i := range in.Items {
+		allErrs = append(allErrs, Validate_PipelineExecution(&in.Items[i], fldPath.Child("items").Index(i))...)
+	}
+	return allErrs
+}
+
+// Validate checks the PipelineExecutionList against the rules of its norman tags.
+func (in *PipelineExecutionList) Validate() field.ErrorList {
+	return Validate_PipelineExecutionList(in, nil)
+}
+
+// Validate_PipelineExecutionSpec is an autogenerated validation function that checks PipelineExecutionSpec
+// values against the rules of their norman tags.
+func Validate_PipelineExecutionSpec(in *PipelineExecutionSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if in.ProjectName == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("projectName"), ""))
+	}
+	if in.PipelineName == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("pipelineName"), ""))
+	}
+	allErrs = append(allErrs, Validate_PipelineConfig(&in.PipelineConfig, fldPath.Child("pipelineConfig"))...)
+	if in.Run != 0 {
+		if in.Run < 1 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("run"), in.Run, "must be greater than or equal to 1"))
+		}
+	}
+	if in.TriggeredBy == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("triggeredBy"), ""))
+	} else {
+		switch in.TriggeredBy {
+		case "user", "cron", "webhook":
+		default:
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("triggeredBy"), in.TriggeredBy, []string{"user", "cron", "webhook"}))
+		}
+	}
+	return allErrs
+}
+
+// Validate checks the PipelineExecutionSpec against the rules of its norman tags.
+func (in *PipelineExecutionSpec) Validate() field.ErrorList {
+	return Validate_PipelineExecutionSpec(in, nil)
+}
+
+// Validate_PipelineList is an autogenerated validation function that checks PipelineList
+// values against the rules of their norman tags.
+func Validate_PipelineList(in *PipelineList, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for i := range in.Items {
+		allErrs = append(allErrs, Validate_Pipeline(&in.Items[i], fldPath.Child("items").Index(i))...)
+	}
+	return allErrs
+}
+
+// Validate checks the PipelineList against the rules of its norman tags.
+func (in *PipelineList) Validate() field.ErrorList {
+	return Validate_PipelineList(in, nil)
+}
+
+// Validate_PipelineSetting is an autogenerated validation function that checks PipelineSetting
+// values against the rules of their norman tags.
+func Validate_PipelineSetting(in *PipelineSetting, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if in.Value == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("value"), ""))
+	}
+	return allErrs
+}
+
+// Validate checks the PipelineSetting against the rules of its norman tags.
+func (in *PipelineSetting) Validate() field.ErrorList {
+	return Validate_PipelineSetting(in, nil)
+}
+
+// Validate_PipelineSettingList is an autogenerated validation function that checks PipelineSettingList
+// values against the rules of their norman tags.
+func Validate_PipelineSettingList(in *PipelineSettingList, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for i := range in.Items {
+		allErrs = append(allErrs, Validate_PipelineSetting(&in.Items[i], fldPath.Child("items").Index(i))...)
+	}
+	return allErrs
+}
+
+// Validate checks the PipelineSettingList against the rules of its norman tags.
+func (in *PipelineSettingList) Validate() field.ErrorList {
+	return Validate_PipelineSettingList(in, nil)
+}
+
+// Validate_PipelineSpec is an autogenerated validation function that checks PipelineSpec
+// values against the rules of their norman tags.
+func Validate_PipelineSpec(in *PipelineSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if in.ProjectName == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("projectName"), ""))
+	}
+	return allErrs
+}
+
+// Validate checks the PipelineSpec against the rules of its norman tags.
+func (in *PipelineSpec) Validate() field.ErrorList {
+	return Validate_PipelineSpec(in, nil)
+}
+
+// Validate_PipelineStatus is an autogenerated validation function that checks PipelineStatus
+// values against the rules of their norman tags.
+func Validate_PipelineStatus(in *PipelineStatus, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if in.PipelineState == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("pipelineState"), ""))
+	} else {
+		switch in.PipelineState {
+		case "active", "inactive":
+		default:
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("pipelineState"), in.PipelineState, []string{"active", "inactive"}))
+		}
+	}
+	if in.NextRun != 0 {
+		if in.NextRun < 1 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("nextRun"), in.NextRun, "must be greater than or equal to 1"))
+		}
+	}
+	if in.SourceCodeCredential != nil {
+		allErrs = append(allErrs, Validate_SourceCodeCredential(in.SourceCodeCredential, fldPath.Child("sourceCodeCredential"))...)
+	}
+	return allErrs
+}
+
+// Validate checks the PipelineStatus against the rules of its norman tags.
+func (in *PipelineStatus) Validate() field.ErrorList {
+	return Validate_PipelineStatus(in, nil)
+}
+
+// Validate_PublishImageConfig is an autogenerated validation function that checks PublishImageConfig
+// values against the rules of their norman tags.
+func Validate_PublishImageConfig(in *PublishImageConfig, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if in.DockerfilePath == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("dockerfilePath"), ""))
+	}
+	if in.BuildContext == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("buildContext"), ""))
+	}
+	if in.Tag == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("tag"), ""))
+	}
+	return allErrs
+}
+
+// Validate checks the PublishImageConfig against the rules of its norman tags.
+func (in *PublishImageConfig) Validate() field.ErrorList {
+	return Validate_PublishImageConfig(in, nil)
+}
+
+// Validate_RunScriptConfig is an autogenerated validation function that checks RunScriptConfig
+// values against the rules of their norman tags.
+func Validate_RunScriptConfig(in *RunScriptConfig, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if in.Image == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("image"), ""))
+	}
+	return allErrs
+}
+
+// Validate checks the RunScriptConfig against the rules of its norman tags.
+func (in *RunScriptConfig) Validate() field.ErrorList {
+	return Validate_RunScriptConfig(in, nil)
+}
+
+// Validate_SourceCodeCredential is an autogenerated validation function that checks SourceCodeCredential
+// values against the rules of their norman tags.
+func Validate_SourceCodeCredential(in *SourceCodeCredential, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, Validate_SourceCodeCredentialSpec(&in.Spec, fldPath.Child("spec"))...)
+	return allErrs
+}
+
+// Validate checks the SourceCodeCredential against the rules of its norman tags.
+func (in *SourceCodeCredential) Validate() field.ErrorList {
+	return Validate_SourceCodeCredential(in, nil)
+}
+
+// Validate_SourceCodeCredentialList is an autogenerated validation function that checks SourceCodeCredentialList
+// values against the rules of their norman tags.
+func Validate_SourceCodeCredentialList(in *SourceCodeCredentialList, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for i := range in.Items {
+		allErrs = append(allErrs, Validate_SourceCodeCredential(&in.Items[i], fldPath.Child("items").Index(i))...)
+	}
+	return allErrs
+}
+
+// Validate checks the SourceCodeCredentialList against the rules of its norman tags.
+func (in *SourceCodeCredentialList) Validate() field.ErrorList {
+	return Validate_SourceCodeCredentialList(in, nil)
+}
+
+// Validate_SourceCodeCredentialSpec is an autogenerated validation function that checks SourceCodeCredentialSpec
+// values against the rules of their norman tags.
+func Validate_SourceCodeCredentialSpec(in *SourceCodeCredentialSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if in.SourceCodeType == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("sourceCodeType"), ""))
+	} else {
+		switch in.SourceCodeType {
+		case "github", "gitlab", "bitbucketcloud", "bitbucketserver":
+		default:
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("sourceCodeType"), in.SourceCodeType, []string{"github", "gitlab", "bitbucketcloud", "bitbucketserver"}))
+		}
+	}
+	if in.UserName == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("userName"), ""))
+	}
+	if in.DisplayName == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("displayName"), ""))
+	}
+	return allErrs
+}
+
+// Validate checks the SourceCodeCredentialSpec against the rules of its norman tags.
+func (in *SourceCodeCredentialSpec) Validate() field.ErrorList {
+	return Validate_SourceCodeCredentialSpec(in, nil)
+}
+
+// Validate_SourceCodeProvider is an autogenerated validation function that checks SourceCodeProvider
+// values against the rules of their norman tags.
+func Validate_SourceCodeProvider(in *SourceCodeProvider, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if in.Type != "" {
+		switch in.Type {
+		case "github", "gitlab", "bitbucketcloud", "bitbucketserver":
+		default:
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("type"), in.Type, []string{"github", "gitlab", "bitbucketcloud", "bitbucketserver"}))
+		}
+	}
+	return allErrs
+}
+
+// Validate checks the SourceCodeProvider against the rules of its norman tags.
+func (in *SourceCodeProvider) Validate() field.ErrorList {
+	return Validate_SourceCodeProvider(in, nil)
+}
+
+// Validate_SourceCodeProviderConfig is an autogenerated validation function that checks SourceCodeProviderConfig
+// values against the rules of their norman tags.
+func Validate_SourceCodeProviderConfig(in *SourceCodeProviderConfig, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if in.ProjectName == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("projectName"), ""))
+	}
+	if in.Type != "" {
+		switch in.Type {
+		case "github", "gitlab", "bitbucketcloud", "bitbucketserver":
+		default:
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("type"), in.Type, []string{"github", "gitlab", "bitbucketcloud", "bitbucketserver"}))
+		}
+	}
+	return allErrs
+}
+
+// Validate checks the SourceCodeProviderConfig against the rules of its norman tags.
+func (in *SourceCodeProviderConfig) Validate() field.ErrorList {
+	return Validate_SourceCodeProviderConfig(in, nil)
+}
+
+// Validate_SourceCodeProviderConfigList is an autogenerated validation function that checks SourceCodeProviderConfigList
+// values against the rules of their norman tags.
+func Validate_SourceCodeProviderConfigList(in *SourceCodeProviderConfigList, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for i := range in.Items {
+		allErrs = append(allErrs, Validate_SourceCodeProviderConfig(&in.Items[i], fldPath.Child("items").Index(i))...)
+	}
+	return allErrs
+}
+
+// Validate checks the SourceCodeProviderConfigList against the rules of its norman tags.
+func (in *SourceCodeProviderConfigList) Validate() field.ErrorList {
+	return Validate_SourceCodeProviderConfigList(in, nil)
+}
+
+// Validate_SourceCodeProviderList is an autogenerated validation function that checks SourceCodeProviderList
+// values against the rules of their norman tags.
+func Validate_SourceCodeProviderList(in *SourceCodeProviderList, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for i := range in.Items {
+		allErrs = append(allErrs, Validate_SourceCodeProvider(&in.Items[i], fldPath.Child("items").Index(i))...)
+	}
+	return allErrs
+}
+
+// Validate checks the SourceCodeProviderList against the rules of its norman tags.
+func (in *SourceCodeProviderList) Validate() field.ErrorList {
+	return Validate_SourceCodeProviderList(in, nil)
+}
+
+// Validate_SourceCodeRepository is an autogenerated validation function that checks SourceCodeRepository
+// values against the rules of their norman tags.
+func Validate_SourceCodeRepository(in *SourceCodeRepository, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, Validate_SourceCodeRepositorySpec(&in.Spec, fldPath.Child("spec"))...)
+	return allErrs
+}
+
+// Validate checks the SourceCodeRepository against the rules of its norman tags.
+func (in *SourceCodeRepository) Validate() field.ErrorList {
+	return Validate_SourceCodeRepository(in, nil)
+}
+
+// Validate_SourceCodeRepositoryList is an autogenerated validation function that checks SourceCodeRepositoryList
+// values against the rules of their norman tags.
+func Validate_SourceCodeRepositoryList(in *SourceCodeRepositoryList, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for i := range in.Items {
+		allErrs = append(allErrs, Validate_SourceCodeRepository(&in.Items[i], fldPath.Child("items").Index(i))...)
+	}
+	return allErrs
+}
+
+// Validate checks the SourceCodeRepositoryList against the rules of its norman tags.
+func (in *SourceCodeRepositoryList) Validate() field.ErrorList {
+	return Validate_SourceCodeRepositoryList(in, nil)
+}
+
+// Validate_SourceCodeRepositorySpec is an autogenerated validation function that checks SourceCodeRepositorySpec
+// values against the rules of their norman tags.
+func Validate_SourceCodeRepositorySpec(in *SourceCodeRepositorySpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if in.SourceCodeType == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("sourceCodeType"), ""))
+	} else {
+		switch in.SourceCodeType {
+		case "github", "gitlab", "bitbucketcloud", "bitbucketserver":
+		default:
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("sourceCodeType"), in.SourceCodeType, []string{"github", "gitlab", "bitbucketcloud", "bitbucketserver"}))
+		}
+	}
+	if in.UserName == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("userName"), ""))
+	}
+	if in.SourceCodeCredentialName == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("sourceCodeCredentialName"), ""))
+	}
+	return allErrs
+}
+
+// Validate checks the SourceCodeRepositorySpec against the rules of its norman tags.
+func (in *SourceCodeRepositorySpec) Validate() field.ErrorList {
+	return Validate_SourceCodeRepositorySpec(in, nil)
+}
+
+// Validate_Stage is an autogenerated validation function that checks Stage
+// values against the rules of their norman tags.
+func Validate_Stage(in *Stage, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if in.Name == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("name"), ""))
+	}
+	if len(in.Steps) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("steps"), ""))
+	}
+	for i := range in.Steps {
+		allErrs = append(allErrs, Validate_Step(&in.Steps[i], fldPath.Child("steps").Index(i))...)
+	}
+	return allErrs
+}
+
+// Validate checks the Stage against the rules of its norman tags.
+func (in *Stage) Validate() field.ErrorList {
+	return Validate_Stage(in, nil)
+}
+
+// Validate_Step is an autogenerated validation function that checks Step
+// values against the rules of their norman tags.
+func Validate_Step(in *Step, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if in.RunScriptConfig != nil {
+		allErrs = append(allErrs, Validate_RunScriptConfig(in.RunScriptConfig, fldPath.Child("runScriptConfig"))...)
+	}
+	if in.PublishImageConfig != nil {
+		allErrs = append(allErrs, Validate_PublishImageConfig(in.PublishImageConfig, fldPath.Child("publishImageConfig"))...)
+	}
+	for i := range in.EnvFrom {
+		allErrs = append(allErrs, Validate_EnvFrom(&in.EnvFrom[i], fldPath.Child("envFrom").Index(i))...)
+	}
+	return allErrs
+}
+
+// Validate checks the Step against the rules of its norman tags.
+func (in *Step) Validate() field.ErrorList {
+	return Validate_Step(in, nil)
+}
@@ -0,0 +1,1148 @@
+package fakes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rancher/norman/controller"
+	"github.com/rancher/norman/lifecycle"
+	"github.com/rancher/norman/objectclient"
+	v1a "github.com/rancher/types/apis/rbac.authorization.k8s.io/v1"
+	"github.com/rancher/types/fakeclient"
+	v1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
+)
+
+// Clientset is an in-memory v1a.Interface. Its objects are kept in a
+// fakeclient.Tracker, which can be shared with the clientsets of other groups
+// to run their controllers together.
+type Clientset struct {
+	*fakeclient.Tracker
+}
+
+var _ v1a.Interface = &Clientset{}
+
+// NewClientset returns a Clientset with a tracker of its own, holding
+// objects. It panics if an object is not a kind of the group.
+func NewClientset(objects ...runtime.Object) *Clientset {
+	return NewClientsetForTracker(fakeclient.NewTracker(), objects...)
+}
+
+// NewClientsetForTracker returns a Clientset storing its objects in tracker.
+// It panics if an object is not a kind of the group.
+func NewClientsetForTracker(tracker *fakeclient.Tracker, objects ...runtime.Object) *Clientset {
+	c := &Clientset{
+		Tracker: tracker,
+	}
+	if err := c.Add(objects...); err != nil {
+		panic(err)
+	}
+	return c
+}
+
+// RESTClient returns nil, the objects of a Clientset are not served.
+func (c *Clientset) RESTClient() rest.Interface {
+	return nil
+}
+
+// Add stores objects as they are, without the checks of Create.
+func (c *Clientset) Add(objects ...runtime.Object) error {
+	for _, obj := range objects {
+		var resource *fakeclient.Resource
+		switch obj.(type) {
+		case *v1.ClusterRoleBinding:
+			resource = c.Resource(v1a.ClusterRoleBindingGroupVersionKind, v1a.ClusterRoleBindingResource)
+		case *v1.ClusterRole:
+			resource = c.Resource(v1a.ClusterRoleGroupVersionKind, v1a.ClusterRoleResource)
+		case *v1.RoleBinding:
+			resource = c.Resource(v1a.RoleBindingGroupVersionKind, v1a.RoleBindingResource)
+		case *v1.Role:
+			resource = c.Resource(v1a.RoleGroupVersionKind, v1a.RoleResource)
+		default:
+			return fmt.Errorf("%T is not a kind of %s", obj, v1a.GroupName)
+		}
+		if err := resource.Add(obj); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *Clientset) ClusterRoleBindings(namespace string) v1a.ClusterRoleBindingInterface {
+	resource := c.Resource(v1a.ClusterRoleBindingGroupVersionKind, v1a.ClusterRoleBindingResource)
+	return &clusterRoleBindingClient{
+		client: resource.Client(namespace),
+	}
+}
+
+func (c *Clientset) ClusterRoles(namespace string) v1a.ClusterRoleInterface {
+	resource := c.Resource(v1a.ClusterRoleGroupVersionKind, v1a.ClusterRoleResource)
+	return &clusterRoleClient{
+		client: resource.Client(namespace),
+	}
+}
+
+func (c *Clientset) RoleBindings(namespace string) v1a.RoleBindingInterface {
+	resource := c.Resource(v1a.RoleBindingGroupVersionKind, v1a.RoleBindingResource)
+	return &roleBindingClient{
+		client: resource.Client(namespace),
+	}
+}
+
+func (c *Clientset) Roles(namespace string) v1a.RoleInterface {
+	resource := c.Resource(v1a.RoleGroupVersionKind, v1a.RoleResource)
+	return &roleClient{
+		client: resource.Client(namespace),
+	}
+}
+
+type clusterRoleBindingClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *clusterRoleBindingClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *clusterRoleBindingClient) Create(o *v1.ClusterRoleBinding) (*v1.ClusterRoleBinding, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.ClusterRoleBinding), nil
+}
+
+func (s *clusterRoleBindingClient) Get(name string, opts metav1.GetOptions) (*v1.ClusterRoleBinding, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.ClusterRoleBinding), nil
+}
+
+func (s *clusterRoleBindingClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v1.ClusterRoleBinding, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.ClusterRoleBinding), nil
+}
+
+func (s *clusterRoleBindingClient) Update(o *v1.ClusterRoleBinding) (*v1.ClusterRoleBinding, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.ClusterRoleBinding), nil
+}
+
+func (s *clusterRoleBindingClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *clusterRoleBindingClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *clusterRoleBindingClient) List(opts metav1.ListOptions) (*v1a.ClusterRoleBindingList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v1a.ClusterRoleBindingList{}
+	list.APIVersion = v1a.ClusterRoleBindingGroupVersionKind.GroupVersion().String()
+	list.Kind = v1a.ClusterRoleBindingGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v1.ClusterRoleBinding))
+	}
+	return list, nil
+}
+
+func (s *clusterRoleBindingClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *clusterRoleBindingClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *clusterRoleBindingClient) Controller() v1a.ClusterRoleBindingController {
+	return &clusterRoleBindingController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *clusterRoleBindingClient) AddHandler(ctx context.Context, name string, sync v1a.ClusterRoleBindingHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *clusterRoleBindingClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v1a.ClusterRoleBindingHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *clusterRoleBindingClient) AddLifecycle(ctx context.Context, name string, lifecycle v1a.ClusterRoleBindingLifecycle) {
+	sync := newClusterRoleBindingLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *clusterRoleBindingClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v1a.ClusterRoleBindingLifecycle) {
+	sync := newClusterRoleBindingLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *clusterRoleBindingClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v1a.ClusterRoleBindingHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *clusterRoleBindingClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v1a.ClusterRoleBindingHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *clusterRoleBindingClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v1a.ClusterRoleBindingLifecycle) {
+	sync := newClusterRoleBindingLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *clusterRoleBindingClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v1a.ClusterRoleBindingLifecycle) {
+	sync := newClusterRoleBindingLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type clusterRoleBindingController struct {
+	controller.GenericController
+}
+
+func (c *clusterRoleBindingController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *clusterRoleBindingController) Lister() v1a.ClusterRoleBindingLister {
+	return &clusterRoleBindingLister{
+		controller: c,
+	}
+}
+
+func (c *clusterRoleBindingController) AddHandler(ctx context.Context, name string, handler v1a.ClusterRoleBindingHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.ClusterRoleBinding); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *clusterRoleBindingController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v1a.ClusterRoleBindingHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.ClusterRoleBinding); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *clusterRoleBindingController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v1a.ClusterRoleBindingHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.ClusterRoleBinding); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *clusterRoleBindingController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v1a.ClusterRoleBindingHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.ClusterRoleBinding); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type clusterRoleBindingLister struct {
+	controller *clusterRoleBindingController
+}
+
+func (l *clusterRoleBindingLister) List(namespace string, selector labels.Selector) (ret []*v1.ClusterRoleBinding, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v1.ClusterRoleBinding))
+	})
+	return
+}
+
+func (l *clusterRoleBindingLister) Get(namespace, name string) (*v1.ClusterRoleBinding, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v1a.ClusterRoleBindingGroupVersionKind.Group,
+			Resource: "clusterRoleBinding",
+		}, key)
+	}
+	return obj.(*v1.ClusterRoleBinding), nil
+}
+
+type clusterRoleBindingLifecycleAdapter struct {
+	lifecycle v1a.ClusterRoleBindingLifecycle
+}
+
+func (w *clusterRoleBindingLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *clusterRoleBindingLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *clusterRoleBindingLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v1.ClusterRoleBinding))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *clusterRoleBindingLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v1.ClusterRoleBinding))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *clusterRoleBindingLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v1.ClusterRoleBinding))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newClusterRoleBindingLifecycleAdapter(name string, clusterScoped bool, client *clusterRoleBindingClient, l v1a.ClusterRoleBindingLifecycle) v1a.ClusterRoleBindingHandlerFunc {
+	adapter := &clusterRoleBindingLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v1.ClusterRoleBinding) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+
+type clusterRoleClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *clusterRoleClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *clusterRoleClient) Create(o *v1.ClusterRole) (*v1.ClusterRole, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.ClusterRole), nil
+}
+
+func (s *clusterRoleClient) Get(name string, opts metav1.GetOptions) (*v1.ClusterRole, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.ClusterRole), nil
+}
+
+func (s *clusterRoleClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v1.ClusterRole, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.ClusterRole), nil
+}
+
+func (s *clusterRoleClient) Update(o *v1.ClusterRole) (*v1.ClusterRole, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.ClusterRole), nil
+}
+
+func (s *clusterRoleClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *clusterRoleClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *clusterRoleClient) List(opts metav1.ListOptions) (*v1a.ClusterRoleList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v1a.ClusterRoleList{}
+	list.APIVersion = v1a.ClusterRoleGroupVersionKind.GroupVersion().String()
+	list.Kind = v1a.ClusterRoleGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v1.ClusterRole))
+	}
+	return list, nil
+}
+
+func (s *clusterRoleClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *clusterRoleClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *clusterRoleClient) Controller() v1a.ClusterRoleController {
+	return &clusterRoleController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *clusterRoleClient) AddHandler(ctx context.Context, name string, sync v1a.ClusterRoleHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *clusterRoleClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v1a.ClusterRoleHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *clusterRoleClient) AddLifecycle(ctx context.Context, name string, lifecycle v1a.ClusterRoleLifecycle) {
+	sync := newClusterRoleLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *clusterRoleClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v1a.ClusterRoleLifecycle) {
+	sync := newClusterRoleLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *clusterRoleClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v1a.ClusterRoleHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *clusterRoleClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v1a.ClusterRoleHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *clusterRoleClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v1a.ClusterRoleLifecycle) {
+	sync := newClusterRoleLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *clusterRoleClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v1a.ClusterRoleLifecycle) {
+	sync := newClusterRoleLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type clusterRoleController struct {
+	controller.GenericController
+}
+
+func (c *clusterRoleController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *clusterRoleController) Lister() v1a.ClusterRoleLister {
+	return &clusterRoleLister{
+		controller: c,
+	}
+}
+
+func (c *clusterRoleController) AddHandler(ctx context.Context, name string, handler v1a.ClusterRoleHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.ClusterRole); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *clusterRoleController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v1a.ClusterRoleHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.ClusterRole); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *clusterRoleController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v1a.ClusterRoleHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.ClusterRole); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *clusterRoleController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v1a.ClusterRoleHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.ClusterRole); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type clusterRoleLister struct {
+	controller *clusterRoleController
+}
+
+func (l *clusterRoleLister) List(namespace string, selector labels.Selector) (ret []*v1.ClusterRole, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v1.ClusterRole))
+	})
+	return
+}
+
+func (l *clusterRoleLister) Get(namespace, name string) (*v1.ClusterRole, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v1a.ClusterRoleGroupVersionKind.Group,
+			Resource: "clusterRole",
+		}, key)
+	}
+	return obj.(*v1.ClusterRole), nil
+}
+
+type clusterRoleLifecycleAdapter struct {
+	lifecycle v1a.ClusterRoleLifecycle
+}
+
+func (w *clusterRoleLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *clusterRoleLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *clusterRoleLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v1.ClusterRole))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *clusterRoleLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v1.ClusterRole))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *clusterRoleLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v1.ClusterRole))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newClusterRoleLifecycleAdapter(name string, clusterScoped bool, client *clusterRoleClient, l v1a.ClusterRoleLifecycle) v1a.ClusterRoleHandlerFunc {
+	adapter := &clusterRoleLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v1.ClusterRole) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+
+type roleBindingClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *roleBindingClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *roleBindingClient) Create(o *v1.RoleBinding) (*v1.RoleBinding, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.RoleBinding), nil
+}
+
+func (s *roleBindingClient) Get(name string, opts metav1.GetOptions) (*v1.RoleBinding, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.RoleBinding), nil
+}
+
+func (s *roleBindingClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v1.RoleBinding, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.RoleBinding), nil
+}
+
+func (s *roleBindingClient) Update(o *v1.RoleBinding) (*v1.RoleBinding, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.RoleBinding), nil
+}
+
+func (s *roleBindingClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *roleBindingClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *roleBindingClient) List(opts metav1.ListOptions) (*v1a.RoleBindingList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v1a.RoleBindingList{}
+	list.APIVersion = v1a.RoleBindingGroupVersionKind.GroupVersion().String()
+	list.Kind = v1a.RoleBindingGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v1.RoleBinding))
+	}
+	return list, nil
+}
+
+func (s *roleBindingClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *roleBindingClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *roleBindingClient) Controller() v1a.RoleBindingController {
+	return &roleBindingController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *roleBindingClient) AddHandler(ctx context.Context, name string, sync v1a.RoleBindingHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *roleBindingClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v1a.RoleBindingHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *roleBindingClient) AddLifecycle(ctx context.Context, name string, lifecycle v1a.RoleBindingLifecycle) {
+	sync := newRoleBindingLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *roleBindingClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v1a.RoleBindingLifecycle) {
+	sync := newRoleBindingLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *roleBindingClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v1a.RoleBindingHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *roleBindingClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v1a.RoleBindingHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *roleBindingClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v1a.RoleBindingLifecycle) {
+	sync := newRoleBindingLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *roleBindingClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v1a.RoleBindingLifecycle) {
+	sync := newRoleBindingLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type roleBindingController struct {
+	controller.GenericController
+}
+
+func (c *roleBindingController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *roleBindingController) Lister() v1a.RoleBindingLister {
+	return &roleBindingLister{
+		controller: c,
+	}
+}
+
+func (c *roleBindingController) AddHandler(ctx context.Context, name string, handler v1a.RoleBindingHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.RoleBinding); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *roleBindingController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v1a.RoleBindingHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.RoleBinding); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *roleBindingController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v1a.RoleBindingHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.RoleBinding); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *roleBindingController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v1a.RoleBindingHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.RoleBinding); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type roleBindingLister struct {
+	controller *roleBindingController
+}
+
+func (l *roleBindingLister) List(namespace string, selector labels.Selector) (ret []*v1.RoleBinding, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v1.RoleBinding))
+	})
+	return
+}
+
+func (l *roleBindingLister) Get(namespace, name string) (*v1.RoleBinding, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v1a.RoleBindingGroupVersionKind.Group,
+			Resource: "roleBinding",
+		}, key)
+	}
+	return obj.(*v1.RoleBinding), nil
+}
+
+type roleBindingLifecycleAdapter struct {
+	lifecycle v1a.RoleBindingLifecycle
+}
+
+func (w *roleBindingLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *roleBindingLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *roleBindingLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v1.RoleBinding))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *roleBindingLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v1.RoleBinding))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *roleBindingLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v1.RoleBinding))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newRoleBindingLifecycleAdapter(name string, clusterScoped bool, client *roleBindingClient, l v1a.RoleBindingLifecycle) v1a.RoleBindingHandlerFunc {
+	adapter := &roleBindingLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v1.RoleBinding) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+
+type roleClient struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *roleClient) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *roleClient) Create(o *v1.Role) (*v1.Role, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.Role), nil
+}
+
+func (s *roleClient) Get(name string, opts metav1.GetOptions) (*v1.Role, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.Role), nil
+}
+
+func (s *roleClient) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*v1.Role, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.Role), nil
+}
+
+func (s *roleClient) Update(o *v1.Role) (*v1.Role, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.Role), nil
+}
+
+func (s *roleClient) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *roleClient) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *roleClient) List(opts metav1.ListOptions) (*v1a.RoleList, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &v1a.RoleList{}
+	list.APIVersion = v1a.RoleGroupVersionKind.GroupVersion().String()
+	list.Kind = v1a.RoleGroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*v1.Role))
+	}
+	return list, nil
+}
+
+func (s *roleClient) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *roleClient) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *roleClient) Controller() v1a.RoleController {
+	return &roleController{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *roleClient) AddHandler(ctx context.Context, name string, sync v1a.RoleHandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *roleClient) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync v1a.RoleHandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *roleClient) AddLifecycle(ctx context.Context, name string, lifecycle v1a.RoleLifecycle) {
+	sync := newRoleLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *roleClient) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle v1a.RoleLifecycle) {
+	sync := newRoleLifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *roleClient) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync v1a.RoleHandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *roleClient) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync v1a.RoleHandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *roleClient) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle v1a.RoleLifecycle) {
+	sync := newRoleLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *roleClient) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle v1a.RoleLifecycle) {
+	sync := newRoleLifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type roleController struct {
+	controller.GenericController
+}
+
+func (c *roleController) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *roleController) Lister() v1a.RoleLister {
+	return &roleLister{
+		controller: c,
+	}
+}
+
+func (c *roleController) AddHandler(ctx context.Context, name string, handler v1a.RoleHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.Role); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *roleController) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler v1a.RoleHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.Role); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *roleController) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler v1a.RoleHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.Role); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *roleController) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler v1a.RoleHandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*v1.Role); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type roleLister struct {
+	controller *roleController
+}
+
+func (l *roleLister) List(namespace string, selector labels.Selector) (ret []*v1.Role, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*v1.Role))
+	})
+	return
+}
+
+func (l *roleLister) Get(namespace, name string) (*v1.Role, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    v1a.RoleGroupVersionKind.Group,
+			Resource: "role",
+		}, key)
+	}
+	return obj.(*v1.Role), nil
+}
+
+type roleLifecycleAdapter struct {
+	lifecycle v1a.RoleLifecycle
+}
+
+func (w *roleLifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *roleLifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *roleLifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*v1.Role))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *roleLifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*v1.Role))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *roleLifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*v1.Role))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func newRoleLifecycleAdapter(name string, clusterScoped bool, client *roleClient, l v1a.RoleLifecycle) v1a.RoleHandlerFunc {
+	adapter := &roleLifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *v1.Role) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
@@ -0,0 +1,1169 @@
+// Code generated from the cluster.cattle.io/v3 schemas. DO NOT EDIT.
+
+// The Api types are the norman API types shared by all schemas, their prefix
+// keeps them apart from schema types of the same name, such as Condition.
+
+export interface ApiResource {
+    id?: string;
+    type?: string;
+    links?: { [key: string]: string };
+    actions?: { [key: string]: string };
+}
+
+export interface ApiPagination {
+    marker?: string;
+    first?: string;
+    previous?: string;
+    next?: string;
+    last?: string;
+    limit?: number;
+    total?: number;
+    partial?: boolean;
+}
+
+export interface ApiSort {
+    name?: string;
+    order?: string;
+    reverse?: string;
+    links?: { [key: string]: string };
+}
+
+export interface ApiCondition {
+    modifier?: string;
+    value?: any;
+}
+
+export interface ApiCollection {
+    type?: string;
+    links?: { [key: string]: string };
+    createTypes?: { [key: string]: string };
+    actions?: { [key: string]: string };
+    pagination?: ApiPagination;
+    sort?: ApiSort;
+    filters?: { [key: string]: ApiCondition[] };
+    resourceType?: string;
+}
+
+export interface ApiListOpts {
+    filters?: { [key: string]: any };
+}
+
+// ApiOps performs the HTTP requests of the clients, it mirrors the
+// APIOperations of the Go client.
+export interface ApiOps {
+    DoList(schemaType: string, opts?: ApiListOpts): Promise<any>;
+    DoNext(nextURL: string): Promise<any>;
+    DoCreate(schemaType: string, createObj: object): Promise<any>;
+    DoReplace(schemaType: string, existing: ApiResource, updates: object): Promise<any>;
+    DoUpdate(schemaType: string, existing: ApiResource, updates: object): Promise<any>;
+    DoByID(schemaType: string, id: string): Promise<any>;
+    DoResourceDelete(schemaType: string, existing: ApiResource): Promise<void>;
+    DoAction(schemaType: string, action: string, existing: ApiResource, input?: object): Promise<any>;
+    DoCollectionAction(schemaType: string, action: string, existing: ApiCollection, input?: object): Promise<any>;
+}
+
+export const ResourceQuotaLimitType = "resourceQuotaLimit";
+export const ResourceQuotaLimitFieldConfigMaps = "configMaps";
+export const ResourceQuotaLimitFieldLimitsCPU = "limitsCpu";
+export const ResourceQuotaLimitFieldLimitsMemory = "limitsMemory";
+export const ResourceQuotaLimitFieldPersistentVolumeClaims = "persistentVolumeClaims";
+export const ResourceQuotaLimitFieldPods = "pods";
+export const ResourceQuotaLimitFieldReplicationControllers = "replicationControllers";
+export const ResourceQuotaLimitFieldRequestsCPU = "requestsCpu";
+export const ResourceQuotaLimitFieldRequestsMemory = "requestsMemory";
+export const ResourceQuotaLimitFieldRequestsStorage = "requestsStorage";
+export const ResourceQuotaLimitFieldSecrets = "secrets";
+export const ResourceQuotaLimitFieldServices = "services";
+export const ResourceQuotaLimitFieldServicesLoadBalancers = "servicesLoadBalancers";
+export const ResourceQuotaLimitFieldServicesNodePorts = "servicesNodePorts";
+
+export interface ResourceQuotaLimit {
+    configMaps?: string;
+    limitsCpu?: string;
+    limitsMemory?: string;
+    persistentVolumeClaims?: string;
+    pods?: string;
+    replicationControllers?: string;
+    requestsCpu?: string;
+    requestsMemory?: string;
+    requestsStorage?: string;
+    secrets?: string;
+    services?: string;
+    servicesLoadBalancers?: string;
+    servicesNodePorts?: string;
+}
+
+export const NamespaceResourceQuotaType = "namespaceResourceQuota";
+export const NamespaceResourceQuotaFieldLimit = "limit";
+
+export interface NamespaceResourceQuota {
+    limit?: ResourceQuotaLimit;
+}
+
+export const ContainerResourceLimitType = "containerResourceLimit";
+export const ContainerResourceLimitFieldLimitsCPU = "limitsCpu";
+export const ContainerResourceLimitFieldLimitsMemory = "limitsMemory";
+export const ContainerResourceLimitFieldRequestsCPU = "requestsCpu";
+export const ContainerResourceLimitFieldRequestsMemory = "requestsMemory";
+
+export interface ContainerResourceLimit {
+    limitsCpu?: string;
+    limitsMemory?: string;
+    requestsCpu?: string;
+    requestsMemory?: string;
+}
+
+export const OwnerReferenceType = "ownerReference";
+export const OwnerReferenceFieldAPIVersion = "apiVersion";
+export const OwnerReferenceFieldBlockOwnerDeletion = "blockOwnerDeletion";
+export const OwnerReferenceFieldController = "controller";
+export const OwnerReferenceFieldKind = "kind";
+export const OwnerReferenceFieldName = "name";
+export const OwnerReferenceFieldUID = "uid";
+
+export interface OwnerReference {
+    apiVersion?: string;
+    blockOwnerDeletion?: boolean;
+    controller?: boolean;
+    kind?: string;
+    name?: string;
+    uid?: string;
+}
+
+export const ObjectMetaType = "objectMeta";
+export const ObjectMetaFieldAnnotations = "annotations";
+export const ObjectMetaFieldCreated = "created";
+export const ObjectMetaFieldFinalizers = "finalizers";
+export const ObjectMetaFieldLabels = "labels";
+export const ObjectMetaFieldName = "name";
+export const ObjectMetaFieldNamespace = "namespace";
+export const ObjectMetaFieldOwnerReferences = "ownerReferences";
+export const ObjectMetaFieldRemoved = "removed";
+export const ObjectMetaFieldSelfLink = "selfLink";
+export const ObjectMetaFieldUUID = "uuid";
+
+export interface ObjectMeta {
+    annotations?: { [key: string]: string };
+    created?: string;
+    finalizers?: string[];
+    labels?: { [key: string]: string };
+    name?: string;
+    namespace?: string;
+    ownerReferences?: OwnerReference[];
+    removed?: string;
+    selfLink?: string;
+    uuid?: string;
+}
+
+export const NamespaceSpecType = "namespaceSpec";
+
+export interface NamespaceSpec {
+}
+
+export const NamespaceConditionType = "namespaceCondition";
+export const NamespaceConditionFieldLastTransitionTime = "lastTransitionTime";
+export const NamespaceConditionFieldMessage = "message";
+export const NamespaceConditionFieldReason = "reason";
+export const NamespaceConditionFieldStatus = "status";
+export const NamespaceConditionFieldType = "type";
+
+export interface NamespaceCondition {
+    lastTransitionTime?: string;
+    message?: string;
+    reason?: string;
+    status?: string;
+    type?: string;
+}
+
+export const NamespaceStatusType = "namespaceStatus";
+export const NamespaceStatusFieldConditions = "conditions";
+export const NamespaceStatusFieldPhase = "phase";
+
+export interface NamespaceStatus {
+    conditions?: NamespaceCondition[];
+    phase?: string;
+}
+
+export const NamespaceType = "namespace";
+export const NamespaceFieldAnnotations = "annotations";
+export const NamespaceFieldContainerDefaultResourceLimit = "containerDefaultResourceLimit";
+export const NamespaceFieldCreated = "created";
+export const NamespaceFieldCreatorID = "creatorId";
+export const NamespaceFieldDescription = "description";
+export const NamespaceFieldLabels = "labels";
+export const NamespaceFieldName = "name";
+export const NamespaceFieldOwnerReferences = "ownerReferences";
+export const NamespaceFieldProjectID = "projectId";
+export const NamespaceFieldRemoved = "removed";
+export const NamespaceFieldResourceQuota = "resourceQuota";
+export const NamespaceFieldState = "state";
+export const NamespaceFieldTransitioning = "transitioning";
+export const NamespaceFieldTransitioningMessage = "transitioningMessage";
+export const NamespaceFieldUUID = "uuid";
+export const NamespaceActionMove = "move";
+
+export interface Namespace extends ApiResource {
+    annotations?: { [key: string]: string };
+    containerDefaultResourceLimit?: ContainerResourceLimit;
+    created?: string;
+    creatorId?: string;
+    description?: string;
+    labels?: { [key: string]: string };
+    name?: string;
+    ownerReferences?: OwnerReference[];
+    projectId?: string;
+    removed?: string;
+    resourceQuota?: NamespaceResourceQuota;
+    state?: string;
+    transitioning?: "yes" | "no" | "error";
+    transitioningMessage?: string;
+    uuid?: string;
+}
+
+export interface NamespaceCollection extends ApiCollection {
+    data?: Namespace[];
+}
+
+export class NamespaceClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<NamespaceCollection> {
+        return this.ops.DoList(NamespaceType, opts);
+    }
+
+    Next(collection: NamespaceCollection): Promise<NamespaceCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: Namespace): Promise<Namespace> {
+        return this.ops.DoCreate(NamespaceType, container);
+    }
+
+    Update(existing: Namespace, updates: object): Promise<Namespace> {
+        return this.ops.DoUpdate(NamespaceType, existing, updates);
+    }
+
+    Replace(obj: Namespace): Promise<Namespace> {
+        return this.ops.DoReplace(NamespaceType, obj, obj);
+    }
+
+    ByID(id: string): Promise<Namespace> {
+        return this.ops.DoByID(NamespaceType, id);
+    }
+
+    Delete(container: Namespace): Promise<void> {
+        return this.ops.DoResourceDelete(NamespaceType, container);
+    }
+
+    ActionMove(resource: Namespace, input: NamespaceMove): Promise<void> {
+        return this.ops.DoAction(NamespaceType, NamespaceActionMove, resource, input);
+    }
+}
+
+export const NamespaceMoveType = "namespaceMove";
+export const NamespaceMoveFieldProjectID = "projectId";
+
+export interface NamespaceMove {
+    projectId?: string;
+}
+
+export const GCEPersistentDiskVolumeSourceType = "gcePersistentDiskVolumeSource";
+export const GCEPersistentDiskVolumeSourceFieldFSType = "fsType";
+export const GCEPersistentDiskVolumeSourceFieldPDName = "pdName";
+export const GCEPersistentDiskVolumeSourceFieldPartition = "partition";
+export const GCEPersistentDiskVolumeSourceFieldReadOnly = "readOnly";
+
+export interface GCEPersistentDiskVolumeSource {
+    fsType?: string;
+    pdName?: string;
+    partition?: number;
+    readOnly?: boolean;
+}
+
+export const AWSElasticBlockStoreVolumeSourceType = "awsElasticBlockStoreVolumeSource";
+export const AWSElasticBlockStoreVolumeSourceFieldFSType = "fsType";
+export const AWSElasticBlockStoreVolumeSourceFieldPartition = "partition";
+export const AWSElasticBlockStoreVolumeSourceFieldReadOnly = "readOnly";
+export const AWSElasticBlockStoreVolumeSourceFieldVolumeID = "volumeID";
+
+export interface AWSElasticBlockStoreVolumeSource {
+    fsType?: string;
+    partition?: number;
+    readOnly?: boolean;
+    volumeID?: string;
+}
+
+export const HostPathVolumeSourceType = "hostPathVolumeSource";
+export const HostPathVolumeSourceFieldKind = "kind";
+export const HostPathVolumeSourceFieldPath = "path";
+
+export interface HostPathVolumeSource {
+    kind?: "DirectoryOrCreate" | "Directory" | "FileOrCreate" | "File" | "Socket" | "CharDevice" | "BlockDevice";
+    path?: string;
+}
+
+export const GlusterfsPersistentVolumeSourceType = "glusterfsPersistentVolumeSource";
+export const GlusterfsPersistentVolumeSourceFieldEndpointsName = "endpoints";
+export const GlusterfsPersistentVolumeSourceFieldEndpointsNamespace = "endpointsNamespace";
+export const GlusterfsPersistentVolumeSourceFieldPath = "path";
+export const GlusterfsPersistentVolumeSourceFieldReadOnly = "readOnly";
+
+export interface GlusterfsPersistentVolumeSource {
+    endpoints?: string;
+    endpointsNamespace?: string;
+    path?: string;
+    readOnly?: boolean;
+}
+
+export const NFSVolumeSourceType = "nfsVolumeSource";
+export const NFSVolumeSourceFieldPath = "path";
+export const NFSVolumeSourceFieldReadOnly = "readOnly";
+export const NFSVolumeSourceFieldServer = "server";
+
+export interface NFSVolumeSource {
+    path?: string;
+    readOnly?: boolean;
+    server?: string;
+}
+
+export const SecretReferenceType = "secretReference";
+export const SecretReferenceFieldName = "name";
+export const SecretReferenceFieldNamespace = "namespace";
+
+export interface SecretReference {
+    name?: string;
+    namespace?: string;
+}
+
+export const RBDPersistentVolumeSourceType = "rbdPersistentVolumeSource";
+export const RBDPersistentVolumeSourceFieldCephMonitors = "monitors";
+export const RBDPersistentVolumeSourceFieldFSType = "fsType";
+export const RBDPersistentVolumeSourceFieldKeyring = "keyring";
+export const RBDPersistentVolumeSourceFieldRBDImage = "image";
+export const RBDPersistentVolumeSourceFieldRBDPool = "pool";
+export const RBDPersistentVolumeSourceFieldRadosUser = "user";
+export const RBDPersistentVolumeSourceFieldReadOnly = "readOnly";
+export const RBDPersistentVolumeSourceFieldSecretRef = "secretRef";
+
+export interface RBDPersistentVolumeSource {
+    monitors?: string[];
+    fsType?: string;
+    keyring?: string;
+    image?: string;
+    pool?: string;
+    user?: string;
+    readOnly?: boolean;
+    secretRef?: SecretReference;
+}
+
+export const ISCSIPersistentVolumeSourceType = "iscsiPersistentVolumeSource";
+export const ISCSIPersistentVolumeSourceFieldDiscoveryCHAPAuth = "chapAuthDiscovery";
+export const ISCSIPersistentVolumeSourceFieldFSType = "fsType";
+export const ISCSIPersistentVolumeSourceFieldIQN = "iqn";
+export const ISCSIPersistentVolumeSourceFieldISCSIInterface = "iscsiInterface";
+export const ISCSIPersistentVolumeSourceFieldInitiatorName = "initiatorName";
+export const ISCSIPersistentVolumeSourceFieldLun = "lun";
+export const ISCSIPersistentVolumeSourceFieldPortals = "portals";
+export const ISCSIPersistentVolumeSourceFieldReadOnly = "readOnly";
+export const ISCSIPersistentVolumeSourceFieldSecretRef = "secretRef";
+export const ISCSIPersistentVolumeSourceFieldSessionCHAPAuth = "chapAuthSession";
+export const ISCSIPersistentVolumeSourceFieldTargetPortal = "targetPortal";
+
+export interface ISCSIPersistentVolumeSource {
+    chapAuthDiscovery?: boolean;
+    fsType?: string;
+    iqn?: string;
+    iscsiInterface?: string;
+    initiatorName?: string;
+    lun?: number;
+    portals?: string[];
+    readOnly?: boolean;
+    secretRef?: SecretReference;
+    chapAuthSession?: boolean;
+    targetPortal?: string;
+}
+
+export const CinderPersistentVolumeSourceType = "cinderPersistentVolumeSource";
+export const CinderPersistentVolumeSourceFieldFSType = "fsType";
+export const CinderPersistentVolumeSourceFieldReadOnly = "readOnly";
+export const CinderPersistentVolumeSourceFieldSecretRef = "secretRef";
+export const CinderPersistentVolumeSourceFieldVolumeID = "volumeID";
+
+export interface CinderPersistentVolumeSource {
+    fsType?: string;
+    readOnly?: boolean;
+    secretRef?: SecretReference;
+    volumeID?: string;
+}
+
+export const CephFSPersistentVolumeSourceType = "cephFSPersistentVolumeSource";
+export const CephFSPersistentVolumeSourceFieldMonitors = "monitors";
+export const CephFSPersistentVolumeSourceFieldPath = "path";
+export const CephFSPersistentVolumeSourceFieldReadOnly = "readOnly";
+export const CephFSPersistentVolumeSourceFieldSecretFile = "secretFile";
+export const CephFSPersistentVolumeSourceFieldSecretRef = "secretRef";
+export const CephFSPersistentVolumeSourceFieldUser = "user";
+
+export interface CephFSPersistentVolumeSource {
+    monitors?: string[];
+    path?: string;
+    readOnly?: boolean;
+    secretFile?: string;
+    secretRef?: SecretReference;
+    user?: string;
+}
+
+export const FCVolumeSourceType = "fcVolumeSource";
+export const FCVolumeSourceFieldFSType = "fsType";
+export const FCVolumeSourceFieldLun = "lun";
+export const FCVolumeSourceFieldReadOnly = "readOnly";
+export const FCVolumeSourceFieldTargetWWNs = "targetWWNs";
+export const FCVolumeSourceFieldWWIDs = "wwids";
+
+export interface FCVolumeSource {
+    fsType?: string;
+    lun?: number;
+    readOnly?: boolean;
+    targetWWNs?: string[];
+    wwids?: string[];
+}
+
+export const FlockerVolumeSourceType = "flockerVolumeSource";
+export const FlockerVolumeSourceFieldDatasetName = "datasetName";
+export const FlockerVolumeSourceFieldDatasetUUID = "datasetUUID";
+
+export interface FlockerVolumeSource {
+    datasetName?: string;
+    datasetUUID?: string;
+}
+
+export const FlexPersistentVolumeSourceType = "flexPersistentVolumeSource";
+export const FlexPersistentVolumeSourceFieldDriver = "driver";
+export const FlexPersistentVolumeSourceFieldFSType = "fsType";
+export const FlexPersistentVolumeSourceFieldOptions = "options";
+export const FlexPersistentVolumeSourceFieldReadOnly = "readOnly";
+export const FlexPersistentVolumeSourceFieldSecretRef = "secretRef";
+
+export interface FlexPersistentVolumeSource {
+    driver?: string;
+    fsType?: string;
+    options?: { [key: string]: string };
+    readOnly?: boolean;
+    secretRef?: SecretReference;
+}
+
+export const AzureFilePersistentVolumeSourceType = "azureFilePersistentVolumeSource";
+export const AzureFilePersistentVolumeSourceFieldReadOnly = "readOnly";
+export const AzureFilePersistentVolumeSourceFieldSecretName = "secretName";
+export const AzureFilePersistentVolumeSourceFieldSecretNamespace = "secretNamespace";
+export const AzureFilePersistentVolumeSourceFieldShareName = "shareName";
+
+export interface AzureFilePersistentVolumeSource {
+    readOnly?: boolean;
+    secretName?: string;
+    secretNamespace?: string;
+    shareName?: string;
+}
+
+export const VsphereVirtualDiskVolumeSourceType = "vsphereVirtualDiskVolumeSource";
+export const VsphereVirtualDiskVolumeSourceFieldFSType = "fsType";
+export const VsphereVirtualDiskVolumeSourceFieldStoragePolicyID = "storagePolicyID";
+export const VsphereVirtualDiskVolumeSourceFieldStoragePolicyName = "storagePolicyName";
+export const VsphereVirtualDiskVolumeSourceFieldVolumePath = "volumePath";
+
+export interface VsphereVirtualDiskVolumeSource {
+    fsType?: string;
+    storagePolicyID?: string;
+    storagePolicyName?: string;
+    volumePath?: string;
+}
+
+export const QuobyteVolumeSourceType = "quobyteVolumeSource";
+export const QuobyteVolumeSourceFieldGroup = "group";
+export const QuobyteVolumeSourceFieldReadOnly = "readOnly";
+export const QuobyteVolumeSourceFieldRegistry = "registry";
+export const QuobyteVolumeSourceFieldTenant = "tenant";
+export const QuobyteVolumeSourceFieldUser = "user";
+export const QuobyteVolumeSourceFieldVolume = "volume";
+
+export interface QuobyteVolumeSource {
+    group?: string;
+    readOnly?: boolean;
+    registry?: string;
+    tenant?: string;
+    user?: string;
+    volume?: string;
+}
+
+export const AzureDiskVolumeSourceType = "azureDiskVolumeSource";
+export const AzureDiskVolumeSourceFieldCachingMode = "cachingMode";
+export const AzureDiskVolumeSourceFieldDataDiskURI = "diskURI";
+export const AzureDiskVolumeSourceFieldDiskName = "diskName";
+export const AzureDiskVolumeSourceFieldFSType = "fsType";
+export const AzureDiskVolumeSourceFieldKind = "kind";
+export const AzureDiskVolumeSourceFieldReadOnly = "readOnly";
+
+export interface AzureDiskVolumeSource {
+    cachingMode?: string;
+    diskURI?: string;
+    diskName?: string;
+    fsType?: string;
+    kind?: string;
+    readOnly?: boolean;
+}
+
+export const PhotonPersistentDiskVolumeSourceType = "photonPersistentDiskVolumeSource";
+export const PhotonPersistentDiskVolumeSourceFieldFSType = "fsType";
+export const PhotonPersistentDiskVolumeSourceFieldPdID = "pdID";
+
+export interface PhotonPersistentDiskVolumeSource {
+    fsType?: string;
+    pdID?: string;
+}
+
+export const PortworxVolumeSourceType = "portworxVolumeSource";
+export const PortworxVolumeSourceFieldFSType = "fsType";
+export const PortworxVolumeSourceFieldReadOnly = "readOnly";
+export const PortworxVolumeSourceFieldVolumeID = "volumeID";
+
+export interface PortworxVolumeSource {
+    fsType?: string;
+    readOnly?: boolean;
+    volumeID?: string;
+}
+
+export const ScaleIOPersistentVolumeSourceType = "scaleIOPersistentVolumeSource";
+export const ScaleIOPersistentVolumeSourceFieldFSType = "fsType";
+export const ScaleIOPersistentVolumeSourceFieldGateway = "gateway";
+export const ScaleIOPersistentVolumeSourceFieldProtectionDomain = "protectionDomain";
+export const ScaleIOPersistentVolumeSourceFieldReadOnly = "readOnly";
+export const ScaleIOPersistentVolumeSourceFieldSSLEnabled = "sslEnabled";
+export const ScaleIOPersistentVolumeSourceFieldSecretRef = "secretRef";
+export const ScaleIOPersistentVolumeSourceFieldStorageMode = "storageMode";
+export const ScaleIOPersistentVolumeSourceFieldStoragePool = "storagePool";
+export const ScaleIOPersistentVolumeSourceFieldSystem = "system";
+export const ScaleIOPersistentVolumeSourceFieldVolumeName = "volumeName";
+
+export interface ScaleIOPersistentVolumeSource {
+    fsType?: string;
+    gateway?: string;
+    protectionDomain?: string;
+    readOnly?: boolean;
+    sslEnabled?: boolean;
+    secretRef?: SecretReference;
+    storageMode?: string;
+    storagePool?: string;
+    system?: string;
+    volumeName?: string;
+}
+
+export const LocalVolumeSourceType = "localVolumeSource";
+export const LocalVolumeSourceFieldFSType = "fsType";
+export const LocalVolumeSourceFieldPath = "path";
+
+export interface LocalVolumeSource {
+    fsType?: string;
+    path?: string;
+}
+
+export const ObjectReferenceType = "objectReference";
+export const ObjectReferenceFieldAPIVersion = "apiVersion";
+export const ObjectReferenceFieldFieldPath = "fieldPath";
+export const ObjectReferenceFieldKind = "kind";
+export const ObjectReferenceFieldName = "name";
+export const ObjectReferenceFieldNamespace = "namespace";
+export const ObjectReferenceFieldResourceVersion = "resourceVersion";
+export const ObjectReferenceFieldUID = "uid";
+
+export interface ObjectReference {
+    apiVersion?: string;
+    fieldPath?: string;
+    kind?: string;
+    name?: string;
+    namespace?: string;
+    resourceVersion?: string;
+    uid?: string;
+}
+
+export const StorageOSPersistentVolumeSourceType = "storageOSPersistentVolumeSource";
+export const StorageOSPersistentVolumeSourceFieldFSType = "fsType";
+export const StorageOSPersistentVolumeSourceFieldReadOnly = "readOnly";
+export const StorageOSPersistentVolumeSourceFieldSecretRef = "secretRef";
+export const StorageOSPersistentVolumeSourceFieldVolumeName = "volumeName";
+export const StorageOSPersistentVolumeSourceFieldVolumeNamespace = "volumeNamespace";
+
+export interface StorageOSPersistentVolumeSource {
+    fsType?: string;
+    readOnly?: boolean;
+    secretRef?: ObjectReference;
+    volumeName?: string;
+    volumeNamespace?: string;
+}
+
+export const CSIPersistentVolumeSourceType = "csiPersistentVolumeSource";
+export const CSIPersistentVolumeSourceFieldControllerExpandSecretRef = "controllerExpandSecretRef";
+export const CSIPersistentVolumeSourceFieldControllerPublishSecretRef = "controllerPublishSecretRef";
+export const CSIPersistentVolumeSourceFieldDriver = "driver";
+export const CSIPersistentVolumeSourceFieldFSType = "fsType";
+export const CSIPersistentVolumeSourceFieldNodePublishSecretRef = "nodePublishSecretRef";
+export const CSIPersistentVolumeSourceFieldNodeStageSecretRef = "nodeStageSecretRef";
+export const CSIPersistentVolumeSourceFieldReadOnly = "readOnly";
+export const CSIPersistentVolumeSourceFieldVolumeAttributes = "volumeAttributes";
+export const CSIPersistentVolumeSourceFieldVolumeHandle = "volumeHandle";
+
+export interface CSIPersistentVolumeSource {
+    controllerExpandSecretRef?: SecretReference;
+    controllerPublishSecretRef?: SecretReference;
+    driver?: string;
+    fsType?: string;
+    nodePublishSecretRef?: SecretReference;
+    nodeStageSecretRef?: SecretReference;
+    readOnly?: boolean;
+    volumeAttributes?: { [key: string]: string };
+    volumeHandle?: string;
+}
+
+export const NodeSelectorRequirementType = "nodeSelectorRequirement";
+export const NodeSelectorRequirementFieldKey = "key";
+export const NodeSelectorRequirementFieldOperator = "operator";
+export const NodeSelectorRequirementFieldValues = "values";
+
+export interface NodeSelectorRequirement {
+    key?: string;
+    operator?: string;
+    values?: string[];
+}
+
+export const NodeSelectorTermType = "nodeSelectorTerm";
+export const NodeSelectorTermFieldMatchExpressions = "matchExpressions";
+export const NodeSelectorTermFieldMatchFields = "matchFields";
+
+export interface NodeSelectorTerm {
+    matchExpressions?: NodeSelectorRequirement[];
+    matchFields?: NodeSelectorRequirement[];
+}
+
+export const NodeSelectorType = "nodeSelector";
+export const NodeSelectorFieldNodeSelectorTerms = "nodeSelectorTerms";
+
+export interface NodeSelector {
+    nodeSelectorTerms?: NodeSelectorTerm[];
+}
+
+export const VolumeNodeAffinityType = "volumeNodeAffinity";
+export const VolumeNodeAffinityFieldRequired = "required";
+
+export interface VolumeNodeAffinity {
+    required?: NodeSelector;
+}
+
+export const PersistentVolumeSpecType = "persistentVolumeSpec";
+export const PersistentVolumeSpecFieldAWSElasticBlockStore = "awsElasticBlockStore";
+export const PersistentVolumeSpecFieldAccessModes = "accessModes";
+export const PersistentVolumeSpecFieldAzureDisk = "azureDisk";
+export const PersistentVolumeSpecFieldAzureFile = "azureFile";
+export const PersistentVolumeSpecFieldCSI = "csi";
+export const PersistentVolumeSpecFieldCapacity = "capacity";
+export const PersistentVolumeSpecFieldCephFS = "cephfs";
+export const PersistentVolumeSpecFieldCinder = "cinder";
+export const PersistentVolumeSpecFieldClaimRef = "claimRef";
+export const PersistentVolumeSpecFieldFC = "fc";
+export const PersistentVolumeSpecFieldFlexVolume = "flexVolume";
+export const PersistentVolumeSpecFieldFlocker = "flocker";
+export const PersistentVolumeSpecFieldGCEPersistentDisk = "gcePersistentDisk";
+export const PersistentVolumeSpecFieldGlusterfs = "glusterfs";
+export const PersistentVolumeSpecFieldHostPath = "hostPath";
+export const PersistentVolumeSpecFieldISCSI = "iscsi";
+export const PersistentVolumeSpecFieldLocal = "local";
+export const PersistentVolumeSpecFieldMountOptions = "mountOptions";
+export const PersistentVolumeSpecFieldNFS = "nfs";
+export const PersistentVolumeSpecFieldNodeAffinity = "nodeAffinity";
+export const PersistentVolumeSpecFieldPersistentVolumeReclaimPolicy = "persistentVolumeReclaimPolicy";
+export const PersistentVolumeSpecFieldPhotonPersistentDisk = "photonPersistentDisk";
+export const PersistentVolumeSpecFieldPortworxVolume = "portworxVolume";
+export const PersistentVolumeSpecFieldQuobyte = "quobyte";
+export const PersistentVolumeSpecFieldRBD = "rbd";
+export const PersistentVolumeSpecFieldScaleIO = "scaleIO";
+export const PersistentVolumeSpecFieldStorageClassID = "storageClassId";
+export const PersistentVolumeSpecFieldStorageOS = "storageos";
+export const PersistentVolumeSpecFieldVolumeMode = "volumeMode";
+export const PersistentVolumeSpecFieldVsphereVolume = "vsphereVolume";
+
+export interface PersistentVolumeSpec {
+    awsElasticBlockStore?: AWSElasticBlockStoreVolumeSource;
+    accessModes?: string[];
+    azureDisk?: AzureDiskVolumeSource;
+    azureFile?: AzureFilePersistentVolumeSource;
+    csi?: CSIPersistentVolumeSource;
+    capacity?: { [key: string]: string };
+    cephfs?: CephFSPersistentVolumeSource;
+    cinder?: CinderPersistentVolumeSource;
+    claimRef?: ObjectReference;
+    fc?: FCVolumeSource;
+    flexVolume?: FlexPersistentVolumeSource;
+    flocker?: FlockerVolumeSource;
+    gcePersistentDisk?: GCEPersistentDiskVolumeSource;
+    glusterfs?: GlusterfsPersistentVolumeSource;
+    hostPath?: HostPathVolumeSource;
+    iscsi?: ISCSIPersistentVolumeSource;
+    local?: LocalVolumeSource;
+    mountOptions?: string[];
+    nfs?: NFSVolumeSource;
+    nodeAffinity?: VolumeNodeAffinity;
+    persistentVolumeReclaimPolicy?: string;
+    photonPersistentDisk?: PhotonPersistentDiskVolumeSource;
+    portworxVolume?: PortworxVolumeSource;
+    quobyte?: QuobyteVolumeSource;
+    rbd?: RBDPersistentVolumeSource;
+    scaleIO?: ScaleIOPersistentVolumeSource;
+    storageClassId?: string;
+    storageos?: StorageOSPersistentVolumeSource;
+    volumeMode?: string;
+    vsphereVolume?: VsphereVirtualDiskVolumeSource;
+}
+
+export const PersistentVolumeStatusType = "persistentVolumeStatus";
+export const PersistentVolumeStatusFieldMessage = "message";
+export const PersistentVolumeStatusFieldPhase = "phase";
+export const PersistentVolumeStatusFieldReason = "reason";
+
+export interface PersistentVolumeStatus {
+    message?: string;
+    phase?: string;
+    reason?: string;
+}
+
+export const PersistentVolumeType = "persistentVolume";
+export const PersistentVolumeFieldAWSElasticBlockStore = "awsElasticBlockStore";
+export const PersistentVolumeFieldAccessModes = "accessModes";
+export const PersistentVolumeFieldAnnotations = "annotations";
+export const PersistentVolumeFieldAzureDisk = "azureDisk";
+export const PersistentVolumeFieldAzureFile = "azureFile";
+export const PersistentVolumeFieldCSI = "csi";
+export const PersistentVolumeFieldCapacity = "capacity";
+export const PersistentVolumeFieldCephFS = "cephfs";
+export const PersistentVolumeFieldCinder = "cinder";
+export const PersistentVolumeFieldClaimRef = "claimRef";
+export const PersistentVolumeFieldCreated = "created";
+export const PersistentVolumeFieldCreatorID = "creatorId";
+export const PersistentVolumeFieldDescription = "description";
+export const PersistentVolumeFieldFC = "fc";
+export const PersistentVolumeFieldFlexVolume = "flexVolume";
+export const PersistentVolumeFieldFlocker = "flocker";
+export const PersistentVolumeFieldGCEPersistentDisk = "gcePersistentDisk";
+export const PersistentVolumeFieldGlusterfs = "glusterfs";
+export const PersistentVolumeFieldHostPath = "hostPath";
+export const PersistentVolumeFieldISCSI = "iscsi";
+export const PersistentVolumeFieldLabels = "labels";
+export const PersistentVolumeFieldLocal = "local";
+export const PersistentVolumeFieldMountOptions = "mountOptions";
+export const PersistentVolumeFieldNFS = "nfs";
+export const PersistentVolumeFieldName = "name";
+export const PersistentVolumeFieldNodeAffinity = "nodeAffinity";
+export const PersistentVolumeFieldOwnerReferences = "ownerReferences";
+export const PersistentVolumeFieldPersistentVolumeReclaimPolicy = "persistentVolumeReclaimPolicy";
+export const PersistentVolumeFieldPhotonPersistentDisk = "photonPersistentDisk";
+export const PersistentVolumeFieldPortworxVolume = "portworxVolume";
+export const PersistentVolumeFieldQuobyte = "quobyte";
+export const PersistentVolumeFieldRBD = "rbd";
+export const PersistentVolumeFieldRemoved = "removed";
+export const PersistentVolumeFieldScaleIO = "scaleIO";
+export const PersistentVolumeFieldState = "state";
+export const PersistentVolumeFieldStatus = "status";
+export const PersistentVolumeFieldStorageClassID = "storageClassId";
+export const PersistentVolumeFieldStorageOS = "storageos";
+export const PersistentVolumeFieldTransitioning = "transitioning";
+export const PersistentVolumeFieldTransitioningMessage = "transitioningMessage";
+export const PersistentVolumeFieldUUID = "uuid";
+export const PersistentVolumeFieldVolumeMode = "volumeMode";
+export const PersistentVolumeFieldVsphereVolume = "vsphereVolume";
+
+export interface PersistentVolume extends ApiResource {
+    awsElasticBlockStore?: AWSElasticBlockStoreVolumeSource;
+    accessModes?: string[];
+    annotations?: { [key: string]: string };
+    azureDisk?: AzureDiskVolumeSource;
+    azureFile?: AzureFilePersistentVolumeSource;
+    csi?: CSIPersistentVolumeSource;
+    capacity?: { [key: string]: string };
+    cephfs?: CephFSPersistentVolumeSource;
+    cinder?: CinderPersistentVolumeSource;
+    claimRef?: ObjectReference;
+    created?: string;
+    creatorId?: string;
+    description?: string;
+    fc?: FCVolumeSource;
+    flexVolume?: FlexPersistentVolumeSource;
+    flocker?: FlockerVolumeSource;
+    gcePersistentDisk?: GCEPersistentDiskVolumeSource;
+    glusterfs?: GlusterfsPersistentVolumeSource;
+    hostPath?: HostPathVolumeSource;
+    iscsi?: ISCSIPersistentVolumeSource;
+    labels?: { [key: string]: string };
+    local?: LocalVolumeSource;
+    mountOptions?: string[];
+    nfs?: NFSVolumeSource;
+    name?: string;
+    nodeAffinity?: VolumeNodeAffinity;
+    ownerReferences?: OwnerReference[];
+    persistentVolumeReclaimPolicy?: string;
+    photonPersistentDisk?: PhotonPersistentDiskVolumeSource;
+    portworxVolume?: PortworxVolumeSource;
+    quobyte?: QuobyteVolumeSource;
+    rbd?: RBDPersistentVolumeSource;
+    removed?: string;
+    scaleIO?: ScaleIOPersistentVolumeSource;
+    state?: string;
+    status?: PersistentVolumeStatus;
+    storageClassId?: string;
+    storageos?: StorageOSPersistentVolumeSource;
+    transitioning?: "yes" | "no" | "error";
+    transitioningMessage?: string;
+    uuid?: string;
+    volumeMode?: string;
+    vsphereVolume?: VsphereVirtualDiskVolumeSource;
+}
+
+export interface PersistentVolumeCollection extends ApiCollection {
+    data?: PersistentVolume[];
+}
+
+export class PersistentVolumeClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<PersistentVolumeCollection> {
+        return this.ops.DoList(PersistentVolumeType, opts);
+    }
+
+    Next(collection: PersistentVolumeCollection): Promise<PersistentVolumeCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: PersistentVolume): Promise<PersistentVolume> {
+        return this.ops.DoCreate(PersistentVolumeType, container);
+    }
+
+    Update(existing: PersistentVolume, updates: object): Promise<PersistentVolume> {
+        return this.ops.DoUpdate(PersistentVolumeType, existing, updates);
+    }
+
+    Replace(obj: PersistentVolume): Promise<PersistentVolume> {
+        return this.ops.DoReplace(PersistentVolumeType, obj, obj);
+    }
+
+    ByID(id: string): Promise<PersistentVolume> {
+        return this.ops.DoByID(PersistentVolumeType, id);
+    }
+
+    Delete(container: PersistentVolume): Promise<void> {
+        return this.ops.DoResourceDelete(PersistentVolumeType, container);
+    }
+}
+
+export const TopologySelectorLabelRequirementType = "topologySelectorLabelRequirement";
+export const TopologySelectorLabelRequirementFieldKey = "key";
+export const TopologySelectorLabelRequirementFieldValues = "values";
+
+export interface TopologySelectorLabelRequirement {
+    key?: string;
+    values?: string[];
+}
+
+export const TopologySelectorTermType = "topologySelectorTerm";
+export const TopologySelectorTermFieldMatchLabelExpressions = "matchLabelExpressions";
+
+export interface TopologySelectorTerm {
+    matchLabelExpressions?: TopologySelectorLabelRequirement[];
+}
+
+export const StorageClassType = "storageClass";
+export const StorageClassFieldAllowVolumeExpansion = "allowVolumeExpansion";
+export const StorageClassFieldAllowedTopologies = "allowedTopologies";
+export const StorageClassFieldAnnotations = "annotations";
+export const StorageClassFieldCreated = "created";
+export const StorageClassFieldCreatorID = "creatorId";
+export const StorageClassFieldDescription = "description";
+export const StorageClassFieldLabels = "labels";
+export const StorageClassFieldMountOptions = "mountOptions";
+export const StorageClassFieldName = "name";
+export const StorageClassFieldOwnerReferences = "ownerReferences";
+export const StorageClassFieldParameters = "parameters";
+export const StorageClassFieldProvisioner = "provisioner";
+export const StorageClassFieldReclaimPolicy = "reclaimPolicy";
+export const StorageClassFieldRemoved = "removed";
+export const StorageClassFieldUUID = "uuid";
+export const StorageClassFieldVolumeBindingMode = "volumeBindingMode";
+
+export interface StorageClass extends ApiResource {
+    allowVolumeExpansion?: boolean;
+    allowedTopologies?: TopologySelectorTerm[];
+    annotations?: { [key: string]: string };
+    created?: string;
+    creatorId?: string;
+    description?: string;
+    labels?: { [key: string]: string };
+    mountOptions?: string[];
+    name?: string;
+    ownerReferences?: OwnerReference[];
+    parameters?: { [key: string]: string };
+    provisioner?: string;
+    reclaimPolicy?: "Recycle" | "Delete" | "Retain";
+    removed?: string;
+    uuid?: string;
+    volumeBindingMode?: string;
+}
+
+export interface StorageClassCollection extends ApiCollection {
+    data?: StorageClass[];
+}
+
+export class StorageClassClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<StorageClassCollection> {
+        return this.ops.DoList(StorageClassType, opts);
+    }
+
+    Next(collection: StorageClassCollection): Promise<StorageClassCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: StorageClass): Promise<StorageClass> {
+        return this.ops.DoCreate(StorageClassType, container);
+    }
+
+    Update(existing: StorageClass, updates: object): Promise<StorageClass> {
+        return this.ops.DoUpdate(StorageClassType, existing, updates);
+    }
+
+    Replace(obj: StorageClass): Promise<StorageClass> {
+        return this.ops.DoReplace(StorageClassType, obj, obj);
+    }
+
+    ByID(id: string): Promise<StorageClass> {
+        return this.ops.DoByID(StorageClassType, id);
+    }
+
+    Delete(container: StorageClass): Promise<void> {
+        return this.ops.DoResourceDelete(StorageClassType, container);
+    }
+}
+
+export const ClusterAuthTokenType = "clusterAuthToken";
+export const ClusterAuthTokenFieldAnnotations = "annotations";
+export const ClusterAuthTokenFieldCreated = "created";
+export const ClusterAuthTokenFieldCreatorID = "creatorId";
+export const ClusterAuthTokenFieldEnabled = "enabled";
+export const ClusterAuthTokenFieldExpiresAt = "expiresAt";
+export const ClusterAuthTokenFieldLabels = "labels";
+export const ClusterAuthTokenFieldName = "name";
+export const ClusterAuthTokenFieldNamespaceId = "namespaceId";
+export const ClusterAuthTokenFieldOwnerReferences = "ownerReferences";
+export const ClusterAuthTokenFieldRemoved = "removed";
+export const ClusterAuthTokenFieldSecretKeyHash = "hash";
+export const ClusterAuthTokenFieldUUID = "uuid";
+export const ClusterAuthTokenFieldUserName = "userName";
+
+export interface ClusterAuthToken {
+    annotations?: { [key: string]: string };
+    created?: string;
+    creatorId?: string;
+    enabled?: boolean;
+    expiresAt?: string;
+    labels?: { [key: string]: string };
+    name?: string;
+    namespaceId?: string;
+    ownerReferences?: OwnerReference[];
+    removed?: string;
+    hash?: string;
+    uuid?: string;
+    userName?: string;
+}
+
+export const ClusterUserAttributeType = "clusterUserAttribute";
+export const ClusterUserAttributeFieldAnnotations = "annotations";
+export const ClusterUserAttributeFieldCreated = "created";
+export const ClusterUserAttributeFieldCreatorID = "creatorId";
+export const ClusterUserAttributeFieldEnabled = "enabled";
+export const ClusterUserAttributeFieldGroups = "groups";
+export const ClusterUserAttributeFieldLabels = "labels";
+export const ClusterUserAttributeFieldLastRefresh = "lastRefresh";
+export const ClusterUserAttributeFieldName = "name";
+export const ClusterUserAttributeFieldNamespaceId = "namespaceId";
+export const ClusterUserAttributeFieldNeedsRefresh = "needsRefresh";
+export const ClusterUserAttributeFieldOwnerReferences = "ownerReferences";
+export const ClusterUserAttributeFieldRemoved = "removed";
+export const ClusterUserAttributeFieldUUID = "uuid";
+
+export interface ClusterUserAttribute {
+    annotations?: { [key: string]: string };
+    created?: string;
+    creatorId?: string;
+    enabled?: boolean;
+    groups?: string[];
+    labels?: { [key: string]: string };
+    lastRefresh?: string;
+    name?: string;
+    namespaceId?: string;
+    needsRefresh?: boolean;
+    ownerReferences?: OwnerReference[];
+    removed?: string;
+    uuid?: string;
+}
+
+export const ServiceReferenceType = "serviceReference";
+export const ServiceReferenceFieldName = "name";
+export const ServiceReferenceFieldNamespace = "namespace";
+export const ServiceReferenceFieldPort = "port";
+
+export interface ServiceReference {
+    name?: string;
+    namespace?: string;
+    port?: number;
+}
+
+export const APIServiceSpecType = "apiServiceSpec";
+export const APIServiceSpecFieldCABundle = "caBundle";
+export const APIServiceSpecFieldGroup = "group";
+export const APIServiceSpecFieldGroupPriorityMinimum = "groupPriorityMinimum";
+export const APIServiceSpecFieldInsecureSkipTLSVerify = "insecureSkipTLSVerify";
+export const APIServiceSpecFieldService = "service";
+export const APIServiceSpecFieldVersion = "version";
+export const APIServiceSpecFieldVersionPriority = "versionPriority";
+
+export interface APIServiceSpec {
+    caBundle?: string;
+    group?: string;
+    groupPriorityMinimum?: number;
+    insecureSkipTLSVerify?: boolean;
+    service?: ServiceReference;
+    version?: string;
+    versionPriority?: number;
+}
+
+export const APIServiceConditionType = "apiServiceCondition";
+export const APIServiceConditionFieldLastTransitionTime = "lastTransitionTime";
+export const APIServiceConditionFieldMessage = "message";
+export const APIServiceConditionFieldReason = "reason";
+export const APIServiceConditionFieldStatus = "status";
+export const APIServiceConditionFieldType = "type";
+
+export interface APIServiceCondition {
+    lastTransitionTime?: string;
+    message?: string;
+    reason?: string;
+    status?: string;
+    type?: string;
+}
+
+export const APIServiceStatusType = "apiServiceStatus";
+export const APIServiceStatusFieldConditions = "conditions";
+
+export interface APIServiceStatus {
+    conditions?: APIServiceCondition[];
+}
+
+export const APIServiceType = "apiService";
+export const APIServiceFieldAnnotations = "annotations";
+export const APIServiceFieldCABundle = "caBundle";
+export const APIServiceFieldConditions = "conditions";
+export const APIServiceFieldCreated = "created";
+export const APIServiceFieldCreatorID = "creatorId";
+export const APIServiceFieldGroup = "group";
+export const APIServiceFieldGroupPriorityMinimum = "groupPriorityMinimum";
+export const APIServiceFieldInsecureSkipTLSVerify = "insecureSkipTLSVerify";
+export const APIServiceFieldLabels = "labels";
+export const APIServiceFieldName = "name";
+export const APIServiceFieldOwnerReferences = "ownerReferences";
+export const APIServiceFieldRemoved = "removed";
+export const APIServiceFieldService = "service";
+export const APIServiceFieldState = "state";
+export const APIServiceFieldTransitioning = "transitioning";
+export const APIServiceFieldTransitioningMessage = "transitioningMessage";
+export const APIServiceFieldUUID = "uuid";
+export const APIServiceFieldVersion = "version";
+export const APIServiceFieldVersionPriority = "versionPriority";
+
+export interface APIService extends ApiResource {
+    annotations?: { [key: string]: string };
+    caBundle?: string;
+    conditions?: APIServiceCondition[];
+    created?: string;
+    creatorId?: string;
+    group?: string;
+    groupPriorityMinimum?: number;
+    insecureSkipTLSVerify?: boolean;
+    labels?: { [key: string]: string };
+    name?: string;
+    ownerReferences?: OwnerReference[];
+    removed?: string;
+    service?: ServiceReference;
+    state?: string;
+    transitioning?: "yes" | "no" | "error";
+    transitioningMessage?: string;
+    uuid?: string;
+    version?: string;
+    versionPriority?: number;
+}
+
+export interface APIServiceCollection extends ApiCollection {
+    data?: APIService[];
+}
+
+export class APIServiceClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<APIServiceCollection> {
+        return this.ops.DoList(APIServiceType, opts);
+    }
+
+    Next(collection: APIServiceCollection): Promise<APIServiceCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: APIService): Promise<APIService> {
+        return this.ops.DoCreate(APIServiceType, container);
+    }
+
+    Update(existing: APIService, updates: object): Promise<APIService> {
+        return this.ops.DoUpdate(APIServiceType, existing, updates);
+    }
+
+    Replace(obj: APIService): Promise<APIService> {
+        return this.ops.DoReplace(APIServiceType, obj, obj);
+    }
+
+    ByID(id: string): Promise<APIService> {
+        return this.ops.DoByID(APIServiceType, id);
+    }
+
+    Delete(container: APIService): Promise<void> {
+        return this.ops.DoResourceDelete(APIServiceType, container);
+    }
+}
+
+export class Client {
+    Namespace: NamespaceClient;
+    PersistentVolume: PersistentVolumeClient;
+    StorageClass: StorageClassClient;
+    APIService: APIServiceClient;
+
+    constructor(ops: ApiOps) {
+        this.Namespace = new NamespaceClient(ops);
+        this.PersistentVolume = new PersistentVolumeClient(ops);
+        this.StorageClass = new StorageClassClient(ops);
+        this.APIService = new APIServiceClient(ops);
+    }
+}
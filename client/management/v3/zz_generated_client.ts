@@ -0,0 +1,10888 @@
+// Code generated from the management.cattle.io/v3 schemas. DO NOT EDIT.
+
+// The Api types are the norman API types shared by all schemas, their prefix
+// keeps them apart from schema types of the same name, such as Condition.
+
+export interface ApiResource {
+    id?: string;
+    type?: string;
+    links?: { [key: string]: string };
+    actions?: { [key: string]: string };
+}
+
+export interface ApiPagination {
+    marker?: string;
+    first?: string;
+    previous?: string;
+    next?: string;
+    last?: string;
+    limit?: number;
+    total?: number;
+    partial?: boolean;
+}
+
+export interface ApiSort {
+    name?: string;
+    order?: string;
+    reverse?: string;
+    links?: { [key: string]: string };
+}
+
+export interface ApiCondition {
+    modifier?: string;
+    value?: any;
+}
+
+export interface ApiCollection {
+    type?: string;
+    links?: { [key: string]: string };
+    createTypes?: { [key: string]: string };
+    actions?: { [key: string]: string };
+    pagination?: ApiPagination;
+    sort?: ApiSort;
+    filters?: { [key: string]: ApiCondition[] };
+    resourceType?: string;
+}
+
+export interface ApiListOpts {
+    filters?: { [key: string]: any };
+}
+
+// ApiOps performs the HTTP requests of the clients, it mirrors the
+// APIOperations of the Go client.
+export interface ApiOps {
+    DoList(schemaType: string, opts?: ApiListOpts): Promise<any>;
+    DoNext(nextURL: string): Promise<any>;
+    DoCreate(schemaType: string, createObj: object): Promise<any>;
+    DoReplace(schemaType: string, existing: ApiResource, updates: object): Promise<any>;
+    DoUpdate(schemaType: string, existing: ApiResource, updates: object): Promise<any>;
+    DoByID(schemaType: string, id: string): Promise<any>;
+    DoResourceDelete(schemaType: string, existing: ApiResource): Promise<void>;
+    DoAction(schemaType: string, action: string, existing: ApiResource, input?: object): Promise<any>;
+    DoCollectionAction(schemaType: string, action: string, existing: ApiCollection, input?: object): Promise<any>;
+}
+
+export const TaintType = "taint";
+export const TaintFieldEffect = "effect";
+export const TaintFieldKey = "key";
+export const TaintFieldTimeAdded = "timeAdded";
+export const TaintFieldValue = "value";
+
+export interface Taint {
+    effect?: string;
+    key?: string;
+    timeAdded?: string;
+    value?: string;
+}
+
+export const ConfigMapNodeConfigSourceType = "configMapNodeConfigSource";
+export const ConfigMapNodeConfigSourceFieldKubeletConfigKey = "kubeletConfigKey";
+export const ConfigMapNodeConfigSourceFieldName = "name";
+export const ConfigMapNodeConfigSourceFieldNamespace = "namespace";
+export const ConfigMapNodeConfigSourceFieldResourceVersion = "resourceVersion";
+export const ConfigMapNodeConfigSourceFieldUID = "uid";
+
+export interface ConfigMapNodeConfigSource {
+    kubeletConfigKey?: string;
+    name?: string;
+    namespace?: string;
+    resourceVersion?: string;
+    uid?: string;
+}
+
+export const NodeConfigSourceType = "nodeConfigSource";
+export const NodeConfigSourceFieldConfigMap = "configMap";
+
+export interface NodeConfigSource {
+    configMap?: ConfigMapNodeConfigSource;
+}
+
+export const InternalNodeSpecType = "internalNodeSpec";
+export const InternalNodeSpecFieldPodCidr = "podCidr";
+export const InternalNodeSpecFieldPodCidrs = "podCidrs";
+export const InternalNodeSpecFieldProviderId = "providerId";
+export const InternalNodeSpecFieldTaints = "taints";
+export const InternalNodeSpecFieldUnschedulable = "unschedulable";
+
+export interface InternalNodeSpec {
+    podCidr?: string;
+    podCidrs?: string[];
+    providerId?: string;
+    taints?: Taint[];
+    unschedulable?: boolean;
+}
+
+export const NodeConditionType = "nodeCondition";
+export const NodeConditionFieldLastHeartbeatTime = "lastHeartbeatTime";
+export const NodeConditionFieldLastTransitionTime = "lastTransitionTime";
+export const NodeConditionFieldMessage = "message";
+export const NodeConditionFieldReason = "reason";
+export const NodeConditionFieldStatus = "status";
+export const NodeConditionFieldType = "type";
+
+export interface NodeCondition {
+    lastHeartbeatTime?: string;
+    lastTransitionTime?: string;
+    message?: string;
+    reason?: string;
+    status?: string;
+    type?: string;
+}
+
+export const NodeAddressType = "nodeAddress";
+export const NodeAddressFieldAddress = "address";
+export const NodeAddressFieldType = "type";
+
+export interface NodeAddress {
+    address?: string;
+    type?: string;
+}
+
+export const DaemonEndpointType = "daemonEndpoint";
+export const DaemonEndpointFieldPort = "Port";
+
+export interface DaemonEndpoint {
+    Port?: number;
+}
+
+export const NodeDaemonEndpointsType = "nodeDaemonEndpoints";
+export const NodeDaemonEndpointsFieldKubeletEndpoint = "kubeletEndpoint";
+
+export interface NodeDaemonEndpoints {
+    kubeletEndpoint?: DaemonEndpoint;
+}
+
+export const NodeSystemInfoType = "nodeSystemInfo";
+export const NodeSystemInfoFieldArchitecture = "architecture";
+export const NodeSystemInfoFieldBootID = "bootID";
+export const NodeSystemInfoFieldContainerRuntimeVersion = "containerRuntimeVersion";
+export const NodeSystemInfoFieldKernelVersion = "kernelVersion";
+export const NodeSystemInfoFieldKubeProxyVersion = "kubeProxyVersion";
+export const NodeSystemInfoFieldKubeletVersion = "kubeletVersion";
+export const NodeSystemInfoFieldMachineID = "machineID";
+export const NodeSystemInfoFieldOSImage = "osImage";
+export const NodeSystemInfoFieldOperatingSystem = "operatingSystem";
+export const NodeSystemInfoFieldSystemUUID = "systemUUID";
+
+export interface NodeSystemInfo {
+    architecture?: string;
+    bootID?: string;
+    containerRuntimeVersion?: string;
+    kernelVersion?: string;
+    kubeProxyVersion?: string;
+    kubeletVersion?: string;
+    machineID?: string;
+    osImage?: string;
+    operatingSystem?: string;
+    systemUUID?: string;
+}
+
+export const ContainerImageType = "containerImage";
+export const ContainerImageFieldNames = "names";
+export const ContainerImageFieldSizeBytes = "sizeBytes";
+
+export interface ContainerImage {
+    names?: string[];
+    sizeBytes?: number;
+}
+
+export const AttachedVolumeType = "attachedVolume";
+export const AttachedVolumeFieldName = "name";
+
+export interface AttachedVolume {
+    name?: string;
+}
+
+export const NodeConfigStatusType = "nodeConfigStatus";
+export const NodeConfigStatusFieldActive = "active";
+export const NodeConfigStatusFieldAssigned = "assigned";
+export const NodeConfigStatusFieldError = "error";
+export const NodeConfigStatusFieldLastKnownGood = "lastKnownGood";
+
+export interface NodeConfigStatus {
+    active?: NodeConfigSource;
+    assigned?: NodeConfigSource;
+    error?: string;
+    lastKnownGood?: NodeConfigSource;
+}
+
+export const CPUInfoType = "cpuInfo";
+export const CPUInfoFieldCount = "count";
+
+export interface CPUInfo {
+    count?: number;
+}
+
+export const MemoryInfoType = "memoryInfo";
+export const MemoryInfoFieldMemTotalKiB = "memTotalKiB";
+
+export interface MemoryInfo {
+    memTotalKiB?: number;
+}
+
+export const OSInfoType = "osInfo";
+export const OSInfoFieldDockerVersion = "dockerVersion";
+export const OSInfoFieldKernelVersion = "kernelVersion";
+export const OSInfoFieldOperatingSystem = "operatingSystem";
+
+export interface OSInfo {
+    dockerVersion?: string;
+    kernelVersion?: string;
+    operatingSystem?: string;
+}
+
+export const KubernetesInfoType = "kubernetesInfo";
+export const KubernetesInfoFieldKubeProxyVersion = "kubeProxyVersion";
+export const KubernetesInfoFieldKubeletVersion = "kubeletVersion";
+
+export interface KubernetesInfo {
+    kubeProxyVersion?: string;
+    kubeletVersion?: string;
+}
+
+export const NodeInfoType = "nodeInfo";
+export const NodeInfoFieldCPU = "cpu";
+export const NodeInfoFieldKubernetes = "kubernetes";
+export const NodeInfoFieldMemory = "memory";
+export const NodeInfoFieldOS = "os";
+
+export interface NodeInfo {
+    cpu?: CPUInfo;
+    kubernetes?: KubernetesInfo;
+    memory?: MemoryInfo;
+    os?: OSInfo;
+}
+
+export const InternalNodeStatusType = "internalNodeStatus";
+export const InternalNodeStatusFieldAllocatable = "allocatable";
+export const InternalNodeStatusFieldCapacity = "capacity";
+export const InternalNodeStatusFieldConfig = "config";
+export const InternalNodeStatusFieldExternalIPAddress = "externalIpAddress";
+export const InternalNodeStatusFieldHostname = "hostname";
+export const InternalNodeStatusFieldIPAddress = "ipAddress";
+export const InternalNodeStatusFieldInfo = "info";
+export const InternalNodeStatusFieldNodeConditions = "nodeConditions";
+export const InternalNodeStatusFieldVolumesAttached = "volumesAttached";
+export const InternalNodeStatusFieldVolumesInUse = "volumesInUse";
+
+export interface InternalNodeStatus {
+    allocatable?: { [key: string]: string };
+    capacity?: { [key: string]: string };
+    config?: NodeConfigStatus;
+    externalIpAddress?: string;
+    hostname?: string;
+    ipAddress?: string;
+    info?: NodeInfo;
+    nodeConditions?: NodeCondition[];
+    volumesAttached?: { [key: string]: AttachedVolume };
+    volumesInUse?: string[];
+}
+
+export const PublicEndpointType = "publicEndpoint";
+export const PublicEndpointFieldAddresses = "addresses";
+export const PublicEndpointFieldAllNodes = "allNodes";
+export const PublicEndpointFieldHostname = "hostname";
+export const PublicEndpointFieldIngressID = "ingressId";
+export const PublicEndpointFieldNodeID = "nodeId";
+export const PublicEndpointFieldPath = "path";
+export const PublicEndpointFieldPodID = "podId";
+export const PublicEndpointFieldPort = "port";
+export const PublicEndpointFieldProtocol = "protocol";
+export const PublicEndpointFieldServiceID = "serviceId";
+
+export interface PublicEndpoint {
+    addresses?: string[];
+    allNodes?: boolean;
+    hostname?: string;
+    ingressId?: string;
+    nodeId?: string;
+    path?: string;
+    podId?: string;
+    port?: number;
+    protocol?: string;
+    serviceId?: string;
+}
+
+export const OwnerReferenceType = "ownerReference";
+export const OwnerReferenceFieldAPIVersion = "apiVersion";
+export const OwnerReferenceFieldBlockOwnerDeletion = "blockOwnerDeletion";
+export const OwnerReferenceFieldController = "controller";
+export const OwnerReferenceFieldKind = "kind";
+export const OwnerReferenceFieldName = "name";
+export const OwnerReferenceFieldUID = "uid";
+
+export interface OwnerReference {
+    apiVersion?: string;
+    blockOwnerDeletion?: boolean;
+    controller?: boolean;
+    kind?: string;
+    name?: string;
+    uid?: string;
+}
+
+export const ObjectMetaType = "objectMeta";
+export const ObjectMetaFieldAnnotations = "annotations";
+export const ObjectMetaFieldCreated = "created";
+export const ObjectMetaFieldFinalizers = "finalizers";
+export const ObjectMetaFieldLabels = "labels";
+export const ObjectMetaFieldName = "name";
+export const ObjectMetaFieldNamespace = "namespace";
+export const ObjectMetaFieldOwnerReferences = "ownerReferences";
+export const ObjectMetaFieldRemoved = "removed";
+export const ObjectMetaFieldSelfLink = "selfLink";
+export const ObjectMetaFieldUUID = "uuid";
+
+export interface ObjectMeta {
+    annotations?: { [key: string]: string };
+    created?: string;
+    finalizers?: string[];
+    labels?: { [key: string]: string };
+    name?: string;
+    namespace?: string;
+    ownerReferences?: OwnerReference[];
+    removed?: string;
+    selfLink?: string;
+    uuid?: string;
+}
+
+export const NodePoolSpecType = "nodePoolSpec";
+export const NodePoolSpecFieldClusterID = "clusterId";
+export const NodePoolSpecFieldControlPlane = "controlPlane";
+export const NodePoolSpecFieldDeleteNotReadyAfterSecs = "deleteNotReadyAfterSecs";
+export const NodePoolSpecFieldDisplayName = "displayName";
+export const NodePoolSpecFieldEtcd = "etcd";
+export const NodePoolSpecFieldHostnamePrefix = "hostnamePrefix";
+export const NodePoolSpecFieldNodeAnnotations = "nodeAnnotations";
+export const NodePoolSpecFieldNodeLabels = "nodeLabels";
+export const NodePoolSpecFieldNodeTaints = "nodeTaints";
+export const NodePoolSpecFieldNodeTemplateID = "nodeTemplateId";
+export const NodePoolSpecFieldQuantity = "quantity";
+export const NodePoolSpecFieldWorker = "worker";
+
+export interface NodePoolSpec {
+    clusterId?: string;
+    controlPlane?: boolean;
+    deleteNotReadyAfterSecs?: number;
+    displayName?: string;
+    etcd?: boolean;
+    hostnamePrefix?: string;
+    nodeAnnotations?: { [key: string]: string };
+    nodeLabels?: { [key: string]: string };
+    nodeTaints?: Taint[];
+    nodeTemplateId?: string;
+    quantity?: number;
+    worker?: boolean;
+}
+
+export const ConditionType = "condition";
+export const ConditionFieldLastTransitionTime = "lastTransitionTime";
+export const ConditionFieldLastUpdateTime = "lastUpdateTime";
+export const ConditionFieldMessage = "message";
+export const ConditionFieldReason = "reason";
+export const ConditionFieldStatus = "status";
+export const ConditionFieldType = "type";
+
+export interface Condition {
+    lastTransitionTime?: string;
+    lastUpdateTime?: string;
+    message?: string;
+    reason?: string;
+    status?: string;
+    type?: string;
+}
+
+export const NodePoolStatusType = "nodePoolStatus";
+export const NodePoolStatusFieldConditions = "conditions";
+
+export interface NodePoolStatus {
+    conditions?: Condition[];
+}
+
+export const NodePoolType = "nodePool";
+export const NodePoolFieldAnnotations = "annotations";
+export const NodePoolFieldClusterID = "clusterId";
+export const NodePoolFieldControlPlane = "controlPlane";
+export const NodePoolFieldCreated = "created";
+export const NodePoolFieldCreatorID = "creatorId";
+export const NodePoolFieldDeleteNotReadyAfterSecs = "deleteNotReadyAfterSecs";
+export const NodePoolFieldDisplayName = "displayName";
+export const NodePoolFieldDriver = "driver";
+export const NodePoolFieldEtcd = "etcd";
+export const NodePoolFieldHostnamePrefix = "hostnamePrefix";
+export const NodePoolFieldLabels = "labels";
+export const NodePoolFieldName = "name";
+export const NodePoolFieldNamespaceId = "namespaceId";
+export const NodePoolFieldNodeAnnotations = "nodeAnnotations";
+export const NodePoolFieldNodeLabels = "nodeLabels";
+export const NodePoolFieldNodeTaints = "nodeTaints";
+export const NodePoolFieldNodeTemplateID = "nodeTemplateId";
+export const NodePoolFieldOwnerReferences = "ownerReferences";
+export const NodePoolFieldQuantity = "quantity";
+export const NodePoolFieldRemoved = "removed";
+export const NodePoolFieldState = "state";
+export const NodePoolFieldStatus = "status";
+export const NodePoolFieldTransitioning = "transitioning";
+export const NodePoolFieldTransitioningMessage = "transitioningMessage";
+export const NodePoolFieldUUID = "uuid";
+export const NodePoolFieldWorker = "worker";
+
+export interface NodePool extends ApiResource {
+    annotations?: { [key: string]: string };
+    clusterId?: string;
+    controlPlane?: boolean;
+    created?: string;
+    creatorId?: string;
+    deleteNotReadyAfterSecs?: number;
+    displayName?: string;
+    driver?: string;
+    etcd?: boolean;
+    hostnamePrefix?: string;
+    labels?: { [key: string]: string };
+    name?: string;
+    namespaceId?: string;
+    nodeAnnotations?: { [key: string]: string };
+    nodeLabels?: { [key: string]: string };
+    nodeTaints?: Taint[];
+    nodeTemplateId?: string;
+    ownerReferences?: OwnerReference[];
+    quantity?: number;
+    removed?: string;
+    state?: string;
+    status?: NodePoolStatus;
+    transitioning?: "yes" | "no" | "error";
+    transitioningMessage?: string;
+    uuid?: string;
+    worker?: boolean;
+}
+
+export interface NodePoolCollection extends ApiCollection {
+    data?: NodePool[];
+}
+
+export class NodePoolClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<NodePoolCollection> {
+        return this.ops.DoList(NodePoolType, opts);
+    }
+
+    Next(collection: NodePoolCollection): Promise<NodePoolCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: NodePool): Promise<NodePool> {
+        return this.ops.DoCreate(NodePoolType, container);
+    }
+
+    Update(existing: NodePool, updates: object): Promise<NodePool> {
+        return this.ops.DoUpdate(NodePoolType, existing, updates);
+    }
+
+    Replace(obj: NodePool): Promise<NodePool> {
+        return this.ops.DoReplace(NodePoolType, obj, obj);
+    }
+
+    ByID(id: string): Promise<NodePool> {
+        return this.ops.DoByID(NodePoolType, id);
+    }
+
+    Delete(container: NodePool): Promise<void> {
+        return this.ops.DoResourceDelete(NodePoolType, container);
+    }
+}
+
+export const NodeDrainInputType = "nodeDrainInput";
+export const NodeDrainInputFieldDeleteLocalData = "deleteLocalData";
+export const NodeDrainInputFieldForce = "force";
+export const NodeDrainInputFieldGracePeriod = "gracePeriod";
+export const NodeDrainInputFieldIgnoreDaemonSets = "ignoreDaemonSets";
+export const NodeDrainInputFieldTimeout = "timeout";
+
+export interface NodeDrainInput {
+    deleteLocalData?: boolean;
+    force?: boolean;
+    gracePeriod?: number;
+    ignoreDaemonSets?: boolean;
+    timeout?: number;
+}
+
+export const CustomConfigType = "customConfig";
+export const CustomConfigFieldAddress = "address";
+export const CustomConfigFieldDockerSocket = "dockerSocket";
+export const CustomConfigFieldInternalAddress = "internalAddress";
+export const CustomConfigFieldLabel = "label";
+export const CustomConfigFieldSSHCert = "sshCert";
+export const CustomConfigFieldSSHKey = "sshKey";
+export const CustomConfigFieldTaints = "taints";
+export const CustomConfigFieldUser = "user";
+
+export interface CustomConfig {
+    address?: string;
+    dockerSocket?: string;
+    internalAddress?: string;
+    label?: { [key: string]: string };
+    sshCert?: string;
+    sshKey?: string;
+    taints?: string[];
+    user?: string;
+}
+
+export const MapDeltaType = "mapDelta";
+export const MapDeltaFieldAdd = "add";
+export const MapDeltaFieldDelete = "delete";
+
+export interface MapDelta {
+    add?: { [key: string]: string };
+    delete?: { [key: string]: boolean };
+}
+
+export const MetadataUpdateType = "metadataUpdate";
+export const MetadataUpdateFieldAnnotations = "annotations";
+export const MetadataUpdateFieldLabels = "labels";
+
+export interface MetadataUpdate {
+    annotations?: MapDelta;
+    labels?: MapDelta;
+}
+
+export const NodeSpecType = "nodeSpec";
+export const NodeSpecFieldControlPlane = "controlPlane";
+export const NodeSpecFieldCustomConfig = "customConfig";
+export const NodeSpecFieldDescription = "description";
+export const NodeSpecFieldDesiredNodeTaints = "desiredNodeTaints";
+export const NodeSpecFieldDesiredNodeUnschedulable = "desiredNodeUnschedulable";
+export const NodeSpecFieldDisplayName = "displayName";
+export const NodeSpecFieldEtcd = "etcd";
+export const NodeSpecFieldImported = "imported";
+export const NodeSpecFieldMetadataUpdate = "metadataUpdate";
+export const NodeSpecFieldNodeDrainInput = "nodeDrainInput";
+export const NodeSpecFieldNodePoolID = "nodePoolId";
+export const NodeSpecFieldNodeTemplateID = "nodeTemplateId";
+export const NodeSpecFieldPodCidr = "podCidr";
+export const NodeSpecFieldPodCidrs = "podCidrs";
+export const NodeSpecFieldProviderId = "providerId";
+export const NodeSpecFieldRequestedHostname = "requestedHostname";
+export const NodeSpecFieldTaints = "taints";
+export const NodeSpecFieldUnschedulable = "unschedulable";
+export const NodeSpecFieldUpdateTaintsFromAPI = "updateTaintsFromAPI";
+export const NodeSpecFieldWorker = "worker";
+
+export interface NodeSpec {
+    controlPlane?: boolean;
+    customConfig?: CustomConfig;
+    description?: string;
+    desiredNodeTaints?: Taint[];
+    desiredNodeUnschedulable?: string;
+    displayName?: string;
+    etcd?: boolean;
+    imported?: boolean;
+    metadataUpdate?: MetadataUpdate;
+    nodeDrainInput?: NodeDrainInput;
+    nodePoolId?: string;
+    nodeTemplateId?: string;
+    podCidr?: string;
+    podCidrs?: string[];
+    providerId?: string;
+    requestedHostname?: string;
+    taints?: Taint[];
+    unschedulable?: boolean;
+    updateTaintsFromAPI?: boolean;
+    worker?: boolean;
+}
+
+export const NodeTemplateSpecType = "nodeTemplateSpec";
+export const NodeTemplateSpecFieldAuthCertificateAuthority = "authCertificateAuthority";
+export const NodeTemplateSpecFieldAuthKey = "authKey";
+export const NodeTemplateSpecFieldCloudCredentialID = "cloudCredentialId";
+export const NodeTemplateSpecFieldDescription = "description";
+export const NodeTemplateSpecFieldDisplayName = "displayName";
+export const NodeTemplateSpecFieldDockerVersion = "dockerVersion";
+export const NodeTemplateSpecFieldDriver = "driver";
+export const NodeTemplateSpecFieldEngineEnv = "engineEnv";
+export const NodeTemplateSpecFieldEngineInsecureRegistry = "engineInsecureRegistry";
+export const NodeTemplateSpecFieldEngineInstallURL = "engineInstallURL";
+export const NodeTemplateSpecFieldEngineLabel = "engineLabel";
+export const NodeTemplateSpecFieldEngineOpt = "engineOpt";
+export const NodeTemplateSpecFieldEngineRegistryMirror = "engineRegistryMirror";
+export const NodeTemplateSpecFieldEngineStorageDriver = "engineStorageDriver";
+export const NodeTemplateSpecFieldNodeTaints = "nodeTaints";
+export const NodeTemplateSpecFieldUseInternalIPAddress = "useInternalIpAddress";
+
+export interface NodeTemplateSpec {
+    authCertificateAuthority?: string;
+    authKey?: string;
+    cloudCredentialId?: string;
+    description?: string;
+    displayName?: string;
+    dockerVersion?: string;
+    driver?: string;
+    engineEnv?: { [key: string]: string };
+    engineInsecureRegistry?: string[];
+    engineInstallURL?: string;
+    engineLabel?: { [key: string]: string };
+    engineOpt?: { [key: string]: string };
+    engineRegistryMirror?: string[];
+    engineStorageDriver?: string;
+    nodeTaints?: Taint[];
+    useInternalIpAddress?: boolean;
+}
+
+export const RKETaintType = "rkeTaint";
+export const RKETaintFieldEffect = "effect";
+export const RKETaintFieldKey = "key";
+export const RKETaintFieldTimeAdded = "timeAdded";
+export const RKETaintFieldValue = "value";
+
+export interface RKETaint {
+    effect?: string;
+    key?: string;
+    timeAdded?: string;
+    value?: string;
+}
+
+export const RKEConfigNodeType = "rkeConfigNode";
+export const RKEConfigNodeFieldAddress = "address";
+export const RKEConfigNodeFieldDockerSocket = "dockerSocket";
+export const RKEConfigNodeFieldHostnameOverride = "hostnameOverride";
+export const RKEConfigNodeFieldInternalAddress = "internalAddress";
+export const RKEConfigNodeFieldLabels = "labels";
+export const RKEConfigNodeFieldNodeID = "nodeId";
+export const RKEConfigNodeFieldPort = "port";
+export const RKEConfigNodeFieldRole = "role";
+export const RKEConfigNodeFieldSSHAgentAuth = "sshAgentAuth";
+export const RKEConfigNodeFieldSSHCert = "sshCert";
+export const RKEConfigNodeFieldSSHCertPath = "sshCertPath";
+export const RKEConfigNodeFieldSSHKey = "sshKey";
+export const RKEConfigNodeFieldSSHKeyPath = "sshKeyPath";
+export const RKEConfigNodeFieldTaints = "taints";
+export const RKEConfigNodeFieldUser = "user";
+
+export interface RKEConfigNode {
+    address?: string;
+    dockerSocket?: string;
+    hostnameOverride?: string;
+    internalAddress?: string;
+    labels?: { [key: string]: string };
+    nodeId?: string;
+    port?: string;
+    role?: string[];
+    sshAgentAuth?: boolean;
+    sshCert?: string;
+    sshCertPath?: string;
+    sshKey?: string;
+    sshKeyPath?: string;
+    taints?: RKETaint[];
+    user?: string;
+}
+
+export const DockerInfoType = "dockerInfo";
+export const DockerInfoFieldArchitecture = "architecture";
+export const DockerInfoFieldCgroupDriver = "cgroupDriver";
+export const DockerInfoFieldDebug = "debug";
+export const DockerInfoFieldDockerRootDir = "dockerRootDir";
+export const DockerInfoFieldDriver = "driver";
+export const DockerInfoFieldExperimentalBuild = "experimentalBuild";
+export const DockerInfoFieldHTTPProxy = "httpProxy";
+export const DockerInfoFieldHTTPSProxy = "httpsProxy";
+export const DockerInfoFieldIndexServerAddress = "indexServerAddress";
+export const DockerInfoFieldKernelVersion = "kernelVersion";
+export const DockerInfoFieldLabels = "labels";
+export const DockerInfoFieldLoggingDriver = "loggingDriver";
+export const DockerInfoFieldName = "name";
+export const DockerInfoFieldNoProxy = "noProxy";
+export const DockerInfoFieldOSType = "osType";
+export const DockerInfoFieldOperatingSystem = "operatingSystem";
+export const DockerInfoFieldServerVersion = "serverVersion";
+
+export interface DockerInfo {
+    architecture?: string;
+    cgroupDriver?: string;
+    debug?: boolean;
+    dockerRootDir?: string;
+    driver?: string;
+    experimentalBuild?: boolean;
+    httpProxy?: string;
+    httpsProxy?: string;
+    indexServerAddress?: string;
+    kernelVersion?: string;
+    labels?: string[];
+    loggingDriver?: string;
+    name?: string;
+    noProxy?: string;
+    osType?: string;
+    operatingSystem?: string;
+    serverVersion?: string;
+}
+
+export const NodeStatusType = "nodeStatus";
+export const NodeStatusFieldAllocatable = "allocatable";
+export const NodeStatusFieldCapacity = "capacity";
+export const NodeStatusFieldConditions = "conditions";
+export const NodeStatusFieldDockerInfo = "dockerInfo";
+export const NodeStatusFieldExternalIPAddress = "externalIpAddress";
+export const NodeStatusFieldHostname = "hostname";
+export const NodeStatusFieldIPAddress = "ipAddress";
+export const NodeStatusFieldInfo = "info";
+export const NodeStatusFieldLimits = "limits";
+export const NodeStatusFieldNodeAnnotations = "nodeAnnotations";
+export const NodeStatusFieldNodeConfig = "rkeNode";
+export const NodeStatusFieldNodeLabels = "nodeLabels";
+export const NodeStatusFieldNodeName = "nodeName";
+export const NodeStatusFieldNodeTaints = "nodeTaints";
+export const NodeStatusFieldRequested = "requested";
+export const NodeStatusFieldVolumesAttached = "volumesAttached";
+export const NodeStatusFieldVolumesInUse = "volumesInUse";
+
+export interface NodeStatus {
+    allocatable?: { [key: string]: string };
+    capacity?: { [key: string]: string };
+    conditions?: NodeCondition[];
+    dockerInfo?: DockerInfo;
+    externalIpAddress?: string;
+    hostname?: string;
+    ipAddress?: string;
+    info?: NodeInfo;
+    limits?: { [key: string]: string };
+    nodeAnnotations?: { [key: string]: string };
+    rkeNode?: RKEConfigNode;
+    nodeLabels?: { [key: string]: string };
+    nodeName?: string;
+    nodeTaints?: Taint[];
+    requested?: { [key: string]: string };
+    volumesAttached?: { [key: string]: AttachedVolume };
+    volumesInUse?: string[];
+}
+
+export const NodeType = "node";
+export const NodeFieldAllocatable = "allocatable";
+export const NodeFieldAnnotations = "annotations";
+export const NodeFieldCapacity = "capacity";
+export const NodeFieldClusterID = "clusterId";
+export const NodeFieldConditions = "conditions";
+export const NodeFieldControlPlane = "controlPlane";
+export const NodeFieldCreated = "created";
+export const NodeFieldCreatorID = "creatorId";
+export const NodeFieldCustomConfig = "customConfig";
+export const NodeFieldDescription = "description";
+export const NodeFieldDockerInfo = "dockerInfo";
+export const NodeFieldEtcd = "etcd";
+export const NodeFieldExternalIPAddress = "externalIpAddress";
+export const NodeFieldHostname = "hostname";
+export const NodeFieldIPAddress = "ipAddress";
+export const NodeFieldImported = "imported";
+export const NodeFieldInfo = "info";
+export const NodeFieldLabels = "labels";
+export const NodeFieldLimits = "limits";
+export const NodeFieldName = "name";
+export const NodeFieldNamespaceId = "namespaceId";
+export const NodeFieldNodeName = "nodeName";
+export const NodeFieldNodePoolID = "nodePoolId";
+export const NodeFieldNodeTaints = "nodeTaints";
+export const NodeFieldNodeTemplateID = "nodeTemplateId";
+export const NodeFieldOwnerReferences = "ownerReferences";
+export const NodeFieldPodCidr = "podCidr";
+export const NodeFieldPodCidrs = "podCidrs";
+export const NodeFieldProviderId = "providerId";
+export const NodeFieldPublicEndpoints = "publicEndpoints";
+export const NodeFieldRemoved = "removed";
+export const NodeFieldRequested = "requested";
+export const NodeFieldRequestedHostname = "requestedHostname";
+export const NodeFieldSshUser = "sshUser";
+export const NodeFieldState = "state";
+export const NodeFieldTaints = "taints";
+export const NodeFieldTransitioning = "transitioning";
+export const NodeFieldTransitioningMessage = "transitioningMessage";
+export const NodeFieldUUID = "uuid";
+export const NodeFieldUnschedulable = "unschedulable";
+export const NodeFieldVolumesAttached = "volumesAttached";
+export const NodeFieldVolumesInUse = "volumesInUse";
+export const NodeFieldWorker = "worker";
+export const NodeActionCordon = "cordon";
+export const NodeActionDrain = "drain";
+export const NodeActionStopDrain = "stopDrain";
+export const NodeActionUncordon = "uncordon";
+
+export interface Node extends ApiResource {
+    allocatable?: { [key: string]: string };
+    annotations?: { [key: string]: string };
+    capacity?: { [key: string]: string };
+    clusterId?: string;
+    conditions?: NodeCondition[];
+    controlPlane?: boolean;
+    created?: string;
+    creatorId?: string;
+    customConfig?: CustomConfig;
+    description?: string;
+    dockerInfo?: DockerInfo;
+    etcd?: boolean;
+    externalIpAddress?: string;
+    hostname?: string;
+    ipAddress?: string;
+    imported?: boolean;
+    info?: NodeInfo;
+    labels?: { [key: string]: string };
+    limits?: { [key: string]: string };
+    name?: string;
+    namespaceId?: string;
+    nodeName?: string;
+    nodePoolId?: string;
+    nodeTaints?: Taint[];
+    nodeTemplateId?: string;
+    ownerReferences?: OwnerReference[];
+    podCidr?: string;
+    podCidrs?: string[];
+    providerId?: string;
+    publicEndpoints?: PublicEndpoint[];
+    removed?: string;
+    requested?: { [key: string]: string };
+    requestedHostname?: string;
+    sshUser?: string;
+    state?: string;
+    taints?: Taint[];
+    transitioning?: "yes" | "no" | "error";
+    transitioningMessage?: string;
+    uuid?: string;
+    unschedulable?: boolean;
+    volumesAttached?: { [key: string]: AttachedVolume };
+    volumesInUse?: string[];
+    worker?: boolean;
+}
+
+export interface NodeCollection extends ApiCollection {
+    data?: Node[];
+}
+
+export class NodeClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<NodeCollection> {
+        return this.ops.DoList(NodeType, opts);
+    }
+
+    Next(collection: NodeCollection): Promise<NodeCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: Node): Promise<Node> {
+        return this.ops.DoCreate(NodeType, container);
+    }
+
+    Update(existing: Node, updates: object): Promise<Node> {
+        return this.ops.DoUpdate(NodeType, existing, updates);
+    }
+
+    Replace(obj: Node): Promise<Node> {
+        return this.ops.DoReplace(NodeType, obj, obj);
+    }
+
+    ByID(id: string): Promise<Node> {
+        return this.ops.DoByID(NodeType, id);
+    }
+
+    Delete(container: Node): Promise<void> {
+        return this.ops.DoResourceDelete(NodeType, container);
+    }
+
+    ActionCordon(resource: Node): Promise<void> {
+        return this.ops.DoAction(NodeType, NodeActionCordon, resource);
+    }
+
+    ActionDrain(resource: Node, input: NodeDrainInput): Promise<void> {
+        return this.ops.DoAction(NodeType, NodeActionDrain, resource, input);
+    }
+
+    ActionStopDrain(resource: Node): Promise<void> {
+        return this.ops.DoAction(NodeType, NodeActionStopDrain, resource);
+    }
+
+    ActionUncordon(resource: Node): Promise<void> {
+        return this.ops.DoAction(NodeType, NodeActionUncordon, resource);
+    }
+}
+
+export const NodeDriverSpecType = "nodeDriverSpec";
+export const NodeDriverSpecFieldActive = "active";
+export const NodeDriverSpecFieldBuiltin = "builtin";
+export const NodeDriverSpecFieldChecksum = "checksum";
+export const NodeDriverSpecFieldDescription = "description";
+export const NodeDriverSpecFieldDisplayName = "displayName";
+export const NodeDriverSpecFieldExternalID = "externalId";
+export const NodeDriverSpecFieldUIURL = "uiUrl";
+export const NodeDriverSpecFieldURL = "url";
+export const NodeDriverSpecFieldWhitelistDomains = "whitelistDomains";
+
+export interface NodeDriverSpec {
+    active?: boolean;
+    builtin?: boolean;
+    checksum?: string;
+    description?: string;
+    displayName?: string;
+    externalId?: string;
+    uiUrl?: string;
+    url?: string;
+    whitelistDomains?: string[];
+}
+
+export const NodeDriverStatusType = "nodeDriverStatus";
+export const NodeDriverStatusFieldAppliedChecksum = "appliedChecksum";
+export const NodeDriverStatusFieldAppliedDockerMachineVersion = "appliedDockerMachineVersion";
+export const NodeDriverStatusFieldAppliedURL = "appliedURL";
+export const NodeDriverStatusFieldConditions = "conditions";
+
+export interface NodeDriverStatus {
+    appliedChecksum?: string;
+    appliedDockerMachineVersion?: string;
+    appliedURL?: string;
+    conditions?: Condition[];
+}
+
+export const NodeDriverType = "nodeDriver";
+export const NodeDriverFieldActive = "active";
+export const NodeDriverFieldAnnotations = "annotations";
+export const NodeDriverFieldBuiltin = "builtin";
+export const NodeDriverFieldChecksum = "checksum";
+export const NodeDriverFieldCreated = "created";
+export const NodeDriverFieldCreatorID = "creatorId";
+export const NodeDriverFieldDescription = "description";
+export const NodeDriverFieldExternalID = "externalId";
+export const NodeDriverFieldLabels = "labels";
+export const NodeDriverFieldName = "name";
+export const NodeDriverFieldOwnerReferences = "ownerReferences";
+export const NodeDriverFieldRemoved = "removed";
+export const NodeDriverFieldState = "state";
+export const NodeDriverFieldStatus = "status";
+export const NodeDriverFieldTransitioning = "transitioning";
+export const NodeDriverFieldTransitioningMessage = "transitioningMessage";
+export const NodeDriverFieldUIURL = "uiUrl";
+export const NodeDriverFieldURL = "url";
+export const NodeDriverFieldUUID = "uuid";
+export const NodeDriverFieldWhitelistDomains = "whitelistDomains";
+export const NodeDriverActionActivate = "activate";
+export const NodeDriverActionDeactivate = "deactivate";
+
+export interface NodeDriver extends ApiResource {
+    active?: boolean;
+    annotations?: { [key: string]: string };
+    builtin?: boolean;
+    checksum?: string;
+    created?: string;
+    creatorId?: string;
+    description?: string;
+    externalId?: string;
+    labels?: { [key: string]: string };
+    name?: string;
+    ownerReferences?: OwnerReference[];
+    removed?: string;
+    state?: string;
+    status?: NodeDriverStatus;
+    transitioning?: "yes" | "no" | "error";
+    transitioningMessage?: string;
+    uiUrl?: string;
+    url?: string;
+    uuid?: string;
+    whitelistDomains?: string[];
+}
+
+export interface NodeDriverCollection extends ApiCollection {
+    data?: NodeDriver[];
+}
+
+export class NodeDriverClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<NodeDriverCollection> {
+        return this.ops.DoList(NodeDriverType, opts);
+    }
+
+    Next(collection: NodeDriverCollection): Promise<NodeDriverCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: NodeDriver): Promise<NodeDriver> {
+        return this.ops.DoCreate(NodeDriverType, container);
+    }
+
+    Update(existing: NodeDriver, updates: object): Promise<NodeDriver> {
+        return this.ops.DoUpdate(NodeDriverType, existing, updates);
+    }
+
+    Replace(obj: NodeDriver): Promise<NodeDriver> {
+        return this.ops.DoReplace(NodeDriverType, obj, obj);
+    }
+
+    ByID(id: string): Promise<NodeDriver> {
+        return this.ops.DoByID(NodeDriverType, id);
+    }
+
+    Delete(container: NodeDriver): Promise<void> {
+        return this.ops.DoResourceDelete(NodeDriverType, container);
+    }
+
+    ActionActivate(resource: NodeDriver): Promise<NodeDriver> {
+        return this.ops.DoAction(NodeDriverType, NodeDriverActionActivate, resource);
+    }
+
+    ActionDeactivate(resource: NodeDriver): Promise<NodeDriver> {
+        return this.ops.DoAction(NodeDriverType, NodeDriverActionDeactivate, resource);
+    }
+}
+
+export const NodeTemplateConditionType = "nodeTemplateCondition";
+export const NodeTemplateConditionFieldLastTransitionTime = "lastTransitionTime";
+export const NodeTemplateConditionFieldLastUpdateTime = "lastUpdateTime";
+export const NodeTemplateConditionFieldReason = "reason";
+export const NodeTemplateConditionFieldStatus = "status";
+export const NodeTemplateConditionFieldType = "type";
+
+export interface NodeTemplateCondition {
+    lastTransitionTime?: string;
+    lastUpdateTime?: string;
+    reason?: string;
+    status?: string;
+    type?: string;
+}
+
+export const NodeTemplateStatusType = "nodeTemplateStatus";
+export const NodeTemplateStatusFieldConditions = "conditions";
+
+export interface NodeTemplateStatus {
+    conditions?: NodeTemplateCondition[];
+}
+
+export const NodeTemplateType = "nodeTemplate";
+export const NodeTemplateFieldAnnotations = "annotations";
+export const NodeTemplateFieldAuthCertificateAuthority = "authCertificateAuthority";
+export const NodeTemplateFieldAuthKey = "authKey";
+export const NodeTemplateFieldCloudCredentialID = "cloudCredentialId";
+export const NodeTemplateFieldCreated = "created";
+export const NodeTemplateFieldCreatorID = "creatorId";
+export const NodeTemplateFieldDescription = "description";
+export const NodeTemplateFieldDockerVersion = "dockerVersion";
+export const NodeTemplateFieldDriver = "driver";
+export const NodeTemplateFieldEngineEnv = "engineEnv";
+export const NodeTemplateFieldEngineInsecureRegistry = "engineInsecureRegistry";
+export const NodeTemplateFieldEngineInstallURL = "engineInstallURL";
+export const NodeTemplateFieldEngineLabel = "engineLabel";
+export const NodeTemplateFieldEngineOpt = "engineOpt";
+export const NodeTemplateFieldEngineRegistryMirror = "engineRegistryMirror";
+export const NodeTemplateFieldEngineStorageDriver = "engineStorageDriver";
+export const NodeTemplateFieldLabels = "labels";
+export const NodeTemplateFieldName = "name";
+export const NodeTemplateFieldNodeTaints = "nodeTaints";
+export const NodeTemplateFieldOwnerReferences = "ownerReferences";
+export const NodeTemplateFieldRemoved = "removed";
+export const NodeTemplateFieldState = "state";
+export const NodeTemplateFieldStatus = "status";
+export const NodeTemplateFieldTransitioning = "transitioning";
+export const NodeTemplateFieldTransitioningMessage = "transitioningMessage";
+export const NodeTemplateFieldUUID = "uuid";
+export const NodeTemplateFieldUseInternalIPAddress = "useInternalIpAddress";
+
+export interface NodeTemplate extends ApiResource {
+    annotations?: { [key: string]: string };
+    authCertificateAuthority?: string;
+    authKey?: string;
+    cloudCredentialId?: string;
+    created?: string;
+    creatorId?: string;
+    description?: string;
+    dockerVersion?: string;
+    driver?: string;
+    engineEnv?: { [key: string]: string };
+    engineInsecureRegistry?: string[];
+    engineInstallURL?: string;
+    engineLabel?: { [key: string]: string };
+    engineOpt?: { [key: string]: string };
+    engineRegistryMirror?: string[];
+    engineStorageDriver?: string;
+    labels?: { [key: string]: string };
+    name?: string;
+    nodeTaints?: Taint[];
+    ownerReferences?: OwnerReference[];
+    removed?: string;
+    state?: string;
+    status?: NodeTemplateStatus;
+    transitioning?: "yes" | "no" | "error";
+    transitioningMessage?: string;
+    uuid?: string;
+    useInternalIpAddress?: boolean;
+}
+
+export interface NodeTemplateCollection extends ApiCollection {
+    data?: NodeTemplate[];
+}
+
+export class NodeTemplateClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<NodeTemplateCollection> {
+        return this.ops.DoList(NodeTemplateType, opts);
+    }
+
+    Next(collection: NodeTemplateCollection): Promise<NodeTemplateCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: NodeTemplate): Promise<NodeTemplate> {
+        return this.ops.DoCreate(NodeTemplateType, container);
+    }
+
+    Update(existing: NodeTemplate, updates: object): Promise<NodeTemplate> {
+        return this.ops.DoUpdate(NodeTemplateType, existing, updates);
+    }
+
+    Replace(obj: NodeTemplate): Promise<NodeTemplate> {
+        return this.ops.DoReplace(NodeTemplateType, obj, obj);
+    }
+
+    ByID(id: string): Promise<NodeTemplate> {
+        return this.ops.DoByID(NodeTemplateType, id);
+    }
+
+    Delete(container: NodeTemplate): Promise<void> {
+        return this.ops.DoResourceDelete(NodeTemplateType, container);
+    }
+}
+
+export const ProjectConditionType = "projectCondition";
+export const ProjectConditionFieldLastTransitionTime = "lastTransitionTime";
+export const ProjectConditionFieldLastUpdateTime = "lastUpdateTime";
+export const ProjectConditionFieldMessage = "message";
+export const ProjectConditionFieldReason = "reason";
+export const ProjectConditionFieldStatus = "status";
+export const ProjectConditionFieldType = "type";
+
+export interface ProjectCondition {
+    lastTransitionTime?: string;
+    lastUpdateTime?: string;
+    message?: string;
+    reason?: string;
+    status?: string;
+    type?: string;
+}
+
+export const MonitoringConditionType = "monitoringCondition";
+export const MonitoringConditionFieldLastTransitionTime = "lastTransitionTime";
+export const MonitoringConditionFieldLastUpdateTime = "lastUpdateTime";
+export const MonitoringConditionFieldMessage = "message";
+export const MonitoringConditionFieldReason = "reason";
+export const MonitoringConditionFieldStatus = "status";
+export const MonitoringConditionFieldType = "type";
+
+export interface MonitoringCondition {
+    lastTransitionTime?: string;
+    lastUpdateTime?: string;
+    message?: string;
+    reason?: string;
+    status?: string;
+    type?: string;
+}
+
+export const MonitoringStatusType = "monitoringStatus";
+export const MonitoringStatusFieldConditions = "conditions";
+export const MonitoringStatusFieldGrafanaEndpoint = "grafanaEndpoint";
+
+export interface MonitoringStatus {
+    conditions?: MonitoringCondition[];
+    grafanaEndpoint?: string;
+}
+
+export const ProjectStatusType = "projectStatus";
+export const ProjectStatusFieldConditions = "conditions";
+export const ProjectStatusFieldMonitoringStatus = "monitoringStatus";
+export const ProjectStatusFieldPodSecurityPolicyTemplateName = "podSecurityPolicyTemplateId";
+
+export interface ProjectStatus {
+    conditions?: ProjectCondition[];
+    monitoringStatus?: MonitoringStatus;
+    podSecurityPolicyTemplateId?: string;
+}
+
+export const SetPodSecurityPolicyTemplateInputType = "setPodSecurityPolicyTemplateInput";
+export const SetPodSecurityPolicyTemplateInputFieldPodSecurityPolicyTemplateName = "podSecurityPolicyTemplateId";
+
+export interface SetPodSecurityPolicyTemplateInput {
+    podSecurityPolicyTemplateId?: string;
+}
+
+export const ImportYamlOutputType = "importYamlOutput";
+export const ImportYamlOutputFieldMessage = "message";
+
+export interface ImportYamlOutput {
+    message?: string;
+}
+
+export const MonitoringInputType = "monitoringInput";
+export const MonitoringInputFieldAnswers = "answers";
+export const MonitoringInputFieldVersion = "version";
+
+export interface MonitoringInput {
+    answers?: { [key: string]: string };
+    version?: string;
+}
+
+export const MonitoringOutputType = "monitoringOutput";
+export const MonitoringOutputFieldAnswers = "answers";
+export const MonitoringOutputFieldVersion = "version";
+
+export interface MonitoringOutput {
+    answers?: { [key: string]: string };
+    version?: string;
+}
+
+export const ResourceQuotaLimitType = "resourceQuotaLimit";
+export const ResourceQuotaLimitFieldConfigMaps = "configMaps";
+export const ResourceQuotaLimitFieldLimitsCPU = "limitsCpu";
+export const ResourceQuotaLimitFieldLimitsMemory = "limitsMemory";
+export const ResourceQuotaLimitFieldPersistentVolumeClaims = "persistentVolumeClaims";
+export const ResourceQuotaLimitFieldPods = "pods";
+export const ResourceQuotaLimitFieldReplicationControllers = "replicationControllers";
+export const ResourceQuotaLimitFieldRequestsCPU = "requestsCpu";
+export const ResourceQuotaLimitFieldRequestsMemory = "requestsMemory";
+export const ResourceQuotaLimitFieldRequestsStorage = "requestsStorage";
+export const ResourceQuotaLimitFieldSecrets = "secrets";
+export const ResourceQuotaLimitFieldServices = "services";
+export const ResourceQuotaLimitFieldServicesLoadBalancers = "servicesLoadBalancers";
+export const ResourceQuotaLimitFieldServicesNodePorts = "servicesNodePorts";
+
+export interface ResourceQuotaLimit {
+    configMaps?: string;
+    limitsCpu?: string;
+    limitsMemory?: string;
+    persistentVolumeClaims?: string;
+    pods?: string;
+    replicationControllers?: string;
+    requestsCpu?: string;
+    requestsMemory?: string;
+    requestsStorage?: string;
+    secrets?: string;
+    services?: string;
+    servicesLoadBalancers?: string;
+    servicesNodePorts?: string;
+}
+
+export const ProjectResourceQuotaType = "projectResourceQuota";
+export const ProjectResourceQuotaFieldLimit = "limit";
+export const ProjectResourceQuotaFieldUsedLimit = "usedLimit";
+
+export interface ProjectResourceQuota {
+    limit?: ResourceQuotaLimit;
+    usedLimit?: ResourceQuotaLimit;
+}
+
+export const NamespaceResourceQuotaType = "namespaceResourceQuota";
+export const NamespaceResourceQuotaFieldLimit = "limit";
+
+export interface NamespaceResourceQuota {
+    limit?: ResourceQuotaLimit;
+}
+
+export const ContainerResourceLimitType = "containerResourceLimit";
+export const ContainerResourceLimitFieldLimitsCPU = "limitsCpu";
+export const ContainerResourceLimitFieldLimitsMemory = "limitsMemory";
+export const ContainerResourceLimitFieldRequestsCPU = "requestsCpu";
+export const ContainerResourceLimitFieldRequestsMemory = "requestsMemory";
+
+export interface ContainerResourceLimit {
+    limitsCpu?: string;
+    limitsMemory?: string;
+    requestsCpu?: string;
+    requestsMemory?: string;
+}
+
+export const ProjectSpecType = "projectSpec";
+export const ProjectSpecFieldClusterID = "clusterId";
+export const ProjectSpecFieldContainerDefaultResourceLimit = "containerDefaultResourceLimit";
+export const ProjectSpecFieldDescription = "description";
+export const ProjectSpecFieldDisplayName = "displayName";
+export const ProjectSpecFieldEnableProjectMonitoring = "enableProjectMonitoring";
+export const ProjectSpecFieldNamespaceDefaultResourceQuota = "namespaceDefaultResourceQuota";
+export const ProjectSpecFieldResourceQuota = "resourceQuota";
+
+export interface ProjectSpec {
+    clusterId?: string;
+    containerDefaultResourceLimit?: ContainerResourceLimit;
+    description?: string;
+    displayName?: string;
+    enableProjectMonitoring?: boolean;
+    namespaceDefaultResourceQuota?: NamespaceResourceQuota;
+    resourceQuota?: ProjectResourceQuota;
+}
+
+export const ProjectType = "project";
+export const ProjectFieldAnnotations = "annotations";
+export const ProjectFieldClusterID = "clusterId";
+export const ProjectFieldConditions = "conditions";
+export const ProjectFieldContainerDefaultResourceLimit = "containerDefaultResourceLimit";
+export const ProjectFieldCreated = "created";
+export const ProjectFieldCreatorID = "creatorId";
+export const ProjectFieldDescription = "description";
+export const ProjectFieldEnableProjectMonitoring = "enableProjectMonitoring";
+export const ProjectFieldLabels = "labels";
+export const ProjectFieldMonitoringStatus = "monitoringStatus";
+export const ProjectFieldName = "name";
+export const ProjectFieldNamespaceDefaultResourceQuota = "namespaceDefaultResourceQuota";
+export const ProjectFieldNamespaceId = "namespaceId";
+export const ProjectFieldOwnerReferences = "ownerReferences";
+export const ProjectFieldPodSecurityPolicyTemplateName = "podSecurityPolicyTemplateId";
+export const ProjectFieldRemoved = "removed";
+export const ProjectFieldResourceQuota = "resourceQuota";
+export const ProjectFieldState = "state";
+export const ProjectFieldTransitioning = "transitioning";
+export const ProjectFieldTransitioningMessage = "transitioningMessage";
+export const ProjectFieldUUID = "uuid";
+export const ProjectActionDisableMonitoring = "disableMonitoring";
+export const ProjectActionEditMonitoring = "editMonitoring";
+export const ProjectActionEnableMonitoring = "enableMonitoring";
+export const ProjectActionExportYaml = "exportYaml";
+export const ProjectActionSetpodsecuritypolicytemplate = "setpodsecuritypolicytemplate";
+export const ProjectActionViewMonitoring = "viewMonitoring";
+
+export interface Project extends ApiResource {
+    annotations?: { [key: string]: string };
+    clusterId?: string;
+    conditions?: ProjectCondition[];
+    containerDefaultResourceLimit?: ContainerResourceLimit;
+    created?: string;
+    creatorId?: string;
+    description?: string;
+    enableProjectMonitoring?: boolean;
+    labels?: { [key: string]: string };
+    monitoringStatus?: MonitoringStatus;
+    name?: string;
+    namespaceDefaultResourceQuota?: NamespaceResourceQuota;
+    namespaceId?: string;
+    ownerReferences?: OwnerReference[];
+    podSecurityPolicyTemplateId?: string;
+    removed?: string;
+    resourceQuota?: ProjectResourceQuota;
+    state?: string;
+    transitioning?: "yes" | "no" | "error";
+    transitioningMessage?: string;
+    uuid?: string;
+}
+
+export interface ProjectCollection extends ApiCollection {
+    data?: Project[];
+}
+
+export class ProjectClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<ProjectCollection> {
+        return this.ops.DoList(ProjectType, opts);
+    }
+
+    Next(collection: ProjectCollection): Promise<ProjectCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: Project): Promise<Project> {
+        return this.ops.DoCreate(ProjectType, container);
+    }
+
+    Update(existing: Project, updates: object): Promise<Project> {
+        return this.ops.DoUpdate(ProjectType, existing, updates);
+    }
+
+    Replace(obj: Project): Promise<Project> {
+        return this.ops.DoReplace(ProjectType, obj, obj);
+    }
+
+    ByID(id: string): Promise<Project> {
+        return this.ops.DoByID(ProjectType, id);
+    }
+
+    Delete(container: Project): Promise<void> {
+        return this.ops.DoResourceDelete(ProjectType, container);
+    }
+
+    ActionDisableMonitoring(resource: Project): Promise<void> {
+        return this.ops.DoAction(ProjectType, ProjectActionDisableMonitoring, resource);
+    }
+
+    ActionEditMonitoring(resource: Project, input: MonitoringInput): Promise<void> {
+        return this.ops.DoAction(ProjectType, ProjectActionEditMonitoring, resource, input);
+    }
+
+    ActionEnableMonitoring(resource: Project, input: MonitoringInput): Promise<void> {
+        return this.ops.DoAction(ProjectType, ProjectActionEnableMonitoring, resource, input);
+    }
+
+    ActionExportYaml(resource: Project): Promise<void> {
+        return this.ops.DoAction(ProjectType, ProjectActionExportYaml, resource);
+    }
+
+    ActionSetpodsecuritypolicytemplate(resource: Project, input: SetPodSecurityPolicyTemplateInput): Promise<Project> {
+        return this.ops.DoAction(ProjectType, ProjectActionSetpodsecuritypolicytemplate, resource, input);
+    }
+
+    ActionViewMonitoring(resource: Project): Promise<MonitoringOutput> {
+        return this.ops.DoAction(ProjectType, ProjectActionViewMonitoring, resource);
+    }
+}
+
+export const PolicyRuleType = "policyRule";
+export const PolicyRuleFieldAPIGroups = "apiGroups";
+export const PolicyRuleFieldNonResourceURLs = "nonResourceURLs";
+export const PolicyRuleFieldResourceNames = "resourceNames";
+export const PolicyRuleFieldResources = "resources";
+export const PolicyRuleFieldVerbs = "verbs";
+
+export interface PolicyRule {
+    apiGroups?: string[];
+    nonResourceURLs?: string[];
+    resourceNames?: string[];
+    resources?: string[];
+    verbs?: string[];
+}
+
+export const GlobalRoleType = "globalRole";
+export const GlobalRoleFieldAnnotations = "annotations";
+export const GlobalRoleFieldCreated = "created";
+export const GlobalRoleFieldCreatorID = "creatorId";
+export const GlobalRoleFieldDescription = "description";
+export const GlobalRoleFieldLabels = "labels";
+export const GlobalRoleFieldName = "name";
+export const GlobalRoleFieldNewUserDefault = "newUserDefault";
+export const GlobalRoleFieldOwnerReferences = "ownerReferences";
+export const GlobalRoleFieldRemoved = "removed";
+export const GlobalRoleFieldRules = "rules";
+export const GlobalRoleFieldUUID = "uuid";
+
+export interface GlobalRole extends ApiResource {
+    annotations?: { [key: string]: string };
+    created?: string;
+    creatorId?: string;
+    description?: string;
+    labels?: { [key: string]: string };
+    name?: string;
+    newUserDefault?: boolean;
+    ownerReferences?: OwnerReference[];
+    removed?: string;
+    rules?: PolicyRule[];
+    uuid?: string;
+}
+
+export interface GlobalRoleCollection extends ApiCollection {
+    data?: GlobalRole[];
+}
+
+export class GlobalRoleClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<GlobalRoleCollection> {
+        return this.ops.DoList(GlobalRoleType, opts);
+    }
+
+    Next(collection: GlobalRoleCollection): Promise<GlobalRoleCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: GlobalRole): Promise<GlobalRole> {
+        return this.ops.DoCreate(GlobalRoleType, container);
+    }
+
+    Update(existing: GlobalRole, updates: object): Promise<GlobalRole> {
+        return this.ops.DoUpdate(GlobalRoleType, existing, updates);
+    }
+
+    Replace(obj: GlobalRole): Promise<GlobalRole> {
+        return this.ops.DoReplace(GlobalRoleType, obj, obj);
+    }
+
+    ByID(id: string): Promise<GlobalRole> {
+        return this.ops.DoByID(GlobalRoleType, id);
+    }
+
+    Delete(container: GlobalRole): Promise<void> {
+        return this.ops.DoResourceDelete(GlobalRoleType, container);
+    }
+}
+
+export const GlobalRoleBindingType = "globalRoleBinding";
+export const GlobalRoleBindingFieldAnnotations = "annotations";
+export const GlobalRoleBindingFieldCreated = "created";
+export const GlobalRoleBindingFieldCreatorID = "creatorId";
+export const GlobalRoleBindingFieldGlobalRoleID = "globalRoleId";
+export const GlobalRoleBindingFieldLabels = "labels";
+export const GlobalRoleBindingFieldName = "name";
+export const GlobalRoleBindingFieldOwnerReferences = "ownerReferences";
+export const GlobalRoleBindingFieldRemoved = "removed";
+export const GlobalRoleBindingFieldUUID = "uuid";
+export const GlobalRoleBindingFieldUserID = "userId";
+
+export interface GlobalRoleBinding extends ApiResource {
+    annotations?: { [key: string]: string };
+    created?: string;
+    creatorId?: string;
+    globalRoleId?: string;
+    labels?: { [key: string]: string };
+    name?: string;
+    ownerReferences?: OwnerReference[];
+    removed?: string;
+    uuid?: string;
+    userId?: string;
+}
+
+export interface GlobalRoleBindingCollection extends ApiCollection {
+    data?: GlobalRoleBinding[];
+}
+
+export class GlobalRoleBindingClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<GlobalRoleBindingCollection> {
+        return this.ops.DoList(GlobalRoleBindingType, opts);
+    }
+
+    Next(collection: GlobalRoleBindingCollection): Promise<GlobalRoleBindingCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: GlobalRoleBinding): Promise<GlobalRoleBinding> {
+        return this.ops.DoCreate(GlobalRoleBindingType, container);
+    }
+
+    Update(existing: GlobalRoleBinding, updates: object): Promise<GlobalRoleBinding> {
+        return this.ops.DoUpdate(GlobalRoleBindingType, existing, updates);
+    }
+
+    Replace(obj: GlobalRoleBinding): Promise<GlobalRoleBinding> {
+        return this.ops.DoReplace(GlobalRoleBindingType, obj, obj);
+    }
+
+    ByID(id: string): Promise<GlobalRoleBinding> {
+        return this.ops.DoByID(GlobalRoleBindingType, id);
+    }
+
+    Delete(container: GlobalRoleBinding): Promise<void> {
+        return this.ops.DoResourceDelete(GlobalRoleBindingType, container);
+    }
+}
+
+export const RoleTemplateType = "roleTemplate";
+export const RoleTemplateFieldAdministrative = "administrative";
+export const RoleTemplateFieldAnnotations = "annotations";
+export const RoleTemplateFieldBuiltin = "builtin";
+export const RoleTemplateFieldClusterCreatorDefault = "clusterCreatorDefault";
+export const RoleTemplateFieldContext = "context";
+export const RoleTemplateFieldCreated = "created";
+export const RoleTemplateFieldCreatorID = "creatorId";
+export const RoleTemplateFieldDescription = "description";
+export const RoleTemplateFieldExternal = "external";
+export const RoleTemplateFieldHidden = "hidden";
+export const RoleTemplateFieldLabels = "labels";
+export const RoleTemplateFieldLocked = "locked";
+export const RoleTemplateFieldName = "name";
+export const RoleTemplateFieldOwnerReferences = "ownerReferences";
+export const RoleTemplateFieldProjectCreatorDefault = "projectCreatorDefault";
+export const RoleTemplateFieldRemoved = "removed";
+export const RoleTemplateFieldRoleTemplateIDs = "roleTemplateIds";
+export const RoleTemplateFieldRules = "rules";
+export const RoleTemplateFieldUUID = "uuid";
+
+export interface RoleTemplate extends ApiResource {
+    administrative?: boolean;
+    annotations?: { [key: string]: string };
+    builtin?: boolean;
+    clusterCreatorDefault?: boolean;
+    context?: string;
+    created?: string;
+    creatorId?: string;
+    description?: string;
+    external?: boolean;
+    hidden?: boolean;
+    labels?: { [key: string]: string };
+    locked?: boolean;
+    name?: string;
+    ownerReferences?: OwnerReference[];
+    projectCreatorDefault?: boolean;
+    removed?: string;
+    roleTemplateIds?: string[];
+    rules?: PolicyRule[];
+    uuid?: string;
+}
+
+export interface RoleTemplateCollection extends ApiCollection {
+    data?: RoleTemplate[];
+}
+
+export class RoleTemplateClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<RoleTemplateCollection> {
+        return this.ops.DoList(RoleTemplateType, opts);
+    }
+
+    Next(collection: RoleTemplateCollection): Promise<RoleTemplateCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: RoleTemplate): Promise<RoleTemplate> {
+        return this.ops.DoCreate(RoleTemplateType, container);
+    }
+
+    Update(existing: RoleTemplate, updates: object): Promise<RoleTemplate> {
+        return this.ops.DoUpdate(RoleTemplateType, existing, updates);
+    }
+
+    Replace(obj: RoleTemplate): Promise<RoleTemplate> {
+        return this.ops.DoReplace(RoleTemplateType, obj, obj);
+    }
+
+    ByID(id: string): Promise<RoleTemplate> {
+        return this.ops.DoByID(RoleTemplateType, id);
+    }
+
+    Delete(container: RoleTemplate): Promise<void> {
+        return this.ops.DoResourceDelete(RoleTemplateType, container);
+    }
+}
+
+export const HostPortRangeType = "hostPortRange";
+export const HostPortRangeFieldMax = "max";
+export const HostPortRangeFieldMin = "min";
+
+export interface HostPortRange {
+    max?: number;
+    min?: number;
+}
+
+export const SELinuxOptionsType = "seLinuxOptions";
+export const SELinuxOptionsFieldLevel = "level";
+export const SELinuxOptionsFieldRole = "role";
+export const SELinuxOptionsFieldType = "type";
+export const SELinuxOptionsFieldUser = "user";
+
+export interface SELinuxOptions {
+    level?: string;
+    role?: string;
+    type?: string;
+    user?: string;
+}
+
+export const SELinuxStrategyOptionsType = "seLinuxStrategyOptions";
+export const SELinuxStrategyOptionsFieldRule = "rule";
+export const SELinuxStrategyOptionsFieldSELinuxOptions = "seLinuxOptions";
+
+export interface SELinuxStrategyOptions {
+    rule?: string;
+    seLinuxOptions?: SELinuxOptions;
+}
+
+export const IDRangeType = "idRange";
+export const IDRangeFieldMax = "max";
+export const IDRangeFieldMin = "min";
+
+export interface IDRange {
+    max?: number;
+    min?: number;
+}
+
+export const RunAsUserStrategyOptionsType = "runAsUserStrategyOptions";
+export const RunAsUserStrategyOptionsFieldRanges = "ranges";
+export const RunAsUserStrategyOptionsFieldRule = "rule";
+
+export interface RunAsUserStrategyOptions {
+    ranges?: IDRange[];
+    rule?: string;
+}
+
+export const RunAsGroupStrategyOptionsType = "runAsGroupStrategyOptions";
+export const RunAsGroupStrategyOptionsFieldRanges = "ranges";
+export const RunAsGroupStrategyOptionsFieldRule = "rule";
+
+export interface RunAsGroupStrategyOptions {
+    ranges?: IDRange[];
+    rule?: string;
+}
+
+export const SupplementalGroupsStrategyOptionsType = "supplementalGroupsStrategyOptions";
+export const SupplementalGroupsStrategyOptionsFieldRanges = "ranges";
+export const SupplementalGroupsStrategyOptionsFieldRule = "rule";
+
+export interface SupplementalGroupsStrategyOptions {
+    ranges?: IDRange[];
+    rule?: string;
+}
+
+export const FSGroupStrategyOptionsType = "fsGroupStrategyOptions";
+export const FSGroupStrategyOptionsFieldRanges = "ranges";
+export const FSGroupStrategyOptionsFieldRule = "rule";
+
+export interface FSGroupStrategyOptions {
+    ranges?: IDRange[];
+    rule?: string;
+}
+
+export const AllowedHostPathType = "allowedHostPath";
+export const AllowedHostPathFieldPathPrefix = "pathPrefix";
+export const AllowedHostPathFieldReadOnly = "readOnly";
+
+export interface AllowedHostPath {
+    pathPrefix?: string;
+    readOnly?: boolean;
+}
+
+export const AllowedFlexVolumeType = "allowedFlexVolume";
+export const AllowedFlexVolumeFieldDriver = "driver";
+
+export interface AllowedFlexVolume {
+    driver?: string;
+}
+
+export const AllowedCSIDriverType = "allowedCSIDriver";
+export const AllowedCSIDriverFieldName = "name";
+
+export interface AllowedCSIDriver {
+    name?: string;
+}
+
+export const RuntimeClassStrategyOptionsType = "runtimeClassStrategyOptions";
+export const RuntimeClassStrategyOptionsFieldAllowedRuntimeClassNames = "allowedRuntimeClassNames";
+export const RuntimeClassStrategyOptionsFieldDefaultRuntimeClassName = "defaultRuntimeClassName";
+
+export interface RuntimeClassStrategyOptions {
+    allowedRuntimeClassNames?: string[];
+    defaultRuntimeClassName?: string;
+}
+
+export const PodSecurityPolicySpecType = "podSecurityPolicySpec";
+export const PodSecurityPolicySpecFieldAllowPrivilegeEscalation = "allowPrivilegeEscalation";
+export const PodSecurityPolicySpecFieldAllowedCSIDrivers = "allowedCSIDrivers";
+export const PodSecurityPolicySpecFieldAllowedCapabilities = "allowedCapabilities";
+export const PodSecurityPolicySpecFieldAllowedFlexVolumes = "allowedFlexVolumes";
+export const PodSecurityPolicySpecFieldAllowedHostPaths = "allowedHostPaths";
+export const PodSecurityPolicySpecFieldAllowedProcMountTypes = "allowedProcMountTypes";
+export const PodSecurityPolicySpecFieldAllowedUnsafeSysctls = "allowedUnsafeSysctls";
+export const PodSecurityPolicySpecFieldDefaultAddCapabilities = "defaultAddCapabilities";
+export const PodSecurityPolicySpecFieldDefaultAllowPrivilegeEscalation = "defaultAllowPrivilegeEscalation";
+export const PodSecurityPolicySpecFieldFSGroup = "fsGroup";
+export const PodSecurityPolicySpecFieldForbiddenSysctls = "forbiddenSysctls";
+export const PodSecurityPolicySpecFieldHostIPC = "hostIPC";
+export const PodSecurityPolicySpecFieldHostNetwork = "hostNetwork";
+export const PodSecurityPolicySpecFieldHostPID = "hostPID";
+export const PodSecurityPolicySpecFieldHostPorts = "hostPorts";
+export const PodSecurityPolicySpecFieldPrivileged = "privileged";
+export const PodSecurityPolicySpecFieldReadOnlyRootFilesystem = "readOnlyRootFilesystem";
+export const PodSecurityPolicySpecFieldRequiredDropCapabilities = "requiredDropCapabilities";
+export const PodSecurityPolicySpecFieldRunAsGroup = "runAsGroup";
+export const PodSecurityPolicySpecFieldRunAsUser = "runAsUser";
+export const PodSecurityPolicySpecFieldRuntimeClass = "runtimeClass";
+export const PodSecurityPolicySpecFieldSELinux = "seLinux";
+export const PodSecurityPolicySpecFieldSupplementalGroups = "supplementalGroups";
+export const PodSecurityPolicySpecFieldVolumes = "volumes";
+
+export interface PodSecurityPolicySpec {
+    allowPrivilegeEscalation?: boolean;
+    allowedCSIDrivers?: AllowedCSIDriver[];
+    allowedCapabilities?: string[];
+    allowedFlexVolumes?: AllowedFlexVolume[];
+    allowedHostPaths?: AllowedHostPath[];
+    allowedProcMountTypes?: string[];
+    allowedUnsafeSysctls?: string[];
+    defaultAddCapabilities?: string[];
+    defaultAllowPrivilegeEscalation?: boolean;
+    fsGroup?: FSGroupStrategyOptions;
+    forbiddenSysctls?: string[];
+    hostIPC?: boolean;
+    hostNetwork?: boolean;
+    hostPID?: boolean;
+    hostPorts?: HostPortRange[];
+    privileged?: boolean;
+    readOnlyRootFilesystem?: boolean;
+    requiredDropCapabilities?: string[];
+    runAsGroup?: RunAsGroupStrategyOptions;
+    runAsUser?: RunAsUserStrategyOptions;
+    runtimeClass?: RuntimeClassStrategyOptions;
+    seLinux?: SELinuxStrategyOptions;
+    supplementalGroups?: SupplementalGroupsStrategyOptions;
+    volumes?: string[];
+}
+
+export const PodSecurityPolicyTemplateType = "podSecurityPolicyTemplate";
+export const PodSecurityPolicyTemplateFieldAllowPrivilegeEscalation = "allowPrivilegeEscalation";
+export const PodSecurityPolicyTemplateFieldAllowedCSIDrivers = "allowedCSIDrivers";
+export const PodSecurityPolicyTemplateFieldAllowedCapabilities = "allowedCapabilities";
+export const PodSecurityPolicyTemplateFieldAllowedFlexVolumes = "allowedFlexVolumes";
+export const PodSecurityPolicyTemplateFieldAllowedHostPaths = "allowedHostPaths";
+export const PodSecurityPolicyTemplateFieldAllowedProcMountTypes = "allowedProcMountTypes";
+export const PodSecurityPolicyTemplateFieldAllowedUnsafeSysctls = "allowedUnsafeSysctls";
+export const PodSecurityPolicyTemplateFieldAnnotations = "annotations";
+export const PodSecurityPolicyTemplateFieldCreated = "created";
+export const PodSecurityPolicyTemplateFieldCreatorID = "creatorId";
+export const PodSecurityPolicyTemplateFieldDefaultAddCapabilities = "defaultAddCapabilities";
+export const PodSecurityPolicyTemplateFieldDefaultAllowPrivilegeEscalation = "defaultAllowPrivilegeEscalation";
+export const PodSecurityPolicyTemplateFieldDescription = "description";
+export const PodSecurityPolicyTemplateFieldFSGroup = "fsGroup";
+export const PodSecurityPolicyTemplateFieldForbiddenSysctls = "forbiddenSysctls";
+export const PodSecurityPolicyTemplateFieldHostIPC = "hostIPC";
+export const PodSecurityPolicyTemplateFieldHostNetwork = "hostNetwork";
+export const PodSecurityPolicyTemplateFieldHostPID = "hostPID";
+export const PodSecurityPolicyTemplateFieldHostPorts = "hostPorts";
+export const PodSecurityPolicyTemplateFieldLabels = "labels";
+export const PodSecurityPolicyTemplateFieldName = "name";
+export const PodSecurityPolicyTemplateFieldOwnerReferences = "ownerReferences";
+export const PodSecurityPolicyTemplateFieldPrivileged = "privileged";
+export const PodSecurityPolicyTemplateFieldReadOnlyRootFilesystem = "readOnlyRootFilesystem";
+export const PodSecurityPolicyTemplateFieldRemoved = "removed";
+export const PodSecurityPolicyTemplateFieldRequiredDropCapabilities = "requiredDropCapabilities";
+export const PodSecurityPolicyTemplateFieldRunAsGroup = "runAsGroup";
+export const PodSecurityPolicyTemplateFieldRunAsUser = "runAsUser";
+export const PodSecurityPolicyTemplateFieldRuntimeClass = "runtimeClass";
+export const PodSecurityPolicyTemplateFieldSELinux = "seLinux";
+export const PodSecurityPolicyTemplateFieldSupplementalGroups = "supplementalGroups";
+export const PodSecurityPolicyTemplateFieldUUID = "uuid";
+export const PodSecurityPolicyTemplateFieldVolumes = "volumes";
+
+export interface PodSecurityPolicyTemplate extends ApiResource {
+    allowPrivilegeEscalation?: boolean;
+    allowedCSIDrivers?: AllowedCSIDriver[];
+    allowedCapabilities?: string[];
+    allowedFlexVolumes?: AllowedFlexVolume[];
+    allowedHostPaths?: AllowedHostPath[];
+    allowedProcMountTypes?: string[];
+    allowedUnsafeSysctls?: string[];
+    annotations?: { [key: string]: string };
+    created?: string;
+    creatorId?: string;
+    defaultAddCapabilities?: string[];
+    defaultAllowPrivilegeEscalation?: boolean;
+    description?: string;
+    fsGroup?: FSGroupStrategyOptions;
+    forbiddenSysctls?: string[];
+    hostIPC?: boolean;
+    hostNetwork?: boolean;
+    hostPID?: boolean;
+    hostPorts?: HostPortRange[];
+    labels?: { [key: string]: string };
+    name?: string;
+    ownerReferences?: OwnerReference[];
+    privileged?: boolean;
+    readOnlyRootFilesystem?: boolean;
+    removed?: string;
+    requiredDropCapabilities?: string[];
+    runAsGroup?: RunAsGroupStrategyOptions;
+    runAsUser?: RunAsUserStrategyOptions;
+    runtimeClass?: RuntimeClassStrategyOptions;
+    seLinux?: SELinuxStrategyOptions;
+    supplementalGroups?: SupplementalGroupsStrategyOptions;
+    uuid?: string;
+    volumes?: string[];
+}
+
+export interface PodSecurityPolicyTemplateCollection extends ApiCollection {
+    data?: PodSecurityPolicyTemplate[];
+}
+
+export class PodSecurityPolicyTemplateClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<PodSecurityPolicyTemplateCollection> {
+        return this.ops.DoList(PodSecurityPolicyTemplateType, opts);
+    }
+
+    Next(collection: PodSecurityPolicyTemplateCollection): Promise<PodSecurityPolicyTemplateCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: PodSecurityPolicyTemplate): Promise<PodSecurityPolicyTemplate> {
+        return this.ops.DoCreate(PodSecurityPolicyTemplateType, container);
+    }
+
+    Update(existing: PodSecurityPolicyTemplate, updates: object): Promise<PodSecurityPolicyTemplate> {
+        return this.ops.DoUpdate(PodSecurityPolicyTemplateType, existing, updates);
+    }
+
+    Replace(obj: PodSecurityPolicyTemplate): Promise<PodSecurityPolicyTemplate> {
+        return this.ops.DoReplace(PodSecurityPolicyTemplateType, obj, obj);
+    }
+
+    ByID(id: string): Promise<PodSecurityPolicyTemplate> {
+        return this.ops.DoByID(PodSecurityPolicyTemplateType, id);
+    }
+
+    Delete(container: PodSecurityPolicyTemplate): Promise<void> {
+        return this.ops.DoResourceDelete(PodSecurityPolicyTemplateType, container);
+    }
+}
+
+export const PodSecurityPolicyTemplateProjectBindingType = "podSecurityPolicyTemplateProjectBinding";
+export const PodSecurityPolicyTemplateProjectBindingFieldAnnotations = "annotations";
+export const PodSecurityPolicyTemplateProjectBindingFieldCreated = "created";
+export const PodSecurityPolicyTemplateProjectBindingFieldCreatorID = "creatorId";
+export const PodSecurityPolicyTemplateProjectBindingFieldLabels = "labels";
+export const PodSecurityPolicyTemplateProjectBindingFieldName = "name";
+export const PodSecurityPolicyTemplateProjectBindingFieldNamespaceId = "namespaceId";
+export const PodSecurityPolicyTemplateProjectBindingFieldOwnerReferences = "ownerReferences";
+export const PodSecurityPolicyTemplateProjectBindingFieldPodSecurityPolicyTemplateName = "podSecurityPolicyTemplateId";
+export const PodSecurityPolicyTemplateProjectBindingFieldRemoved = "removed";
+export const PodSecurityPolicyTemplateProjectBindingFieldTargetProjectName = "targetProjectId";
+export const PodSecurityPolicyTemplateProjectBindingFieldUUID = "uuid";
+
+export interface PodSecurityPolicyTemplateProjectBinding extends ApiResource {
+    annotations?: { [key: string]: string };
+    created?: string;
+    creatorId?: string;
+    labels?: { [key: string]: string };
+    name?: string;
+    namespaceId?: string;
+    ownerReferences?: OwnerReference[];
+    podSecurityPolicyTemplateId?: string;
+    removed?: string;
+    targetProjectId?: string;
+    uuid?: string;
+}
+
+export interface PodSecurityPolicyTemplateProjectBindingCollection extends ApiCollection {
+    data?: PodSecurityPolicyTemplateProjectBinding[];
+}
+
+export class PodSecurityPolicyTemplateProjectBindingClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<PodSecurityPolicyTemplateProjectBindingCollection> {
+        return this.ops.DoList(PodSecurityPolicyTemplateProjectBindingType, opts);
+    }
+
+    Next(collection: PodSecurityPolicyTemplateProjectBindingCollection): Promise<PodSecurityPolicyTemplateProjectBindingCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: PodSecurityPolicyTemplateProjectBinding): Promise<PodSecurityPolicyTemplateProjectBinding> {
+        return this.ops.DoCreate(PodSecurityPolicyTemplateProjectBindingType, container);
+    }
+
+    Update(existing: PodSecurityPolicyTemplateProjectBinding, updates: object): Promise<PodSecurityPolicyTemplateProjectBinding> {
+        return this.ops.DoUpdate(PodSecurityPolicyTemplateProjectBindingType, existing, updates);
+    }
+
+    Replace(obj: PodSecurityPolicyTemplateProjectBinding): Promise<PodSecurityPolicyTemplateProjectBinding> {
+        return this.ops.DoReplace(PodSecurityPolicyTemplateProjectBindingType, obj, obj);
+    }
+
+    ByID(id: string): Promise<PodSecurityPolicyTemplateProjectBinding> {
+        return this.ops.DoByID(PodSecurityPolicyTemplateProjectBindingType, id);
+    }
+
+    Delete(container: PodSecurityPolicyTemplateProjectBinding): Promise<void> {
+        return this.ops.DoResourceDelete(PodSecurityPolicyTemplateProjectBindingType, container);
+    }
+}
+
+export const ClusterRoleTemplateBindingType = "clusterRoleTemplateBinding";
+export const ClusterRoleTemplateBindingFieldAnnotations = "annotations";
+export const ClusterRoleTemplateBindingFieldClusterID = "clusterId";
+export const ClusterRoleTemplateBindingFieldCreated = "created";
+export const ClusterRoleTemplateBindingFieldCreatorID = "creatorId";
+export const ClusterRoleTemplateBindingFieldGroupID = "groupId";
+export const ClusterRoleTemplateBindingFieldGroupPrincipalID = "groupPrincipalId";
+export const ClusterRoleTemplateBindingFieldLabels = "labels";
+export const ClusterRoleTemplateBindingFieldName = "name";
+export const ClusterRoleTemplateBindingFieldNamespaceId = "namespaceId";
+export const ClusterRoleTemplateBindingFieldOwnerReferences = "ownerReferences";
+export const ClusterRoleTemplateBindingFieldRemoved = "removed";
+export const ClusterRoleTemplateBindingFieldRoleTemplateID = "roleTemplateId";
+export const ClusterRoleTemplateBindingFieldUUID = "uuid";
+export const ClusterRoleTemplateBindingFieldUserID = "userId";
+export const ClusterRoleTemplateBindingFieldUserPrincipalID = "userPrincipalId";
+
+export interface ClusterRoleTemplateBinding extends ApiResource {
+    annotations?: { [key: string]: string };
+    clusterId?: string;
+    created?: string;
+    creatorId?: string;
+    groupId?: string;
+    groupPrincipalId?: string;
+    labels?: { [key: string]: string };
+    name?: string;
+    namespaceId?: string;
+    ownerReferences?: OwnerReference[];
+    removed?: string;
+    roleTemplateId?: string;
+    uuid?: string;
+    userId?: string;
+    userPrincipalId?: string;
+}
+
+export interface ClusterRoleTemplateBindingCollection extends ApiCollection {
+    data?: ClusterRoleTemplateBinding[];
+}
+
+export class ClusterRoleTemplateBindingClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<ClusterRoleTemplateBindingCollection> {
+        return this.ops.DoList(ClusterRoleTemplateBindingType, opts);
+    }
+
+    Next(collection: ClusterRoleTemplateBindingCollection): Promise<ClusterRoleTemplateBindingCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: ClusterRoleTemplateBinding): Promise<ClusterRoleTemplateBinding> {
+        return this.ops.DoCreate(ClusterRoleTemplateBindingType, container);
+    }
+
+    Update(existing: ClusterRoleTemplateBinding, updates: object): Promise<ClusterRoleTemplateBinding> {
+        return this.ops.DoUpdate(ClusterRoleTemplateBindingType, existing, updates);
+    }
+
+    Replace(obj: ClusterRoleTemplateBinding): Promise<ClusterRoleTemplateBinding> {
+        return this.ops.DoReplace(ClusterRoleTemplateBindingType, obj, obj);
+    }
+
+    ByID(id: string): Promise<ClusterRoleTemplateBinding> {
+        return this.ops.DoByID(ClusterRoleTemplateBindingType, id);
+    }
+
+    Delete(container: ClusterRoleTemplateBinding): Promise<void> {
+        return this.ops.DoResourceDelete(ClusterRoleTemplateBindingType, container);
+    }
+}
+
+export const ProjectRoleTemplateBindingType = "projectRoleTemplateBinding";
+export const ProjectRoleTemplateBindingFieldAnnotations = "annotations";
+export const ProjectRoleTemplateBindingFieldCreated = "created";
+export const ProjectRoleTemplateBindingFieldCreatorID = "creatorId";
+export const ProjectRoleTemplateBindingFieldGroupID = "groupId";
+export const ProjectRoleTemplateBindingFieldGroupPrincipalID = "groupPrincipalId";
+export const ProjectRoleTemplateBindingFieldLabels = "labels";
+export const ProjectRoleTemplateBindingFieldName = "name";
+export const ProjectRoleTemplateBindingFieldNamespaceId = "namespaceId";
+export const ProjectRoleTemplateBindingFieldOwnerReferences = "ownerReferences";
+export const ProjectRoleTemplateBindingFieldProjectID = "projectId";
+export const ProjectRoleTemplateBindingFieldRemoved = "removed";
+export const ProjectRoleTemplateBindingFieldRoleTemplateID = "roleTemplateId";
+export const ProjectRoleTemplateBindingFieldServiceAccount = "serviceAccount";
+export const ProjectRoleTemplateBindingFieldUUID = "uuid";
+export const ProjectRoleTemplateBindingFieldUserID = "userId";
+export const ProjectRoleTemplateBindingFieldUserPrincipalID = "userPrincipalId";
+
+export interface ProjectRoleTemplateBinding extends ApiResource {
+    annotations?: { [key: string]: string };
+    created?: string;
+    creatorId?: string;
+    groupId?: string;
+    groupPrincipalId?: string;
+    labels?: { [key: string]: string };
+    name?: string;
+    namespaceId?: string;
+    ownerReferences?: OwnerReference[];
+    projectId?: string;
+    removed?: string;
+    roleTemplateId?: string;
+    serviceAccount?: string;
+    uuid?: string;
+    userId?: string;
+    userPrincipalId?: string;
+}
+
+export interface ProjectRoleTemplateBindingCollection extends ApiCollection {
+    data?: ProjectRoleTemplateBinding[];
+}
+
+export class ProjectRoleTemplateBindingClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<ProjectRoleTemplateBindingCollection> {
+        return this.ops.DoList(ProjectRoleTemplateBindingType, opts);
+    }
+
+    Next(collection: ProjectRoleTemplateBindingCollection): Promise<ProjectRoleTemplateBindingCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: ProjectRoleTemplateBinding): Promise<ProjectRoleTemplateBinding> {
+        return this.ops.DoCreate(ProjectRoleTemplateBindingType, container);
+    }
+
+    Update(existing: ProjectRoleTemplateBinding, updates: object): Promise<ProjectRoleTemplateBinding> {
+        return this.ops.DoUpdate(ProjectRoleTemplateBindingType, existing, updates);
+    }
+
+    Replace(obj: ProjectRoleTemplateBinding): Promise<ProjectRoleTemplateBinding> {
+        return this.ops.DoReplace(ProjectRoleTemplateBindingType, obj, obj);
+    }
+
+    ByID(id: string): Promise<ProjectRoleTemplateBinding> {
+        return this.ops.DoByID(ProjectRoleTemplateBindingType, id);
+    }
+
+    Delete(container: ProjectRoleTemplateBinding): Promise<void> {
+        return this.ops.DoResourceDelete(ProjectRoleTemplateBindingType, container);
+    }
+}
+
+export const S3BackupConfigType = "s3BackupConfig";
+export const S3BackupConfigFieldAccessKey = "accessKey";
+export const S3BackupConfigFieldBucketName = "bucketName";
+export const S3BackupConfigFieldCustomCA = "customCa";
+export const S3BackupConfigFieldEndpoint = "endpoint";
+export const S3BackupConfigFieldFolder = "folder";
+export const S3BackupConfigFieldRegion = "region";
+export const S3BackupConfigFieldSecretKey = "secretKey";
+
+export interface S3BackupConfig {
+    accessKey?: string;
+    bucketName?: string;
+    customCa?: string;
+    endpoint?: string;
+    folder?: string;
+    region?: string;
+    secretKey?: string;
+}
+
+export const BackupConfigType = "backupConfig";
+export const BackupConfigFieldEnabled = "enabled";
+export const BackupConfigFieldIntervalHours = "intervalHours";
+export const BackupConfigFieldRetention = "retention";
+export const BackupConfigFieldS3BackupConfig = "s3BackupConfig";
+export const BackupConfigFieldSafeTimestamp = "safeTimestamp";
+
+export interface BackupConfig {
+    enabled?: boolean;
+    intervalHours?: number;
+    retention?: number;
+    s3BackupConfig?: S3BackupConfig;
+    safeTimestamp?: boolean;
+}
+
+export const ETCDServiceType = "etcdService";
+export const ETCDServiceFieldBackupConfig = "backupConfig";
+export const ETCDServiceFieldCACert = "caCert";
+export const ETCDServiceFieldCert = "cert";
+export const ETCDServiceFieldCreation = "creation";
+export const ETCDServiceFieldExternalURLs = "externalUrls";
+export const ETCDServiceFieldExtraArgs = "extraArgs";
+export const ETCDServiceFieldExtraBinds = "extraBinds";
+export const ETCDServiceFieldExtraEnv = "extraEnv";
+export const ETCDServiceFieldGID = "gid";
+export const ETCDServiceFieldImage = "image";
+export const ETCDServiceFieldKey = "key";
+export const ETCDServiceFieldPath = "path";
+export const ETCDServiceFieldRetention = "retention";
+export const ETCDServiceFieldSnapshot = "snapshot";
+export const ETCDServiceFieldUID = "uid";
+
+export interface ETCDService {
+    backupConfig?: BackupConfig;
+    caCert?: string;
+    cert?: string;
+    creation?: string;
+    externalUrls?: string[];
+    extraArgs?: { [key: string]: string };
+    extraBinds?: string[];
+    extraEnv?: string[];
+    gid?: number;
+    image?: string;
+    key?: string;
+    path?: string;
+    retention?: string;
+    snapshot?: boolean;
+    uid?: number;
+}
+
+export const SecretsEncryptionConfigType = "secretsEncryptionConfig";
+export const SecretsEncryptionConfigFieldCustomConfig = "customConfig";
+export const SecretsEncryptionConfigFieldEnabled = "enabled";
+
+export interface SecretsEncryptionConfig {
+    customConfig?: { [key: string]: any };
+    enabled?: boolean;
+}
+
+export const AuditLogConfigType = "auditLogConfig";
+export const AuditLogConfigFieldFormat = "format";
+export const AuditLogConfigFieldMaxAge = "maxAge";
+export const AuditLogConfigFieldMaxBackup = "maxBackup";
+export const AuditLogConfigFieldMaxSize = "maxSize";
+export const AuditLogConfigFieldPath = "path";
+export const AuditLogConfigFieldPolicy = "policy";
+
+export interface AuditLogConfig {
+    format?: string;
+    maxAge?: number;
+    maxBackup?: number;
+    maxSize?: number;
+    path?: string;
+    policy?: { [key: string]: any };
+}
+
+export const AuditLogType = "auditLog";
+export const AuditLogFieldConfiguration = "configuration";
+export const AuditLogFieldEnabled = "enabled";
+
+export interface AuditLog {
+    configuration?: AuditLogConfig;
+    enabled?: boolean;
+}
+
+export const EventRateLimitType = "eventRateLimit";
+export const EventRateLimitFieldConfiguration = "configuration";
+export const EventRateLimitFieldEnabled = "enabled";
+
+export interface EventRateLimit {
+    configuration?: { [key: string]: any };
+    enabled?: boolean;
+}
+
+export const KubeAPIServiceType = "kubeAPIService";
+export const KubeAPIServiceFieldAdmissionConfiguration = "admissionConfiguration";
+export const KubeAPIServiceFieldAlwaysPullImages = "alwaysPullImages";
+export const KubeAPIServiceFieldAuditLog = "auditLog";
+export const KubeAPIServiceFieldEventRateLimit = "eventRateLimit";
+export const KubeAPIServiceFieldExtraArgs = "extraArgs";
+export const KubeAPIServiceFieldExtraBinds = "extraBinds";
+export const KubeAPIServiceFieldExtraEnv = "extraEnv";
+export const KubeAPIServiceFieldImage = "image";
+export const KubeAPIServiceFieldPodSecurityPolicy = "podSecurityPolicy";
+export const KubeAPIServiceFieldSecretsEncryptionConfig = "secretsEncryptionConfig";
+export const KubeAPIServiceFieldServiceClusterIPRange = "serviceClusterIpRange";
+export const KubeAPIServiceFieldServiceNodePortRange = "serviceNodePortRange";
+
+export interface KubeAPIService {
+    admissionConfiguration?: { [key: string]: any };
+    alwaysPullImages?: boolean;
+    auditLog?: AuditLog;
+    eventRateLimit?: EventRateLimit;
+    extraArgs?: { [key: string]: string };
+    extraBinds?: string[];
+    extraEnv?: string[];
+    image?: string;
+    podSecurityPolicy?: boolean;
+    secretsEncryptionConfig?: SecretsEncryptionConfig;
+    serviceClusterIpRange?: string;
+    serviceNodePortRange?: string;
+}
+
+export const KubeControllerServiceType = "kubeControllerService";
+export const KubeControllerServiceFieldClusterCIDR = "clusterCidr";
+export const KubeControllerServiceFieldExtraArgs = "extraArgs";
+export const KubeControllerServiceFieldExtraBinds = "extraBinds";
+export const KubeControllerServiceFieldExtraEnv = "extraEnv";
+export const KubeControllerServiceFieldImage = "image";
+export const KubeControllerServiceFieldServiceClusterIPRange = "serviceClusterIpRange";
+
+export interface KubeControllerService {
+    clusterCidr?: string;
+    extraArgs?: { [key: string]: string };
+    extraBinds?: string[];
+    extraEnv?: string[];
+    image?: string;
+    serviceClusterIpRange?: string;
+}
+
+export const SchedulerServiceType = "schedulerService";
+export const SchedulerServiceFieldExtraArgs = "extraArgs";
+export const SchedulerServiceFieldExtraBinds = "extraBinds";
+export const SchedulerServiceFieldExtraEnv = "extraEnv";
+export const SchedulerServiceFieldImage = "image";
+
+export interface SchedulerService {
+    extraArgs?: { [key: string]: string };
+    extraBinds?: string[];
+    extraEnv?: string[];
+    image?: string;
+}
+
+export const KubeletServiceType = "kubeletService";
+export const KubeletServiceFieldClusterDNSServer = "clusterDnsServer";
+export const KubeletServiceFieldClusterDomain = "clusterDomain";
+export const KubeletServiceFieldExtraArgs = "extraArgs";
+export const KubeletServiceFieldExtraBinds = "extraBinds";
+export const KubeletServiceFieldExtraEnv = "extraEnv";
+export const KubeletServiceFieldFailSwapOn = "failSwapOn";
+export const KubeletServiceFieldGenerateServingCertificate = "generateServingCertificate";
+export const KubeletServiceFieldImage = "image";
+export const KubeletServiceFieldInfraContainerImage = "infraContainerImage";
+
+export interface KubeletService {
+    clusterDnsServer?: string;
+    clusterDomain?: string;
+    extraArgs?: { [key: string]: string };
+    extraBinds?: string[];
+    extraEnv?: string[];
+    failSwapOn?: boolean;
+    generateServingCertificate?: boolean;
+    image?: string;
+    infraContainerImage?: string;
+}
+
+export const KubeproxyServiceType = "kubeproxyService";
+export const KubeproxyServiceFieldExtraArgs = "extraArgs";
+export const KubeproxyServiceFieldExtraBinds = "extraBinds";
+export const KubeproxyServiceFieldExtraEnv = "extraEnv";
+export const KubeproxyServiceFieldImage = "image";
+
+export interface KubeproxyService {
+    extraArgs?: { [key: string]: string };
+    extraBinds?: string[];
+    extraEnv?: string[];
+    image?: string;
+}
+
+export const RKEConfigServicesType = "rkeConfigServices";
+export const RKEConfigServicesFieldEtcd = "etcd";
+export const RKEConfigServicesFieldKubeAPI = "kubeApi";
+export const RKEConfigServicesFieldKubeController = "kubeController";
+export const RKEConfigServicesFieldKubelet = "kubelet";
+export const RKEConfigServicesFieldKubeproxy = "kubeproxy";
+export const RKEConfigServicesFieldScheduler = "scheduler";
+
+export interface RKEConfigServices {
+    etcd?: ETCDService;
+    kubeApi?: KubeAPIService;
+    kubeController?: KubeControllerService;
+    kubelet?: KubeletService;
+    kubeproxy?: KubeproxyService;
+    scheduler?: SchedulerService;
+}
+
+export const CalicoNetworkProviderType = "calicoNetworkProvider";
+export const CalicoNetworkProviderFieldCloudProvider = "cloudProvider";
+
+export interface CalicoNetworkProvider {
+    cloudProvider?: string;
+}
+
+export const CanalNetworkProviderType = "canalNetworkProvider";
+export const CanalNetworkProviderFieldIface = "iface";
+
+export interface CanalNetworkProvider {
+    iface?: string;
+}
+
+export const FlannelNetworkProviderType = "flannelNetworkProvider";
+export const FlannelNetworkProviderFieldIface = "iface";
+
+export interface FlannelNetworkProvider {
+    iface?: string;
+}
+
+export const WeaveNetworkProviderType = "weaveNetworkProvider";
+export const WeaveNetworkProviderFieldPassword = "password";
+
+export interface WeaveNetworkProvider {
+    password?: string;
+}
+
+export const NetworkConfigType = "networkConfig";
+export const NetworkConfigFieldCalicoNetworkProvider = "calicoNetworkProvider";
+export const NetworkConfigFieldCanalNetworkProvider = "canalNetworkProvider";
+export const NetworkConfigFieldFlannelNetworkProvider = "flannelNetworkProvider";
+export const NetworkConfigFieldMTU = "mtu";
+export const NetworkConfigFieldNodeSelector = "nodeSelector";
+export const NetworkConfigFieldOptions = "options";
+export const NetworkConfigFieldPlugin = "plugin";
+export const NetworkConfigFieldWeaveNetworkProvider = "weaveNetworkProvider";
+
+export interface NetworkConfig {
+    calicoNetworkProvider?: CalicoNetworkProvider;
+    canalNetworkProvider?: CanalNetworkProvider;
+    flannelNetworkProvider?: FlannelNetworkProvider;
+    mtu?: number;
+    nodeSelector?: { [key: string]: string };
+    options?: { [key: string]: string };
+    plugin?: string;
+    weaveNetworkProvider?: WeaveNetworkProvider;
+}
+
+export const AuthWebhookConfigType = "authWebhookConfig";
+export const AuthWebhookConfigFieldCacheTimeout = "cacheTimeout";
+export const AuthWebhookConfigFieldConfigFile = "configFile";
+
+export interface AuthWebhookConfig {
+    cacheTimeout?: string;
+    configFile?: string;
+}
+
+export const AuthnConfigType = "authnConfig";
+export const AuthnConfigFieldSANs = "sans";
+export const AuthnConfigFieldStrategy = "strategy";
+export const AuthnConfigFieldWebhook = "webhook";
+
+export interface AuthnConfig {
+    sans?: string[];
+    strategy?: string;
+    webhook?: AuthWebhookConfig;
+}
+
+export const RKESystemImagesType = "rkeSystemImages";
+export const RKESystemImagesFieldAlpine = "alpine";
+export const RKESystemImagesFieldCalicoCNI = "calicoCni";
+export const RKESystemImagesFieldCalicoControllers = "calicoControllers";
+export const RKESystemImagesFieldCalicoCtl = "calicoCtl";
+export const RKESystemImagesFieldCalicoFlexVol = "calicoFlexVol";
+export const RKESystemImagesFieldCalicoNode = "calicoNode";
+export const RKESystemImagesFieldCanalCNI = "canalCni";
+export const RKESystemImagesFieldCanalFlannel = "canalFlannel";
+export const RKESystemImagesFieldCanalFlexVol = "canalFlexVol";
+export const RKESystemImagesFieldCanalNode = "canalNode";
+export const RKESystemImagesFieldCertDownloader = "certDownloader";
+export const RKESystemImagesFieldCoreDNS = "coredns";
+export const RKESystemImagesFieldCoreDNSAutoscaler = "corednsAutoscaler";
+export const RKESystemImagesFieldDNSmasq = "dnsmasq";
+export const RKESystemImagesFieldEtcd = "etcd";
+export const RKESystemImagesFieldFlannel = "flannel";
+export const RKESystemImagesFieldFlannelCNI = "flannelCni";
+export const RKESystemImagesFieldIngress = "ingress";
+export const RKESystemImagesFieldIngressBackend = "ingressBackend";
+export const RKESystemImagesFieldKubeDNS = "kubedns";
+export const RKESystemImagesFieldKubeDNSAutoscaler = "kubednsAutoscaler";
+export const RKESystemImagesFieldKubeDNSSidecar = "kubednsSidecar";
+export const RKESystemImagesFieldKubernetes = "kubernetes";
+export const RKESystemImagesFieldKubernetesServicesSidecar = "kubernetesServicesSidecar";
+export const RKESystemImagesFieldMetricsServer = "metricsServer";
+export const RKESystemImagesFieldNginxProxy = "nginxProxy";
+export const RKESystemImagesFieldPodInfraContainer = "podInfraContainer";
+export const RKESystemImagesFieldWeaveCNI = "weaveCni";
+export const RKESystemImagesFieldWeaveNode = "weaveNode";
+export const RKESystemImagesFieldWindowsPodInfraContainer = "windowsPodInfraContainer";
+
+export interface RKESystemImages {
+    alpine?: string;
+    calicoCni?: string;
+    calicoControllers?: string;
+    calicoCtl?: string;
+    calicoFlexVol?: string;
+    calicoNode?: string;
+    canalCni?: string;
+    canalFlannel?: string;
+    canalFlexVol?: string;
+    canalNode?: string;
+    certDownloader?: string;
+    coredns?: string;
+    corednsAutoscaler?: string;
+    dnsmasq?: string;
+    etcd?: string;
+    flannel?: string;
+    flannelCni?: string;
+    ingress?: string;
+    ingressBackend?: string;
+    kubedns?: string;
+    kubednsAutoscaler?: string;
+    kubednsSidecar?: string;
+    kubernetes?: string;
+    kubernetesServicesSidecar?: string;
+    metricsServer?: string;
+    nginxProxy?: string;
+    podInfraContainer?: string;
+    weaveCni?: string;
+    weaveNode?: string;
+    windowsPodInfraContainer?: string;
+}
+
+export const AuthzConfigType = "authzConfig";
+export const AuthzConfigFieldMode = "mode";
+export const AuthzConfigFieldOptions = "options";
+
+export interface AuthzConfig {
+    mode?: string;
+    options?: { [key: string]: string };
+}
+
+export const PrivateRegistryType = "privateRegistry";
+export const PrivateRegistryFieldIsDefault = "isDefault";
+export const PrivateRegistryFieldPassword = "password";
+export const PrivateRegistryFieldURL = "url";
+export const PrivateRegistryFieldUser = "user";
+
+export interface PrivateRegistry {
+    isDefault?: boolean;
+    password?: string;
+    url?: string;
+    user?: string;
+}
+
+export const IngressConfigType = "ingressConfig";
+export const IngressConfigFieldDNSPolicy = "dnsPolicy";
+export const IngressConfigFieldExtraArgs = "extraArgs";
+export const IngressConfigFieldExtraEnvs = "extraEnvs";
+export const IngressConfigFieldExtraVolumeMounts = "extraVolumeMounts";
+export const IngressConfigFieldExtraVolumes = "extraVolumes";
+export const IngressConfigFieldNodeSelector = "nodeSelector";
+export const IngressConfigFieldOptions = "options";
+export const IngressConfigFieldProvider = "provider";
+
+export interface IngressConfig {
+    dnsPolicy?: string;
+    extraArgs?: { [key: string]: string };
+    extraEnvs?: any[];
+    extraVolumeMounts?: any[];
+    extraVolumes?: any[];
+    nodeSelector?: { [key: string]: string };
+    options?: { [key: string]: string };
+    provider?: string;
+}
+
+export const GlobalAwsOptsType = "globalAwsOpts";
+export const GlobalAwsOptsFieldDisableSecurityGroupIngress = "disable-security-group-ingress";
+export const GlobalAwsOptsFieldDisableStrictZoneCheck = "disable-strict-zone-check";
+export const GlobalAwsOptsFieldElbSecurityGroup = "elb-security-group";
+export const GlobalAwsOptsFieldKubernetesClusterID = "kubernetes-cluster-id";
+export const GlobalAwsOptsFieldKubernetesClusterTag = "kubernetes-cluster-tag";
+export const GlobalAwsOptsFieldRoleARN = "role-arn";
+export const GlobalAwsOptsFieldRouteTableID = "routetable-id";
+export const GlobalAwsOptsFieldSubnetID = "subnet-id";
+export const GlobalAwsOptsFieldVPC = "vpc";
+export const GlobalAwsOptsFieldZone = "zone";
+
+export interface GlobalAwsOpts {
+    "disable-security-group-ingress"?: boolean;
+    "disable-strict-zone-check"?: boolean;
+    "elb-security-group"?: string;
+    "kubernetes-cluster-id"?: string;
+    "kubernetes-cluster-tag"?: string;
+    "role-arn"?: string;
+    "routetable-id"?: string;
+    "subnet-id"?: string;
+    vpc?: string;
+    zone?: string;
+}
+
+export const ServiceOverrideType = "serviceOverride";
+export const ServiceOverrideFieldRegion = "region";
+export const ServiceOverrideFieldService = "service";
+export const ServiceOverrideFieldSigningMethod = "signing-method";
+export const ServiceOverrideFieldSigningName = "signing-name";
+export const ServiceOverrideFieldSigningRegion = "signing-region";
+export const ServiceOverrideFieldURL = "url";
+
+export interface ServiceOverride {
+    region?: string;
+    service?: string;
+    "signing-method"?: string;
+    "signing-name"?: string;
+    "signing-region"?: string;
+    url?: string;
+}
+
+export const AWSCloudProviderType = "awsCloudProvider";
+export const AWSCloudProviderFieldGlobal = "global";
+export const AWSCloudProviderFieldServiceOverride = "serviceOverride";
+
+export interface AWSCloudProvider {
+    global?: GlobalAwsOpts;
+    serviceOverride?: { [key: string]: ServiceOverride };
+}
+
+export const AzureCloudProviderType = "azureCloudProvider";
+export const AzureCloudProviderFieldAADClientCertPassword = "aadClientCertPassword";
+export const AzureCloudProviderFieldAADClientCertPath = "aadClientCertPath";
+export const AzureCloudProviderFieldAADClientID = "aadClientId";
+export const AzureCloudProviderFieldAADClientSecret = "aadClientSecret";
+export const AzureCloudProviderFieldCloud = "cloud";
+export const AzureCloudProviderFieldCloudProviderBackoff = "cloudProviderBackoff";
+export const AzureCloudProviderFieldCloudProviderBackoffDuration = "cloudProviderBackoffDuration";
+export const AzureCloudProviderFieldCloudProviderBackoffExponent = "cloudProviderBackoffExponent";
+export const AzureCloudProviderFieldCloudProviderBackoffJitter = "cloudProviderBackoffJitter";
+export const AzureCloudProviderFieldCloudProviderBackoffRetries = "cloudProviderBackoffRetries";
+export const AzureCloudProviderFieldCloudProviderRateLimit = "cloudProviderRateLimit";
+export const AzureCloudProviderFieldCloudProviderRateLimitBucket = "cloudProviderRateLimitBucket";
+export const AzureCloudProviderFieldCloudProviderRateLimitQPS = "cloudProviderRateLimitQPS";
+export const AzureCloudProviderFieldExcludeMasterFromStandardLB = "excludeMasterFromStandardLB";
+export const AzureCloudProviderFieldLoadBalancerSku = "loadBalancerSku";
+export const AzureCloudProviderFieldLocation = "location";
+export const AzureCloudProviderFieldMaximumLoadBalancerRuleCount = "maximumLoadBalancerRuleCount";
+export const AzureCloudProviderFieldPrimaryAvailabilitySetName = "primaryAvailabilitySetName";
+export const AzureCloudProviderFieldPrimaryScaleSetName = "primaryScaleSetName";
+export const AzureCloudProviderFieldResourceGroup = "resourceGroup";
+export const AzureCloudProviderFieldRouteTableName = "routeTableName";
+export const AzureCloudProviderFieldSecurityGroupName = "securityGroupName";
+export const AzureCloudProviderFieldSubnetName = "subnetName";
+export const AzureCloudProviderFieldSubscriptionID = "subscriptionId";
+export const AzureCloudProviderFieldTenantID = "tenantId";
+export const AzureCloudProviderFieldUseInstanceMetadata = "useInstanceMetadata";
+export const AzureCloudProviderFieldUseManagedIdentityExtension = "useManagedIdentityExtension";
+export const AzureCloudProviderFieldUserAssignedIdentityID = "userAssignedIdentityID";
+export const AzureCloudProviderFieldVMType = "vmType";
+export const AzureCloudProviderFieldVnetName = "vnetName";
+export const AzureCloudProviderFieldVnetResourceGroup = "vnetResourceGroup";
+
+export interface AzureCloudProvider {
+    aadClientCertPassword?: string;
+    aadClientCertPath?: string;
+    aadClientId?: string;
+    aadClientSecret?: string;
+    cloud?: string;
+    cloudProviderBackoff?: boolean;
+    cloudProviderBackoffDuration?: number;
+    cloudProviderBackoffExponent?: number;
+    cloudProviderBackoffJitter?: number;
+    cloudProviderBackoffRetries?: number;
+    cloudProviderRateLimit?: boolean;
+    cloudProviderRateLimitBucket?: number;
+    cloudProviderRateLimitQPS?: number;
+    excludeMasterFromStandardLB?: boolean;
+    loadBalancerSku?: string;
+    location?: string;
+    maximumLoadBalancerRuleCount?: number;
+    primaryAvailabilitySetName?: string;
+    primaryScaleSetName?: string;
+    resourceGroup?: string;
+    routeTableName?: string;
+    securityGroupName?: string;
+    subnetName?: string;
+    subscriptionId?: string;
+    tenantId?: string;
+    useInstanceMetadata?: boolean;
+    useManagedIdentityExtension?: boolean;
+    userAssignedIdentityID?: string;
+    vmType?: string;
+    vnetName?: string;
+    vnetResourceGroup?: string;
+}
+
+export const GlobalOpenstackOptsType = "globalOpenstackOpts";
+export const GlobalOpenstackOptsFieldAuthURL = "auth-url";
+export const GlobalOpenstackOptsFieldCAFile = "ca-file";
+export const GlobalOpenstackOptsFieldDomainID = "domain-id";
+export const GlobalOpenstackOptsFieldDomainName = "domain-name";
+export const GlobalOpenstackOptsFieldPassword = "password";
+export const GlobalOpenstackOptsFieldRegion = "region";
+export const GlobalOpenstackOptsFieldTenantID = "tenant-id";
+export const GlobalOpenstackOptsFieldTenantName = "tenant-name";
+export const GlobalOpenstackOptsFieldTrustID = "trust-id";
+export const GlobalOpenstackOptsFieldUserID = "user-id";
+export const GlobalOpenstackOptsFieldUsername = "username";
+
+export interface GlobalOpenstackOpts {
+    "auth-url"?: string;
+    "ca-file"?: string;
+    "domain-id"?: string;
+    "domain-name"?: string;
+    password?: string;
+    region?: string;
+    "tenant-id"?: string;
+    "tenant-name"?: string;
+    "trust-id"?: string;
+    "user-id"?: string;
+    username?: string;
+}
+
+export const LoadBalancerOpenstackOptsType = "loadBalancerOpenstackOpts";
+export const LoadBalancerOpenstackOptsFieldCreateMonitor = "create-monitor";
+export const LoadBalancerOpenstackOptsFieldFloatingNetworkID = "floating-network-id";
+export const LoadBalancerOpenstackOptsFieldLBMethod = "lb-method";
+export const LoadBalancerOpenstackOptsFieldLBProvider = "lb-provider";
+export const LoadBalancerOpenstackOptsFieldLBVersion = "lb-version";
+export const LoadBalancerOpenstackOptsFieldManageSecurityGroups = "manage-security-groups";
+export const LoadBalancerOpenstackOptsFieldMonitorDelay = "monitor-delay";
+export const LoadBalancerOpenstackOptsFieldMonitorMaxRetries = "monitor-max-retries";
+export const LoadBalancerOpenstackOptsFieldMonitorTimeout = "monitor-timeout";
+export const LoadBalancerOpenstackOptsFieldSubnetID = "subnet-id";
+export const LoadBalancerOpenstackOptsFieldUseOctavia = "use-octavia";
+
+export interface LoadBalancerOpenstackOpts {
+    "create-monitor"?: boolean;
+    "floating-network-id"?: string;
+    "lb-method"?: string;
+    "lb-provider"?: string;
+    "lb-version"?: string;
+    "manage-security-groups"?: boolean;
+    "monitor-delay"?: string;
+    "monitor-max-retries"?: number;
+    "monitor-timeout"?: string;
+    "subnet-id"?: string;
+    "use-octavia"?: boolean;
+}
+
+export const BlockStorageOpenstackOptsType = "blockStorageOpenstackOpts";
+export const BlockStorageOpenstackOptsFieldBSVersion = "bs-version";
+export const BlockStorageOpenstackOptsFieldIgnoreVolumeAZ = "ignore-volume-az";
+export const BlockStorageOpenstackOptsFieldTrustDevicePath = "trust-device-path";
+
+export interface BlockStorageOpenstackOpts {
+    "bs-version"?: string;
+    "ignore-volume-az"?: boolean;
+    "trust-device-path"?: boolean;
+}
+
+export const RouteOpenstackOptsType = "routeOpenstackOpts";
+export const RouteOpenstackOptsFieldRouterID = "router-id";
+
+export interface RouteOpenstackOpts {
+    "router-id"?: string;
+}
+
+export const MetadataOpenstackOptsType = "metadataOpenstackOpts";
+export const MetadataOpenstackOptsFieldRequestTimeout = "request-timeout";
+export const MetadataOpenstackOptsFieldSearchOrder = "search-order";
+
+export interface MetadataOpenstackOpts {
+    "request-timeout"?: number;
+    "search-order"?: string;
+}
+
+export const OpenstackCloudProviderType = "openstackCloudProvider";
+export const OpenstackCloudProviderFieldBlockStorage = "blockStorage";
+export const OpenstackCloudProviderFieldGlobal = "global";
+export const OpenstackCloudProviderFieldLoadBalancer = "loadBalancer";
+export const OpenstackCloudProviderFieldMetadata = "metadata";
+export const OpenstackCloudProviderFieldRoute = "route";
+
+export interface OpenstackCloudProvider {
+    blockStorage?: BlockStorageOpenstackOpts;
+    global?: GlobalOpenstackOpts;
+    loadBalancer?: LoadBalancerOpenstackOpts;
+    metadata?: MetadataOpenstackOpts;
+    route?: RouteOpenstackOpts;
+}
+
+export const GlobalVsphereOptsType = "globalVsphereOpts";
+export const GlobalVsphereOptsFieldDatacenter = "datacenter";
+export const GlobalVsphereOptsFieldDatacenters = "datacenters";
+export const GlobalVsphereOptsFieldDefaultDatastore = "datastore";
+export const GlobalVsphereOptsFieldInsecureFlag = "insecure-flag";
+export const GlobalVsphereOptsFieldPassword = "password";
+export const GlobalVsphereOptsFieldRoundTripperCount = "soap-roundtrip-count";
+export const GlobalVsphereOptsFieldUser = "user";
+export const GlobalVsphereOptsFieldVCenterIP = "server";
+export const GlobalVsphereOptsFieldVCenterPort = "port";
+export const GlobalVsphereOptsFieldVMName = "vm-name";
+export const GlobalVsphereOptsFieldVMUUID = "vm-uuid";
+export const GlobalVsphereOptsFieldWorkingDir = "working-dir";
+
+export interface GlobalVsphereOpts {
+    datacenter?: string;
+    datacenters?: string;
+    datastore?: string;
+    "insecure-flag"?: boolean;
+    password?: string;
+    "soap-roundtrip-count"?: number;
+    user?: string;
+    server?: string;
+    port?: string;
+    "vm-name"?: string;
+    "vm-uuid"?: string;
+    "working-dir"?: string;
+}
+
+export const VirtualCenterConfigType = "virtualCenterConfig";
+export const VirtualCenterConfigFieldDatacenters = "datacenters";
+export const VirtualCenterConfigFieldPassword = "password";
+export const VirtualCenterConfigFieldRoundTripperCount = "soap-roundtrip-count";
+export const VirtualCenterConfigFieldUser = "user";
+export const VirtualCenterConfigFieldVCenterPort = "port";
+
+export interface VirtualCenterConfig {
+    datacenters?: string;
+    password?: string;
+    "soap-roundtrip-count"?: number;
+    user?: string;
+    port?: string;
+}
+
+export const NetworkVshpereOptsType = "networkVshpereOpts";
+export const NetworkVshpereOptsFieldPublicNetwork = "public-network";
+
+export interface NetworkVshpereOpts {
+    "public-network"?: string;
+}
+
+export const DiskVsphereOptsType = "diskVsphereOpts";
+export const DiskVsphereOptsFieldSCSIControllerType = "scsicontrollertype";
+
+export interface DiskVsphereOpts {
+    scsicontrollertype?: string;
+}
+
+export const WorkspaceVsphereOptsType = "workspaceVsphereOpts";
+export const WorkspaceVsphereOptsFieldDatacenter = "datacenter";
+export const WorkspaceVsphereOptsFieldDefaultDatastore = "default-datastore";
+export const WorkspaceVsphereOptsFieldFolder = "folder";
+export const WorkspaceVsphereOptsFieldResourcePoolPath = "resourcepool-path";
+export const WorkspaceVsphereOptsFieldVCenterIP = "server";
+
+export interface WorkspaceVsphereOpts {
+    datacenter?: string;
+    "default-datastore"?: string;
+    folder?: string;
+    "resourcepool-path"?: string;
+    server?: string;
+}
+
+export const VsphereCloudProviderType = "vsphereCloudProvider";
+export const VsphereCloudProviderFieldDisk = "disk";
+export const VsphereCloudProviderFieldGlobal = "global";
+export const VsphereCloudProviderFieldNetwork = "network";
+export const VsphereCloudProviderFieldVirtualCenter = "virtualCenter";
+export const VsphereCloudProviderFieldWorkspace = "workspace";
+
+export interface VsphereCloudProvider {
+    disk?: DiskVsphereOpts;
+    global?: GlobalVsphereOpts;
+    network?: NetworkVshpereOpts;
+    virtualCenter?: { [key: string]: VirtualCenterConfig };
+    workspace?: WorkspaceVsphereOpts;
+}
+
+export const CloudProviderType = "cloudProvider";
+export const CloudProviderFieldAWSCloudProvider = "awsCloudProvider";
+export const CloudProviderFieldAzureCloudProvider = "azureCloudProvider";
+export const CloudProviderFieldCustomCloudProvider = "customCloudProvider";
+export const CloudProviderFieldName = "name";
+export const CloudProviderFieldOpenstackCloudProvider = "openstackCloudProvider";
+export const CloudProviderFieldVsphereCloudProvider = "vsphereCloudProvider";
+
+export interface CloudProvider {
+    awsCloudProvider?: AWSCloudProvider;
+    azureCloudProvider?: AzureCloudProvider;
+    customCloudProvider?: string;
+    name?: string;
+    openstackCloudProvider?: OpenstackCloudProvider;
+    vsphereCloudProvider?: VsphereCloudProvider;
+}
+
+export const BastionHostType = "bastionHost";
+export const BastionHostFieldAddress = "address";
+export const BastionHostFieldPort = "port";
+export const BastionHostFieldSSHAgentAuth = "sshAgentAuth";
+export const BastionHostFieldSSHCert = "sshCert";
+export const BastionHostFieldSSHCertPath = "sshCertPath";
+export const BastionHostFieldSSHKey = "sshKey";
+export const BastionHostFieldSSHKeyPath = "sshKeyPath";
+export const BastionHostFieldUser = "user";
+
+export interface BastionHost {
+    address?: string;
+    port?: string;
+    sshAgentAuth?: boolean;
+    sshCert?: string;
+    sshCertPath?: string;
+    sshKey?: string;
+    sshKeyPath?: string;
+    user?: string;
+}
+
+export const MonitoringConfigType = "monitoringConfig";
+export const MonitoringConfigFieldNodeSelector = "nodeSelector";
+export const MonitoringConfigFieldOptions = "options";
+export const MonitoringConfigFieldProvider = "provider";
+
+export interface MonitoringConfig {
+    nodeSelector?: { [key: string]: string };
+    options?: { [key: string]: string };
+    provider?: string;
+}
+
+export const RestoreConfigType = "restoreConfig";
+export const RestoreConfigFieldRestore = "restore";
+export const RestoreConfigFieldSnapshotName = "snapshotName";
+
+export interface RestoreConfig {
+    restore?: boolean;
+    snapshotName?: string;
+}
+
+export const RotateCertificatesType = "rotateCertificates";
+export const RotateCertificatesFieldCACertificates = "caCertificates";
+export const RotateCertificatesFieldServices = "services";
+
+export interface RotateCertificates {
+    caCertificates?: boolean;
+    services?: "etcd" | "kubelet" | "kube-apiserver" | "kube-proxy" | "kube-scheduler" | "kube-controller-manager";
+}
+
+export const DNSConfigType = "dnsConfig";
+export const DNSConfigFieldNodeSelector = "nodeSelector";
+export const DNSConfigFieldProvider = "provider";
+export const DNSConfigFieldReverseCIDRs = "reversecidrs";
+export const DNSConfigFieldStubDomains = "stubdomains";
+export const DNSConfigFieldUpstreamNameservers = "upstreamnameservers";
+
+export interface DNSConfig {
+    nodeSelector?: { [key: string]: string };
+    provider?: string;
+    reversecidrs?: string[];
+    stubdomains?: { [key: string]: string[] };
+    upstreamnameservers?: string[];
+}
+
+export const RancherKubernetesEngineConfigType = "rancherKubernetesEngineConfig";
+export const RancherKubernetesEngineConfigFieldAddonJobTimeout = "addonJobTimeout";
+export const RancherKubernetesEngineConfigFieldAddons = "addons";
+export const RancherKubernetesEngineConfigFieldAddonsInclude = "addonsInclude";
+export const RancherKubernetesEngineConfigFieldAuthentication = "authentication";
+export const RancherKubernetesEngineConfigFieldAuthorization = "authorization";
+export const RancherKubernetesEngineConfigFieldBastionHost = "bastionHost";
+export const RancherKubernetesEngineConfigFieldCloudProvider = "cloudProvider";
+export const RancherKubernetesEngineConfigFieldClusterName = "clusterName";
+export const RancherKubernetesEngineConfigFieldDNS = "dns";
+export const RancherKubernetesEngineConfigFieldIgnoreDockerVersion = "ignoreDockerVersion";
+export const RancherKubernetesEngineConfigFieldIngress = "ingress";
+export const RancherKubernetesEngineConfigFieldMonitoring = "monitoring";
+export const RancherKubernetesEngineConfigFieldNetwork = "network";
+export const RancherKubernetesEngineConfigFieldNodes = "nodes";
+export const RancherKubernetesEngineConfigFieldPrefixPath = "prefixPath";
+export const RancherKubernetesEngineConfigFieldPrivateRegistries = "privateRegistries";
+export const RancherKubernetesEngineConfigFieldRestore = "restore";
+export const RancherKubernetesEngineConfigFieldRotateCertificates = "rotateCertificates";
+export const RancherKubernetesEngineConfigFieldSSHAgentAuth = "sshAgentAuth";
+export const RancherKubernetesEngineConfigFieldSSHCertPath = "sshCertPath";
+export const RancherKubernetesEngineConfigFieldSSHKeyPath = "sshKeyPath";
+export const RancherKubernetesEngineConfigFieldServices = "services";
+export const RancherKubernetesEngineConfigFieldVersion = "kubernetesVersion";
+
+export interface RancherKubernetesEngineConfig {
+    addonJobTimeout?: number;
+    addons?: string;
+    addonsInclude?: string[];
+    authentication?: AuthnConfig;
+    authorization?: AuthzConfig;
+    bastionHost?: BastionHost;
+    cloudProvider?: CloudProvider;
+    clusterName?: string;
+    dns?: DNSConfig;
+    ignoreDockerVersion?: boolean;
+    ingress?: IngressConfig;
+    monitoring?: MonitoringConfig;
+    network?: NetworkConfig;
+    nodes?: RKEConfigNode[];
+    prefixPath?: string;
+    privateRegistries?: PrivateRegistry[];
+    restore?: RestoreConfig;
+    rotateCertificates?: RotateCertificates;
+    sshAgentAuth?: boolean;
+    sshCertPath?: string;
+    sshKeyPath?: string;
+    services?: RKEConfigServices;
+    kubernetesVersion?: string;
+}
+
+export const LocalClusterAuthEndpointType = "localClusterAuthEndpoint";
+export const LocalClusterAuthEndpointFieldCACerts = "caCerts";
+export const LocalClusterAuthEndpointFieldEnabled = "enabled";
+export const LocalClusterAuthEndpointFieldFQDN = "fqdn";
+
+export interface LocalClusterAuthEndpoint {
+    caCerts?: string;
+    enabled?: boolean;
+    fqdn?: string;
+}
+
+export const ImportedConfigType = "importedConfig";
+export const ImportedConfigFieldKubeConfig = "kubeConfig";
+
+export interface ImportedConfig {
+    kubeConfig?: string;
+}
+
+export const AnswerType = "answer";
+export const AnswerFieldClusterID = "clusterId";
+export const AnswerFieldProjectID = "projectId";
+export const AnswerFieldValues = "values";
+
+export interface Answer {
+    clusterId?: string;
+    projectId?: string;
+    values?: { [key: string]: string };
+}
+
+export const SubQuestionType = "subQuestion";
+export const SubQuestionFieldDefault = "default";
+export const SubQuestionFieldDescription = "description";
+export const SubQuestionFieldGroup = "group";
+export const SubQuestionFieldInvalidChars = "invalidChars";
+export const SubQuestionFieldLabel = "label";
+export const SubQuestionFieldMax = "max";
+export const SubQuestionFieldMaxLength = "maxLength";
+export const SubQuestionFieldMin = "min";
+export const SubQuestionFieldMinLength = "minLength";
+export const SubQuestionFieldOptions = "options";
+export const SubQuestionFieldRequired = "required";
+export const SubQuestionFieldSatisfies = "satisfies";
+export const SubQuestionFieldShowIf = "showIf";
+export const SubQuestionFieldType = "type";
+export const SubQuestionFieldValidChars = "validChars";
+export const SubQuestionFieldVariable = "variable";
+
+export interface SubQuestion {
+    default?: string;
+    description?: string;
+    group?: string;
+    invalidChars?: string;
+    label?: string;
+    max?: number;
+    maxLength?: number;
+    min?: number;
+    minLength?: number;
+    options?: string[];
+    required?: boolean;
+    satisfies?: string;
+    showIf?: string;
+    type?: string;
+    validChars?: string;
+    variable?: string;
+}
+
+export const QuestionType = "question";
+export const QuestionFieldDefault = "default";
+export const QuestionFieldDescription = "description";
+export const QuestionFieldGroup = "group";
+export const QuestionFieldInvalidChars = "invalidChars";
+export const QuestionFieldLabel = "label";
+export const QuestionFieldMax = "max";
+export const QuestionFieldMaxLength = "maxLength";
+export const QuestionFieldMin = "min";
+export const QuestionFieldMinLength = "minLength";
+export const QuestionFieldOptions = "options";
+export const QuestionFieldRequired = "required";
+export const QuestionFieldSatisfies = "satisfies";
+export const QuestionFieldShowIf = "showIf";
+export const QuestionFieldShowSubquestionIf = "showSubquestionIf";
+export const QuestionFieldSubquestions = "subquestions";
+export const QuestionFieldType = "type";
+export const QuestionFieldValidChars = "validChars";
+export const QuestionFieldVariable = "variable";
+
+export interface Question {
+    default?: string;
+    description?: string;
+    group?: string;
+    invalidChars?: string;
+    label?: string;
+    max?: number;
+    maxLength?: number;
+    min?: number;
+    minLength?: number;
+    options?: string[];
+    required?: boolean;
+    satisfies?: string;
+    showIf?: string;
+    showSubquestionIf?: string;
+    subquestions?: SubQuestion[];
+    type?: string;
+    validChars?: string;
+    variable?: string;
+}
+
+export const ClusterSpecType = "clusterSpec";
+export const ClusterSpecFieldAgentImageOverride = "agentImageOverride";
+export const ClusterSpecFieldAmazonElasticContainerServiceConfig = "amazonElasticContainerServiceConfig";
+export const ClusterSpecFieldAzureKubernetesServiceConfig = "azureKubernetesServiceConfig";
+export const ClusterSpecFieldClusterTemplateAnswers = "answers";
+export const ClusterSpecFieldClusterTemplateID = "clusterTemplateId";
+export const ClusterSpecFieldClusterTemplateQuestions = "questions";
+export const ClusterSpecFieldClusterTemplateRevisionID = "clusterTemplateRevisionId";
+export const ClusterSpecFieldDefaultClusterRoleForProjectMembers = "defaultClusterRoleForProjectMembers";
+export const ClusterSpecFieldDefaultPodSecurityPolicyTemplateID = "defaultPodSecurityPolicyTemplateId";
+export const ClusterSpecFieldDescription = "description";
+export const ClusterSpecFieldDesiredAgentImage = "desiredAgentImage";
+export const ClusterSpecFieldDesiredAuthImage = "desiredAuthImage";
+export const ClusterSpecFieldDisplayName = "displayName";
+export const ClusterSpecFieldDockerRootDir = "dockerRootDir";
+export const ClusterSpecFieldEnableClusterAlerting = "enableClusterAlerting";
+export const ClusterSpecFieldEnableClusterMonitoring = "enableClusterMonitoring";
+export const ClusterSpecFieldEnableNetworkPolicy = "enableNetworkPolicy";
+export const ClusterSpecFieldGenericEngineConfig = "genericEngineConfig";
+export const ClusterSpecFieldGoogleKubernetesEngineConfig = "googleKubernetesEngineConfig";
+export const ClusterSpecFieldImportedConfig = "importedConfig";
+export const ClusterSpecFieldInternal = "internal";
+export const ClusterSpecFieldLocalClusterAuthEndpoint = "localClusterAuthEndpoint";
+export const ClusterSpecFieldRancherKubernetesEngineConfig = "rancherKubernetesEngineConfig";
+export const ClusterSpecFieldWindowsPreferedCluster = "windowsPreferedCluster";
+
+export interface ClusterSpec {
+    agentImageOverride?: string;
+    amazonElasticContainerServiceConfig?: { [key: string]: any };
+    azureKubernetesServiceConfig?: { [key: string]: any };
+    answers?: Answer;
+    clusterTemplateId?: string;
+    questions?: Question[];
+    clusterTemplateRevisionId?: string;
+    defaultClusterRoleForProjectMembers?: string;
+    defaultPodSecurityPolicyTemplateId?: string;
+    description?: string;
+    desiredAgentImage?: string;
+    desiredAuthImage?: string;
+    displayName?: string;
+    dockerRootDir?: string;
+    enableClusterAlerting?: boolean;
+    enableClusterMonitoring?: boolean;
+    enableNetworkPolicy?: boolean;
+    genericEngineConfig?: { [key: string]: any };
+    googleKubernetesEngineConfig?: { [key: string]: any };
+    importedConfig?: ImportedConfig;
+    internal?: boolean;
+    localClusterAuthEndpoint?: LocalClusterAuthEndpoint;
+    rancherKubernetesEngineConfig?: RancherKubernetesEngineConfig;
+    windowsPreferedCluster?: boolean;
+}
+
+export const ClusterConditionType = "clusterCondition";
+export const ClusterConditionFieldLastTransitionTime = "lastTransitionTime";
+export const ClusterConditionFieldLastUpdateTime = "lastUpdateTime";
+export const ClusterConditionFieldMessage = "message";
+export const ClusterConditionFieldReason = "reason";
+export const ClusterConditionFieldStatus = "status";
+export const ClusterConditionFieldType = "type";
+
+export interface ClusterCondition {
+    lastTransitionTime?: string;
+    lastUpdateTime?: string;
+    message?: string;
+    reason?: string;
+    status?: string;
+    type?: string;
+}
+
+export const ComponentConditionType = "componentCondition";
+export const ComponentConditionFieldError = "error";
+export const ComponentConditionFieldMessage = "message";
+export const ComponentConditionFieldStatus = "status";
+export const ComponentConditionFieldType = "type";
+
+export interface ComponentCondition {
+    error?: string;
+    message?: string;
+    status?: string;
+    type?: string;
+}
+
+export const ClusterComponentStatusType = "clusterComponentStatus";
+export const ClusterComponentStatusFieldConditions = "conditions";
+export const ClusterComponentStatusFieldName = "name";
+
+export interface ClusterComponentStatus {
+    conditions?: ComponentCondition[];
+    name?: string;
+}
+
+export const InfoType = "info";
+export const InfoFieldBuildDate = "buildDate";
+export const InfoFieldCompiler = "compiler";
+export const InfoFieldGitCommit = "gitCommit";
+export const InfoFieldGitTreeState = "gitTreeState";
+export const InfoFieldGitVersion = "gitVersion";
+export const InfoFieldGoVersion = "goVersion";
+export const InfoFieldMajor = "major";
+export const InfoFieldMinor = "minor";
+export const InfoFieldPlatform = "platform";
+
+export interface Info {
+    buildDate?: string;
+    compiler?: string;
+    gitCommit?: string;
+    gitTreeState?: string;
+    gitVersion?: string;
+    goVersion?: string;
+    major?: string;
+    minor?: string;
+    platform?: string;
+}
+
+export const LoadBalancerCapabilitiesType = "loadBalancerCapabilities";
+export const LoadBalancerCapabilitiesFieldEnabled = "enabled";
+export const LoadBalancerCapabilitiesFieldHealthCheckSupported = "healthCheckSupported";
+export const LoadBalancerCapabilitiesFieldProtocolsSupported = "protocolsSupported";
+export const LoadBalancerCapabilitiesFieldProvider = "provider";
+
+export interface LoadBalancerCapabilities {
+    enabled?: boolean;
+    healthCheckSupported?: boolean;
+    protocolsSupported?: string[];
+    provider?: string;
+}
+
+export const IngressCapabilitiesType = "ingressCapabilities";
+export const IngressCapabilitiesFieldCustomDefaultBackend = "customDefaultBackend";
+export const IngressCapabilitiesFieldIngressProvider = "ingressProvider";
+
+export interface IngressCapabilities {
+    customDefaultBackend?: boolean;
+    ingressProvider?: string;
+}
+
+export const CapabilitiesType = "capabilities";
+export const CapabilitiesFieldIngressCapabilities = "ingressCapabilities";
+export const CapabilitiesFieldLoadBalancerCapabilities = "loadBalancerCapabilities";
+export const CapabilitiesFieldNodePoolScalingSupported = "nodePoolScalingSupported";
+export const CapabilitiesFieldNodePortRange = "nodePortRange";
+export const CapabilitiesFieldPspEnabled = "pspEnabled";
+export const CapabilitiesFieldTaintSupport = "taintSupport";
+
+export interface Capabilities {
+    ingressCapabilities?: IngressCapabilities[];
+    loadBalancerCapabilities?: LoadBalancerCapabilities;
+    nodePoolScalingSupported?: boolean;
+    nodePortRange?: string;
+    pspEnabled?: boolean;
+    taintSupport?: boolean;
+}
+
+export const CertExpirationType = "certExpiration";
+export const CertExpirationFieldExpirationDate = "expirationDate";
+
+export interface CertExpiration {
+    expirationDate?: string;
+}
+
+export const ClusterStatusType = "clusterStatus";
+export const ClusterStatusFieldAPIEndpoint = "apiEndpoint";
+export const ClusterStatusFieldAgentImage = "agentImage";
+export const ClusterStatusFieldAllocatable = "allocatable";
+export const ClusterStatusFieldAppliedEnableNetworkPolicy = "appliedEnableNetworkPolicy";
+export const ClusterStatusFieldAppliedPodSecurityPolicyTemplateName = "appliedPodSecurityPolicyTemplateId";
+export const ClusterStatusFieldAppliedSpec = "appliedSpec";
+export const ClusterStatusFieldAuthImage = "authImage";
+export const ClusterStatusFieldCACert = "caCert";
+export const ClusterStatusFieldCapabilities = "capabilities";
+export const ClusterStatusFieldCapacity = "capacity";
+export const ClusterStatusFieldCertificatesExpiration = "certificatesExpiration";
+export const ClusterStatusFieldComponentStatuses = "componentStatuses";
+export const ClusterStatusFieldConditions = "conditions";
+export const ClusterStatusFieldDriver = "driver";
+export const ClusterStatusFieldFailedSpec = "failedSpec";
+export const ClusterStatusFieldIstioEnabled = "istioEnabled";
+export const ClusterStatusFieldLimits = "limits";
+export const ClusterStatusFieldMonitoringStatus = "monitoringStatus";
+export const ClusterStatusFieldRequested = "requested";
+export const ClusterStatusFieldVersion = "version";
+
+export interface ClusterStatus {
+    apiEndpoint?: string;
+    agentImage?: string;
+    allocatable?: { [key: string]: string };
+    appliedEnableNetworkPolicy?: boolean;
+    appliedPodSecurityPolicyTemplateId?: string;
+    appliedSpec?: ClusterSpec;
+    authImage?: string;
+    caCert?: string;
+    capabilities?: Capabilities;
+    capacity?: { [key: string]: string };
+    certificatesExpiration?: { [key: string]: CertExpiration };
+    componentStatuses?: ClusterComponentStatus[];
+    conditions?: ClusterCondition[];
+    driver?: string;
+    failedSpec?: ClusterSpec;
+    istioEnabled?: boolean;
+    limits?: { [key: string]: string };
+    monitoringStatus?: MonitoringStatus;
+    requested?: { [key: string]: string };
+    version?: Info;
+}
+
+export const ClusterType = "cluster";
+export const ClusterFieldAPIEndpoint = "apiEndpoint";
+export const ClusterFieldAgentImage = "agentImage";
+export const ClusterFieldAgentImageOverride = "agentImageOverride";
+export const ClusterFieldAllocatable = "allocatable";
+export const ClusterFieldAnnotations = "annotations";
+export const ClusterFieldAppliedEnableNetworkPolicy = "appliedEnableNetworkPolicy";
+export const ClusterFieldAppliedPodSecurityPolicyTemplateName = "appliedPodSecurityPolicyTemplateId";
+export const ClusterFieldAppliedSpec = "appliedSpec";
+export const ClusterFieldAuthImage = "authImage";
+export const ClusterFieldCACert = "caCert";
+export const ClusterFieldCapabilities = "capabilities";
+export const ClusterFieldCapacity = "capacity";
+export const ClusterFieldCertificatesExpiration = "certificatesExpiration";
+export const ClusterFieldClusterTemplateAnswers = "answers";
+export const ClusterFieldClusterTemplateID = "clusterTemplateId";
+export const ClusterFieldClusterTemplateQuestions = "questions";
+export const ClusterFieldClusterTemplateRevisionID = "clusterTemplateRevisionId";
+export const ClusterFieldComponentStatuses = "componentStatuses";
+export const ClusterFieldConditions = "conditions";
+export const ClusterFieldCreated = "created";
+export const ClusterFieldCreatorID = "creatorId";
+export const ClusterFieldDefaultClusterRoleForProjectMembers = "defaultClusterRoleForProjectMembers";
+export const ClusterFieldDefaultPodSecurityPolicyTemplateID = "defaultPodSecurityPolicyTemplateId";
+export const ClusterFieldDescription = "description";
+export const ClusterFieldDesiredAgentImage = "desiredAgentImage";
+export const ClusterFieldDesiredAuthImage = "desiredAuthImage";
+export const ClusterFieldDockerRootDir = "dockerRootDir";
+export const ClusterFieldDriver = "driver";
+export const ClusterFieldEnableClusterAlerting = "enableClusterAlerting";
+export const ClusterFieldEnableClusterMonitoring = "enableClusterMonitoring";
+export const ClusterFieldEnableNetworkPolicy = "enableNetworkPolicy";
+export const ClusterFieldFailedSpec = "failedSpec";
+export const ClusterFieldImportedConfig = "importedConfig";
+export const ClusterFieldInternal = "internal";
+export const ClusterFieldIstioEnabled = "istioEnabled";
+export const ClusterFieldLabels = "labels";
+export const ClusterFieldLimits = "limits";
+export const ClusterFieldLocalClusterAuthEndpoint = "localClusterAuthEndpoint";
+export const ClusterFieldMonitoringStatus = "monitoringStatus";
+export const ClusterFieldName = "name";
+export const ClusterFieldOwnerReferences = "ownerReferences";
+export const ClusterFieldRancherKubernetesEngineConfig = "rancherKubernetesEngineConfig";
+export const ClusterFieldRemoved = "removed";
+export const ClusterFieldRequested = "requested";
+export const ClusterFieldState = "state";
+export const ClusterFieldTransitioning = "transitioning";
+export const ClusterFieldTransitioningMessage = "transitioningMessage";
+export const ClusterFieldUUID = "uuid";
+export const ClusterFieldVersion = "version";
+export const ClusterFieldWindowsPreferedCluster = "windowsPreferedCluster";
+export const ClusterActionBackupEtcd = "backupEtcd";
+export const ClusterActionDisableMonitoring = "disableMonitoring";
+export const ClusterActionEditMonitoring = "editMonitoring";
+export const ClusterActionEnableMonitoring = "enableMonitoring";
+export const ClusterActionExportYaml = "exportYaml";
+export const ClusterActionGenerateKubeconfig = "generateKubeconfig";
+export const ClusterActionImportYaml = "importYaml";
+export const ClusterActionRestoreFromEtcdBackup = "restoreFromEtcdBackup";
+export const ClusterActionRotateCertificates = "rotateCertificates";
+export const ClusterActionRunSecurityScan = "runSecurityScan";
+export const ClusterActionSaveAsTemplate = "saveAsTemplate";
+export const ClusterActionViewMonitoring = "viewMonitoring";
+
+export interface Cluster extends ApiResource {
+    apiEndpoint?: string;
+    agentImage?: string;
+    agentImageOverride?: string;
+    allocatable?: { [key: string]: string };
+    annotations?: { [key: string]: string };
+    appliedEnableNetworkPolicy?: boolean;
+    appliedPodSecurityPolicyTemplateId?: string;
+    appliedSpec?: ClusterSpec;
+    authImage?: string;
+    caCert?: string;
+    capabilities?: Capabilities;
+    capacity?: { [key: string]: string };
+    certificatesExpiration?: { [key: string]: CertExpiration };
+    answers?: Answer;
+    clusterTemplateId?: string;
+    questions?: Question[];
+    clusterTemplateRevisionId?: string;
+    componentStatuses?: ClusterComponentStatus[];
+    conditions?: ClusterCondition[];
+    created?: string;
+    creatorId?: string;
+    defaultClusterRoleForProjectMembers?: string;
+    defaultPodSecurityPolicyTemplateId?: string;
+    description?: string;
+    desiredAgentImage?: string;
+    desiredAuthImage?: string;
+    dockerRootDir?: string;
+    driver?: string;
+    enableClusterAlerting?: boolean;
+    enableClusterMonitoring?: boolean;
+    enableNetworkPolicy?: boolean;
+    failedSpec?: ClusterSpec;
+    importedConfig?: ImportedConfig;
+    internal?: boolean;
+    istioEnabled?: boolean;
+    labels?: { [key: string]: string };
+    limits?: { [key: string]: string };
+    localClusterAuthEndpoint?: LocalClusterAuthEndpoint;
+    monitoringStatus?: MonitoringStatus;
+    name?: string;
+    ownerReferences?: OwnerReference[];
+    rancherKubernetesEngineConfig?: RancherKubernetesEngineConfig;
+    removed?: string;
+    requested?: { [key: string]: string };
+    state?: string;
+    transitioning?: "yes" | "no" | "error";
+    transitioningMessage?: string;
+    uuid?: string;
+    version?: Info;
+    windowsPreferedCluster?: boolean;
+}
+
+export interface ClusterCollection extends ApiCollection {
+    data?: Cluster[];
+}
+
+export class ClusterClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<ClusterCollection> {
+        return this.ops.DoList(ClusterType, opts);
+    }
+
+    Next(collection: ClusterCollection): Promise<ClusterCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: Cluster): Promise<Cluster> {
+        return this.ops.DoCreate(ClusterType, container);
+    }
+
+    Update(existing: Cluster, updates: object): Promise<Cluster> {
+        return this.ops.DoUpdate(ClusterType, existing, updates);
+    }
+
+    Replace(obj: Cluster): Promise<Cluster> {
+        return this.ops.DoReplace(ClusterType, obj, obj);
+    }
+
+    ByID(id: string): Promise<Cluster> {
+        return this.ops.DoByID(ClusterType, id);
+    }
+
+    Delete(container: Cluster): Promise<void> {
+        return this.ops.DoResourceDelete(ClusterType, container);
+    }
+
+    ActionBackupEtcd(resource: Cluster): Promise<void> {
+        return this.ops.DoAction(ClusterType, ClusterActionBackupEtcd, resource);
+    }
+
+    ActionDisableMonitoring(resource: Cluster): Promise<void> {
+        return this.ops.DoAction(ClusterType, ClusterActionDisableMonitoring, resource);
+    }
+
+    ActionEditMonitoring(resource: Cluster, input: MonitoringInput): Promise<void> {
+        return this.ops.DoAction(ClusterType, ClusterActionEditMonitoring, resource, input);
+    }
+
+    ActionEnableMonitoring(resource: Cluster, input: MonitoringInput): Promise<void> {
+        return this.ops.DoAction(ClusterType, ClusterActionEnableMonitoring, resource, input);
+    }
+
+    ActionExportYaml(resource: Cluster): Promise<ExportOutput> {
+        return this.ops.DoAction(ClusterType, ClusterActionExportYaml, resource);
+    }
+
+    ActionGenerateKubeconfig(resource: Cluster): Promise<GenerateKubeConfigOutput> {
+        return this.ops.DoAction(ClusterType, ClusterActionGenerateKubeconfig, resource);
+    }
+
+    ActionImportYaml(resource: Cluster, input: ImportClusterYamlInput): Promise<ImportYamlOutput> {
+        return this.ops.DoAction(ClusterType, ClusterActionImportYaml, resource, input);
+    }
+
+    ActionRestoreFromEtcdBackup(resource: Cluster, input: RestoreFromEtcdBackupInput): Promise<void> {
+        return this.ops.DoAction(ClusterType, ClusterActionRestoreFromEtcdBackup, resource, input);
+    }
+
+    ActionRotateCertificates(resource: Cluster, input: RotateCertificateInput): Promise<RotateCertificateOutput> {
+        return this.ops.DoAction(ClusterType, ClusterActionRotateCertificates, resource, input);
+    }
+
+    ActionRunSecurityScan(resource: Cluster): Promise<void> {
+        return this.ops.DoAction(ClusterType, ClusterActionRunSecurityScan, resource);
+    }
+
+    ActionSaveAsTemplate(resource: Cluster, input: SaveAsTemplateInput): Promise<SaveAsTemplateOutput> {
+        return this.ops.DoAction(ClusterType, ClusterActionSaveAsTemplate, resource, input);
+    }
+
+    ActionViewMonitoring(resource: Cluster): Promise<MonitoringOutput> {
+        return this.ops.DoAction(ClusterType, ClusterActionViewMonitoring, resource);
+    }
+}
+
+export const ClusterRegistrationTokenSpecType = "clusterRegistrationTokenSpec";
+export const ClusterRegistrationTokenSpecFieldClusterID = "clusterId";
+
+export interface ClusterRegistrationTokenSpec {
+    clusterId?: string;
+}
+
+export const ClusterRegistrationTokenStatusType = "clusterRegistrationTokenStatus";
+export const ClusterRegistrationTokenStatusFieldCommand = "command";
+export const ClusterRegistrationTokenStatusFieldInsecureCommand = "insecureCommand";
+export const ClusterRegistrationTokenStatusFieldManifestURL = "manifestUrl";
+export const ClusterRegistrationTokenStatusFieldNodeCommand = "nodeCommand";
+export const ClusterRegistrationTokenStatusFieldToken = "token";
+export const ClusterRegistrationTokenStatusFieldWindowsNodeCommand = "windowsNodeCommand";
+
+export interface ClusterRegistrationTokenStatus {
+    command?: string;
+    insecureCommand?: string;
+    manifestUrl?: string;
+    nodeCommand?: string;
+    token?: string;
+    windowsNodeCommand?: string;
+}
+
+export const ClusterRegistrationTokenType = "clusterRegistrationToken";
+export const ClusterRegistrationTokenFieldAnnotations = "annotations";
+export const ClusterRegistrationTokenFieldClusterID = "clusterId";
+export const ClusterRegistrationTokenFieldCommand = "command";
+export const ClusterRegistrationTokenFieldCreated = "created";
+export const ClusterRegistrationTokenFieldCreatorID = "creatorId";
+export const ClusterRegistrationTokenFieldInsecureCommand = "insecureCommand";
+export const ClusterRegistrationTokenFieldLabels = "labels";
+export const ClusterRegistrationTokenFieldManifestURL = "manifestUrl";
+export const ClusterRegistrationTokenFieldName = "name";
+export const ClusterRegistrationTokenFieldNamespaceId = "namespaceId";
+export const ClusterRegistrationTokenFieldNodeCommand = "nodeCommand";
+export const ClusterRegistrationTokenFieldOwnerReferences = "ownerReferences";
+export const ClusterRegistrationTokenFieldRemoved = "removed";
+export const ClusterRegistrationTokenFieldState = "state";
+export const ClusterRegistrationTokenFieldToken = "token";
+export const ClusterRegistrationTokenFieldTransitioning = "transitioning";
+export const ClusterRegistrationTokenFieldTransitioningMessage = "transitioningMessage";
+export const ClusterRegistrationTokenFieldUUID = "uuid";
+export const ClusterRegistrationTokenFieldWindowsNodeCommand = "windowsNodeCommand";
+
+export interface ClusterRegistrationToken extends ApiResource {
+    annotations?: { [key: string]: string };
+    clusterId?: string;
+    command?: string;
+    created?: string;
+    creatorId?: string;
+    insecureCommand?: string;
+    labels?: { [key: string]: string };
+    manifestUrl?: string;
+    name?: string;
+    namespaceId?: string;
+    nodeCommand?: string;
+    ownerReferences?: OwnerReference[];
+    removed?: string;
+    state?: string;
+    token?: string;
+    transitioning?: "yes" | "no" | "error";
+    transitioningMessage?: string;
+    uuid?: string;
+    windowsNodeCommand?: string;
+}
+
+export interface ClusterRegistrationTokenCollection extends ApiCollection {
+    data?: ClusterRegistrationToken[];
+}
+
+export class ClusterRegistrationTokenClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<ClusterRegistrationTokenCollection> {
+        return this.ops.DoList(ClusterRegistrationTokenType, opts);
+    }
+
+    Next(collection: ClusterRegistrationTokenCollection): Promise<ClusterRegistrationTokenCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: ClusterRegistrationToken): Promise<ClusterRegistrationToken> {
+        return this.ops.DoCreate(ClusterRegistrationTokenType, container);
+    }
+
+    Update(existing: ClusterRegistrationToken, updates: object): Promise<ClusterRegistrationToken> {
+        return this.ops.DoUpdate(ClusterRegistrationTokenType, existing, updates);
+    }
+
+    Replace(obj: ClusterRegistrationToken): Promise<ClusterRegistrationToken> {
+        return this.ops.DoReplace(ClusterRegistrationTokenType, obj, obj);
+    }
+
+    ByID(id: string): Promise<ClusterRegistrationToken> {
+        return this.ops.DoByID(ClusterRegistrationTokenType, id);
+    }
+
+    Delete(container: ClusterRegistrationToken): Promise<void> {
+        return this.ops.DoResourceDelete(ClusterRegistrationTokenType, container);
+    }
+}
+
+export const GenerateKubeConfigOutputType = "generateKubeConfigOutput";
+export const GenerateKubeConfigOutputFieldConfig = "config";
+
+export interface GenerateKubeConfigOutput {
+    config?: string;
+}
+
+export const ImportClusterYamlInputType = "importClusterYamlInput";
+export const ImportClusterYamlInputFieldDefaultNamespace = "defaultNamespace";
+export const ImportClusterYamlInputFieldNamespace = "namespace";
+export const ImportClusterYamlInputFieldProjectID = "projectId";
+export const ImportClusterYamlInputFieldYAML = "yaml";
+
+export interface ImportClusterYamlInput {
+    defaultNamespace?: string;
+    namespace?: string;
+    projectId?: string;
+    yaml?: string;
+}
+
+export const RotateCertificateInputType = "rotateCertificateInput";
+export const RotateCertificateInputFieldCACertificates = "caCertificates";
+export const RotateCertificateInputFieldServices = "services";
+
+export interface RotateCertificateInput {
+    caCertificates?: boolean;
+    services?: "etcd" | "kubelet" | "kube-apiserver" | "kube-proxy" | "kube-scheduler" | "kube-controller-manager";
+}
+
+export const RotateCertificateOutputType = "rotateCertificateOutput";
+export const RotateCertificateOutputFieldMessage = "message";
+
+export interface RotateCertificateOutput {
+    message?: string;
+}
+
+export const ExportOutputType = "exportOutput";
+export const ExportOutputFieldYAMLOutput = "yamlOutput";
+
+export interface ExportOutput {
+    yamlOutput?: string;
+}
+
+export const RestoreFromEtcdBackupInputType = "restoreFromEtcdBackupInput";
+export const RestoreFromEtcdBackupInputFieldEtcdBackupID = "etcdBackupId";
+
+export interface RestoreFromEtcdBackupInput {
+    etcdBackupId?: string;
+}
+
+export const SaveAsTemplateInputType = "saveAsTemplateInput";
+export const SaveAsTemplateInputFieldClusterTemplateName = "clusterTemplateName";
+export const SaveAsTemplateInputFieldClusterTemplateRevisionName = "clusterTemplateRevisionName";
+
+export interface SaveAsTemplateInput {
+    clusterTemplateName?: string;
+    clusterTemplateRevisionName?: string;
+}
+
+export const SaveAsTemplateOutputType = "saveAsTemplateOutput";
+export const SaveAsTemplateOutputFieldClusterTemplateName = "clusterTemplateName";
+export const SaveAsTemplateOutputFieldClusterTemplateRevisionName = "clusterTemplateRevisionName";
+
+export interface SaveAsTemplateOutput {
+    clusterTemplateName?: string;
+    clusterTemplateRevisionName?: string;
+}
+
+export const CatalogRefreshType = "catalogRefresh";
+export const CatalogRefreshFieldCatalogs = "catalogs";
+
+export interface CatalogRefresh {
+    catalogs?: string[];
+}
+
+export const CatalogSpecType = "catalogSpec";
+export const CatalogSpecFieldBranch = "branch";
+export const CatalogSpecFieldCatalogKind = "catalogKind";
+export const CatalogSpecFieldDescription = "description";
+export const CatalogSpecFieldPassword = "password";
+export const CatalogSpecFieldURL = "url";
+export const CatalogSpecFieldUsername = "username";
+
+export interface CatalogSpec {
+    branch?: string;
+    catalogKind?: string;
+    description?: string;
+    password?: string;
+    url?: string;
+    username?: string;
+}
+
+export const VersionCommitsType = "versionCommits";
+export const VersionCommitsFieldValue = "Value";
+
+export interface VersionCommits {
+    Value?: { [key: string]: string };
+}
+
+export const CatalogConditionType = "catalogCondition";
+export const CatalogConditionFieldLastTransitionTime = "lastTransitionTime";
+export const CatalogConditionFieldLastUpdateTime = "lastUpdateTime";
+export const CatalogConditionFieldMessage = "message";
+export const CatalogConditionFieldReason = "reason";
+export const CatalogConditionFieldStatus = "status";
+export const CatalogConditionFieldType = "type";
+
+export interface CatalogCondition {
+    lastTransitionTime?: string;
+    lastUpdateTime?: string;
+    message?: string;
+    reason?: string;
+    status?: string;
+    type?: string;
+}
+
+export const CatalogStatusType = "catalogStatus";
+export const CatalogStatusFieldCommit = "commit";
+export const CatalogStatusFieldConditions = "conditions";
+export const CatalogStatusFieldHelmVersionCommits = "helmVersionCommits";
+export const CatalogStatusFieldLastRefreshTimestamp = "lastRefreshTimestamp";
+
+export interface CatalogStatus {
+    commit?: string;
+    conditions?: CatalogCondition[];
+    helmVersionCommits?: { [key: string]: VersionCommits };
+    lastRefreshTimestamp?: string;
+}
+
+export const CatalogType = "catalog";
+export const CatalogFieldAnnotations = "annotations";
+export const CatalogFieldBranch = "branch";
+export const CatalogFieldCommit = "commit";
+export const CatalogFieldConditions = "conditions";
+export const CatalogFieldCreated = "created";
+export const CatalogFieldCreatorID = "creatorId";
+export const CatalogFieldDescription = "description";
+export const CatalogFieldKind = "kind";
+export const CatalogFieldLabels = "labels";
+export const CatalogFieldLastRefreshTimestamp = "lastRefreshTimestamp";
+export const CatalogFieldName = "name";
+export const CatalogFieldOwnerReferences = "ownerReferences";
+export const CatalogFieldPassword = "password";
+export const CatalogFieldRemoved = "removed";
+export const CatalogFieldState = "state";
+export const CatalogFieldTransitioning = "transitioning";
+export const CatalogFieldTransitioningMessage = "transitioningMessage";
+export const CatalogFieldURL = "url";
+export const CatalogFieldUUID = "uuid";
+export const CatalogFieldUsername = "username";
+export const CatalogActionRefresh = "refresh";
+export const CatalogCollectionActionRefresh = "refresh";
+
+export interface Catalog extends ApiResource {
+    annotations?: { [key: string]: string };
+    branch?: string;
+    commit?: string;
+    conditions?: CatalogCondition[];
+    created?: string;
+    creatorId?: string;
+    description?: string;
+    kind?: string;
+    labels?: { [key: string]: string };
+    lastRefreshTimestamp?: string;
+    name?: string;
+    ownerReferences?: OwnerReference[];
+    password?: string;
+    removed?: string;
+    state?: string;
+    transitioning?: "yes" | "no" | "error";
+    transitioningMessage?: string;
+    url?: string;
+    uuid?: string;
+    username?: string;
+}
+
+export interface CatalogCollection extends ApiCollection {
+    data?: Catalog[];
+}
+
+export class CatalogClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<CatalogCollection> {
+        return this.ops.DoList(CatalogType, opts);
+    }
+
+    Next(collection: CatalogCollection): Promise<CatalogCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: Catalog): Promise<Catalog> {
+        return this.ops.DoCreate(CatalogType, container);
+    }
+
+    Update(existing: Catalog, updates: object): Promise<Catalog> {
+        return this.ops.DoUpdate(CatalogType, existing, updates);
+    }
+
+    Replace(obj: Catalog): Promise<Catalog> {
+        return this.ops.DoReplace(CatalogType, obj, obj);
+    }
+
+    ByID(id: string): Promise<Catalog> {
+        return this.ops.DoByID(CatalogType, id);
+    }
+
+    Delete(container: Catalog): Promise<void> {
+        return this.ops.DoResourceDelete(CatalogType, container);
+    }
+
+    ActionRefresh(resource: Catalog): Promise<CatalogRefresh> {
+        return this.ops.DoAction(CatalogType, CatalogActionRefresh, resource);
+    }
+
+    CollectionActionRefresh(resource: CatalogCollection): Promise<CatalogRefresh> {
+        return this.ops.DoCollectionAction(CatalogType, CatalogCollectionActionRefresh, resource);
+    }
+}
+
+export const TemplateVersionSpecType = "templateVersionSpec";
+export const TemplateVersionSpecFieldAppReadme = "appReadme";
+export const TemplateVersionSpecFieldDigest = "digest";
+export const TemplateVersionSpecFieldExternalID = "externalId";
+export const TemplateVersionSpecFieldFiles = "files";
+export const TemplateVersionSpecFieldKubeVersion = "kubeVersion";
+export const TemplateVersionSpecFieldQuestions = "questions";
+export const TemplateVersionSpecFieldRancherMaxVersion = "rancherMaxVersion";
+export const TemplateVersionSpecFieldRancherMinVersion = "rancherMinVersion";
+export const TemplateVersionSpecFieldRancherVersion = "rancherVersion";
+export const TemplateVersionSpecFieldReadme = "readme";
+export const TemplateVersionSpecFieldRequiredNamespace = "requiredNamespace";
+export const TemplateVersionSpecFieldUpgradeVersionLinks = "upgradeVersionLinks";
+export const TemplateVersionSpecFieldVersion = "version";
+export const TemplateVersionSpecFieldVersionDir = "versionDir";
+export const TemplateVersionSpecFieldVersionName = "versionName";
+export const TemplateVersionSpecFieldVersionURLs = "versionUrls";
+
+export interface TemplateVersionSpec {
+    appReadme?: string;
+    digest?: string;
+    externalId?: string;
+    files?: { [key: string]: string };
+    kubeVersion?: string;
+    questions?: Question[];
+    rancherMaxVersion?: string;
+    rancherMinVersion?: string;
+    rancherVersion?: string;
+    readme?: string;
+    requiredNamespace?: string;
+    upgradeVersionLinks?: { [key: string]: string };
+    version?: string;
+    versionDir?: string;
+    versionName?: string;
+    versionUrls?: string[];
+}
+
+export const TemplateSpecType = "templateSpec";
+export const TemplateSpecFieldCatalogID = "catalogId";
+export const TemplateSpecFieldCategories = "categories";
+export const TemplateSpecFieldCategory = "category";
+export const TemplateSpecFieldClusterCatalogID = "clusterCatalogId";
+export const TemplateSpecFieldClusterID = "clusterId";
+export const TemplateSpecFieldDefaultTemplateVersionID = "defaultTemplateVersionId";
+export const TemplateSpecFieldDefaultVersion = "defaultVersion";
+export const TemplateSpecFieldDescription = "description";
+export const TemplateSpecFieldDisplayName = "displayName";
+export const TemplateSpecFieldFolderName = "folderName";
+export const TemplateSpecFieldIcon = "icon";
+export const TemplateSpecFieldIconFilename = "iconFilename";
+export const TemplateSpecFieldMaintainer = "maintainer";
+export const TemplateSpecFieldPath = "path";
+export const TemplateSpecFieldProjectCatalogID = "projectCatalogId";
+export const TemplateSpecFieldProjectID = "projectId";
+export const TemplateSpecFieldProjectURL = "projectURL";
+export const TemplateSpecFieldReadme = "readme";
+export const TemplateSpecFieldUpgradeFrom = "upgradeFrom";
+export const TemplateSpecFieldVersions = "versions";
+
+export interface TemplateSpec {
+    catalogId?: string;
+    categories?: string[];
+    category?: string;
+    clusterCatalogId?: string;
+    clusterId?: string;
+    defaultTemplateVersionId?: string;
+    defaultVersion?: string;
+    description?: string;
+    displayName?: string;
+    folderName?: string;
+    icon?: string;
+    iconFilename?: string;
+    maintainer?: string;
+    path?: string;
+    projectCatalogId?: string;
+    projectId?: string;
+    projectURL?: string;
+    readme?: string;
+    upgradeFrom?: string;
+    versions?: TemplateVersionSpec[];
+}
+
+export const TemplateStatusType = "templateStatus";
+
+export interface TemplateStatus {
+}
+
+export const TemplateType = "template";
+export const TemplateFieldAnnotations = "annotations";
+export const TemplateFieldCatalogID = "catalogId";
+export const TemplateFieldCategories = "categories";
+export const TemplateFieldCategory = "category";
+export const TemplateFieldClusterCatalogID = "clusterCatalogId";
+export const TemplateFieldClusterID = "clusterId";
+export const TemplateFieldCreated = "created";
+export const TemplateFieldCreatorID = "creatorId";
+export const TemplateFieldDefaultTemplateVersionID = "defaultTemplateVersionId";
+export const TemplateFieldDefaultVersion = "defaultVersion";
+export const TemplateFieldDescription = "description";
+export const TemplateFieldFolderName = "folderName";
+export const TemplateFieldIcon = "icon";
+export const TemplateFieldIconFilename = "iconFilename";
+export const TemplateFieldLabels = "labels";
+export const TemplateFieldMaintainer = "maintainer";
+export const TemplateFieldName = "name";
+export const TemplateFieldOwnerReferences = "ownerReferences";
+export const TemplateFieldPath = "path";
+export const TemplateFieldProjectCatalogID = "projectCatalogId";
+export const TemplateFieldProjectID = "projectId";
+export const TemplateFieldProjectURL = "projectURL";
+export const TemplateFieldReadme = "readme";
+export const TemplateFieldRemoved = "removed";
+export const TemplateFieldState = "state";
+export const TemplateFieldStatus = "status";
+export const TemplateFieldTransitioning = "transitioning";
+export const TemplateFieldTransitioningMessage = "transitioningMessage";
+export const TemplateFieldUUID = "uuid";
+export const TemplateFieldUpgradeFrom = "upgradeFrom";
+export const TemplateFieldVersionLinks = "versionLinks";
+export const TemplateFieldVersions = "versions";
+
+export interface Template extends ApiResource {
+    annotations?: { [key: string]: string };
+    catalogId?: string;
+    categories?: string[];
+    category?: string;
+    clusterCatalogId?: string;
+    clusterId?: string;
+    created?: string;
+    creatorId?: string;
+    defaultTemplateVersionId?: string;
+    defaultVersion?: string;
+    description?: string;
+    folderName?: string;
+    icon?: string;
+    iconFilename?: string;
+    labels?: { [key: string]: string };
+    maintainer?: string;
+    name?: string;
+    ownerReferences?: OwnerReference[];
+    path?: string;
+    projectCatalogId?: string;
+    projectId?: string;
+    projectURL?: string;
+    readme?: string;
+    removed?: string;
+    state?: string;
+    status?: TemplateStatus;
+    transitioning?: "yes" | "no" | "error";
+    transitioningMessage?: string;
+    uuid?: string;
+    upgradeFrom?: string;
+    versionLinks?: { [key: string]: string };
+    versions?: TemplateVersionSpec[];
+}
+
+export interface TemplateCollection extends ApiCollection {
+    data?: Template[];
+}
+
+export class TemplateClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<TemplateCollection> {
+        return this.ops.DoList(TemplateType, opts);
+    }
+
+    Next(collection: TemplateCollection): Promise<TemplateCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: Template): Promise<Template> {
+        return this.ops.DoCreate(TemplateType, container);
+    }
+
+    Update(existing: Template, updates: object): Promise<Template> {
+        return this.ops.DoUpdate(TemplateType, existing, updates);
+    }
+
+    Replace(obj: Template): Promise<Template> {
+        return this.ops.DoReplace(TemplateType, obj, obj);
+    }
+
+    ByID(id: string): Promise<Template> {
+        return this.ops.DoByID(TemplateType, id);
+    }
+
+    Delete(container: Template): Promise<void> {
+        return this.ops.DoResourceDelete(TemplateType, container);
+    }
+}
+
+export const CatalogTemplateType = "catalogTemplate";
+export const CatalogTemplateFieldAnnotations = "annotations";
+export const CatalogTemplateFieldCatalogID = "catalogId";
+export const CatalogTemplateFieldCategories = "categories";
+export const CatalogTemplateFieldCategory = "category";
+export const CatalogTemplateFieldClusterCatalogID = "clusterCatalogId";
+export const CatalogTemplateFieldClusterID = "clusterId";
+export const CatalogTemplateFieldCreated = "created";
+export const CatalogTemplateFieldCreatorID = "creatorId";
+export const CatalogTemplateFieldDefaultTemplateVersionID = "defaultTemplateVersionId";
+export const CatalogTemplateFieldDefaultVersion = "defaultVersion";
+export const CatalogTemplateFieldDescription = "description";
+export const CatalogTemplateFieldFolderName = "folderName";
+export const CatalogTemplateFieldIcon = "icon";
+export const CatalogTemplateFieldIconFilename = "iconFilename";
+export const CatalogTemplateFieldLabels = "labels";
+export const CatalogTemplateFieldMaintainer = "maintainer";
+export const CatalogTemplateFieldName = "name";
+export const CatalogTemplateFieldOwnerReferences = "ownerReferences";
+export const CatalogTemplateFieldPath = "path";
+export const CatalogTemplateFieldProjectCatalogID = "projectCatalogId";
+export const CatalogTemplateFieldProjectID = "projectId";
+export const CatalogTemplateFieldProjectURL = "projectURL";
+export const CatalogTemplateFieldReadme = "readme";
+export const CatalogTemplateFieldRemoved = "removed";
+export const CatalogTemplateFieldState = "state";
+export const CatalogTemplateFieldStatus = "status";
+export const CatalogTemplateFieldTransitioning = "transitioning";
+export const CatalogTemplateFieldTransitioningMessage = "transitioningMessage";
+export const CatalogTemplateFieldUUID = "uuid";
+export const CatalogTemplateFieldUpgradeFrom = "upgradeFrom";
+export const CatalogTemplateFieldVersionLinks = "versionLinks";
+export const CatalogTemplateFieldVersions = "versions";
+
+export interface CatalogTemplate extends ApiResource {
+    annotations?: { [key: string]: string };
+    catalogId?: string;
+    categories?: string[];
+    category?: string;
+    clusterCatalogId?: string;
+    clusterId?: string;
+    created?: string;
+    creatorId?: string;
+    defaultTemplateVersionId?: string;
+    defaultVersion?: string;
+    description?: string;
+    folderName?: string;
+    icon?: string;
+    iconFilename?: string;
+    labels?: { [key: string]: string };
+    maintainer?: string;
+    name?: string;
+    ownerReferences?: OwnerReference[];
+    path?: string;
+    projectCatalogId?: string;
+    projectId?: string;
+    projectURL?: string;
+    readme?: string;
+    removed?: string;
+    state?: string;
+    status?: TemplateStatus;
+    transitioning?: "yes" | "no" | "error";
+    transitioningMessage?: string;
+    uuid?: string;
+    upgradeFrom?: string;
+    versionLinks?: { [key: string]: string };
+    versions?: TemplateVersionSpec[];
+}
+
+export interface CatalogTemplateCollection extends ApiCollection {
+    data?: CatalogTemplate[];
+}
+
+export class CatalogTemplateClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<CatalogTemplateCollection> {
+        return this.ops.DoList(CatalogTemplateType, opts);
+    }
+
+    Next(collection: CatalogTemplateCollection): Promise<CatalogTemplateCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: CatalogTemplate): Promise<CatalogTemplate> {
+        return this.ops.DoCreate(CatalogTemplateType, container);
+    }
+
+    Update(existing: CatalogTemplate, updates: object): Promise<CatalogTemplate> {
+        return this.ops.DoUpdate(CatalogTemplateType, existing, updates);
+    }
+
+    Replace(obj: CatalogTemplate): Promise<CatalogTemplate> {
+        return this.ops.DoReplace(CatalogTemplateType, obj, obj);
+    }
+
+    ByID(id: string): Promise<CatalogTemplate> {
+        return this.ops.DoByID(CatalogTemplateType, id);
+    }
+
+    Delete(container: CatalogTemplate): Promise<void> {
+        return this.ops.DoResourceDelete(CatalogTemplateType, container);
+    }
+}
+
+export const TemplateVersionStatusType = "templateVersionStatus";
+
+export interface TemplateVersionStatus {
+}
+
+export const CatalogTemplateVersionType = "catalogTemplateVersion";
+export const CatalogTemplateVersionFieldAnnotations = "annotations";
+export const CatalogTemplateVersionFieldAppReadme = "appReadme";
+export const CatalogTemplateVersionFieldCreated = "created";
+export const CatalogTemplateVersionFieldCreatorID = "creatorId";
+export const CatalogTemplateVersionFieldDigest = "digest";
+export const CatalogTemplateVersionFieldExternalID = "externalId";
+export const CatalogTemplateVersionFieldFiles = "files";
+export const CatalogTemplateVersionFieldKubeVersion = "kubeVersion";
+export const CatalogTemplateVersionFieldLabels = "labels";
+export const CatalogTemplateVersionFieldName = "name";
+export const CatalogTemplateVersionFieldOwnerReferences = "ownerReferences";
+export const CatalogTemplateVersionFieldQuestions = "questions";
+export const CatalogTemplateVersionFieldRancherMaxVersion = "rancherMaxVersion";
+export const CatalogTemplateVersionFieldRancherMinVersion = "rancherMinVersion";
+export const CatalogTemplateVersionFieldRancherVersion = "rancherVersion";
+export const CatalogTemplateVersionFieldReadme = "readme";
+export const CatalogTemplateVersionFieldRemoved = "removed";
+export const CatalogTemplateVersionFieldRequiredNamespace = "requiredNamespace";
+export const CatalogTemplateVersionFieldState = "state";
+export const CatalogTemplateVersionFieldStatus = "status";
+export const CatalogTemplateVersionFieldTransitioning = "transitioning";
+export const CatalogTemplateVersionFieldTransitioningMessage = "transitioningMessage";
+export const CatalogTemplateVersionFieldUUID = "uuid";
+export const CatalogTemplateVersionFieldUpgradeVersionLinks = "upgradeVersionLinks";
+export const CatalogTemplateVersionFieldVersion = "version";
+export const CatalogTemplateVersionFieldVersionDir = "versionDir";
+export const CatalogTemplateVersionFieldVersionName = "versionName";
+export const CatalogTemplateVersionFieldVersionURLs = "versionUrls";
+
+export interface CatalogTemplateVersion extends ApiResource {
+    annotations?: { [key: string]: string };
+    appReadme?: string;
+    created?: string;
+    creatorId?: string;
+    digest?: string;
+    externalId?: string;
+    files?: { [key: string]: string };
+    kubeVersion?: string;
+    labels?: { [key: string]: string };
+    name?: string;
+    ownerReferences?: OwnerReference[];
+    questions?: Question[];
+    rancherMaxVersion?: string;
+    rancherMinVersion?: string;
+    rancherVersion?: string;
+    readme?: string;
+    removed?: string;
+    requiredNamespace?: string;
+    state?: string;
+    status?: TemplateVersionStatus;
+    transitioning?: "yes" | "no" | "error";
+    transitioningMessage?: string;
+    uuid?: string;
+    upgradeVersionLinks?: { [key: string]: string };
+    version?: string;
+    versionDir?: string;
+    versionName?: string;
+    versionUrls?: string[];
+}
+
+export interface CatalogTemplateVersionCollection extends ApiCollection {
+    data?: CatalogTemplateVersion[];
+}
+
+export class CatalogTemplateVersionClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<CatalogTemplateVersionCollection> {
+        return this.ops.DoList(CatalogTemplateVersionType, opts);
+    }
+
+    Next(collection: CatalogTemplateVersionCollection): Promise<CatalogTemplateVersionCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: CatalogTemplateVersion): Promise<CatalogTemplateVersion> {
+        return this.ops.DoCreate(CatalogTemplateVersionType, container);
+    }
+
+    Update(existing: CatalogTemplateVersion, updates: object): Promise<CatalogTemplateVersion> {
+        return this.ops.DoUpdate(CatalogTemplateVersionType, existing, updates);
+    }
+
+    Replace(obj: CatalogTemplateVersion): Promise<CatalogTemplateVersion> {
+        return this.ops.DoReplace(CatalogTemplateVersionType, obj, obj);
+    }
+
+    ByID(id: string): Promise<CatalogTemplateVersion> {
+        return this.ops.DoByID(CatalogTemplateVersionType, id);
+    }
+
+    Delete(container: CatalogTemplateVersion): Promise<void> {
+        return this.ops.DoResourceDelete(CatalogTemplateVersionType, container);
+    }
+}
+
+export const TemplateVersionType = "templateVersion";
+export const TemplateVersionFieldAnnotations = "annotations";
+export const TemplateVersionFieldAppReadme = "appReadme";
+export const TemplateVersionFieldCreated = "created";
+export const TemplateVersionFieldCreatorID = "creatorId";
+export const TemplateVersionFieldDigest = "digest";
+export const TemplateVersionFieldExternalID = "externalId";
+export const TemplateVersionFieldFiles = "files";
+export const TemplateVersionFieldKubeVersion = "kubeVersion";
+export const TemplateVersionFieldLabels = "labels";
+export const TemplateVersionFieldName = "name";
+export const TemplateVersionFieldOwnerReferences = "ownerReferences";
+export const TemplateVersionFieldQuestions = "questions";
+export const TemplateVersionFieldRancherMaxVersion = "rancherMaxVersion";
+export const TemplateVersionFieldRancherMinVersion = "rancherMinVersion";
+export const TemplateVersionFieldRancherVersion = "rancherVersion";
+export const TemplateVersionFieldReadme = "readme";
+export const TemplateVersionFieldRemoved = "removed";
+export const TemplateVersionFieldRequiredNamespace = "requiredNamespace";
+export const TemplateVersionFieldState = "state";
+export const TemplateVersionFieldStatus = "status";
+export const TemplateVersionFieldTransitioning = "transitioning";
+export const TemplateVersionFieldTransitioningMessage = "transitioningMessage";
+export const TemplateVersionFieldUUID = "uuid";
+export const TemplateVersionFieldUpgradeVersionLinks = "upgradeVersionLinks";
+export const TemplateVersionFieldVersion = "version";
+export const TemplateVersionFieldVersionDir = "versionDir";
+export const TemplateVersionFieldVersionName = "versionName";
+export const TemplateVersionFieldVersionURLs = "versionUrls";
+
+export interface TemplateVersion extends ApiResource {
+    annotations?: { [key: string]: string };
+    appReadme?: string;
+    created?: string;
+    creatorId?: string;
+    digest?: string;
+    externalId?: string;
+    files?: { [key: string]: string };
+    kubeVersion?: string;
+    labels?: { [key: string]: string };
+    name?: string;
+    ownerReferences?: OwnerReference[];
+    questions?: Question[];
+    rancherMaxVersion?: string;
+    rancherMinVersion?: string;
+    rancherVersion?: string;
+    readme?: string;
+    removed?: string;
+    requiredNamespace?: string;
+    state?: string;
+    status?: TemplateVersionStatus;
+    transitioning?: "yes" | "no" | "error";
+    transitioningMessage?: string;
+    uuid?: string;
+    upgradeVersionLinks?: { [key: string]: string };
+    version?: string;
+    versionDir?: string;
+    versionName?: string;
+    versionUrls?: string[];
+}
+
+export interface TemplateVersionCollection extends ApiCollection {
+    data?: TemplateVersion[];
+}
+
+export class TemplateVersionClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<TemplateVersionCollection> {
+        return this.ops.DoList(TemplateVersionType, opts);
+    }
+
+    Next(collection: TemplateVersionCollection): Promise<TemplateVersionCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: TemplateVersion): Promise<TemplateVersion> {
+        return this.ops.DoCreate(TemplateVersionType, container);
+    }
+
+    Update(existing: TemplateVersion, updates: object): Promise<TemplateVersion> {
+        return this.ops.DoUpdate(TemplateVersionType, existing, updates);
+    }
+
+    Replace(obj: TemplateVersion): Promise<TemplateVersion> {
+        return this.ops.DoReplace(TemplateVersionType, obj, obj);
+    }
+
+    ByID(id: string): Promise<TemplateVersion> {
+        return this.ops.DoByID(TemplateVersionType, id);
+    }
+
+    Delete(container: TemplateVersion): Promise<void> {
+        return this.ops.DoResourceDelete(TemplateVersionType, container);
+    }
+}
+
+export const TemplateContentType = "templateContent";
+export const TemplateContentFieldAnnotations = "annotations";
+export const TemplateContentFieldCreated = "created";
+export const TemplateContentFieldCreatorID = "creatorId";
+export const TemplateContentFieldData = "data";
+export const TemplateContentFieldLabels = "labels";
+export const TemplateContentFieldName = "name";
+export const TemplateContentFieldOwnerReferences = "ownerReferences";
+export const TemplateContentFieldRemoved = "removed";
+export const TemplateContentFieldUUID = "uuid";
+
+export interface TemplateContent extends ApiResource {
+    annotations?: { [key: string]: string };
+    created?: string;
+    creatorId?: string;
+    data?: string;
+    labels?: { [key: string]: string };
+    name?: string;
+    ownerReferences?: OwnerReference[];
+    removed?: string;
+    uuid?: string;
+}
+
+export interface TemplateContentCollection extends ApiCollection {
+    data?: TemplateContent[];
+}
+
+export class TemplateContentClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<TemplateContentCollection> {
+        return this.ops.DoList(TemplateContentType, opts);
+    }
+
+    Next(collection: TemplateContentCollection): Promise<TemplateContentCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: TemplateContent): Promise<TemplateContent> {
+        return this.ops.DoCreate(TemplateContentType, container);
+    }
+
+    Update(existing: TemplateContent, updates: object): Promise<TemplateContent> {
+        return this.ops.DoUpdate(TemplateContentType, existing, updates);
+    }
+
+    Replace(obj: TemplateContent): Promise<TemplateContent> {
+        return this.ops.DoReplace(TemplateContentType, obj, obj);
+    }
+
+    ByID(id: string): Promise<TemplateContent> {
+        return this.ops.DoByID(TemplateContentType, id);
+    }
+
+    Delete(container: TemplateContent): Promise<void> {
+        return this.ops.DoResourceDelete(TemplateContentType, container);
+    }
+}
+
+export const GroupType = "group";
+export const GroupFieldAnnotations = "annotations";
+export const GroupFieldCreated = "created";
+export const GroupFieldCreatorID = "creatorId";
+export const GroupFieldLabels = "labels";
+export const GroupFieldName = "name";
+export const GroupFieldOwnerReferences = "ownerReferences";
+export const GroupFieldRemoved = "removed";
+export const GroupFieldUUID = "uuid";
+
+export interface Group extends ApiResource {
+    annotations?: { [key: string]: string };
+    created?: string;
+    creatorId?: string;
+    labels?: { [key: string]: string };
+    name?: string;
+    ownerReferences?: OwnerReference[];
+    removed?: string;
+    uuid?: string;
+}
+
+export interface GroupCollection extends ApiCollection {
+    data?: Group[];
+}
+
+export class GroupClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<GroupCollection> {
+        return this.ops.DoList(GroupType, opts);
+    }
+
+    Next(collection: GroupCollection): Promise<GroupCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: Group): Promise<Group> {
+        return this.ops.DoCreate(GroupType, container);
+    }
+
+    Update(existing: Group, updates: object): Promise<Group> {
+        return this.ops.DoUpdate(GroupType, existing, updates);
+    }
+
+    Replace(obj: Group): Promise<Group> {
+        return this.ops.DoReplace(GroupType, obj, obj);
+    }
+
+    ByID(id: string): Promise<Group> {
+        return this.ops.DoByID(GroupType, id);
+    }
+
+    Delete(container: Group): Promise<void> {
+        return this.ops.DoResourceDelete(GroupType, container);
+    }
+}
+
+export const GroupMemberType = "groupMember";
+export const GroupMemberFieldAnnotations = "annotations";
+export const GroupMemberFieldCreated = "created";
+export const GroupMemberFieldCreatorID = "creatorId";
+export const GroupMemberFieldGroupID = "groupId";
+export const GroupMemberFieldLabels = "labels";
+export const GroupMemberFieldName = "name";
+export const GroupMemberFieldOwnerReferences = "ownerReferences";
+export const GroupMemberFieldPrincipalID = "principalId";
+export const GroupMemberFieldRemoved = "removed";
+export const GroupMemberFieldUUID = "uuid";
+
+export interface GroupMember extends ApiResource {
+    annotations?: { [key: string]: string };
+    created?: string;
+    creatorId?: string;
+    groupId?: string;
+    labels?: { [key: string]: string };
+    name?: string;
+    ownerReferences?: OwnerReference[];
+    principalId?: string;
+    removed?: string;
+    uuid?: string;
+}
+
+export interface GroupMemberCollection extends ApiCollection {
+    data?: GroupMember[];
+}
+
+export class GroupMemberClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<GroupMemberCollection> {
+        return this.ops.DoList(GroupMemberType, opts);
+    }
+
+    Next(collection: GroupMemberCollection): Promise<GroupMemberCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: GroupMember): Promise<GroupMember> {
+        return this.ops.DoCreate(GroupMemberType, container);
+    }
+
+    Update(existing: GroupMember, updates: object): Promise<GroupMember> {
+        return this.ops.DoUpdate(GroupMemberType, existing, updates);
+    }
+
+    Replace(obj: GroupMember): Promise<GroupMember> {
+        return this.ops.DoReplace(GroupMemberType, obj, obj);
+    }
+
+    ByID(id: string): Promise<GroupMember> {
+        return this.ops.DoByID(GroupMemberType, id);
+    }
+
+    Delete(container: GroupMember): Promise<void> {
+        return this.ops.DoResourceDelete(GroupMemberType, container);
+    }
+}
+
+export const PrincipalType = "principal";
+export const PrincipalFieldAnnotations = "annotations";
+export const PrincipalFieldCreated = "created";
+export const PrincipalFieldCreatorID = "creatorId";
+export const PrincipalFieldExtraInfo = "extraInfo";
+export const PrincipalFieldLabels = "labels";
+export const PrincipalFieldLoginName = "loginName";
+export const PrincipalFieldMe = "me";
+export const PrincipalFieldMemberOf = "memberOf";
+export const PrincipalFieldName = "name";
+export const PrincipalFieldOwnerReferences = "ownerReferences";
+export const PrincipalFieldPrincipalType = "principalType";
+export const PrincipalFieldProfilePicture = "profilePicture";
+export const PrincipalFieldProfileURL = "profileURL";
+export const PrincipalFieldProvider = "provider";
+export const PrincipalFieldRemoved = "removed";
+export const PrincipalFieldUUID = "uuid";
+export const PrincipalCollectionActionSearch = "search";
+
+export interface Principal extends ApiResource {
+    annotations?: { [key: string]: string };
+    created?: string;
+    creatorId?: string;
+    extraInfo?: { [key: string]: string };
+    labels?: { [key: string]: string };
+    loginName?: string;
+    me?: boolean;
+    memberOf?: boolean;
+    name?: string;
+    ownerReferences?: OwnerReference[];
+    principalType?: string;
+    profilePicture?: string;
+    profileURL?: string;
+    provider?: string;
+    removed?: string;
+    uuid?: string;
+}
+
+export interface PrincipalCollection extends ApiCollection {
+    data?: Principal[];
+}
+
+export class PrincipalClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<PrincipalCollection> {
+        return this.ops.DoList(PrincipalType, opts);
+    }
+
+    Next(collection: PrincipalCollection): Promise<PrincipalCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: Principal): Promise<Principal> {
+        return this.ops.DoCreate(PrincipalType, container);
+    }
+
+    Update(existing: Principal, updates: object): Promise<Principal> {
+        return this.ops.DoUpdate(PrincipalType, existing, updates);
+    }
+
+    Replace(obj: Principal): Promise<Principal> {
+        return this.ops.DoReplace(PrincipalType, obj, obj);
+    }
+
+    ByID(id: string): Promise<Principal> {
+        return this.ops.DoByID(PrincipalType, id);
+    }
+
+    Delete(container: Principal): Promise<void> {
+        return this.ops.DoResourceDelete(PrincipalType, container);
+    }
+
+    CollectionActionSearch(resource: PrincipalCollection, input: SearchPrincipalsInput): Promise<PrincipalCollection> {
+        return this.ops.DoCollectionAction(PrincipalType, PrincipalCollectionActionSearch, resource, input);
+    }
+}
+
+export const SearchPrincipalsInputType = "searchPrincipalsInput";
+export const SearchPrincipalsInputFieldName = "name";
+export const SearchPrincipalsInputFieldPrincipalType = "principalType";
+
+export interface SearchPrincipalsInput {
+    name?: string;
+    principalType?: "user" | "group";
+}
+
+export const ChangePasswordInputType = "changePasswordInput";
+export const ChangePasswordInputFieldCurrentPassword = "currentPassword";
+export const ChangePasswordInputFieldNewPassword = "newPassword";
+
+export interface ChangePasswordInput {
+    currentPassword?: string;
+    newPassword?: string;
+}
+
+export const SetPasswordInputType = "setPasswordInput";
+export const SetPasswordInputFieldNewPassword = "newPassword";
+
+export interface SetPasswordInput {
+    newPassword?: string;
+}
+
+export const UserSpecType = "userSpec";
+
+export interface UserSpec {
+}
+
+export const UserConditionType = "userCondition";
+export const UserConditionFieldLastTransitionTime = "lastTransitionTime";
+export const UserConditionFieldLastUpdateTime = "lastUpdateTime";
+export const UserConditionFieldMessage = "message";
+export const UserConditionFieldReason = "reason";
+export const UserConditionFieldStatus = "status";
+export const UserConditionFieldType = "type";
+
+export interface UserCondition {
+    lastTransitionTime?: string;
+    lastUpdateTime?: string;
+    message?: string;
+    reason?: string;
+    status?: string;
+    type?: string;
+}
+
+export const UserStatusType = "userStatus";
+export const UserStatusFieldConditions = "conditions";
+
+export interface UserStatus {
+    conditions?: UserCondition[];
+}
+
+export const UserType = "user";
+export const UserFieldAnnotations = "annotations";
+export const UserFieldConditions = "conditions";
+export const UserFieldCreated = "created";
+export const UserFieldCreatorID = "creatorId";
+export const UserFieldDescription = "description";
+export const UserFieldEnabled = "enabled";
+export const UserFieldLabels = "labels";
+export const UserFieldMe = "me";
+export const UserFieldMustChangePassword = "mustChangePassword";
+export const UserFieldName = "name";
+export const UserFieldOwnerReferences = "ownerReferences";
+export const UserFieldPassword = "password";
+export const UserFieldPrincipalIDs = "principalIds";
+export const UserFieldRemoved = "removed";
+export const UserFieldState = "state";
+export const UserFieldTransitioning = "transitioning";
+export const UserFieldTransitioningMessage = "transitioningMessage";
+export const UserFieldUUID = "uuid";
+export const UserFieldUsername = "username";
+export const UserActionRefreshauthprovideraccess = "refreshauthprovideraccess";
+export const UserActionSetpassword = "setpassword";
+export const UserCollectionActionChangepassword = "changepassword";
+export const UserCollectionActionRefreshauthprovideraccess = "refreshauthprovideraccess";
+
+export interface User extends ApiResource {
+    annotations?: { [key: string]: string };
+    conditions?: UserCondition[];
+    created?: string;
+    creatorId?: string;
+    description?: string;
+    enabled?: boolean;
+    labels?: { [key: string]: string };
+    me?: boolean;
+    mustChangePassword?: boolean;
+    name?: string;
+    ownerReferences?: OwnerReference[];
+    password?: string;
+    principalIds?: string[];
+    removed?: string;
+    state?: string;
+    transitioning?: "yes" | "no" | "error";
+    transitioningMessage?: string;
+    uuid?: string;
+    username?: string;
+}
+
+export interface UserCollection extends ApiCollection {
+    data?: User[];
+}
+
+export class UserClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<UserCollection> {
+        return this.ops.DoList(UserType, opts);
+    }
+
+    Next(collection: UserCollection): Promise<UserCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: User): Promise<User> {
+        return this.ops.DoCreate(UserType, container);
+    }
+
+    Update(existing: User, updates: object): Promise<User> {
+        return this.ops.DoUpdate(UserType, existing, updates);
+    }
+
+    Replace(obj: User): Promise<User> {
+        return this.ops.DoReplace(UserType, obj, obj);
+    }
+
+    ByID(id: string): Promise<User> {
+        return this.ops.DoByID(UserType, id);
+    }
+
+    Delete(container: User): Promise<void> {
+        return this.ops.DoResourceDelete(UserType, container);
+    }
+
+    ActionRefreshauthprovideraccess(resource: User): Promise<void> {
+        return this.ops.DoAction(UserType, UserActionRefreshauthprovideraccess, resource);
+    }
+
+    ActionSetpassword(resource: User, input: SetPasswordInput): Promise<User> {
+        return this.ops.DoAction(UserType, UserActionSetpassword, resource, input);
+    }
+
+    CollectionActionChangepassword(resource: UserCollection, input: ChangePasswordInput): Promise<void> {
+        return this.ops.DoCollectionAction(UserType, UserCollectionActionChangepassword, resource, input);
+    }
+
+    CollectionActionRefreshauthprovideraccess(resource: UserCollection): Promise<void> {
+        return this.ops.DoCollectionAction(UserType, UserCollectionActionRefreshauthprovideraccess, resource);
+    }
+}
+
+export const AuthConfigType = "authConfig";
+export const AuthConfigFieldAccessMode = "accessMode";
+export const AuthConfigFieldAllowedPrincipalIDs = "allowedPrincipalIds";
+export const AuthConfigFieldAnnotations = "annotations";
+export const AuthConfigFieldCreated = "created";
+export const AuthConfigFieldCreatorID = "creatorId";
+export const AuthConfigFieldEnabled = "enabled";
+export const AuthConfigFieldLabels = "labels";
+export const AuthConfigFieldName = "name";
+export const AuthConfigFieldOwnerReferences = "ownerReferences";
+export const AuthConfigFieldRemoved = "removed";
+export const AuthConfigFieldType = "type";
+export const AuthConfigFieldUUID = "uuid";
+
+export interface AuthConfig extends ApiResource {
+    accessMode?: "required" | "restricted" | "unrestricted";
+    allowedPrincipalIds?: string[];
+    annotations?: { [key: string]: string };
+    created?: string;
+    creatorId?: string;
+    enabled?: boolean;
+    labels?: { [key: string]: string };
+    name?: string;
+    ownerReferences?: OwnerReference[];
+    removed?: string;
+    type?: string;
+    uuid?: string;
+}
+
+export interface AuthConfigCollection extends ApiCollection {
+    data?: AuthConfig[];
+}
+
+export class AuthConfigClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<AuthConfigCollection> {
+        return this.ops.DoList(AuthConfigType, opts);
+    }
+
+    Next(collection: AuthConfigCollection): Promise<AuthConfigCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: AuthConfig): Promise<AuthConfig> {
+        return this.ops.DoCreate(AuthConfigType, container);
+    }
+
+    Update(existing: AuthConfig, updates: object): Promise<AuthConfig> {
+        return this.ops.DoUpdate(AuthConfigType, existing, updates);
+    }
+
+    Replace(obj: AuthConfig): Promise<AuthConfig> {
+        return this.ops.DoReplace(AuthConfigType, obj, obj);
+    }
+
+    ByID(id: string): Promise<AuthConfig> {
+        return this.ops.DoByID(AuthConfigType, id);
+    }
+
+    Delete(container: AuthConfig): Promise<void> {
+        return this.ops.DoResourceDelete(AuthConfigType, container);
+    }
+}
+
+export const LocalConfigType = "localConfig";
+export const LocalConfigFieldAccessMode = "accessMode";
+export const LocalConfigFieldAllowedPrincipalIDs = "allowedPrincipalIds";
+export const LocalConfigFieldAnnotations = "annotations";
+export const LocalConfigFieldCreated = "created";
+export const LocalConfigFieldCreatorID = "creatorId";
+export const LocalConfigFieldEnabled = "enabled";
+export const LocalConfigFieldLabels = "labels";
+export const LocalConfigFieldName = "name";
+export const LocalConfigFieldOwnerReferences = "ownerReferences";
+export const LocalConfigFieldRemoved = "removed";
+export const LocalConfigFieldType = "type";
+export const LocalConfigFieldUUID = "uuid";
+
+export interface LocalConfig {
+    accessMode?: "required" | "restricted" | "unrestricted";
+    allowedPrincipalIds?: string[];
+    annotations?: { [key: string]: string };
+    created?: string;
+    creatorId?: string;
+    enabled?: boolean;
+    labels?: { [key: string]: string };
+    name?: string;
+    ownerReferences?: OwnerReference[];
+    removed?: string;
+    type?: string;
+    uuid?: string;
+}
+
+export const GithubConfigType = "githubConfig";
+export const GithubConfigFieldAccessMode = "accessMode";
+export const GithubConfigFieldAllowedPrincipalIDs = "allowedPrincipalIds";
+export const GithubConfigFieldAnnotations = "annotations";
+export const GithubConfigFieldClientID = "clientId";
+export const GithubConfigFieldClientSecret = "clientSecret";
+export const GithubConfigFieldCreated = "created";
+export const GithubConfigFieldCreatorID = "creatorId";
+export const GithubConfigFieldEnabled = "enabled";
+export const GithubConfigFieldHostname = "hostname";
+export const GithubConfigFieldLabels = "labels";
+export const GithubConfigFieldName = "name";
+export const GithubConfigFieldOwnerReferences = "ownerReferences";
+export const GithubConfigFieldRemoved = "removed";
+export const GithubConfigFieldTLS = "tls";
+export const GithubConfigFieldType = "type";
+export const GithubConfigFieldUUID = "uuid";
+
+export interface GithubConfig {
+    accessMode?: "required" | "restricted" | "unrestricted";
+    allowedPrincipalIds?: string[];
+    annotations?: { [key: string]: string };
+    clientId?: string;
+    clientSecret?: string;
+    created?: string;
+    creatorId?: string;
+    enabled?: boolean;
+    hostname?: string;
+    labels?: { [key: string]: string };
+    name?: string;
+    ownerReferences?: OwnerReference[];
+    removed?: string;
+    tls?: boolean;
+    type?: string;
+    uuid?: string;
+}
+
+export const GithubConfigTestOutputType = "githubConfigTestOutput";
+export const GithubConfigTestOutputFieldRedirectURL = "redirectUrl";
+
+export interface GithubConfigTestOutput {
+    redirectUrl?: string;
+}
+
+export const GithubConfigApplyInputType = "githubConfigApplyInput";
+export const GithubConfigApplyInputFieldCode = "code";
+export const GithubConfigApplyInputFieldEnabled = "enabled";
+export const GithubConfigApplyInputFieldGithubConfig = "githubConfig";
+
+export interface GithubConfigApplyInput {
+    code?: string;
+    enabled?: boolean;
+    githubConfig?: GithubConfig;
+}
+
+export const AzureADConfigType = "azureADConfig";
+export const AzureADConfigFieldAccessMode = "accessMode";
+export const AzureADConfigFieldAllowedPrincipalIDs = "allowedPrincipalIds";
+export const AzureADConfigFieldAnnotations = "annotations";
+export const AzureADConfigFieldApplicationID = "applicationId";
+export const AzureADConfigFieldApplicationSecret = "applicationSecret";
+export const AzureADConfigFieldAuthEndpoint = "authEndpoint";
+export const AzureADConfigFieldCreated = "created";
+export const AzureADConfigFieldCreatorID = "creatorId";
+export const AzureADConfigFieldEnabled = "enabled";
+export const AzureADConfigFieldEndpoint = "endpoint";
+export const AzureADConfigFieldGraphEndpoint = "graphEndpoint";
+export const AzureADConfigFieldLabels = "labels";
+export const AzureADConfigFieldName = "name";
+export const AzureADConfigFieldOwnerReferences = "ownerReferences";
+export const AzureADConfigFieldRancherURL = "rancherUrl";
+export const AzureADConfigFieldRemoved = "removed";
+export const AzureADConfigFieldTenantID = "tenantId";
+export const AzureADConfigFieldTokenEndpoint = "tokenEndpoint";
+export const AzureADConfigFieldType = "type";
+export const AzureADConfigFieldUUID = "uuid";
+
+export interface AzureADConfig {
+    accessMode?: "required" | "restricted" | "unrestricted";
+    allowedPrincipalIds?: string[];
+    annotations?: { [key: string]: string };
+    applicationId?: string;
+    applicationSecret?: string;
+    authEndpoint?: string;
+    created?: string;
+    creatorId?: string;
+    enabled?: boolean;
+    endpoint?: string;
+    graphEndpoint?: string;
+    labels?: { [key: string]: string };
+    name?: string;
+    ownerReferences?: OwnerReference[];
+    rancherUrl?: string;
+    removed?: string;
+    tenantId?: string;
+    tokenEndpoint?: string;
+    type?: string;
+    uuid?: string;
+}
+
+export const AzureADConfigTestOutputType = "azureADConfigTestOutput";
+export const AzureADConfigTestOutputFieldRedirectURL = "redirectUrl";
+
+export interface AzureADConfigTestOutput {
+    redirectUrl?: string;
+}
+
+export const AzureADConfigApplyInputType = "azureADConfigApplyInput";
+export const AzureADConfigApplyInputFieldCode = "code";
+export const AzureADConfigApplyInputFieldConfig = "config";
+
+export interface AzureADConfigApplyInput {
+    code?: string;
+    config?: AzureADConfig;
+}
+
+export const ActiveDirectoryConfigType = "activeDirectoryConfig";
+export const ActiveDirectoryConfigFieldAccessMode = "accessMode";
+export const ActiveDirectoryConfigFieldAllowedPrincipalIDs = "allowedPrincipalIds";
+export const ActiveDirectoryConfigFieldAnnotations = "annotations";
+export const ActiveDirectoryConfigFieldCertificate = "certificate";
+export const ActiveDirectoryConfigFieldConnectionTimeout = "connectionTimeout";
+export const ActiveDirectoryConfigFieldCreated = "created";
+export const ActiveDirectoryConfigFieldCreatorID = "creatorId";
+export const ActiveDirectoryConfigFieldDefaultLoginDomain = "defaultLoginDomain";
+export const ActiveDirectoryConfigFieldEnabled = "enabled";
+export const ActiveDirectoryConfigFieldGroupDNAttribute = "groupDNAttribute";
+export const ActiveDirectoryConfigFieldGroupMemberMappingAttribute = "groupMemberMappingAttribute";
+export const ActiveDirectoryConfigFieldGroupMemberUserAttribute = "groupMemberUserAttribute";
+export const ActiveDirectoryConfigFieldGroupNameAttribute = "groupNameAttribute";
+export const ActiveDirectoryConfigFieldGroupObjectClass = "groupObjectClass";
+export const ActiveDirectoryConfigFieldGroupSearchAttribute = "groupSearchAttribute";
+export const ActiveDirectoryConfigFieldGroupSearchBase = "groupSearchBase";
+export const ActiveDirectoryConfigFieldGroupSearchFilter = "groupSearchFilter";
+export const ActiveDirectoryConfigFieldLabels = "labels";
+export const ActiveDirectoryConfigFieldName = "name";
+export const ActiveDirectoryConfigFieldNestedGroupMembershipEnabled = "nestedGroupMembershipEnabled";
+export const ActiveDirectoryConfigFieldOwnerReferences = "ownerReferences";
+export const ActiveDirectoryConfigFieldPort = "port";
+export const ActiveDirectoryConfigFieldRemoved = "removed";
+export const ActiveDirectoryConfigFieldServers = "servers";
+export const ActiveDirectoryConfigFieldServiceAccountPassword = "serviceAccountPassword";
+export const ActiveDirectoryConfigFieldServiceAccountUsername = "serviceAccountUsername";
+export const ActiveDirectoryConfigFieldTLS = "tls";
+export const ActiveDirectoryConfigFieldType = "type";
+export const ActiveDirectoryConfigFieldUUID = "uuid";
+export const ActiveDirectoryConfigFieldUserDisabledBitMask = "userDisabledBitMask";
+export const ActiveDirectoryConfigFieldUserEnabledAttribute = "userEnabledAttribute";
+export const ActiveDirectoryConfigFieldUserLoginAttribute = "userLoginAttribute";
+export const ActiveDirectoryConfigFieldUserNameAttribute = "userNameAttribute";
+export const ActiveDirectoryConfigFieldUserObjectClass = "userObjectClass";
+export const ActiveDirectoryConfigFieldUserSearchAttribute = "userSearchAttribute";
+export const ActiveDirectoryConfigFieldUserSearchBase = "userSearchBase";
+export const ActiveDirectoryConfigFieldUserSearchFilter = "userSearchFilter";
+
+export interface ActiveDirectoryConfig {
+    accessMode?: "required" | "restricted" | "unrestricted";
+    allowedPrincipalIds?: string[];
+    annotations?: { [key: string]: string };
+    certificate?: string;
+    connectionTimeout?: number;
+    created?: string;
+    creatorId?: string;
+    defaultLoginDomain?: string;
+    enabled?: boolean;
+    groupDNAttribute?: string;
+    groupMemberMappingAttribute?: string;
+    groupMemberUserAttribute?: string;
+    groupNameAttribute?: string;
+    groupObjectClass?: string;
+    groupSearchAttribute?: string;
+    groupSearchBase?: string;
+    groupSearchFilter?: string;
+    labels?: { [key: string]: string };
+    name?: string;
+    nestedGroupMembershipEnabled?: boolean;
+    ownerReferences?: OwnerReference[];
+    port?: number;
+    removed?: string;
+    servers?: string[];
+    serviceAccountPassword?: string;
+    serviceAccountUsername?: string;
+    tls?: boolean;
+    type?: string;
+    uuid?: string;
+    userDisabledBitMask?: number;
+    userEnabledAttribute?: string;
+    userLoginAttribute?: string;
+    userNameAttribute?: string;
+    userObjectClass?: string;
+    userSearchAttribute?: string;
+    userSearchBase?: string;
+    userSearchFilter?: string;
+}
+
+export const ActiveDirectoryTestAndApplyInputType = "activeDirectoryTestAndApplyInput";
+export const ActiveDirectoryTestAndApplyInputFieldActiveDirectoryConfig = "activeDirectoryConfig";
+export const ActiveDirectoryTestAndApplyInputFieldEnabled = "enabled";
+export const ActiveDirectoryTestAndApplyInputFieldPassword = "password";
+export const ActiveDirectoryTestAndApplyInputFieldUsername = "username";
+
+export interface ActiveDirectoryTestAndApplyInput {
+    activeDirectoryConfig?: ActiveDirectoryConfig;
+    enabled?: boolean;
+    password?: string;
+    username?: string;
+}
+
+export const OpenLdapConfigType = "openLdapConfig";
+export const OpenLdapConfigFieldAccessMode = "accessMode";
+export const OpenLdapConfigFieldAllowedPrincipalIDs = "allowedPrincipalIds";
+export const OpenLdapConfigFieldAnnotations = "annotations";
+export const OpenLdapConfigFieldCertificate = "certificate";
+export const OpenLdapConfigFieldConnectionTimeout = "connectionTimeout";
+export const OpenLdapConfigFieldCreated = "created";
+export const OpenLdapConfigFieldCreatorID = "creatorId";
+export const OpenLdapConfigFieldEnabled = "enabled";
+export const OpenLdapConfigFieldGroupDNAttribute = "groupDNAttribute";
+export const OpenLdapConfigFieldGroupMemberMappingAttribute = "groupMemberMappingAttribute";
+export const OpenLdapConfigFieldGroupMemberUserAttribute = "groupMemberUserAttribute";
+export const OpenLdapConfigFieldGroupNameAttribute = "groupNameAttribute";
+export const OpenLdapConfigFieldGroupObjectClass = "groupObjectClass";
+export const OpenLdapConfigFieldGroupSearchAttribute = "groupSearchAttribute";
+export const OpenLdapConfigFieldGroupSearchBase = "groupSearchBase";
+export const OpenLdapConfigFieldGroupSearchFilter = "groupSearchFilter";
+export const OpenLdapConfigFieldLabels = "labels";
+export const OpenLdapConfigFieldName = "name";
+export const OpenLdapConfigFieldNestedGroupMembershipEnabled = "nestedGroupMembershipEnabled";
+export const OpenLdapConfigFieldOwnerReferences = "ownerReferences";
+export const OpenLdapConfigFieldPort = "port";
+export const OpenLdapConfigFieldRemoved = "removed";
+export const OpenLdapConfigFieldServers = "servers";
+export const OpenLdapConfigFieldServiceAccountDistinguishedName = "serviceAccountDistinguishedName";
+export const OpenLdapConfigFieldServiceAccountPassword = "serviceAccountPassword";
+export const OpenLdapConfigFieldTLS = "tls";
+export const OpenLdapConfigFieldType = "type";
+export const OpenLdapConfigFieldUUID = "uuid";
+export const OpenLdapConfigFieldUserDisabledBitMask = "userDisabledBitMask";
+export const OpenLdapConfigFieldUserEnabledAttribute = "userEnabledAttribute";
+export const OpenLdapConfigFieldUserLoginAttribute = "userLoginAttribute";
+export const OpenLdapConfigFieldUserMemberAttribute = "userMemberAttribute";
+export const OpenLdapConfigFieldUserNameAttribute = "userNameAttribute";
+export const OpenLdapConfigFieldUserObjectClass = "userObjectClass";
+export const OpenLdapConfigFieldUserSearchAttribute = "userSearchAttribute";
+export const OpenLdapConfigFieldUserSearchBase = "userSearchBase";
+export const OpenLdapConfigFieldUserSearchFilter = "userSearchFilter";
+
+export interface OpenLdapConfig {
+    accessMode?: "required" | "restricted" | "unrestricted";
+    allowedPrincipalIds?: string[];
+    annotations?: { [key: string]: string };
+    certificate?: string;
+    connectionTimeout?: number;
+    created?: string;
+    creatorId?: string;
+    enabled?: boolean;
+    groupDNAttribute?: string;
+    groupMemberMappingAttribute?: string;
+    groupMemberUserAttribute?: string;
+    groupNameAttribute?: string;
+    groupObjectClass?: string;
+    groupSearchAttribute?: string;
+    groupSearchBase?: string;
+    groupSearchFilter?: string;
+    labels?: { [key: string]: string };
+    name?: string;
+    nestedGroupMembershipEnabled?: boolean;
+    ownerReferences?: OwnerReference[];
+    port?: number;
+    removed?: string;
+    servers?: string[];
+    serviceAccountDistinguishedName?: string;
+    serviceAccountPassword?: string;
+    tls?: boolean;
+    type?: string;
+    uuid?: string;
+    userDisabledBitMask?: number;
+    userEnabledAttribute?: string;
+    userLoginAttribute?: string;
+    userMemberAttribute?: string;
+    userNameAttribute?: string;
+    userObjectClass?: string;
+    userSearchAttribute?: string;
+    userSearchBase?: string;
+    userSearchFilter?: string;
+}
+
+export const LdapConfigType = "ldapConfig";
+export const LdapConfigFieldAccessMode = "accessMode";
+export const LdapConfigFieldAllowedPrincipalIDs = "allowedPrincipalIds";
+export const LdapConfigFieldAnnotations = "annotations";
+export const LdapConfigFieldCertificate = "certificate";
+export const LdapConfigFieldConnectionTimeout = "connectionTimeout";
+export const LdapConfigFieldCreated = "created";
+export const LdapConfigFieldCreatorID = "creatorId";
+export const LdapConfigFieldEnabled = "enabled";
+export const LdapConfigFieldGroupDNAttribute = "groupDNAttribute";
+export const LdapConfigFieldGroupMemberMappingAttribute = "groupMemberMappingAttribute";
+export const LdapConfigFieldGroupMemberUserAttribute = "groupMemberUserAttribute";
+export const LdapConfigFieldGroupNameAttribute = "groupNameAttribute";
+export const LdapConfigFieldGroupObjectClass = "groupObjectClass";
+export const LdapConfigFieldGroupSearchAttribute = "groupSearchAttribute";
+export const LdapConfigFieldGroupSearchBase = "groupSearchBase";
+export const LdapConfigFieldGroupSearchFilter = "groupSearchFilter";
+export const LdapConfigFieldLabels = "labels";
+export const LdapConfigFieldName = "name";
+export const LdapConfigFieldNestedGroupMembershipEnabled = "nestedGroupMembershipEnabled";
+export const LdapConfigFieldOwnerReferences = "ownerReferences";
+export const LdapConfigFieldPort = "port";
+export const LdapConfigFieldRemoved = "removed";
+export const LdapConfigFieldServers = "servers";
+export const LdapConfigFieldServiceAccountDistinguishedName = "serviceAccountDistinguishedName";
+export const LdapConfigFieldServiceAccountPassword = "serviceAccountPassword";
+export const LdapConfigFieldTLS = "tls";
+export const LdapConfigFieldType = "type";
+export const LdapConfigFieldUUID = "uuid";
+export const LdapConfigFieldUserDisabledBitMask = "userDisabledBitMask";
+export const LdapConfigFieldUserEnabledAttribute = "userEnabledAttribute";
+export const LdapConfigFieldUserLoginAttribute = "userLoginAttribute";
+export const LdapConfigFieldUserMemberAttribute = "userMemberAttribute";
+export const LdapConfigFieldUserNameAttribute = "userNameAttribute";
+export const LdapConfigFieldUserObjectClass = "userObjectClass";
+export const LdapConfigFieldUserSearchAttribute = "userSearchAttribute";
+export const LdapConfigFieldUserSearchBase = "userSearchBase";
+export const LdapConfigFieldUserSearchFilter = "userSearchFilter";
+
+export interface LdapConfig extends ApiResource {
+    accessMode?: "required" | "restricted" | "unrestricted";
+    allowedPrincipalIds?: string[];
+    annotations?: { [key: string]: string };
+    certificate?: string;
+    connectionTimeout?: number;
+    created?: string;
+    creatorId?: string;
+    enabled?: boolean;
+    groupDNAttribute?: string;
+    groupMemberMappingAttribute?: string;
+    groupMemberUserAttribute?: string;
+    groupNameAttribute?: string;
+    groupObjectClass?: string;
+    groupSearchAttribute?: string;
+    groupSearchBase?: string;
+    groupSearchFilter?: string;
+    labels?: { [key: string]: string };
+    name?: string;
+    nestedGroupMembershipEnabled?: boolean;
+    ownerReferences?: OwnerReference[];
+    port?: number;
+    removed?: string;
+    servers?: string[];
+    serviceAccountDistinguishedName?: string;
+    serviceAccountPassword?: string;
+    tls?: boolean;
+    type?: string;
+    uuid?: string;
+    userDisabledBitMask?: number;
+    userEnabledAttribute?: string;
+    userLoginAttribute?: string;
+    userMemberAttribute?: string;
+    userNameAttribute?: string;
+    userObjectClass?: string;
+    userSearchAttribute?: string;
+    userSearchBase?: string;
+    userSearchFilter?: string;
+}
+
+export interface LdapConfigCollection extends ApiCollection {
+    data?: LdapConfig[];
+}
+
+export class LdapConfigClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<LdapConfigCollection> {
+        return this.ops.DoList(LdapConfigType, opts);
+    }
+
+    Next(collection: LdapConfigCollection): Promise<LdapConfigCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: LdapConfig): Promise<LdapConfig> {
+        return this.ops.DoCreate(LdapConfigType, container);
+    }
+
+    Update(existing: LdapConfig, updates: object): Promise<LdapConfig> {
+        return this.ops.DoUpdate(LdapConfigType, existing, updates);
+    }
+
+    Replace(obj: LdapConfig): Promise<LdapConfig> {
+        return this.ops.DoReplace(LdapConfigType, obj, obj);
+    }
+
+    ByID(id: string): Promise<LdapConfig> {
+        return this.ops.DoByID(LdapConfigType, id);
+    }
+
+    Delete(container: LdapConfig): Promise<void> {
+        return this.ops.DoResourceDelete(LdapConfigType, container);
+    }
+}
+
+export const OpenLdapTestAndApplyInputType = "openLdapTestAndApplyInput";
+export const OpenLdapTestAndApplyInputFieldLdapConfig = "ldapConfig";
+export const OpenLdapTestAndApplyInputFieldPassword = "password";
+export const OpenLdapTestAndApplyInputFieldUsername = "username";
+
+export interface OpenLdapTestAndApplyInput {
+    ldapConfig?: LdapConfig;
+    password?: string;
+    username?: string;
+}
+
+export const FreeIpaConfigType = "freeIpaConfig";
+export const FreeIpaConfigFieldAccessMode = "accessMode";
+export const FreeIpaConfigFieldAllowedPrincipalIDs = "allowedPrincipalIds";
+export const FreeIpaConfigFieldAnnotations = "annotations";
+export const FreeIpaConfigFieldCertificate = "certificate";
+export const FreeIpaConfigFieldConnectionTimeout = "connectionTimeout";
+export const FreeIpaConfigFieldCreated = "created";
+export const FreeIpaConfigFieldCreatorID = "creatorId";
+export const FreeIpaConfigFieldEnabled = "enabled";
+export const FreeIpaConfigFieldGroupDNAttribute = "groupDNAttribute";
+export const FreeIpaConfigFieldGroupMemberMappingAttribute = "groupMemberMappingAttribute";
+export const FreeIpaConfigFieldGroupMemberUserAttribute = "groupMemberUserAttribute";
+export const FreeIpaConfigFieldGroupNameAttribute = "groupNameAttribute";
+export const FreeIpaConfigFieldGroupObjectClass = "groupObjectClass";
+export const FreeIpaConfigFieldGroupSearchAttribute = "groupSearchAttribute";
+export const FreeIpaConfigFieldGroupSearchBase = "groupSearchBase";
+export const FreeIpaConfigFieldGroupSearchFilter = "groupSearchFilter";
+export const FreeIpaConfigFieldLabels = "labels";
+export const FreeIpaConfigFieldName = "name";
+export const FreeIpaConfigFieldOwnerReferences = "ownerReferences";
+export const FreeIpaConfigFieldPort = "port";
+export const FreeIpaConfigFieldRemoved = "removed";
+export const FreeIpaConfigFieldServers = "servers";
+export const FreeIpaConfigFieldServiceAccountDistinguishedName = "serviceAccountDistinguishedName";
+export const FreeIpaConfigFieldServiceAccountPassword = "serviceAccountPassword";
+export const FreeIpaConfigFieldTLS = "tls";
+export const FreeIpaConfigFieldType = "type";
+export const FreeIpaConfigFieldUUID = "uuid";
+export const FreeIpaConfigFieldUserDisabledBitMask = "userDisabledBitMask";
+export const FreeIpaConfigFieldUserEnabledAttribute = "userEnabledAttribute";
+export const FreeIpaConfigFieldUserLoginAttribute = "userLoginAttribute";
+export const FreeIpaConfigFieldUserMemberAttribute = "userMemberAttribute";
+export const FreeIpaConfigFieldUserNameAttribute = "userNameAttribute";
+export const FreeIpaConfigFieldUserObjectClass = "userObjectClass";
+export const FreeIpaConfigFieldUserSearchAttribute = "userSearchAttribute";
+export const FreeIpaConfigFieldUserSearchBase = "userSearchBase";
+export const FreeIpaConfigFieldUserSearchFilter = "userSearchFilter";
+
+export interface FreeIpaConfig {
+    accessMode?: "required" | "restricted" | "unrestricted";
+    allowedPrincipalIds?: string[];
+    annotations?: { [key: string]: string };
+    certificate?: string;
+    connectionTimeout?: number;
+    created?: string;
+    creatorId?: string;
+    enabled?: boolean;
+    groupDNAttribute?: string;
+    groupMemberMappingAttribute?: string;
+    groupMemberUserAttribute?: string;
+    groupNameAttribute?: string;
+    groupObjectClass?: string;
+    groupSearchAttribute?: string;
+    groupSearchBase?: string;
+    groupSearchFilter?: string;
+    labels?: { [key: string]: string };
+    name?: string;
+    ownerReferences?: OwnerReference[];
+    port?: number;
+    removed?: string;
+    servers?: string[];
+    serviceAccountDistinguishedName?: string;
+    serviceAccountPassword?: string;
+    tls?: boolean;
+    type?: string;
+    uuid?: string;
+    userDisabledBitMask?: number;
+    userEnabledAttribute?: string;
+    userLoginAttribute?: string;
+    userMemberAttribute?: string;
+    userNameAttribute?: string;
+    userObjectClass?: string;
+    userSearchAttribute?: string;
+    userSearchBase?: string;
+    userSearchFilter?: string;
+}
+
+export const FreeIpaTestAndApplyInputType = "freeIpaTestAndApplyInput";
+export const FreeIpaTestAndApplyInputFieldLdapConfig = "ldapConfig";
+export const FreeIpaTestAndApplyInputFieldPassword = "password";
+export const FreeIpaTestAndApplyInputFieldUsername = "username";
+
+export interface FreeIpaTestAndApplyInput {
+    ldapConfig?: LdapConfig;
+    password?: string;
+    username?: string;
+}
+
+export const PingConfigType = "pingConfig";
+export const PingConfigFieldAccessMode = "accessMode";
+export const PingConfigFieldAllowedPrincipalIDs = "allowedPrincipalIds";
+export const PingConfigFieldAnnotations = "annotations";
+export const PingConfigFieldCreated = "created";
+export const PingConfigFieldCreatorID = "creatorId";
+export const PingConfigFieldDisplayNameField = "displayNameField";
+export const PingConfigFieldEnabled = "enabled";
+export const PingConfigFieldGroupsField = "groupsField";
+export const PingConfigFieldIDPMetadataContent = "idpMetadataContent";
+export const PingConfigFieldLabels = "labels";
+export const PingConfigFieldName = "name";
+export const PingConfigFieldOwnerReferences = "ownerReferences";
+export const PingConfigFieldRancherAPIHost = "rancherApiHost";
+export const PingConfigFieldRemoved = "removed";
+export const PingConfigFieldSpCert = "spCert";
+export const PingConfigFieldSpKey = "spKey";
+export const PingConfigFieldType = "type";
+export const PingConfigFieldUIDField = "uidField";
+export const PingConfigFieldUUID = "uuid";
+export const PingConfigFieldUserNameField = "userNameField";
+
+export interface PingConfig {
+    accessMode?: "required" | "restricted" | "unrestricted";
+    allowedPrincipalIds?: string[];
+    annotations?: { [key: string]: string };
+    created?: string;
+    creatorId?: string;
+    displayNameField?: string;
+    enabled?: boolean;
+    groupsField?: string;
+    idpMetadataContent?: string;
+    labels?: { [key: string]: string };
+    name?: string;
+    ownerReferences?: OwnerReference[];
+    rancherApiHost?: string;
+    removed?: string;
+    spCert?: string;
+    spKey?: string;
+    type?: string;
+    uidField?: string;
+    uuid?: string;
+    userNameField?: string;
+}
+
+export const ADFSConfigType = "adfsConfig";
+export const ADFSConfigFieldAccessMode = "accessMode";
+export const ADFSConfigFieldAllowedPrincipalIDs = "allowedPrincipalIds";
+export const ADFSConfigFieldAnnotations = "annotations";
+export const ADFSConfigFieldCreated = "created";
+export const ADFSConfigFieldCreatorID = "creatorId";
+export const ADFSConfigFieldDisplayNameField = "displayNameField";
+export const ADFSConfigFieldEnabled = "enabled";
+export const ADFSConfigFieldGroupsField = "groupsField";
+export const ADFSConfigFieldIDPMetadataContent = "idpMetadataContent";
+export const ADFSConfigFieldLabels = "labels";
+export const ADFSConfigFieldName = "name";
+export const ADFSConfigFieldOwnerReferences = "ownerReferences";
+export const ADFSConfigFieldRancherAPIHost = "rancherApiHost";
+export const ADFSConfigFieldRemoved = "removed";
+export const ADFSConfigFieldSpCert = "spCert";
+export const ADFSConfigFieldSpKey = "spKey";
+export const ADFSConfigFieldType = "type";
+export const ADFSConfigFieldUIDField = "uidField";
+export const ADFSConfigFieldUUID = "uuid";
+export const ADFSConfigFieldUserNameField = "userNameField";
+
+export interface ADFSConfig {
+    accessMode?: "required" | "restricted" | "unrestricted";
+    allowedPrincipalIds?: string[];
+    annotations?: { [key: string]: string };
+    created?: string;
+    creatorId?: string;
+    displayNameField?: string;
+    enabled?: boolean;
+    groupsField?: string;
+    idpMetadataContent?: string;
+    labels?: { [key: string]: string };
+    name?: string;
+    ownerReferences?: OwnerReference[];
+    rancherApiHost?: string;
+    removed?: string;
+    spCert?: string;
+    spKey?: string;
+    type?: string;
+    uidField?: string;
+    uuid?: string;
+    userNameField?: string;
+}
+
+export const KeyCloakConfigType = "keyCloakConfig";
+export const KeyCloakConfigFieldAccessMode = "accessMode";
+export const KeyCloakConfigFieldAllowedPrincipalIDs = "allowedPrincipalIds";
+export const KeyCloakConfigFieldAnnotations = "annotations";
+export const KeyCloakConfigFieldCreated = "created";
+export const KeyCloakConfigFieldCreatorID = "creatorId";
+export const KeyCloakConfigFieldDisplayNameField = "displayNameField";
+export const KeyCloakConfigFieldEnabled = "enabled";
+export const KeyCloakConfigFieldGroupsField = "groupsField";
+export const KeyCloakConfigFieldIDPMetadataContent = "idpMetadataContent";
+export const KeyCloakConfigFieldLabels = "labels";
+export const KeyCloakConfigFieldName = "name";
+export const KeyCloakConfigFieldOwnerReferences = "ownerReferences";
+export const KeyCloakConfigFieldRancherAPIHost = "rancherApiHost";
+export const KeyCloakConfigFieldRemoved = "removed";
+export const KeyCloakConfigFieldSpCert = "spCert";
+export const KeyCloakConfigFieldSpKey = "spKey";
+export const KeyCloakConfigFieldType = "type";
+export const KeyCloakConfigFieldUIDField = "uidField";
+export const KeyCloakConfigFieldUUID = "uuid";
+export const KeyCloakConfigFieldUserNameField = "userNameField";
+
+export interface KeyCloakConfig {
+    accessMode?: "required" | "restricted" | "unrestricted";
+    allowedPrincipalIds?: string[];
+    annotations?: { [key: string]: string };
+    created?: string;
+    creatorId?: string;
+    displayNameField?: string;
+    enabled?: boolean;
+    groupsField?: string;
+    idpMetadataContent?: string;
+    labels?: { [key: string]: string };
+    name?: string;
+    ownerReferences?: OwnerReference[];
+    rancherApiHost?: string;
+    removed?: string;
+    spCert?: string;
+    spKey?: string;
+    type?: string;
+    uidField?: string;
+    uuid?: string;
+    userNameField?: string;
+}
+
+export const OKTAConfigType = "oktaConfig";
+export const OKTAConfigFieldAccessMode = "accessMode";
+export const OKTAConfigFieldAllowedPrincipalIDs = "allowedPrincipalIds";
+export const OKTAConfigFieldAnnotations = "annotations";
+export const OKTAConfigFieldCreated = "created";
+export const OKTAConfigFieldCreatorID = "creatorId";
+export const OKTAConfigFieldDisplayNameField = "displayNameField";
+export const OKTAConfigFieldEnabled = "enabled";
+export const OKTAConfigFieldGroupsField = "groupsField";
+export const OKTAConfigFieldIDPMetadataContent = "idpMetadataContent";
+export const OKTAConfigFieldLabels = "labels";
+export const OKTAConfigFieldName = "name";
+export const OKTAConfigFieldOwnerReferences = "ownerReferences";
+export const OKTAConfigFieldRancherAPIHost = "rancherApiHost";
+export const OKTAConfigFieldRemoved = "removed";
+export const OKTAConfigFieldSpCert = "spCert";
+export const OKTAConfigFieldSpKey = "spKey";
+export const OKTAConfigFieldType = "type";
+export const OKTAConfigFieldUIDField = "uidField";
+export const OKTAConfigFieldUUID = "uuid";
+export const OKTAConfigFieldUserNameField = "userNameField";
+
+export interface OKTAConfig {
+    accessMode?: "required" | "restricted" | "unrestricted";
+    allowedPrincipalIds?: string[];
+    annotations?: { [key: string]: string };
+    created?: string;
+    creatorId?: string;
+    displayNameField?: string;
+    enabled?: boolean;
+    groupsField?: string;
+    idpMetadataContent?: string;
+    labels?: { [key: string]: string };
+    name?: string;
+    ownerReferences?: OwnerReference[];
+    rancherApiHost?: string;
+    removed?: string;
+    spCert?: string;
+    spKey?: string;
+    type?: string;
+    uidField?: string;
+    uuid?: string;
+    userNameField?: string;
+}
+
+export const SamlConfigTestInputType = "samlConfigTestInput";
+export const SamlConfigTestInputFieldFinalRedirectURL = "finalRedirectUrl";
+
+export interface SamlConfigTestInput {
+    finalRedirectUrl?: string;
+}
+
+export const SamlConfigTestOutputType = "samlConfigTestOutput";
+export const SamlConfigTestOutputFieldIdpRedirectURL = "idpRedirectUrl";
+
+export interface SamlConfigTestOutput {
+    idpRedirectUrl?: string;
+}
+
+export const GoogleOauthConfigType = "googleOauthConfig";
+export const GoogleOauthConfigFieldAccessMode = "accessMode";
+export const GoogleOauthConfigFieldAdminEmail = "adminEmail";
+export const GoogleOauthConfigFieldAllowedPrincipalIDs = "allowedPrincipalIds";
+export const GoogleOauthConfigFieldAnnotations = "annotations";
+export const GoogleOauthConfigFieldCreated = "created";
+export const GoogleOauthConfigFieldCreatorID = "creatorId";
+export const GoogleOauthConfigFieldEnabled = "enabled";
+export const GoogleOauthConfigFieldHostname = "hostname";
+export const GoogleOauthConfigFieldLabels = "labels";
+export const GoogleOauthConfigFieldName = "name";
+export const GoogleOauthConfigFieldNestedGroupMembershipEnabled = "nestedGroupMembershipEnabled";
+export const GoogleOauthConfigFieldOauthCredential = "oauthCredential";
+export const GoogleOauthConfigFieldOwnerReferences = "ownerReferences";
+export const GoogleOauthConfigFieldRemoved = "removed";
+export const GoogleOauthConfigFieldServiceAccountCredential = "serviceAccountCredential";
+export const GoogleOauthConfigFieldType = "type";
+export const GoogleOauthConfigFieldUUID = "uuid";
+export const GoogleOauthConfigFieldUserInfoEndpoint = "userInfoEndpoint";
+
+export interface GoogleOauthConfig {
+    accessMode?: "required" | "restricted" | "unrestricted";
+    adminEmail?: string;
+    allowedPrincipalIds?: string[];
+    annotations?: { [key: string]: string };
+    created?: string;
+    creatorId?: string;
+    enabled?: boolean;
+    hostname?: string;
+    labels?: { [key: string]: string };
+    name?: string;
+    nestedGroupMembershipEnabled?: boolean;
+    oauthCredential?: string;
+    ownerReferences?: OwnerReference[];
+    removed?: string;
+    serviceAccountCredential?: string;
+    type?: string;
+    uuid?: string;
+    userInfoEndpoint?: string;
+}
+
+export const GoogleOauthConfigApplyInputType = "googleOauthConfigApplyInput";
+export const GoogleOauthConfigApplyInputFieldCode = "code";
+export const GoogleOauthConfigApplyInputFieldEnabled = "enabled";
+export const GoogleOauthConfigApplyInputFieldGoogleOauthConfig = "googleOauthConfig";
+
+export interface GoogleOauthConfigApplyInput {
+    code?: string;
+    enabled?: boolean;
+    googleOauthConfig?: GoogleOauthConfig;
+}
+
+export const GoogleOauthConfigTestOutputType = "googleOauthConfigTestOutput";
+export const GoogleOauthConfigTestOutputFieldRedirectURL = "redirectUrl";
+
+export interface GoogleOauthConfigTestOutput {
+    redirectUrl?: string;
+}
+
+export const TokenType = "token";
+export const TokenFieldAnnotations = "annotations";
+export const TokenFieldAuthProvider = "authProvider";
+export const TokenFieldClusterID = "clusterId";
+export const TokenFieldCreated = "created";
+export const TokenFieldCreatorID = "creatorId";
+export const TokenFieldCurrent = "current";
+export const TokenFieldDescription = "description";
+export const TokenFieldEnabled = "enabled";
+export const TokenFieldExpired = "expired";
+export const TokenFieldExpiresAt = "expiresAt";
+export const TokenFieldGroupPrincipals = "groupPrincipals";
+export const TokenFieldIsDerived = "isDerived";
+export const TokenFieldLabels = "labels";
+export const TokenFieldLastUpdateTime = "lastUpdateTime";
+export const TokenFieldName = "name";
+export const TokenFieldOwnerReferences = "ownerReferences";
+export const TokenFieldProviderInfo = "providerInfo";
+export const TokenFieldRemoved = "removed";
+export const TokenFieldTTLMillis = "ttl";
+export const TokenFieldToken = "token";
+export const TokenFieldUUID = "uuid";
+export const TokenFieldUserID = "userId";
+export const TokenFieldUserPrincipal = "userPrincipal";
+export const TokenCollectionActionLogout = "logout";
+
+export interface Token extends ApiResource {
+    annotations?: { [key: string]: string };
+    authProvider?: string;
+    clusterId?: string;
+    created?: string;
+    creatorId?: string;
+    current?: boolean;
+    description?: string;
+    enabled?: boolean;
+    expired?: boolean;
+    expiresAt?: string;
+    groupPrincipals?: string[];
+    isDerived?: boolean;
+    labels?: { [key: string]: string };
+    lastUpdateTime?: string;
+    name?: string;
+    ownerReferences?: OwnerReference[];
+    providerInfo?: { [key: string]: string };
+    removed?: string;
+    ttl?: number;
+    token?: string;
+    uuid?: string;
+    userId?: string;
+    userPrincipal?: string;
+}
+
+export interface TokenCollection extends ApiCollection {
+    data?: Token[];
+}
+
+export class TokenClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<TokenCollection> {
+        return this.ops.DoList(TokenType, opts);
+    }
+
+    Next(collection: TokenCollection): Promise<TokenCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: Token): Promise<Token> {
+        return this.ops.DoCreate(TokenType, container);
+    }
+
+    Update(existing: Token, updates: object): Promise<Token> {
+        return this.ops.DoUpdate(TokenType, existing, updates);
+    }
+
+    Replace(obj: Token): Promise<Token> {
+        return this.ops.DoReplace(TokenType, obj, obj);
+    }
+
+    ByID(id: string): Promise<Token> {
+        return this.ops.DoByID(TokenType, id);
+    }
+
+    Delete(container: Token): Promise<void> {
+        return this.ops.DoResourceDelete(TokenType, container);
+    }
+
+    CollectionActionLogout(resource: TokenCollection): Promise<void> {
+        return this.ops.DoCollectionAction(TokenType, TokenCollectionActionLogout, resource);
+    }
+}
+
+export const ValuesType = "values";
+export const ValuesFieldBoolValue = "boolValue";
+export const ValuesFieldIntValue = "intValue";
+export const ValuesFieldStringSliceValue = "stringSliceValue";
+export const ValuesFieldStringValue = "stringValue";
+
+export interface Values {
+    boolValue?: boolean;
+    intValue?: number;
+    stringSliceValue?: string[];
+    stringValue?: string;
+}
+
+export const FieldType = "field";
+export const FieldFieldCreate = "create";
+export const FieldFieldDefault = "default";
+export const FieldFieldDescription = "description";
+export const FieldFieldDynamicField = "dynamicField";
+export const FieldFieldInvalidChars = "invalidChars";
+export const FieldFieldMax = "max";
+export const FieldFieldMaxLength = "maxLength";
+export const FieldFieldMin = "min";
+export const FieldFieldMinLength = "minLength";
+export const FieldFieldNullable = "nullable";
+export const FieldFieldOptions = "options";
+export const FieldFieldRequired = "required";
+export const FieldFieldType = "type";
+export const FieldFieldUnique = "unique";
+export const FieldFieldUpdate = "update";
+export const FieldFieldValidChars = "validChars";
+
+export interface Field {
+    create?: boolean;
+    default?: Values;
+    description?: string;
+    dynamicField?: boolean;
+    invalidChars?: string;
+    max?: number;
+    maxLength?: number;
+    min?: number;
+    minLength?: number;
+    nullable?: boolean;
+    options?: string[];
+    required?: boolean;
+    type?: string;
+    unique?: boolean;
+    update?: boolean;
+    validChars?: string;
+}
+
+export const ActionType = "action";
+export const ActionFieldInput = "input";
+export const ActionFieldOutput = "output";
+
+export interface Action {
+    input?: string;
+    output?: string;
+}
+
+export const FilterType = "filter";
+export const FilterFieldModifiers = "modifiers";
+
+export interface Filter {
+    modifiers?: string[];
+}
+
+export const DynamicSchemaSpecType = "dynamicSchemaSpec";
+export const DynamicSchemaSpecFieldCollectionActions = "collectionActions";
+export const DynamicSchemaSpecFieldCollectionFields = "collectionFields";
+export const DynamicSchemaSpecFieldCollectionFilters = "collectionFilters";
+export const DynamicSchemaSpecFieldCollectionMethods = "collectionMethods";
+export const DynamicSchemaSpecFieldDynamicSchemaVersion = "dynamicSchemaVersion";
+export const DynamicSchemaSpecFieldEmbed = "embed";
+export const DynamicSchemaSpecFieldEmbedType = "embedType";
+export const DynamicSchemaSpecFieldIncludeableLinks = "includeableLinks";
+export const DynamicSchemaSpecFieldPluralName = "pluralName";
+export const DynamicSchemaSpecFieldResourceActions = "resourceActions";
+export const DynamicSchemaSpecFieldResourceFields = "resourceFields";
+export const DynamicSchemaSpecFieldResourceMethods = "resourceMethods";
+export const DynamicSchemaSpecFieldSchemaName = "schemaName";
+
+export interface DynamicSchemaSpec {
+    collectionActions?: { [key: string]: Action };
+    collectionFields?: { [key: string]: Field };
+    collectionFilters?: { [key: string]: Filter };
+    collectionMethods?: string[];
+    dynamicSchemaVersion?: string;
+    embed?: boolean;
+    embedType?: string;
+    includeableLinks?: string[];
+    pluralName?: string;
+    resourceActions?: { [key: string]: Action };
+    resourceFields?: { [key: string]: Field };
+    resourceMethods?: string[];
+    schemaName?: string;
+}
+
+export const DynamicSchemaStatusType = "dynamicSchemaStatus";
+export const DynamicSchemaStatusFieldFake = "fake";
+
+export interface DynamicSchemaStatus {
+    fake?: string;
+}
+
+export const DynamicSchemaType = "dynamicSchema";
+export const DynamicSchemaFieldAnnotations = "annotations";
+export const DynamicSchemaFieldCollectionActions = "collectionActions";
+export const DynamicSchemaFieldCollectionFields = "collectionFields";
+export const DynamicSchemaFieldCollectionFilters = "collectionFilters";
+export const DynamicSchemaFieldCollectionMethods = "collectionMethods";
+export const DynamicSchemaFieldCreated = "created";
+export const DynamicSchemaFieldCreatorID = "creatorId";
+export const DynamicSchemaFieldDynamicSchemaVersion = "dynamicSchemaVersion";
+export const DynamicSchemaFieldEmbed = "embed";
+export const DynamicSchemaFieldEmbedType = "embedType";
+export const DynamicSchemaFieldIncludeableLinks = "includeableLinks";
+export const DynamicSchemaFieldLabels = "labels";
+export const DynamicSchemaFieldName = "name";
+export const DynamicSchemaFieldOwnerReferences = "ownerReferences";
+export const DynamicSchemaFieldPluralName = "pluralName";
+export const DynamicSchemaFieldRemoved = "removed";
+export const DynamicSchemaFieldResourceActions = "resourceActions";
+export const DynamicSchemaFieldResourceFields = "resourceFields";
+export const DynamicSchemaFieldResourceMethods = "resourceMethods";
+export const DynamicSchemaFieldSchemaName = "schemaName";
+export const DynamicSchemaFieldState = "state";
+export const DynamicSchemaFieldStatus = "status";
+export const DynamicSchemaFieldTransitioning = "transitioning";
+export const DynamicSchemaFieldTransitioningMessage = "transitioningMessage";
+export const DynamicSchemaFieldUUID = "uuid";
+
+export interface DynamicSchema extends ApiResource {
+    annotations?: { [key: string]: string };
+    collectionActions?: { [key: string]: Action };
+    collectionFields?: { [key: string]: Field };
+    collectionFilters?: { [key: string]: Filter };
+    collectionMethods?: string[];
+    created?: string;
+    creatorId?: string;
+    dynamicSchemaVersion?: string;
+    embed?: boolean;
+    embedType?: string;
+    includeableLinks?: string[];
+    labels?: { [key: string]: string };
+    name?: string;
+    ownerReferences?: OwnerReference[];
+    pluralName?: string;
+    removed?: string;
+    resourceActions?: { [key: string]: Action };
+    resourceFields?: { [key: string]: Field };
+    resourceMethods?: string[];
+    schemaName?: string;
+    state?: string;
+    status?: DynamicSchemaStatus;
+    transitioning?: "yes" | "no" | "error";
+    transitioningMessage?: string;
+    uuid?: string;
+}
+
+export interface DynamicSchemaCollection extends ApiCollection {
+    data?: DynamicSchema[];
+}
+
+export class DynamicSchemaClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<DynamicSchemaCollection> {
+        return this.ops.DoList(DynamicSchemaType, opts);
+    }
+
+    Next(collection: DynamicSchemaCollection): Promise<DynamicSchemaCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: DynamicSchema): Promise<DynamicSchema> {
+        return this.ops.DoCreate(DynamicSchemaType, container);
+    }
+
+    Update(existing: DynamicSchema, updates: object): Promise<DynamicSchema> {
+        return this.ops.DoUpdate(DynamicSchemaType, existing, updates);
+    }
+
+    Replace(obj: DynamicSchema): Promise<DynamicSchema> {
+        return this.ops.DoReplace(DynamicSchemaType, obj, obj);
+    }
+
+    ByID(id: string): Promise<DynamicSchema> {
+        return this.ops.DoByID(DynamicSchemaType, id);
+    }
+
+    Delete(container: DynamicSchema): Promise<void> {
+        return this.ops.DoResourceDelete(DynamicSchemaType, container);
+    }
+}
+
+export const PreferenceType = "preference";
+export const PreferenceFieldAnnotations = "annotations";
+export const PreferenceFieldCreated = "created";
+export const PreferenceFieldCreatorID = "creatorId";
+export const PreferenceFieldLabels = "labels";
+export const PreferenceFieldName = "name";
+export const PreferenceFieldNamespaceId = "namespaceId";
+export const PreferenceFieldOwnerReferences = "ownerReferences";
+export const PreferenceFieldRemoved = "removed";
+export const PreferenceFieldUUID = "uuid";
+export const PreferenceFieldValue = "value";
+
+export interface Preference extends ApiResource {
+    annotations?: { [key: string]: string };
+    created?: string;
+    creatorId?: string;
+    labels?: { [key: string]: string };
+    name?: string;
+    namespaceId?: string;
+    ownerReferences?: OwnerReference[];
+    removed?: string;
+    uuid?: string;
+    value?: string;
+}
+
+export interface PreferenceCollection extends ApiCollection {
+    data?: Preference[];
+}
+
+export class PreferenceClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<PreferenceCollection> {
+        return this.ops.DoList(PreferenceType, opts);
+    }
+
+    Next(collection: PreferenceCollection): Promise<PreferenceCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: Preference): Promise<Preference> {
+        return this.ops.DoCreate(PreferenceType, container);
+    }
+
+    Update(existing: Preference, updates: object): Promise<Preference> {
+        return this.ops.DoUpdate(PreferenceType, existing, updates);
+    }
+
+    Replace(obj: Preference): Promise<Preference> {
+        return this.ops.DoReplace(PreferenceType, obj, obj);
+    }
+
+    ByID(id: string): Promise<Preference> {
+        return this.ops.DoByID(PreferenceType, id);
+    }
+
+    Delete(container: Preference): Promise<void> {
+        return this.ops.DoResourceDelete(PreferenceType, container);
+    }
+}
+
+export const UserAttributeType = "userAttribute";
+export const UserAttributeFieldAnnotations = "annotations";
+export const UserAttributeFieldCreated = "created";
+export const UserAttributeFieldCreatorID = "creatorId";
+export const UserAttributeFieldGroupPrincipals = "groupPrincipals";
+export const UserAttributeFieldLabels = "labels";
+export const UserAttributeFieldLastRefresh = "lastRefresh";
+export const UserAttributeFieldName = "name";
+export const UserAttributeFieldNeedsRefresh = "needsRefresh";
+export const UserAttributeFieldOwnerReferences = "ownerReferences";
+export const UserAttributeFieldRemoved = "removed";
+export const UserAttributeFieldUUID = "uuid";
+export const UserAttributeFieldUserName = "userName";
+
+export interface UserAttribute {
+    annotations?: { [key: string]: string };
+    created?: string;
+    creatorId?: string;
+    groupPrincipals?: { [key: string]: Principal };
+    labels?: { [key: string]: string };
+    lastRefresh?: string;
+    name?: string;
+    needsRefresh?: boolean;
+    ownerReferences?: OwnerReference[];
+    removed?: string;
+    uuid?: string;
+    userName?: string;
+}
+
+export const ProjectNetworkPolicySpecType = "projectNetworkPolicySpec";
+export const ProjectNetworkPolicySpecFieldDescription = "description";
+export const ProjectNetworkPolicySpecFieldProjectID = "projectId";
+
+export interface ProjectNetworkPolicySpec {
+    description?: string;
+    projectId?: string;
+}
+
+export const ProjectNetworkPolicyStatusType = "projectNetworkPolicyStatus";
+
+export interface ProjectNetworkPolicyStatus {
+}
+
+export const ProjectNetworkPolicyType = "projectNetworkPolicy";
+export const ProjectNetworkPolicyFieldAnnotations = "annotations";
+export const ProjectNetworkPolicyFieldCreated = "created";
+export const ProjectNetworkPolicyFieldCreatorID = "creatorId";
+export const ProjectNetworkPolicyFieldDescription = "description";
+export const ProjectNetworkPolicyFieldLabels = "labels";
+export const ProjectNetworkPolicyFieldName = "name";
+export const ProjectNetworkPolicyFieldNamespaceId = "namespaceId";
+export const ProjectNetworkPolicyFieldOwnerReferences = "ownerReferences";
+export const ProjectNetworkPolicyFieldProjectID = "projectId";
+export const ProjectNetworkPolicyFieldRemoved = "removed";
+export const ProjectNetworkPolicyFieldState = "state";
+export const ProjectNetworkPolicyFieldStatus = "status";
+export const ProjectNetworkPolicyFieldTransitioning = "transitioning";
+export const ProjectNetworkPolicyFieldTransitioningMessage = "transitioningMessage";
+export const ProjectNetworkPolicyFieldUUID = "uuid";
+
+export interface ProjectNetworkPolicy extends ApiResource {
+    annotations?: { [key: string]: string };
+    created?: string;
+    creatorId?: string;
+    description?: string;
+    labels?: { [key: string]: string };
+    name?: string;
+    namespaceId?: string;
+    ownerReferences?: OwnerReference[];
+    projectId?: string;
+    removed?: string;
+    state?: string;
+    status?: ProjectNetworkPolicyStatus;
+    transitioning?: "yes" | "no" | "error";
+    transitioningMessage?: string;
+    uuid?: string;
+}
+
+export interface ProjectNetworkPolicyCollection extends ApiCollection {
+    data?: ProjectNetworkPolicy[];
+}
+
+export class ProjectNetworkPolicyClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<ProjectNetworkPolicyCollection> {
+        return this.ops.DoList(ProjectNetworkPolicyType, opts);
+    }
+
+    Next(collection: ProjectNetworkPolicyCollection): Promise<ProjectNetworkPolicyCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: ProjectNetworkPolicy): Promise<ProjectNetworkPolicy> {
+        return this.ops.DoCreate(ProjectNetworkPolicyType, container);
+    }
+
+    Update(existing: ProjectNetworkPolicy, updates: object): Promise<ProjectNetworkPolicy> {
+        return this.ops.DoUpdate(ProjectNetworkPolicyType, existing, updates);
+    }
+
+    Replace(obj: ProjectNetworkPolicy): Promise<ProjectNetworkPolicy> {
+        return this.ops.DoReplace(ProjectNetworkPolicyType, obj, obj);
+    }
+
+    ByID(id: string): Promise<ProjectNetworkPolicy> {
+        return this.ops.DoByID(ProjectNetworkPolicyType, id);
+    }
+
+    Delete(container: ProjectNetworkPolicy): Promise<void> {
+        return this.ops.DoResourceDelete(ProjectNetworkPolicyType, container);
+    }
+}
+
+export const ElasticsearchConfigType = "elasticsearchConfig";
+export const ElasticsearchConfigFieldAuthPassword = "authPassword";
+export const ElasticsearchConfigFieldAuthUserName = "authUsername";
+export const ElasticsearchConfigFieldCertificate = "certificate";
+export const ElasticsearchConfigFieldClientCert = "clientCert";
+export const ElasticsearchConfigFieldClientKey = "clientKey";
+export const ElasticsearchConfigFieldClientKeyPass = "clientKeyPass";
+export const ElasticsearchConfigFieldDateFormat = "dateFormat";
+export const ElasticsearchConfigFieldEndpoint = "endpoint";
+export const ElasticsearchConfigFieldIndexPrefix = "indexPrefix";
+export const ElasticsearchConfigFieldSSLVerify = "sslVerify";
+export const ElasticsearchConfigFieldSSLVersion = "sslVersion";
+
+export interface ElasticsearchConfig {
+    authPassword?: string;
+    authUsername?: string;
+    certificate?: string;
+    clientCert?: string;
+    clientKey?: string;
+    clientKeyPass?: string;
+    dateFormat?: "YYYY-MM-DD" | "YYYY-MM" | "YYYY";
+    endpoint?: string;
+    indexPrefix?: string;
+    sslVerify?: boolean;
+    sslVersion?: "SSLv23" | "TLSv1" | "TLSv1_1" | "TLSv1_2";
+}
+
+export const SplunkConfigType = "splunkConfig";
+export const SplunkConfigFieldCertificate = "certificate";
+export const SplunkConfigFieldClientCert = "clientCert";
+export const SplunkConfigFieldClientKey = "clientKey";
+export const SplunkConfigFieldClientKeyPass = "clientKeyPass";
+export const SplunkConfigFieldEndpoint = "endpoint";
+export const SplunkConfigFieldIndex = "index";
+export const SplunkConfigFieldSSLVerify = "sslVerify";
+export const SplunkConfigFieldSource = "source";
+export const SplunkConfigFieldToken = "token";
+
+export interface SplunkConfig {
+    certificate?: string;
+    clientCert?: string;
+    clientKey?: string;
+    clientKeyPass?: string;
+    endpoint?: string;
+    index?: string;
+    sslVerify?: boolean;
+    source?: string;
+    token?: string;
+}
+
+export const KafkaConfigType = "kafkaConfig";
+export const KafkaConfigFieldBrokerEndpoints = "brokerEndpoints";
+export const KafkaConfigFieldCertificate = "certificate";
+export const KafkaConfigFieldClientCert = "clientCert";
+export const KafkaConfigFieldClientKey = "clientKey";
+export const KafkaConfigFieldSaslPassword = "saslPassword";
+export const KafkaConfigFieldSaslScramMechanism = "saslScramMechanism";
+export const KafkaConfigFieldSaslType = "saslType";
+export const KafkaConfigFieldSaslUsername = "saslUsername";
+export const KafkaConfigFieldTopic = "topic";
+export const KafkaConfigFieldZookeeperEndpoint = "zookeeperEndpoint";
+
+export interface KafkaConfig {
+    brokerEndpoints?: string[];
+    certificate?: string;
+    clientCert?: string;
+    clientKey?: string;
+    saslPassword?: string;
+    saslScramMechanism?: "sha256" | "sha512";
+    saslType?: "plain" | "scram";
+    saslUsername?: string;
+    topic?: string;
+    zookeeperEndpoint?: string;
+}
+
+export const SyslogConfigType = "syslogConfig";
+export const SyslogConfigFieldCertificate = "certificate";
+export const SyslogConfigFieldClientCert = "clientCert";
+export const SyslogConfigFieldClientKey = "clientKey";
+export const SyslogConfigFieldEnableTLS = "enableTls";
+export const SyslogConfigFieldEndpoint = "endpoint";
+export const SyslogConfigFieldProgram = "program";
+export const SyslogConfigFieldProtocol = "protocol";
+export const SyslogConfigFieldSSLVerify = "sslVerify";
+export const SyslogConfigFieldSeverity = "severity";
+export const SyslogConfigFieldToken = "token";
+
+export interface SyslogConfig {
+    certificate?: string;
+    clientCert?: string;
+    clientKey?: string;
+    enableTls?: boolean;
+    endpoint?: string;
+    program?: string;
+    protocol?: "udp" | "tcp";
+    sslVerify?: boolean;
+    severity?: "emerg" | "alert" | "crit" | "err" | "warning" | "notice" | "info" | "debug";
+    token?: string;
+}
+
+export const FluentServerType = "fluentServer";
+export const FluentServerFieldEndpoint = "endpoint";
+export const FluentServerFieldHostname = "hostname";
+export const FluentServerFieldPassword = "password";
+export const FluentServerFieldSharedKey = "sharedKey";
+export const FluentServerFieldStandby = "standby";
+export const FluentServerFieldUsername = "username";
+export const FluentServerFieldWeight = "weight";
+
+export interface FluentServer {
+    endpoint?: string;
+    hostname?: string;
+    password?: string;
+    sharedKey?: string;
+    standby?: boolean;
+    username?: string;
+    weight?: number;
+}
+
+export const FluentForwarderConfigType = "fluentForwarderConfig";
+export const FluentForwarderConfigFieldCertificate = "certificate";
+export const FluentForwarderConfigFieldClientCert = "clientCert";
+export const FluentForwarderConfigFieldClientKey = "clientKey";
+export const FluentForwarderConfigFieldClientKeyPass = "clientKeyPass";
+export const FluentForwarderConfigFieldCompress = "compress";
+export const FluentForwarderConfigFieldEnableTLS = "enableTls";
+export const FluentForwarderConfigFieldFluentServers = "fluentServers";
+export const FluentForwarderConfigFieldSSLVerify = "sslVerify";
+
+export interface FluentForwarderConfig {
+    certificate?: string;
+    clientCert?: string;
+    clientKey?: string;
+    clientKeyPass?: string;
+    compress?: boolean;
+    enableTls?: boolean;
+    fluentServers?: FluentServer[];
+    sslVerify?: boolean;
+}
+
+export const CustomTargetConfigType = "customTargetConfig";
+export const CustomTargetConfigFieldCertificate = "certificate";
+export const CustomTargetConfigFieldClientCert = "clientCert";
+export const CustomTargetConfigFieldClientKey = "clientKey";
+export const CustomTargetConfigFieldContent = "content";
+
+export interface CustomTargetConfig {
+    certificate?: string;
+    clientCert?: string;
+    clientKey?: string;
+    content?: string;
+}
+
+export const ClusterTestInputType = "clusterTestInput";
+export const ClusterTestInputFieldClusterName = "clusterId";
+export const ClusterTestInputFieldCustomTargetConfig = "customTargetConfig";
+export const ClusterTestInputFieldElasticsearchConfig = "elasticsearchConfig";
+export const ClusterTestInputFieldFluentForwarderConfig = "fluentForwarderConfig";
+export const ClusterTestInputFieldKafkaConfig = "kafkaConfig";
+export const ClusterTestInputFieldOutputTags = "outputTags";
+export const ClusterTestInputFieldSplunkConfig = "splunkConfig";
+export const ClusterTestInputFieldSyslogConfig = "syslogConfig";
+
+export interface ClusterTestInput {
+    clusterId?: string;
+    customTargetConfig?: CustomTargetConfig;
+    elasticsearchConfig?: ElasticsearchConfig;
+    fluentForwarderConfig?: FluentForwarderConfig;
+    kafkaConfig?: KafkaConfig;
+    outputTags?: { [key: string]: string };
+    splunkConfig?: SplunkConfig;
+    syslogConfig?: SyslogConfig;
+}
+
+export const ProjectTestInputType = "projectTestInput";
+export const ProjectTestInputFieldCustomTargetConfig = "customTargetConfig";
+export const ProjectTestInputFieldElasticsearchConfig = "elasticsearchConfig";
+export const ProjectTestInputFieldFluentForwarderConfig = "fluentForwarderConfig";
+export const ProjectTestInputFieldKafkaConfig = "kafkaConfig";
+export const ProjectTestInputFieldOutputTags = "outputTags";
+export const ProjectTestInputFieldProjectName = "projectId";
+export const ProjectTestInputFieldSplunkConfig = "splunkConfig";
+export const ProjectTestInputFieldSyslogConfig = "syslogConfig";
+
+export interface ProjectTestInput {
+    customTargetConfig?: CustomTargetConfig;
+    elasticsearchConfig?: ElasticsearchConfig;
+    fluentForwarderConfig?: FluentForwarderConfig;
+    kafkaConfig?: KafkaConfig;
+    outputTags?: { [key: string]: string };
+    projectId?: string;
+    splunkConfig?: SplunkConfig;
+    syslogConfig?: SyslogConfig;
+}
+
+export const ClusterLoggingSpecType = "clusterLoggingSpec";
+export const ClusterLoggingSpecFieldClusterID = "clusterId";
+export const ClusterLoggingSpecFieldCustomTargetConfig = "customTargetConfig";
+export const ClusterLoggingSpecFieldDisplayName = "displayName";
+export const ClusterLoggingSpecFieldElasticsearchConfig = "elasticsearchConfig";
+export const ClusterLoggingSpecFieldEnableJSONParsing = "enableJSONParsing";
+export const ClusterLoggingSpecFieldFluentForwarderConfig = "fluentForwarderConfig";
+export const ClusterLoggingSpecFieldIncludeSystemComponent = "includeSystemComponent";
+export const ClusterLoggingSpecFieldKafkaConfig = "kafkaConfig";
+export const ClusterLoggingSpecFieldOutputFlushInterval = "outputFlushInterval";
+export const ClusterLoggingSpecFieldOutputTags = "outputTags";
+export const ClusterLoggingSpecFieldSplunkConfig = "splunkConfig";
+export const ClusterLoggingSpecFieldSyslogConfig = "syslogConfig";
+
+export interface ClusterLoggingSpec {
+    clusterId?: string;
+    customTargetConfig?: CustomTargetConfig;
+    displayName?: string;
+    elasticsearchConfig?: ElasticsearchConfig;
+    enableJSONParsing?: boolean;
+    fluentForwarderConfig?: FluentForwarderConfig;
+    includeSystemComponent?: boolean;
+    kafkaConfig?: KafkaConfig;
+    outputFlushInterval?: number;
+    outputTags?: { [key: string]: string };
+    splunkConfig?: SplunkConfig;
+    syslogConfig?: SyslogConfig;
+}
+
+export const LoggingConditionType = "loggingCondition";
+export const LoggingConditionFieldLastTransitionTime = "lastTransitionTime";
+export const LoggingConditionFieldLastUpdateTime = "lastUpdateTime";
+export const LoggingConditionFieldMessage = "message";
+export const LoggingConditionFieldReason = "reason";
+export const LoggingConditionFieldStatus = "status";
+export const LoggingConditionFieldType = "type";
+
+export interface LoggingCondition {
+    lastTransitionTime?: string;
+    lastUpdateTime?: string;
+    message?: string;
+    reason?: string;
+    status?: string;
+    type?: string;
+}
+
+export const ClusterLoggingStatusType = "clusterLoggingStatus";
+export const ClusterLoggingStatusFieldAppliedSpec = "appliedSpec";
+export const ClusterLoggingStatusFieldConditions = "conditions";
+export const ClusterLoggingStatusFieldFailedSpec = "failedSpec";
+
+export interface ClusterLoggingStatus {
+    appliedSpec?: ClusterLoggingSpec;
+    conditions?: LoggingCondition[];
+    failedSpec?: ClusterLoggingSpec;
+}
+
+export const ClusterLoggingType = "clusterLogging";
+export const ClusterLoggingFieldAnnotations = "annotations";
+export const ClusterLoggingFieldAppliedSpec = "appliedSpec";
+export const ClusterLoggingFieldClusterID = "clusterId";
+export const ClusterLoggingFieldConditions = "conditions";
+export const ClusterLoggingFieldCreated = "created";
+export const ClusterLoggingFieldCreatorID = "creatorId";
+export const ClusterLoggingFieldCustomTargetConfig = "customTargetConfig";
+export const ClusterLoggingFieldElasticsearchConfig = "elasticsearchConfig";
+export const ClusterLoggingFieldEnableJSONParsing = "enableJSONParsing";
+export const ClusterLoggingFieldFailedSpec = "failedSpec";
+export const ClusterLoggingFieldFluentForwarderConfig = "fluentForwarderConfig";
+export const ClusterLoggingFieldIncludeSystemComponent = "includeSystemComponent";
+export const ClusterLoggingFieldKafkaConfig = "kafkaConfig";
+export const ClusterLoggingFieldLabels = "labels";
+export const ClusterLoggingFieldName = "name";
+export const ClusterLoggingFieldNamespaceId = "namespaceId";
+export const ClusterLoggingFieldOutputFlushInterval = "outputFlushInterval";
+export const ClusterLoggingFieldOutputTags = "outputTags";
+export const ClusterLoggingFieldOwnerReferences = "ownerReferences";
+export const ClusterLoggingFieldRemoved = "removed";
+export const ClusterLoggingFieldSplunkConfig = "splunkConfig";
+export const ClusterLoggingFieldState = "state";
+export const ClusterLoggingFieldSyslogConfig = "syslogConfig";
+export const ClusterLoggingFieldTransitioning = "transitioning";
+export const ClusterLoggingFieldTransitioningMessage = "transitioningMessage";
+export const ClusterLoggingFieldUUID = "uuid";
+export const ClusterLoggingCollectionActionDryRun = "dryRun";
+export const ClusterLoggingCollectionActionTest = "test";
+
+export interface ClusterLogging extends ApiResource {
+    annotations?: { [key: string]: string };
+    appliedSpec?: ClusterLoggingSpec;
+    clusterId?: string;
+    conditions?: LoggingCondition[];
+    created?: string;
+    creatorId?: string;
+    customTargetConfig?: CustomTargetConfig;
+    elasticsearchConfig?: ElasticsearchConfig;
+    enableJSONParsing?: boolean;
+    failedSpec?: ClusterLoggingSpec;
+    fluentForwarderConfig?: FluentForwarderConfig;
+    includeSystemComponent?: boolean;
+    kafkaConfig?: KafkaConfig;
+    labels?: { [key: string]: string };
+    name?: string;
+    namespaceId?: string;
+    outputFlushInterval?: number;
+    outputTags?: { [key: string]: string };
+    ownerReferences?: OwnerReference[];
+    removed?: string;
+    splunkConfig?: SplunkConfig;
+    state?: string;
+    syslogConfig?: SyslogConfig;
+    transitioning?: "yes" | "no" | "error";
+    transitioningMessage?: string;
+    uuid?: string;
+}
+
+export interface ClusterLoggingCollection extends ApiCollection {
+    data?: ClusterLogging[];
+}
+
+export class ClusterLoggingClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<ClusterLoggingCollection> {
+        return this.ops.DoList(ClusterLoggingType, opts);
+    }
+
+    Next(collection: ClusterLoggingCollection): Promise<ClusterLoggingCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: ClusterLogging): Promise<ClusterLogging> {
+        return this.ops.DoCreate(ClusterLoggingType, container);
+    }
+
+    Update(existing: ClusterLogging, updates: object): Promise<ClusterLogging> {
+        return this.ops.DoUpdate(ClusterLoggingType, existing, updates);
+    }
+
+    Replace(obj: ClusterLogging): Promise<ClusterLogging> {
+        return this.ops.DoReplace(ClusterLoggingType, obj, obj);
+    }
+
+    ByID(id: string): Promise<ClusterLogging> {
+        return this.ops.DoByID(ClusterLoggingType, id);
+    }
+
+    Delete(container: ClusterLogging): Promise<void> {
+        return this.ops.DoResourceDelete(ClusterLoggingType, container);
+    }
+
+    CollectionActionDryRun(resource: ClusterLoggingCollection, input: ClusterTestInput): Promise<void> {
+        return this.ops.DoCollectionAction(ClusterLoggingType, ClusterLoggingCollectionActionDryRun, resource, input);
+    }
+
+    CollectionActionTest(resource: ClusterLoggingCollection, input: ClusterTestInput): Promise<void> {
+        return this.ops.DoCollectionAction(ClusterLoggingType, ClusterLoggingCollectionActionTest, resource, input);
+    }
+}
+
+export const ProjectLoggingSpecType = "projectLoggingSpec";
+export const ProjectLoggingSpecFieldCustomTargetConfig = "customTargetConfig";
+export const ProjectLoggingSpecFieldDisplayName = "displayName";
+export const ProjectLoggingSpecFieldElasticsearchConfig = "elasticsearchConfig";
+export const ProjectLoggingSpecFieldEnableJSONParsing = "enableJSONParsing";
+export const ProjectLoggingSpecFieldFluentForwarderConfig = "fluentForwarderConfig";
+export const ProjectLoggingSpecFieldKafkaConfig = "kafkaConfig";
+export const ProjectLoggingSpecFieldOutputFlushInterval = "outputFlushInterval";
+export const ProjectLoggingSpecFieldOutputTags = "outputTags";
+export const ProjectLoggingSpecFieldProjectID = "projectId";
+export const ProjectLoggingSpecFieldSplunkConfig = "splunkConfig";
+export const ProjectLoggingSpecFieldSyslogConfig = "syslogConfig";
+
+export interface ProjectLoggingSpec {
+    customTargetConfig?: CustomTargetConfig;
+    displayName?: string;
+    elasticsearchConfig?: ElasticsearchConfig;
+    enableJSONParsing?: boolean;
+    fluentForwarderConfig?: FluentForwarderConfig;
+    kafkaConfig?: KafkaConfig;
+    outputFlushInterval?: number;
+    outputTags?: { [key: string]: string };
+    projectId?: string;
+    splunkConfig?: SplunkConfig;
+    syslogConfig?: SyslogConfig;
+}
+
+export const ProjectLoggingStatusType = "projectLoggingStatus";
+export const ProjectLoggingStatusFieldAppliedSpec = "appliedSpec";
+export const ProjectLoggingStatusFieldConditions = "conditions";
+
+export interface ProjectLoggingStatus {
+    appliedSpec?: ProjectLoggingSpec;
+    conditions?: LoggingCondition[];
+}
+
+export const ProjectLoggingType = "projectLogging";
+export const ProjectLoggingFieldAnnotations = "annotations";
+export const ProjectLoggingFieldCreated = "created";
+export const ProjectLoggingFieldCreatorID = "creatorId";
+export const ProjectLoggingFieldCustomTargetConfig = "customTargetConfig";
+export const ProjectLoggingFieldElasticsearchConfig = "elasticsearchConfig";
+export const ProjectLoggingFieldEnableJSONParsing = "enableJSONParsing";
+export const ProjectLoggingFieldFluentForwarderConfig = "fluentForwarderConfig";
+export const ProjectLoggingFieldKafkaConfig = "kafkaConfig";
+export const ProjectLoggingFieldLabels = "labels";
+export const ProjectLoggingFieldName = "name";
+export const ProjectLoggingFieldNamespaceId = "namespaceId";
+export const ProjectLoggingFieldOutputFlushInterval = "outputFlushInterval";
+export const ProjectLoggingFieldOutputTags = "outputTags";
+export const ProjectLoggingFieldOwnerReferences = "ownerReferences";
+export const ProjectLoggingFieldProjectID = "projectId";
+export const ProjectLoggingFieldRemoved = "removed";
+export const ProjectLoggingFieldSplunkConfig = "splunkConfig";
+export const ProjectLoggingFieldState = "state";
+export const ProjectLoggingFieldStatus = "status";
+export const ProjectLoggingFieldSyslogConfig = "syslogConfig";
+export const ProjectLoggingFieldTransitioning = "transitioning";
+export const ProjectLoggingFieldTransitioningMessage = "transitioningMessage";
+export const ProjectLoggingFieldUUID = "uuid";
+export const ProjectLoggingCollectionActionDryRun = "dryRun";
+export const ProjectLoggingCollectionActionTest = "test";
+
+export interface ProjectLogging extends ApiResource {
+    annotations?: { [key: string]: string };
+    created?: string;
+    creatorId?: string;
+    customTargetConfig?: CustomTargetConfig;
+    elasticsearchConfig?: ElasticsearchConfig;
+    enableJSONParsing?: boolean;
+    fluentForwarderConfig?: FluentForwarderConfig;
+    kafkaConfig?: KafkaConfig;
+    labels?: { [key: string]: string };
+    name?: string;
+    namespaceId?: string;
+    outputFlushInterval?: number;
+    outputTags?: { [key: string]: string };
+    ownerReferences?: OwnerReference[];
+    projectId?: string;
+    removed?: string;
+    splunkConfig?: SplunkConfig;
+    state?: string;
+    status?: ProjectLoggingStatus;
+    syslogConfig?: SyslogConfig;
+    transitioning?: "yes" | "no" | "error";
+    transitioningMessage?: string;
+    uuid?: string;
+}
+
+export interface ProjectLoggingCollection extends ApiCollection {
+    data?: ProjectLogging[];
+}
+
+export class ProjectLoggingClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<ProjectLoggingCollection> {
+        return this.ops.DoList(ProjectLoggingType, opts);
+    }
+
+    Next(collection: ProjectLoggingCollection): Promise<ProjectLoggingCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: ProjectLogging): Promise<ProjectLogging> {
+        return this.ops.DoCreate(ProjectLoggingType, container);
+    }
+
+    Update(existing: ProjectLogging, updates: object): Promise<ProjectLogging> {
+        return this.ops.DoUpdate(ProjectLoggingType, existing, updates);
+    }
+
+    Replace(obj: ProjectLogging): Promise<ProjectLogging> {
+        return this.ops.DoReplace(ProjectLoggingType, obj, obj);
+    }
+
+    ByID(id: string): Promise<ProjectLogging> {
+        return this.ops.DoByID(ProjectLoggingType, id);
+    }
+
+    Delete(container: ProjectLogging): Promise<void> {
+        return this.ops.DoResourceDelete(ProjectLoggingType, container);
+    }
+
+    CollectionActionDryRun(resource: ProjectLoggingCollection, input: ProjectTestInput): Promise<void> {
+        return this.ops.DoCollectionAction(ProjectLoggingType, ProjectLoggingCollectionActionDryRun, resource, input);
+    }
+
+    CollectionActionTest(resource: ProjectLoggingCollection, input: ProjectTestInput): Promise<void> {
+        return this.ops.DoCollectionAction(ProjectLoggingType, ProjectLoggingCollectionActionTest, resource, input);
+    }
+}
+
+export const ListenConfigType = "listenConfig";
+export const ListenConfigFieldAlgorithm = "algorithm";
+export const ListenConfigFieldAnnotations = "annotations";
+export const ListenConfigFieldCACerts = "caCerts";
+export const ListenConfigFieldCN = "cn";
+export const ListenConfigFieldCert = "cert";
+export const ListenConfigFieldCertFingerprint = "certFingerprint";
+export const ListenConfigFieldCreated = "created";
+export const ListenConfigFieldCreatorID = "creatorId";
+export const ListenConfigFieldDescription = "description";
+export const ListenConfigFieldDomains = "domains";
+export const ListenConfigFieldEnabled = "enabled";
+export const ListenConfigFieldExpiresAt = "expiresAt";
+export const ListenConfigFieldGeneratedCerts = "generatedCerts";
+export const ListenConfigFieldIssuedAt = "issuedAt";
+export const ListenConfigFieldIssuer = "issuer";
+export const ListenConfigFieldKey = "key";
+export const ListenConfigFieldKeySize = "keySize";
+export const ListenConfigFieldKnownIPs = "knownIps";
+export const ListenConfigFieldLabels = "labels";
+export const ListenConfigFieldMode = "mode";
+export const ListenConfigFieldName = "name";
+export const ListenConfigFieldOwnerReferences = "ownerReferences";
+export const ListenConfigFieldRemoved = "removed";
+export const ListenConfigFieldSerialNumber = "serialNumber";
+export const ListenConfigFieldSubjectAlternativeNames = "subjectAlternativeNames";
+export const ListenConfigFieldTOS = "tos";
+export const ListenConfigFieldUUID = "uuid";
+export const ListenConfigFieldVersion = "version";
+
+export interface ListenConfig extends ApiResource {
+    algorithm?: string;
+    annotations?: { [key: string]: string };
+    caCerts?: string;
+    cn?: string;
+    cert?: string;
+    certFingerprint?: string;
+    created?: string;
+    creatorId?: string;
+    description?: string;
+    domains?: string[];
+    enabled?: boolean;
+    expiresAt?: string;
+    generatedCerts?: { [key: string]: string };
+    issuedAt?: string;
+    issuer?: string;
+    key?: string;
+    keySize?: number;
+    knownIps?: string[];
+    labels?: { [key: string]: string };
+    mode?: "https" | "http" | "acme";
+    name?: string;
+    ownerReferences?: OwnerReference[];
+    removed?: string;
+    serialNumber?: string;
+    subjectAlternativeNames?: string[];
+    tos?: string[];
+    uuid?: string;
+    version?: number;
+}
+
+export interface ListenConfigCollection extends ApiCollection {
+    data?: ListenConfig[];
+}
+
+export class ListenConfigClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<ListenConfigCollection> {
+        return this.ops.DoList(ListenConfigType, opts);
+    }
+
+    Next(collection: ListenConfigCollection): Promise<ListenConfigCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: ListenConfig): Promise<ListenConfig> {
+        return this.ops.DoCreate(ListenConfigType, container);
+    }
+
+    Update(existing: ListenConfig, updates: object): Promise<ListenConfig> {
+        return this.ops.DoUpdate(ListenConfigType, existing, updates);
+    }
+
+    Replace(obj: ListenConfig): Promise<ListenConfig> {
+        return this.ops.DoReplace(ListenConfigType, obj, obj);
+    }
+
+    ByID(id: string): Promise<ListenConfig> {
+        return this.ops.DoByID(ListenConfigType, id);
+    }
+
+    Delete(container: ListenConfig): Promise<void> {
+        return this.ops.DoResourceDelete(ListenConfigType, container);
+    }
+}
+
+export const SettingType = "setting";
+export const SettingFieldAnnotations = "annotations";
+export const SettingFieldCreated = "created";
+export const SettingFieldCreatorID = "creatorId";
+export const SettingFieldCustomized = "customized";
+export const SettingFieldDefault = "default";
+export const SettingFieldLabels = "labels";
+export const SettingFieldName = "name";
+export const SettingFieldOwnerReferences = "ownerReferences";
+export const SettingFieldRemoved = "removed";
+export const SettingFieldSource = "source";
+export const SettingFieldUUID = "uuid";
+export const SettingFieldValue = "value";
+
+export interface Setting extends ApiResource {
+    annotations?: { [key: string]: string };
+    created?: string;
+    creatorId?: string;
+    customized?: boolean;
+    default?: string;
+    labels?: { [key: string]: string };
+    name?: string;
+    ownerReferences?: OwnerReference[];
+    removed?: string;
+    source?: "db" | "default" | "env";
+    uuid?: string;
+    value?: string;
+}
+
+export interface SettingCollection extends ApiCollection {
+    data?: Setting[];
+}
+
+export class SettingClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<SettingCollection> {
+        return this.ops.DoList(SettingType, opts);
+    }
+
+    Next(collection: SettingCollection): Promise<SettingCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: Setting): Promise<Setting> {
+        return this.ops.DoCreate(SettingType, container);
+    }
+
+    Update(existing: Setting, updates: object): Promise<Setting> {
+        return this.ops.DoUpdate(SettingType, existing, updates);
+    }
+
+    Replace(obj: Setting): Promise<Setting> {
+        return this.ops.DoReplace(SettingType, obj, obj);
+    }
+
+    ByID(id: string): Promise<Setting> {
+        return this.ops.DoByID(SettingType, id);
+    }
+
+    Delete(container: Setting): Promise<void> {
+        return this.ops.DoResourceDelete(SettingType, container);
+    }
+}
+
+export const FeatureType = "feature";
+export const FeatureFieldAnnotations = "annotations";
+export const FeatureFieldCreated = "created";
+export const FeatureFieldCreatorID = "creatorId";
+export const FeatureFieldDefault = "default";
+export const FeatureFieldLabels = "labels";
+export const FeatureFieldName = "name";
+export const FeatureFieldOwnerReferences = "ownerReferences";
+export const FeatureFieldRemoved = "removed";
+export const FeatureFieldUUID = "uuid";
+export const FeatureFieldValue = "value";
+
+export interface Feature extends ApiResource {
+    annotations?: { [key: string]: string };
+    created?: string;
+    creatorId?: string;
+    default?: boolean;
+    labels?: { [key: string]: string };
+    name?: string;
+    ownerReferences?: OwnerReference[];
+    removed?: string;
+    uuid?: string;
+    value?: boolean;
+}
+
+export interface FeatureCollection extends ApiCollection {
+    data?: Feature[];
+}
+
+export class FeatureClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<FeatureCollection> {
+        return this.ops.DoList(FeatureType, opts);
+    }
+
+    Next(collection: FeatureCollection): Promise<FeatureCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: Feature): Promise<Feature> {
+        return this.ops.DoCreate(FeatureType, container);
+    }
+
+    Update(existing: Feature, updates: object): Promise<Feature> {
+        return this.ops.DoUpdate(FeatureType, existing, updates);
+    }
+
+    Replace(obj: Feature): Promise<Feature> {
+        return this.ops.DoReplace(FeatureType, obj, obj);
+    }
+
+    ByID(id: string): Promise<Feature> {
+        return this.ops.DoByID(FeatureType, id);
+    }
+
+    Delete(container: Feature): Promise<void> {
+        return this.ops.DoResourceDelete(FeatureType, container);
+    }
+}
+
+export const ObjectFieldSelectorType = "objectFieldSelector";
+export const ObjectFieldSelectorFieldAPIVersion = "apiVersion";
+export const ObjectFieldSelectorFieldFieldPath = "fieldPath";
+
+export interface ObjectFieldSelector {
+    apiVersion?: string;
+    fieldPath?: string;
+}
+
+export const ResourceFieldSelectorType = "resourceFieldSelector";
+export const ResourceFieldSelectorFieldContainerName = "containerName";
+export const ResourceFieldSelectorFieldDivisor = "divisor";
+export const ResourceFieldSelectorFieldResource = "resource";
+
+export interface ResourceFieldSelector {
+    containerName?: string;
+    divisor?: string;
+    resource?: string;
+}
+
+export const ConfigMapKeySelectorType = "configMapKeySelector";
+export const ConfigMapKeySelectorFieldKey = "key";
+export const ConfigMapKeySelectorFieldName = "name";
+export const ConfigMapKeySelectorFieldOptional = "optional";
+
+export interface ConfigMapKeySelector {
+    key?: string;
+    name?: string;
+    optional?: boolean;
+}
+
+export const SecretKeySelectorType = "secretKeySelector";
+export const SecretKeySelectorFieldKey = "key";
+export const SecretKeySelectorFieldName = "name";
+export const SecretKeySelectorFieldOptional = "optional";
+
+export interface SecretKeySelector {
+    key?: string;
+    name?: string;
+    optional?: boolean;
+}
+
+export const EnvVarSourceType = "envVarSource";
+export const EnvVarSourceFieldConfigMapKeyRef = "configMapKeyRef";
+export const EnvVarSourceFieldFieldRef = "fieldRef";
+export const EnvVarSourceFieldResourceFieldRef = "resourceFieldRef";
+export const EnvVarSourceFieldSecretKeyRef = "secretKeyRef";
+
+export interface EnvVarSource {
+    configMapKeyRef?: ConfigMapKeySelector;
+    fieldRef?: ObjectFieldSelector;
+    resourceFieldRef?: ResourceFieldSelector;
+    secretKeyRef?: SecretKeySelector;
+}
+
+export const ExtraEnvType = "extraEnv";
+export const ExtraEnvFieldName = "name";
+export const ExtraEnvFieldValue = "value";
+export const ExtraEnvFieldValueFrom = "valueFrom";
+
+export interface ExtraEnv {
+    name?: string;
+    value?: string;
+    valueFrom?: EnvVarSource;
+}
+
+export const HostPathVolumeSourceType = "hostPathVolumeSource";
+export const HostPathVolumeSourceFieldPath = "path";
+export const HostPathVolumeSourceFieldType = "type";
+
+export interface HostPathVolumeSource {
+    path?: string;
+    type?: string;
+}
+
+export const EmptyDirVolumeSourceType = "emptyDirVolumeSource";
+export const EmptyDirVolumeSourceFieldMedium = "medium";
+export const EmptyDirVolumeSourceFieldSizeLimit = "sizeLimit";
+
+export interface EmptyDirVolumeSource {
+    medium?: string;
+    sizeLimit?: string;
+}
+
+export const GCEPersistentDiskVolumeSourceType = "gcePersistentDiskVolumeSource";
+export const GCEPersistentDiskVolumeSourceFieldFSType = "fsType";
+export const GCEPersistentDiskVolumeSourceFieldPDName = "pdName";
+export const GCEPersistentDiskVolumeSourceFieldPartition = "partition";
+export const GCEPersistentDiskVolumeSourceFieldReadOnly = "readOnly";
+
+export interface GCEPersistentDiskVolumeSource {
+    fsType?: string;
+    pdName?: string;
+    partition?: number;
+    readOnly?: boolean;
+}
+
+export const AWSElasticBlockStoreVolumeSourceType = "awsElasticBlockStoreVolumeSource";
+export const AWSElasticBlockStoreVolumeSourceFieldFSType = "fsType";
+export const AWSElasticBlockStoreVolumeSourceFieldPartition = "partition";
+export const AWSElasticBlockStoreVolumeSourceFieldReadOnly = "readOnly";
+export const AWSElasticBlockStoreVolumeSourceFieldVolumeID = "volumeID";
+
+export interface AWSElasticBlockStoreVolumeSource {
+    fsType?: string;
+    partition?: number;
+    readOnly?: boolean;
+    volumeID?: string;
+}
+
+export const GitRepoVolumeSourceType = "gitRepoVolumeSource";
+export const GitRepoVolumeSourceFieldDirectory = "directory";
+export const GitRepoVolumeSourceFieldRepository = "repository";
+export const GitRepoVolumeSourceFieldRevision = "revision";
+
+export interface GitRepoVolumeSource {
+    directory?: string;
+    repository?: string;
+    revision?: string;
+}
+
+export const KeyToPathType = "keyToPath";
+export const KeyToPathFieldKey = "key";
+export const KeyToPathFieldMode = "mode";
+export const KeyToPathFieldPath = "path";
+
+export interface KeyToPath {
+    key?: string;
+    mode?: number;
+    path?: string;
+}
+
+export const SecretVolumeSourceType = "secretVolumeSource";
+export const SecretVolumeSourceFieldDefaultMode = "defaultMode";
+export const SecretVolumeSourceFieldItems = "items";
+export const SecretVolumeSourceFieldOptional = "optional";
+export const SecretVolumeSourceFieldSecretName = "secretName";
+
+export interface SecretVolumeSource {
+    defaultMode?: number;
+    items?: KeyToPath[];
+    optional?: boolean;
+    secretName?: string;
+}
+
+export const NFSVolumeSourceType = "nfsVolumeSource";
+export const NFSVolumeSourceFieldPath = "path";
+export const NFSVolumeSourceFieldReadOnly = "readOnly";
+export const NFSVolumeSourceFieldServer = "server";
+
+export interface NFSVolumeSource {
+    path?: string;
+    readOnly?: boolean;
+    server?: string;
+}
+
+export const LocalObjectReferenceType = "localObjectReference";
+export const LocalObjectReferenceFieldName = "name";
+
+export interface LocalObjectReference {
+    name?: string;
+}
+
+export const ISCSIVolumeSourceType = "iscsiVolumeSource";
+export const ISCSIVolumeSourceFieldDiscoveryCHAPAuth = "chapAuthDiscovery";
+export const ISCSIVolumeSourceFieldFSType = "fsType";
+export const ISCSIVolumeSourceFieldIQN = "iqn";
+export const ISCSIVolumeSourceFieldISCSIInterface = "iscsiInterface";
+export const ISCSIVolumeSourceFieldInitiatorName = "initiatorName";
+export const ISCSIVolumeSourceFieldLun = "lun";
+export const ISCSIVolumeSourceFieldPortals = "portals";
+export const ISCSIVolumeSourceFieldReadOnly = "readOnly";
+export const ISCSIVolumeSourceFieldSecretRef = "secretRef";
+export const ISCSIVolumeSourceFieldSessionCHAPAuth = "chapAuthSession";
+export const ISCSIVolumeSourceFieldTargetPortal = "targetPortal";
+
+export interface ISCSIVolumeSource {
+    chapAuthDiscovery?: boolean;
+    fsType?: string;
+    iqn?: string;
+    iscsiInterface?: string;
+    initiatorName?: string;
+    lun?: number;
+    portals?: string[];
+    readOnly?: boolean;
+    secretRef?: LocalObjectReference;
+    chapAuthSession?: boolean;
+    targetPortal?: string;
+}
+
+export const GlusterfsVolumeSourceType = "glusterfsVolumeSource";
+export const GlusterfsVolumeSourceFieldEndpointsName = "endpoints";
+export const GlusterfsVolumeSourceFieldPath = "path";
+export const GlusterfsVolumeSourceFieldReadOnly = "readOnly";
+
+export interface GlusterfsVolumeSource {
+    endpoints?: string;
+    path?: string;
+    readOnly?: boolean;
+}
+
+export const PersistentVolumeClaimVolumeSourceType = "persistentVolumeClaimVolumeSource";
+export const PersistentVolumeClaimVolumeSourceFieldClaimName = "claimName";
+export const PersistentVolumeClaimVolumeSourceFieldReadOnly = "readOnly";
+
+export interface PersistentVolumeClaimVolumeSource {
+    claimName?: string;
+    readOnly?: boolean;
+}
+
+export const RBDVolumeSourceType = "rbdVolumeSource";
+export const RBDVolumeSourceFieldCephMonitors = "monitors";
+export const RBDVolumeSourceFieldFSType = "fsType";
+export const RBDVolumeSourceFieldKeyring = "keyring";
+export const RBDVolumeSourceFieldRBDImage = "image";
+export const RBDVolumeSourceFieldRBDPool = "pool";
+export const RBDVolumeSourceFieldRadosUser = "user";
+export const RBDVolumeSourceFieldReadOnly = "readOnly";
+export const RBDVolumeSourceFieldSecretRef = "secretRef";
+
+export interface RBDVolumeSource {
+    monitors?: string[];
+    fsType?: string;
+    keyring?: string;
+    image?: string;
+    pool?: string;
+    user?: string;
+    readOnly?: boolean;
+    secretRef?: LocalObjectReference;
+}
+
+export const FlexVolumeSourceType = "flexVolumeSource";
+export const FlexVolumeSourceFieldDriver = "driver";
+export const FlexVolumeSourceFieldFSType = "fsType";
+export const FlexVolumeSourceFieldOptions = "options";
+export const FlexVolumeSourceFieldReadOnly = "readOnly";
+export const FlexVolumeSourceFieldSecretRef = "secretRef";
+
+export interface FlexVolumeSource {
+    driver?: string;
+    fsType?: string;
+    options?: { [key: string]: string };
+    readOnly?: boolean;
+    secretRef?: LocalObjectReference;
+}
+
+export const CinderVolumeSourceType = "cinderVolumeSource";
+export const CinderVolumeSourceFieldFSType = "fsType";
+export const CinderVolumeSourceFieldReadOnly = "readOnly";
+export const CinderVolumeSourceFieldSecretRef = "secretRef";
+export const CinderVolumeSourceFieldVolumeID = "volumeID";
+
+export interface CinderVolumeSource {
+    fsType?: string;
+    readOnly?: boolean;
+    secretRef?: LocalObjectReference;
+    volumeID?: string;
+}
+
+export const CephFSVolumeSourceType = "cephFSVolumeSource";
+export const CephFSVolumeSourceFieldMonitors = "monitors";
+export const CephFSVolumeSourceFieldPath = "path";
+export const CephFSVolumeSourceFieldReadOnly = "readOnly";
+export const CephFSVolumeSourceFieldSecretFile = "secretFile";
+export const CephFSVolumeSourceFieldSecretRef = "secretRef";
+export const CephFSVolumeSourceFieldUser = "user";
+
+export interface CephFSVolumeSource {
+    monitors?: string[];
+    path?: string;
+    readOnly?: boolean;
+    secretFile?: string;
+    secretRef?: LocalObjectReference;
+    user?: string;
+}
+
+export const FlockerVolumeSourceType = "flockerVolumeSource";
+export const FlockerVolumeSourceFieldDatasetName = "datasetName";
+export const FlockerVolumeSourceFieldDatasetUUID = "datasetUUID";
+
+export interface FlockerVolumeSource {
+    datasetName?: string;
+    datasetUUID?: string;
+}
+
+export const DownwardAPIVolumeFileType = "downwardAPIVolumeFile";
+export const DownwardAPIVolumeFileFieldFieldRef = "fieldRef";
+export const DownwardAPIVolumeFileFieldMode = "mode";
+export const DownwardAPIVolumeFileFieldPath = "path";
+export const DownwardAPIVolumeFileFieldResourceFieldRef = "resourceFieldRef";
+
+export interface DownwardAPIVolumeFile {
+    fieldRef?: ObjectFieldSelector;
+    mode?: number;
+    path?: string;
+    resourceFieldRef?: ResourceFieldSelector;
+}
+
+export const DownwardAPIVolumeSourceType = "downwardAPIVolumeSource";
+export const DownwardAPIVolumeSourceFieldDefaultMode = "defaultMode";
+export const DownwardAPIVolumeSourceFieldItems = "items";
+
+export interface DownwardAPIVolumeSource {
+    defaultMode?: number;
+    items?: DownwardAPIVolumeFile[];
+}
+
+export const FCVolumeSourceType = "fcVolumeSource";
+export const FCVolumeSourceFieldFSType = "fsType";
+export const FCVolumeSourceFieldLun = "lun";
+export const FCVolumeSourceFieldReadOnly = "readOnly";
+export const FCVolumeSourceFieldTargetWWNs = "targetWWNs";
+export const FCVolumeSourceFieldWWIDs = "wwids";
+
+export interface FCVolumeSource {
+    fsType?: string;
+    lun?: number;
+    readOnly?: boolean;
+    targetWWNs?: string[];
+    wwids?: string[];
+}
+
+export const AzureFileVolumeSourceType = "azureFileVolumeSource";
+export const AzureFileVolumeSourceFieldReadOnly = "readOnly";
+export const AzureFileVolumeSourceFieldSecretName = "secretName";
+export const AzureFileVolumeSourceFieldShareName = "shareName";
+
+export interface AzureFileVolumeSource {
+    readOnly?: boolean;
+    secretName?: string;
+    shareName?: string;
+}
+
+export const ConfigMapVolumeSourceType = "configMapVolumeSource";
+export const ConfigMapVolumeSourceFieldDefaultMode = "defaultMode";
+export const ConfigMapVolumeSourceFieldItems = "items";
+export const ConfigMapVolumeSourceFieldName = "name";
+export const ConfigMapVolumeSourceFieldOptional = "optional";
+
+export interface ConfigMapVolumeSource {
+    defaultMode?: number;
+    items?: KeyToPath[];
+    name?: string;
+    optional?: boolean;
+}
+
+export const VsphereVirtualDiskVolumeSourceType = "vsphereVirtualDiskVolumeSource";
+export const VsphereVirtualDiskVolumeSourceFieldFSType = "fsType";
+export const VsphereVirtualDiskVolumeSourceFieldStoragePolicyID = "storagePolicyID";
+export const VsphereVirtualDiskVolumeSourceFieldStoragePolicyName = "storagePolicyName";
+export const VsphereVirtualDiskVolumeSourceFieldVolumePath = "volumePath";
+
+export interface VsphereVirtualDiskVolumeSource {
+    fsType?: string;
+    storagePolicyID?: string;
+    storagePolicyName?: string;
+    volumePath?: string;
+}
+
+export const QuobyteVolumeSourceType = "quobyteVolumeSource";
+export const QuobyteVolumeSourceFieldGroup = "group";
+export const QuobyteVolumeSourceFieldReadOnly = "readOnly";
+export const QuobyteVolumeSourceFieldRegistry = "registry";
+export const QuobyteVolumeSourceFieldTenant = "tenant";
+export const QuobyteVolumeSourceFieldUser = "user";
+export const QuobyteVolumeSourceFieldVolume = "volume";
+
+export interface QuobyteVolumeSource {
+    group?: string;
+    readOnly?: boolean;
+    registry?: string;
+    tenant?: string;
+    user?: string;
+    volume?: string;
+}
+
+export const AzureDiskVolumeSourceType = "azureDiskVolumeSource";
+export const AzureDiskVolumeSourceFieldCachingMode = "cachingMode";
+export const AzureDiskVolumeSourceFieldDataDiskURI = "diskURI";
+export const AzureDiskVolumeSourceFieldDiskName = "diskName";
+export const AzureDiskVolumeSourceFieldFSType = "fsType";
+export const AzureDiskVolumeSourceFieldKind = "kind";
+export const AzureDiskVolumeSourceFieldReadOnly = "readOnly";
+
+export interface AzureDiskVolumeSource {
+    cachingMode?: string;
+    diskURI?: string;
+    diskName?: string;
+    fsType?: string;
+    kind?: string;
+    readOnly?: boolean;
+}
+
+export const PhotonPersistentDiskVolumeSourceType = "photonPersistentDiskVolumeSource";
+export const PhotonPersistentDiskVolumeSourceFieldFSType = "fsType";
+export const PhotonPersistentDiskVolumeSourceFieldPdID = "pdID";
+
+export interface PhotonPersistentDiskVolumeSource {
+    fsType?: string;
+    pdID?: string;
+}
+
+export const SecretProjectionType = "secretProjection";
+export const SecretProjectionFieldItems = "items";
+export const SecretProjectionFieldName = "name";
+export const SecretProjectionFieldOptional = "optional";
+
+export interface SecretProjection {
+    items?: KeyToPath[];
+    name?: string;
+    optional?: boolean;
+}
+
+export const DownwardAPIProjectionType = "downwardAPIProjection";
+export const DownwardAPIProjectionFieldItems = "items";
+
+export interface DownwardAPIProjection {
+    items?: DownwardAPIVolumeFile[];
+}
+
+export const ConfigMapProjectionType = "configMapProjection";
+export const ConfigMapProjectionFieldItems = "items";
+export const ConfigMapProjectionFieldName = "name";
+export const ConfigMapProjectionFieldOptional = "optional";
+
+export interface ConfigMapProjection {
+    items?: KeyToPath[];
+    name?: string;
+    optional?: boolean;
+}
+
+export const ServiceAccountTokenProjectionType = "serviceAccountTokenProjection";
+export const ServiceAccountTokenProjectionFieldAudience = "audience";
+export const ServiceAccountTokenProjectionFieldExpirationSeconds = "expirationSeconds";
+export const ServiceAccountTokenProjectionFieldPath = "path";
+
+export interface ServiceAccountTokenProjection {
+    audience?: string;
+    expirationSeconds?: number;
+    path?: string;
+}
+
+export const VolumeProjectionType = "volumeProjection";
+export const VolumeProjectionFieldConfigMap = "configMap";
+export const VolumeProjectionFieldDownwardAPI = "downwardAPI";
+export const VolumeProjectionFieldSecret = "secret";
+export const VolumeProjectionFieldServiceAccountToken = "serviceAccountToken";
+
+export interface VolumeProjection {
+    configMap?: ConfigMapProjection;
+    downwardAPI?: DownwardAPIProjection;
+    secret?: SecretProjection;
+    serviceAccountToken?: ServiceAccountTokenProjection;
+}
+
+export const ProjectedVolumeSourceType = "projectedVolumeSource";
+export const ProjectedVolumeSourceFieldDefaultMode = "defaultMode";
+export const ProjectedVolumeSourceFieldSources = "sources";
+
+export interface ProjectedVolumeSource {
+    defaultMode?: number;
+    sources?: VolumeProjection[];
+}
+
+export const PortworxVolumeSourceType = "portworxVolumeSource";
+export const PortworxVolumeSourceFieldFSType = "fsType";
+export const PortworxVolumeSourceFieldReadOnly = "readOnly";
+export const PortworxVolumeSourceFieldVolumeID = "volumeID";
+
+export interface PortworxVolumeSource {
+    fsType?: string;
+    readOnly?: boolean;
+    volumeID?: string;
+}
+
+export const ScaleIOVolumeSourceType = "scaleIOVolumeSource";
+export const ScaleIOVolumeSourceFieldFSType = "fsType";
+export const ScaleIOVolumeSourceFieldGateway = "gateway";
+export const ScaleIOVolumeSourceFieldProtectionDomain = "protectionDomain";
+export const ScaleIOVolumeSourceFieldReadOnly = "readOnly";
+export const ScaleIOVolumeSourceFieldSSLEnabled = "sslEnabled";
+export const ScaleIOVolumeSourceFieldSecretRef = "secretRef";
+export const ScaleIOVolumeSourceFieldStorageMode = "storageMode";
+export const ScaleIOVolumeSourceFieldStoragePool = "storagePool";
+export const ScaleIOVolumeSourceFieldSystem = "system";
+export const ScaleIOVolumeSourceFieldVolumeName = "volumeName";
+
+export interface ScaleIOVolumeSource {
+    fsType?: string;
+    gateway?: string;
+    protectionDomain?: string;
+    readOnly?: boolean;
+    sslEnabled?: boolean;
+    secretRef?: LocalObjectReference;
+    storageMode?: string;
+    storagePool?: string;
+    system?: string;
+    volumeName?: string;
+}
+
+export const StorageOSVolumeSourceType = "storageOSVolumeSource";
+export const StorageOSVolumeSourceFieldFSType = "fsType";
+export const StorageOSVolumeSourceFieldReadOnly = "readOnly";
+export const StorageOSVolumeSourceFieldSecretRef = "secretRef";
+export const StorageOSVolumeSourceFieldVolumeName = "volumeName";
+export const StorageOSVolumeSourceFieldVolumeNamespace = "volumeNamespace";
+
+export interface StorageOSVolumeSource {
+    fsType?: string;
+    readOnly?: boolean;
+    secretRef?: LocalObjectReference;
+    volumeName?: string;
+    volumeNamespace?: string;
+}
+
+export const CSIVolumeSourceType = "csiVolumeSource";
+export const CSIVolumeSourceFieldDriver = "driver";
+export const CSIVolumeSourceFieldFSType = "fsType";
+export const CSIVolumeSourceFieldNodePublishSecretRef = "nodePublishSecretRef";
+export const CSIVolumeSourceFieldReadOnly = "readOnly";
+export const CSIVolumeSourceFieldVolumeAttributes = "volumeAttributes";
+
+export interface CSIVolumeSource {
+    driver?: string;
+    fsType?: string;
+    nodePublishSecretRef?: LocalObjectReference;
+    readOnly?: boolean;
+    volumeAttributes?: { [key: string]: string };
+}
+
+export const ExtraVolumeType = "extraVolume";
+export const ExtraVolumeFieldAWSElasticBlockStore = "awsElasticBlockStore";
+export const ExtraVolumeFieldAzureDisk = "azureDisk";
+export const ExtraVolumeFieldAzureFile = "azureFile";
+export const ExtraVolumeFieldCSI = "csi";
+export const ExtraVolumeFieldCephFS = "cephfs";
+export const ExtraVolumeFieldCinder = "cinder";
+export const ExtraVolumeFieldConfigMap = "configMap";
+export const ExtraVolumeFieldDownwardAPI = "downwardAPI";
+export const ExtraVolumeFieldEmptyDir = "emptyDir";
+export const ExtraVolumeFieldFC = "fc";
+export const ExtraVolumeFieldFlexVolume = "flexVolume";
+export const ExtraVolumeFieldFlocker = "flocker";
+export const ExtraVolumeFieldGCEPersistentDisk = "gcePersistentDisk";
+export const ExtraVolumeFieldGitRepo = "gitRepo";
+export const ExtraVolumeFieldGlusterfs = "glusterfs";
+export const ExtraVolumeFieldHostPath = "hostPath";
+export const ExtraVolumeFieldISCSI = "iscsi";
+export const ExtraVolumeFieldNFS = "nfs";
+export const ExtraVolumeFieldName = "name";
+export const ExtraVolumeFieldPersistentVolumeClaim = "persistentVolumeClaim";
+export const ExtraVolumeFieldPhotonPersistentDisk = "photonPersistentDisk";
+export const ExtraVolumeFieldPortworxVolume = "portworxVolume";
+export const ExtraVolumeFieldProjected = "projected";
+export const ExtraVolumeFieldQuobyte = "quobyte";
+export const ExtraVolumeFieldRBD = "rbd";
+export const ExtraVolumeFieldScaleIO = "scaleIO";
+export const ExtraVolumeFieldSecret = "secret";
+export const ExtraVolumeFieldStorageOS = "storageos";
+export const ExtraVolumeFieldVsphereVolume = "vsphereVolume";
+
+export interface ExtraVolume {
+    awsElasticBlockStore?: AWSElasticBlockStoreVolumeSource;
+    azureDisk?: AzureDiskVolumeSource;
+    azureFile?: AzureFileVolumeSource;
+    csi?: CSIVolumeSource;
+    cephfs?: CephFSVolumeSource;
+    cinder?: CinderVolumeSource;
+    configMap?: ConfigMapVolumeSource;
+    downwardAPI?: DownwardAPIVolumeSource;
+    emptyDir?: EmptyDirVolumeSource;
+    fc?: FCVolumeSource;
+    flexVolume?: FlexVolumeSource;
+    flocker?: FlockerVolumeSource;
+    gcePersistentDisk?: GCEPersistentDiskVolumeSource;
+    gitRepo?: GitRepoVolumeSource;
+    glusterfs?: GlusterfsVolumeSource;
+    hostPath?: HostPathVolumeSource;
+    iscsi?: ISCSIVolumeSource;
+    nfs?: NFSVolumeSource;
+    name?: string;
+    persistentVolumeClaim?: PersistentVolumeClaimVolumeSource;
+    photonPersistentDisk?: PhotonPersistentDiskVolumeSource;
+    portworxVolume?: PortworxVolumeSource;
+    projected?: ProjectedVolumeSource;
+    quobyte?: QuobyteVolumeSource;
+    rbd?: RBDVolumeSource;
+    scaleIO?: ScaleIOVolumeSource;
+    secret?: SecretVolumeSource;
+    storageos?: StorageOSVolumeSource;
+    vsphereVolume?: VsphereVirtualDiskVolumeSource;
+}
+
+export const ExtraVolumeMountType = "extraVolumeMount";
+export const ExtraVolumeMountFieldMountPath = "mountPath";
+export const ExtraVolumeMountFieldMountPropagation = "mountPropagation";
+export const ExtraVolumeMountFieldName = "name";
+export const ExtraVolumeMountFieldReadOnly = "readOnly";
+export const ExtraVolumeMountFieldSubPath = "subPath";
+export const ExtraVolumeMountFieldSubPathExpr = "subPathExpr";
+
+export interface ExtraVolumeMount {
+    mountPath?: string;
+    mountPropagation?: string;
+    name?: string;
+    readOnly?: boolean;
+    subPath?: string;
+    subPathExpr?: string;
+}
+
+export const RecipientType = "recipient";
+export const RecipientFieldNotifierID = "notifierId";
+export const RecipientFieldNotifierType = "notifierType";
+export const RecipientFieldRecipient = "recipient";
+
+export interface Recipient {
+    notifierId?: string;
+    notifierType?: "slack" | "email" | "pagerduty" | "webhook" | "wechat";
+    recipient?: string;
+}
+
+export const TargetNodeType = "targetNode";
+export const TargetNodeFieldCPUThreshold = "cpuThreshold";
+export const TargetNodeFieldCondition = "condition";
+export const TargetNodeFieldMemThreshold = "memThreshold";
+export const TargetNodeFieldNodeID = "nodeId";
+export const TargetNodeFieldSelector = "selector";
+
+export interface TargetNode {
+    cpuThreshold?: number;
+    condition?: "notready" | "mem" | "cpu";
+    memThreshold?: number;
+    nodeId?: string;
+    selector?: { [key: string]: string };
+}
+
+export const TargetSystemServiceType = "targetSystemService";
+export const TargetSystemServiceFieldCondition = "condition";
+
+export interface TargetSystemService {
+    condition?: "etcd" | "controller-manager" | "scheduler";
+}
+
+export const TargetEventType = "targetEvent";
+export const TargetEventFieldEventType = "eventType";
+export const TargetEventFieldResourceKind = "resourceKind";
+
+export interface TargetEvent {
+    eventType?: "Normal" | "Warning";
+    resourceKind?: "Pod" | "Node" | "Deployment" | "StatefulSet" | "DaemonSet";
+}
+
+export const ClusterAlertSpecType = "clusterAlertSpec";
+export const ClusterAlertSpecFieldClusterID = "clusterId";
+export const ClusterAlertSpecFieldDescription = "description";
+export const ClusterAlertSpecFieldDisplayName = "displayName";
+export const ClusterAlertSpecFieldInitialWaitSeconds = "initialWaitSeconds";
+export const ClusterAlertSpecFieldRecipients = "recipients";
+export const ClusterAlertSpecFieldRepeatIntervalSeconds = "repeatIntervalSeconds";
+export const ClusterAlertSpecFieldSeverity = "severity";
+export const ClusterAlertSpecFieldTargetEvent = "targetEvent";
+export const ClusterAlertSpecFieldTargetNode = "targetNode";
+export const ClusterAlertSpecFieldTargetSystemService = "targetSystemService";
+
+export interface ClusterAlertSpec {
+    clusterId?: string;
+    description?: string;
+    displayName?: string;
+    initialWaitSeconds?: number;
+    recipients?: Recipient[];
+    repeatIntervalSeconds?: number;
+    severity?: "info" | "critical" | "warning";
+    targetEvent?: TargetEvent;
+    targetNode?: TargetNode;
+    targetSystemService?: TargetSystemService;
+}
+
+export const AlertStatusType = "alertStatus";
+export const AlertStatusFieldAlertState = "alertState";
+
+export interface AlertStatus {
+    alertState?: "active" | "inactive" | "alerting" | "muted";
+}
+
+export const ClusterAlertType = "clusterAlert";
+export const ClusterAlertFieldAnnotations = "annotations";
+export const ClusterAlertFieldClusterID = "clusterId";
+export const ClusterAlertFieldCreated = "created";
+export const ClusterAlertFieldCreatorID = "creatorId";
+export const ClusterAlertFieldDescription = "description";
+export const ClusterAlertFieldDisplayName = "displayName";
+export const ClusterAlertFieldInitialWaitSeconds = "initialWaitSeconds";
+export const ClusterAlertFieldLabels = "labels";
+export const ClusterAlertFieldName = "name";
+export const ClusterAlertFieldNamespaceId = "namespaceId";
+export const ClusterAlertFieldOwnerReferences = "ownerReferences";
+export const ClusterAlertFieldRecipients = "recipients";
+export const ClusterAlertFieldRemoved = "removed";
+export const ClusterAlertFieldRepeatIntervalSeconds = "repeatIntervalSeconds";
+export const ClusterAlertFieldSeverity = "severity";
+export const ClusterAlertFieldState = "state";
+export const ClusterAlertFieldStatus = "status";
+export const ClusterAlertFieldTargetEvent = "targetEvent";
+export const ClusterAlertFieldTargetNode = "targetNode";
+export const ClusterAlertFieldTargetSystemService = "targetSystemService";
+export const ClusterAlertFieldTransitioning = "transitioning";
+export const ClusterAlertFieldTransitioningMessage = "transitioningMessage";
+export const ClusterAlertFieldUUID = "uuid";
+
+export interface ClusterAlert extends ApiResource {
+    annotations?: { [key: string]: string };
+    clusterId?: string;
+    created?: string;
+    creatorId?: string;
+    description?: string;
+    displayName?: string;
+    initialWaitSeconds?: number;
+    labels?: { [key: string]: string };
+    name?: string;
+    namespaceId?: string;
+    ownerReferences?: OwnerReference[];
+    recipients?: Recipient[];
+    removed?: string;
+    repeatIntervalSeconds?: number;
+    severity?: "info" | "critical" | "warning";
+    state?: string;
+    status?: AlertStatus;
+    targetEvent?: TargetEvent;
+    targetNode?: TargetNode;
+    targetSystemService?: TargetSystemService;
+    transitioning?: "yes" | "no" | "error";
+    transitioningMessage?: string;
+    uuid?: string;
+}
+
+export interface ClusterAlertCollection extends ApiCollection {
+    data?: ClusterAlert[];
+}
+
+export class ClusterAlertClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<ClusterAlertCollection> {
+        return this.ops.DoList(ClusterAlertType, opts);
+    }
+
+    Next(collection: ClusterAlertCollection): Promise<ClusterAlertCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: ClusterAlert): Promise<ClusterAlert> {
+        return this.ops.DoCreate(ClusterAlertType, container);
+    }
+
+    Update(existing: ClusterAlert, updates: object): Promise<ClusterAlert> {
+        return this.ops.DoUpdate(ClusterAlertType, existing, updates);
+    }
+
+    Replace(obj: ClusterAlert): Promise<ClusterAlert> {
+        return this.ops.DoReplace(ClusterAlertType, obj, obj);
+    }
+
+    ByID(id: string): Promise<ClusterAlert> {
+        return this.ops.DoByID(ClusterAlertType, id);
+    }
+
+    Delete(container: ClusterAlert): Promise<void> {
+        return this.ops.DoResourceDelete(ClusterAlertType, container);
+    }
+}
+
+export const TargetWorkloadType = "targetWorkload";
+export const TargetWorkloadFieldAvailablePercentage = "availablePercentage";
+export const TargetWorkloadFieldSelector = "selector";
+export const TargetWorkloadFieldWorkloadID = "workloadId";
+
+export interface TargetWorkload {
+    availablePercentage?: number;
+    selector?: { [key: string]: string };
+    workloadId?: string;
+}
+
+export const TargetPodType = "targetPod";
+export const TargetPodFieldCondition = "condition";
+export const TargetPodFieldPodID = "podId";
+export const TargetPodFieldRestartIntervalSeconds = "restartIntervalSeconds";
+export const TargetPodFieldRestartTimes = "restartTimes";
+
+export interface TargetPod {
+    condition?: "notrunning" | "notscheduled" | "restarts";
+    podId?: string;
+    restartIntervalSeconds?: number;
+    restartTimes?: number;
+}
+
+export const ProjectAlertSpecType = "projectAlertSpec";
+export const ProjectAlertSpecFieldDescription = "description";
+export const ProjectAlertSpecFieldDisplayName = "displayName";
+export const ProjectAlertSpecFieldInitialWaitSeconds = "initialWaitSeconds";
+export const ProjectAlertSpecFieldProjectID = "projectId";
+export const ProjectAlertSpecFieldRecipients = "recipients";
+export const ProjectAlertSpecFieldRepeatIntervalSeconds = "repeatIntervalSeconds";
+export const ProjectAlertSpecFieldSeverity = "severity";
+export const ProjectAlertSpecFieldTargetPod = "targetPod";
+export const ProjectAlertSpecFieldTargetWorkload = "targetWorkload";
+
+export interface ProjectAlertSpec {
+    description?: string;
+    displayName?: string;
+    initialWaitSeconds?: number;
+    projectId?: string;
+    recipients?: Recipient[];
+    repeatIntervalSeconds?: number;
+    severity?: "info" | "critical" | "warning";
+    targetPod?: TargetPod;
+    targetWorkload?: TargetWorkload;
+}
+
+export const ProjectAlertType = "projectAlert";
+export const ProjectAlertFieldAnnotations = "annotations";
+export const ProjectAlertFieldCreated = "created";
+export const ProjectAlertFieldCreatorID = "creatorId";
+export const ProjectAlertFieldDescription = "description";
+export const ProjectAlertFieldDisplayName = "displayName";
+export const ProjectAlertFieldInitialWaitSeconds = "initialWaitSeconds";
+export const ProjectAlertFieldLabels = "labels";
+export const ProjectAlertFieldName = "name";
+export const ProjectAlertFieldNamespaceId = "namespaceId";
+export const ProjectAlertFieldOwnerReferences = "ownerReferences";
+export const ProjectAlertFieldProjectID = "projectId";
+export const ProjectAlertFieldRecipients = "recipients";
+export const ProjectAlertFieldRemoved = "removed";
+export const ProjectAlertFieldRepeatIntervalSeconds = "repeatIntervalSeconds";
+export const ProjectAlertFieldSeverity = "severity";
+export const ProjectAlertFieldState = "state";
+export const ProjectAlertFieldStatus = "status";
+export const ProjectAlertFieldTargetPod = "targetPod";
+export const ProjectAlertFieldTargetWorkload = "targetWorkload";
+export const ProjectAlertFieldTransitioning = "transitioning";
+export const ProjectAlertFieldTransitioningMessage = "transitioningMessage";
+export const ProjectAlertFieldUUID = "uuid";
+
+export interface ProjectAlert extends ApiResource {
+    annotations?: { [key: string]: string };
+    created?: string;
+    creatorId?: string;
+    description?: string;
+    displayName?: string;
+    initialWaitSeconds?: number;
+    labels?: { [key: string]: string };
+    name?: string;
+    namespaceId?: string;
+    ownerReferences?: OwnerReference[];
+    projectId?: string;
+    recipients?: Recipient[];
+    removed?: string;
+    repeatIntervalSeconds?: number;
+    severity?: "info" | "critical" | "warning";
+    state?: string;
+    status?: AlertStatus;
+    targetPod?: TargetPod;
+    targetWorkload?: TargetWorkload;
+    transitioning?: "yes" | "no" | "error";
+    transitioningMessage?: string;
+    uuid?: string;
+}
+
+export interface ProjectAlertCollection extends ApiCollection {
+    data?: ProjectAlert[];
+}
+
+export class ProjectAlertClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<ProjectAlertCollection> {
+        return this.ops.DoList(ProjectAlertType, opts);
+    }
+
+    Next(collection: ProjectAlertCollection): Promise<ProjectAlertCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: ProjectAlert): Promise<ProjectAlert> {
+        return this.ops.DoCreate(ProjectAlertType, container);
+    }
+
+    Update(existing: ProjectAlert, updates: object): Promise<ProjectAlert> {
+        return this.ops.DoUpdate(ProjectAlertType, existing, updates);
+    }
+
+    Replace(obj: ProjectAlert): Promise<ProjectAlert> {
+        return this.ops.DoReplace(ProjectAlertType, obj, obj);
+    }
+
+    ByID(id: string): Promise<ProjectAlert> {
+        return this.ops.DoByID(ProjectAlertType, id);
+    }
+
+    Delete(container: ProjectAlert): Promise<void> {
+        return this.ops.DoResourceDelete(ProjectAlertType, container);
+    }
+}
+
+export const SMTPConfigType = "smtpConfig";
+export const SMTPConfigFieldDefaultRecipient = "defaultRecipient";
+export const SMTPConfigFieldHost = "host";
+export const SMTPConfigFieldPassword = "password";
+export const SMTPConfigFieldPort = "port";
+export const SMTPConfigFieldSender = "sender";
+export const SMTPConfigFieldTLS = "tls";
+export const SMTPConfigFieldUsername = "username";
+
+export interface SMTPConfig {
+    defaultRecipient?: string;
+    host?: string;
+    password?: string;
+    port?: number;
+    sender?: string;
+    tls?: boolean;
+    username?: string;
+}
+
+export const SlackConfigType = "slackConfig";
+export const SlackConfigFieldDefaultRecipient = "defaultRecipient";
+export const SlackConfigFieldProxyURL = "proxyUrl";
+export const SlackConfigFieldURL = "url";
+
+export interface SlackConfig {
+    defaultRecipient?: string;
+    proxyUrl?: string;
+    url?: string;
+}
+
+export const PagerdutyConfigType = "pagerdutyConfig";
+export const PagerdutyConfigFieldProxyURL = "proxyUrl";
+export const PagerdutyConfigFieldServiceKey = "serviceKey";
+
+export interface PagerdutyConfig {
+    proxyUrl?: string;
+    serviceKey?: string;
+}
+
+export const WebhookConfigType = "webhookConfig";
+export const WebhookConfigFieldProxyURL = "proxyUrl";
+export const WebhookConfigFieldURL = "url";
+
+export interface WebhookConfig {
+    proxyUrl?: string;
+    url?: string;
+}
+
+export const WechatConfigType = "wechatConfig";
+export const WechatConfigFieldAgent = "agent";
+export const WechatConfigFieldCorp = "corp";
+export const WechatConfigFieldDefaultRecipient = "defaultRecipient";
+export const WechatConfigFieldProxyURL = "proxyUrl";
+export const WechatConfigFieldRecipientType = "recipientType";
+export const WechatConfigFieldSecret = "secret";
+
+export interface WechatConfig {
+    agent?: string;
+    corp?: string;
+    defaultRecipient?: string;
+    proxyUrl?: string;
+    recipientType?: "tag" | "party" | "user";
+    secret?: string;
+}
+
+export const NotificationType = "notification";
+export const NotificationFieldMessage = "message";
+export const NotificationFieldPagerdutyConfig = "pagerdutyConfig";
+export const NotificationFieldSMTPConfig = "smtpConfig";
+export const NotificationFieldSlackConfig = "slackConfig";
+export const NotificationFieldWebhookConfig = "webhookConfig";
+export const NotificationFieldWechatConfig = "wechatConfig";
+
+export interface Notification {
+    message?: string;
+    pagerdutyConfig?: PagerdutyConfig;
+    smtpConfig?: SMTPConfig;
+    slackConfig?: SlackConfig;
+    webhookConfig?: WebhookConfig;
+    wechatConfig?: WechatConfig;
+}
+
+export const NotifierSpecType = "notifierSpec";
+export const NotifierSpecFieldClusterID = "clusterId";
+export const NotifierSpecFieldDescription = "description";
+export const NotifierSpecFieldDisplayName = "displayName";
+export const NotifierSpecFieldPagerdutyConfig = "pagerdutyConfig";
+export const NotifierSpecFieldSMTPConfig = "smtpConfig";
+export const NotifierSpecFieldSendResolved = "sendResolved";
+export const NotifierSpecFieldSlackConfig = "slackConfig";
+export const NotifierSpecFieldWebhookConfig = "webhookConfig";
+export const NotifierSpecFieldWechatConfig = "wechatConfig";
+
+export interface NotifierSpec {
+    clusterId?: string;
+    description?: string;
+    displayName?: string;
+    pagerdutyConfig?: PagerdutyConfig;
+    smtpConfig?: SMTPConfig;
+    sendResolved?: boolean;
+    slackConfig?: SlackConfig;
+    webhookConfig?: WebhookConfig;
+    wechatConfig?: WechatConfig;
+}
+
+export const NotifierStatusType = "notifierStatus";
+
+export interface NotifierStatus {
+}
+
+export const NotifierType = "notifier";
+export const NotifierFieldAnnotations = "annotations";
+export const NotifierFieldClusterID = "clusterId";
+export const NotifierFieldCreated = "created";
+export const NotifierFieldCreatorID = "creatorId";
+export const NotifierFieldDescription = "description";
+export const NotifierFieldLabels = "labels";
+export const NotifierFieldName = "name";
+export const NotifierFieldNamespaceId = "namespaceId";
+export const NotifierFieldOwnerReferences = "ownerReferences";
+export const NotifierFieldPagerdutyConfig = "pagerdutyConfig";
+export const NotifierFieldRemoved = "removed";
+export const NotifierFieldSMTPConfig = "smtpConfig";
+export const NotifierFieldSendResolved = "sendResolved";
+export const NotifierFieldSlackConfig = "slackConfig";
+export const NotifierFieldState = "state";
+export const NotifierFieldStatus = "status";
+export const NotifierFieldTransitioning = "transitioning";
+export const NotifierFieldTransitioningMessage = "transitioningMessage";
+export const NotifierFieldUUID = "uuid";
+export const NotifierFieldWebhookConfig = "webhookConfig";
+export const NotifierFieldWechatConfig = "wechatConfig";
+export const NotifierActionSend = "send";
+export const NotifierCollectionActionSend = "send";
+
+export interface Notifier extends ApiResource {
+    annotations?: { [key: string]: string };
+    clusterId?: string;
+    created?: string;
+    creatorId?: string;
+    description?: string;
+    labels?: { [key: string]: string };
+    name?: string;
+    namespaceId?: string;
+    ownerReferences?: OwnerReference[];
+    pagerdutyConfig?: PagerdutyConfig;
+    removed?: string;
+    smtpConfig?: SMTPConfig;
+    sendResolved?: boolean;
+    slackConfig?: SlackConfig;
+    state?: string;
+    status?: NotifierStatus;
+    transitioning?: "yes" | "no" | "error";
+    transitioningMessage?: string;
+    uuid?: string;
+    webhookConfig?: WebhookConfig;
+    wechatConfig?: WechatConfig;
+}
+
+export interface NotifierCollection extends ApiCollection {
+    data?: Notifier[];
+}
+
+export class NotifierClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<NotifierCollection> {
+        return this.ops.DoList(NotifierType, opts);
+    }
+
+    Next(collection: NotifierCollection): Promise<NotifierCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: Notifier): Promise<Notifier> {
+        return this.ops.DoCreate(NotifierType, container);
+    }
+
+    Update(existing: Notifier, updates: object): Promise<Notifier> {
+        return this.ops.DoUpdate(NotifierType, existing, updates);
+    }
+
+    Replace(obj: Notifier): Promise<Notifier> {
+        return this.ops.DoReplace(NotifierType, obj, obj);
+    }
+
+    ByID(id: string): Promise<Notifier> {
+        return this.ops.DoByID(NotifierType, id);
+    }
+
+    Delete(container: Notifier): Promise<void> {
+        return this.ops.DoResourceDelete(NotifierType, container);
+    }
+
+    ActionSend(resource: Notifier, input: Notification): Promise<void> {
+        return this.ops.DoAction(NotifierType, NotifierActionSend, resource, input);
+    }
+
+    CollectionActionSend(resource: NotifierCollection, input: Notification): Promise<void> {
+        return this.ops.DoCollectionAction(NotifierType, NotifierCollectionActionSend, resource, input);
+    }
+}
+
+export const ClusterGroupSpecType = "clusterGroupSpec";
+export const ClusterGroupSpecFieldClusterID = "clusterId";
+export const ClusterGroupSpecFieldDescription = "description";
+export const ClusterGroupSpecFieldDisplayName = "displayName";
+export const ClusterGroupSpecFieldGroupIntervalSeconds = "groupIntervalSeconds";
+export const ClusterGroupSpecFieldGroupWaitSeconds = "groupWaitSeconds";
+export const ClusterGroupSpecFieldRecipients = "recipients";
+export const ClusterGroupSpecFieldRepeatIntervalSeconds = "repeatIntervalSeconds";
+
+export interface ClusterGroupSpec {
+    clusterId?: string;
+    description?: string;
+    displayName?: string;
+    groupIntervalSeconds?: number;
+    groupWaitSeconds?: number;
+    recipients?: Recipient[];
+    repeatIntervalSeconds?: number;
+}
+
+export const ClusterAlertGroupType = "clusterAlertGroup";
+export const ClusterAlertGroupFieldAlertState = "alertState";
+export const ClusterAlertGroupFieldAnnotations = "annotations";
+export const ClusterAlertGroupFieldClusterID = "clusterId";
+export const ClusterAlertGroupFieldCreated = "created";
+export const ClusterAlertGroupFieldCreatorID = "creatorId";
+export const ClusterAlertGroupFieldDescription = "description";
+export const ClusterAlertGroupFieldGroupIntervalSeconds = "groupIntervalSeconds";
+export const ClusterAlertGroupFieldGroupWaitSeconds = "groupWaitSeconds";
+export const ClusterAlertGroupFieldLabels = "labels";
+export const ClusterAlertGroupFieldName = "name";
+export const ClusterAlertGroupFieldNamespaceId = "namespaceId";
+export const ClusterAlertGroupFieldOwnerReferences = "ownerReferences";
+export const ClusterAlertGroupFieldRecipients = "recipients";
+export const ClusterAlertGroupFieldRemoved = "removed";
+export const ClusterAlertGroupFieldRepeatIntervalSeconds = "repeatIntervalSeconds";
+export const ClusterAlertGroupFieldState = "state";
+export const ClusterAlertGroupFieldTransitioning = "transitioning";
+export const ClusterAlertGroupFieldTransitioningMessage = "transitioningMessage";
+export const ClusterAlertGroupFieldUUID = "uuid";
+
+export interface ClusterAlertGroup extends ApiResource {
+    alertState?: "active" | "inactive" | "alerting" | "muted";
+    annotations?: { [key: string]: string };
+    clusterId?: string;
+    created?: string;
+    creatorId?: string;
+    description?: string;
+    groupIntervalSeconds?: number;
+    groupWaitSeconds?: number;
+    labels?: { [key: string]: string };
+    name?: string;
+    namespaceId?: string;
+    ownerReferences?: OwnerReference[];
+    recipients?: Recipient[];
+    removed?: string;
+    repeatIntervalSeconds?: number;
+    state?: string;
+    transitioning?: "yes" | "no" | "error";
+    transitioningMessage?: string;
+    uuid?: string;
+}
+
+export interface ClusterAlertGroupCollection extends ApiCollection {
+    data?: ClusterAlertGroup[];
+}
+
+export class ClusterAlertGroupClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<ClusterAlertGroupCollection> {
+        return this.ops.DoList(ClusterAlertGroupType, opts);
+    }
+
+    Next(collection: ClusterAlertGroupCollection): Promise<ClusterAlertGroupCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: ClusterAlertGroup): Promise<ClusterAlertGroup> {
+        return this.ops.DoCreate(ClusterAlertGroupType, container);
+    }
+
+    Update(existing: ClusterAlertGroup, updates: object): Promise<ClusterAlertGroup> {
+        return this.ops.DoUpdate(ClusterAlertGroupType, existing, updates);
+    }
+
+    Replace(obj: ClusterAlertGroup): Promise<ClusterAlertGroup> {
+        return this.ops.DoReplace(ClusterAlertGroupType, obj, obj);
+    }
+
+    ByID(id: string): Promise<ClusterAlertGroup> {
+        return this.ops.DoByID(ClusterAlertGroupType, id);
+    }
+
+    Delete(container: ClusterAlertGroup): Promise<void> {
+        return this.ops.DoResourceDelete(ClusterAlertGroupType, container);
+    }
+}
+
+export const ProjectGroupSpecType = "projectGroupSpec";
+export const ProjectGroupSpecFieldDescription = "description";
+export const ProjectGroupSpecFieldDisplayName = "displayName";
+export const ProjectGroupSpecFieldGroupIntervalSeconds = "groupIntervalSeconds";
+export const ProjectGroupSpecFieldGroupWaitSeconds = "groupWaitSeconds";
+export const ProjectGroupSpecFieldProjectID = "projectId";
+export const ProjectGroupSpecFieldRecipients = "recipients";
+export const ProjectGroupSpecFieldRepeatIntervalSeconds = "repeatIntervalSeconds";
+
+export interface ProjectGroupSpec {
+    description?: string;
+    displayName?: string;
+    groupIntervalSeconds?: number;
+    groupWaitSeconds?: number;
+    projectId?: string;
+    recipients?: Recipient[];
+    repeatIntervalSeconds?: number;
+}
+
+export const ProjectAlertGroupType = "projectAlertGroup";
+export const ProjectAlertGroupFieldAlertState = "alertState";
+export const ProjectAlertGroupFieldAnnotations = "annotations";
+export const ProjectAlertGroupFieldCreated = "created";
+export const ProjectAlertGroupFieldCreatorID = "creatorId";
+export const ProjectAlertGroupFieldDescription = "description";
+export const ProjectAlertGroupFieldGroupIntervalSeconds = "groupIntervalSeconds";
+export const ProjectAlertGroupFieldGroupWaitSeconds = "groupWaitSeconds";
+export const ProjectAlertGroupFieldLabels = "labels";
+export const ProjectAlertGroupFieldName = "name";
+export const ProjectAlertGroupFieldNamespaceId = "namespaceId";
+export const ProjectAlertGroupFieldOwnerReferences = "ownerReferences";
+export const ProjectAlertGroupFieldProjectID = "projectId";
+export const ProjectAlertGroupFieldRecipients = "recipients";
+export const ProjectAlertGroupFieldRemoved = "removed";
+export const ProjectAlertGroupFieldRepeatIntervalSeconds = "repeatIntervalSeconds";
+export const ProjectAlertGroupFieldState = "state";
+export const ProjectAlertGroupFieldTransitioning = "transitioning";
+export const ProjectAlertGroupFieldTransitioningMessage = "transitioningMessage";
+export const ProjectAlertGroupFieldUUID = "uuid";
+
+export interface ProjectAlertGroup extends ApiResource {
+    alertState?: "active" | "inactive" | "alerting" | "muted";
+    annotations?: { [key: string]: string };
+    created?: string;
+    creatorId?: string;
+    description?: string;
+    groupIntervalSeconds?: number;
+    groupWaitSeconds?: number;
+    labels?: { [key: string]: string };
+    name?: string;
+    namespaceId?: string;
+    ownerReferences?: OwnerReference[];
+    projectId?: string;
+    recipients?: Recipient[];
+    removed?: string;
+    repeatIntervalSeconds?: number;
+    state?: string;
+    transitioning?: "yes" | "no" | "error";
+    transitioningMessage?: string;
+    uuid?: string;
+}
+
+export interface ProjectAlertGroupCollection extends ApiCollection {
+    data?: ProjectAlertGroup[];
+}
+
+export class ProjectAlertGroupClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<ProjectAlertGroupCollection> {
+        return this.ops.DoList(ProjectAlertGroupType, opts);
+    }
+
+    Next(collection: ProjectAlertGroupCollection): Promise<ProjectAlertGroupCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: ProjectAlertGroup): Promise<ProjectAlertGroup> {
+        return this.ops.DoCreate(ProjectAlertGroupType, container);
+    }
+
+    Update(existing: ProjectAlertGroup, updates: object): Promise<ProjectAlertGroup> {
+        return this.ops.DoUpdate(ProjectAlertGroupType, existing, updates);
+    }
+
+    Replace(obj: ProjectAlertGroup): Promise<ProjectAlertGroup> {
+        return this.ops.DoReplace(ProjectAlertGroupType, obj, obj);
+    }
+
+    ByID(id: string): Promise<ProjectAlertGroup> {
+        return this.ops.DoByID(ProjectAlertGroupType, id);
+    }
+
+    Delete(container: ProjectAlertGroup): Promise<void> {
+        return this.ops.DoResourceDelete(ProjectAlertGroupType, container);
+    }
+}
+
+export const NodeRuleType = "nodeRule";
+export const NodeRuleFieldCPUThreshold = "cpuThreshold";
+export const NodeRuleFieldCondition = "condition";
+export const NodeRuleFieldMemThreshold = "memThreshold";
+export const NodeRuleFieldNodeID = "nodeId";
+export const NodeRuleFieldSelector = "selector";
+
+export interface NodeRule {
+    cpuThreshold?: number;
+    condition?: "notready" | "mem" | "cpu";
+    memThreshold?: number;
+    nodeId?: string;
+    selector?: { [key: string]: string };
+}
+
+export const EventRuleType = "eventRule";
+export const EventRuleFieldEventType = "eventType";
+export const EventRuleFieldResourceKind = "resourceKind";
+
+export interface EventRule {
+    eventType?: "Normal" | "Warning";
+    resourceKind?: "Pod" | "Node" | "Deployment" | "StatefulSet" | "DaemonSet";
+}
+
+export const SystemServiceRuleType = "systemServiceRule";
+export const SystemServiceRuleFieldCondition = "condition";
+
+export interface SystemServiceRule {
+    condition?: "etcd" | "controller-manager" | "scheduler";
+}
+
+export const MetricRuleType = "metricRule";
+export const MetricRuleFieldComparison = "comparison";
+export const MetricRuleFieldDescription = "description";
+export const MetricRuleFieldDuration = "duration";
+export const MetricRuleFieldExpression = "expression";
+export const MetricRuleFieldThresholdValue = "thresholdValue";
+
+export interface MetricRule {
+    comparison?: "equal" | "not-equal" | "greater-than" | "less-than" | "greater-or-equal" | "less-or-equal" | "has-value";
+    description?: string;
+    duration?: string;
+    expression?: string;
+    thresholdValue?: number;
+}
+
+export const ClusterAlertRuleSpecType = "clusterAlertRuleSpec";
+export const ClusterAlertRuleSpecFieldClusterID = "clusterId";
+export const ClusterAlertRuleSpecFieldDisplayName = "displayName";
+export const ClusterAlertRuleSpecFieldEventRule = "eventRule";
+export const ClusterAlertRuleSpecFieldGroupID = "groupId";
+export const ClusterAlertRuleSpecFieldGroupIntervalSeconds = "groupIntervalSeconds";
+export const ClusterAlertRuleSpecFieldGroupWaitSeconds = "groupWaitSeconds";
+export const ClusterAlertRuleSpecFieldInherited = "inherited";
+export const ClusterAlertRuleSpecFieldMetricRule = "metricRule";
+export const ClusterAlertRuleSpecFieldNodeRule = "nodeRule";
+export const ClusterAlertRuleSpecFieldRepeatIntervalSeconds = "repeatIntervalSeconds";
+export const ClusterAlertRuleSpecFieldSeverity = "severity";
+export const ClusterAlertRuleSpecFieldSystemServiceRule = "systemServiceRule";
+
+export interface ClusterAlertRuleSpec {
+    clusterId?: string;
+    displayName?: string;
+    eventRule?: EventRule;
+    groupId?: string;
+    groupIntervalSeconds?: number;
+    groupWaitSeconds?: number;
+    inherited?: boolean;
+    metricRule?: MetricRule;
+    nodeRule?: NodeRule;
+    repeatIntervalSeconds?: number;
+    severity?: "info" | "critical" | "warning";
+    systemServiceRule?: SystemServiceRule;
+}
+
+export const ClusterAlertRuleType = "clusterAlertRule";
+export const ClusterAlertRuleFieldAlertState = "alertState";
+export const ClusterAlertRuleFieldAnnotations = "annotations";
+export const ClusterAlertRuleFieldClusterID = "clusterId";
+export const ClusterAlertRuleFieldCreated = "created";
+export const ClusterAlertRuleFieldCreatorID = "creatorId";
+export const ClusterAlertRuleFieldEventRule = "eventRule";
+export const ClusterAlertRuleFieldGroupID = "groupId";
+export const ClusterAlertRuleFieldGroupIntervalSeconds = "groupIntervalSeconds";
+export const ClusterAlertRuleFieldGroupWaitSeconds = "groupWaitSeconds";
+export const ClusterAlertRuleFieldInherited = "inherited";
+export const ClusterAlertRuleFieldLabels = "labels";
+export const ClusterAlertRuleFieldMetricRule = "metricRule";
+export const ClusterAlertRuleFieldName = "name";
+export const ClusterAlertRuleFieldNamespaceId = "namespaceId";
+export const ClusterAlertRuleFieldNodeRule = "nodeRule";
+export const ClusterAlertRuleFieldOwnerReferences = "ownerReferences";
+export const ClusterAlertRuleFieldRemoved = "removed";
+export const ClusterAlertRuleFieldRepeatIntervalSeconds = "repeatIntervalSeconds";
+export const ClusterAlertRuleFieldSeverity = "severity";
+export const ClusterAlertRuleFieldState = "state";
+export const ClusterAlertRuleFieldSystemServiceRule = "systemServiceRule";
+export const ClusterAlertRuleFieldTransitioning = "transitioning";
+export const ClusterAlertRuleFieldTransitioningMessage = "transitioningMessage";
+export const ClusterAlertRuleFieldUUID = "uuid";
+export const ClusterAlertRuleActionActivate = "activate";
+export const ClusterAlertRuleActionDeactivate = "deactivate";
+export const ClusterAlertRuleActionMute = "mute";
+export const ClusterAlertRuleActionUnmute = "unmute";
+
+export interface ClusterAlertRule extends ApiResource {
+    alertState?: "active" | "inactive" | "alerting" | "muted";
+    annotations?: { [key: string]: string };
+    clusterId?: string;
+    created?: string;
+    creatorId?: string;
+    eventRule?: EventRule;
+    groupId?: string;
+    groupIntervalSeconds?: number;
+    groupWaitSeconds?: number;
+    inherited?: boolean;
+    labels?: { [key: string]: string };
+    metricRule?: MetricRule;
+    name?: string;
+    namespaceId?: string;
+    nodeRule?: NodeRule;
+    ownerReferences?: OwnerReference[];
+    removed?: string;
+    repeatIntervalSeconds?: number;
+    severity?: "info" | "critical" | "warning";
+    state?: string;
+    systemServiceRule?: SystemServiceRule;
+    transitioning?: "yes" | "no" | "error";
+    transitioningMessage?: string;
+    uuid?: string;
+}
+
+export interface ClusterAlertRuleCollection extends ApiCollection {
+    data?: ClusterAlertRule[];
+}
+
+export class ClusterAlertRuleClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<ClusterAlertRuleCollection> {
+        return this.ops.DoList(ClusterAlertRuleType, opts);
+    }
+
+    Next(collection: ClusterAlertRuleCollection): Promise<ClusterAlertRuleCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: ClusterAlertRule): Promise<ClusterAlertRule> {
+        return this.ops.DoCreate(ClusterAlertRuleType, container);
+    }
+
+    Update(existing: ClusterAlertRule, updates: object): Promise<ClusterAlertRule> {
+        return this.ops.DoUpdate(ClusterAlertRuleType, existing, updates);
+    }
+
+    Replace(obj: ClusterAlertRule): Promise<ClusterAlertRule> {
+        return this.ops.DoReplace(ClusterAlertRuleType, obj, obj);
+    }
+
+    ByID(id: string): Promise<ClusterAlertRule> {
+        return this.ops.DoByID(ClusterAlertRuleType, id);
+    }
+
+    Delete(container: ClusterAlertRule): Promise<void> {
+        return this.ops.DoResourceDelete(ClusterAlertRuleType, container);
+    }
+
+    ActionActivate(resource: ClusterAlertRule): Promise<void> {
+        return this.ops.DoAction(ClusterAlertRuleType, ClusterAlertRuleActionActivate, resource);
+    }
+
+    ActionDeactivate(resource: ClusterAlertRule): Promise<void> {
+        return this.ops.DoAction(ClusterAlertRuleType, ClusterAlertRuleActionDeactivate, resource);
+    }
+
+    ActionMute(resource: ClusterAlertRule): Promise<void> {
+        return this.ops.DoAction(ClusterAlertRuleType, ClusterAlertRuleActionMute, resource);
+    }
+
+    ActionUnmute(resource: ClusterAlertRule): Promise<void> {
+        return this.ops.DoAction(ClusterAlertRuleType, ClusterAlertRuleActionUnmute, resource);
+    }
+}
+
+export const PodRuleType = "podRule";
+export const PodRuleFieldCondition = "condition";
+export const PodRuleFieldPodID = "podId";
+export const PodRuleFieldRestartIntervalSeconds = "restartIntervalSeconds";
+export const PodRuleFieldRestartTimes = "restartTimes";
+
+export interface PodRule {
+    condition?: "notrunning" | "notscheduled" | "restarts";
+    podId?: string;
+    restartIntervalSeconds?: number;
+    restartTimes?: number;
+}
+
+export const WorkloadRuleType = "workloadRule";
+export const WorkloadRuleFieldAvailablePercentage = "availablePercentage";
+export const WorkloadRuleFieldSelector = "selector";
+export const WorkloadRuleFieldWorkloadID = "workloadId";
+
+export interface WorkloadRule {
+    availablePercentage?: number;
+    selector?: { [key: string]: string };
+    workloadId?: string;
+}
+
+export const ProjectAlertRuleSpecType = "projectAlertRuleSpec";
+export const ProjectAlertRuleSpecFieldDisplayName = "displayName";
+export const ProjectAlertRuleSpecFieldGroupID = "groupId";
+export const ProjectAlertRuleSpecFieldGroupIntervalSeconds = "groupIntervalSeconds";
+export const ProjectAlertRuleSpecFieldGroupWaitSeconds = "groupWaitSeconds";
+export const ProjectAlertRuleSpecFieldInherited = "inherited";
+export const ProjectAlertRuleSpecFieldMetricRule = "metricRule";
+export const ProjectAlertRuleSpecFieldPodRule = "podRule";
+export const ProjectAlertRuleSpecFieldProjectID = "projectId";
+export const ProjectAlertRuleSpecFieldRepeatIntervalSeconds = "repeatIntervalSeconds";
+export const ProjectAlertRuleSpecFieldSeverity = "severity";
+export const ProjectAlertRuleSpecFieldWorkloadRule = "workloadRule";
+
+export interface ProjectAlertRuleSpec {
+    displayName?: string;
+    groupId?: string;
+    groupIntervalSeconds?: number;
+    groupWaitSeconds?: number;
+    inherited?: boolean;
+    metricRule?: MetricRule;
+    podRule?: PodRule;
+    projectId?: string;
+    repeatIntervalSeconds?: number;
+    severity?: "info" | "critical" | "warning";
+    workloadRule?: WorkloadRule;
+}
+
+export const ProjectAlertRuleType = "projectAlertRule";
+export const ProjectAlertRuleFieldAlertState = "alertState";
+export const ProjectAlertRuleFieldAnnotations = "annotations";
+export const ProjectAlertRuleFieldCreated = "created";
+export const ProjectAlertRuleFieldCreatorID = "creatorId";
+export const ProjectAlertRuleFieldGroupID = "groupId";
+export const ProjectAlertRuleFieldGroupIntervalSeconds = "groupIntervalSeconds";
+export const ProjectAlertRuleFieldGroupWaitSeconds = "groupWaitSeconds";
+export const ProjectAlertRuleFieldInherited = "inherited";
+export const ProjectAlertRuleFieldLabels = "labels";
+export const ProjectAlertRuleFieldMetricRule = "metricRule";
+export const ProjectAlertRuleFieldName = "name";
+export const ProjectAlertRuleFieldNamespaceId = "namespaceId";
+export const ProjectAlertRuleFieldOwnerReferences = "ownerReferences";
+export const ProjectAlertRuleFieldPodRule = "podRule";
+export const ProjectAlertRuleFieldProjectID = "projectId";
+export const ProjectAlertRuleFieldRemoved = "removed";
+export const ProjectAlertRuleFieldRepeatIntervalSeconds = "repeatIntervalSeconds";
+export const ProjectAlertRuleFieldSeverity = "severity";
+export const ProjectAlertRuleFieldState = "state";
+export const ProjectAlertRuleFieldTransitioning = "transitioning";
+export const ProjectAlertRuleFieldTransitioningMessage = "transitioningMessage";
+export const ProjectAlertRuleFieldUUID = "uuid";
+export const ProjectAlertRuleFieldWorkloadRule = "workloadRule";
+export const ProjectAlertRuleActionActivate = "activate";
+export const ProjectAlertRuleActionDeactivate = "deactivate";
+export const ProjectAlertRuleActionMute = "mute";
+export const ProjectAlertRuleActionUnmute = "unmute";
+
+export interface ProjectAlertRule extends ApiResource {
+    alertState?: "active" | "inactive" | "alerting" | "muted";
+    annotations?: { [key: string]: string };
+    created?: string;
+    creatorId?: string;
+    groupId?: string;
+    groupIntervalSeconds?: number;
+    groupWaitSeconds?: number;
+    inherited?: boolean;
+    labels?: { [key: string]: string };
+    metricRule?: MetricRule;
+    name?: string;
+    namespaceId?: string;
+    ownerReferences?: OwnerReference[];
+    podRule?: PodRule;
+    projectId?: string;
+    removed?: string;
+    repeatIntervalSeconds?: number;
+    severity?: "info" | "critical" | "warning";
+    state?: string;
+    transitioning?: "yes" | "no" | "error";
+    transitioningMessage?: string;
+    uuid?: string;
+    workloadRule?: WorkloadRule;
+}
+
+export interface ProjectAlertRuleCollection extends ApiCollection {
+    data?: ProjectAlertRule[];
+}
+
+export class ProjectAlertRuleClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<ProjectAlertRuleCollection> {
+        return this.ops.DoList(ProjectAlertRuleType, opts);
+    }
+
+    Next(collection: ProjectAlertRuleCollection): Promise<ProjectAlertRuleCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: ProjectAlertRule): Promise<ProjectAlertRule> {
+        return this.ops.DoCreate(ProjectAlertRuleType, container);
+    }
+
+    Update(existing: ProjectAlertRule, updates: object): Promise<ProjectAlertRule> {
+        return this.ops.DoUpdate(ProjectAlertRuleType, existing, updates);
+    }
+
+    Replace(obj: ProjectAlertRule): Promise<ProjectAlertRule> {
+        return this.ops.DoReplace(ProjectAlertRuleType, obj, obj);
+    }
+
+    ByID(id: string): Promise<ProjectAlertRule> {
+        return this.ops.DoByID(ProjectAlertRuleType, id);
+    }
+
+    Delete(container: ProjectAlertRule): Promise<void> {
+        return this.ops.DoResourceDelete(ProjectAlertRuleType, container);
+    }
+
+    ActionActivate(resource: ProjectAlertRule): Promise<void> {
+        return this.ops.DoAction(ProjectAlertRuleType, ProjectAlertRuleActionActivate, resource);
+    }
+
+    ActionDeactivate(resource: ProjectAlertRule): Promise<void> {
+        return this.ops.DoAction(ProjectAlertRuleType, ProjectAlertRuleActionDeactivate, resource);
+    }
+
+    ActionMute(resource: ProjectAlertRule): Promise<void> {
+        return this.ops.DoAction(ProjectAlertRuleType, ProjectAlertRuleActionMute, resource);
+    }
+
+    ActionUnmute(resource: ProjectAlertRule): Promise<void> {
+        return this.ops.DoAction(ProjectAlertRuleType, ProjectAlertRuleActionUnmute, resource);
+    }
+}
+
+export const ComposeSpecType = "composeSpec";
+export const ComposeSpecFieldRancherCompose = "rancherCompose";
+
+export interface ComposeSpec {
+    rancherCompose?: string;
+}
+
+export const ComposeConditionType = "composeCondition";
+export const ComposeConditionFieldLastTransitionTime = "lastTransitionTime";
+export const ComposeConditionFieldLastUpdateTime = "lastUpdateTime";
+export const ComposeConditionFieldMessage = "message";
+export const ComposeConditionFieldReason = "reason";
+export const ComposeConditionFieldStatus = "status";
+export const ComposeConditionFieldType = "type";
+
+export interface ComposeCondition {
+    lastTransitionTime?: string;
+    lastUpdateTime?: string;
+    message?: string;
+    reason?: string;
+    status?: string;
+    type?: string;
+}
+
+export const ComposeStatusType = "composeStatus";
+export const ComposeStatusFieldConditions = "conditions";
+
+export interface ComposeStatus {
+    conditions?: ComposeCondition[];
+}
+
+export const ComposeConfigType = "composeConfig";
+export const ComposeConfigFieldAnnotations = "annotations";
+export const ComposeConfigFieldCreated = "created";
+export const ComposeConfigFieldCreatorID = "creatorId";
+export const ComposeConfigFieldLabels = "labels";
+export const ComposeConfigFieldName = "name";
+export const ComposeConfigFieldOwnerReferences = "ownerReferences";
+export const ComposeConfigFieldRancherCompose = "rancherCompose";
+export const ComposeConfigFieldRemoved = "removed";
+export const ComposeConfigFieldState = "state";
+export const ComposeConfigFieldStatus = "status";
+export const ComposeConfigFieldTransitioning = "transitioning";
+export const ComposeConfigFieldTransitioningMessage = "transitioningMessage";
+export const ComposeConfigFieldUUID = "uuid";
+
+export interface ComposeConfig extends ApiResource {
+    annotations?: { [key: string]: string };
+    created?: string;
+    creatorId?: string;
+    labels?: { [key: string]: string };
+    name?: string;
+    ownerReferences?: OwnerReference[];
+    rancherCompose?: string;
+    removed?: string;
+    state?: string;
+    status?: ComposeStatus;
+    transitioning?: "yes" | "no" | "error";
+    transitioningMessage?: string;
+    uuid?: string;
+}
+
+export interface ComposeConfigCollection extends ApiCollection {
+    data?: ComposeConfig[];
+}
+
+export class ComposeConfigClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<ComposeConfigCollection> {
+        return this.ops.DoList(ComposeConfigType, opts);
+    }
+
+    Next(collection: ComposeConfigCollection): Promise<ComposeConfigCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: ComposeConfig): Promise<ComposeConfig> {
+        return this.ops.DoCreate(ComposeConfigType, container);
+    }
+
+    Update(existing: ComposeConfig, updates: object): Promise<ComposeConfig> {
+        return this.ops.DoUpdate(ComposeConfigType, existing, updates);
+    }
+
+    Replace(obj: ComposeConfig): Promise<ComposeConfig> {
+        return this.ops.DoReplace(ComposeConfigType, obj, obj);
+    }
+
+    ByID(id: string): Promise<ComposeConfig> {
+        return this.ops.DoByID(ComposeConfigType, id);
+    }
+
+    Delete(container: ComposeConfig): Promise<void> {
+        return this.ops.DoResourceDelete(ComposeConfigType, container);
+    }
+}
+
+export const ProjectCatalogType = "projectCatalog";
+export const ProjectCatalogFieldAnnotations = "annotations";
+export const ProjectCatalogFieldBranch = "branch";
+export const ProjectCatalogFieldCommit = "commit";
+export const ProjectCatalogFieldConditions = "conditions";
+export const ProjectCatalogFieldCreated = "created";
+export const ProjectCatalogFieldCreatorID = "creatorId";
+export const ProjectCatalogFieldDescription = "description";
+export const ProjectCatalogFieldKind = "kind";
+export const ProjectCatalogFieldLabels = "labels";
+export const ProjectCatalogFieldLastRefreshTimestamp = "lastRefreshTimestamp";
+export const ProjectCatalogFieldName = "name";
+export const ProjectCatalogFieldNamespaceId = "namespaceId";
+export const ProjectCatalogFieldOwnerReferences = "ownerReferences";
+export const ProjectCatalogFieldPassword = "password";
+export const ProjectCatalogFieldProjectID = "projectId";
+export const ProjectCatalogFieldRemoved = "removed";
+export const ProjectCatalogFieldState = "state";
+export const ProjectCatalogFieldTransitioning = "transitioning";
+export const ProjectCatalogFieldTransitioningMessage = "transitioningMessage";
+export const ProjectCatalogFieldURL = "url";
+export const ProjectCatalogFieldUUID = "uuid";
+export const ProjectCatalogFieldUsername = "username";
+export const ProjectCatalogActionRefresh = "refresh";
+export const ProjectCatalogCollectionActionRefresh = "refresh";
+
+export interface ProjectCatalog extends ApiResource {
+    annotations?: { [key: string]: string };
+    branch?: string;
+    commit?: string;
+    conditions?: CatalogCondition[];
+    created?: string;
+    creatorId?: string;
+    description?: string;
+    kind?: string;
+    labels?: { [key: string]: string };
+    lastRefreshTimestamp?: string;
+    name?: string;
+    namespaceId?: string;
+    ownerReferences?: OwnerReference[];
+    password?: string;
+    projectId?: string;
+    removed?: string;
+    state?: string;
+    transitioning?: "yes" | "no" | "error";
+    transitioningMessage?: string;
+    url?: string;
+    uuid?: string;
+    username?: string;
+}
+
+export interface ProjectCatalogCollection extends ApiCollection {
+    data?: ProjectCatalog[];
+}
+
+export class ProjectCatalogClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<ProjectCatalogCollection> {
+        return this.ops.DoList(ProjectCatalogType, opts);
+    }
+
+    Next(collection: ProjectCatalogCollection): Promise<ProjectCatalogCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: ProjectCatalog): Promise<ProjectCatalog> {
+        return this.ops.DoCreate(ProjectCatalogType, container);
+    }
+
+    Update(existing: ProjectCatalog, updates: object): Promise<ProjectCatalog> {
+        return this.ops.DoUpdate(ProjectCatalogType, existing, updates);
+    }
+
+    Replace(obj: ProjectCatalog): Promise<ProjectCatalog> {
+        return this.ops.DoReplace(ProjectCatalogType, obj, obj);
+    }
+
+    ByID(id: string): Promise<ProjectCatalog> {
+        return this.ops.DoByID(ProjectCatalogType, id);
+    }
+
+    Delete(container: ProjectCatalog): Promise<void> {
+        return this.ops.DoResourceDelete(ProjectCatalogType, container);
+    }
+
+    ActionRefresh(resource: ProjectCatalog): Promise<CatalogRefresh> {
+        return this.ops.DoAction(ProjectCatalogType, ProjectCatalogActionRefresh, resource);
+    }
+
+    CollectionActionRefresh(resource: ProjectCatalogCollection): Promise<CatalogRefresh> {
+        return this.ops.DoCollectionAction(ProjectCatalogType, ProjectCatalogCollectionActionRefresh, resource);
+    }
+}
+
+export const ClusterCatalogType = "clusterCatalog";
+export const ClusterCatalogFieldAnnotations = "annotations";
+export const ClusterCatalogFieldBranch = "branch";
+export const ClusterCatalogFieldClusterID = "clusterId";
+export const ClusterCatalogFieldCommit = "commit";
+export const ClusterCatalogFieldConditions = "conditions";
+export const ClusterCatalogFieldCreated = "created";
+export const ClusterCatalogFieldCreatorID = "creatorId";
+export const ClusterCatalogFieldDescription = "description";
+export const ClusterCatalogFieldKind = "kind";
+export const ClusterCatalogFieldLabels = "labels";
+export const ClusterCatalogFieldLastRefreshTimestamp = "lastRefreshTimestamp";
+export const ClusterCatalogFieldName = "name";
+export const ClusterCatalogFieldNamespaceId = "namespaceId";
+export const ClusterCatalogFieldOwnerReferences = "ownerReferences";
+export const ClusterCatalogFieldPassword = "password";
+export const ClusterCatalogFieldRemoved = "removed";
+export const ClusterCatalogFieldState = "state";
+export const ClusterCatalogFieldTransitioning = "transitioning";
+export const ClusterCatalogFieldTransitioningMessage = "transitioningMessage";
+export const ClusterCatalogFieldURL = "url";
+export const ClusterCatalogFieldUUID = "uuid";
+export const ClusterCatalogFieldUsername = "username";
+export const ClusterCatalogActionRefresh = "refresh";
+export const ClusterCatalogCollectionActionRefresh = "refresh";
+
+export interface ClusterCatalog extends ApiResource {
+    annotations?: { [key: string]: string };
+    branch?: string;
+    clusterId?: string;
+    commit?: string;
+    conditions?: CatalogCondition[];
+    created?: string;
+    creatorId?: string;
+    description?: string;
+    kind?: string;
+    labels?: { [key: string]: string };
+    lastRefreshTimestamp?: string;
+    name?: string;
+    namespaceId?: string;
+    ownerReferences?: OwnerReference[];
+    password?: string;
+    removed?: string;
+    state?: string;
+    transitioning?: "yes" | "no" | "error";
+    transitioningMessage?: string;
+    url?: string;
+    uuid?: string;
+    username?: string;
+}
+
+export interface ClusterCatalogCollection extends ApiCollection {
+    data?: ClusterCatalog[];
+}
+
+export class ClusterCatalogClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<ClusterCatalogCollection> {
+        return this.ops.DoList(ClusterCatalogType, opts);
+    }
+
+    Next(collection: ClusterCatalogCollection): Promise<ClusterCatalogCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: ClusterCatalog): Promise<ClusterCatalog> {
+        return this.ops.DoCreate(ClusterCatalogType, container);
+    }
+
+    Update(existing: ClusterCatalog, updates: object): Promise<ClusterCatalog> {
+        return this.ops.DoUpdate(ClusterCatalogType, existing, updates);
+    }
+
+    Replace(obj: ClusterCatalog): Promise<ClusterCatalog> {
+        return this.ops.DoReplace(ClusterCatalogType, obj, obj);
+    }
+
+    ByID(id: string): Promise<ClusterCatalog> {
+        return this.ops.DoByID(ClusterCatalogType, id);
+    }
+
+    Delete(container: ClusterCatalog): Promise<void> {
+        return this.ops.DoResourceDelete(ClusterCatalogType, container);
+    }
+
+    ActionRefresh(resource: ClusterCatalog): Promise<CatalogRefresh> {
+        return this.ops.DoAction(ClusterCatalogType, ClusterCatalogActionRefresh, resource);
+    }
+
+    CollectionActionRefresh(resource: ClusterCatalogCollection): Promise<CatalogRefresh> {
+        return this.ops.DoCollectionAction(ClusterCatalogType, ClusterCatalogCollectionActionRefresh, resource);
+    }
+}
+
+export const TargetType = "target";
+export const TargetFieldAppID = "appId";
+export const TargetFieldHealthstate = "healthState";
+export const TargetFieldProjectID = "projectId";
+export const TargetFieldState = "state";
+
+export interface Target {
+    appId?: string;
+    healthState?: string;
+    projectId?: string;
+    state?: string;
+}
+
+export const MemberType = "member";
+export const MemberFieldAccessType = "accessType";
+export const MemberFieldGroupPrincipalID = "groupPrincipalId";
+export const MemberFieldUserPrincipalID = "userPrincipalId";
+
+export interface Member {
+    accessType?: "owner" | "member" | "read-only";
+    groupPrincipalId?: string;
+    userPrincipalId?: string;
+}
+
+export const RollingUpdateType = "rollingUpdate";
+export const RollingUpdateFieldBatchSize = "batchSize";
+export const RollingUpdateFieldInterval = "interval";
+
+export interface RollingUpdate {
+    batchSize?: number;
+    interval?: number;
+}
+
+export const UpgradeStrategyType = "upgradeStrategy";
+export const UpgradeStrategyFieldRollingUpdate = "rollingUpdate";
+
+export interface UpgradeStrategy {
+    rollingUpdate?: RollingUpdate;
+}
+
+export const MultiClusterAppSpecType = "multiClusterAppSpec";
+export const MultiClusterAppSpecFieldAnswers = "answers";
+export const MultiClusterAppSpecFieldMembers = "members";
+export const MultiClusterAppSpecFieldRevisionHistoryLimit = "revisionHistoryLimit";
+export const MultiClusterAppSpecFieldRoles = "roles";
+export const MultiClusterAppSpecFieldTargets = "targets";
+export const MultiClusterAppSpecFieldTemplateVersionID = "templateVersionId";
+export const MultiClusterAppSpecFieldTimeout = "timeout";
+export const MultiClusterAppSpecFieldUpgradeStrategy = "upgradeStrategy";
+export const MultiClusterAppSpecFieldWait = "wait";
+
+export interface MultiClusterAppSpec {
+    answers?: Answer[];
+    members?: Member[];
+    revisionHistoryLimit?: number;
+    roles?: string[];
+    targets?: Target[];
+    templateVersionId?: string;
+    timeout?: number;
+    upgradeStrategy?: UpgradeStrategy;
+    wait?: boolean;
+}
+
+export const AppConditionType = "appCondition";
+export const AppConditionFieldLastTransitionTime = "lastTransitionTime";
+export const AppConditionFieldLastUpdateTime = "lastUpdateTime";
+export const AppConditionFieldMessage = "message";
+export const AppConditionFieldReason = "reason";
+export const AppConditionFieldStatus = "status";
+export const AppConditionFieldType = "type";
+
+export interface AppCondition {
+    lastTransitionTime?: string;
+    lastUpdateTime?: string;
+    message?: string;
+    reason?: string;
+    status?: string;
+    type?: string;
+}
+
+export const MultiClusterAppStatusType = "multiClusterAppStatus";
+export const MultiClusterAppStatusFieldConditions = "conditions";
+export const MultiClusterAppStatusFieldRevisionID = "revisionId";
+
+export interface MultiClusterAppStatus {
+    conditions?: AppCondition[];
+    revisionId?: string;
+}
+
+export const MultiClusterAppType = "multiClusterApp";
+export const MultiClusterAppFieldAnnotations = "annotations";
+export const MultiClusterAppFieldAnswers = "answers";
+export const MultiClusterAppFieldCreated = "created";
+export const MultiClusterAppFieldCreatorID = "creatorId";
+export const MultiClusterAppFieldLabels = "labels";
+export const MultiClusterAppFieldMembers = "members";
+export const MultiClusterAppFieldName = "name";
+export const MultiClusterAppFieldOwnerReferences = "ownerReferences";
+export const MultiClusterAppFieldRemoved = "removed";
+export const MultiClusterAppFieldRevisionHistoryLimit = "revisionHistoryLimit";
+export const MultiClusterAppFieldRoles = "roles";
+export const MultiClusterAppFieldState = "state";
+export const MultiClusterAppFieldStatus = "status";
+export const MultiClusterAppFieldTargets = "targets";
+export const MultiClusterAppFieldTemplateVersionID = "templateVersionId";
+export const MultiClusterAppFieldTimeout = "timeout";
+export const MultiClusterAppFieldTransitioning = "transitioning";
+export const MultiClusterAppFieldTransitioningMessage = "transitioningMessage";
+export const MultiClusterAppFieldUUID = "uuid";
+export const MultiClusterAppFieldUpgradeStrategy = "upgradeStrategy";
+export const MultiClusterAppFieldWait = "wait";
+export const MultiClusterAppActionAddProjects = "addProjects";
+export const MultiClusterAppActionRemoveProjects = "removeProjects";
+export const MultiClusterAppActionRollback = "rollback";
+
+export interface MultiClusterApp extends ApiResource {
+    annotations?: { [key: string]: string };
+    answers?: Answer[];
+    created?: string;
+    creatorId?: string;
+    labels?: { [key: string]: string };
+    members?: Member[];
+    name?: string;
+    ownerReferences?: OwnerReference[];
+    removed?: string;
+    revisionHistoryLimit?: number;
+    roles?: string[];
+    state?: string;
+    status?: MultiClusterAppStatus;
+    targets?: Target[];
+    templateVersionId?: string;
+    timeout?: number;
+    transitioning?: "yes" | "no" | "error";
+    transitioningMessage?: string;
+    uuid?: string;
+    upgradeStrategy?: UpgradeStrategy;
+    wait?: boolean;
+}
+
+export interface MultiClusterAppCollection extends ApiCollection {
+    data?: MultiClusterApp[];
+}
+
+export class MultiClusterAppClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<MultiClusterAppCollection> {
+        return this.ops.DoList(MultiClusterAppType, opts);
+    }
+
+    Next(collection: MultiClusterAppCollection): Promise<MultiClusterAppCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: MultiClusterApp): Promise<MultiClusterApp> {
+        return this.ops.DoCreate(MultiClusterAppType, container);
+    }
+
+    Update(existing: MultiClusterApp, updates: object): Promise<MultiClusterApp> {
+        return this.ops.DoUpdate(MultiClusterAppType, existing, updates);
+    }
+
+    Replace(obj: MultiClusterApp): Promise<MultiClusterApp> {
+        return this.ops.DoReplace(MultiClusterAppType, obj, obj);
+    }
+
+    ByID(id: string): Promise<MultiClusterApp> {
+        return this.ops.DoByID(MultiClusterAppType, id);
+    }
+
+    Delete(container: MultiClusterApp): Promise<void> {
+        return this.ops.DoResourceDelete(MultiClusterAppType, container);
+    }
+
+    ActionAddProjects(resource: MultiClusterApp, input: UpdateMultiClusterAppTargetsInput): Promise<void> {
+        return this.ops.DoAction(MultiClusterAppType, MultiClusterAppActionAddProjects, resource, input);
+    }
+
+    ActionRemoveProjects(resource: MultiClusterApp, input: UpdateMultiClusterAppTargetsInput): Promise<void> {
+        return this.ops.DoAction(MultiClusterAppType, MultiClusterAppActionRemoveProjects, resource, input);
+    }
+
+    ActionRollback(resource: MultiClusterApp, input: MultiClusterAppRollbackInput): Promise<void> {
+        return this.ops.DoAction(MultiClusterAppType, MultiClusterAppActionRollback, resource, input);
+    }
+}
+
+export const MultiClusterAppRollbackInputType = "multiClusterAppRollbackInput";
+export const MultiClusterAppRollbackInputFieldRevisionID = "revisionId";
+
+export interface MultiClusterAppRollbackInput {
+    revisionId?: string;
+}
+
+export const MultiClusterAppRevisionType = "multiClusterAppRevision";
+export const MultiClusterAppRevisionFieldAnnotations = "annotations";
+export const MultiClusterAppRevisionFieldAnswers = "answers";
+export const MultiClusterAppRevisionFieldCreated = "created";
+export const MultiClusterAppRevisionFieldCreatorID = "creatorId";
+export const MultiClusterAppRevisionFieldLabels = "labels";
+export const MultiClusterAppRevisionFieldName = "name";
+export const MultiClusterAppRevisionFieldOwnerReferences = "ownerReferences";
+export const MultiClusterAppRevisionFieldRemoved = "removed";
+export const MultiClusterAppRevisionFieldTemplateVersionID = "templateVersionId";
+export const MultiClusterAppRevisionFieldUUID = "uuid";
+
+export interface MultiClusterAppRevision extends ApiResource {
+    annotations?: { [key: string]: string };
+    answers?: Answer[];
+    created?: string;
+    creatorId?: string;
+    labels?: { [key: string]: string };
+    name?: string;
+    ownerReferences?: OwnerReference[];
+    removed?: string;
+    templateVersionId?: string;
+    uuid?: string;
+}
+
+export interface MultiClusterAppRevisionCollection extends ApiCollection {
+    data?: MultiClusterAppRevision[];
+}
+
+export class MultiClusterAppRevisionClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<MultiClusterAppRevisionCollection> {
+        return this.ops.DoList(MultiClusterAppRevisionType, opts);
+    }
+
+    Next(collection: MultiClusterAppRevisionCollection): Promise<MultiClusterAppRevisionCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: MultiClusterAppRevision): Promise<MultiClusterAppRevision> {
+        return this.ops.DoCreate(MultiClusterAppRevisionType, container);
+    }
+
+    Update(existing: MultiClusterAppRevision, updates: object): Promise<MultiClusterAppRevision> {
+        return this.ops.DoUpdate(MultiClusterAppRevisionType, existing, updates);
+    }
+
+    Replace(obj: MultiClusterAppRevision): Promise<MultiClusterAppRevision> {
+        return this.ops.DoReplace(MultiClusterAppRevisionType, obj, obj);
+    }
+
+    ByID(id: string): Promise<MultiClusterAppRevision> {
+        return this.ops.DoByID(MultiClusterAppRevisionType, id);
+    }
+
+    Delete(container: MultiClusterAppRevision): Promise<void> {
+        return this.ops.DoResourceDelete(MultiClusterAppRevisionType, container);
+    }
+}
+
+export const UpdateMultiClusterAppTargetsInputType = "updateMultiClusterAppTargetsInput";
+export const UpdateMultiClusterAppTargetsInputFieldAnswers = "answers";
+export const UpdateMultiClusterAppTargetsInputFieldProjects = "projects";
+
+export interface UpdateMultiClusterAppTargetsInput {
+    answers?: Answer[];
+    projects?: string[];
+}
+
+export const UpdateGlobalDNSTargetsInputType = "updateGlobalDNSTargetsInput";
+export const UpdateGlobalDNSTargetsInputFieldProjectIDs = "projectIds";
+
+export interface UpdateGlobalDNSTargetsInput {
+    projectIds?: string[];
+}
+
+export const GlobalDNSSpecType = "globalDnsSpec";
+export const GlobalDNSSpecFieldFQDN = "fqdn";
+export const GlobalDNSSpecFieldMembers = "members";
+export const GlobalDNSSpecFieldMultiClusterAppID = "multiClusterAppId";
+export const GlobalDNSSpecFieldProjectIDs = "projectIds";
+export const GlobalDNSSpecFieldProviderID = "providerId";
+export const GlobalDNSSpecFieldTTL = "ttl";
+
+export interface GlobalDNSSpec {
+    fqdn?: string;
+    members?: Member[];
+    multiClusterAppId?: string;
+    projectIds?: string[];
+    providerId?: string;
+    ttl?: number;
+}
+
+export const GlobalDNSStatusType = "globalDnsStatus";
+export const GlobalDNSStatusFieldClusterEndpoints = "clusterEndpoints";
+export const GlobalDNSStatusFieldEndpoints = "endpoints";
+
+export interface GlobalDNSStatus {
+    clusterEndpoints?: { [key: string]: string[] };
+    endpoints?: string[];
+}
+
+export const GlobalDNSType = "globalDns";
+export const GlobalDNSFieldAnnotations = "annotations";
+export const GlobalDNSFieldCreated = "created";
+export const GlobalDNSFieldCreatorID = "creatorId";
+export const GlobalDNSFieldFQDN = "fqdn";
+export const GlobalDNSFieldLabels = "labels";
+export const GlobalDNSFieldMembers = "members";
+export const GlobalDNSFieldMultiClusterAppID = "multiClusterAppId";
+export const GlobalDNSFieldName = "name";
+export const GlobalDNSFieldOwnerReferences = "ownerReferences";
+export const GlobalDNSFieldProjectIDs = "projectIds";
+export const GlobalDNSFieldProviderID = "providerId";
+export const GlobalDNSFieldRemoved = "removed";
+export const GlobalDNSFieldState = "state";
+export const GlobalDNSFieldStatus = "status";
+export const GlobalDNSFieldTTL = "ttl";
+export const GlobalDNSFieldTransitioning = "transitioning";
+export const GlobalDNSFieldTransitioningMessage = "transitioningMessage";
+export const GlobalDNSFieldUUID = "uuid";
+export const GlobalDNSActionAddProjects = "addProjects";
+export const GlobalDNSActionRemoveProjects = "removeProjects";
+
+export interface GlobalDNS extends ApiResource {
+    annotations?: { [key: string]: string };
+    created?: string;
+    creatorId?: string;
+    fqdn?: string;
+    labels?: { [key: string]: string };
+    members?: Member[];
+    multiClusterAppId?: string;
+    name?: string;
+    ownerReferences?: OwnerReference[];
+    projectIds?: string[];
+    providerId?: string;
+    removed?: string;
+    state?: string;
+    status?: GlobalDNSStatus;
+    ttl?: number;
+    transitioning?: "yes" | "no" | "error";
+    transitioningMessage?: string;
+    uuid?: string;
+}
+
+export interface GlobalDNSCollection extends ApiCollection {
+    data?: GlobalDNS[];
+}
+
+export class GlobalDNSClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<GlobalDNSCollection> {
+        return this.ops.DoList(GlobalDNSType, opts);
+    }
+
+    Next(collection: GlobalDNSCollection): Promise<GlobalDNSCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: GlobalDNS): Promise<GlobalDNS> {
+        return this.ops.DoCreate(GlobalDNSType, container);
+    }
+
+    Update(existing: GlobalDNS, updates: object): Promise<GlobalDNS> {
+        return this.ops.DoUpdate(GlobalDNSType, existing, updates);
+    }
+
+    Replace(obj: GlobalDNS): Promise<GlobalDNS> {
+        return this.ops.DoReplace(GlobalDNSType, obj, obj);
+    }
+
+    ByID(id: string): Promise<GlobalDNS> {
+        return this.ops.DoByID(GlobalDNSType, id);
+    }
+
+    Delete(container: GlobalDNS): Promise<void> {
+        return this.ops.DoResourceDelete(GlobalDNSType, container);
+    }
+
+    ActionAddProjects(resource: GlobalDNS, input: UpdateGlobalDNSTargetsInput): Promise<void> {
+        return this.ops.DoAction(GlobalDNSType, GlobalDNSActionAddProjects, resource, input);
+    }
+
+    ActionRemoveProjects(resource: GlobalDNS, input: UpdateGlobalDNSTargetsInput): Promise<void> {
+        return this.ops.DoAction(GlobalDNSType, GlobalDNSActionRemoveProjects, resource, input);
+    }
+}
+
+export const Route53ProviderConfigType = "route53ProviderConfig";
+export const Route53ProviderConfigFieldAccessKey = "accessKey";
+export const Route53ProviderConfigFieldCredentialsPath = "credentialsPath";
+export const Route53ProviderConfigFieldRegion = "region";
+export const Route53ProviderConfigFieldRoleArn = "roleArn";
+export const Route53ProviderConfigFieldSecretKey = "secretKey";
+export const Route53ProviderConfigFieldZoneType = "zoneType";
+
+export interface Route53ProviderConfig {
+    accessKey?: string;
+    credentialsPath?: string;
+    region?: string;
+    roleArn?: string;
+    secretKey?: string;
+    zoneType?: string;
+}
+
+export const CloudflareProviderConfigType = "cloudflareProviderConfig";
+export const CloudflareProviderConfigFieldAPIEmail = "apiEmail";
+export const CloudflareProviderConfigFieldAPIKey = "apiKey";
+export const CloudflareProviderConfigFieldProxySetting = "proxySetting";
+
+export interface CloudflareProviderConfig {
+    apiEmail?: string;
+    apiKey?: string;
+    proxySetting?: boolean;
+}
+
+export const AlidnsProviderConfigType = "alidnsProviderConfig";
+export const AlidnsProviderConfigFieldAccessKey = "accessKey";
+export const AlidnsProviderConfigFieldSecretKey = "secretKey";
+
+export interface AlidnsProviderConfig {
+    accessKey?: string;
+    secretKey?: string;
+}
+
+export const GlobalDNSProviderSpecType = "globalDnsProviderSpec";
+export const GlobalDNSProviderSpecFieldAlidnsProviderConfig = "alidnsProviderConfig";
+export const GlobalDNSProviderSpecFieldCloudflareProviderConfig = "cloudflareProviderConfig";
+export const GlobalDNSProviderSpecFieldMembers = "members";
+export const GlobalDNSProviderSpecFieldRootDomain = "rootDomain";
+export const GlobalDNSProviderSpecFieldRoute53ProviderConfig = "route53ProviderConfig";
+
+export interface GlobalDNSProviderSpec {
+    alidnsProviderConfig?: AlidnsProviderConfig;
+    cloudflareProviderConfig?: CloudflareProviderConfig;
+    members?: Member[];
+    rootDomain?: string;
+    route53ProviderConfig?: Route53ProviderConfig;
+}
+
+export const GlobalDNSProviderType = "globalDnsProvider";
+export const GlobalDNSProviderFieldAlidnsProviderConfig = "alidnsProviderConfig";
+export const GlobalDNSProviderFieldAnnotations = "annotations";
+export const GlobalDNSProviderFieldCloudflareProviderConfig = "cloudflareProviderConfig";
+export const GlobalDNSProviderFieldCreated = "created";
+export const GlobalDNSProviderFieldCreatorID = "creatorId";
+export const GlobalDNSProviderFieldLabels = "labels";
+export const GlobalDNSProviderFieldMembers = "members";
+export const GlobalDNSProviderFieldName = "name";
+export const GlobalDNSProviderFieldOwnerReferences = "ownerReferences";
+export const GlobalDNSProviderFieldRemoved = "removed";
+export const GlobalDNSProviderFieldRootDomain = "rootDomain";
+export const GlobalDNSProviderFieldRoute53ProviderConfig = "route53ProviderConfig";
+export const GlobalDNSProviderFieldUUID = "uuid";
+
+export interface GlobalDNSProvider extends ApiResource {
+    alidnsProviderConfig?: AlidnsProviderConfig;
+    annotations?: { [key: string]: string };
+    cloudflareProviderConfig?: CloudflareProviderConfig;
+    created?: string;
+    creatorId?: string;
+    labels?: { [key: string]: string };
+    members?: Member[];
+    name?: string;
+    ownerReferences?: OwnerReference[];
+    removed?: string;
+    rootDomain?: string;
+    route53ProviderConfig?: Route53ProviderConfig;
+    uuid?: string;
+}
+
+export interface GlobalDNSProviderCollection extends ApiCollection {
+    data?: GlobalDNSProvider[];
+}
+
+export class GlobalDNSProviderClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<GlobalDNSProviderCollection> {
+        return this.ops.DoList(GlobalDNSProviderType, opts);
+    }
+
+    Next(collection: GlobalDNSProviderCollection): Promise<GlobalDNSProviderCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: GlobalDNSProvider): Promise<GlobalDNSProvider> {
+        return this.ops.DoCreate(GlobalDNSProviderType, container);
+    }
+
+    Update(existing: GlobalDNSProvider, updates: object): Promise<GlobalDNSProvider> {
+        return this.ops.DoUpdate(GlobalDNSProviderType, existing, updates);
+    }
+
+    Replace(obj: GlobalDNSProvider): Promise<GlobalDNSProvider> {
+        return this.ops.DoReplace(GlobalDNSProviderType, obj, obj);
+    }
+
+    ByID(id: string): Promise<GlobalDNSProvider> {
+        return this.ops.DoByID(GlobalDNSProviderType, id);
+    }
+
+    Delete(container: GlobalDNSProvider): Promise<void> {
+        return this.ops.DoResourceDelete(GlobalDNSProviderType, container);
+    }
+}
+
+export const KontainerDriverSpecType = "kontainerDriverSpec";
+export const KontainerDriverSpecFieldActive = "active";
+export const KontainerDriverSpecFieldBuiltIn = "builtIn";
+export const KontainerDriverSpecFieldChecksum = "checksum";
+export const KontainerDriverSpecFieldUIURL = "uiUrl";
+export const KontainerDriverSpecFieldURL = "url";
+export const KontainerDriverSpecFieldWhitelistDomains = "whitelistDomains";
+
+export interface KontainerDriverSpec {
+    active?: boolean;
+    builtIn?: boolean;
+    checksum?: string;
+    uiUrl?: string;
+    url?: string;
+    whitelistDomains?: string[];
+}
+
+export const KontainerDriverStatusType = "kontainerDriverStatus";
+export const KontainerDriverStatusFieldActualURL = "actualUrl";
+export const KontainerDriverStatusFieldConditions = "conditions";
+export const KontainerDriverStatusFieldDisplayName = "displayName";
+export const KontainerDriverStatusFieldExecutablePath = "executablePath";
+
+export interface KontainerDriverStatus {
+    actualUrl?: string;
+    conditions?: Condition[];
+    displayName?: string;
+    executablePath?: string;
+}
+
+export const KontainerDriverType = "kontainerDriver";
+export const KontainerDriverFieldActive = "active";
+export const KontainerDriverFieldActualURL = "actualUrl";
+export const KontainerDriverFieldAnnotations = "annotations";
+export const KontainerDriverFieldBuiltIn = "builtIn";
+export const KontainerDriverFieldChecksum = "checksum";
+export const KontainerDriverFieldConditions = "conditions";
+export const KontainerDriverFieldCreated = "created";
+export const KontainerDriverFieldCreatorID = "creatorId";
+export const KontainerDriverFieldExecutablePath = "executablePath";
+export const KontainerDriverFieldLabels = "labels";
+export const KontainerDriverFieldName = "name";
+export const KontainerDriverFieldOwnerReferences = "ownerReferences";
+export const KontainerDriverFieldRemoved = "removed";
+export const KontainerDriverFieldState = "state";
+export const KontainerDriverFieldTransitioning = "transitioning";
+export const KontainerDriverFieldTransitioningMessage = "transitioningMessage";
+export const KontainerDriverFieldUIURL = "uiUrl";
+export const KontainerDriverFieldURL = "url";
+export const KontainerDriverFieldUUID = "uuid";
+export const KontainerDriverFieldWhitelistDomains = "whitelistDomains";
+export const KontainerDriverActionActivate = "activate";
+export const KontainerDriverActionDeactivate = "deactivate";
+export const KontainerDriverCollectionActionRefresh = "refresh";
+
+export interface KontainerDriver extends ApiResource {
+    active?: boolean;
+    actualUrl?: string;
+    annotations?: { [key: string]: string };
+    builtIn?: boolean;
+    checksum?: string;
+    conditions?: Condition[];
+    created?: string;
+    creatorId?: string;
+    executablePath?: string;
+    labels?: { [key: string]: string };
+    name?: string;
+    ownerReferences?: OwnerReference[];
+    removed?: string;
+    state?: string;
+    transitioning?: "yes" | "no" | "error";
+    transitioningMessage?: string;
+    uiUrl?: string;
+    url?: string;
+    uuid?: string;
+    whitelistDomains?: string[];
+}
+
+export interface KontainerDriverCollection extends ApiCollection {
+    data?: KontainerDriver[];
+}
+
+export class KontainerDriverClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<KontainerDriverCollection> {
+        return this.ops.DoList(KontainerDriverType, opts);
+    }
+
+    Next(collection: KontainerDriverCollection): Promise<KontainerDriverCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: KontainerDriver): Promise<KontainerDriver> {
+        return this.ops.DoCreate(KontainerDriverType, container);
+    }
+
+    Update(existing: KontainerDriver, updates: object): Promise<KontainerDriver> {
+        return this.ops.DoUpdate(KontainerDriverType, existing, updates);
+    }
+
+    Replace(obj: KontainerDriver): Promise<KontainerDriver> {
+        return this.ops.DoReplace(KontainerDriverType, obj, obj);
+    }
+
+    ByID(id: string): Promise<KontainerDriver> {
+        return this.ops.DoByID(KontainerDriverType, id);
+    }
+
+    Delete(container: KontainerDriver): Promise<void> {
+        return this.ops.DoResourceDelete(KontainerDriverType, container);
+    }
+
+    ActionActivate(resource: KontainerDriver): Promise<void> {
+        return this.ops.DoAction(KontainerDriverType, KontainerDriverActionActivate, resource);
+    }
+
+    ActionDeactivate(resource: KontainerDriver): Promise<void> {
+        return this.ops.DoAction(KontainerDriverType, KontainerDriverActionDeactivate, resource);
+    }
+
+    CollectionActionRefresh(resource: KontainerDriverCollection): Promise<void> {
+        return this.ops.DoCollectionAction(KontainerDriverType, KontainerDriverCollectionActionRefresh, resource);
+    }
+}
+
+export const EtcdBackupSpecType = "etcdBackupSpec";
+export const EtcdBackupSpecFieldBackupConfig = "backupConfig";
+export const EtcdBackupSpecFieldClusterID = "clusterId";
+export const EtcdBackupSpecFieldFilename = "filename";
+export const EtcdBackupSpecFieldManual = "manual";
+
+export interface EtcdBackupSpec {
+    backupConfig?: BackupConfig;
+    clusterId?: string;
+    filename?: string;
+    manual?: boolean;
+}
+
+export const EtcdBackupConditionType = "etcdBackupCondition";
+export const EtcdBackupConditionFieldLastTransitionTime = "lastTransitionTime";
+export const EtcdBackupConditionFieldLastUpdateTime = "lastUpdateTime";
+export const EtcdBackupConditionFieldMessage = "message";
+export const EtcdBackupConditionFieldReason = "reason";
+export const EtcdBackupConditionFieldStatus = "status";
+export const EtcdBackupConditionFieldType = "type";
+
+export interface EtcdBackupCondition {
+    lastTransitionTime?: string;
+    lastUpdateTime?: string;
+    message?: string;
+    reason?: string;
+    status?: string;
+    type?: string;
+}
+
+export const EtcdBackupStatusType = "etcdBackupStatus";
+export const EtcdBackupStatusFieldConditions = "conditions";
+
+export interface EtcdBackupStatus {
+    conditions?: EtcdBackupCondition[];
+}
+
+export const EtcdBackupType = "etcdBackup";
+export const EtcdBackupFieldAnnotations = "annotations";
+export const EtcdBackupFieldBackupConfig = "backupConfig";
+export const EtcdBackupFieldClusterID = "clusterId";
+export const EtcdBackupFieldCreated = "created";
+export const EtcdBackupFieldCreatorID = "creatorId";
+export const EtcdBackupFieldFilename = "filename";
+export const EtcdBackupFieldLabels = "labels";
+export const EtcdBackupFieldManual = "manual";
+export const EtcdBackupFieldName = "name";
+export const EtcdBackupFieldNamespaceId = "namespaceId";
+export const EtcdBackupFieldOwnerReferences = "ownerReferences";
+export const EtcdBackupFieldRemoved = "removed";
+export const EtcdBackupFieldState = "state";
+export const EtcdBackupFieldStatus = "status";
+export const EtcdBackupFieldTransitioning = "transitioning";
+export const EtcdBackupFieldTransitioningMessage = "transitioningMessage";
+export const EtcdBackupFieldUUID = "uuid";
+
+export interface EtcdBackup extends ApiResource {
+    annotations?: { [key: string]: string };
+    backupConfig?: BackupConfig;
+    clusterId?: string;
+    created?: string;
+    creatorId?: string;
+    filename?: string;
+    labels?: { [key: string]: string };
+    manual?: boolean;
+    name?: string;
+    namespaceId?: string;
+    ownerReferences?: OwnerReference[];
+    removed?: string;
+    state?: string;
+    status?: EtcdBackupStatus;
+    transitioning?: "yes" | "no" | "error";
+    transitioningMessage?: string;
+    uuid?: string;
+}
+
+export interface EtcdBackupCollection extends ApiCollection {
+    data?: EtcdBackup[];
+}
+
+export class EtcdBackupClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<EtcdBackupCollection> {
+        return this.ops.DoList(EtcdBackupType, opts);
+    }
+
+    Next(collection: EtcdBackupCollection): Promise<EtcdBackupCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: EtcdBackup): Promise<EtcdBackup> {
+        return this.ops.DoCreate(EtcdBackupType, container);
+    }
+
+    Update(existing: EtcdBackup, updates: object): Promise<EtcdBackup> {
+        return this.ops.DoUpdate(EtcdBackupType, existing, updates);
+    }
+
+    Replace(obj: EtcdBackup): Promise<EtcdBackup> {
+        return this.ops.DoReplace(EtcdBackupType, obj, obj);
+    }
+
+    ByID(id: string): Promise<EtcdBackup> {
+        return this.ops.DoByID(EtcdBackupType, id);
+    }
+
+    Delete(container: EtcdBackup): Promise<void> {
+        return this.ops.DoResourceDelete(EtcdBackupType, container);
+    }
+}
+
+export const ClusterScanConfigType = "clusterScanConfig";
+
+export interface ClusterScanConfig {
+}
+
+export const ClusterScanSpecType = "clusterScanSpec";
+export const ClusterScanSpecFieldClusterID = "clusterId";
+export const ClusterScanSpecFieldManual = "manual";
+export const ClusterScanSpecFieldScanConfig = "scanConfig";
+export const ClusterScanSpecFieldScanType = "scanType";
+
+export interface ClusterScanSpec {
+    clusterId?: string;
+    manual?: boolean;
+    scanConfig?: ClusterScanConfig;
+    scanType?: string;
+}
+
+export const ClusterScanConditionType = "clusterScanCondition";
+export const ClusterScanConditionFieldLastTransitionTime = "lastTransitionTime";
+export const ClusterScanConditionFieldLastUpdateTime = "lastUpdateTime";
+export const ClusterScanConditionFieldMessage = "message";
+export const ClusterScanConditionFieldReason = "reason";
+export const ClusterScanConditionFieldStatus = "status";
+export const ClusterScanConditionFieldType = "type";
+
+export interface ClusterScanCondition {
+    lastTransitionTime?: string;
+    lastUpdateTime?: string;
+    message?: string;
+    reason?: string;
+    status?: string;
+    type?: string;
+}
+
+export const ClusterScanStatusType = "clusterScanStatus";
+export const ClusterScanStatusFieldConditions = "conditions";
+
+export interface ClusterScanStatus {
+    conditions?: ClusterScanCondition[];
+}
+
+export const ClusterScanType = "clusterScan";
+export const ClusterScanFieldAnnotations = "annotations";
+export const ClusterScanFieldClusterID = "clusterId";
+export const ClusterScanFieldCreated = "created";
+export const ClusterScanFieldCreatorID = "creatorId";
+export const ClusterScanFieldLabels = "labels";
+export const ClusterScanFieldManual = "manual";
+export const ClusterScanFieldName = "name";
+export const ClusterScanFieldNamespaceId = "namespaceId";
+export const ClusterScanFieldOwnerReferences = "ownerReferences";
+export const ClusterScanFieldRemoved = "removed";
+export const ClusterScanFieldScanConfig = "scanConfig";
+export const ClusterScanFieldScanType = "scanType";
+export const ClusterScanFieldState = "state";
+export const ClusterScanFieldStatus = "status";
+export const ClusterScanFieldTransitioning = "transitioning";
+export const ClusterScanFieldTransitioningMessage = "transitioningMessage";
+export const ClusterScanFieldUUID = "uuid";
+
+export interface ClusterScan extends ApiResource {
+    annotations?: { [key: string]: string };
+    clusterId?: string;
+    created?: string;
+    creatorId?: string;
+    labels?: { [key: string]: string };
+    manual?: boolean;
+    name?: string;
+    namespaceId?: string;
+    ownerReferences?: OwnerReference[];
+    removed?: string;
+    scanConfig?: ClusterScanConfig;
+    scanType?: string;
+    state?: string;
+    status?: ClusterScanStatus;
+    transitioning?: "yes" | "no" | "error";
+    transitioningMessage?: string;
+    uuid?: string;
+}
+
+export interface ClusterScanCollection extends ApiCollection {
+    data?: ClusterScan[];
+}
+
+export class ClusterScanClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<ClusterScanCollection> {
+        return this.ops.DoList(ClusterScanType, opts);
+    }
+
+    Next(collection: ClusterScanCollection): Promise<ClusterScanCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: ClusterScan): Promise<ClusterScan> {
+        return this.ops.DoCreate(ClusterScanType, container);
+    }
+
+    Update(existing: ClusterScan, updates: object): Promise<ClusterScan> {
+        return this.ops.DoUpdate(ClusterScanType, existing, updates);
+    }
+
+    Replace(obj: ClusterScan): Promise<ClusterScan> {
+        return this.ops.DoReplace(ClusterScanType, obj, obj);
+    }
+
+    ByID(id: string): Promise<ClusterScan> {
+        return this.ops.DoByID(ClusterScanType, id);
+    }
+
+    Delete(container: ClusterScan): Promise<void> {
+        return this.ops.DoResourceDelete(ClusterScanType, container);
+    }
+}
+
+export const QueryGraphInputType = "queryGraphInput";
+export const QueryGraphInputFieldFilters = "filters";
+export const QueryGraphInputFieldFrom = "from";
+export const QueryGraphInputFieldInterval = "interval";
+export const QueryGraphInputFieldIsDetails = "isDetails";
+export const QueryGraphInputFieldMetricParams = "metricParams";
+export const QueryGraphInputFieldTo = "to";
+
+export interface QueryGraphInput {
+    filters?: { [key: string]: string };
+    from?: string;
+    interval?: string;
+    isDetails?: boolean;
+    metricParams?: { [key: string]: string };
+    to?: string;
+}
+
+export const QueryClusterGraphType = "queryClusterGraph";
+export const QueryClusterGraphFieldGraphName = "graphID";
+export const QueryClusterGraphFieldSeries = "series";
+
+export interface QueryClusterGraph {
+    graphID?: string;
+    series?: string[];
+}
+
+export const QueryClusterGraphOutputType = "queryClusterGraphOutput";
+export const QueryClusterGraphOutputFieldData = "data";
+export const QueryClusterGraphOutputFieldType = "type";
+
+export interface QueryClusterGraphOutput {
+    data?: QueryClusterGraph[];
+    type?: string;
+}
+
+export const QueryProjectGraphType = "queryProjectGraph";
+export const QueryProjectGraphFieldGraphName = "graphID";
+export const QueryProjectGraphFieldSeries = "series";
+
+export interface QueryProjectGraph {
+    graphID?: string;
+    series?: string[];
+}
+
+export const QueryProjectGraphOutputType = "queryProjectGraphOutput";
+export const QueryProjectGraphOutputFieldData = "data";
+export const QueryProjectGraphOutputFieldType = "type";
+
+export interface QueryProjectGraphOutput {
+    data?: QueryProjectGraph[];
+    type?: string;
+}
+
+export const QueryClusterMetricInputType = "queryClusterMetricInput";
+export const QueryClusterMetricInputFieldClusterName = "clusterId";
+export const QueryClusterMetricInputFieldExpr = "expr";
+export const QueryClusterMetricInputFieldFrom = "from";
+export const QueryClusterMetricInputFieldInterval = "interval";
+export const QueryClusterMetricInputFieldTo = "to";
+
+export interface QueryClusterMetricInput {
+    clusterId?: string;
+    expr?: string;
+    from?: string;
+    interval?: string;
+    to?: string;
+}
+
+export const QueryProjectMetricInputType = "queryProjectMetricInput";
+export const QueryProjectMetricInputFieldExpr = "expr";
+export const QueryProjectMetricInputFieldFrom = "from";
+export const QueryProjectMetricInputFieldInterval = "interval";
+export const QueryProjectMetricInputFieldProjectName = "projectId";
+export const QueryProjectMetricInputFieldTo = "to";
+
+export interface QueryProjectMetricInput {
+    expr?: string;
+    from?: string;
+    interval?: string;
+    projectId?: string;
+    to?: string;
+}
+
+export const QueryMetricOutputType = "queryMetricOutput";
+export const QueryMetricOutputFieldSeries = "series";
+export const QueryMetricOutputFieldType = "type";
+
+export interface QueryMetricOutput {
+    series?: string[];
+    type?: string;
+}
+
+export const ClusterMetricNamesInputType = "clusterMetricNamesInput";
+export const ClusterMetricNamesInputFieldClusterName = "clusterId";
+
+export interface ClusterMetricNamesInput {
+    clusterId?: string;
+}
+
+export const ProjectMetricNamesInputType = "projectMetricNamesInput";
+export const ProjectMetricNamesInputFieldProjectName = "projectId";
+
+export interface ProjectMetricNamesInput {
+    projectId?: string;
+}
+
+export const MetricNamesOutputType = "metricNamesOutput";
+export const MetricNamesOutputFieldNames = "names";
+export const MetricNamesOutputFieldType = "type";
+
+export interface MetricNamesOutput {
+    names?: string[];
+    type?: string;
+}
+
+export const TimeSeriesType = "timeSeries";
+export const TimeSeriesFieldName = "name";
+export const TimeSeriesFieldPoints = "points";
+
+export interface TimeSeries {
+    name?: string;
+    points?: number[][];
+}
+
+export const MonitorMetricSpecType = "monitorMetricSpec";
+export const MonitorMetricSpecFieldDescription = "description";
+export const MonitorMetricSpecFieldExpression = "expression";
+export const MonitorMetricSpecFieldLegendFormat = "legendFormat";
+
+export interface MonitorMetricSpec {
+    description?: string;
+    expression?: string;
+    legendFormat?: string;
+}
+
+export const MonitorMetricType = "monitorMetric";
+export const MonitorMetricFieldAnnotations = "annotations";
+export const MonitorMetricFieldCreated = "created";
+export const MonitorMetricFieldCreatorID = "creatorId";
+export const MonitorMetricFieldDescription = "description";
+export const MonitorMetricFieldExpression = "expression";
+export const MonitorMetricFieldLabels = "labels";
+export const MonitorMetricFieldLegendFormat = "legendFormat";
+export const MonitorMetricFieldName = "name";
+export const MonitorMetricFieldNamespaceId = "namespaceId";
+export const MonitorMetricFieldOwnerReferences = "ownerReferences";
+export const MonitorMetricFieldRemoved = "removed";
+export const MonitorMetricFieldUUID = "uuid";
+export const MonitorMetricCollectionActionListclustermetricname = "listclustermetricname";
+export const MonitorMetricCollectionActionListprojectmetricname = "listprojectmetricname";
+export const MonitorMetricCollectionActionQuerycluster = "querycluster";
+export const MonitorMetricCollectionActionQueryproject = "queryproject";
+
+export interface MonitorMetric extends ApiResource {
+    annotations?: { [key: string]: string };
+    created?: string;
+    creatorId?: string;
+    description?: string;
+    expression?: string;
+    labels?: { [key: string]: string };
+    legendFormat?: string;
+    name?: string;
+    namespaceId?: string;
+    ownerReferences?: OwnerReference[];
+    removed?: string;
+    uuid?: string;
+}
+
+export interface MonitorMetricCollection extends ApiCollection {
+    data?: MonitorMetric[];
+}
+
+export class MonitorMetricClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<MonitorMetricCollection> {
+        return this.ops.DoList(MonitorMetricType, opts);
+    }
+
+    Next(collection: MonitorMetricCollection): Promise<MonitorMetricCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: MonitorMetric): Promise<MonitorMetric> {
+        return this.ops.DoCreate(MonitorMetricType, container);
+    }
+
+    Update(existing: MonitorMetric, updates: object): Promise<MonitorMetric> {
+        return this.ops.DoUpdate(MonitorMetricType, existing, updates);
+    }
+
+    Replace(obj: MonitorMetric): Promise<MonitorMetric> {
+        return this.ops.DoReplace(MonitorMetricType, obj, obj);
+    }
+
+    ByID(id: string): Promise<MonitorMetric> {
+        return this.ops.DoByID(MonitorMetricType, id);
+    }
+
+    Delete(container: MonitorMetric): Promise<void> {
+        return this.ops.DoResourceDelete(MonitorMetricType, container);
+    }
+
+    CollectionActionListclustermetricname(resource: MonitorMetricCollection, input: ClusterMetricNamesInput): Promise<MetricNamesOutput> {
+        return this.ops.DoCollectionAction(MonitorMetricType, MonitorMetricCollectionActionListclustermetricname, resource, input);
+    }
+
+    CollectionActionListprojectmetricname(resource: MonitorMetricCollection, input: ProjectMetricNamesInput): Promise<MetricNamesOutput> {
+        return this.ops.DoCollectionAction(MonitorMetricType, MonitorMetricCollectionActionListprojectmetricname, resource, input);
+    }
+
+    CollectionActionQuerycluster(resource: MonitorMetricCollection, input: QueryClusterMetricInput): Promise<QueryMetricOutput> {
+        return this.ops.DoCollectionAction(MonitorMetricType, MonitorMetricCollectionActionQuerycluster, resource, input);
+    }
+
+    CollectionActionQueryproject(resource: MonitorMetricCollection, input: QueryProjectMetricInput): Promise<QueryMetricOutput> {
+        return this.ops.DoCollectionAction(MonitorMetricType, MonitorMetricCollectionActionQueryproject, resource, input);
+    }
+}
+
+export const YAxisType = "yAxis";
+export const YAxisFieldUnit = "unit";
+
+export interface YAxis {
+    unit?: string;
+}
+
+export const ClusterMonitorGraphSpecType = "clusterMonitorGraphSpec";
+export const ClusterMonitorGraphSpecFieldClusterID = "clusterId";
+export const ClusterMonitorGraphSpecFieldDescription = "description";
+export const ClusterMonitorGraphSpecFieldDetailsMetricsSelector = "detailsMetricsSelector";
+export const ClusterMonitorGraphSpecFieldDisplayResourceType = "displayResourceType";
+export const ClusterMonitorGraphSpecFieldGraphType = "graphType";
+export const ClusterMonitorGraphSpecFieldMetricsSelector = "metricsSelector";
+export const ClusterMonitorGraphSpecFieldPriority = "priority";
+export const ClusterMonitorGraphSpecFieldResourceType = "resourceType";
+export const ClusterMonitorGraphSpecFieldYAxis = "yAxis";
+
+export interface ClusterMonitorGraphSpec {
+    clusterId?: string;
+    description?: string;
+    detailsMetricsSelector?: { [key: string]: string };
+    displayResourceType?: "node" | "cluster" | "etcd" | "kube-component" | "rancher-component";
+    graphType?: "graph" | "singlestat";
+    metricsSelector?: { [key: string]: string };
+    priority?: number;
+    resourceType?: "node" | "cluster" | "etcd" | "apiserver" | "scheduler" | "controllermanager" | "fluentd" | "istiocluster" | "istioproject";
+    yAxis?: YAxis;
+}
+
+export const ClusterMonitorGraphType = "clusterMonitorGraph";
+export const ClusterMonitorGraphFieldAnnotations = "annotations";
+export const ClusterMonitorGraphFieldClusterID = "clusterId";
+export const ClusterMonitorGraphFieldCreated = "created";
+export const ClusterMonitorGraphFieldCreatorID = "creatorId";
+export const ClusterMonitorGraphFieldDescription = "description";
+export const ClusterMonitorGraphFieldDetailsMetricsSelector = "detailsMetricsSelector";
+export const ClusterMonitorGraphFieldDisplayResourceType = "displayResourceType";
+export const ClusterMonitorGraphFieldGraphType = "graphType";
+export const ClusterMonitorGraphFieldLabels = "labels";
+export const ClusterMonitorGraphFieldMetricsSelector = "metricsSelector";
+export const ClusterMonitorGraphFieldName = "name";
+export const ClusterMonitorGraphFieldNamespaceId = "namespaceId";
+export const ClusterMonitorGraphFieldOwnerReferences = "ownerReferences";
+export const ClusterMonitorGraphFieldPriority = "priority";
+export const ClusterMonitorGraphFieldRemoved = "removed";
+export const ClusterMonitorGraphFieldResourceType = "resourceType";
+export const ClusterMonitorGraphFieldUUID = "uuid";
+export const ClusterMonitorGraphFieldYAxis = "yAxis";
+export const ClusterMonitorGraphCollectionActionQuery = "query";
+
+export interface ClusterMonitorGraph extends ApiResource {
+    annotations?: { [key: string]: string };
+    clusterId?: string;
+    created?: string;
+    creatorId?: string;
+    description?: string;
+    detailsMetricsSelector?: { [key: string]: string };
+    displayResourceType?: "node" | "cluster" | "etcd" | "kube-component" | "rancher-component";
+    graphType?: "graph" | "singlestat";
+    labels?: { [key: string]: string };
+    metricsSelector?: { [key: string]: string };
+    name?: string;
+    namespaceId?: string;
+    ownerReferences?: OwnerReference[];
+    priority?: number;
+    removed?: string;
+    resourceType?: "node" | "cluster" | "etcd" | "apiserver" | "scheduler" | "controllermanager" | "fluentd" | "istiocluster" | "istioproject";
+    uuid?: string;
+    yAxis?: YAxis;
+}
+
+export interface ClusterMonitorGraphCollection extends ApiCollection {
+    data?: ClusterMonitorGraph[];
+}
+
+export class ClusterMonitorGraphClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<ClusterMonitorGraphCollection> {
+        return this.ops.DoList(ClusterMonitorGraphType, opts);
+    }
+
+    Next(collection: ClusterMonitorGraphCollection): Promise<ClusterMonitorGraphCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: ClusterMonitorGraph): Promise<ClusterMonitorGraph> {
+        return this.ops.DoCreate(ClusterMonitorGraphType, container);
+    }
+
+    Update(existing: ClusterMonitorGraph, updates: object): Promise<ClusterMonitorGraph> {
+        return this.ops.DoUpdate(ClusterMonitorGraphType, existing, updates);
+    }
+
+    Replace(obj: ClusterMonitorGraph): Promise<ClusterMonitorGraph> {
+        return this.ops.DoReplace(ClusterMonitorGraphType, obj, obj);
+    }
+
+    ByID(id: string): Promise<ClusterMonitorGraph> {
+        return this.ops.DoByID(ClusterMonitorGraphType, id);
+    }
+
+    Delete(container: ClusterMonitorGraph): Promise<void> {
+        return this.ops.DoResourceDelete(ClusterMonitorGraphType, container);
+    }
+
+    CollectionActionQuery(resource: ClusterMonitorGraphCollection, input: QueryGraphInput): Promise<QueryClusterGraphOutput> {
+        return this.ops.DoCollectionAction(ClusterMonitorGraphType, ClusterMonitorGraphCollectionActionQuery, resource, input);
+    }
+}
+
+export const ProjectMonitorGraphSpecType = "projectMonitorGraphSpec";
+export const ProjectMonitorGraphSpecFieldDescription = "description";
+export const ProjectMonitorGraphSpecFieldDetailsMetricsSelector = "detailsMetricsSelector";
+export const ProjectMonitorGraphSpecFieldDisplayResourceType = "displayResourceType";
+export const ProjectMonitorGraphSpecFieldGraphType = "graphType";
+export const ProjectMonitorGraphSpecFieldMetricsSelector = "metricsSelector";
+export const ProjectMonitorGraphSpecFieldPriority = "priority";
+export const ProjectMonitorGraphSpecFieldProjectID = "projectId";
+export const ProjectMonitorGraphSpecFieldResourceType = "resourceType";
+export const ProjectMonitorGraphSpecFieldYAxis = "yAxis";
+
+export interface ProjectMonitorGraphSpec {
+    description?: string;
+    detailsMetricsSelector?: { [key: string]: string };
+    displayResourceType?: "workload" | "pod" | "container";
+    graphType?: "graph" | "singlestat";
+    metricsSelector?: { [key: string]: string };
+    priority?: number;
+    projectId?: string;
+    resourceType?: "workload" | "pod" | "container";
+    yAxis?: YAxis;
+}
+
+export const ProjectMonitorGraphType = "projectMonitorGraph";
+export const ProjectMonitorGraphFieldAnnotations = "annotations";
+export const ProjectMonitorGraphFieldCreated = "created";
+export const ProjectMonitorGraphFieldCreatorID = "creatorId";
+export const ProjectMonitorGraphFieldDescription = "description";
+export const ProjectMonitorGraphFieldDetailsMetricsSelector = "detailsMetricsSelector";
+export const ProjectMonitorGraphFieldDisplayResourceType = "displayResourceType";
+export const ProjectMonitorGraphFieldGraphType = "graphType";
+export const ProjectMonitorGraphFieldLabels = "labels";
+export const ProjectMonitorGraphFieldMetricsSelector = "metricsSelector";
+export const ProjectMonitorGraphFieldName = "name";
+export const ProjectMonitorGraphFieldNamespaceId = "namespaceId";
+export const ProjectMonitorGraphFieldOwnerReferences = "ownerReferences";
+export const ProjectMonitorGraphFieldPriority = "priority";
+export const ProjectMonitorGraphFieldProjectID = "projectId";
+export const ProjectMonitorGraphFieldRemoved = "removed";
+export const ProjectMonitorGraphFieldResourceType = "resourceType";
+export const ProjectMonitorGraphFieldUUID = "uuid";
+export const ProjectMonitorGraphFieldYAxis = "yAxis";
+export const ProjectMonitorGraphCollectionActionQuery = "query";
+
+export interface ProjectMonitorGraph extends ApiResource {
+    annotations?: { [key: string]: string };
+    created?: string;
+    creatorId?: string;
+    description?: string;
+    detailsMetricsSelector?: { [key: string]: string };
+    displayResourceType?: "workload" | "pod" | "container";
+    graphType?: "graph" | "singlestat";
+    labels?: { [key: string]: string };
+    metricsSelector?: { [key: string]: string };
+    name?: string;
+    namespaceId?: string;
+    ownerReferences?: OwnerReference[];
+    priority?: number;
+    projectId?: string;
+    removed?: string;
+    resourceType?: "workload" | "pod" | "container";
+    uuid?: string;
+    yAxis?: YAxis;
+}
+
+export interface ProjectMonitorGraphCollection extends ApiCollection {
+    data?: ProjectMonitorGraph[];
+}
+
+export class ProjectMonitorGraphClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<ProjectMonitorGraphCollection> {
+        return this.ops.DoList(ProjectMonitorGraphType, opts);
+    }
+
+    Next(collection: ProjectMonitorGraphCollection): Promise<ProjectMonitorGraphCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: ProjectMonitorGraph): Promise<ProjectMonitorGraph> {
+        return this.ops.DoCreate(ProjectMonitorGraphType, container);
+    }
+
+    Update(existing: ProjectMonitorGraph, updates: object): Promise<ProjectMonitorGraph> {
+        return this.ops.DoUpdate(ProjectMonitorGraphType, existing, updates);
+    }
+
+    Replace(obj: ProjectMonitorGraph): Promise<ProjectMonitorGraph> {
+        return this.ops.DoReplace(ProjectMonitorGraphType, obj, obj);
+    }
+
+    ByID(id: string): Promise<ProjectMonitorGraph> {
+        return this.ops.DoByID(ProjectMonitorGraphType, id);
+    }
+
+    Delete(container: ProjectMonitorGraph): Promise<void> {
+        return this.ops.DoResourceDelete(ProjectMonitorGraphType, container);
+    }
+
+    CollectionActionQuery(resource: ProjectMonitorGraphCollection, input: QueryGraphInput): Promise<QueryProjectGraphOutput> {
+        return this.ops.DoCollectionAction(ProjectMonitorGraphType, ProjectMonitorGraphCollectionActionQuery, resource, input);
+    }
+}
+
+export const CloudCredentialSpecType = "cloudCredentialSpec";
+export const CloudCredentialSpecFieldDescription = "description";
+export const CloudCredentialSpecFieldDisplayName = "displayName";
+
+export interface CloudCredentialSpec {
+    description?: string;
+    displayName?: string;
+}
+
+export const CloudCredentialType = "cloudCredential";
+export const CloudCredentialFieldAnnotations = "annotations";
+export const CloudCredentialFieldCreated = "created";
+export const CloudCredentialFieldCreatorID = "creatorId";
+export const CloudCredentialFieldDescription = "description";
+export const CloudCredentialFieldLabels = "labels";
+export const CloudCredentialFieldName = "name";
+export const CloudCredentialFieldOwnerReferences = "ownerReferences";
+export const CloudCredentialFieldRemoved = "removed";
+export const CloudCredentialFieldUUID = "uuid";
+
+export interface CloudCredential extends ApiResource {
+    annotations?: { [key: string]: string };
+    created?: string;
+    creatorId?: string;
+    description?: string;
+    labels?: { [key: string]: string };
+    name?: string;
+    ownerReferences?: OwnerReference[];
+    removed?: string;
+    uuid?: string;
+}
+
+export interface CloudCredentialCollection extends ApiCollection {
+    data?: CloudCredential[];
+}
+
+export class CloudCredentialClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<CloudCredentialCollection> {
+        return this.ops.DoList(CloudCredentialType, opts);
+    }
+
+    Next(collection: CloudCredentialCollection): Promise<CloudCredentialCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: CloudCredential): Promise<CloudCredential> {
+        return this.ops.DoCreate(CloudCredentialType, container);
+    }
+
+    Update(existing: CloudCredential, updates: object): Promise<CloudCredential> {
+        return this.ops.DoUpdate(CloudCredentialType, existing, updates);
+    }
+
+    Replace(obj: CloudCredential): Promise<CloudCredential> {
+        return this.ops.DoReplace(CloudCredentialType, obj, obj);
+    }
+
+    ByID(id: string): Promise<CloudCredential> {
+        return this.ops.DoByID(CloudCredentialType, id);
+    }
+
+    Delete(container: CloudCredential): Promise<void> {
+        return this.ops.DoResourceDelete(CloudCredentialType, container);
+    }
+}
+
+export const ManagementSecretType = "managementSecret";
+export const ManagementSecretFieldAnnotations = "annotations";
+export const ManagementSecretFieldCreated = "created";
+export const ManagementSecretFieldCreatorID = "creatorId";
+export const ManagementSecretFieldData = "data";
+export const ManagementSecretFieldLabels = "labels";
+export const ManagementSecretFieldName = "name";
+export const ManagementSecretFieldOwnerReferences = "ownerReferences";
+export const ManagementSecretFieldRemoved = "removed";
+export const ManagementSecretFieldStringData = "stringData";
+export const ManagementSecretFieldType = "type";
+export const ManagementSecretFieldUUID = "uuid";
+
+export interface ManagementSecret extends ApiResource {
+    annotations?: { [key: string]: string };
+    created?: string;
+    creatorId?: string;
+    data?: { [key: string]: string };
+    labels?: { [key: string]: string };
+    name?: string;
+    ownerReferences?: OwnerReference[];
+    removed?: string;
+    stringData?: { [key: string]: string };
+    type?: string;
+    uuid?: string;
+}
+
+export interface ManagementSecretCollection extends ApiCollection {
+    data?: ManagementSecret[];
+}
+
+export class ManagementSecretClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<ManagementSecretCollection> {
+        return this.ops.DoList(ManagementSecretType, opts);
+    }
+
+    Next(collection: ManagementSecretCollection): Promise<ManagementSecretCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: ManagementSecret): Promise<ManagementSecret> {
+        return this.ops.DoCreate(ManagementSecretType, container);
+    }
+
+    Update(existing: ManagementSecret, updates: object): Promise<ManagementSecret> {
+        return this.ops.DoUpdate(ManagementSecretType, existing, updates);
+    }
+
+    Replace(obj: ManagementSecret): Promise<ManagementSecret> {
+        return this.ops.DoReplace(ManagementSecretType, obj, obj);
+    }
+
+    ByID(id: string): Promise<ManagementSecret> {
+        return this.ops.DoByID(ManagementSecretType, id);
+    }
+
+    Delete(container: ManagementSecret): Promise<void> {
+        return this.ops.DoResourceDelete(ManagementSecretType, container);
+    }
+}
+
+export const ClusterTemplateQuestionsOutputType = "clusterTemplateQuestionsOutput";
+export const ClusterTemplateQuestionsOutputFieldQuestions = "questions";
+
+export interface ClusterTemplateQuestionsOutput {
+    questions?: Question[];
+}
+
+export const ClusterTemplateSpecType = "clusterTemplateSpec";
+export const ClusterTemplateSpecFieldDefaultRevisionID = "defaultRevisionId";
+export const ClusterTemplateSpecFieldDescription = "description";
+export const ClusterTemplateSpecFieldDisplayName = "displayName";
+export const ClusterTemplateSpecFieldMembers = "members";
+
+export interface ClusterTemplateSpec {
+    defaultRevisionId?: string;
+    description?: string;
+    displayName?: string;
+    members?: Member[];
+}
+
+export const ClusterTemplateType = "clusterTemplate";
+export const ClusterTemplateFieldAnnotations = "annotations";
+export const ClusterTemplateFieldCreated = "created";
+export const ClusterTemplateFieldCreatorID = "creatorId";
+export const ClusterTemplateFieldDefaultRevisionID = "defaultRevisionId";
+export const ClusterTemplateFieldDescription = "description";
+export const ClusterTemplateFieldLabels = "labels";
+export const ClusterTemplateFieldMembers = "members";
+export const ClusterTemplateFieldName = "name";
+export const ClusterTemplateFieldOwnerReferences = "ownerReferences";
+export const ClusterTemplateFieldRemoved = "removed";
+export const ClusterTemplateFieldUUID = "uuid";
+
+export interface ClusterTemplate extends ApiResource {
+    annotations?: { [key: string]: string };
+    created?: string;
+    creatorId?: string;
+    defaultRevisionId?: string;
+    description?: string;
+    labels?: { [key: string]: string };
+    members?: Member[];
+    name?: string;
+    ownerReferences?: OwnerReference[];
+    removed?: string;
+    uuid?: string;
+}
+
+export interface ClusterTemplateCollection extends ApiCollection {
+    data?: ClusterTemplate[];
+}
+
+export class ClusterTemplateClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<ClusterTemplateCollection> {
+        return this.ops.DoList(ClusterTemplateType, opts);
+    }
+
+    Next(collection: ClusterTemplateCollection): Promise<ClusterTemplateCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: ClusterTemplate): Promise<ClusterTemplate> {
+        return this.ops.DoCreate(ClusterTemplateType, container);
+    }
+
+    Update(existing: ClusterTemplate, updates: object): Promise<ClusterTemplate> {
+        return this.ops.DoUpdate(ClusterTemplateType, existing, updates);
+    }
+
+    Replace(obj: ClusterTemplate): Promise<ClusterTemplate> {
+        return this.ops.DoReplace(ClusterTemplateType, obj, obj);
+    }
+
+    ByID(id: string): Promise<ClusterTemplate> {
+        return this.ops.DoByID(ClusterTemplateType, id);
+    }
+
+    Delete(container: ClusterTemplate): Promise<void> {
+        return this.ops.DoResourceDelete(ClusterTemplateType, container);
+    }
+}
+
+export const ClusterSpecBaseType = "clusterSpecBase";
+export const ClusterSpecBaseFieldAgentImageOverride = "agentImageOverride";
+export const ClusterSpecBaseFieldDefaultClusterRoleForProjectMembers = "defaultClusterRoleForProjectMembers";
+export const ClusterSpecBaseFieldDefaultPodSecurityPolicyTemplateID = "defaultPodSecurityPolicyTemplateId";
+export const ClusterSpecBaseFieldDesiredAgentImage = "desiredAgentImage";
+export const ClusterSpecBaseFieldDesiredAuthImage = "desiredAuthImage";
+export const ClusterSpecBaseFieldDockerRootDir = "dockerRootDir";
+export const ClusterSpecBaseFieldEnableClusterAlerting = "enableClusterAlerting";
+export const ClusterSpecBaseFieldEnableClusterMonitoring = "enableClusterMonitoring";
+export const ClusterSpecBaseFieldEnableNetworkPolicy = "enableNetworkPolicy";
+export const ClusterSpecBaseFieldLocalClusterAuthEndpoint = "localClusterAuthEndpoint";
+export const ClusterSpecBaseFieldRancherKubernetesEngineConfig = "rancherKubernetesEngineConfig";
+export const ClusterSpecBaseFieldWindowsPreferedCluster = "windowsPreferedCluster";
+
+export interface ClusterSpecBase {
+    agentImageOverride?: string;
+    defaultClusterRoleForProjectMembers?: string;
+    defaultPodSecurityPolicyTemplateId?: string;
+    desiredAgentImage?: string;
+    desiredAuthImage?: string;
+    dockerRootDir?: string;
+    enableClusterAlerting?: boolean;
+    enableClusterMonitoring?: boolean;
+    enableNetworkPolicy?: boolean;
+    localClusterAuthEndpoint?: LocalClusterAuthEndpoint;
+    rancherKubernetesEngineConfig?: RancherKubernetesEngineConfig;
+    windowsPreferedCluster?: boolean;
+}
+
+export const ClusterTemplateRevisionSpecType = "clusterTemplateRevisionSpec";
+export const ClusterTemplateRevisionSpecFieldClusterConfig = "clusterConfig";
+export const ClusterTemplateRevisionSpecFieldClusterTemplateID = "clusterTemplateId";
+export const ClusterTemplateRevisionSpecFieldDisplayName = "displayName";
+export const ClusterTemplateRevisionSpecFieldEnabled = "enabled";
+export const ClusterTemplateRevisionSpecFieldQuestions = "questions";
+
+export interface ClusterTemplateRevisionSpec {
+    clusterConfig?: ClusterSpecBase;
+    clusterTemplateId?: string;
+    displayName?: string;
+    enabled?: boolean;
+    questions?: Question[];
+}
+
+export const ClusterTemplateRevisionType = "clusterTemplateRevision";
+export const ClusterTemplateRevisionFieldAnnotations = "annotations";
+export const ClusterTemplateRevisionFieldClusterConfig = "clusterConfig";
+export const ClusterTemplateRevisionFieldClusterTemplateID = "clusterTemplateId";
+export const ClusterTemplateRevisionFieldCreated = "created";
+export const ClusterTemplateRevisionFieldCreatorID = "creatorId";
+export const ClusterTemplateRevisionFieldEnabled = "enabled";
+export const ClusterTemplateRevisionFieldLabels = "labels";
+export const ClusterTemplateRevisionFieldName = "name";
+export const ClusterTemplateRevisionFieldOwnerReferences = "ownerReferences";
+export const ClusterTemplateRevisionFieldQuestions = "questions";
+export const ClusterTemplateRevisionFieldRemoved = "removed";
+export const ClusterTemplateRevisionFieldUUID = "uuid";
+export const ClusterTemplateRevisionActionDisable = "disable";
+export const ClusterTemplateRevisionActionEnable = "enable";
+export const ClusterTemplateRevisionCollectionActionListquestions = "listquestions";
+
+export interface ClusterTemplateRevision extends ApiResource {
+    annotations?: { [key: string]: string };
+    clusterConfig?: ClusterSpecBase;
+    clusterTemplateId?: string;
+    created?: string;
+    creatorId?: string;
+    enabled?: boolean;
+    labels?: { [key: string]: string };
+    name?: string;
+    ownerReferences?: OwnerReference[];
+    questions?: Question[];
+    removed?: string;
+    uuid?: string;
+}
+
+export interface ClusterTemplateRevisionCollection extends ApiCollection {
+    data?: ClusterTemplateRevision[];
+}
+
+export class ClusterTemplateRevisionClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<ClusterTemplateRevisionCollection> {
+        return this.ops.DoList(ClusterTemplateRevisionType, opts);
+    }
+
+    Next(collection: ClusterTemplateRevisionCollection): Promise<ClusterTemplateRevisionCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: ClusterTemplateRevision): Promise<ClusterTemplateRevision> {
+        return this.ops.DoCreate(ClusterTemplateRevisionType, container);
+    }
+
+    Update(existing: ClusterTemplateRevision, updates: object): Promise<ClusterTemplateRevision> {
+        return this.ops.DoUpdate(ClusterTemplateRevisionType, existing, updates);
+    }
+
+    Replace(obj: ClusterTemplateRevision): Promise<ClusterTemplateRevision> {
+        return this.ops.DoReplace(ClusterTemplateRevisionType, obj, obj);
+    }
+
+    ByID(id: string): Promise<ClusterTemplateRevision> {
+        return this.ops.DoByID(ClusterTemplateRevisionType, id);
+    }
+
+    Delete(container: ClusterTemplateRevision): Promise<void> {
+        return this.ops.DoResourceDelete(ClusterTemplateRevisionType, container);
+    }
+
+    ActionDisable(resource: ClusterTemplateRevision): Promise<void> {
+        return this.ops.DoAction(ClusterTemplateRevisionType, ClusterTemplateRevisionActionDisable, resource);
+    }
+
+    ActionEnable(resource: ClusterTemplateRevision): Promise<void> {
+        return this.ops.DoAction(ClusterTemplateRevisionType, ClusterTemplateRevisionActionEnable, resource);
+    }
+
+    CollectionActionListquestions(resource: ClusterTemplateRevisionCollection): Promise<ClusterTemplateQuestionsOutput> {
+        return this.ops.DoCollectionAction(ClusterTemplateRevisionType, ClusterTemplateRevisionCollectionActionListquestions, resource);
+    }
+}
+
+export const RKEK8sSystemImageType = "rkeK8sSystemImage";
+export const RKEK8sSystemImageFieldAnnotations = "annotations";
+export const RKEK8sSystemImageFieldCreated = "created";
+export const RKEK8sSystemImageFieldCreatorID = "creatorId";
+export const RKEK8sSystemImageFieldLabels = "labels";
+export const RKEK8sSystemImageFieldName = "name";
+export const RKEK8sSystemImageFieldOwnerReferences = "ownerReferences";
+export const RKEK8sSystemImageFieldRemoved = "removed";
+export const RKEK8sSystemImageFieldSystemImages = "systemImages";
+export const RKEK8sSystemImageFieldUUID = "uuid";
+
+export interface RKEK8sSystemImage extends ApiResource {
+    annotations?: { [key: string]: string };
+    created?: string;
+    creatorId?: string;
+    labels?: { [key: string]: string };
+    name?: string;
+    ownerReferences?: OwnerReference[];
+    removed?: string;
+    systemImages?: RKESystemImages;
+    uuid?: string;
+}
+
+export interface RKEK8sSystemImageCollection extends ApiCollection {
+    data?: RKEK8sSystemImage[];
+}
+
+export class RKEK8sSystemImageClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<RKEK8sSystemImageCollection> {
+        return this.ops.DoList(RKEK8sSystemImageType, opts);
+    }
+
+    Next(collection: RKEK8sSystemImageCollection): Promise<RKEK8sSystemImageCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: RKEK8sSystemImage): Promise<RKEK8sSystemImage> {
+        return this.ops.DoCreate(RKEK8sSystemImageType, container);
+    }
+
+    Update(existing: RKEK8sSystemImage, updates: object): Promise<RKEK8sSystemImage> {
+        return this.ops.DoUpdate(RKEK8sSystemImageType, existing, updates);
+    }
+
+    Replace(obj: RKEK8sSystemImage): Promise<RKEK8sSystemImage> {
+        return this.ops.DoReplace(RKEK8sSystemImageType, obj, obj);
+    }
+
+    ByID(id: string): Promise<RKEK8sSystemImage> {
+        return this.ops.DoByID(RKEK8sSystemImageType, id);
+    }
+
+    Delete(container: RKEK8sSystemImage): Promise<void> {
+        return this.ops.DoResourceDelete(RKEK8sSystemImageType, container);
+    }
+}
+
+export const KubernetesServicesOptionsType = "kubernetesServicesOptions";
+export const KubernetesServicesOptionsFieldEtcd = "etcd";
+export const KubernetesServicesOptionsFieldKubeAPI = "kubeapi";
+export const KubernetesServicesOptionsFieldKubeController = "kubeController";
+export const KubernetesServicesOptionsFieldKubelet = "kubelet";
+export const KubernetesServicesOptionsFieldKubeproxy = "kubeproxy";
+export const KubernetesServicesOptionsFieldScheduler = "scheduler";
+
+export interface KubernetesServicesOptions {
+    etcd?: { [key: string]: string };
+    kubeapi?: { [key: string]: string };
+    kubeController?: { [key: string]: string };
+    kubelet?: { [key: string]: string };
+    kubeproxy?: { [key: string]: string };
+    scheduler?: { [key: string]: string };
+}
+
+export const RKEK8sServiceOptionType = "rkeK8sServiceOption";
+export const RKEK8sServiceOptionFieldAnnotations = "annotations";
+export const RKEK8sServiceOptionFieldCreated = "created";
+export const RKEK8sServiceOptionFieldCreatorID = "creatorId";
+export const RKEK8sServiceOptionFieldLabels = "labels";
+export const RKEK8sServiceOptionFieldName = "name";
+export const RKEK8sServiceOptionFieldOwnerReferences = "ownerReferences";
+export const RKEK8sServiceOptionFieldRemoved = "removed";
+export const RKEK8sServiceOptionFieldServiceOptions = "serviceOptions";
+export const RKEK8sServiceOptionFieldUUID = "uuid";
+
+export interface RKEK8sServiceOption extends ApiResource {
+    annotations?: { [key: string]: string };
+    created?: string;
+    creatorId?: string;
+    labels?: { [key: string]: string };
+    name?: string;
+    ownerReferences?: OwnerReference[];
+    removed?: string;
+    serviceOptions?: KubernetesServicesOptions;
+    uuid?: string;
+}
+
+export interface RKEK8sServiceOptionCollection extends ApiCollection {
+    data?: RKEK8sServiceOption[];
+}
+
+export class RKEK8sServiceOptionClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<RKEK8sServiceOptionCollection> {
+        return this.ops.DoList(RKEK8sServiceOptionType, opts);
+    }
+
+    Next(collection: RKEK8sServiceOptionCollection): Promise<RKEK8sServiceOptionCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: RKEK8sServiceOption): Promise<RKEK8sServiceOption> {
+        return this.ops.DoCreate(RKEK8sServiceOptionType, container);
+    }
+
+    Update(existing: RKEK8sServiceOption, updates: object): Promise<RKEK8sServiceOption> {
+        return this.ops.DoUpdate(RKEK8sServiceOptionType, existing, updates);
+    }
+
+    Replace(obj: RKEK8sServiceOption): Promise<RKEK8sServiceOption> {
+        return this.ops.DoReplace(RKEK8sServiceOptionType, obj, obj);
+    }
+
+    ByID(id: string): Promise<RKEK8sServiceOption> {
+        return this.ops.DoByID(RKEK8sServiceOptionType, id);
+    }
+
+    Delete(container: RKEK8sServiceOption): Promise<void> {
+        return this.ops.DoResourceDelete(RKEK8sServiceOptionType, container);
+    }
+}
+
+export const RKEAddonType = "rkeAddon";
+export const RKEAddonFieldAnnotations = "annotations";
+export const RKEAddonFieldCreated = "created";
+export const RKEAddonFieldCreatorID = "creatorId";
+export const RKEAddonFieldLabels = "labels";
+export const RKEAddonFieldName = "name";
+export const RKEAddonFieldOwnerReferences = "ownerReferences";
+export const RKEAddonFieldRemoved = "removed";
+export const RKEAddonFieldTemplate = "template";
+export const RKEAddonFieldUUID = "uuid";
+
+export interface RKEAddon extends ApiResource {
+    annotations?: { [key: string]: string };
+    created?: string;
+    creatorId?: string;
+    labels?: { [key: string]: string };
+    name?: string;
+    ownerReferences?: OwnerReference[];
+    removed?: string;
+    template?: string;
+    uuid?: string;
+}
+
+export interface RKEAddonCollection extends ApiCollection {
+    data?: RKEAddon[];
+}
+
+export class RKEAddonClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<RKEAddonCollection> {
+        return this.ops.DoList(RKEAddonType, opts);
+    }
+
+    Next(collection: RKEAddonCollection): Promise<RKEAddonCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: RKEAddon): Promise<RKEAddon> {
+        return this.ops.DoCreate(RKEAddonType, container);
+    }
+
+    Update(existing: RKEAddon, updates: object): Promise<RKEAddon> {
+        return this.ops.DoUpdate(RKEAddonType, existing, updates);
+    }
+
+    Replace(obj: RKEAddon): Promise<RKEAddon> {
+        return this.ops.DoReplace(RKEAddonType, obj, obj);
+    }
+
+    ByID(id: string): Promise<RKEAddon> {
+        return this.ops.DoByID(RKEAddonType, id);
+    }
+
+    Delete(container: RKEAddon): Promise<void> {
+        return this.ops.DoResourceDelete(RKEAddonType, container);
+    }
+}
+
+export const KeyType = "key";
+export const KeyFieldName = "name";
+export const KeyFieldSecret = "secret";
+
+export interface Key {
+    name?: string;
+    secret?: string;
+}
+
+export const DurationType = "duration";
+
+export interface Duration {
+}
+
+export const KMSConfigurationType = "kmsConfiguration";
+export const KMSConfigurationFieldCacheSize = "cacheSize";
+export const KMSConfigurationFieldEndpoint = "endpoint";
+export const KMSConfigurationFieldName = "name";
+export const KMSConfigurationFieldTimeout = "timeout";
+
+export interface KMSConfiguration {
+    cacheSize?: number;
+    endpoint?: string;
+    name?: string;
+    timeout?: string;
+}
+
+export const ExampleSpecType = "exampleSpec";
+export const ExampleSpecFieldDisplayName = "displayName";
+
+export interface ExampleSpec {
+    displayName?: string;
+}
+
+export const ExampleType = "example";
+export const ExampleFieldAnnotations = "annotations";
+export const ExampleFieldCreated = "created";
+export const ExampleFieldCreatorID = "creatorId";
+export const ExampleFieldDisplayName = "displayName";
+export const ExampleFieldLabels = "labels";
+export const ExampleFieldName = "name";
+export const ExampleFieldOwnerReferences = "ownerReferences";
+export const ExampleFieldRemoved = "removed";
+export const ExampleFieldUUID = "uuid";
+
+export interface Example extends ApiResource {
+    annotations?: { [key: string]: string };
+    created?: string;
+    creatorId?: string;
+    displayName?: string;
+    labels?: { [key: string]: string };
+    name?: string;
+    ownerReferences?: OwnerReference[];
+    removed?: string;
+    uuid?: string;
+}
+
+export interface ExampleCollection extends ApiCollection {
+    data?: Example[];
+}
+
+export class ExampleClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<ExampleCollection> {
+        return this.ops.DoList(ExampleType, opts);
+    }
+
+    Next(collection: ExampleCollection): Promise<ExampleCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: Example): Promise<Example> {
+        return this.ops.DoCreate(ExampleType, container);
+    }
+
+    Update(existing: Example, updates: object): Promise<Example> {
+        return this.ops.DoUpdate(ExampleType, existing, updates);
+    }
+
+    Replace(obj: Example): Promise<Example> {
+        return this.ops.DoReplace(ExampleType, obj, obj);
+    }
+
+    ByID(id: string): Promise<Example> {
+        return this.ops.DoByID(ExampleType, id);
+    }
+
+    Delete(container: Example): Promise<void> {
+        return this.ops.DoResourceDelete(ExampleType, container);
+    }
+}
+
+export class Client {
+    NodePool: NodePoolClient;
+    Node: NodeClient;
+    NodeDriver: NodeDriverClient;
+    NodeTemplate: NodeTemplateClient;
+    Project: ProjectClient;
+    GlobalRole: GlobalRoleClient;
+    GlobalRoleBinding: GlobalRoleBindingClient;
+    RoleTemplate: RoleTemplateClient;
+    PodSecurityPolicyTemplate: PodSecurityPolicyTemplateClient;
+    PodSecurityPolicyTemplateProjectBinding: PodSecurityPolicyTemplateProjectBindingClient;
+    ClusterRoleTemplateBinding: ClusterRoleTemplateBindingClient;
+    ProjectRoleTemplateBinding: ProjectRoleTemplateBindingClient;
+    Cluster: ClusterClient;
+    ClusterRegistrationToken: ClusterRegistrationTokenClient;
+    Catalog: CatalogClient;
+    Template: TemplateClient;
+    CatalogTemplate: CatalogTemplateClient;
+    CatalogTemplateVersion: CatalogTemplateVersionClient;
+    TemplateVersion: TemplateVersionClient;
+    TemplateContent: TemplateContentClient;
+    Group: GroupClient;
+    GroupMember: GroupMemberClient;
+    Principal: PrincipalClient;
+    User: UserClient;
+    AuthConfig: AuthConfigClient;
+    LdapConfig: LdapConfigClient;
+    Token: TokenClient;
+    DynamicSchema: DynamicSchemaClient;
+    Preference: PreferenceClient;
+    ProjectNetworkPolicy: ProjectNetworkPolicyClient;
+    ClusterLogging: ClusterLoggingClient;
+    ProjectLogging: ProjectLoggingClient;
+    ListenConfig: ListenConfigClient;
+    Setting: SettingClient;
+    Feature: FeatureClient;
+    ClusterAlert: ClusterAlertClient;
+    ProjectAlert: ProjectAlertClient;
+    Notifier: NotifierClient;
+    ClusterAlertGroup: ClusterAlertGroupClient;
+    ProjectAlertGroup: ProjectAlertGroupClient;
+    ClusterAlertRule: ClusterAlertRuleClient;
+    ProjectAlertRule: ProjectAlertRuleClient;
+    ComposeConfig: ComposeConfigClient;
+    ProjectCatalog: ProjectCatalogClient;
+    ClusterCatalog: ClusterCatalogClient;
+    MultiClusterApp: MultiClusterAppClient;
+    MultiClusterAppRevision: MultiClusterAppRevisionClient;
+    GlobalDNS: GlobalDNSClient;
+    GlobalDNSProvider: GlobalDNSProviderClient;
+    KontainerDriver: KontainerDriverClient;
+    EtcdBackup: EtcdBackupClient;
+    ClusterScan: ClusterScanClient;
+    MonitorMetric: MonitorMetricClient;
+    ClusterMonitorGraph: ClusterMonitorGraphClient;
+    ProjectMonitorGraph: ProjectMonitorGraphClient;
+    CloudCredential: CloudCredentialClient;
+    ManagementSecret: ManagementSecretClient;
+    ClusterTemplate: ClusterTemplateClient;
+    ClusterTemplateRevision: ClusterTemplateRevisionClient;
+    RKEK8sSystemImage: RKEK8sSystemImageClient;
+    RKEK8sServiceOption: RKEK8sServiceOptionClient;
+    RKEAddon: RKEAddonClient;
+    Example: ExampleClient;
+
+    constructor(ops: ApiOps) {
+        this.NodePool = new NodePoolClient(ops);
+        this.Node = new NodeClient(ops);
+        this.NodeDriver = new NodeDriverClient(ops);
+        this.NodeTemplate = new NodeTemplateClient(ops);
+        this.Project = new ProjectClient(ops);
+        this.GlobalRole = new GlobalRoleClient(ops);
+        this.GlobalRoleBinding = new GlobalRoleBindingClient(ops);
+        this.RoleTemplate = new RoleTemplateClient(ops);
+        this.PodSecurityPolicyTemplate = new PodSecurityPolicyTemplateClient(ops);
+        this.PodSecurityPolicyTemplateProjectBinding = new PodSecurityPolicyTemplateProjectBindingClient(ops);
+        this.ClusterRoleTemplateBinding = new ClusterRoleTemplateBindingClient(ops);
+        this.ProjectRoleTemplateBinding = new ProjectRoleTemplateBindingClient(ops);
+        this.Cluster = new ClusterClient(ops);
+        this.ClusterRegistrationToken = new ClusterRegistrationTokenClient(ops);
+        this.Catalog = new CatalogClient(ops);
+        this.Template = new TemplateClient(ops);
+        this.CatalogTemplate = new CatalogTemplateClient(ops);
+        this.CatalogTemplateVersion = new CatalogTemplateVersionClient(ops);
+        this.TemplateVersion = new TemplateVersionClient(ops);
+        this.TemplateContent = new TemplateContentClient(ops);
+        this.Group = new GroupClient(ops);
+        this.GroupMember = new GroupMemberClient(ops);
+        this.Principal = new PrincipalClient(ops);
+        this.User = new UserClient(ops);
+        this.AuthConfig = new AuthConfigClient(ops);
+        this.LdapConfig = new LdapConfigClient(ops);
+        this.Token = new TokenClient(ops);
+        this.DynamicSchema = new DynamicSchemaClient(ops);
+        this.Preference = new PreferenceClient(ops);
+        this.ProjectNetworkPolicy = new ProjectNetworkPolicyClient(ops);
+        this.ClusterLogging = new ClusterLoggingClient(ops);
+        this.ProjectLogging = new ProjectLoggingClient(ops);
+        this.ListenConfig = new ListenConfigClient(ops);
+        this.Setting = new SettingClient(ops);
+        this.Feature = new FeatureClient(ops);
+        this.ClusterAlert = new ClusterAlertClient(ops);
+        this.ProjectAlert = new ProjectAlertClient(ops);
+        this.Notifier = new NotifierClient(ops);
+        this.ClusterAlertGroup = new ClusterAlertGroupClient(ops);
+        this.ProjectAlertGroup = new ProjectAlertGroupClient(ops);
+        this.ClusterAlertRule = new ClusterAlertRuleClient(ops);
+        this.ProjectAlertRule = new ProjectAlertRuleClient(ops);
+        this.ComposeConfig = new ComposeConfigClient(ops);
+        this.ProjectCatalog = new ProjectCatalogClient(ops);
+        this.ClusterCatalog = new ClusterCatalogClient(ops);
+        this.MultiClusterApp = new MultiClusterAppClient(ops);
+        this.MultiClusterAppRevision = new MultiClusterAppRevisionClient(ops);
+        this.GlobalDNS = new GlobalDNSClient(ops);
+        this.GlobalDNSProvider = new GlobalDNSProviderClient(ops);
+        this.KontainerDriver = new KontainerDriverClient(ops);
+        this.EtcdBackup = new EtcdBackupClient(ops);
+        this.ClusterScan = new ClusterScanClient(ops);
+        this.MonitorMetric = new MonitorMetricClient(ops);
+        this.ClusterMonitorGraph = new ClusterMonitorGraphClient(ops);
+        this.ProjectMonitorGraph = new ProjectMonitorGraphClient(ops);
+        this.CloudCredential = new CloudCredentialClient(ops);
+        this.ManagementSecret = new ManagementSecretClient(ops);
+        this.ClusterTemplate = new ClusterTemplateClient(ops);
+        this.ClusterTemplateRevision = new ClusterTemplateRevisionClient(ops);
+        this.RKEK8sSystemImage = new RKEK8sSystemImageClient(ops);
+        this.RKEK8sServiceOption = new RKEK8sServiceOptionClient(ops);
+        this.RKEAddon = new RKEAddonClient(ops);
+        this.Example = new ExampleClient(ops);
+    }
+}
@@ -0,0 +1,563 @@
+// Code generated from the management.cattle.io/v3public schemas. DO NOT EDIT.
+
+// The Api types are the norman API types shared by all schemas, their prefix
+// keeps them apart from schema types of the same name, such as Condition.
+
+export interface ApiResource {
+    id?: string;
+    type?: string;
+    links?: { [key: string]: string };
+    actions?: { [key: string]: string };
+}
+
+export interface ApiPagination {
+    marker?: string;
+    first?: string;
+    previous?: string;
+    next?: string;
+    last?: string;
+    limit?: number;
+    total?: number;
+    partial?: boolean;
+}
+
+export interface ApiSort {
+    name?: string;
+    order?: string;
+    reverse?: string;
+    links?: { [key: string]: string };
+}
+
+export interface ApiCondition {
+    modifier?: string;
+    value?: any;
+}
+
+export interface ApiCollection {
+    type?: string;
+    links?: { [key: string]: string };
+    createTypes?: { [key: string]: string };
+    actions?: { [key: string]: string };
+    pagination?: ApiPagination;
+    sort?: ApiSort;
+    filters?: { [key: string]: ApiCondition[] };
+    resourceType?: string;
+}
+
+export interface ApiListOpts {
+    filters?: { [key: string]: any };
+}
+
+// ApiOps performs the HTTP requests of the clients, it mirrors the
+// APIOperations of the Go client.
+export interface ApiOps {
+    DoList(schemaType: string, opts?: ApiListOpts): Promise<any>;
+    DoNext(nextURL: string): Promise<any>;
+    DoCreate(schemaType: string, createObj: object): Promise<any>;
+    DoReplace(schemaType: string, existing: ApiResource, updates: object): Promise<any>;
+    DoUpdate(schemaType: string, existing: ApiResource, updates: object): Promise<any>;
+    DoByID(schemaType: string, id: string): Promise<any>;
+    DoResourceDelete(schemaType: string, existing: ApiResource): Promise<void>;
+    DoAction(schemaType: string, action: string, existing: ApiResource, input?: object): Promise<any>;
+    DoCollectionAction(schemaType: string, action: string, existing: ApiCollection, input?: object): Promise<any>;
+}
+
+export const OwnerReferenceType = "ownerReference";
+export const OwnerReferenceFieldAPIVersion = "apiVersion";
+export const OwnerReferenceFieldBlockOwnerDeletion = "blockOwnerDeletion";
+export const OwnerReferenceFieldController = "controller";
+export const OwnerReferenceFieldKind = "kind";
+export const OwnerReferenceFieldName = "name";
+export const OwnerReferenceFieldUID = "uid";
+
+export interface OwnerReference {
+    apiVersion?: string;
+    blockOwnerDeletion?: boolean;
+    controller?: boolean;
+    kind?: string;
+    name?: string;
+    uid?: string;
+}
+
+export const ObjectMetaType = "objectMeta";
+export const ObjectMetaFieldAnnotations = "annotations";
+export const ObjectMetaFieldCreated = "created";
+export const ObjectMetaFieldFinalizers = "finalizers";
+export const ObjectMetaFieldLabels = "labels";
+export const ObjectMetaFieldName = "name";
+export const ObjectMetaFieldNamespace = "namespace";
+export const ObjectMetaFieldOwnerReferences = "ownerReferences";
+export const ObjectMetaFieldRemoved = "removed";
+export const ObjectMetaFieldSelfLink = "selfLink";
+export const ObjectMetaFieldUUID = "uuid";
+
+export interface ObjectMeta {
+    annotations?: { [key: string]: string };
+    created?: string;
+    finalizers?: string[];
+    labels?: { [key: string]: string };
+    name?: string;
+    namespace?: string;
+    ownerReferences?: OwnerReference[];
+    removed?: string;
+    selfLink?: string;
+    uuid?: string;
+}
+
+export const TokenType = "token";
+export const TokenFieldAnnotations = "annotations";
+export const TokenFieldAuthProvider = "authProvider";
+export const TokenFieldClusterID = "clusterId";
+export const TokenFieldCreated = "created";
+export const TokenFieldCreatorID = "creatorId";
+export const TokenFieldCurrent = "current";
+export const TokenFieldDescription = "description";
+export const TokenFieldEnabled = "enabled";
+export const TokenFieldExpired = "expired";
+export const TokenFieldExpiresAt = "expiresAt";
+export const TokenFieldGroupPrincipals = "groupPrincipals";
+export const TokenFieldIsDerived = "isDerived";
+export const TokenFieldLabels = "labels";
+export const TokenFieldLastUpdateTime = "lastUpdateTime";
+export const TokenFieldName = "name";
+export const TokenFieldOwnerReferences = "ownerReferences";
+export const TokenFieldProviderInfo = "providerInfo";
+export const TokenFieldRemoved = "removed";
+export const TokenFieldTTLMillis = "ttl";
+export const TokenFieldToken = "token";
+export const TokenFieldUUID = "uuid";
+export const TokenFieldUserID = "userId";
+export const TokenFieldUserPrincipal = "userPrincipal";
+
+export interface Token {
+    annotations?: { [key: string]: string };
+    authProvider?: string;
+    clusterId?: string;
+    created?: string;
+    creatorId?: string;
+    current?: boolean;
+    description?: string;
+    enabled?: boolean;
+    expired?: boolean;
+    expiresAt?: string;
+    groupPrincipals?: string[];
+    isDerived?: boolean;
+    labels?: { [key: string]: string };
+    lastUpdateTime?: string;
+    name?: string;
+    ownerReferences?: OwnerReference[];
+    providerInfo?: { [key: string]: string };
+    removed?: string;
+    ttl?: number;
+    token?: string;
+    uuid?: string;
+    userId?: string;
+    userPrincipal?: string;
+}
+
+export const AuthProviderType = "authProvider";
+export const AuthProviderFieldAnnotations = "annotations";
+export const AuthProviderFieldCreated = "created";
+export const AuthProviderFieldCreatorID = "creatorId";
+export const AuthProviderFieldLabels = "labels";
+export const AuthProviderFieldName = "name";
+export const AuthProviderFieldOwnerReferences = "ownerReferences";
+export const AuthProviderFieldRemoved = "removed";
+export const AuthProviderFieldType = "type";
+export const AuthProviderFieldUUID = "uuid";
+
+export interface AuthProvider extends ApiResource {
+    annotations?: { [key: string]: string };
+    created?: string;
+    creatorId?: string;
+    labels?: { [key: string]: string };
+    name?: string;
+    ownerReferences?: OwnerReference[];
+    removed?: string;
+    type?: string;
+    uuid?: string;
+}
+
+export interface AuthProviderCollection extends ApiCollection {
+    data?: AuthProvider[];
+}
+
+export class AuthProviderClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<AuthProviderCollection> {
+        return this.ops.DoList(AuthProviderType, opts);
+    }
+
+    Next(collection: AuthProviderCollection): Promise<AuthProviderCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: AuthProvider): Promise<AuthProvider> {
+        return this.ops.DoCreate(AuthProviderType, container);
+    }
+
+    Update(existing: AuthProvider, updates: object): Promise<AuthProvider> {
+        return this.ops.DoUpdate(AuthProviderType, existing, updates);
+    }
+
+    Replace(obj: AuthProvider): Promise<AuthProvider> {
+        return this.ops.DoReplace(AuthProviderType, obj, obj);
+    }
+
+    ByID(id: string): Promise<AuthProvider> {
+        return this.ops.DoByID(AuthProviderType, id);
+    }
+
+    Delete(container: AuthProvider): Promise<void> {
+        return this.ops.DoResourceDelete(AuthProviderType, container);
+    }
+}
+
+export const LocalProviderType = "localProvider";
+export const LocalProviderFieldAnnotations = "annotations";
+export const LocalProviderFieldCreated = "created";
+export const LocalProviderFieldCreatorID = "creatorId";
+export const LocalProviderFieldLabels = "labels";
+export const LocalProviderFieldName = "name";
+export const LocalProviderFieldOwnerReferences = "ownerReferences";
+export const LocalProviderFieldRemoved = "removed";
+export const LocalProviderFieldType = "type";
+export const LocalProviderFieldUUID = "uuid";
+
+export interface LocalProvider {
+    annotations?: { [key: string]: string };
+    created?: string;
+    creatorId?: string;
+    labels?: { [key: string]: string };
+    name?: string;
+    ownerReferences?: OwnerReference[];
+    removed?: string;
+    type?: string;
+    uuid?: string;
+}
+
+export const BasicLoginType = "basicLogin";
+export const BasicLoginFieldDescription = "description";
+export const BasicLoginFieldPassword = "password";
+export const BasicLoginFieldResponseType = "responseType";
+export const BasicLoginFieldTTLMillis = "ttl";
+export const BasicLoginFieldUsername = "username";
+
+export interface BasicLogin {
+    description?: string;
+    password?: string;
+    responseType?: string;
+    ttl?: number;
+    username?: string;
+}
+
+export const GithubProviderType = "githubProvider";
+export const GithubProviderFieldAnnotations = "annotations";
+export const GithubProviderFieldCreated = "created";
+export const GithubProviderFieldCreatorID = "creatorId";
+export const GithubProviderFieldLabels = "labels";
+export const GithubProviderFieldName = "name";
+export const GithubProviderFieldOwnerReferences = "ownerReferences";
+export const GithubProviderFieldRedirectURL = "redirectUrl";
+export const GithubProviderFieldRemoved = "removed";
+export const GithubProviderFieldType = "type";
+export const GithubProviderFieldUUID = "uuid";
+
+export interface GithubProvider {
+    annotations?: { [key: string]: string };
+    created?: string;
+    creatorId?: string;
+    labels?: { [key: string]: string };
+    name?: string;
+    ownerReferences?: OwnerReference[];
+    redirectUrl?: string;
+    removed?: string;
+    type?: string;
+    uuid?: string;
+}
+
+export const GithubLoginType = "githubLogin";
+export const GithubLoginFieldCode = "code";
+export const GithubLoginFieldDescription = "description";
+export const GithubLoginFieldResponseType = "responseType";
+export const GithubLoginFieldTTLMillis = "ttl";
+
+export interface GithubLogin {
+    code?: string;
+    description?: string;
+    responseType?: string;
+    ttl?: number;
+}
+
+export const GoogleOAuthProviderType = "googleOAuthProvider";
+export const GoogleOAuthProviderFieldAnnotations = "annotations";
+export const GoogleOAuthProviderFieldCreated = "created";
+export const GoogleOAuthProviderFieldCreatorID = "creatorId";
+export const GoogleOAuthProviderFieldLabels = "labels";
+export const GoogleOAuthProviderFieldName = "name";
+export const GoogleOAuthProviderFieldOwnerReferences = "ownerReferences";
+export const GoogleOAuthProviderFieldRedirectURL = "redirectUrl";
+export const GoogleOAuthProviderFieldRemoved = "removed";
+export const GoogleOAuthProviderFieldType = "type";
+export const GoogleOAuthProviderFieldUUID = "uuid";
+
+export interface GoogleOAuthProvider {
+    annotations?: { [key: string]: string };
+    created?: string;
+    creatorId?: string;
+    labels?: { [key: string]: string };
+    name?: string;
+    ownerReferences?: OwnerReference[];
+    redirectUrl?: string;
+    removed?: string;
+    type?: string;
+    uuid?: string;
+}
+
+export const GoogleOauthLoginType = "googleOauthLogin";
+export const GoogleOauthLoginFieldCode = "code";
+export const GoogleOauthLoginFieldDescription = "description";
+export const GoogleOauthLoginFieldResponseType = "responseType";
+export const GoogleOauthLoginFieldTTLMillis = "ttl";
+
+export interface GoogleOauthLogin {
+    code?: string;
+    description?: string;
+    responseType?: string;
+    ttl?: number;
+}
+
+export const ActiveDirectoryProviderType = "activeDirectoryProvider";
+export const ActiveDirectoryProviderFieldAnnotations = "annotations";
+export const ActiveDirectoryProviderFieldCreated = "created";
+export const ActiveDirectoryProviderFieldCreatorID = "creatorId";
+export const ActiveDirectoryProviderFieldDefaultLoginDomain = "defaultLoginDomain";
+export const ActiveDirectoryProviderFieldLabels = "labels";
+export const ActiveDirectoryProviderFieldName = "name";
+export const ActiveDirectoryProviderFieldOwnerReferences = "ownerReferences";
+export const ActiveDirectoryProviderFieldRemoved = "removed";
+export const ActiveDirectoryProviderFieldType = "type";
+export const ActiveDirectoryProviderFieldUUID = "uuid";
+
+export interface ActiveDirectoryProvider {
+    annotations?: { [key: string]: string };
+    created?: string;
+    creatorId?: string;
+    defaultLoginDomain?: string;
+    labels?: { [key: string]: string };
+    name?: string;
+    ownerReferences?: OwnerReference[];
+    removed?: string;
+    type?: string;
+    uuid?: string;
+}
+
+export const AzureADProviderType = "azureADProvider";
+export const AzureADProviderFieldAnnotations = "annotations";
+export const AzureADProviderFieldCreated = "created";
+export const AzureADProviderFieldCreatorID = "creatorId";
+export const AzureADProviderFieldLabels = "labels";
+export const AzureADProviderFieldName = "name";
+export const AzureADProviderFieldOwnerReferences = "ownerReferences";
+export const AzureADProviderFieldRedirectURL = "redirectUrl";
+export const AzureADProviderFieldRemoved = "removed";
+export const AzureADProviderFieldType = "type";
+export const AzureADProviderFieldUUID = "uuid";
+
+export interface AzureADProvider {
+    annotations?: { [key: string]: string };
+    created?: string;
+    creatorId?: string;
+    labels?: { [key: string]: string };
+    name?: string;
+    ownerReferences?: OwnerReference[];
+    redirectUrl?: string;
+    removed?: string;
+    type?: string;
+    uuid?: string;
+}
+
+export const AzureADLoginType = "azureADLogin";
+export const AzureADLoginFieldCode = "code";
+export const AzureADLoginFieldDescription = "description";
+export const AzureADLoginFieldResponseType = "responseType";
+export const AzureADLoginFieldTTLMillis = "ttl";
+
+export interface AzureADLogin {
+    code?: string;
+    description?: string;
+    responseType?: string;
+    ttl?: number;
+}
+
+export const PingProviderType = "pingProvider";
+export const PingProviderFieldAnnotations = "annotations";
+export const PingProviderFieldCreated = "created";
+export const PingProviderFieldCreatorID = "creatorId";
+export const PingProviderFieldLabels = "labels";
+export const PingProviderFieldName = "name";
+export const PingProviderFieldOwnerReferences = "ownerReferences";
+export const PingProviderFieldRedirectURL = "redirectUrl";
+export const PingProviderFieldRemoved = "removed";
+export const PingProviderFieldType = "type";
+export const PingProviderFieldUUID = "uuid";
+
+export interface PingProvider {
+    annotations?: { [key: string]: string };
+    created?: string;
+    creatorId?: string;
+    labels?: { [key: string]: string };
+    name?: string;
+    ownerReferences?: OwnerReference[];
+    redirectUrl?: string;
+    removed?: string;
+    type?: string;
+    uuid?: string;
+}
+
+export const ADFSProviderType = "adfsProvider";
+export const ADFSProviderFieldAnnotations = "annotations";
+export const ADFSProviderFieldCreated = "created";
+export const ADFSProviderFieldCreatorID = "creatorId";
+export const ADFSProviderFieldLabels = "labels";
+export const ADFSProviderFieldName = "name";
+export const ADFSProviderFieldOwnerReferences = "ownerReferences";
+export const ADFSProviderFieldRedirectURL = "redirectUrl";
+export const ADFSProviderFieldRemoved = "removed";
+export const ADFSProviderFieldType = "type";
+export const ADFSProviderFieldUUID = "uuid";
+
+export interface ADFSProvider {
+    annotations?: { [key: string]: string };
+    created?: string;
+    creatorId?: string;
+    labels?: { [key: string]: string };
+    name?: string;
+    ownerReferences?: OwnerReference[];
+    redirectUrl?: string;
+    removed?: string;
+    type?: string;
+    uuid?: string;
+}
+
+export const KeyCloakProviderType = "keyCloakProvider";
+export const KeyCloakProviderFieldAnnotations = "annotations";
+export const KeyCloakProviderFieldCreated = "created";
+export const KeyCloakProviderFieldCreatorID = "creatorId";
+export const KeyCloakProviderFieldLabels = "labels";
+export const KeyCloakProviderFieldName = "name";
+export const KeyCloakProviderFieldOwnerReferences = "ownerReferences";
+export const KeyCloakProviderFieldRedirectURL = "redirectUrl";
+export const KeyCloakProviderFieldRemoved = "removed";
+export const KeyCloakProviderFieldType = "type";
+export const KeyCloakProviderFieldUUID = "uuid";
+
+export interface KeyCloakProvider {
+    annotations?: { [key: string]: string };
+    created?: string;
+    creatorId?: string;
+    labels?: { [key: string]: string };
+    name?: string;
+    ownerReferences?: OwnerReference[];
+    redirectUrl?: string;
+    removed?: string;
+    type?: string;
+    uuid?: string;
+}
+
+export const OKTAProviderType = "oktaProvider";
+export const OKTAProviderFieldAnnotations = "annotations";
+export const OKTAProviderFieldCreated = "created";
+export const OKTAProviderFieldCreatorID = "creatorId";
+export const OKTAProviderFieldLabels = "labels";
+export const OKTAProviderFieldName = "name";
+export const OKTAProviderFieldOwnerReferences = "ownerReferences";
+export const OKTAProviderFieldRedirectURL = "redirectUrl";
+export const OKTAProviderFieldRemoved = "removed";
+export const OKTAProviderFieldType = "type";
+export const OKTAProviderFieldUUID = "uuid";
+
+export interface OKTAProvider {
+    annotations?: { [key: string]: string };
+    created?: string;
+    creatorId?: string;
+    labels?: { [key: string]: string };
+    name?: string;
+    ownerReferences?: OwnerReference[];
+    redirectUrl?: string;
+    removed?: string;
+    type?: string;
+    uuid?: string;
+}
+
+export const SamlLoginInputType = "samlLoginInput";
+export const SamlLoginInputFieldFinalRedirectURL = "finalRedirectUrl";
+
+export interface SamlLoginInput {
+    finalRedirectUrl?: string;
+}
+
+export const SamlLoginOutputType = "samlLoginOutput";
+export const SamlLoginOutputFieldIdpRedirectURL = "idpRedirectUrl";
+
+export interface SamlLoginOutput {
+    idpRedirectUrl?: string;
+}
+
+export const OpenLdapProviderType = "openLdapProvider";
+export const OpenLdapProviderFieldAnnotations = "annotations";
+export const OpenLdapProviderFieldCreated = "created";
+export const OpenLdapProviderFieldCreatorID = "creatorId";
+export const OpenLdapProviderFieldLabels = "labels";
+export const OpenLdapProviderFieldName = "name";
+export const OpenLdapProviderFieldOwnerReferences = "ownerReferences";
+export const OpenLdapProviderFieldRemoved = "removed";
+export const OpenLdapProviderFieldType = "type";
+export const OpenLdapProviderFieldUUID = "uuid";
+
+export interface OpenLdapProvider {
+    annotations?: { [key: string]: string };
+    created?: string;
+    creatorId?: string;
+    labels?: { [key: string]: string };
+    name?: string;
+    ownerReferences?: OwnerReference[];
+    removed?: string;
+    type?: string;
+    uuid?: string;
+}
+
+export const FreeIpaProviderType = "freeIpaProvider";
+export const FreeIpaProviderFieldAnnotations = "annotations";
+export const FreeIpaProviderFieldCreated = "created";
+export const FreeIpaProviderFieldCreatorID = "creatorId";
+export const FreeIpaProviderFieldLabels = "labels";
+export const FreeIpaProviderFieldName = "name";
+export const FreeIpaProviderFieldOwnerReferences = "ownerReferences";
+export const FreeIpaProviderFieldRemoved = "removed";
+export const FreeIpaProviderFieldType = "type";
+export const FreeIpaProviderFieldUUID = "uuid";
+
+export interface FreeIpaProvider {
+    annotations?: { [key: string]: string };
+    created?: string;
+    creatorId?: string;
+    labels?: { [key: string]: string };
+    name?: string;
+    ownerReferences?: OwnerReference[];
+    removed?: string;
+    type?: string;
+    uuid?: string;
+}
+
+export class Client {
+    AuthProvider: AuthProviderClient;
+
+    constructor(ops: ApiOps) {
+        this.AuthProvider = new AuthProviderClient(ops);
+    }
+}
@@ -0,0 +1,9531 @@
+// Code generated from the project.cattle.io/v3 schemas. DO NOT EDIT.
+
+// The Api types are the norman API types shared by all schemas, their prefix
+// keeps them apart from schema types of the same name, such as Condition.
+
+export interface ApiResource {
+    id?: string;
+    type?: string;
+    links?: { [key: string]: string };
+    actions?: { [key: string]: string };
+}
+
+export interface ApiPagination {
+    marker?: string;
+    first?: string;
+    previous?: string;
+    next?: string;
+    last?: string;
+    limit?: number;
+    total?: number;
+    partial?: boolean;
+}
+
+export interface ApiSort {
+    name?: string;
+    order?: string;
+    reverse?: string;
+    links?: { [key: string]: string };
+}
+
+export interface ApiCondition {
+    modifier?: string;
+    value?: any;
+}
+
+export interface ApiCollection {
+    type?: string;
+    links?: { [key: string]: string };
+    createTypes?: { [key: string]: string };
+    actions?: { [key: string]: string };
+    pagination?: ApiPagination;
+    sort?: ApiSort;
+    filters?: { [key: string]: ApiCondition[] };
+    resourceType?: string;
+}
+
+export interface ApiListOpts {
+    filters?: { [key: string]: any };
+}
+
+// ApiOps performs the HTTP requests of the clients, it mirrors the
+// APIOperations of the Go client.
+export interface ApiOps {
+    DoList(schemaType: string, opts?: ApiListOpts): Promise<any>;
+    DoNext(nextURL: string): Promise<any>;
+    DoCreate(schemaType: string, createObj: object): Promise<any>;
+    DoReplace(schemaType: string, existing: ApiResource, updates: object): Promise<any>;
+    DoUpdate(schemaType: string, existing: ApiResource, updates: object): Promise<any>;
+    DoByID(schemaType: string, id: string): Promise<any>;
+    DoResourceDelete(schemaType: string, existing: ApiResource): Promise<void>;
+    DoAction(schemaType: string, action: string, existing: ApiResource, input?: object): Promise<any>;
+    DoCollectionAction(schemaType: string, action: string, existing: ApiCollection, input?: object): Promise<any>;
+}
+
+export const PersistentVolumeClaimVolumeSourceType = "persistentVolumeClaimVolumeSource";
+export const PersistentVolumeClaimVolumeSourceFieldPersistentVolumeClaimID = "persistentVolumeClaimId";
+export const PersistentVolumeClaimVolumeSourceFieldReadOnly = "readOnly";
+
+export interface PersistentVolumeClaimVolumeSource {
+    persistentVolumeClaimId?: string;
+    readOnly?: boolean;
+}
+
+export const KeyToPathType = "keyToPath";
+export const KeyToPathFieldKey = "key";
+export const KeyToPathFieldMode = "mode";
+export const KeyToPathFieldPath = "path";
+
+export interface KeyToPath {
+    key?: string;
+    mode?: number;
+    path?: string;
+}
+
+export const SecretVolumeSourceType = "secretVolumeSource";
+export const SecretVolumeSourceFieldDefaultMode = "defaultMode";
+export const SecretVolumeSourceFieldItems = "items";
+export const SecretVolumeSourceFieldOptional = "optional";
+export const SecretVolumeSourceFieldSecretName = "secretName";
+
+export interface SecretVolumeSource {
+    defaultMode?: number;
+    items?: KeyToPath[];
+    optional?: boolean;
+    secretName?: string;
+}
+
+export const VolumeMountType = "volumeMount";
+export const VolumeMountFieldMountPath = "mountPath";
+export const VolumeMountFieldMountPropagation = "mountPropagation";
+export const VolumeMountFieldName = "name";
+export const VolumeMountFieldReadOnly = "readOnly";
+export const VolumeMountFieldSubPath = "subPath";
+export const VolumeMountFieldSubPathExpr = "subPathExpr";
+
+export interface VolumeMount {
+    mountPath?: string;
+    mountPropagation?: string;
+    name?: string;
+    readOnly?: boolean;
+    subPath?: string;
+    subPathExpr?: string;
+}
+
+export const HostPathVolumeSourceType = "hostPathVolumeSource";
+export const HostPathVolumeSourceFieldKind = "kind";
+export const HostPathVolumeSourceFieldPath = "path";
+
+export interface HostPathVolumeSource {
+    kind?: "DirectoryOrCreate" | "Directory" | "FileOrCreate" | "File" | "Socket" | "CharDevice" | "BlockDevice";
+    path?: string;
+}
+
+export const EmptyDirVolumeSourceType = "emptyDirVolumeSource";
+export const EmptyDirVolumeSourceFieldMedium = "medium";
+export const EmptyDirVolumeSourceFieldSizeLimit = "sizeLimit";
+
+export interface EmptyDirVolumeSource {
+    medium?: string;
+    sizeLimit?: string;
+}
+
+export const GCEPersistentDiskVolumeSourceType = "gcePersistentDiskVolumeSource";
+export const GCEPersistentDiskVolumeSourceFieldFSType = "fsType";
+export const GCEPersistentDiskVolumeSourceFieldPDName = "pdName";
+export const GCEPersistentDiskVolumeSourceFieldPartition = "partition";
+export const GCEPersistentDiskVolumeSourceFieldReadOnly = "readOnly";
+
+export interface GCEPersistentDiskVolumeSource {
+    fsType?: string;
+    pdName?: string;
+    partition?: number;
+    readOnly?: boolean;
+}
+
+export const AWSElasticBlockStoreVolumeSourceType = "awsElasticBlockStoreVolumeSource";
+export const AWSElasticBlockStoreVolumeSourceFieldFSType = "fsType";
+export const AWSElasticBlockStoreVolumeSourceFieldPartition = "partition";
+export const AWSElasticBlockStoreVolumeSourceFieldReadOnly = "readOnly";
+export const AWSElasticBlockStoreVolumeSourceFieldVolumeID = "volumeID";
+
+export interface AWSElasticBlockStoreVolumeSource {
+    fsType?: string;
+    partition?: number;
+    readOnly?: boolean;
+    volumeID?: string;
+}
+
+export const GitRepoVolumeSourceType = "gitRepoVolumeSource";
+export const GitRepoVolumeSourceFieldDirectory = "directory";
+export const GitRepoVolumeSourceFieldRepository = "repository";
+export const GitRepoVolumeSourceFieldRevision = "revision";
+
+export interface GitRepoVolumeSource {
+    directory?: string;
+    repository?: string;
+    revision?: string;
+}
+
+export const NFSVolumeSourceType = "nfsVolumeSource";
+export const NFSVolumeSourceFieldPath = "path";
+export const NFSVolumeSourceFieldReadOnly = "readOnly";
+export const NFSVolumeSourceFieldServer = "server";
+
+export interface NFSVolumeSource {
+    path?: string;
+    readOnly?: boolean;
+    server?: string;
+}
+
+export const LocalObjectReferenceType = "localObjectReference";
+export const LocalObjectReferenceFieldName = "name";
+
+export interface LocalObjectReference {
+    name?: string;
+}
+
+export const ISCSIVolumeSourceType = "iscsiVolumeSource";
+export const ISCSIVolumeSourceFieldDiscoveryCHAPAuth = "chapAuthDiscovery";
+export const ISCSIVolumeSourceFieldFSType = "fsType";
+export const ISCSIVolumeSourceFieldIQN = "iqn";
+export const ISCSIVolumeSourceFieldISCSIInterface = "iscsiInterface";
+export const ISCSIVolumeSourceFieldInitiatorName = "initiatorName";
+export const ISCSIVolumeSourceFieldLun = "lun";
+export const ISCSIVolumeSourceFieldPortals = "portals";
+export const ISCSIVolumeSourceFieldReadOnly = "readOnly";
+export const ISCSIVolumeSourceFieldSecretRef = "secretRef";
+export const ISCSIVolumeSourceFieldSessionCHAPAuth = "chapAuthSession";
+export const ISCSIVolumeSourceFieldTargetPortal = "targetPortal";
+
+export interface ISCSIVolumeSource {
+    chapAuthDiscovery?: boolean;
+    fsType?: string;
+    iqn?: string;
+    iscsiInterface?: string;
+    initiatorName?: string;
+    lun?: number;
+    portals?: string[];
+    readOnly?: boolean;
+    secretRef?: LocalObjectReference;
+    chapAuthSession?: boolean;
+    targetPortal?: string;
+}
+
+export const GlusterfsVolumeSourceType = "glusterfsVolumeSource";
+export const GlusterfsVolumeSourceFieldEndpointsName = "endpoints";
+export const GlusterfsVolumeSourceFieldPath = "path";
+export const GlusterfsVolumeSourceFieldReadOnly = "readOnly";
+
+export interface GlusterfsVolumeSource {
+    endpoints?: string;
+    path?: string;
+    readOnly?: boolean;
+}
+
+export const RBDVolumeSourceType = "rbdVolumeSource";
+export const RBDVolumeSourceFieldCephMonitors = "monitors";
+export const RBDVolumeSourceFieldFSType = "fsType";
+export const RBDVolumeSourceFieldKeyring = "keyring";
+export const RBDVolumeSourceFieldRBDImage = "image";
+export const RBDVolumeSourceFieldRBDPool = "pool";
+export const RBDVolumeSourceFieldRadosUser = "user";
+export const RBDVolumeSourceFieldReadOnly = "readOnly";
+export const RBDVolumeSourceFieldSecretRef = "secretRef";
+
+export interface RBDVolumeSource {
+    monitors?: string[];
+    fsType?: string;
+    keyring?: string;
+    image?: string;
+    pool?: string;
+    user?: string;
+    readOnly?: boolean;
+    secretRef?: LocalObjectReference;
+}
+
+export const FlexVolumeSourceType = "flexVolumeSource";
+export const FlexVolumeSourceFieldDriver = "driver";
+export const FlexVolumeSourceFieldFSType = "fsType";
+export const FlexVolumeSourceFieldOptions = "options";
+export const FlexVolumeSourceFieldReadOnly = "readOnly";
+export const FlexVolumeSourceFieldSecretRef = "secretRef";
+
+export interface FlexVolumeSource {
+    driver?: string;
+    fsType?: string;
+    options?: { [key: string]: string };
+    readOnly?: boolean;
+    secretRef?: LocalObjectReference;
+}
+
+export const CinderVolumeSourceType = "cinderVolumeSource";
+export const CinderVolumeSourceFieldFSType = "fsType";
+export const CinderVolumeSourceFieldReadOnly = "readOnly";
+export const CinderVolumeSourceFieldSecretRef = "secretRef";
+export const CinderVolumeSourceFieldVolumeID = "volumeID";
+
+export interface CinderVolumeSource {
+    fsType?: string;
+    readOnly?: boolean;
+    secretRef?: LocalObjectReference;
+    volumeID?: string;
+}
+
+export const CephFSVolumeSourceType = "cephFSVolumeSource";
+export const CephFSVolumeSourceFieldMonitors = "monitors";
+export const CephFSVolumeSourceFieldPath = "path";
+export const CephFSVolumeSourceFieldReadOnly = "readOnly";
+export const CephFSVolumeSourceFieldSecretFile = "secretFile";
+export const CephFSVolumeSourceFieldSecretRef = "secretRef";
+export const CephFSVolumeSourceFieldUser = "user";
+
+export interface CephFSVolumeSource {
+    monitors?: string[];
+    path?: string;
+    readOnly?: boolean;
+    secretFile?: string;
+    secretRef?: LocalObjectReference;
+    user?: string;
+}
+
+export const FlockerVolumeSourceType = "flockerVolumeSource";
+export const FlockerVolumeSourceFieldDatasetName = "datasetName";
+export const FlockerVolumeSourceFieldDatasetUUID = "datasetUUID";
+
+export interface FlockerVolumeSource {
+    datasetName?: string;
+    datasetUUID?: string;
+}
+
+export const ObjectFieldSelectorType = "objectFieldSelector";
+export const ObjectFieldSelectorFieldAPIVersion = "apiVersion";
+export const ObjectFieldSelectorFieldFieldPath = "fieldPath";
+
+export interface ObjectFieldSelector {
+    apiVersion?: string;
+    fieldPath?: string;
+}
+
+export const ResourceFieldSelectorType = "resourceFieldSelector";
+export const ResourceFieldSelectorFieldContainerName = "containerName";
+export const ResourceFieldSelectorFieldDivisor = "divisor";
+export const ResourceFieldSelectorFieldResource = "resource";
+
+export interface ResourceFieldSelector {
+    containerName?: string;
+    divisor?: string;
+    resource?: string;
+}
+
+export const DownwardAPIVolumeFileType = "downwardAPIVolumeFile";
+export const DownwardAPIVolumeFileFieldFieldRef = "fieldRef";
+export const DownwardAPIVolumeFileFieldMode = "mode";
+export const DownwardAPIVolumeFileFieldPath = "path";
+export const DownwardAPIVolumeFileFieldResourceFieldRef = "resourceFieldRef";
+
+export interface DownwardAPIVolumeFile {
+    fieldRef?: ObjectFieldSelector;
+    mode?: number;
+    path?: string;
+    resourceFieldRef?: ResourceFieldSelector;
+}
+
+export const DownwardAPIVolumeSourceType = "downwardAPIVolumeSource";
+export const DownwardAPIVolumeSourceFieldDefaultMode = "defaultMode";
+export const DownwardAPIVolumeSourceFieldItems = "items";
+
+export interface DownwardAPIVolumeSource {
+    defaultMode?: number;
+    items?: DownwardAPIVolumeFile[];
+}
+
+export const FCVolumeSourceType = "fcVolumeSource";
+export const FCVolumeSourceFieldFSType = "fsType";
+export const FCVolumeSourceFieldLun = "lun";
+export const FCVolumeSourceFieldReadOnly = "readOnly";
+export const FCVolumeSourceFieldTargetWWNs = "targetWWNs";
+export const FCVolumeSourceFieldWWIDs = "wwids";
+
+export interface FCVolumeSource {
+    fsType?: string;
+    lun?: number;
+    readOnly?: boolean;
+    targetWWNs?: string[];
+    wwids?: string[];
+}
+
+export const AzureFileVolumeSourceType = "azureFileVolumeSource";
+export const AzureFileVolumeSourceFieldReadOnly = "readOnly";
+export const AzureFileVolumeSourceFieldSecretName = "secretName";
+export const AzureFileVolumeSourceFieldShareName = "shareName";
+
+export interface AzureFileVolumeSource {
+    readOnly?: boolean;
+    secretName?: string;
+    shareName?: string;
+}
+
+export const ConfigMapVolumeSourceType = "configMapVolumeSource";
+export const ConfigMapVolumeSourceFieldDefaultMode = "defaultMode";
+export const ConfigMapVolumeSourceFieldItems = "items";
+export const ConfigMapVolumeSourceFieldName = "name";
+export const ConfigMapVolumeSourceFieldOptional = "optional";
+
+export interface ConfigMapVolumeSource {
+    defaultMode?: number;
+    items?: KeyToPath[];
+    name?: string;
+    optional?: boolean;
+}
+
+export const VsphereVirtualDiskVolumeSourceType = "vsphereVirtualDiskVolumeSource";
+export const VsphereVirtualDiskVolumeSourceFieldFSType = "fsType";
+export const VsphereVirtualDiskVolumeSourceFieldStoragePolicyID = "storagePolicyID";
+export const VsphereVirtualDiskVolumeSourceFieldStoragePolicyName = "storagePolicyName";
+export const VsphereVirtualDiskVolumeSourceFieldVolumePath = "volumePath";
+
+export interface VsphereVirtualDiskVolumeSource {
+    fsType?: string;
+    storagePolicyID?: string;
+    storagePolicyName?: string;
+    volumePath?: string;
+}
+
+export const QuobyteVolumeSourceType = "quobyteVolumeSource";
+export const QuobyteVolumeSourceFieldGroup = "group";
+export const QuobyteVolumeSourceFieldReadOnly = "readOnly";
+export const QuobyteVolumeSourceFieldRegistry = "registry";
+export const QuobyteVolumeSourceFieldTenant = "tenant";
+export const QuobyteVolumeSourceFieldUser = "user";
+export const QuobyteVolumeSourceFieldVolume = "volume";
+
+export interface QuobyteVolumeSource {
+    group?: string;
+    readOnly?: boolean;
+    registry?: string;
+    tenant?: string;
+    user?: string;
+    volume?: string;
+}
+
+export const AzureDiskVolumeSourceType = "azureDiskVolumeSource";
+export const AzureDiskVolumeSourceFieldCachingMode = "cachingMode";
+export const AzureDiskVolumeSourceFieldDataDiskURI = "diskURI";
+export const AzureDiskVolumeSourceFieldDiskName = "diskName";
+export const AzureDiskVolumeSourceFieldFSType = "fsType";
+export const AzureDiskVolumeSourceFieldKind = "kind";
+export const AzureDiskVolumeSourceFieldReadOnly = "readOnly";
+
+export interface AzureDiskVolumeSource {
+    cachingMode?: string;
+    diskURI?: string;
+    diskName?: string;
+    fsType?: string;
+    kind?: string;
+    readOnly?: boolean;
+}
+
+export const PhotonPersistentDiskVolumeSourceType = "photonPersistentDiskVolumeSource";
+export const PhotonPersistentDiskVolumeSourceFieldFSType = "fsType";
+export const PhotonPersistentDiskVolumeSourceFieldPdID = "pdID";
+
+export interface PhotonPersistentDiskVolumeSource {
+    fsType?: string;
+    pdID?: string;
+}
+
+export const SecretProjectionType = "secretProjection";
+export const SecretProjectionFieldItems = "items";
+export const SecretProjectionFieldName = "name";
+export const SecretProjectionFieldOptional = "optional";
+
+export interface SecretProjection {
+    items?: KeyToPath[];
+    name?: string;
+    optional?: boolean;
+}
+
+export const DownwardAPIProjectionType = "downwardAPIProjection";
+export const DownwardAPIProjectionFieldItems = "items";
+
+export interface DownwardAPIProjection {
+    items?: DownwardAPIVolumeFile[];
+}
+
+export const ConfigMapProjectionType = "configMapProjection";
+export const ConfigMapProjectionFieldItems = "items";
+export const ConfigMapProjectionFieldName = "name";
+export const ConfigMapProjectionFieldOptional = "optional";
+
+export interface ConfigMapProjection {
+    items?: KeyToPath[];
+    name?: string;
+    optional?: boolean;
+}
+
+export const ServiceAccountTokenProjectionType = "serviceAccountTokenProjection";
+export const ServiceAccountTokenProjectionFieldAudience = "audience";
+export const ServiceAccountTokenProjectionFieldExpirationSeconds = "expirationSeconds";
+export const ServiceAccountTokenProjectionFieldPath = "path";
+
+export interface ServiceAccountTokenProjection {
+    audience?: string;
+    expirationSeconds?: number;
+    path?: string;
+}
+
+export const VolumeProjectionType = "volumeProjection";
+export const VolumeProjectionFieldConfigMap = "configMap";
+export const VolumeProjectionFieldDownwardAPI = "downwardAPI";
+export const VolumeProjectionFieldSecret = "secret";
+export const VolumeProjectionFieldServiceAccountToken = "serviceAccountToken";
+
+export interface VolumeProjection {
+    configMap?: ConfigMapProjection;
+    downwardAPI?: DownwardAPIProjection;
+    secret?: SecretProjection;
+    serviceAccountToken?: ServiceAccountTokenProjection;
+}
+
+export const ProjectedVolumeSourceType = "projectedVolumeSource";
+export const ProjectedVolumeSourceFieldDefaultMode = "defaultMode";
+export const ProjectedVolumeSourceFieldSources = "sources";
+
+export interface ProjectedVolumeSource {
+    defaultMode?: number;
+    sources?: VolumeProjection[];
+}
+
+export const PortworxVolumeSourceType = "portworxVolumeSource";
+export const PortworxVolumeSourceFieldFSType = "fsType";
+export const PortworxVolumeSourceFieldReadOnly = "readOnly";
+export const PortworxVolumeSourceFieldVolumeID = "volumeID";
+
+export interface PortworxVolumeSource {
+    fsType?: string;
+    readOnly?: boolean;
+    volumeID?: string;
+}
+
+export const ScaleIOVolumeSourceType = "scaleIOVolumeSource";
+export const ScaleIOVolumeSourceFieldFSType = "fsType";
+export const ScaleIOVolumeSourceFieldGateway = "gateway";
+export const ScaleIOVolumeSourceFieldProtectionDomain = "protectionDomain";
+export const ScaleIOVolumeSourceFieldReadOnly = "readOnly";
+export const ScaleIOVolumeSourceFieldSSLEnabled = "sslEnabled";
+export const ScaleIOVolumeSourceFieldSecretRef = "secretRef";
+export const ScaleIOVolumeSourceFieldStorageMode = "storageMode";
+export const ScaleIOVolumeSourceFieldStoragePool = "storagePool";
+export const ScaleIOVolumeSourceFieldSystem = "system";
+export const ScaleIOVolumeSourceFieldVolumeName = "volumeName";
+
+export interface ScaleIOVolumeSource {
+    fsType?: string;
+    gateway?: string;
+    protectionDomain?: string;
+    readOnly?: boolean;
+    sslEnabled?: boolean;
+    secretRef?: LocalObjectReference;
+    storageMode?: string;
+    storagePool?: string;
+    system?: string;
+    volumeName?: string;
+}
+
+export const StorageOSVolumeSourceType = "storageOSVolumeSource";
+export const StorageOSVolumeSourceFieldFSType = "fsType";
+export const StorageOSVolumeSourceFieldReadOnly = "readOnly";
+export const StorageOSVolumeSourceFieldSecretRef = "secretRef";
+export const StorageOSVolumeSourceFieldVolumeName = "volumeName";
+export const StorageOSVolumeSourceFieldVolumeNamespace = "volumeNamespace";
+
+export interface StorageOSVolumeSource {
+    fsType?: string;
+    readOnly?: boolean;
+    secretRef?: LocalObjectReference;
+    volumeName?: string;
+    volumeNamespace?: string;
+}
+
+export const CSIVolumeSourceType = "csiVolumeSource";
+export const CSIVolumeSourceFieldDriver = "driver";
+export const CSIVolumeSourceFieldFSType = "fsType";
+export const CSIVolumeSourceFieldNodePublishSecretRef = "nodePublishSecretRef";
+export const CSIVolumeSourceFieldReadOnly = "readOnly";
+export const CSIVolumeSourceFieldVolumeAttributes = "volumeAttributes";
+
+export interface CSIVolumeSource {
+    driver?: string;
+    fsType?: string;
+    nodePublishSecretRef?: LocalObjectReference;
+    readOnly?: boolean;
+    volumeAttributes?: { [key: string]: string };
+}
+
+export const VolumeType = "volume";
+export const VolumeFieldAWSElasticBlockStore = "awsElasticBlockStore";
+export const VolumeFieldAzureDisk = "azureDisk";
+export const VolumeFieldAzureFile = "azureFile";
+export const VolumeFieldCSI = "csi";
+export const VolumeFieldCephFS = "cephfs";
+export const VolumeFieldCinder = "cinder";
+export const VolumeFieldConfigMap = "configMap";
+export const VolumeFieldDownwardAPI = "downwardAPI";
+export const VolumeFieldEmptyDir = "emptyDir";
+export const VolumeFieldFC = "fc";
+export const VolumeFieldFlexVolume = "flexVolume";
+export const VolumeFieldFlocker = "flocker";
+export const VolumeFieldGCEPersistentDisk = "gcePersistentDisk";
+export const VolumeFieldGitRepo = "gitRepo";
+export const VolumeFieldGlusterfs = "glusterfs";
+export const VolumeFieldHostPath = "hostPath";
+export const VolumeFieldISCSI = "iscsi";
+export const VolumeFieldNFS = "nfs";
+export const VolumeFieldName = "name";
+export const VolumeFieldPersistentVolumeClaim = "persistentVolumeClaim";
+export const VolumeFieldPhotonPersistentDisk = "photonPersistentDisk";
+export const VolumeFieldPortworxVolume = "portworxVolume";
+export const VolumeFieldProjected = "projected";
+export const VolumeFieldQuobyte = "quobyte";
+export const VolumeFieldRBD = "rbd";
+export const VolumeFieldScaleIO = "scaleIO";
+export const VolumeFieldSecret = "secret";
+export const VolumeFieldStorageOS = "storageos";
+export const VolumeFieldVsphereVolume = "vsphereVolume";
+
+export interface Volume {
+    awsElasticBlockStore?: AWSElasticBlockStoreVolumeSource;
+    azureDisk?: AzureDiskVolumeSource;
+    azureFile?: AzureFileVolumeSource;
+    csi?: CSIVolumeSource;
+    cephfs?: CephFSVolumeSource;
+    cinder?: CinderVolumeSource;
+    configMap?: ConfigMapVolumeSource;
+    downwardAPI?: DownwardAPIVolumeSource;
+    emptyDir?: EmptyDirVolumeSource;
+    fc?: FCVolumeSource;
+    flexVolume?: FlexVolumeSource;
+    flocker?: FlockerVolumeSource;
+    gcePersistentDisk?: GCEPersistentDiskVolumeSource;
+    gitRepo?: GitRepoVolumeSource;
+    glusterfs?: GlusterfsVolumeSource;
+    hostPath?: HostPathVolumeSource;
+    iscsi?: ISCSIVolumeSource;
+    nfs?: NFSVolumeSource;
+    name?: string;
+    persistentVolumeClaim?: PersistentVolumeClaimVolumeSource;
+    photonPersistentDisk?: PhotonPersistentDiskVolumeSource;
+    portworxVolume?: PortworxVolumeSource;
+    projected?: ProjectedVolumeSource;
+    quobyte?: QuobyteVolumeSource;
+    rbd?: RBDVolumeSource;
+    scaleIO?: ScaleIOVolumeSource;
+    secret?: SecretVolumeSource;
+    storageos?: StorageOSVolumeSource;
+    vsphereVolume?: VsphereVirtualDiskVolumeSource;
+}
+
+export const GlusterfsPersistentVolumeSourceType = "glusterfsPersistentVolumeSource";
+export const GlusterfsPersistentVolumeSourceFieldEndpointsName = "endpoints";
+export const GlusterfsPersistentVolumeSourceFieldEndpointsNamespace = "endpointsNamespace";
+export const GlusterfsPersistentVolumeSourceFieldPath = "path";
+export const GlusterfsPersistentVolumeSourceFieldReadOnly = "readOnly";
+
+export interface GlusterfsPersistentVolumeSource {
+    endpoints?: string;
+    endpointsNamespace?: string;
+    path?: string;
+    readOnly?: boolean;
+}
+
+export const SecretReferenceType = "secretReference";
+export const SecretReferenceFieldName = "name";
+export const SecretReferenceFieldNamespace = "namespace";
+
+export interface SecretReference {
+    name?: string;
+    namespace?: string;
+}
+
+export const RBDPersistentVolumeSourceType = "rbdPersistentVolumeSource";
+export const RBDPersistentVolumeSourceFieldCephMonitors = "monitors";
+export const RBDPersistentVolumeSourceFieldFSType = "fsType";
+export const RBDPersistentVolumeSourceFieldKeyring = "keyring";
+export const RBDPersistentVolumeSourceFieldRBDImage = "image";
+export const RBDPersistentVolumeSourceFieldRBDPool = "pool";
+export const RBDPersistentVolumeSourceFieldRadosUser = "user";
+export const RBDPersistentVolumeSourceFieldReadOnly = "readOnly";
+export const RBDPersistentVolumeSourceFieldSecretRef = "secretRef";
+
+export interface RBDPersistentVolumeSource {
+    monitors?: string[];
+    fsType?: string;
+    keyring?: string;
+    image?: string;
+    pool?: string;
+    user?: string;
+    readOnly?: boolean;
+    secretRef?: SecretReference;
+}
+
+export const ISCSIPersistentVolumeSourceType = "iscsiPersistentVolumeSource";
+export const ISCSIPersistentVolumeSourceFieldDiscoveryCHAPAuth = "chapAuthDiscovery";
+export const ISCSIPersistentVolumeSourceFieldFSType = "fsType";
+export const ISCSIPersistentVolumeSourceFieldIQN = "iqn";
+export const ISCSIPersistentVolumeSourceFieldISCSIInterface = "iscsiInterface";
+export const ISCSIPersistentVolumeSourceFieldInitiatorName = "initiatorName";
+export const ISCSIPersistentVolumeSourceFieldLun = "lun";
+export const ISCSIPersistentVolumeSourceFieldPortals = "portals";
+export const ISCSIPersistentVolumeSourceFieldReadOnly = "readOnly";
+export const ISCSIPersistentVolumeSourceFieldSecretRef = "secretRef";
+export const ISCSIPersistentVolumeSourceFieldSessionCHAPAuth = "chapAuthSession";
+export const ISCSIPersistentVolumeSourceFieldTargetPortal = "targetPortal";
+
+export interface ISCSIPersistentVolumeSource {
+    chapAuthDiscovery?: boolean;
+    fsType?: string;
+    iqn?: string;
+    iscsiInterface?: string;
+    initiatorName?: string;
+    lun?: number;
+    portals?: string[];
+    readOnly?: boolean;
+    secretRef?: SecretReference;
+    chapAuthSession?: boolean;
+    targetPortal?: string;
+}
+
+export const CinderPersistentVolumeSourceType = "cinderPersistentVolumeSource";
+export const CinderPersistentVolumeSourceFieldFSType = "fsType";
+export const CinderPersistentVolumeSourceFieldReadOnly = "readOnly";
+export const CinderPersistentVolumeSourceFieldSecretRef = "secretRef";
+export const CinderPersistentVolumeSourceFieldVolumeID = "volumeID";
+
+export interface CinderPersistentVolumeSource {
+    fsType?: string;
+    readOnly?: boolean;
+    secretRef?: SecretReference;
+    volumeID?: string;
+}
+
+export const CephFSPersistentVolumeSourceType = "cephFSPersistentVolumeSource";
+export const CephFSPersistentVolumeSourceFieldMonitors = "monitors";
+export const CephFSPersistentVolumeSourceFieldPath = "path";
+export const CephFSPersistentVolumeSourceFieldReadOnly = "readOnly";
+export const CephFSPersistentVolumeSourceFieldSecretFile = "secretFile";
+export const CephFSPersistentVolumeSourceFieldSecretRef = "secretRef";
+export const CephFSPersistentVolumeSourceFieldUser = "user";
+
+export interface CephFSPersistentVolumeSource {
+    monitors?: string[];
+    path?: string;
+    readOnly?: boolean;
+    secretFile?: string;
+    secretRef?: SecretReference;
+    user?: string;
+}
+
+export const FlexPersistentVolumeSourceType = "flexPersistentVolumeSource";
+export const FlexPersistentVolumeSourceFieldDriver = "driver";
+export const FlexPersistentVolumeSourceFieldFSType = "fsType";
+export const FlexPersistentVolumeSourceFieldOptions = "options";
+export const FlexPersistentVolumeSourceFieldReadOnly = "readOnly";
+export const FlexPersistentVolumeSourceFieldSecretRef = "secretRef";
+
+export interface FlexPersistentVolumeSource {
+    driver?: string;
+    fsType?: string;
+    options?: { [key: string]: string };
+    readOnly?: boolean;
+    secretRef?: SecretReference;
+}
+
+export const AzureFilePersistentVolumeSourceType = "azureFilePersistentVolumeSource";
+export const AzureFilePersistentVolumeSourceFieldReadOnly = "readOnly";
+export const AzureFilePersistentVolumeSourceFieldSecretName = "secretName";
+export const AzureFilePersistentVolumeSourceFieldSecretNamespace = "secretNamespace";
+export const AzureFilePersistentVolumeSourceFieldShareName = "shareName";
+
+export interface AzureFilePersistentVolumeSource {
+    readOnly?: boolean;
+    secretName?: string;
+    secretNamespace?: string;
+    shareName?: string;
+}
+
+export const ScaleIOPersistentVolumeSourceType = "scaleIOPersistentVolumeSource";
+export const ScaleIOPersistentVolumeSourceFieldFSType = "fsType";
+export const ScaleIOPersistentVolumeSourceFieldGateway = "gateway";
+export const ScaleIOPersistentVolumeSourceFieldProtectionDomain = "protectionDomain";
+export const ScaleIOPersistentVolumeSourceFieldReadOnly = "readOnly";
+export const ScaleIOPersistentVolumeSourceFieldSSLEnabled = "sslEnabled";
+export const ScaleIOPersistentVolumeSourceFieldSecretRef = "secretRef";
+export const ScaleIOPersistentVolumeSourceFieldStorageMode = "storageMode";
+export const ScaleIOPersistentVolumeSourceFieldStoragePool = "storagePool";
+export const ScaleIOPersistentVolumeSourceFieldSystem = "system";
+export const ScaleIOPersistentVolumeSourceFieldVolumeName = "volumeName";
+
+export interface ScaleIOPersistentVolumeSource {
+    fsType?: string;
+    gateway?: string;
+    protectionDomain?: string;
+    readOnly?: boolean;
+    sslEnabled?: boolean;
+    secretRef?: SecretReference;
+    storageMode?: string;
+    storagePool?: string;
+    system?: string;
+    volumeName?: string;
+}
+
+export const LocalVolumeSourceType = "localVolumeSource";
+export const LocalVolumeSourceFieldFSType = "fsType";
+export const LocalVolumeSourceFieldPath = "path";
+
+export interface LocalVolumeSource {
+    fsType?: string;
+    path?: string;
+}
+
+export const ObjectReferenceType = "objectReference";
+export const ObjectReferenceFieldAPIVersion = "apiVersion";
+export const ObjectReferenceFieldFieldPath = "fieldPath";
+export const ObjectReferenceFieldKind = "kind";
+export const ObjectReferenceFieldName = "name";
+export const ObjectReferenceFieldNamespace = "namespace";
+export const ObjectReferenceFieldResourceVersion = "resourceVersion";
+export const ObjectReferenceFieldUID = "uid";
+
+export interface ObjectReference {
+    apiVersion?: string;
+    fieldPath?: string;
+    kind?: string;
+    name?: string;
+    namespace?: string;
+    resourceVersion?: string;
+    uid?: string;
+}
+
+export const StorageOSPersistentVolumeSourceType = "storageOSPersistentVolumeSource";
+export const StorageOSPersistentVolumeSourceFieldFSType = "fsType";
+export const StorageOSPersistentVolumeSourceFieldReadOnly = "readOnly";
+export const StorageOSPersistentVolumeSourceFieldSecretRef = "secretRef";
+export const StorageOSPersistentVolumeSourceFieldVolumeName = "volumeName";
+export const StorageOSPersistentVolumeSourceFieldVolumeNamespace = "volumeNamespace";
+
+export interface StorageOSPersistentVolumeSource {
+    fsType?: string;
+    readOnly?: boolean;
+    secretRef?: ObjectReference;
+    volumeName?: string;
+    volumeNamespace?: string;
+}
+
+export const CSIPersistentVolumeSourceType = "csiPersistentVolumeSource";
+export const CSIPersistentVolumeSourceFieldControllerExpandSecretRef = "controllerExpandSecretRef";
+export const CSIPersistentVolumeSourceFieldControllerPublishSecretRef = "controllerPublishSecretRef";
+export const CSIPersistentVolumeSourceFieldDriver = "driver";
+export const CSIPersistentVolumeSourceFieldFSType = "fsType";
+export const CSIPersistentVolumeSourceFieldNodePublishSecretRef = "nodePublishSecretRef";
+export const CSIPersistentVolumeSourceFieldNodeStageSecretRef = "nodeStageSecretRef";
+export const CSIPersistentVolumeSourceFieldReadOnly = "readOnly";
+export const CSIPersistentVolumeSourceFieldVolumeAttributes = "volumeAttributes";
+export const CSIPersistentVolumeSourceFieldVolumeHandle = "volumeHandle";
+
+export interface CSIPersistentVolumeSource {
+    controllerExpandSecretRef?: SecretReference;
+    controllerPublishSecretRef?: SecretReference;
+    driver?: string;
+    fsType?: string;
+    nodePublishSecretRef?: SecretReference;
+    nodeStageSecretRef?: SecretReference;
+    readOnly?: boolean;
+    volumeAttributes?: { [key: string]: string };
+    volumeHandle?: string;
+}
+
+export const NodeSelectorRequirementType = "nodeSelectorRequirement";
+export const NodeSelectorRequirementFieldKey = "key";
+export const NodeSelectorRequirementFieldOperator = "operator";
+export const NodeSelectorRequirementFieldValues = "values";
+
+export interface NodeSelectorRequirement {
+    key?: string;
+    operator?: string;
+    values?: string[];
+}
+
+export const NodeSelectorTermType = "nodeSelectorTerm";
+export const NodeSelectorTermFieldMatchExpressions = "matchExpressions";
+export const NodeSelectorTermFieldMatchFields = "matchFields";
+
+export interface NodeSelectorTerm {
+    matchExpressions?: NodeSelectorRequirement[];
+    matchFields?: NodeSelectorRequirement[];
+}
+
+export const NodeSelectorType = "nodeSelector";
+export const NodeSelectorFieldNodeSelectorTerms = "nodeSelectorTerms";
+
+export interface NodeSelector {
+    nodeSelectorTerms?: NodeSelectorTerm[];
+}
+
+export const VolumeNodeAffinityType = "volumeNodeAffinity";
+export const VolumeNodeAffinityFieldRequired = "required";
+
+export interface VolumeNodeAffinity {
+    required?: NodeSelector;
+}
+
+export const PersistentVolumeSpecType = "persistentVolumeSpec";
+export const PersistentVolumeSpecFieldAWSElasticBlockStore = "awsElasticBlockStore";
+export const PersistentVolumeSpecFieldAccessModes = "accessModes";
+export const PersistentVolumeSpecFieldAzureDisk = "azureDisk";
+export const PersistentVolumeSpecFieldAzureFile = "azureFile";
+export const PersistentVolumeSpecFieldCSI = "csi";
+export const PersistentVolumeSpecFieldCapacity = "capacity";
+export const PersistentVolumeSpecFieldCephFS = "cephfs";
+export const PersistentVolumeSpecFieldCinder = "cinder";
+export const PersistentVolumeSpecFieldClaimRef = "claimRef";
+export const PersistentVolumeSpecFieldFC = "fc";
+export const PersistentVolumeSpecFieldFlexVolume = "flexVolume";
+export const PersistentVolumeSpecFieldFlocker = "flocker";
+export const PersistentVolumeSpecFieldGCEPersistentDisk = "gcePersistentDisk";
+export const PersistentVolumeSpecFieldGlusterfs = "glusterfs";
+export const PersistentVolumeSpecFieldHostPath = "hostPath";
+export const PersistentVolumeSpecFieldISCSI = "iscsi";
+export const PersistentVolumeSpecFieldLocal = "local";
+export const PersistentVolumeSpecFieldMountOptions = "mountOptions";
+export const PersistentVolumeSpecFieldNFS = "nfs";
+export const PersistentVolumeSpecFieldNodeAffinity = "nodeAffinity";
+export const PersistentVolumeSpecFieldPersistentVolumeReclaimPolicy = "persistentVolumeReclaimPolicy";
+export const PersistentVolumeSpecFieldPhotonPersistentDisk = "photonPersistentDisk";
+export const PersistentVolumeSpecFieldPortworxVolume = "portworxVolume";
+export const PersistentVolumeSpecFieldQuobyte = "quobyte";
+export const PersistentVolumeSpecFieldRBD = "rbd";
+export const PersistentVolumeSpecFieldScaleIO = "scaleIO";
+export const PersistentVolumeSpecFieldStorageClassID = "storageClassId";
+export const PersistentVolumeSpecFieldStorageOS = "storageos";
+export const PersistentVolumeSpecFieldVolumeMode = "volumeMode";
+export const PersistentVolumeSpecFieldVsphereVolume = "vsphereVolume";
+
+export interface PersistentVolumeSpec {
+    awsElasticBlockStore?: AWSElasticBlockStoreVolumeSource;
+    accessModes?: string[];
+    azureDisk?: AzureDiskVolumeSource;
+    azureFile?: AzureFilePersistentVolumeSource;
+    csi?: CSIPersistentVolumeSource;
+    capacity?: { [key: string]: string };
+    cephfs?: CephFSPersistentVolumeSource;
+    cinder?: CinderPersistentVolumeSource;
+    claimRef?: ObjectReference;
+    fc?: FCVolumeSource;
+    flexVolume?: FlexPersistentVolumeSource;
+    flocker?: FlockerVolumeSource;
+    gcePersistentDisk?: GCEPersistentDiskVolumeSource;
+    glusterfs?: GlusterfsPersistentVolumeSource;
+    hostPath?: HostPathVolumeSource;
+    iscsi?: ISCSIPersistentVolumeSource;
+    local?: LocalVolumeSource;
+    mountOptions?: string[];
+    nfs?: NFSVolumeSource;
+    nodeAffinity?: VolumeNodeAffinity;
+    persistentVolumeReclaimPolicy?: string;
+    photonPersistentDisk?: PhotonPersistentDiskVolumeSource;
+    portworxVolume?: PortworxVolumeSource;
+    quobyte?: QuobyteVolumeSource;
+    rbd?: RBDPersistentVolumeSource;
+    scaleIO?: ScaleIOPersistentVolumeSource;
+    storageClassId?: string;
+    storageos?: StorageOSPersistentVolumeSource;
+    volumeMode?: string;
+    vsphereVolume?: VsphereVirtualDiskVolumeSource;
+}
+
+export const LabelSelectorRequirementType = "labelSelectorRequirement";
+export const LabelSelectorRequirementFieldKey = "key";
+export const LabelSelectorRequirementFieldOperator = "operator";
+export const LabelSelectorRequirementFieldValues = "values";
+
+export interface LabelSelectorRequirement {
+    key?: string;
+    operator?: string;
+    values?: string[];
+}
+
+export const LabelSelectorType = "labelSelector";
+export const LabelSelectorFieldMatchExpressions = "matchExpressions";
+export const LabelSelectorFieldMatchLabels = "matchLabels";
+
+export interface LabelSelector {
+    matchExpressions?: LabelSelectorRequirement[];
+    matchLabels?: { [key: string]: string };
+}
+
+export const ResourceRequirementsType = "resourceRequirements";
+export const ResourceRequirementsFieldLimits = "limits";
+export const ResourceRequirementsFieldRequests = "requests";
+
+export interface ResourceRequirements {
+    limits?: { [key: string]: string };
+    requests?: { [key: string]: string };
+}
+
+export const TypedLocalObjectReferenceType = "typedLocalObjectReference";
+export const TypedLocalObjectReferenceFieldAPIGroup = "apiGroup";
+export const TypedLocalObjectReferenceFieldKind = "kind";
+export const TypedLocalObjectReferenceFieldName = "name";
+
+export interface TypedLocalObjectReference {
+    apiGroup?: string;
+    kind?: string;
+    name?: string;
+}
+
+export const PersistentVolumeClaimSpecType = "persistentVolumeClaimSpec";
+export const PersistentVolumeClaimSpecFieldAccessModes = "accessModes";
+export const PersistentVolumeClaimSpecFieldDataSource = "dataSource";
+export const PersistentVolumeClaimSpecFieldResources = "resources";
+export const PersistentVolumeClaimSpecFieldSelector = "selector";
+export const PersistentVolumeClaimSpecFieldStorageClassID = "storageClassId";
+export const PersistentVolumeClaimSpecFieldVolumeID = "volumeId";
+export const PersistentVolumeClaimSpecFieldVolumeMode = "volumeMode";
+
+export interface PersistentVolumeClaimSpec {
+    accessModes?: string[];
+    dataSource?: TypedLocalObjectReference;
+    resources?: ResourceRequirements;
+    selector?: LabelSelector;
+    storageClassId?: string;
+    volumeId?: string;
+    volumeMode?: string;
+}
+
+export const OwnerReferenceType = "ownerReference";
+export const OwnerReferenceFieldAPIVersion = "apiVersion";
+export const OwnerReferenceFieldBlockOwnerDeletion = "blockOwnerDeletion";
+export const OwnerReferenceFieldController = "controller";
+export const OwnerReferenceFieldKind = "kind";
+export const OwnerReferenceFieldName = "name";
+export const OwnerReferenceFieldUID = "uid";
+
+export interface OwnerReference {
+    apiVersion?: string;
+    blockOwnerDeletion?: boolean;
+    controller?: boolean;
+    kind?: string;
+    name?: string;
+    uid?: string;
+}
+
+export const ObjectMetaType = "objectMeta";
+export const ObjectMetaFieldAnnotations = "annotations";
+export const ObjectMetaFieldCreated = "created";
+export const ObjectMetaFieldFinalizers = "finalizers";
+export const ObjectMetaFieldLabels = "labels";
+export const ObjectMetaFieldName = "name";
+export const ObjectMetaFieldNamespace = "namespace";
+export const ObjectMetaFieldOwnerReferences = "ownerReferences";
+export const ObjectMetaFieldRemoved = "removed";
+export const ObjectMetaFieldSelfLink = "selfLink";
+export const ObjectMetaFieldUUID = "uuid";
+
+export interface ObjectMeta {
+    annotations?: { [key: string]: string };
+    created?: string;
+    finalizers?: string[];
+    labels?: { [key: string]: string };
+    name?: string;
+    namespace?: string;
+    ownerReferences?: OwnerReference[];
+    removed?: string;
+    selfLink?: string;
+    uuid?: string;
+}
+
+export const PersistentVolumeClaimConditionType = "persistentVolumeClaimCondition";
+export const PersistentVolumeClaimConditionFieldLastProbeTime = "lastProbeTime";
+export const PersistentVolumeClaimConditionFieldLastTransitionTime = "lastTransitionTime";
+export const PersistentVolumeClaimConditionFieldMessage = "message";
+export const PersistentVolumeClaimConditionFieldReason = "reason";
+export const PersistentVolumeClaimConditionFieldStatus = "status";
+export const PersistentVolumeClaimConditionFieldType = "type";
+
+export interface PersistentVolumeClaimCondition {
+    lastProbeTime?: string;
+    lastTransitionTime?: string;
+    message?: string;
+    reason?: string;
+    status?: string;
+    type?: string;
+}
+
+export const PersistentVolumeClaimStatusType = "persistentVolumeClaimStatus";
+export const PersistentVolumeClaimStatusFieldAccessModes = "accessModes";
+export const PersistentVolumeClaimStatusFieldCapacity = "capacity";
+export const PersistentVolumeClaimStatusFieldConditions = "conditions";
+export const PersistentVolumeClaimStatusFieldPhase = "phase";
+
+export interface PersistentVolumeClaimStatus {
+    accessModes?: string[];
+    capacity?: { [key: string]: string };
+    conditions?: PersistentVolumeClaimCondition[];
+    phase?: string;
+}
+
+export const PersistentVolumeClaimType = "persistentVolumeClaim";
+export const PersistentVolumeClaimFieldAccessModes = "accessModes";
+export const PersistentVolumeClaimFieldAnnotations = "annotations";
+export const PersistentVolumeClaimFieldCreated = "created";
+export const PersistentVolumeClaimFieldCreatorID = "creatorId";
+export const PersistentVolumeClaimFieldDataSource = "dataSource";
+export const PersistentVolumeClaimFieldLabels = "labels";
+export const PersistentVolumeClaimFieldName = "name";
+export const PersistentVolumeClaimFieldNamespaceId = "namespaceId";
+export const PersistentVolumeClaimFieldOwnerReferences = "ownerReferences";
+export const PersistentVolumeClaimFieldProjectID = "projectId";
+export const PersistentVolumeClaimFieldRemoved = "removed";
+export const PersistentVolumeClaimFieldResources = "resources";
+export const PersistentVolumeClaimFieldSelector = "selector";
+export const PersistentVolumeClaimFieldState = "state";
+export const PersistentVolumeClaimFieldStatus = "status";
+export const PersistentVolumeClaimFieldStorageClassID = "storageClassId";
+export const PersistentVolumeClaimFieldTransitioning = "transitioning";
+export const PersistentVolumeClaimFieldTransitioningMessage = "transitioningMessage";
+export const PersistentVolumeClaimFieldUUID = "uuid";
+export const PersistentVolumeClaimFieldVolumeID = "volumeId";
+export const PersistentVolumeClaimFieldVolumeMode = "volumeMode";
+
+export interface PersistentVolumeClaim extends ApiResource {
+    accessModes?: string[];
+    annotations?: { [key: string]: string };
+    created?: string;
+    creatorId?: string;
+    dataSource?: TypedLocalObjectReference;
+    labels?: { [key: string]: string };
+    name?: string;
+    namespaceId?: string;
+    ownerReferences?: OwnerReference[];
+    projectId?: string;
+    removed?: string;
+    resources?: ResourceRequirements;
+    selector?: LabelSelector;
+    state?: string;
+    status?: PersistentVolumeClaimStatus;
+    storageClassId?: string;
+    transitioning?: "yes" | "no" | "error";
+    transitioningMessage?: string;
+    uuid?: string;
+    volumeId?: string;
+    volumeMode?: string;
+}
+
+export interface PersistentVolumeClaimCollection extends ApiCollection {
+    data?: PersistentVolumeClaim[];
+}
+
+export class PersistentVolumeClaimClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<PersistentVolumeClaimCollection> {
+        return this.ops.DoList(PersistentVolumeClaimType, opts);
+    }
+
+    Next(collection: PersistentVolumeClaimCollection): Promise<PersistentVolumeClaimCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: PersistentVolumeClaim): Promise<PersistentVolumeClaim> {
+        return this.ops.DoCreate(PersistentVolumeClaimType, container);
+    }
+
+    Update(existing: PersistentVolumeClaim, updates: object): Promise<PersistentVolumeClaim> {
+        return this.ops.DoUpdate(PersistentVolumeClaimType, existing, updates);
+    }
+
+    Replace(obj: PersistentVolumeClaim): Promise<PersistentVolumeClaim> {
+        return this.ops.DoReplace(PersistentVolumeClaimType, obj, obj);
+    }
+
+    ByID(id: string): Promise<PersistentVolumeClaim> {
+        return this.ops.DoByID(PersistentVolumeClaimType, id);
+    }
+
+    Delete(container: PersistentVolumeClaim): Promise<void> {
+        return this.ops.DoResourceDelete(PersistentVolumeClaimType, container);
+    }
+}
+
+export const ConfigMapType = "configMap";
+export const ConfigMapFieldAnnotations = "annotations";
+export const ConfigMapFieldBinaryData = "binaryData";
+export const ConfigMapFieldCreated = "created";
+export const ConfigMapFieldCreatorID = "creatorId";
+export const ConfigMapFieldData = "data";
+export const ConfigMapFieldLabels = "labels";
+export const ConfigMapFieldName = "name";
+export const ConfigMapFieldNamespaceId = "namespaceId";
+export const ConfigMapFieldOwnerReferences = "ownerReferences";
+export const ConfigMapFieldProjectID = "projectId";
+export const ConfigMapFieldRemoved = "removed";
+export const ConfigMapFieldUUID = "uuid";
+
+export interface ConfigMap extends ApiResource {
+    annotations?: { [key: string]: string };
+    binaryData?: { [key: string]: string };
+    created?: string;
+    creatorId?: string;
+    data?: { [key: string]: string };
+    labels?: { [key: string]: string };
+    name?: string;
+    namespaceId?: string;
+    ownerReferences?: OwnerReference[];
+    projectId?: string;
+    removed?: string;
+    uuid?: string;
+}
+
+export interface ConfigMapCollection extends ApiCollection {
+    data?: ConfigMap[];
+}
+
+export class ConfigMapClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<ConfigMapCollection> {
+        return this.ops.DoList(ConfigMapType, opts);
+    }
+
+    Next(collection: ConfigMapCollection): Promise<ConfigMapCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: ConfigMap): Promise<ConfigMap> {
+        return this.ops.DoCreate(ConfigMapType, container);
+    }
+
+    Update(existing: ConfigMap, updates: object): Promise<ConfigMap> {
+        return this.ops.DoUpdate(ConfigMapType, existing, updates);
+    }
+
+    Replace(obj: ConfigMap): Promise<ConfigMap> {
+        return this.ops.DoReplace(ConfigMapType, obj, obj);
+    }
+
+    ByID(id: string): Promise<ConfigMap> {
+        return this.ops.DoByID(ConfigMapType, id);
+    }
+
+    Delete(container: ConfigMap): Promise<void> {
+        return this.ops.DoResourceDelete(ConfigMapType, container);
+    }
+}
+
+export const IngressBackendType = "ingressBackend";
+export const IngressBackendFieldServiceID = "serviceId";
+export const IngressBackendFieldTargetPort = "targetPort";
+export const IngressBackendFieldWorkloadIDs = "workloadIds";
+
+export interface IngressBackend {
+    serviceId?: string;
+    targetPort?: number | string;
+    workloadIds?: string[];
+}
+
+export const HTTPIngressPathType = "httpIngressPath";
+export const HTTPIngressPathFieldPath = "path";
+export const HTTPIngressPathFieldServiceID = "serviceId";
+export const HTTPIngressPathFieldTargetPort = "targetPort";
+export const HTTPIngressPathFieldWorkloadIDs = "workloadIds";
+
+export interface HTTPIngressPath {
+    path?: string;
+    serviceId?: string;
+    targetPort?: number | string;
+    workloadIds?: string[];
+}
+
+export const HTTPIngressRuleValueType = "httpIngressRuleValue";
+export const HTTPIngressRuleValueFieldPaths = "paths";
+
+export interface HTTPIngressRuleValue {
+    paths?: HTTPIngressPath[];
+}
+
+export const IngressRuleType = "ingressRule";
+export const IngressRuleFieldHost = "host";
+export const IngressRuleFieldPaths = "paths";
+
+export interface IngressRule {
+    host?: string;
+    paths?: HTTPIngressPath[];
+}
+
+export const IngressTLSType = "ingressTLS";
+export const IngressTLSFieldCertificateID = "certificateId";
+export const IngressTLSFieldHosts = "hosts";
+
+export interface IngressTLS {
+    certificateId?: string;
+    hosts?: string[];
+}
+
+export const IngressSpecType = "ingressSpec";
+export const IngressSpecFieldBackend = "backend";
+export const IngressSpecFieldRules = "rules";
+export const IngressSpecFieldTLS = "tls";
+
+export interface IngressSpec {
+    backend?: IngressBackend;
+    rules?: IngressRule[];
+    tls?: IngressTLS[];
+}
+
+export const LoadBalancerIngressType = "loadBalancerIngress";
+export const LoadBalancerIngressFieldHostname = "hostname";
+export const LoadBalancerIngressFieldIP = "ip";
+
+export interface LoadBalancerIngress {
+    hostname?: string;
+    ip?: string;
+}
+
+export const LoadBalancerStatusType = "loadBalancerStatus";
+export const LoadBalancerStatusFieldIngress = "ingress";
+
+export interface LoadBalancerStatus {
+    ingress?: LoadBalancerIngress[];
+}
+
+export const IngressStatusType = "ingressStatus";
+export const IngressStatusFieldLoadBalancer = "loadBalancer";
+
+export interface IngressStatus {
+    loadBalancer?: LoadBalancerStatus;
+}
+
+export const IngressType = "ingress";
+export const IngressFieldAnnotations = "annotations";
+export const IngressFieldCreated = "created";
+export const IngressFieldCreatorID = "creatorId";
+export const IngressFieldDefaultBackend = "defaultBackend";
+export const IngressFieldDescription = "description";
+export const IngressFieldLabels = "labels";
+export const IngressFieldName = "name";
+export const IngressFieldNamespaceId = "namespaceId";
+export const IngressFieldOwnerReferences = "ownerReferences";
+export const IngressFieldProjectID = "projectId";
+export const IngressFieldPublicEndpoints = "publicEndpoints";
+export const IngressFieldRemoved = "removed";
+export const IngressFieldRules = "rules";
+export const IngressFieldState = "state";
+export const IngressFieldStatus = "status";
+export const IngressFieldTLS = "tls";
+export const IngressFieldTransitioning = "transitioning";
+export const IngressFieldTransitioningMessage = "transitioningMessage";
+export const IngressFieldUUID = "uuid";
+
+export interface Ingress extends ApiResource {
+    annotations?: { [key: string]: string };
+    created?: string;
+    creatorId?: string;
+    defaultBackend?: IngressBackend;
+    description?: string;
+    labels?: { [key: string]: string };
+    name?: string;
+    namespaceId?: string;
+    ownerReferences?: OwnerReference[];
+    projectId?: string;
+    publicEndpoints?: PublicEndpoint[];
+    removed?: string;
+    rules?: IngressRule[];
+    state?: string;
+    status?: IngressStatus;
+    tls?: IngressTLS[];
+    transitioning?: "yes" | "no" | "error";
+    transitioningMessage?: string;
+    uuid?: string;
+}
+
+export interface IngressCollection extends ApiCollection {
+    data?: Ingress[];
+}
+
+export class IngressClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<IngressCollection> {
+        return this.ops.DoList(IngressType, opts);
+    }
+
+    Next(collection: IngressCollection): Promise<IngressCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: Ingress): Promise<Ingress> {
+        return this.ops.DoCreate(IngressType, container);
+    }
+
+    Update(existing: Ingress, updates: object): Promise<Ingress> {
+        return this.ops.DoUpdate(IngressType, existing, updates);
+    }
+
+    Replace(obj: Ingress): Promise<Ingress> {
+        return this.ops.DoReplace(IngressType, obj, obj);
+    }
+
+    ByID(id: string): Promise<Ingress> {
+        return this.ops.DoByID(IngressType, id);
+    }
+
+    Delete(container: Ingress): Promise<void> {
+        return this.ops.DoResourceDelete(IngressType, container);
+    }
+}
+
+export const SecretType = "secret";
+export const SecretFieldAnnotations = "annotations";
+export const SecretFieldCreated = "created";
+export const SecretFieldCreatorID = "creatorId";
+export const SecretFieldData = "data";
+export const SecretFieldDescription = "description";
+export const SecretFieldKind = "kind";
+export const SecretFieldLabels = "labels";
+export const SecretFieldName = "name";
+export const SecretFieldNamespaceId = "namespaceId";
+export const SecretFieldOwnerReferences = "ownerReferences";
+export const SecretFieldProjectID = "projectId";
+export const SecretFieldRemoved = "removed";
+export const SecretFieldStringData = "stringData";
+export const SecretFieldUUID = "uuid";
+
+export interface Secret extends ApiResource {
+    annotations?: { [key: string]: string };
+    created?: string;
+    creatorId?: string;
+    data?: { [key: string]: string };
+    description?: string;
+    kind?: string;
+    labels?: { [key: string]: string };
+    name?: string;
+    namespaceId?: string;
+    ownerReferences?: OwnerReference[];
+    projectId?: string;
+    removed?: string;
+    stringData?: { [key: string]: string };
+    uuid?: string;
+}
+
+export interface SecretCollection extends ApiCollection {
+    data?: Secret[];
+}
+
+export class SecretClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<SecretCollection> {
+        return this.ops.DoList(SecretType, opts);
+    }
+
+    Next(collection: SecretCollection): Promise<SecretCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: Secret): Promise<Secret> {
+        return this.ops.DoCreate(SecretType, container);
+    }
+
+    Update(existing: Secret, updates: object): Promise<Secret> {
+        return this.ops.DoUpdate(SecretType, existing, updates);
+    }
+
+    Replace(obj: Secret): Promise<Secret> {
+        return this.ops.DoReplace(SecretType, obj, obj);
+    }
+
+    ByID(id: string): Promise<Secret> {
+        return this.ops.DoByID(SecretType, id);
+    }
+
+    Delete(container: Secret): Promise<void> {
+        return this.ops.DoResourceDelete(SecretType, container);
+    }
+}
+
+export const ServiceAccountTokenType = "serviceAccountToken";
+export const ServiceAccountTokenFieldAccountName = "accountName";
+export const ServiceAccountTokenFieldAccountUID = "accountUid";
+export const ServiceAccountTokenFieldAnnotations = "annotations";
+export const ServiceAccountTokenFieldCACRT = "caCrt";
+export const ServiceAccountTokenFieldCreated = "created";
+export const ServiceAccountTokenFieldCreatorID = "creatorId";
+export const ServiceAccountTokenFieldDescription = "description";
+export const ServiceAccountTokenFieldLabels = "labels";
+export const ServiceAccountTokenFieldName = "name";
+export const ServiceAccountTokenFieldNamespaceId = "namespaceId";
+export const ServiceAccountTokenFieldOwnerReferences = "ownerReferences";
+export const ServiceAccountTokenFieldProjectID = "projectId";
+export const ServiceAccountTokenFieldRemoved = "removed";
+export const ServiceAccountTokenFieldToken = "token";
+export const ServiceAccountTokenFieldUUID = "uuid";
+
+export interface ServiceAccountToken extends ApiResource {
+    accountName?: string;
+    accountUid?: string;
+    annotations?: { [key: string]: string };
+    caCrt?: string;
+    created?: string;
+    creatorId?: string;
+    description?: string;
+    labels?: { [key: string]: string };
+    name?: string;
+    namespaceId?: string;
+    ownerReferences?: OwnerReference[];
+    projectId?: string;
+    removed?: string;
+    token?: string;
+    uuid?: string;
+}
+
+export interface ServiceAccountTokenCollection extends ApiCollection {
+    data?: ServiceAccountToken[];
+}
+
+export class ServiceAccountTokenClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<ServiceAccountTokenCollection> {
+        return this.ops.DoList(ServiceAccountTokenType, opts);
+    }
+
+    Next(collection: ServiceAccountTokenCollection): Promise<ServiceAccountTokenCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: ServiceAccountToken): Promise<ServiceAccountToken> {
+        return this.ops.DoCreate(ServiceAccountTokenType, container);
+    }
+
+    Update(existing: ServiceAccountToken, updates: object): Promise<ServiceAccountToken> {
+        return this.ops.DoUpdate(ServiceAccountTokenType, existing, updates);
+    }
+
+    Replace(obj: ServiceAccountToken): Promise<ServiceAccountToken> {
+        return this.ops.DoReplace(ServiceAccountTokenType, obj, obj);
+    }
+
+    ByID(id: string): Promise<ServiceAccountToken> {
+        return this.ops.DoByID(ServiceAccountTokenType, id);
+    }
+
+    Delete(container: ServiceAccountToken): Promise<void> {
+        return this.ops.DoResourceDelete(ServiceAccountTokenType, container);
+    }
+}
+
+export const RegistryCredentialType = "registryCredential";
+export const RegistryCredentialFieldAuth = "auth";
+export const RegistryCredentialFieldDescription = "description";
+export const RegistryCredentialFieldEmail = "email";
+export const RegistryCredentialFieldPassword = "password";
+export const RegistryCredentialFieldUsername = "username";
+
+export interface RegistryCredential {
+    auth?: string;
+    description?: string;
+    email?: string;
+    password?: string;
+    username?: string;
+}
+
+export const DockerCredentialType = "dockerCredential";
+export const DockerCredentialFieldAnnotations = "annotations";
+export const DockerCredentialFieldCreated = "created";
+export const DockerCredentialFieldCreatorID = "creatorId";
+export const DockerCredentialFieldDescription = "description";
+export const DockerCredentialFieldLabels = "labels";
+export const DockerCredentialFieldName = "name";
+export const DockerCredentialFieldNamespaceId = "namespaceId";
+export const DockerCredentialFieldOwnerReferences = "ownerReferences";
+export const DockerCredentialFieldProjectID = "projectId";
+export const DockerCredentialFieldRegistries = "registries";
+export const DockerCredentialFieldRemoved = "removed";
+export const DockerCredentialFieldUUID = "uuid";
+
+export interface DockerCredential extends ApiResource {
+    annotations?: { [key: string]: string };
+    created?: string;
+    creatorId?: string;
+    description?: string;
+    labels?: { [key: string]: string };
+    name?: string;
+    namespaceId?: string;
+    ownerReferences?: OwnerReference[];
+    projectId?: string;
+    registries?: { [key: string]: RegistryCredential };
+    removed?: string;
+    uuid?: string;
+}
+
+export interface DockerCredentialCollection extends ApiCollection {
+    data?: DockerCredential[];
+}
+
+export class DockerCredentialClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<DockerCredentialCollection> {
+        return this.ops.DoList(DockerCredentialType, opts);
+    }
+
+    Next(collection: DockerCredentialCollection): Promise<DockerCredentialCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: DockerCredential): Promise<DockerCredential> {
+        return this.ops.DoCreate(DockerCredentialType, container);
+    }
+
+    Update(existing: DockerCredential, updates: object): Promise<DockerCredential> {
+        return this.ops.DoUpdate(DockerCredentialType, existing, updates);
+    }
+
+    Replace(obj: DockerCredential): Promise<DockerCredential> {
+        return this.ops.DoReplace(DockerCredentialType, obj, obj);
+    }
+
+    ByID(id: string): Promise<DockerCredential> {
+        return this.ops.DoByID(DockerCredentialType, id);
+    }
+
+    Delete(container: DockerCredential): Promise<void> {
+        return this.ops.DoResourceDelete(DockerCredentialType, container);
+    }
+}
+
+export const CertificateType = "certificate";
+export const CertificateFieldAlgorithm = "algorithm";
+export const CertificateFieldAnnotations = "annotations";
+export const CertificateFieldCN = "cn";
+export const CertificateFieldCertFingerprint = "certFingerprint";
+export const CertificateFieldCerts = "certs";
+export const CertificateFieldCreated = "created";
+export const CertificateFieldCreatorID = "creatorId";
+export const CertificateFieldDescription = "description";
+export const CertificateFieldExpiresAt = "expiresAt";
+export const CertificateFieldIssuedAt = "issuedAt";
+export const CertificateFieldIssuer = "issuer";
+export const CertificateFieldKey = "key";
+export const CertificateFieldKeySize = "keySize";
+export const CertificateFieldLabels = "labels";
+export const CertificateFieldName = "name";
+export const CertificateFieldNamespaceId = "namespaceId";
+export const CertificateFieldOwnerReferences = "ownerReferences";
+export const CertificateFieldProjectID = "projectId";
+export const CertificateFieldRemoved = "removed";
+export const CertificateFieldSerialNumber = "serialNumber";
+export const CertificateFieldSubjectAlternativeNames = "subjectAlternativeNames";
+export const CertificateFieldUUID = "uuid";
+export const CertificateFieldVersion = "version";
+
+export interface Certificate extends ApiResource {
+    algorithm?: string;
+    annotations?: { [key: string]: string };
+    cn?: string;
+    certFingerprint?: string;
+    certs?: string;
+    created?: string;
+    creatorId?: string;
+    description?: string;
+    expiresAt?: string;
+    issuedAt?: string;
+    issuer?: string;
+    key?: string;
+    keySize?: string;
+    labels?: { [key: string]: string };
+    name?: string;
+    namespaceId?: string;
+    ownerReferences?: OwnerReference[];
+    projectId?: string;
+    removed?: string;
+    serialNumber?: string;
+    subjectAlternativeNames?: string[];
+    uuid?: string;
+    version?: string;
+}
+
+export interface CertificateCollection extends ApiCollection {
+    data?: Certificate[];
+}
+
+export class CertificateClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<CertificateCollection> {
+        return this.ops.DoList(CertificateType, opts);
+    }
+
+    Next(collection: CertificateCollection): Promise<CertificateCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: Certificate): Promise<Certificate> {
+        return this.ops.DoCreate(CertificateType, container);
+    }
+
+    Update(existing: Certificate, updates: object): Promise<Certificate> {
+        return this.ops.DoUpdate(CertificateType, existing, updates);
+    }
+
+    Replace(obj: Certificate): Promise<Certificate> {
+        return this.ops.DoReplace(CertificateType, obj, obj);
+    }
+
+    ByID(id: string): Promise<Certificate> {
+        return this.ops.DoByID(CertificateType, id);
+    }
+
+    Delete(container: Certificate): Promise<void> {
+        return this.ops.DoResourceDelete(CertificateType, container);
+    }
+}
+
+export const BasicAuthType = "basicAuth";
+export const BasicAuthFieldAnnotations = "annotations";
+export const BasicAuthFieldCreated = "created";
+export const BasicAuthFieldCreatorID = "creatorId";
+export const BasicAuthFieldDescription = "description";
+export const BasicAuthFieldLabels = "labels";
+export const BasicAuthFieldName = "name";
+export const BasicAuthFieldNamespaceId = "namespaceId";
+export const BasicAuthFieldOwnerReferences = "ownerReferences";
+export const BasicAuthFieldPassword = "password";
+export const BasicAuthFieldProjectID = "projectId";
+export const BasicAuthFieldRemoved = "removed";
+export const BasicAuthFieldUUID = "uuid";
+export const BasicAuthFieldUsername = "username";
+
+export interface BasicAuth extends ApiResource {
+    annotations?: { [key: string]: string };
+    created?: string;
+    creatorId?: string;
+    description?: string;
+    labels?: { [key: string]: string };
+    name?: string;
+    namespaceId?: string;
+    ownerReferences?: OwnerReference[];
+    password?: string;
+    projectId?: string;
+    removed?: string;
+    uuid?: string;
+    username?: string;
+}
+
+export interface BasicAuthCollection extends ApiCollection {
+    data?: BasicAuth[];
+}
+
+export class BasicAuthClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<BasicAuthCollection> {
+        return this.ops.DoList(BasicAuthType, opts);
+    }
+
+    Next(collection: BasicAuthCollection): Promise<BasicAuthCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: BasicAuth): Promise<BasicAuth> {
+        return this.ops.DoCreate(BasicAuthType, container);
+    }
+
+    Update(existing: BasicAuth, updates: object): Promise<BasicAuth> {
+        return this.ops.DoUpdate(BasicAuthType, existing, updates);
+    }
+
+    Replace(obj: BasicAuth): Promise<BasicAuth> {
+        return this.ops.DoReplace(BasicAuthType, obj, obj);
+    }
+
+    ByID(id: string): Promise<BasicAuth> {
+        return this.ops.DoByID(BasicAuthType, id);
+    }
+
+    Delete(container: BasicAuth): Promise<void> {
+        return this.ops.DoResourceDelete(BasicAuthType, container);
+    }
+}
+
+export const SSHAuthType = "sshAuth";
+export const SSHAuthFieldAnnotations = "annotations";
+export const SSHAuthFieldCreated = "created";
+export const SSHAuthFieldCreatorID = "creatorId";
+export const SSHAuthFieldDescription = "description";
+export const SSHAuthFieldFingerprint = "certFingerprint";
+export const SSHAuthFieldLabels = "labels";
+export const SSHAuthFieldName = "name";
+export const SSHAuthFieldNamespaceId = "namespaceId";
+export const SSHAuthFieldOwnerReferences = "ownerReferences";
+export const SSHAuthFieldPrivateKey = "privateKey";
+export const SSHAuthFieldProjectID = "projectId";
+export const SSHAuthFieldRemoved = "removed";
+export const SSHAuthFieldUUID = "uuid";
+
+export interface SSHAuth extends ApiResource {
+    annotations?: { [key: string]: string };
+    created?: string;
+    creatorId?: string;
+    description?: string;
+    certFingerprint?: string;
+    labels?: { [key: string]: string };
+    name?: string;
+    namespaceId?: string;
+    ownerReferences?: OwnerReference[];
+    privateKey?: string;
+    projectId?: string;
+    removed?: string;
+    uuid?: string;
+}
+
+export interface SSHAuthCollection extends ApiCollection {
+    data?: SSHAuth[];
+}
+
+export class SSHAuthClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<SSHAuthCollection> {
+        return this.ops.DoList(SSHAuthType, opts);
+    }
+
+    Next(collection: SSHAuthCollection): Promise<SSHAuthCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: SSHAuth): Promise<SSHAuth> {
+        return this.ops.DoCreate(SSHAuthType, container);
+    }
+
+    Update(existing: SSHAuth, updates: object): Promise<SSHAuth> {
+        return this.ops.DoUpdate(SSHAuthType, existing, updates);
+    }
+
+    Replace(obj: SSHAuth): Promise<SSHAuth> {
+        return this.ops.DoReplace(SSHAuthType, obj, obj);
+    }
+
+    ByID(id: string): Promise<SSHAuth> {
+        return this.ops.DoByID(SSHAuthType, id);
+    }
+
+    Delete(container: SSHAuth): Promise<void> {
+        return this.ops.DoResourceDelete(SSHAuthType, container);
+    }
+}
+
+export const NamespacedSecretType = "namespacedSecret";
+export const NamespacedSecretFieldAnnotations = "annotations";
+export const NamespacedSecretFieldCreated = "created";
+export const NamespacedSecretFieldCreatorID = "creatorId";
+export const NamespacedSecretFieldData = "data";
+export const NamespacedSecretFieldDescription = "description";
+export const NamespacedSecretFieldKind = "kind";
+export const NamespacedSecretFieldLabels = "labels";
+export const NamespacedSecretFieldName = "name";
+export const NamespacedSecretFieldNamespaceId = "namespaceId";
+export const NamespacedSecretFieldOwnerReferences = "ownerReferences";
+export const NamespacedSecretFieldProjectID = "projectId";
+export const NamespacedSecretFieldRemoved = "removed";
+export const NamespacedSecretFieldStringData = "stringData";
+export const NamespacedSecretFieldUUID = "uuid";
+
+export interface NamespacedSecret extends ApiResource {
+    annotations?: { [key: string]: string };
+    created?: string;
+    creatorId?: string;
+    data?: { [key: string]: string };
+    description?: string;
+    kind?: string;
+    labels?: { [key: string]: string };
+    name?: string;
+    namespaceId?: string;
+    ownerReferences?: OwnerReference[];
+    projectId?: string;
+    removed?: string;
+    stringData?: { [key: string]: string };
+    uuid?: string;
+}
+
+export interface NamespacedSecretCollection extends ApiCollection {
+    data?: NamespacedSecret[];
+}
+
+export class NamespacedSecretClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<NamespacedSecretCollection> {
+        return this.ops.DoList(NamespacedSecretType, opts);
+    }
+
+    Next(collection: NamespacedSecretCollection): Promise<NamespacedSecretCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: NamespacedSecret): Promise<NamespacedSecret> {
+        return this.ops.DoCreate(NamespacedSecretType, container);
+    }
+
+    Update(existing: NamespacedSecret, updates: object): Promise<NamespacedSecret> {
+        return this.ops.DoUpdate(NamespacedSecretType, existing, updates);
+    }
+
+    Replace(obj: NamespacedSecret): Promise<NamespacedSecret> {
+        return this.ops.DoReplace(NamespacedSecretType, obj, obj);
+    }
+
+    ByID(id: string): Promise<NamespacedSecret> {
+        return this.ops.DoByID(NamespacedSecretType, id);
+    }
+
+    Delete(container: NamespacedSecret): Promise<void> {
+        return this.ops.DoResourceDelete(NamespacedSecretType, container);
+    }
+}
+
+export const NamespacedServiceAccountTokenType = "namespacedServiceAccountToken";
+export const NamespacedServiceAccountTokenFieldAccountName = "accountName";
+export const NamespacedServiceAccountTokenFieldAccountUID = "accountUid";
+export const NamespacedServiceAccountTokenFieldAnnotations = "annotations";
+export const NamespacedServiceAccountTokenFieldCACRT = "caCrt";
+export const NamespacedServiceAccountTokenFieldCreated = "created";
+export const NamespacedServiceAccountTokenFieldCreatorID = "creatorId";
+export const NamespacedServiceAccountTokenFieldDescription = "description";
+export const NamespacedServiceAccountTokenFieldLabels = "labels";
+export const NamespacedServiceAccountTokenFieldName = "name";
+export const NamespacedServiceAccountTokenFieldNamespaceId = "namespaceId";
+export const NamespacedServiceAccountTokenFieldOwnerReferences = "ownerReferences";
+export const NamespacedServiceAccountTokenFieldProjectID = "projectId";
+export const NamespacedServiceAccountTokenFieldRemoved = "removed";
+export const NamespacedServiceAccountTokenFieldToken = "token";
+export const NamespacedServiceAccountTokenFieldUUID = "uuid";
+
+export interface NamespacedServiceAccountToken extends ApiResource {
+    accountName?: string;
+    accountUid?: string;
+    annotations?: { [key: string]: string };
+    caCrt?: string;
+    created?: string;
+    creatorId?: string;
+    description?: string;
+    labels?: { [key: string]: string };
+    name?: string;
+    namespaceId?: string;
+    ownerReferences?: OwnerReference[];
+    projectId?: string;
+    removed?: string;
+    token?: string;
+    uuid?: string;
+}
+
+export interface NamespacedServiceAccountTokenCollection extends ApiCollection {
+    data?: NamespacedServiceAccountToken[];
+}
+
+export class NamespacedServiceAccountTokenClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<NamespacedServiceAccountTokenCollection> {
+        return this.ops.DoList(NamespacedServiceAccountTokenType, opts);
+    }
+
+    Next(collection: NamespacedServiceAccountTokenCollection): Promise<NamespacedServiceAccountTokenCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: NamespacedServiceAccountToken): Promise<NamespacedServiceAccountToken> {
+        return this.ops.DoCreate(NamespacedServiceAccountTokenType, container);
+    }
+
+    Update(existing: NamespacedServiceAccountToken, updates: object): Promise<NamespacedServiceAccountToken> {
+        return this.ops.DoUpdate(NamespacedServiceAccountTokenType, existing, updates);
+    }
+
+    Replace(obj: NamespacedServiceAccountToken): Promise<NamespacedServiceAccountToken> {
+        return this.ops.DoReplace(NamespacedServiceAccountTokenType, obj, obj);
+    }
+
+    ByID(id: string): Promise<NamespacedServiceAccountToken> {
+        return this.ops.DoByID(NamespacedServiceAccountTokenType, id);
+    }
+
+    Delete(container: NamespacedServiceAccountToken): Promise<void> {
+        return this.ops.DoResourceDelete(NamespacedServiceAccountTokenType, container);
+    }
+}
+
+export const NamespacedDockerCredentialType = "namespacedDockerCredential";
+export const NamespacedDockerCredentialFieldAnnotations = "annotations";
+export const NamespacedDockerCredentialFieldCreated = "created";
+export const NamespacedDockerCredentialFieldCreatorID = "creatorId";
+export const NamespacedDockerCredentialFieldDescription = "description";
+export const NamespacedDockerCredentialFieldLabels = "labels";
+export const NamespacedDockerCredentialFieldName = "name";
+export const NamespacedDockerCredentialFieldNamespaceId = "namespaceId";
+export const NamespacedDockerCredentialFieldOwnerReferences = "ownerReferences";
+export const NamespacedDockerCredentialFieldProjectID = "projectId";
+export const NamespacedDockerCredentialFieldRegistries = "registries";
+export const NamespacedDockerCredentialFieldRemoved = "removed";
+export const NamespacedDockerCredentialFieldUUID = "uuid";
+
+export interface NamespacedDockerCredential extends ApiResource {
+    annotations?: { [key: string]: string };
+    created?: string;
+    creatorId?: string;
+    description?: string;
+    labels?: { [key: string]: string };
+    name?: string;
+    namespaceId?: string;
+    ownerReferences?: OwnerReference[];
+    projectId?: string;
+    registries?: { [key: string]: RegistryCredential };
+    removed?: string;
+    uuid?: string;
+}
+
+export interface NamespacedDockerCredentialCollection extends ApiCollection {
+    data?: NamespacedDockerCredential[];
+}
+
+export class NamespacedDockerCredentialClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<NamespacedDockerCredentialCollection> {
+        return this.ops.DoList(NamespacedDockerCredentialType, opts);
+    }
+
+    Next(collection: NamespacedDockerCredentialCollection): Promise<NamespacedDockerCredentialCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: NamespacedDockerCredential): Promise<NamespacedDockerCredential> {
+        return this.ops.DoCreate(NamespacedDockerCredentialType, container);
+    }
+
+    Update(existing: NamespacedDockerCredential, updates: object): Promise<NamespacedDockerCredential> {
+        return this.ops.DoUpdate(NamespacedDockerCredentialType, existing, updates);
+    }
+
+    Replace(obj: NamespacedDockerCredential): Promise<NamespacedDockerCredential> {
+        return this.ops.DoReplace(NamespacedDockerCredentialType, obj, obj);
+    }
+
+    ByID(id: string): Promise<NamespacedDockerCredential> {
+        return this.ops.DoByID(NamespacedDockerCredentialType, id);
+    }
+
+    Delete(container: NamespacedDockerCredential): Promise<void> {
+        return this.ops.DoResourceDelete(NamespacedDockerCredentialType, container);
+    }
+}
+
+export const NamespacedCertificateType = "namespacedCertificate";
+export const NamespacedCertificateFieldAlgorithm = "algorithm";
+export const NamespacedCertificateFieldAnnotations = "annotations";
+export const NamespacedCertificateFieldCN = "cn";
+export const NamespacedCertificateFieldCertFingerprint = "certFingerprint";
+export const NamespacedCertificateFieldCerts = "certs";
+export const NamespacedCertificateFieldCreated = "created";
+export const NamespacedCertificateFieldCreatorID = "creatorId";
+export const NamespacedCertificateFieldDescription = "description";
+export const NamespacedCertificateFieldExpiresAt = "expiresAt";
+export const NamespacedCertificateFieldIssuedAt = "issuedAt";
+export const NamespacedCertificateFieldIssuer = "issuer";
+export const NamespacedCertificateFieldKey = "key";
+export const NamespacedCertificateFieldKeySize = "keySize";
+export const NamespacedCertificateFieldLabels = "labels";
+export const NamespacedCertificateFieldName = "name";
+export const NamespacedCertificateFieldNamespaceId = "namespaceId";
+export const NamespacedCertificateFieldOwnerReferences = "ownerReferences";
+export const NamespacedCertificateFieldProjectID = "projectId";
+export const NamespacedCertificateFieldRemoved = "removed";
+export const NamespacedCertificateFieldSerialNumber = "serialNumber";
+export const NamespacedCertificateFieldSubjectAlternativeNames = "subjectAlternativeNames";
+export const NamespacedCertificateFieldUUID = "uuid";
+export const NamespacedCertificateFieldVersion = "version";
+
+export interface NamespacedCertificate extends ApiResource {
+    algorithm?: string;
+    annotations?: { [key: string]: string };
+    cn?: string;
+    certFingerprint?: string;
+    certs?: string;
+    created?: string;
+    creatorId?: string;
+    description?: string;
+    expiresAt?: string;
+    issuedAt?: string;
+    issuer?: string;
+    key?: string;
+    keySize?: string;
+    labels?: { [key: string]: string };
+    name?: string;
+    namespaceId?: string;
+    ownerReferences?: OwnerReference[];
+    projectId?: string;
+    removed?: string;
+    serialNumber?: string;
+    subjectAlternativeNames?: string[];
+    uuid?: string;
+    version?: string;
+}
+
+export interface NamespacedCertificateCollection extends ApiCollection {
+    data?: NamespacedCertificate[];
+}
+
+export class NamespacedCertificateClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<NamespacedCertificateCollection> {
+        return this.ops.DoList(NamespacedCertificateType, opts);
+    }
+
+    Next(collection: NamespacedCertificateCollection): Promise<NamespacedCertificateCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: NamespacedCertificate): Promise<NamespacedCertificate> {
+        return this.ops.DoCreate(NamespacedCertificateType, container);
+    }
+
+    Update(existing: NamespacedCertificate, updates: object): Promise<NamespacedCertificate> {
+        return this.ops.DoUpdate(NamespacedCertificateType, existing, updates);
+    }
+
+    Replace(obj: NamespacedCertificate): Promise<NamespacedCertificate> {
+        return this.ops.DoReplace(NamespacedCertificateType, obj, obj);
+    }
+
+    ByID(id: string): Promise<NamespacedCertificate> {
+        return this.ops.DoByID(NamespacedCertificateType, id);
+    }
+
+    Delete(container: NamespacedCertificate): Promise<void> {
+        return this.ops.DoResourceDelete(NamespacedCertificateType, container);
+    }
+}
+
+export const NamespacedBasicAuthType = "namespacedBasicAuth";
+export const NamespacedBasicAuthFieldAnnotations = "annotations";
+export const NamespacedBasicAuthFieldCreated = "created";
+export const NamespacedBasicAuthFieldCreatorID = "creatorId";
+export const NamespacedBasicAuthFieldDescription = "description";
+export const NamespacedBasicAuthFieldLabels = "labels";
+export const NamespacedBasicAuthFieldName = "name";
+export const NamespacedBasicAuthFieldNamespaceId = "namespaceId";
+export const NamespacedBasicAuthFieldOwnerReferences = "ownerReferences";
+export const NamespacedBasicAuthFieldPassword = "password";
+export const NamespacedBasicAuthFieldProjectID = "projectId";
+export const NamespacedBasicAuthFieldRemoved = "removed";
+export const NamespacedBasicAuthFieldUUID = "uuid";
+export const NamespacedBasicAuthFieldUsername = "username";
+
+export interface NamespacedBasicAuth extends ApiResource {
+    annotations?: { [key: string]: string };
+    created?: string;
+    creatorId?: string;
+    description?: string;
+    labels?: { [key: string]: string };
+    name?: string;
+    namespaceId?: string;
+    ownerReferences?: OwnerReference[];
+    password?: string;
+    projectId?: string;
+    removed?: string;
+    uuid?: string;
+    username?: string;
+}
+
+export interface NamespacedBasicAuthCollection extends ApiCollection {
+    data?: NamespacedBasicAuth[];
+}
+
+export class NamespacedBasicAuthClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<NamespacedBasicAuthCollection> {
+        return this.ops.DoList(NamespacedBasicAuthType, opts);
+    }
+
+    Next(collection: NamespacedBasicAuthCollection): Promise<NamespacedBasicAuthCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: NamespacedBasicAuth): Promise<NamespacedBasicAuth> {
+        return this.ops.DoCreate(NamespacedBasicAuthType, container);
+    }
+
+    Update(existing: NamespacedBasicAuth, updates: object): Promise<NamespacedBasicAuth> {
+        return this.ops.DoUpdate(NamespacedBasicAuthType, existing, updates);
+    }
+
+    Replace(obj: NamespacedBasicAuth): Promise<NamespacedBasicAuth> {
+        return this.ops.DoReplace(NamespacedBasicAuthType, obj, obj);
+    }
+
+    ByID(id: string): Promise<NamespacedBasicAuth> {
+        return this.ops.DoByID(NamespacedBasicAuthType, id);
+    }
+
+    Delete(container: NamespacedBasicAuth): Promise<void> {
+        return this.ops.DoResourceDelete(NamespacedBasicAuthType, container);
+    }
+}
+
+export const NamespacedSSHAuthType = "namespacedSshAuth";
+export const NamespacedSSHAuthFieldAnnotations = "annotations";
+export const NamespacedSSHAuthFieldCreated = "created";
+export const NamespacedSSHAuthFieldCreatorID = "creatorId";
+export const NamespacedSSHAuthFieldDescription = "description";
+export const NamespacedSSHAuthFieldFingerprint = "certFingerprint";
+export const NamespacedSSHAuthFieldLabels = "labels";
+export const NamespacedSSHAuthFieldName = "name";
+export const NamespacedSSHAuthFieldNamespaceId = "namespaceId";
+export const NamespacedSSHAuthFieldOwnerReferences = "ownerReferences";
+export const NamespacedSSHAuthFieldPrivateKey = "privateKey";
+export const NamespacedSSHAuthFieldProjectID = "projectId";
+export const NamespacedSSHAuthFieldRemoved = "removed";
+export const NamespacedSSHAuthFieldUUID = "uuid";
+
+export interface NamespacedSSHAuth extends ApiResource {
+    annotations?: { [key: string]: string };
+    created?: string;
+    creatorId?: string;
+    description?: string;
+    certFingerprint?: string;
+    labels?: { [key: string]: string };
+    name?: string;
+    namespaceId?: string;
+    ownerReferences?: OwnerReference[];
+    privateKey?: string;
+    projectId?: string;
+    removed?: string;
+    uuid?: string;
+}
+
+export interface NamespacedSSHAuthCollection extends ApiCollection {
+    data?: NamespacedSSHAuth[];
+}
+
+export class NamespacedSSHAuthClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<NamespacedSSHAuthCollection> {
+        return this.ops.DoList(NamespacedSSHAuthType, opts);
+    }
+
+    Next(collection: NamespacedSSHAuthCollection): Promise<NamespacedSSHAuthCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: NamespacedSSHAuth): Promise<NamespacedSSHAuth> {
+        return this.ops.DoCreate(NamespacedSSHAuthType, container);
+    }
+
+    Update(existing: NamespacedSSHAuth, updates: object): Promise<NamespacedSSHAuth> {
+        return this.ops.DoUpdate(NamespacedSSHAuthType, existing, updates);
+    }
+
+    Replace(obj: NamespacedSSHAuth): Promise<NamespacedSSHAuth> {
+        return this.ops.DoReplace(NamespacedSSHAuthType, obj, obj);
+    }
+
+    ByID(id: string): Promise<NamespacedSSHAuth> {
+        return this.ops.DoByID(NamespacedSSHAuthType, id);
+    }
+
+    Delete(container: NamespacedSSHAuth): Promise<void> {
+        return this.ops.DoResourceDelete(NamespacedSSHAuthType, container);
+    }
+}
+
+export const ServiceType = "service";
+export const ServiceFieldAnnotations = "annotations";
+export const ServiceFieldClusterIp = "clusterIp";
+export const ServiceFieldCreated = "created";
+export const ServiceFieldCreatorID = "creatorId";
+export const ServiceFieldDescription = "description";
+export const ServiceFieldExternalIPs = "externalIPs";
+export const ServiceFieldExternalTrafficPolicy = "externalTrafficPolicy";
+export const ServiceFieldHealthCheckNodePort = "healthCheckNodePort";
+export const ServiceFieldHostname = "hostname";
+export const ServiceFieldIPAddresses = "ipAddresses";
+export const ServiceFieldIPFamily = "ipFamily";
+export const ServiceFieldKind = "kind";
+export const ServiceFieldLabels = "labels";
+export const ServiceFieldLoadBalancerIP = "loadBalancerIP";
+export const ServiceFieldLoadBalancerSourceRanges = "loadBalancerSourceRanges";
+export const ServiceFieldName = "name";
+export const ServiceFieldNamespaceId = "namespaceId";
+export const ServiceFieldOwnerReferences = "ownerReferences";
+export const ServiceFieldPorts = "ports";
+export const ServiceFieldProjectID = "projectId";
+export const ServiceFieldPublicEndpoints = "publicEndpoints";
+export const ServiceFieldPublishNotReadyAddresses = "publishNotReadyAddresses";
+export const ServiceFieldRemoved = "removed";
+export const ServiceFieldSelector = "selector";
+export const ServiceFieldSessionAffinity = "sessionAffinity";
+export const ServiceFieldSessionAffinityConfig = "sessionAffinityConfig";
+export const ServiceFieldState = "state";
+export const ServiceFieldTargetDNSRecordIDs = "targetDnsRecordIds";
+export const ServiceFieldTargetWorkloadIDs = "targetWorkloadIds";
+export const ServiceFieldTransitioning = "transitioning";
+export const ServiceFieldTransitioningMessage = "transitioningMessage";
+export const ServiceFieldUUID = "uuid";
+export const ServiceFieldWorkloadID = "workloadId";
+
+export interface Service extends ApiResource {
+    annotations?: { [key: string]: string };
+    clusterIp?: string;
+    created?: string;
+    creatorId?: string;
+    description?: string;
+    externalIPs?: string[];
+    externalTrafficPolicy?: string;
+    healthCheckNodePort?: number;
+    hostname?: string;
+    ipAddresses?: string[];
+    ipFamily?: string;
+    kind?: string;
+    labels?: { [key: string]: string };
+    loadBalancerIP?: string;
+    loadBalancerSourceRanges?: string[];
+    name?: string;
+    namespaceId?: string;
+    ownerReferences?: OwnerReference[];
+    ports?: ServicePort[];
+    projectId?: string;
+    publicEndpoints?: PublicEndpoint[];
+    publishNotReadyAddresses?: boolean;
+    removed?: string;
+    selector?: { [key: string]: string };
+    sessionAffinity?: string;
+    sessionAffinityConfig?: SessionAffinityConfig;
+    state?: string;
+    targetDnsRecordIds?: string[];
+    targetWorkloadIds?: string[];
+    transitioning?: "yes" | "no" | "error";
+    transitioningMessage?: string;
+    uuid?: string;
+    workloadId?: string;
+}
+
+export interface ServiceCollection extends ApiCollection {
+    data?: Service[];
+}
+
+export class ServiceClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<ServiceCollection> {
+        return this.ops.DoList(ServiceType, opts);
+    }
+
+    Next(collection: ServiceCollection): Promise<ServiceCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: Service): Promise<Service> {
+        return this.ops.DoCreate(ServiceType, container);
+    }
+
+    Update(existing: Service, updates: object): Promise<Service> {
+        return this.ops.DoUpdate(ServiceType, existing, updates);
+    }
+
+    Replace(obj: Service): Promise<Service> {
+        return this.ops.DoReplace(ServiceType, obj, obj);
+    }
+
+    ByID(id: string): Promise<Service> {
+        return this.ops.DoByID(ServiceType, id);
+    }
+
+    Delete(container: Service): Promise<void> {
+        return this.ops.DoResourceDelete(ServiceType, container);
+    }
+}
+
+export const ServicePortType = "servicePort";
+export const ServicePortFieldName = "name";
+export const ServicePortFieldNodePort = "nodePort";
+export const ServicePortFieldPort = "port";
+export const ServicePortFieldProtocol = "protocol";
+export const ServicePortFieldTargetPort = "targetPort";
+
+export interface ServicePort {
+    name?: string;
+    nodePort?: number;
+    port?: number;
+    protocol?: string;
+    targetPort?: number | string;
+}
+
+export const ClientIPConfigType = "clientIPConfig";
+export const ClientIPConfigFieldTimeoutSeconds = "timeoutSeconds";
+
+export interface ClientIPConfig {
+    timeoutSeconds?: number;
+}
+
+export const SessionAffinityConfigType = "sessionAffinityConfig";
+export const SessionAffinityConfigFieldClientIP = "clientIP";
+
+export interface SessionAffinityConfig {
+    clientIP?: ClientIPConfig;
+}
+
+export const ServiceSpecType = "serviceSpec";
+export const ServiceSpecFieldClusterIp = "clusterIp";
+export const ServiceSpecFieldExternalIPs = "externalIPs";
+export const ServiceSpecFieldExternalTrafficPolicy = "externalTrafficPolicy";
+export const ServiceSpecFieldHealthCheckNodePort = "healthCheckNodePort";
+export const ServiceSpecFieldHostname = "hostname";
+export const ServiceSpecFieldIPFamily = "ipFamily";
+export const ServiceSpecFieldLoadBalancerIP = "loadBalancerIP";
+export const ServiceSpecFieldLoadBalancerSourceRanges = "loadBalancerSourceRanges";
+export const ServiceSpecFieldPorts = "ports";
+export const ServiceSpecFieldPublishNotReadyAddresses = "publishNotReadyAddresses";
+export const ServiceSpecFieldSelector = "selector";
+export const ServiceSpecFieldServiceKind = "serviceKind";
+export const ServiceSpecFieldSessionAffinity = "sessionAffinity";
+export const ServiceSpecFieldSessionAffinityConfig = "sessionAffinityConfig";
+
+export interface ServiceSpec {
+    clusterIp?: string;
+    externalIPs?: string[];
+    externalTrafficPolicy?: string;
+    healthCheckNodePort?: number;
+    hostname?: string;
+    ipFamily?: string;
+    loadBalancerIP?: string;
+    loadBalancerSourceRanges?: string[];
+    ports?: ServicePort[];
+    publishNotReadyAddresses?: boolean;
+    selector?: { [key: string]: string };
+    serviceKind?: string;
+    sessionAffinity?: string;
+    sessionAffinityConfig?: SessionAffinityConfig;
+}
+
+export const ServiceStatusType = "serviceStatus";
+export const ServiceStatusFieldLoadBalancer = "loadBalancer";
+
+export interface ServiceStatus {
+    loadBalancer?: LoadBalancerStatus;
+}
+
+export const DNSRecordType = "dnsRecord";
+export const DNSRecordFieldAnnotations = "annotations";
+export const DNSRecordFieldClusterIp = "clusterIp";
+export const DNSRecordFieldCreated = "created";
+export const DNSRecordFieldCreatorID = "creatorId";
+export const DNSRecordFieldDescription = "description";
+export const DNSRecordFieldHostname = "hostname";
+export const DNSRecordFieldIPAddresses = "ipAddresses";
+export const DNSRecordFieldIPFamily = "ipFamily";
+export const DNSRecordFieldLabels = "labels";
+export const DNSRecordFieldName = "name";
+export const DNSRecordFieldNamespaceId = "namespaceId";
+export const DNSRecordFieldOwnerReferences = "ownerReferences";
+export const DNSRecordFieldPorts = "ports";
+export const DNSRecordFieldProjectID = "projectId";
+export const DNSRecordFieldPublicEndpoints = "publicEndpoints";
+export const DNSRecordFieldRemoved = "removed";
+export const DNSRecordFieldSelector = "selector";
+export const DNSRecordFieldState = "state";
+export const DNSRecordFieldTargetDNSRecordIDs = "targetDnsRecordIds";
+export const DNSRecordFieldTargetWorkloadIDs = "targetWorkloadIds";
+export const DNSRecordFieldTransitioning = "transitioning";
+export const DNSRecordFieldTransitioningMessage = "transitioningMessage";
+export const DNSRecordFieldUUID = "uuid";
+export const DNSRecordFieldWorkloadID = "workloadId";
+
+export interface DNSRecord extends ApiResource {
+    annotations?: { [key: string]: string };
+    clusterIp?: string;
+    created?: string;
+    creatorId?: string;
+    description?: string;
+    hostname?: string;
+    ipAddresses?: string[];
+    ipFamily?: string;
+    labels?: { [key: string]: string };
+    name?: string;
+    namespaceId?: string;
+    ownerReferences?: OwnerReference[];
+    ports?: ServicePort[];
+    projectId?: string;
+    publicEndpoints?: PublicEndpoint[];
+    removed?: string;
+    selector?: { [key: string]: string };
+    state?: string;
+    targetDnsRecordIds?: string[];
+    targetWorkloadIds?: string[];
+    transitioning?: "yes" | "no" | "error";
+    transitioningMessage?: string;
+    uuid?: string;
+    workloadId?: string;
+}
+
+export interface DNSRecordCollection extends ApiCollection {
+    data?: DNSRecord[];
+}
+
+export class DNSRecordClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<DNSRecordCollection> {
+        return this.ops.DoList(DNSRecordType, opts);
+    }
+
+    Next(collection: DNSRecordCollection): Promise<DNSRecordCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: DNSRecord): Promise<DNSRecord> {
+        return this.ops.DoCreate(DNSRecordType, container);
+    }
+
+    Update(existing: DNSRecord, updates: object): Promise<DNSRecord> {
+        return this.ops.DoUpdate(DNSRecordType, existing, updates);
+    }
+
+    Replace(obj: DNSRecord): Promise<DNSRecord> {
+        return this.ops.DoReplace(DNSRecordType, obj, obj);
+    }
+
+    ByID(id: string): Promise<DNSRecord> {
+        return this.ops.DoByID(DNSRecordType, id);
+    }
+
+    Delete(container: DNSRecord): Promise<void> {
+        return this.ops.DoResourceDelete(DNSRecordType, container);
+    }
+}
+
+export const ContainerPortType = "containerPort";
+export const ContainerPortFieldContainerPort = "containerPort";
+export const ContainerPortFieldDNSName = "dnsName";
+export const ContainerPortFieldHostIp = "hostIp";
+export const ContainerPortFieldHostPort = "hostPort";
+export const ContainerPortFieldKind = "kind";
+export const ContainerPortFieldName = "name";
+export const ContainerPortFieldProtocol = "protocol";
+export const ContainerPortFieldSourcePort = "sourcePort";
+
+export interface ContainerPort {
+    containerPort?: number;
+    dnsName?: string;
+    hostIp?: string;
+    hostPort?: number;
+    kind?: "HostPort" | "NodePort" | "ClusterIP" | "LoadBalancer";
+    name?: string;
+    protocol?: string;
+    sourcePort?: number;
+}
+
+export const CapabilitiesType = "capabilities";
+export const CapabilitiesFieldCapAdd = "capAdd";
+export const CapabilitiesFieldCapDrop = "capDrop";
+
+export interface Capabilities {
+    capAdd?: string[];
+    capDrop?: string[];
+}
+
+export const PublicEndpointType = "publicEndpoint";
+export const PublicEndpointFieldAddresses = "addresses";
+export const PublicEndpointFieldAllNodes = "allNodes";
+export const PublicEndpointFieldHostname = "hostname";
+export const PublicEndpointFieldIngressID = "ingressId";
+export const PublicEndpointFieldNodeID = "nodeId";
+export const PublicEndpointFieldPath = "path";
+export const PublicEndpointFieldPodID = "podId";
+export const PublicEndpointFieldPort = "port";
+export const PublicEndpointFieldProtocol = "protocol";
+export const PublicEndpointFieldServiceID = "serviceId";
+
+export interface PublicEndpoint {
+    addresses?: string[];
+    allNodes?: boolean;
+    hostname?: string;
+    ingressId?: string;
+    nodeId?: string;
+    path?: string;
+    podId?: string;
+    port?: number;
+    protocol?: string;
+    serviceId?: string;
+}
+
+export const WorkloadMetricType = "workloadMetric";
+export const WorkloadMetricFieldPath = "path";
+export const WorkloadMetricFieldPort = "port";
+export const WorkloadMetricFieldSchema = "schema";
+
+export interface WorkloadMetric {
+    path?: string;
+    port?: number;
+    schema?: "HTTP" | "HTTPS";
+}
+
+export const ExecActionType = "execAction";
+export const ExecActionFieldCommand = "command";
+
+export interface ExecAction {
+    command?: string[];
+}
+
+export const HTTPHeaderType = "httpHeader";
+export const HTTPHeaderFieldName = "name";
+export const HTTPHeaderFieldValue = "value";
+
+export interface HTTPHeader {
+    name?: string;
+    value?: string;
+}
+
+export const HTTPGetActionType = "httpGetAction";
+export const HTTPGetActionFieldHTTPHeaders = "httpHeaders";
+export const HTTPGetActionFieldHost = "host";
+export const HTTPGetActionFieldPath = "path";
+export const HTTPGetActionFieldPort = "port";
+export const HTTPGetActionFieldScheme = "scheme";
+
+export interface HTTPGetAction {
+    httpHeaders?: HTTPHeader[];
+    host?: string;
+    path?: string;
+    port?: number | string;
+    scheme?: string;
+}
+
+export const TCPSocketActionType = "tcpSocketAction";
+export const TCPSocketActionFieldHost = "host";
+export const TCPSocketActionFieldPort = "port";
+
+export interface TCPSocketAction {
+    host?: string;
+    port?: number | string;
+}
+
+export const HandlerType = "handler";
+export const HandlerFieldCommand = "command";
+export const HandlerFieldHTTPHeaders = "httpHeaders";
+export const HandlerFieldHost = "host";
+export const HandlerFieldPath = "path";
+export const HandlerFieldPort = "port";
+export const HandlerFieldScheme = "scheme";
+export const HandlerFieldTCP = "tcp";
+
+export interface Handler {
+    command?: string[];
+    httpHeaders?: HTTPHeader[];
+    host?: string;
+    path?: string;
+    port?: number | string;
+    scheme?: string;
+    tcp?: boolean;
+}
+
+export const ProbeType = "probe";
+export const ProbeFieldCommand = "command";
+export const ProbeFieldFailureThreshold = "failureThreshold";
+export const ProbeFieldHTTPHeaders = "httpHeaders";
+export const ProbeFieldHost = "host";
+export const ProbeFieldInitialDelaySeconds = "initialDelaySeconds";
+export const ProbeFieldPath = "path";
+export const ProbeFieldPeriodSeconds = "periodSeconds";
+export const ProbeFieldPort = "port";
+export const ProbeFieldScheme = "scheme";
+export const ProbeFieldSuccessThreshold = "successThreshold";
+export const ProbeFieldTCP = "tcp";
+export const ProbeFieldTimeoutSeconds = "timeoutSeconds";
+
+export interface Probe {
+    command?: string[];
+    failureThreshold?: number;
+    httpHeaders?: HTTPHeader[];
+    host?: string;
+    initialDelaySeconds?: number;
+    path?: string;
+    periodSeconds?: number;
+    port?: number | string;
+    scheme?: string;
+    successThreshold?: number;
+    tcp?: boolean;
+    timeoutSeconds?: number;
+}
+
+export const ConfigMapEnvSourceType = "configMapEnvSource";
+export const ConfigMapEnvSourceFieldName = "name";
+export const ConfigMapEnvSourceFieldOptional = "optional";
+
+export interface ConfigMapEnvSource {
+    name?: string;
+    optional?: boolean;
+}
+
+export const SecretEnvSourceType = "secretEnvSource";
+export const SecretEnvSourceFieldName = "name";
+export const SecretEnvSourceFieldOptional = "optional";
+
+export interface SecretEnvSource {
+    name?: string;
+    optional?: boolean;
+}
+
+export const EnvFromSourceType = "envFromSource";
+export const EnvFromSourceFieldConfigMapRef = "configMapRef";
+export const EnvFromSourceFieldPrefix = "prefix";
+export const EnvFromSourceFieldSecretRef = "secretRef";
+
+export interface EnvFromSource {
+    configMapRef?: ConfigMapEnvSource;
+    prefix?: string;
+    secretRef?: SecretEnvSource;
+}
+
+export const ConfigMapKeySelectorType = "configMapKeySelector";
+export const ConfigMapKeySelectorFieldKey = "key";
+export const ConfigMapKeySelectorFieldName = "name";
+export const ConfigMapKeySelectorFieldOptional = "optional";
+
+export interface ConfigMapKeySelector {
+    key?: string;
+    name?: string;
+    optional?: boolean;
+}
+
+export const SecretKeySelectorType = "secretKeySelector";
+export const SecretKeySelectorFieldKey = "key";
+export const SecretKeySelectorFieldName = "name";
+export const SecretKeySelectorFieldOptional = "optional";
+
+export interface SecretKeySelector {
+    key?: string;
+    name?: string;
+    optional?: boolean;
+}
+
+export const EnvVarSourceType = "envVarSource";
+export const EnvVarSourceFieldConfigMapKeyRef = "configMapKeyRef";
+export const EnvVarSourceFieldFieldRef = "fieldRef";
+export const EnvVarSourceFieldResourceFieldRef = "resourceFieldRef";
+export const EnvVarSourceFieldSecretKeyRef = "secretKeyRef";
+
+export interface EnvVarSource {
+    configMapKeyRef?: ConfigMapKeySelector;
+    fieldRef?: ObjectFieldSelector;
+    resourceFieldRef?: ResourceFieldSelector;
+    secretKeyRef?: SecretKeySelector;
+}
+
+export const EnvVarType = "envVar";
+export const EnvVarFieldName = "name";
+export const EnvVarFieldValue = "value";
+export const EnvVarFieldValueFrom = "valueFrom";
+
+export interface EnvVar {
+    name?: string;
+    value?: string;
+    valueFrom?: EnvVarSource;
+}
+
+export const VolumeDeviceType = "volumeDevice";
+export const VolumeDeviceFieldDevicePath = "devicePath";
+export const VolumeDeviceFieldName = "name";
+
+export interface VolumeDevice {
+    devicePath?: string;
+    name?: string;
+}
+
+export const LifecycleType = "lifecycle";
+export const LifecycleFieldPostStart = "postStart";
+export const LifecycleFieldPreStop = "preStop";
+
+export interface Lifecycle {
+    postStart?: Handler;
+    preStop?: Handler;
+}
+
+export const SELinuxOptionsType = "seLinuxOptions";
+export const SELinuxOptionsFieldLevel = "level";
+export const SELinuxOptionsFieldRole = "role";
+export const SELinuxOptionsFieldType = "type";
+export const SELinuxOptionsFieldUser = "user";
+
+export interface SELinuxOptions {
+    level?: string;
+    role?: string;
+    type?: string;
+    user?: string;
+}
+
+export const WindowsSecurityContextOptionsType = "windowsSecurityContextOptions";
+export const WindowsSecurityContextOptionsFieldGMSACredentialSpec = "gmsaCredentialSpec";
+export const WindowsSecurityContextOptionsFieldGMSACredentialSpecName = "gmsaCredentialSpecName";
+export const WindowsSecurityContextOptionsFieldRunAsUserName = "runAsUserName";
+
+export interface WindowsSecurityContextOptions {
+    gmsaCredentialSpec?: string;
+    gmsaCredentialSpecName?: string;
+    runAsUserName?: string;
+}
+
+export const SecurityContextType = "securityContext";
+export const SecurityContextFieldAllowPrivilegeEscalation = "allowPrivilegeEscalation";
+export const SecurityContextFieldCapAdd = "capAdd";
+export const SecurityContextFieldCapDrop = "capDrop";
+export const SecurityContextFieldPrivileged = "privileged";
+export const SecurityContextFieldProcMount = "procMount";
+export const SecurityContextFieldReadOnly = "readOnly";
+export const SecurityContextFieldRunAsGroup = "runAsGroup";
+export const SecurityContextFieldRunAsNonRoot = "runAsNonRoot";
+export const SecurityContextFieldUid = "uid";
+export const SecurityContextFieldWindowsOptions = "windowsOptions";
+
+export interface SecurityContext {
+    allowPrivilegeEscalation?: boolean;
+    capAdd?: string[];
+    capDrop?: string[];
+    privileged?: boolean;
+    procMount?: string;
+    readOnly?: boolean;
+    runAsGroup?: number;
+    runAsNonRoot?: boolean;
+    uid?: number;
+    windowsOptions?: WindowsSecurityContextOptions;
+}
+
+export const EnvironmentFromType = "environmentFrom";
+export const EnvironmentFromFieldOptional = "optional";
+export const EnvironmentFromFieldPrefix = "prefix";
+export const EnvironmentFromFieldSource = "source";
+export const EnvironmentFromFieldSourceKey = "sourceKey";
+export const EnvironmentFromFieldSourceName = "sourceName";
+export const EnvironmentFromFieldTargetKey = "targetKey";
+
+export interface EnvironmentFrom {
+    optional?: boolean;
+    prefix?: string;
+    source?: "field" | "resource" | "configMap" | "secret";
+    sourceKey?: string;
+    sourceName?: string;
+    targetKey?: string;
+}
+
+export const ContainerType = "container";
+export const ContainerFieldAllowPrivilegeEscalation = "allowPrivilegeEscalation";
+export const ContainerFieldCapAdd = "capAdd";
+export const ContainerFieldCapDrop = "capDrop";
+export const ContainerFieldCommand = "command";
+export const ContainerFieldEntrypoint = "entrypoint";
+export const ContainerFieldEnvironment = "environment";
+export const ContainerFieldEnvironmentFrom = "environmentFrom";
+export const ContainerFieldExitCode = "exitCode";
+export const ContainerFieldImage = "image";
+export const ContainerFieldImagePullPolicy = "imagePullPolicy";
+export const ContainerFieldInitContainer = "initContainer";
+export const ContainerFieldLivenessProbe = "livenessProbe";
+export const ContainerFieldName = "name";
+export const ContainerFieldPorts = "ports";
+export const ContainerFieldPostStart = "postStart";
+export const ContainerFieldPreStop = "preStop";
+export const ContainerFieldPrivileged = "privileged";
+export const ContainerFieldProcMount = "procMount";
+export const ContainerFieldReadOnly = "readOnly";
+export const ContainerFieldReadinessProbe = "readinessProbe";
+export const ContainerFieldResources = "resources";
+export const ContainerFieldRestartCount = "restartCount";
+export const ContainerFieldRunAsGroup = "runAsGroup";
+export const ContainerFieldRunAsNonRoot = "runAsNonRoot";
+export const ContainerFieldStartupProbe = "startupProbe";
+export const ContainerFieldState = "state";
+export const ContainerFieldStdin = "stdin";
+export const ContainerFieldStdinOnce = "stdinOnce";
+export const ContainerFieldTTY = "tty";
+export const ContainerFieldTerminationMessagePath = "terminationMessagePath";
+export const ContainerFieldTerminationMessagePolicy = "terminationMessagePolicy";
+export const ContainerFieldTransitioning = "transitioning";
+export const ContainerFieldTransitioningMessage = "transitioningMessage";
+export const ContainerFieldUid = "uid";
+export const ContainerFieldVolumeDevices = "volumeDevices";
+export const ContainerFieldVolumeMounts = "volumeMounts";
+export const ContainerFieldWindowsOptions = "windowsOptions";
+export const ContainerFieldWorkingDir = "workingDir";
+
+export interface Container {
+    allowPrivilegeEscalation?: boolean;
+    capAdd?: string[];
+    capDrop?: string[];
+    command?: string[];
+    entrypoint?: string[];
+    environment?: { [key: string]: string };
+    environmentFrom?: EnvironmentFrom[];
+    exitCode?: number;
+    image?: string;
+    imagePullPolicy?: string;
+    initContainer?: boolean;
+    livenessProbe?: Probe;
+    name?: string;
+    ports?: ContainerPort[];
+    postStart?: Handler;
+    preStop?: Handler;
+    privileged?: boolean;
+    procMount?: string;
+    readOnly?: boolean;
+    readinessProbe?: Probe;
+    resources?: ResourceRequirements;
+    restartCount?: number;
+    runAsGroup?: number;
+    runAsNonRoot?: boolean;
+    startupProbe?: Probe;
+    state?: string;
+    stdin?: boolean;
+    stdinOnce?: boolean;
+    tty?: boolean;
+    terminationMessagePath?: string;
+    terminationMessagePolicy?: string;
+    transitioning?: string;
+    transitioningMessage?: string;
+    uid?: number;
+    volumeDevices?: VolumeDevice[];
+    volumeMounts?: VolumeMount[];
+    windowsOptions?: WindowsSecurityContextOptions;
+    workingDir?: string;
+}
+
+export const EphemeralContainerType = "ephemeralContainer";
+export const EphemeralContainerFieldArgs = "args";
+export const EphemeralContainerFieldCommand = "command";
+export const EphemeralContainerFieldEnv = "env";
+export const EphemeralContainerFieldEnvFrom = "envFrom";
+export const EphemeralContainerFieldImage = "image";
+export const EphemeralContainerFieldImagePullPolicy = "imagePullPolicy";
+export const EphemeralContainerFieldLifecycle = "lifecycle";
+export const EphemeralContainerFieldLivenessProbe = "livenessProbe";
+export const EphemeralContainerFieldName = "name";
+export const EphemeralContainerFieldPorts = "ports";
+export const EphemeralContainerFieldReadinessProbe = "readinessProbe";
+export const EphemeralContainerFieldResources = "resources";
+export const EphemeralContainerFieldSecurityContext = "securityContext";
+export const EphemeralContainerFieldStartupProbe = "startupProbe";
+export const EphemeralContainerFieldStdin = "stdin";
+export const EphemeralContainerFieldStdinOnce = "stdinOnce";
+export const EphemeralContainerFieldTTY = "tty";
+export const EphemeralContainerFieldTargetContainerName = "targetContainerName";
+export const EphemeralContainerFieldTerminationMessagePath = "terminationMessagePath";
+export const EphemeralContainerFieldTerminationMessagePolicy = "terminationMessagePolicy";
+export const EphemeralContainerFieldVolumeDevices = "volumeDevices";
+export const EphemeralContainerFieldVolumeMounts = "volumeMounts";
+export const EphemeralContainerFieldWorkingDir = "workingDir";
+
+export interface EphemeralContainer {
+    args?: string[];
+    command?: string[];
+    env?: EnvVar[];
+    envFrom?: EnvFromSource[];
+    image?: string;
+    imagePullPolicy?: string;
+    lifecycle?: Lifecycle;
+    livenessProbe?: Probe;
+    name?: string;
+    ports?: ContainerPort[];
+    readinessProbe?: Probe;
+    resources?: ResourceRequirements;
+    securityContext?: SecurityContext;
+    startupProbe?: Probe;
+    stdin?: boolean;
+    stdinOnce?: boolean;
+    tty?: boolean;
+    targetContainerName?: string;
+    terminationMessagePath?: string;
+    terminationMessagePolicy?: string;
+    volumeDevices?: VolumeDevice[];
+    volumeMounts?: VolumeMount[];
+    workingDir?: string;
+}
+
+export const SysctlType = "sysctl";
+export const SysctlFieldName = "name";
+export const SysctlFieldValue = "value";
+
+export interface Sysctl {
+    name?: string;
+    value?: string;
+}
+
+export const PodSecurityContextType = "podSecurityContext";
+export const PodSecurityContextFieldFsgid = "fsgid";
+export const PodSecurityContextFieldGids = "gids";
+export const PodSecurityContextFieldRunAsGroup = "runAsGroup";
+export const PodSecurityContextFieldRunAsNonRoot = "runAsNonRoot";
+export const PodSecurityContextFieldSysctls = "sysctls";
+export const PodSecurityContextFieldUid = "uid";
+export const PodSecurityContextFieldWindowsOptions = "windowsOptions";
+
+export interface PodSecurityContext {
+    fsgid?: number;
+    gids?: number[];
+    runAsGroup?: number;
+    runAsNonRoot?: boolean;
+    sysctls?: Sysctl[];
+    uid?: number;
+    windowsOptions?: WindowsSecurityContextOptions;
+}
+
+export const PreferredSchedulingTermType = "preferredSchedulingTerm";
+export const PreferredSchedulingTermFieldPreference = "preference";
+export const PreferredSchedulingTermFieldWeight = "weight";
+
+export interface PreferredSchedulingTerm {
+    preference?: NodeSelectorTerm;
+    weight?: number;
+}
+
+export const NodeAffinityType = "nodeAffinity";
+export const NodeAffinityFieldPreferredDuringSchedulingIgnoredDuringExecution = "preferredDuringSchedulingIgnoredDuringExecution";
+export const NodeAffinityFieldRequiredDuringSchedulingIgnoredDuringExecution = "requiredDuringSchedulingIgnoredDuringExecution";
+
+export interface NodeAffinity {
+    preferredDuringSchedulingIgnoredDuringExecution?: PreferredSchedulingTerm[];
+    requiredDuringSchedulingIgnoredDuringExecution?: NodeSelector;
+}
+
+export const PodAffinityTermType = "podAffinityTerm";
+export const PodAffinityTermFieldLabelSelector = "labelSelector";
+export const PodAffinityTermFieldNamespaces = "namespaces";
+export const PodAffinityTermFieldTopologyKey = "topologyKey";
+
+export interface PodAffinityTerm {
+    labelSelector?: LabelSelector;
+    namespaces?: string[];
+    topologyKey?: string;
+}
+
+export const WeightedPodAffinityTermType = "weightedPodAffinityTerm";
+export const WeightedPodAffinityTermFieldPodAffinityTerm = "podAffinityTerm";
+export const WeightedPodAffinityTermFieldWeight = "weight";
+
+export interface WeightedPodAffinityTerm {
+    podAffinityTerm?: PodAffinityTerm;
+    weight?: number;
+}
+
+export const PodAffinityType = "podAffinity";
+export const PodAffinityFieldPreferredDuringSchedulingIgnoredDuringExecution = "preferredDuringSchedulingIgnoredDuringExecution";
+export const PodAffinityFieldRequiredDuringSchedulingIgnoredDuringExecution = "requiredDuringSchedulingIgnoredDuringExecution";
+
+export interface PodAffinity {
+    preferredDuringSchedulingIgnoredDuringExecution?: WeightedPodAffinityTerm[];
+    requiredDuringSchedulingIgnoredDuringExecution?: PodAffinityTerm[];
+}
+
+export const PodAntiAffinityType = "podAntiAffinity";
+export const PodAntiAffinityFieldPreferredDuringSchedulingIgnoredDuringExecution = "preferredDuringSchedulingIgnoredDuringExecution";
+export const PodAntiAffinityFieldRequiredDuringSchedulingIgnoredDuringExecution = "requiredDuringSchedulingIgnoredDuringExecution";
+
+export interface PodAntiAffinity {
+    preferredDuringSchedulingIgnoredDuringExecution?: WeightedPodAffinityTerm[];
+    requiredDuringSchedulingIgnoredDuringExecution?: PodAffinityTerm[];
+}
+
+export const AffinityType = "affinity";
+export const AffinityFieldNodeAffinity = "nodeAffinity";
+export const AffinityFieldPodAffinity = "podAffinity";
+export const AffinityFieldPodAntiAffinity = "podAntiAffinity";
+
+export interface Affinity {
+    nodeAffinity?: NodeAffinity;
+    podAffinity?: PodAffinity;
+    podAntiAffinity?: PodAntiAffinity;
+}
+
+export const TolerationType = "toleration";
+export const TolerationFieldEffect = "effect";
+export const TolerationFieldKey = "key";
+export const TolerationFieldOperator = "operator";
+export const TolerationFieldTolerationSeconds = "tolerationSeconds";
+export const TolerationFieldValue = "value";
+
+export interface Toleration {
+    effect?: string;
+    key?: string;
+    operator?: string;
+    tolerationSeconds?: number;
+    value?: string;
+}
+
+export const HostAliasType = "hostAlias";
+export const HostAliasFieldHostnames = "hostnames";
+export const HostAliasFieldIP = "ip";
+
+export interface HostAlias {
+    hostnames?: string[];
+    ip?: string;
+}
+
+export const PodDNSConfigOptionType = "podDNSConfigOption";
+export const PodDNSConfigOptionFieldName = "name";
+export const PodDNSConfigOptionFieldValue = "value";
+
+export interface PodDNSConfigOption {
+    name?: string;
+    value?: string;
+}
+
+export const PodDNSConfigType = "podDNSConfig";
+export const PodDNSConfigFieldNameservers = "nameservers";
+export const PodDNSConfigFieldOptions = "options";
+export const PodDNSConfigFieldSearches = "searches";
+
+export interface PodDNSConfig {
+    nameservers?: string[];
+    options?: PodDNSConfigOption[];
+    searches?: string[];
+}
+
+export const PodReadinessGateType = "podReadinessGate";
+export const PodReadinessGateFieldConditionType = "conditionType";
+
+export interface PodReadinessGate {
+    conditionType?: string;
+}
+
+export const TopologySpreadConstraintType = "topologySpreadConstraint";
+export const TopologySpreadConstraintFieldLabelSelector = "labelSelector";
+export const TopologySpreadConstraintFieldMaxSkew = "maxSkew";
+export const TopologySpreadConstraintFieldTopologyKey = "topologyKey";
+export const TopologySpreadConstraintFieldWhenUnsatisfiable = "whenUnsatisfiable";
+
+export interface TopologySpreadConstraint {
+    labelSelector?: LabelSelector;
+    maxSkew?: number;
+    topologyKey?: string;
+    whenUnsatisfiable?: string;
+}
+
+export const NodeSchedulingType = "nodeScheduling";
+export const NodeSchedulingFieldNodeID = "nodeId";
+export const NodeSchedulingFieldPreferred = "preferred";
+export const NodeSchedulingFieldRequireAll = "requireAll";
+export const NodeSchedulingFieldRequireAny = "requireAny";
+
+export interface NodeScheduling {
+    nodeId?: string;
+    preferred?: string[];
+    requireAll?: string[];
+    requireAny?: string[];
+}
+
+export const SchedulingType = "scheduling";
+export const SchedulingFieldNode = "node";
+export const SchedulingFieldPriority = "priority";
+export const SchedulingFieldPriorityClassName = "priorityClassName";
+export const SchedulingFieldScheduler = "scheduler";
+export const SchedulingFieldTolerate = "tolerate";
+
+export interface Scheduling {
+    node?: NodeScheduling;
+    priority?: number;
+    priorityClassName?: string;
+    scheduler?: string;
+    tolerate?: Toleration[];
+}
+
+export const PodSpecType = "podSpec";
+export const PodSpecFieldActiveDeadlineSeconds = "activeDeadlineSeconds";
+export const PodSpecFieldAutomountServiceAccountToken = "automountServiceAccountToken";
+export const PodSpecFieldContainers = "containers";
+export const PodSpecFieldDNSConfig = "dnsConfig";
+export const PodSpecFieldDNSPolicy = "dnsPolicy";
+export const PodSpecFieldEnableServiceLinks = "enableServiceLinks";
+export const PodSpecFieldEphemeralContainers = "ephemeralContainers";
+export const PodSpecFieldFsgid = "fsgid";
+export const PodSpecFieldGids = "gids";
+export const PodSpecFieldHostAliases = "hostAliases";
+export const PodSpecFieldHostIPC = "hostIPC";
+export const PodSpecFieldHostNetwork = "hostNetwork";
+export const PodSpecFieldHostPID = "hostPID";
+export const PodSpecFieldHostname = "hostname";
+export const PodSpecFieldImagePullSecrets = "imagePullSecrets";
+export const PodSpecFieldNodeID = "nodeId";
+export const PodSpecFieldOverhead = "overhead";
+export const PodSpecFieldPreemptionPolicy = "preemptionPolicy";
+export const PodSpecFieldReadinessGates = "readinessGates";
+export const PodSpecFieldRestartPolicy = "restartPolicy";
+export const PodSpecFieldRunAsGroup = "runAsGroup";
+export const PodSpecFieldRunAsNonRoot = "runAsNonRoot";
+export const PodSpecFieldRuntimeClassName = "runtimeClassName";
+export const PodSpecFieldScheduling = "scheduling";
+export const PodSpecFieldServiceAccountName = "serviceAccountName";
+export const PodSpecFieldShareProcessNamespace = "shareProcessNamespace";
+export const PodSpecFieldSubdomain = "subdomain";
+export const PodSpecFieldSysctls = "sysctls";
+export const PodSpecFieldTerminationGracePeriodSeconds = "terminationGracePeriodSeconds";
+export const PodSpecFieldTopologySpreadConstraints = "topologySpreadConstraints";
+export const PodSpecFieldUid = "uid";
+export const PodSpecFieldVolumes = "volumes";
+export const PodSpecFieldWindowsOptions = "windowsOptions";
+
+export interface PodSpec {
+    activeDeadlineSeconds?: number;
+    automountServiceAccountToken?: boolean;
+    containers?: Container[];
+    dnsConfig?: PodDNSConfig;
+    dnsPolicy?: string;
+    enableServiceLinks?: boolean;
+    ephemeralContainers?: EphemeralContainer[];
+    fsgid?: number;
+    gids?: number[];
+    hostAliases?: HostAlias[];
+    hostIPC?: boolean;
+    hostNetwork?: boolean;
+    hostPID?: boolean;
+    hostname?: string;
+    imagePullSecrets?: LocalObjectReference[];
+    nodeId?: string;
+    overhead?: { [key: string]: string };
+    preemptionPolicy?: string;
+    readinessGates?: PodReadinessGate[];
+    restartPolicy?: string;
+    runAsGroup?: number;
+    runAsNonRoot?: boolean;
+    runtimeClassName?: string;
+    scheduling?: Scheduling;
+    serviceAccountName?: string;
+    shareProcessNamespace?: boolean;
+    subdomain?: string;
+    sysctls?: Sysctl[];
+    terminationGracePeriodSeconds?: number;
+    topologySpreadConstraints?: TopologySpreadConstraint[];
+    uid?: number;
+    volumes?: Volume[];
+    windowsOptions?: WindowsSecurityContextOptions;
+}
+
+export const PodConditionType = "podCondition";
+export const PodConditionFieldLastProbeTime = "lastProbeTime";
+export const PodConditionFieldLastTransitionTime = "lastTransitionTime";
+export const PodConditionFieldMessage = "message";
+export const PodConditionFieldReason = "reason";
+export const PodConditionFieldStatus = "status";
+export const PodConditionFieldType = "type";
+
+export interface PodCondition {
+    lastProbeTime?: string;
+    lastTransitionTime?: string;
+    message?: string;
+    reason?: string;
+    status?: string;
+    type?: string;
+}
+
+export const PodIPType = "podIP";
+export const PodIPFieldIP = "ip";
+
+export interface PodIP {
+    ip?: string;
+}
+
+export const ContainerStateWaitingType = "containerStateWaiting";
+export const ContainerStateWaitingFieldMessage = "message";
+export const ContainerStateWaitingFieldReason = "reason";
+
+export interface ContainerStateWaiting {
+    message?: string;
+    reason?: string;
+}
+
+export const ContainerStateRunningType = "containerStateRunning";
+export const ContainerStateRunningFieldStartedAt = "startedAt";
+
+export interface ContainerStateRunning {
+    startedAt?: string;
+}
+
+export const ContainerStateTerminatedType = "containerStateTerminated";
+export const ContainerStateTerminatedFieldContainerID = "containerID";
+export const ContainerStateTerminatedFieldExitCode = "exitCode";
+export const ContainerStateTerminatedFieldFinishedAt = "finishedAt";
+export const ContainerStateTerminatedFieldMessage = "message";
+export const ContainerStateTerminatedFieldReason = "reason";
+export const ContainerStateTerminatedFieldSignal = "signal";
+export const ContainerStateTerminatedFieldStartedAt = "startedAt";
+
+export interface ContainerStateTerminated {
+    containerID?: string;
+    exitCode?: number;
+    finishedAt?: string;
+    message?: string;
+    reason?: string;
+    signal?: number;
+    startedAt?: string;
+}
+
+export const ContainerStateType = "containerState";
+export const ContainerStateFieldRunning = "running";
+export const ContainerStateFieldTerminated = "terminated";
+export const ContainerStateFieldWaiting = "waiting";
+
+export interface ContainerState {
+    running?: ContainerStateRunning;
+    terminated?: ContainerStateTerminated;
+    waiting?: ContainerStateWaiting;
+}
+
+export const ContainerStatusType = "containerStatus";
+export const ContainerStatusFieldContainerID = "containerID";
+export const ContainerStatusFieldImage = "image";
+export const ContainerStatusFieldImageID = "imageID";
+export const ContainerStatusFieldLastTerminationState = "lastState";
+export const ContainerStatusFieldName = "name";
+export const ContainerStatusFieldReady = "ready";
+export const ContainerStatusFieldRestartCount = "restartCount";
+export const ContainerStatusFieldStarted = "started";
+export const ContainerStatusFieldState = "state";
+
+export interface ContainerStatus {
+    containerID?: string;
+    image?: string;
+    imageID?: string;
+    lastState?: ContainerState;
+    name?: string;
+    ready?: boolean;
+    restartCount?: number;
+    started?: boolean;
+    state?: ContainerState;
+}
+
+export const PodStatusType = "podStatus";
+export const PodStatusFieldConditions = "conditions";
+export const PodStatusFieldContainerStatuses = "containerStatuses";
+export const PodStatusFieldEphemeralContainerStatuses = "ephemeralContainerStatuses";
+export const PodStatusFieldInitContainerStatuses = "initContainerStatuses";
+export const PodStatusFieldMessage = "message";
+export const PodStatusFieldNodeIp = "nodeIp";
+export const PodStatusFieldNominatedNodeName = "nominatedNodeName";
+export const PodStatusFieldPhase = "phase";
+export const PodStatusFieldPodIPs = "podIPs";
+export const PodStatusFieldPodIp = "podIp";
+export const PodStatusFieldQOSClass = "qosClass";
+export const PodStatusFieldReason = "reason";
+export const PodStatusFieldStartTime = "startTime";
+
+export interface PodStatus {
+    conditions?: PodCondition[];
+    containerStatuses?: ContainerStatus[];
+    ephemeralContainerStatuses?: ContainerStatus[];
+    initContainerStatuses?: ContainerStatus[];
+    message?: string;
+    nodeIp?: string;
+    nominatedNodeName?: string;
+    phase?: string;
+    podIPs?: PodIP[];
+    podIp?: string;
+    qosClass?: string;
+    reason?: string;
+    startTime?: string;
+}
+
+export const PodType = "pod";
+export const PodFieldActiveDeadlineSeconds = "activeDeadlineSeconds";
+export const PodFieldAnnotations = "annotations";
+export const PodFieldAutomountServiceAccountToken = "automountServiceAccountToken";
+export const PodFieldContainers = "containers";
+export const PodFieldCreated = "created";
+export const PodFieldCreatorID = "creatorId";
+export const PodFieldDNSConfig = "dnsConfig";
+export const PodFieldDNSPolicy = "dnsPolicy";
+export const PodFieldDescription = "description";
+export const PodFieldEnableServiceLinks = "enableServiceLinks";
+export const PodFieldEphemeralContainers = "ephemeralContainers";
+export const PodFieldFsgid = "fsgid";
+export const PodFieldGids = "gids";
+export const PodFieldHostAliases = "hostAliases";
+export const PodFieldHostIPC = "hostIPC";
+export const PodFieldHostNetwork = "hostNetwork";
+export const PodFieldHostPID = "hostPID";
+export const PodFieldHostname = "hostname";
+export const PodFieldImagePullSecrets = "imagePullSecrets";
+export const PodFieldLabels = "labels";
+export const PodFieldName = "name";
+export const PodFieldNamespaceId = "namespaceId";
+export const PodFieldNodeID = "nodeId";
+export const PodFieldOverhead = "overhead";
+export const PodFieldOwnerReferences = "ownerReferences";
+export const PodFieldPreemptionPolicy = "preemptionPolicy";
+export const PodFieldProjectID = "projectId";
+export const PodFieldPublicEndpoints = "publicEndpoints";
+export const PodFieldReadinessGates = "readinessGates";
+export const PodFieldRemoved = "removed";
+export const PodFieldRestartPolicy = "restartPolicy";
+export const PodFieldRunAsGroup = "runAsGroup";
+export const PodFieldRunAsNonRoot = "runAsNonRoot";
+export const PodFieldRuntimeClassName = "runtimeClassName";
+export const PodFieldScheduling = "scheduling";
+export const PodFieldServiceAccountName = "serviceAccountName";
+export const PodFieldShareProcessNamespace = "shareProcessNamespace";
+export const PodFieldState = "state";
+export const PodFieldStatus = "status";
+export const PodFieldSubdomain = "subdomain";
+export const PodFieldSysctls = "sysctls";
+export const PodFieldTerminationGracePeriodSeconds = "terminationGracePeriodSeconds";
+export const PodFieldTopologySpreadConstraints = "topologySpreadConstraints";
+export const PodFieldTransitioning = "transitioning";
+export const PodFieldTransitioningMessage = "transitioningMessage";
+export const PodFieldUUID = "uuid";
+export const PodFieldUid = "uid";
+export const PodFieldVolumes = "volumes";
+export const PodFieldWindowsOptions = "windowsOptions";
+export const PodFieldWorkloadID = "workloadId";
+export const PodFieldWorkloadMetrics = "workloadMetrics";
+
+export interface Pod extends ApiResource {
+    activeDeadlineSeconds?: number;
+    annotations?: { [key: string]: string };
+    automountServiceAccountToken?: boolean;
+    containers?: Container[];
+    created?: string;
+    creatorId?: string;
+    dnsConfig?: PodDNSConfig;
+    dnsPolicy?: string;
+    description?: string;
+    enableServiceLinks?: boolean;
+    ephemeralContainers?: EphemeralContainer[];
+    fsgid?: number;
+    gids?: number[];
+    hostAliases?: HostAlias[];
+    hostIPC?: boolean;
+    hostNetwork?: boolean;
+    hostPID?: boolean;
+    hostname?: string;
+    imagePullSecrets?: LocalObjectReference[];
+    labels?: { [key: string]: string };
+    name?: string;
+    namespaceId?: string;
+    nodeId?: string;
+    overhead?: { [key: string]: string };
+    ownerReferences?: OwnerReference[];
+    preemptionPolicy?: string;
+    projectId?: string;
+    publicEndpoints?: PublicEndpoint[];
+    readinessGates?: PodReadinessGate[];
+    removed?: string;
+    restartPolicy?: string;
+    runAsGroup?: number;
+    runAsNonRoot?: boolean;
+    runtimeClassName?: string;
+    scheduling?: Scheduling;
+    serviceAccountName?: string;
+    shareProcessNamespace?: boolean;
+    state?: string;
+    status?: PodStatus;
+    subdomain?: string;
+    sysctls?: Sysctl[];
+    terminationGracePeriodSeconds?: number;
+    topologySpreadConstraints?: TopologySpreadConstraint[];
+    transitioning?: "yes" | "no" | "error";
+    transitioningMessage?: string;
+    uuid?: string;
+    uid?: number;
+    volumes?: Volume[];
+    windowsOptions?: WindowsSecurityContextOptions;
+    workloadId?: string;
+    workloadMetrics?: WorkloadMetric[];
+}
+
+export interface PodCollection extends ApiCollection {
+    data?: Pod[];
+}
+
+export class PodClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<PodCollection> {
+        return this.ops.DoList(PodType, opts);
+    }
+
+    Next(collection: PodCollection): Promise<PodCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: Pod): Promise<Pod> {
+        return this.ops.DoCreate(PodType, container);
+    }
+
+    Update(existing: Pod, updates: object): Promise<Pod> {
+        return this.ops.DoUpdate(PodType, existing, updates);
+    }
+
+    Replace(obj: Pod): Promise<Pod> {
+        return this.ops.DoReplace(PodType, obj, obj);
+    }
+
+    ByID(id: string): Promise<Pod> {
+        return this.ops.DoByID(PodType, id);
+    }
+
+    Delete(container: Pod): Promise<void> {
+        return this.ops.DoResourceDelete(PodType, container);
+    }
+}
+
+export const PodTemplateSpecType = "podTemplateSpec";
+export const PodTemplateSpecFieldActiveDeadlineSeconds = "activeDeadlineSeconds";
+export const PodTemplateSpecFieldAutomountServiceAccountToken = "automountServiceAccountToken";
+export const PodTemplateSpecFieldContainers = "containers";
+export const PodTemplateSpecFieldDNSConfig = "dnsConfig";
+export const PodTemplateSpecFieldDNSPolicy = "dnsPolicy";
+export const PodTemplateSpecFieldEnableServiceLinks = "enableServiceLinks";
+export const PodTemplateSpecFieldEphemeralContainers = "ephemeralContainers";
+export const PodTemplateSpecFieldFsgid = "fsgid";
+export const PodTemplateSpecFieldGids = "gids";
+export const PodTemplateSpecFieldHostAliases = "hostAliases";
+export const PodTemplateSpecFieldHostIPC = "hostIPC";
+export const PodTemplateSpecFieldHostNetwork = "hostNetwork";
+export const PodTemplateSpecFieldHostPID = "hostPID";
+export const PodTemplateSpecFieldHostname = "hostname";
+export const PodTemplateSpecFieldImagePullSecrets = "imagePullSecrets";
+export const PodTemplateSpecFieldNodeID = "nodeId";
+export const PodTemplateSpecFieldObjectMeta = "metadata";
+export const PodTemplateSpecFieldOverhead = "overhead";
+export const PodTemplateSpecFieldPreemptionPolicy = "preemptionPolicy";
+export const PodTemplateSpecFieldReadinessGates = "readinessGates";
+export const PodTemplateSpecFieldRestartPolicy = "restartPolicy";
+export const PodTemplateSpecFieldRunAsGroup = "runAsGroup";
+export const PodTemplateSpecFieldRunAsNonRoot = "runAsNonRoot";
+export const PodTemplateSpecFieldRuntimeClassName = "runtimeClassName";
+export const PodTemplateSpecFieldScheduling = "scheduling";
+export const PodTemplateSpecFieldServiceAccountName = "serviceAccountName";
+export const PodTemplateSpecFieldShareProcessNamespace = "shareProcessNamespace";
+export const PodTemplateSpecFieldSubdomain = "subdomain";
+export const PodTemplateSpecFieldSysctls = "sysctls";
+export const PodTemplateSpecFieldTerminationGracePeriodSeconds = "terminationGracePeriodSeconds";
+export const PodTemplateSpecFieldTopologySpreadConstraints = "topologySpreadConstraints";
+export const PodTemplateSpecFieldUid = "uid";
+export const PodTemplateSpecFieldVolumes = "volumes";
+export const PodTemplateSpecFieldWindowsOptions = "windowsOptions";
+
+export interface PodTemplateSpec {
+    activeDeadlineSeconds?: number;
+    automountServiceAccountToken?: boolean;
+    containers?: Container[];
+    dnsConfig?: PodDNSConfig;
+    dnsPolicy?: string;
+    enableServiceLinks?: boolean;
+    ephemeralContainers?: EphemeralContainer[];
+    fsgid?: number;
+    gids?: number[];
+    hostAliases?: HostAlias[];
+    hostIPC?: boolean;
+    hostNetwork?: boolean;
+    hostPID?: boolean;
+    hostname?: string;
+    imagePullSecrets?: LocalObjectReference[];
+    nodeId?: string;
+    metadata?: ObjectMeta;
+    overhead?: { [key: string]: string };
+    preemptionPolicy?: string;
+    readinessGates?: PodReadinessGate[];
+    restartPolicy?: string;
+    runAsGroup?: number;
+    runAsNonRoot?: boolean;
+    runtimeClassName?: string;
+    scheduling?: Scheduling;
+    serviceAccountName?: string;
+    shareProcessNamespace?: boolean;
+    subdomain?: string;
+    sysctls?: Sysctl[];
+    terminationGracePeriodSeconds?: number;
+    topologySpreadConstraints?: TopologySpreadConstraint[];
+    uid?: number;
+    volumes?: Volume[];
+    windowsOptions?: WindowsSecurityContextOptions;
+}
+
+export const RollingUpdateDeploymentType = "rollingUpdateDeployment";
+export const RollingUpdateDeploymentFieldMaxSurge = "maxSurge";
+export const RollingUpdateDeploymentFieldMaxUnavailable = "maxUnavailable";
+
+export interface RollingUpdateDeployment {
+    maxSurge?: number | string;
+    maxUnavailable?: number | string;
+}
+
+export const DeploymentStrategyType = "deploymentStrategy";
+export const DeploymentStrategyFieldMaxSurge = "maxSurge";
+export const DeploymentStrategyFieldMaxUnavailable = "maxUnavailable";
+export const DeploymentStrategyFieldStrategy = "strategy";
+
+export interface DeploymentStrategy {
+    maxSurge?: number | string;
+    maxUnavailable?: number | string;
+    strategy?: "Recreate" | "RollingUpdate";
+}
+
+export const DeploymentConfigType = "deploymentConfig";
+export const DeploymentConfigFieldMaxSurge = "maxSurge";
+export const DeploymentConfigFieldMaxUnavailable = "maxUnavailable";
+export const DeploymentConfigFieldMinReadySeconds = "minReadySeconds";
+export const DeploymentConfigFieldProgressDeadlineSeconds = "progressDeadlineSeconds";
+export const DeploymentConfigFieldRevisionHistoryLimit = "revisionHistoryLimit";
+export const DeploymentConfigFieldStrategy = "strategy";
+
+export interface DeploymentConfig {
+    maxSurge?: number | string;
+    maxUnavailable?: number | string;
+    minReadySeconds?: number;
+    progressDeadlineSeconds?: number;
+    revisionHistoryLimit?: number;
+    strategy?: "Recreate" | "RollingUpdate";
+}
+
+export const DeploymentSpecType = "deploymentSpec";
+export const DeploymentSpecFieldActiveDeadlineSeconds = "activeDeadlineSeconds";
+export const DeploymentSpecFieldAutomountServiceAccountToken = "automountServiceAccountToken";
+export const DeploymentSpecFieldContainers = "containers";
+export const DeploymentSpecFieldDNSConfig = "dnsConfig";
+export const DeploymentSpecFieldDNSPolicy = "dnsPolicy";
+export const DeploymentSpecFieldDeploymentConfig = "deploymentConfig";
+export const DeploymentSpecFieldEnableServiceLinks = "enableServiceLinks";
+export const DeploymentSpecFieldEphemeralContainers = "ephemeralContainers";
+export const DeploymentSpecFieldFsgid = "fsgid";
+export const DeploymentSpecFieldGids = "gids";
+export const DeploymentSpecFieldHostAliases = "hostAliases";
+export const DeploymentSpecFieldHostIPC = "hostIPC";
+export const DeploymentSpecFieldHostNetwork = "hostNetwork";
+export const DeploymentSpecFieldHostPID = "hostPID";
+export const DeploymentSpecFieldHostname = "hostname";
+export const DeploymentSpecFieldImagePullSecrets = "imagePullSecrets";
+export const DeploymentSpecFieldNodeID = "nodeId";
+export const DeploymentSpecFieldObjectMeta = "metadata";
+export const DeploymentSpecFieldOverhead = "overhead";
+export const DeploymentSpecFieldPaused = "paused";
+export const DeploymentSpecFieldPreemptionPolicy = "preemptionPolicy";
+export const DeploymentSpecFieldReadinessGates = "readinessGates";
+export const DeploymentSpecFieldRestartPolicy = "restartPolicy";
+export const DeploymentSpecFieldRunAsGroup = "runAsGroup";
+export const DeploymentSpecFieldRunAsNonRoot = "runAsNonRoot";
+export const DeploymentSpecFieldRuntimeClassName = "runtimeClassName";
+export const DeploymentSpecFieldScale = "scale";
+export const DeploymentSpecFieldScheduling = "scheduling";
+export const DeploymentSpecFieldSelector = "selector";
+export const DeploymentSpecFieldServiceAccountName = "serviceAccountName";
+export const DeploymentSpecFieldShareProcessNamespace = "shareProcessNamespace";
+export const DeploymentSpecFieldSubdomain = "subdomain";
+export const DeploymentSpecFieldSysctls = "sysctls";
+export const DeploymentSpecFieldTerminationGracePeriodSeconds = "terminationGracePeriodSeconds";
+export const DeploymentSpecFieldTopologySpreadConstraints = "topologySpreadConstraints";
+export const DeploymentSpecFieldUid = "uid";
+export const DeploymentSpecFieldVolumes = "volumes";
+export const DeploymentSpecFieldWindowsOptions = "windowsOptions";
+
+export interface DeploymentSpec {
+    activeDeadlineSeconds?: number;
+    automountServiceAccountToken?: boolean;
+    containers?: Container[];
+    dnsConfig?: PodDNSConfig;
+    dnsPolicy?: string;
+    deploymentConfig?: DeploymentConfig;
+    enableServiceLinks?: boolean;
+    ephemeralContainers?: EphemeralContainer[];
+    fsgid?: number;
+    gids?: number[];
+    hostAliases?: HostAlias[];
+    hostIPC?: boolean;
+    hostNetwork?: boolean;
+    hostPID?: boolean;
+    hostname?: string;
+    imagePullSecrets?: LocalObjectReference[];
+    nodeId?: string;
+    metadata?: ObjectMeta;
+    overhead?: { [key: string]: string };
+    paused?: boolean;
+    preemptionPolicy?: string;
+    readinessGates?: PodReadinessGate[];
+    restartPolicy?: string;
+    runAsGroup?: number;
+    runAsNonRoot?: boolean;
+    runtimeClassName?: string;
+    scale?: number;
+    scheduling?: Scheduling;
+    selector?: LabelSelector;
+    serviceAccountName?: string;
+    shareProcessNamespace?: boolean;
+    subdomain?: string;
+    sysctls?: Sysctl[];
+    terminationGracePeriodSeconds?: number;
+    topologySpreadConstraints?: TopologySpreadConstraint[];
+    uid?: number;
+    volumes?: Volume[];
+    windowsOptions?: WindowsSecurityContextOptions;
+}
+
+export const DeploymentRollbackInputType = "deploymentRollbackInput";
+export const DeploymentRollbackInputFieldReplicaSetID = "replicaSetId";
+
+export interface DeploymentRollbackInput {
+    replicaSetId?: string;
+}
+
+export const DeploymentConditionType = "deploymentCondition";
+export const DeploymentConditionFieldLastTransitionTime = "lastTransitionTime";
+export const DeploymentConditionFieldLastUpdateTime = "lastUpdateTime";
+export const DeploymentConditionFieldMessage = "message";
+export const DeploymentConditionFieldReason = "reason";
+export const DeploymentConditionFieldStatus = "status";
+export const DeploymentConditionFieldType = "type";
+
+export interface DeploymentCondition {
+    lastTransitionTime?: string;
+    lastUpdateTime?: string;
+    message?: string;
+    reason?: string;
+    status?: string;
+    type?: string;
+}
+
+export const DeploymentStatusType = "deploymentStatus";
+export const DeploymentStatusFieldAvailableReplicas = "availableReplicas";
+export const DeploymentStatusFieldCollisionCount = "collisionCount";
+export const DeploymentStatusFieldConditions = "conditions";
+export const DeploymentStatusFieldObservedGeneration = "observedGeneration";
+export const DeploymentStatusFieldReadyReplicas = "readyReplicas";
+export const DeploymentStatusFieldReplicas = "replicas";
+export const DeploymentStatusFieldUnavailableReplicas = "unavailableReplicas";
+export const DeploymentStatusFieldUpdatedReplicas = "updatedReplicas";
+
+export interface DeploymentStatus {
+    availableReplicas?: number;
+    collisionCount?: number;
+    conditions?: DeploymentCondition[];
+    observedGeneration?: number;
+    readyReplicas?: number;
+    replicas?: number;
+    unavailableReplicas?: number;
+    updatedReplicas?: number;
+}
+
+export const DeploymentType = "deployment";
+export const DeploymentFieldActiveDeadlineSeconds = "activeDeadlineSeconds";
+export const DeploymentFieldAnnotations = "annotations";
+export const DeploymentFieldAutomountServiceAccountToken = "automountServiceAccountToken";
+export const DeploymentFieldContainers = "containers";
+export const DeploymentFieldCreated = "created";
+export const DeploymentFieldCreatorID = "creatorId";
+export const DeploymentFieldDNSConfig = "dnsConfig";
+export const DeploymentFieldDNSPolicy = "dnsPolicy";
+export const DeploymentFieldDeploymentConfig = "deploymentConfig";
+export const DeploymentFieldDeploymentStatus = "deploymentStatus";
+export const DeploymentFieldEnableServiceLinks = "enableServiceLinks";
+export const DeploymentFieldEphemeralContainers = "ephemeralContainers";
+export const DeploymentFieldFsgid = "fsgid";
+export const DeploymentFieldGids = "gids";
+export const DeploymentFieldHostAliases = "hostAliases";
+export const DeploymentFieldHostIPC = "hostIPC";
+export const DeploymentFieldHostNetwork = "hostNetwork";
+export const DeploymentFieldHostPID = "hostPID";
+export const DeploymentFieldHostname = "hostname";
+export const DeploymentFieldImagePullSecrets = "imagePullSecrets";
+export const DeploymentFieldLabels = "labels";
+export const DeploymentFieldName = "name";
+export const DeploymentFieldNamespaceId = "namespaceId";
+export const DeploymentFieldNodeID = "nodeId";
+export const DeploymentFieldOverhead = "overhead";
+export const DeploymentFieldOwnerReferences = "ownerReferences";
+export const DeploymentFieldPaused = "paused";
+export const DeploymentFieldPreemptionPolicy = "preemptionPolicy";
+export const DeploymentFieldProjectID = "projectId";
+export const DeploymentFieldPublicEndpoints = "publicEndpoints";
+export const DeploymentFieldReadinessGates = "readinessGates";
+export const DeploymentFieldRemoved = "removed";
+export const DeploymentFieldRestartPolicy = "restartPolicy";
+export const DeploymentFieldRunAsGroup = "runAsGroup";
+export const DeploymentFieldRunAsNonRoot = "runAsNonRoot";
+export const DeploymentFieldRuntimeClassName = "runtimeClassName";
+export const DeploymentFieldScale = "scale";
+export const DeploymentFieldScheduling = "scheduling";
+export const DeploymentFieldSelector = "selector";
+export const DeploymentFieldServiceAccountName = "serviceAccountName";
+export const DeploymentFieldShareProcessNamespace = "shareProcessNamespace";
+export const DeploymentFieldState = "state";
+export const DeploymentFieldSubdomain = "subdomain";
+export const DeploymentFieldSysctls = "sysctls";
+export const DeploymentFieldTerminationGracePeriodSeconds = "terminationGracePeriodSeconds";
+export const DeploymentFieldTopologySpreadConstraints = "topologySpreadConstraints";
+export const DeploymentFieldTransitioning = "transitioning";
+export const DeploymentFieldTransitioningMessage = "transitioningMessage";
+export const DeploymentFieldUUID = "uuid";
+export const DeploymentFieldUid = "uid";
+export const DeploymentFieldVolumes = "volumes";
+export const DeploymentFieldWindowsOptions = "windowsOptions";
+export const DeploymentFieldWorkloadAnnotations = "workloadAnnotations";
+export const DeploymentFieldWorkloadLabels = "workloadLabels";
+export const DeploymentFieldWorkloadMetrics = "workloadMetrics";
+export const DeploymentActionPause = "pause";
+export const DeploymentActionResume = "resume";
+export const DeploymentActionRollback = "rollback";
+
+export interface Deployment extends ApiResource {
+    activeDeadlineSeconds?: number;
+    annotations?: { [key: string]: string };
+    automountServiceAccountToken?: boolean;
+    containers?: Container[];
+    created?: string;
+    creatorId?: string;
+    dnsConfig?: PodDNSConfig;
+    dnsPolicy?: string;
+    deploymentConfig?: DeploymentConfig;
+    deploymentStatus?: DeploymentStatus;
+    enableServiceLinks?: boolean;
+    ephemeralContainers?: EphemeralContainer[];
+    fsgid?: number;
+    gids?: number[];
+    hostAliases?: HostAlias[];
+    hostIPC?: boolean;
+    hostNetwork?: boolean;
+    hostPID?: boolean;
+    hostname?: string;
+    imagePullSecrets?: LocalObjectReference[];
+    labels?: { [key: string]: string };
+    name?: string;
+    namespaceId?: string;
+    nodeId?: string;
+    overhead?: { [key: string]: string };
+    ownerReferences?: OwnerReference[];
+    paused?: boolean;
+    preemptionPolicy?: string;
+    projectId?: string;
+    publicEndpoints?: PublicEndpoint[];
+    readinessGates?: PodReadinessGate[];
+    removed?: string;
+    restartPolicy?: string;
+    runAsGroup?: number;
+    runAsNonRoot?: boolean;
+    runtimeClassName?: string;
+    scale?: number;
+    scheduling?: Scheduling;
+    selector?: LabelSelector;
+    serviceAccountName?: string;
+    shareProcessNamespace?: boolean;
+    state?: string;
+    subdomain?: string;
+    sysctls?: Sysctl[];
+    terminationGracePeriodSeconds?: number;
+    topologySpreadConstraints?: TopologySpreadConstraint[];
+    transitioning?: "yes" | "no" | "error";
+    transitioningMessage?: string;
+    uuid?: string;
+    uid?: number;
+    volumes?: Volume[];
+    windowsOptions?: WindowsSecurityContextOptions;
+    workloadAnnotations?: { [key: string]: string };
+    workloadLabels?: { [key: string]: string };
+    workloadMetrics?: WorkloadMetric[];
+}
+
+export interface DeploymentCollection extends ApiCollection {
+    data?: Deployment[];
+}
+
+export class DeploymentClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<DeploymentCollection> {
+        return this.ops.DoList(DeploymentType, opts);
+    }
+
+    Next(collection: DeploymentCollection): Promise<DeploymentCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: Deployment): Promise<Deployment> {
+        return this.ops.DoCreate(DeploymentType, container);
+    }
+
+    Update(existing: Deployment, updates: object): Promise<Deployment> {
+        return this.ops.DoUpdate(DeploymentType, existing, updates);
+    }
+
+    Replace(obj: Deployment): Promise<Deployment> {
+        return this.ops.DoReplace(DeploymentType, obj, obj);
+    }
+
+    ByID(id: string): Promise<Deployment> {
+        return this.ops.DoByID(DeploymentType, id);
+    }
+
+    Delete(container: Deployment): Promise<void> {
+        return this.ops.DoResourceDelete(DeploymentType, container);
+    }
+
+    ActionPause(resource: Deployment): Promise<void> {
+        return this.ops.DoAction(DeploymentType, DeploymentActionPause, resource);
+    }
+
+    ActionResume(resource: Deployment): Promise<void> {
+        return this.ops.DoAction(DeploymentType, DeploymentActionResume, resource);
+    }
+
+    ActionRollback(resource: Deployment, input: DeploymentRollbackInput): Promise<void> {
+        return this.ops.DoAction(DeploymentType, DeploymentActionRollback, resource, input);
+    }
+}
+
+export const ReplicationControllerConfigType = "replicationControllerConfig";
+export const ReplicationControllerConfigFieldMinReadySeconds = "minReadySeconds";
+
+export interface ReplicationControllerConfig {
+    minReadySeconds?: number;
+}
+
+export const ReplicationControllerSpecType = "replicationControllerSpec";
+export const ReplicationControllerSpecFieldActiveDeadlineSeconds = "activeDeadlineSeconds";
+export const ReplicationControllerSpecFieldAutomountServiceAccountToken = "automountServiceAccountToken";
+export const ReplicationControllerSpecFieldContainers = "containers";
+export const ReplicationControllerSpecFieldDNSConfig = "dnsConfig";
+export const ReplicationControllerSpecFieldDNSPolicy = "dnsPolicy";
+export const ReplicationControllerSpecFieldEnableServiceLinks = "enableServiceLinks";
+export const ReplicationControllerSpecFieldEphemeralContainers = "ephemeralContainers";
+export const ReplicationControllerSpecFieldFsgid = "fsgid";
+export const ReplicationControllerSpecFieldGids = "gids";
+export const ReplicationControllerSpecFieldHostAliases = "hostAliases";
+export const ReplicationControllerSpecFieldHostIPC = "hostIPC";
+export const ReplicationControllerSpecFieldHostNetwork = "hostNetwork";
+export const ReplicationControllerSpecFieldHostPID = "hostPID";
+export const ReplicationControllerSpecFieldHostname = "hostname";
+export const ReplicationControllerSpecFieldImagePullSecrets = "imagePullSecrets";
+export const ReplicationControllerSpecFieldNodeID = "nodeId";
+export const ReplicationControllerSpecFieldObjectMeta = "metadata";
+export const ReplicationControllerSpecFieldOverhead = "overhead";
+export const ReplicationControllerSpecFieldPreemptionPolicy = "preemptionPolicy";
+export const ReplicationControllerSpecFieldReadinessGates = "readinessGates";
+export const ReplicationControllerSpecFieldReplicationControllerConfig = "replicationControllerConfig";
+export const ReplicationControllerSpecFieldRestartPolicy = "restartPolicy";
+export const ReplicationControllerSpecFieldRunAsGroup = "runAsGroup";
+export const ReplicationControllerSpecFieldRunAsNonRoot = "runAsNonRoot";
+export const ReplicationControllerSpecFieldRuntimeClassName = "runtimeClassName";
+export const ReplicationControllerSpecFieldScale = "scale";
+export const ReplicationControllerSpecFieldScheduling = "scheduling";
+export const ReplicationControllerSpecFieldSelector = "selector";
+export const ReplicationControllerSpecFieldServiceAccountName = "serviceAccountName";
+export const ReplicationControllerSpecFieldShareProcessNamespace = "shareProcessNamespace";
+export const ReplicationControllerSpecFieldSubdomain = "subdomain";
+export const ReplicationControllerSpecFieldSysctls = "sysctls";
+export const ReplicationControllerSpecFieldTerminationGracePeriodSeconds = "terminationGracePeriodSeconds";
+export const ReplicationControllerSpecFieldTopologySpreadConstraints = "topologySpreadConstraints";
+export const ReplicationControllerSpecFieldUid = "uid";
+export const ReplicationControllerSpecFieldVolumes = "volumes";
+export const ReplicationControllerSpecFieldWindowsOptions = "windowsOptions";
+
+export interface ReplicationControllerSpec {
+    activeDeadlineSeconds?: number;
+    automountServiceAccountToken?: boolean;
+    containers?: Container[];
+    dnsConfig?: PodDNSConfig;
+    dnsPolicy?: string;
+    enableServiceLinks?: boolean;
+    ephemeralContainers?: EphemeralContainer[];
+    fsgid?: number;
+    gids?: number[];
+    hostAliases?: HostAlias[];
+    hostIPC?: boolean;
+    hostNetwork?: boolean;
+    hostPID?: boolean;
+    hostname?: string;
+    imagePullSecrets?: LocalObjectReference[];
+    nodeId?: string;
+    metadata?: ObjectMeta;
+    overhead?: { [key: string]: string };
+    preemptionPolicy?: string;
+    readinessGates?: PodReadinessGate[];
+    replicationControllerConfig?: ReplicationControllerConfig;
+    restartPolicy?: string;
+    runAsGroup?: number;
+    runAsNonRoot?: boolean;
+    runtimeClassName?: string;
+    scale?: number;
+    scheduling?: Scheduling;
+    selector?: { [key: string]: string };
+    serviceAccountName?: string;
+    shareProcessNamespace?: boolean;
+    subdomain?: string;
+    sysctls?: Sysctl[];
+    terminationGracePeriodSeconds?: number;
+    topologySpreadConstraints?: TopologySpreadConstraint[];
+    uid?: number;
+    volumes?: Volume[];
+    windowsOptions?: WindowsSecurityContextOptions;
+}
+
+export const ReplicationControllerConditionType = "replicationControllerCondition";
+export const ReplicationControllerConditionFieldLastTransitionTime = "lastTransitionTime";
+export const ReplicationControllerConditionFieldMessage = "message";
+export const ReplicationControllerConditionFieldReason = "reason";
+export const ReplicationControllerConditionFieldStatus = "status";
+export const ReplicationControllerConditionFieldType = "type";
+
+export interface ReplicationControllerCondition {
+    lastTransitionTime?: string;
+    message?: string;
+    reason?: string;
+    status?: string;
+    type?: string;
+}
+
+export const ReplicationControllerStatusType = "replicationControllerStatus";
+export const ReplicationControllerStatusFieldAvailableReplicas = "availableReplicas";
+export const ReplicationControllerStatusFieldConditions = "conditions";
+export const ReplicationControllerStatusFieldFullyLabeledReplicas = "fullyLabeledReplicas";
+export const ReplicationControllerStatusFieldObservedGeneration = "observedGeneration";
+export const ReplicationControllerStatusFieldReadyReplicas = "readyReplicas";
+export const ReplicationControllerStatusFieldReplicas = "replicas";
+
+export interface ReplicationControllerStatus {
+    availableReplicas?: number;
+    conditions?: ReplicationControllerCondition[];
+    fullyLabeledReplicas?: number;
+    observedGeneration?: number;
+    readyReplicas?: number;
+    replicas?: number;
+}
+
+export const ReplicationControllerType = "replicationController";
+export const ReplicationControllerFieldActiveDeadlineSeconds = "activeDeadlineSeconds";
+export const ReplicationControllerFieldAnnotations = "annotations";
+export const ReplicationControllerFieldAutomountServiceAccountToken = "automountServiceAccountToken";
+export const ReplicationControllerFieldContainers = "containers";
+export const ReplicationControllerFieldCreated = "created";
+export const ReplicationControllerFieldCreatorID = "creatorId";
+export const ReplicationControllerFieldDNSConfig = "dnsConfig";
+export const ReplicationControllerFieldDNSPolicy = "dnsPolicy";
+export const ReplicationControllerFieldEnableServiceLinks = "enableServiceLinks";
+export const ReplicationControllerFieldEphemeralContainers = "ephemeralContainers";
+export const ReplicationControllerFieldFsgid = "fsgid";
+export const ReplicationControllerFieldGids = "gids";
+export const ReplicationControllerFieldHostAliases = "hostAliases";
+export const ReplicationControllerFieldHostIPC = "hostIPC";
+export const ReplicationControllerFieldHostNetwork = "hostNetwork";
+export const ReplicationControllerFieldHostPID = "hostPID";
+export const ReplicationControllerFieldHostname = "hostname";
+export const ReplicationControllerFieldImagePullSecrets = "imagePullSecrets";
+export const ReplicationControllerFieldLabels = "labels";
+export const ReplicationControllerFieldName = "name";
+export const ReplicationControllerFieldNamespaceId = "namespaceId";
+export const ReplicationControllerFieldNodeID = "nodeId";
+export const ReplicationControllerFieldOverhead = "overhead";
+export const ReplicationControllerFieldOwnerReferences = "ownerReferences";
+export const ReplicationControllerFieldPreemptionPolicy = "preemptionPolicy";
+export const ReplicationControllerFieldProjectID = "projectId";
+export const ReplicationControllerFieldPublicEndpoints = "publicEndpoints";
+export const ReplicationControllerFieldReadinessGates = "readinessGates";
+export const ReplicationControllerFieldRemoved = "removed";
+export const ReplicationControllerFieldReplicationControllerConfig = "replicationControllerConfig";
+export const ReplicationControllerFieldReplicationControllerStatus = "replicationControllerStatus";
+export const ReplicationControllerFieldRestartPolicy = "restartPolicy";
+export const ReplicationControllerFieldRunAsGroup = "runAsGroup";
+export const ReplicationControllerFieldRunAsNonRoot = "runAsNonRoot";
+export const ReplicationControllerFieldRuntimeClassName = "runtimeClassName";
+export const ReplicationControllerFieldScale = "scale";
+export const ReplicationControllerFieldScheduling = "scheduling";
+export const ReplicationControllerFieldSelector = "selector";
+export const ReplicationControllerFieldServiceAccountName = "serviceAccountName";
+export const ReplicationControllerFieldShareProcessNamespace = "shareProcessNamespace";
+export const ReplicationControllerFieldState = "state";
+export const ReplicationControllerFieldSubdomain = "subdomain";
+export const ReplicationControllerFieldSysctls = "sysctls";
+export const ReplicationControllerFieldTerminationGracePeriodSeconds = "terminationGracePeriodSeconds";
+export const ReplicationControllerFieldTopologySpreadConstraints = "topologySpreadConstraints";
+export const ReplicationControllerFieldTransitioning = "transitioning";
+export const ReplicationControllerFieldTransitioningMessage = "transitioningMessage";
+export const ReplicationControllerFieldUUID = "uuid";
+export const ReplicationControllerFieldUid = "uid";
+export const ReplicationControllerFieldVolumes = "volumes";
+export const ReplicationControllerFieldWindowsOptions = "windowsOptions";
+export const ReplicationControllerFieldWorkloadAnnotations = "workloadAnnotations";
+export const ReplicationControllerFieldWorkloadLabels = "workloadLabels";
+export const ReplicationControllerFieldWorkloadMetrics = "workloadMetrics";
+
+export interface ReplicationController extends ApiResource {
+    activeDeadlineSeconds?: number;
+    annotations?: { [key: string]: string };
+    automountServiceAccountToken?: boolean;
+    containers?: Container[];
+    created?: string;
+    creatorId?: string;
+    dnsConfig?: PodDNSConfig;
+    dnsPolicy?: string;
+    enableServiceLinks?: boolean;
+    ephemeralContainers?: EphemeralContainer[];
+    fsgid?: number;
+    gids?: number[];
+    hostAliases?: HostAlias[];
+    hostIPC?: boolean;
+    hostNetwork?: boolean;
+    hostPID?: boolean;
+    hostname?: string;
+    imagePullSecrets?: LocalObjectReference[];
+    labels?: { [key: string]: string };
+    name?: string;
+    namespaceId?: string;
+    nodeId?: string;
+    overhead?: { [key: string]: string };
+    ownerReferences?: OwnerReference[];
+    preemptionPolicy?: string;
+    projectId?: string;
+    publicEndpoints?: PublicEndpoint[];
+    readinessGates?: PodReadinessGate[];
+    removed?: string;
+    replicationControllerConfig?: ReplicationControllerConfig;
+    replicationControllerStatus?: ReplicationControllerStatus;
+    restartPolicy?: string;
+    runAsGroup?: number;
+    runAsNonRoot?: boolean;
+    runtimeClassName?: string;
+    scale?: number;
+    scheduling?: Scheduling;
+    selector?: { [key: string]: string };
+    serviceAccountName?: string;
+    shareProcessNamespace?: boolean;
+    state?: string;
+    subdomain?: string;
+    sysctls?: Sysctl[];
+    terminationGracePeriodSeconds?: number;
+    topologySpreadConstraints?: TopologySpreadConstraint[];
+    transitioning?: "yes" | "no" | "error";
+    transitioningMessage?: string;
+    uuid?: string;
+    uid?: number;
+    volumes?: Volume[];
+    windowsOptions?: WindowsSecurityContextOptions;
+    workloadAnnotations?: { [key: string]: string };
+    workloadLabels?: { [key: string]: string };
+    workloadMetrics?: WorkloadMetric[];
+}
+
+export interface ReplicationControllerCollection extends ApiCollection {
+    data?: ReplicationController[];
+}
+
+export class ReplicationControllerClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<ReplicationControllerCollection> {
+        return this.ops.DoList(ReplicationControllerType, opts);
+    }
+
+    Next(collection: ReplicationControllerCollection): Promise<ReplicationControllerCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: ReplicationController): Promise<ReplicationController> {
+        return this.ops.DoCreate(ReplicationControllerType, container);
+    }
+
+    Update(existing: ReplicationController, updates: object): Promise<ReplicationController> {
+        return this.ops.DoUpdate(ReplicationControllerType, existing, updates);
+    }
+
+    Replace(obj: ReplicationController): Promise<ReplicationController> {
+        return this.ops.DoReplace(ReplicationControllerType, obj, obj);
+    }
+
+    ByID(id: string): Promise<ReplicationController> {
+        return this.ops.DoByID(ReplicationControllerType, id);
+    }
+
+    Delete(container: ReplicationController): Promise<void> {
+        return this.ops.DoResourceDelete(ReplicationControllerType, container);
+    }
+}
+
+export const ReplicaSetConfigType = "replicaSetConfig";
+export const ReplicaSetConfigFieldMinReadySeconds = "minReadySeconds";
+
+export interface ReplicaSetConfig {
+    minReadySeconds?: number;
+}
+
+export const ReplicaSetSpecType = "replicaSetSpec";
+export const ReplicaSetSpecFieldReplicaSetConfig = "replicaSetConfig";
+export const ReplicaSetSpecFieldScale = "scale";
+export const ReplicaSetSpecFieldSelector = "selector";
+export const ReplicaSetSpecFieldTemplate = "template";
+
+export interface ReplicaSetSpec {
+    replicaSetConfig?: ReplicaSetConfig;
+    scale?: number;
+    selector?: LabelSelector;
+    template?: PodTemplateSpec;
+}
+
+export const ReplicaSetConditionType = "replicaSetCondition";
+export const ReplicaSetConditionFieldLastTransitionTime = "lastTransitionTime";
+export const ReplicaSetConditionFieldMessage = "message";
+export const ReplicaSetConditionFieldReason = "reason";
+export const ReplicaSetConditionFieldStatus = "status";
+export const ReplicaSetConditionFieldType = "type";
+
+export interface ReplicaSetCondition {
+    lastTransitionTime?: string;
+    message?: string;
+    reason?: string;
+    status?: string;
+    type?: string;
+}
+
+export const ReplicaSetStatusType = "replicaSetStatus";
+export const ReplicaSetStatusFieldAvailableReplicas = "availableReplicas";
+export const ReplicaSetStatusFieldConditions = "conditions";
+export const ReplicaSetStatusFieldFullyLabeledReplicas = "fullyLabeledReplicas";
+export const ReplicaSetStatusFieldObservedGeneration = "observedGeneration";
+export const ReplicaSetStatusFieldReadyReplicas = "readyReplicas";
+export const ReplicaSetStatusFieldReplicas = "replicas";
+
+export interface ReplicaSetStatus {
+    availableReplicas?: number;
+    conditions?: ReplicaSetCondition[];
+    fullyLabeledReplicas?: number;
+    observedGeneration?: number;
+    readyReplicas?: number;
+    replicas?: number;
+}
+
+export const ReplicaSetType = "replicaSet";
+export const ReplicaSetFieldActiveDeadlineSeconds = "activeDeadlineSeconds";
+export const ReplicaSetFieldAnnotations = "annotations";
+export const ReplicaSetFieldAutomountServiceAccountToken = "automountServiceAccountToken";
+export const ReplicaSetFieldContainers = "containers";
+export const ReplicaSetFieldCreated = "created";
+export const ReplicaSetFieldCreatorID = "creatorId";
+export const ReplicaSetFieldDNSConfig = "dnsConfig";
+export const ReplicaSetFieldDNSPolicy = "dnsPolicy";
+export const ReplicaSetFieldEnableServiceLinks = "enableServiceLinks";
+export const ReplicaSetFieldEphemeralContainers = "ephemeralContainers";
+export const ReplicaSetFieldFsgid = "fsgid";
+export const ReplicaSetFieldGids = "gids";
+export const ReplicaSetFieldHostAliases = "hostAliases";
+export const ReplicaSetFieldHostIPC = "hostIPC";
+export const ReplicaSetFieldHostNetwork = "hostNetwork";
+export const ReplicaSetFieldHostPID = "hostPID";
+export const ReplicaSetFieldHostname = "hostname";
+export const ReplicaSetFieldImagePullSecrets = "imagePullSecrets";
+export const ReplicaSetFieldLabels = "labels";
+export const ReplicaSetFieldName = "name";
+export const ReplicaSetFieldNamespaceId = "namespaceId";
+export const ReplicaSetFieldNodeID = "nodeId";
+export const ReplicaSetFieldOverhead = "overhead";
+export const ReplicaSetFieldOwnerReferences = "ownerReferences";
+export const ReplicaSetFieldPreemptionPolicy = "preemptionPolicy";
+export const ReplicaSetFieldProjectID = "projectId";
+export const ReplicaSetFieldPublicEndpoints = "publicEndpoints";
+export const ReplicaSetFieldReadinessGates = "readinessGates";
+export const ReplicaSetFieldRemoved = "removed";
+export const ReplicaSetFieldReplicaSetConfig = "replicaSetConfig";
+export const ReplicaSetFieldReplicaSetStatus = "replicaSetStatus";
+export const ReplicaSetFieldRestartPolicy = "restartPolicy";
+export const ReplicaSetFieldRunAsGroup = "runAsGroup";
+export const ReplicaSetFieldRunAsNonRoot = "runAsNonRoot";
+export const ReplicaSetFieldRuntimeClassName = "runtimeClassName";
+export const ReplicaSetFieldScale = "scale";
+export const ReplicaSetFieldScheduling = "scheduling";
+export const ReplicaSetFieldSelector = "selector";
+export const ReplicaSetFieldServiceAccountName = "serviceAccountName";
+export const ReplicaSetFieldShareProcessNamespace = "shareProcessNamespace";
+export const ReplicaSetFieldState = "state";
+export const ReplicaSetFieldSubdomain = "subdomain";
+export const ReplicaSetFieldSysctls = "sysctls";
+export const ReplicaSetFieldTerminationGracePeriodSeconds = "terminationGracePeriodSeconds";
+export const ReplicaSetFieldTopologySpreadConstraints = "topologySpreadConstraints";
+export const ReplicaSetFieldTransitioning = "transitioning";
+export const ReplicaSetFieldTransitioningMessage = "transitioningMessage";
+export const ReplicaSetFieldUUID = "uuid";
+export const ReplicaSetFieldUid = "uid";
+export const ReplicaSetFieldVolumes = "volumes";
+export const ReplicaSetFieldWindowsOptions = "windowsOptions";
+export const ReplicaSetFieldWorkloadAnnotations = "workloadAnnotations";
+export const ReplicaSetFieldWorkloadLabels = "workloadLabels";
+export const ReplicaSetFieldWorkloadMetrics = "workloadMetrics";
+
+export interface ReplicaSet extends ApiResource {
+    activeDeadlineSeconds?: number;
+    annotations?: { [key: string]: string };
+    automountServiceAccountToken?: boolean;
+    containers?: Container[];
+    created?: string;
+    creatorId?: string;
+    dnsConfig?: PodDNSConfig;
+    dnsPolicy?: string;
+    enableServiceLinks?: boolean;
+    ephemeralContainers?: EphemeralContainer[];
+    fsgid?: number;
+    gids?: number[];
+    hostAliases?: HostAlias[];
+    hostIPC?: boolean;
+    hostNetwork?: boolean;
+    hostPID?: boolean;
+    hostname?: string;
+    imagePullSecrets?: LocalObjectReference[];
+    labels?: { [key: string]: string };
+    name?: string;
+    namespaceId?: string;
+    nodeId?: string;
+    overhead?: { [key: string]: string };
+    ownerReferences?: OwnerReference[];
+    preemptionPolicy?: string;
+    projectId?: string;
+    publicEndpoints?: PublicEndpoint[];
+    readinessGates?: PodReadinessGate[];
+    removed?: string;
+    replicaSetConfig?: ReplicaSetConfig;
+    replicaSetStatus?: ReplicaSetStatus;
+    restartPolicy?: string;
+    runAsGroup?: number;
+    runAsNonRoot?: boolean;
+    runtimeClassName?: string;
+    scale?: number;
+    scheduling?: Scheduling;
+    selector?: LabelSelector;
+    serviceAccountName?: string;
+    shareProcessNamespace?: boolean;
+    state?: string;
+    subdomain?: string;
+    sysctls?: Sysctl[];
+    terminationGracePeriodSeconds?: number;
+    topologySpreadConstraints?: TopologySpreadConstraint[];
+    transitioning?: "yes" | "no" | "error";
+    transitioningMessage?: string;
+    uuid?: string;
+    uid?: number;
+    volumes?: Volume[];
+    windowsOptions?: WindowsSecurityContextOptions;
+    workloadAnnotations?: { [key: string]: string };
+    workloadLabels?: { [key: string]: string };
+    workloadMetrics?: WorkloadMetric[];
+}
+
+export interface ReplicaSetCollection extends ApiCollection {
+    data?: ReplicaSet[];
+}
+
+export class ReplicaSetClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<ReplicaSetCollection> {
+        return this.ops.DoList(ReplicaSetType, opts);
+    }
+
+    Next(collection: ReplicaSetCollection): Promise<ReplicaSetCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: ReplicaSet): Promise<ReplicaSet> {
+        return this.ops.DoCreate(ReplicaSetType, container);
+    }
+
+    Update(existing: ReplicaSet, updates: object): Promise<ReplicaSet> {
+        return this.ops.DoUpdate(ReplicaSetType, existing, updates);
+    }
+
+    Replace(obj: ReplicaSet): Promise<ReplicaSet> {
+        return this.ops.DoReplace(ReplicaSetType, obj, obj);
+    }
+
+    ByID(id: string): Promise<ReplicaSet> {
+        return this.ops.DoByID(ReplicaSetType, id);
+    }
+
+    Delete(container: ReplicaSet): Promise<void> {
+        return this.ops.DoResourceDelete(ReplicaSetType, container);
+    }
+}
+
+export const RollingUpdateStatefulSetStrategyType = "rollingUpdateStatefulSetStrategy";
+export const RollingUpdateStatefulSetStrategyFieldPartition = "partition";
+
+export interface RollingUpdateStatefulSetStrategy {
+    partition?: number;
+}
+
+export const StatefulSetUpdateStrategyType = "statefulSetUpdateStrategy";
+export const StatefulSetUpdateStrategyFieldPartition = "partition";
+export const StatefulSetUpdateStrategyFieldStrategy = "strategy";
+
+export interface StatefulSetUpdateStrategy {
+    partition?: number;
+    strategy?: "RollingUpdate" | "OnDelete";
+}
+
+export const StatefulSetConfigType = "statefulSetConfig";
+export const StatefulSetConfigFieldPartition = "partition";
+export const StatefulSetConfigFieldPodManagementPolicy = "podManagementPolicy";
+export const StatefulSetConfigFieldRevisionHistoryLimit = "revisionHistoryLimit";
+export const StatefulSetConfigFieldServiceName = "serviceName";
+export const StatefulSetConfigFieldStrategy = "strategy";
+export const StatefulSetConfigFieldVolumeClaimTemplates = "volumeClaimTemplates";
+
+export interface StatefulSetConfig {
+    partition?: number;
+    podManagementPolicy?: "OrderedReady" | "Parallel";
+    revisionHistoryLimit?: number;
+    serviceName?: string;
+    strategy?: "RollingUpdate" | "OnDelete";
+    volumeClaimTemplates?: PersistentVolumeClaim[];
+}
+
+export const StatefulSetSpecType = "statefulSetSpec";
+export const StatefulSetSpecFieldActiveDeadlineSeconds = "activeDeadlineSeconds";
+export const StatefulSetSpecFieldAutomountServiceAccountToken = "automountServiceAccountToken";
+export const StatefulSetSpecFieldContainers = "containers";
+export const StatefulSetSpecFieldDNSConfig = "dnsConfig";
+export const StatefulSetSpecFieldDNSPolicy = "dnsPolicy";
+export const StatefulSetSpecFieldEnableServiceLinks = "enableServiceLinks";
+export const StatefulSetSpecFieldEphemeralContainers = "ephemeralContainers";
+export const StatefulSetSpecFieldFsgid = "fsgid";
+export const StatefulSetSpecFieldGids = "gids";
+export const StatefulSetSpecFieldHostAliases = "hostAliases";
+export const StatefulSetSpecFieldHostIPC = "hostIPC";
+export const StatefulSetSpecFieldHostNetwork = "hostNetwork";
+export const StatefulSetSpecFieldHostPID = "hostPID";
+export const StatefulSetSpecFieldHostname = "hostname";
+export const StatefulSetSpecFieldImagePullSecrets = "imagePullSecrets";
+export const StatefulSetSpecFieldNodeID = "nodeId";
+export const StatefulSetSpecFieldObjectMeta = "metadata";
+export const StatefulSetSpecFieldOverhead = "overhead";
+export const StatefulSetSpecFieldPreemptionPolicy = "preemptionPolicy";
+export const StatefulSetSpecFieldReadinessGates = "readinessGates";
+export const StatefulSetSpecFieldRestartPolicy = "restartPolicy";
+export const StatefulSetSpecFieldRunAsGroup = "runAsGroup";
+export const StatefulSetSpecFieldRunAsNonRoot = "runAsNonRoot";
+export const StatefulSetSpecFieldRuntimeClassName = "runtimeClassName";
+export const StatefulSetSpecFieldScale = "scale";
+export const StatefulSetSpecFieldScheduling = "scheduling";
+export const StatefulSetSpecFieldSelector = "selector";
+export const StatefulSetSpecFieldServiceAccountName = "serviceAccountName";
+export const StatefulSetSpecFieldShareProcessNamespace = "shareProcessNamespace";
+export const StatefulSetSpecFieldStatefulSetConfig = "statefulSetConfig";
+export const StatefulSetSpecFieldSubdomain = "subdomain";
+export const StatefulSetSpecFieldSysctls = "sysctls";
+export const StatefulSetSpecFieldTerminationGracePeriodSeconds = "terminationGracePeriodSeconds";
+export const StatefulSetSpecFieldTopologySpreadConstraints = "topologySpreadConstraints";
+export const StatefulSetSpecFieldUid = "uid";
+export const StatefulSetSpecFieldVolumes = "volumes";
+export const StatefulSetSpecFieldWindowsOptions = "windowsOptions";
+
+export interface StatefulSetSpec {
+    activeDeadlineSeconds?: number;
+    automountServiceAccountToken?: boolean;
+    containers?: Container[];
+    dnsConfig?: PodDNSConfig;
+    dnsPolicy?: string;
+    enableServiceLinks?: boolean;
+    ephemeralContainers?: EphemeralContainer[];
+    fsgid?: number;
+    gids?: number[];
+    hostAliases?: HostAlias[];
+    hostIPC?: boolean;
+    hostNetwork?: boolean;
+    hostPID?: boolean;
+    hostname?: string;
+    imagePullSecrets?: LocalObjectReference[];
+    nodeId?: string;
+    metadata?: ObjectMeta;
+    overhead?: { [key: string]: string };
+    preemptionPolicy?: string;
+    readinessGates?: PodReadinessGate[];
+    restartPolicy?: string;
+    runAsGroup?: number;
+    runAsNonRoot?: boolean;
+    runtimeClassName?: string;
+    scale?: number;
+    scheduling?: Scheduling;
+    selector?: LabelSelector;
+    serviceAccountName?: string;
+    shareProcessNamespace?: boolean;
+    statefulSetConfig?: StatefulSetConfig;
+    subdomain?: string;
+    sysctls?: Sysctl[];
+    terminationGracePeriodSeconds?: number;
+    topologySpreadConstraints?: TopologySpreadConstraint[];
+    uid?: number;
+    volumes?: Volume[];
+    windowsOptions?: WindowsSecurityContextOptions;
+}
+
+export const StatefulSetConditionType = "statefulSetCondition";
+export const StatefulSetConditionFieldLastTransitionTime = "lastTransitionTime";
+export const StatefulSetConditionFieldMessage = "message";
+export const StatefulSetConditionFieldReason = "reason";
+export const StatefulSetConditionFieldStatus = "status";
+export const StatefulSetConditionFieldType = "type";
+
+export interface StatefulSetCondition {
+    lastTransitionTime?: string;
+    message?: string;
+    reason?: string;
+    status?: string;
+    type?: string;
+}
+
+export const StatefulSetStatusType = "statefulSetStatus";
+export const StatefulSetStatusFieldCollisionCount = "collisionCount";
+export const StatefulSetStatusFieldConditions = "conditions";
+export const StatefulSetStatusFieldCurrentReplicas = "currentReplicas";
+export const StatefulSetStatusFieldCurrentRevision = "currentRevision";
+export const StatefulSetStatusFieldObservedGeneration = "observedGeneration";
+export const StatefulSetStatusFieldReadyReplicas = "readyReplicas";
+export const StatefulSetStatusFieldReplicas = "replicas";
+export const StatefulSetStatusFieldUpdateRevision = "updateRevision";
+export const StatefulSetStatusFieldUpdatedReplicas = "updatedReplicas";
+
+export interface StatefulSetStatus {
+    collisionCount?: number;
+    conditions?: StatefulSetCondition[];
+    currentReplicas?: number;
+    currentRevision?: string;
+    observedGeneration?: number;
+    readyReplicas?: number;
+    replicas?: number;
+    updateRevision?: string;
+    updatedReplicas?: number;
+}
+
+export const StatefulSetType = "statefulSet";
+export const StatefulSetFieldActiveDeadlineSeconds = "activeDeadlineSeconds";
+export const StatefulSetFieldAnnotations = "annotations";
+export const StatefulSetFieldAutomountServiceAccountToken = "automountServiceAccountToken";
+export const StatefulSetFieldContainers = "containers";
+export const StatefulSetFieldCreated = "created";
+export const StatefulSetFieldCreatorID = "creatorId";
+export const StatefulSetFieldDNSConfig = "dnsConfig";
+export const StatefulSetFieldDNSPolicy = "dnsPolicy";
+export const StatefulSetFieldEnableServiceLinks = "enableServiceLinks";
+export const StatefulSetFieldEphemeralContainers = "ephemeralContainers";
+export const StatefulSetFieldFsgid = "fsgid";
+export const StatefulSetFieldGids = "gids";
+export const StatefulSetFieldHostAliases = "hostAliases";
+export const StatefulSetFieldHostIPC = "hostIPC";
+export const StatefulSetFieldHostNetwork = "hostNetwork";
+export const StatefulSetFieldHostPID = "hostPID";
+export const StatefulSetFieldHostname = "hostname";
+export const StatefulSetFieldImagePullSecrets = "imagePullSecrets";
+export const StatefulSetFieldLabels = "labels";
+export const StatefulSetFieldName = "name";
+export const StatefulSetFieldNamespaceId = "namespaceId";
+export const StatefulSetFieldNodeID = "nodeId";
+export const StatefulSetFieldOverhead = "overhead";
+export const StatefulSetFieldOwnerReferences = "ownerReferences";
+export const StatefulSetFieldPreemptionPolicy = "preemptionPolicy";
+export const StatefulSetFieldProjectID = "projectId";
+export const StatefulSetFieldPublicEndpoints = "publicEndpoints";
+export const StatefulSetFieldReadinessGates = "readinessGates";
+export const StatefulSetFieldRemoved = "removed";
+export const StatefulSetFieldRestartPolicy = "restartPolicy";
+export const StatefulSetFieldRunAsGroup = "runAsGroup";
+export const StatefulSetFieldRunAsNonRoot = "runAsNonRoot";
+export const StatefulSetFieldRuntimeClassName = "runtimeClassName";
+export const StatefulSetFieldScale = "scale";
+export const StatefulSetFieldScheduling = "scheduling";
+export const StatefulSetFieldSelector = "selector";
+export const StatefulSetFieldServiceAccountName = "serviceAccountName";
+export const StatefulSetFieldShareProcessNamespace = "shareProcessNamespace";
+export const StatefulSetFieldState = "state";
+export const StatefulSetFieldStatefulSetConfig = "statefulSetConfig";
+export const StatefulSetFieldStatefulSetStatus = "statefulSetStatus";
+export const StatefulSetFieldSubdomain = "subdomain";
+export const StatefulSetFieldSysctls = "sysctls";
+export const StatefulSetFieldTerminationGracePeriodSeconds = "terminationGracePeriodSeconds";
+export const StatefulSetFieldTopologySpreadConstraints = "topologySpreadConstraints";
+export const StatefulSetFieldTransitioning = "transitioning";
+export const StatefulSetFieldTransitioningMessage = "transitioningMessage";
+export const StatefulSetFieldUUID = "uuid";
+export const StatefulSetFieldUid = "uid";
+export const StatefulSetFieldVolumes = "volumes";
+export const StatefulSetFieldWindowsOptions = "windowsOptions";
+export const StatefulSetFieldWorkloadAnnotations = "workloadAnnotations";
+export const StatefulSetFieldWorkloadLabels = "workloadLabels";
+export const StatefulSetFieldWorkloadMetrics = "workloadMetrics";
+
+export interface StatefulSet extends ApiResource {
+    activeDeadlineSeconds?: number;
+    annotations?: { [key: string]: string };
+    automountServiceAccountToken?: boolean;
+    containers?: Container[];
+    created?: string;
+    creatorId?: string;
+    dnsConfig?: PodDNSConfig;
+    dnsPolicy?: string;
+    enableServiceLinks?: boolean;
+    ephemeralContainers?: EphemeralContainer[];
+    fsgid?: number;
+    gids?: number[];
+    hostAliases?: HostAlias[];
+    hostIPC?: boolean;
+    hostNetwork?: boolean;
+    hostPID?: boolean;
+    hostname?: string;
+    imagePullSecrets?: LocalObjectReference[];
+    labels?: { [key: string]: string };
+    name?: string;
+    namespaceId?: string;
+    nodeId?: string;
+    overhead?: { [key: string]: string };
+    ownerReferences?: OwnerReference[];
+    preemptionPolicy?: string;
+    projectId?: string;
+    publicEndpoints?: PublicEndpoint[];
+    readinessGates?: PodReadinessGate[];
+    removed?: string;
+    restartPolicy?: string;
+    runAsGroup?: number;
+    runAsNonRoot?: boolean;
+    runtimeClassName?: string;
+    scale?: number;
+    scheduling?: Scheduling;
+    selector?: LabelSelector;
+    serviceAccountName?: string;
+    shareProcessNamespace?: boolean;
+    state?: string;
+    statefulSetConfig?: StatefulSetConfig;
+    statefulSetStatus?: StatefulSetStatus;
+    subdomain?: string;
+    sysctls?: Sysctl[];
+    terminationGracePeriodSeconds?: number;
+    topologySpreadConstraints?: TopologySpreadConstraint[];
+    transitioning?: "yes" | "no" | "error";
+    transitioningMessage?: string;
+    uuid?: string;
+    uid?: number;
+    volumes?: Volume[];
+    windowsOptions?: WindowsSecurityContextOptions;
+    workloadAnnotations?: { [key: string]: string };
+    workloadLabels?: { [key: string]: string };
+    workloadMetrics?: WorkloadMetric[];
+}
+
+export interface StatefulSetCollection extends ApiCollection {
+    data?: StatefulSet[];
+}
+
+export class StatefulSetClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<StatefulSetCollection> {
+        return this.ops.DoList(StatefulSetType, opts);
+    }
+
+    Next(collection: StatefulSetCollection): Promise<StatefulSetCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: StatefulSet): Promise<StatefulSet> {
+        return this.ops.DoCreate(StatefulSetType, container);
+    }
+
+    Update(existing: StatefulSet, updates: object): Promise<StatefulSet> {
+        return this.ops.DoUpdate(StatefulSetType, existing, updates);
+    }
+
+    Replace(obj: StatefulSet): Promise<StatefulSet> {
+        return this.ops.DoReplace(StatefulSetType, obj, obj);
+    }
+
+    ByID(id: string): Promise<StatefulSet> {
+        return this.ops.DoByID(StatefulSetType, id);
+    }
+
+    Delete(container: StatefulSet): Promise<void> {
+        return this.ops.DoResourceDelete(StatefulSetType, container);
+    }
+}
+
+export const RollingUpdateDaemonSetType = "rollingUpdateDaemonSet";
+export const RollingUpdateDaemonSetFieldMaxUnavailable = "maxUnavailable";
+
+export interface RollingUpdateDaemonSet {
+    maxUnavailable?: number | string;
+}
+
+export const DaemonSetUpdateStrategyType = "daemonSetUpdateStrategy";
+export const DaemonSetUpdateStrategyFieldMaxUnavailable = "maxUnavailable";
+export const DaemonSetUpdateStrategyFieldStrategy = "strategy";
+
+export interface DaemonSetUpdateStrategy {
+    maxUnavailable?: number | string;
+    strategy?: "RollingUpdate" | "OnDelete";
+}
+
+export const DaemonSetConfigType = "daemonSetConfig";
+export const DaemonSetConfigFieldMaxUnavailable = "maxUnavailable";
+export const DaemonSetConfigFieldMinReadySeconds = "minReadySeconds";
+export const DaemonSetConfigFieldRevisionHistoryLimit = "revisionHistoryLimit";
+export const DaemonSetConfigFieldStrategy = "strategy";
+
+export interface DaemonSetConfig {
+    maxUnavailable?: number | string;
+    minReadySeconds?: number;
+    revisionHistoryLimit?: number;
+    strategy?: "RollingUpdate" | "OnDelete";
+}
+
+export const DaemonSetSpecType = "daemonSetSpec";
+export const DaemonSetSpecFieldActiveDeadlineSeconds = "activeDeadlineSeconds";
+export const DaemonSetSpecFieldAutomountServiceAccountToken = "automountServiceAccountToken";
+export const DaemonSetSpecFieldContainers = "containers";
+export const DaemonSetSpecFieldDNSConfig = "dnsConfig";
+export const DaemonSetSpecFieldDNSPolicy = "dnsPolicy";
+export const DaemonSetSpecFieldDaemonSetConfig = "daemonSetConfig";
+export const DaemonSetSpecFieldEnableServiceLinks = "enableServiceLinks";
+export const DaemonSetSpecFieldEphemeralContainers = "ephemeralContainers";
+export const DaemonSetSpecFieldFsgid = "fsgid";
+export const DaemonSetSpecFieldGids = "gids";
+export const DaemonSetSpecFieldHostAliases = "hostAliases";
+export const DaemonSetSpecFieldHostIPC = "hostIPC";
+export const DaemonSetSpecFieldHostNetwork = "hostNetwork";
+export const DaemonSetSpecFieldHostPID = "hostPID";
+export const DaemonSetSpecFieldHostname = "hostname";
+export const DaemonSetSpecFieldImagePullSecrets = "imagePullSecrets";
+export const DaemonSetSpecFieldNodeID = "nodeId";
+export const DaemonSetSpecFieldObjectMeta = "metadata";
+export const DaemonSetSpecFieldOverhead = "overhead";
+export const DaemonSetSpecFieldPreemptionPolicy = "preemptionPolicy";
+export const DaemonSetSpecFieldReadinessGates = "readinessGates";
+export const DaemonSetSpecFieldRestartPolicy = "restartPolicy";
+export const DaemonSetSpecFieldRunAsGroup = "runAsGroup";
+export const DaemonSetSpecFieldRunAsNonRoot = "runAsNonRoot";
+export const DaemonSetSpecFieldRuntimeClassName = "runtimeClassName";
+export const DaemonSetSpecFieldScheduling = "scheduling";
+export const DaemonSetSpecFieldSelector = "selector";
+export const DaemonSetSpecFieldServiceAccountName = "serviceAccountName";
+export const DaemonSetSpecFieldShareProcessNamespace = "shareProcessNamespace";
+export const DaemonSetSpecFieldSubdomain = "subdomain";
+export const DaemonSetSpecFieldSysctls = "sysctls";
+export const DaemonSetSpecFieldTerminationGracePeriodSeconds = "terminationGracePeriodSeconds";
+export const DaemonSetSpecFieldTopologySpreadConstraints = "topologySpreadConstraints";
+export const DaemonSetSpecFieldUid = "uid";
+export const DaemonSetSpecFieldVolumes = "volumes";
+export const DaemonSetSpecFieldWindowsOptions = "windowsOptions";
+
+export interface DaemonSetSpec {
+    activeDeadlineSeconds?: number;
+    automountServiceAccountToken?: boolean;
+    containers?: Container[];
+    dnsConfig?: PodDNSConfig;
+    dnsPolicy?: string;
+    daemonSetConfig?: DaemonSetConfig;
+    enableServiceLinks?: boolean;
+    ephemeralContainers?: EphemeralContainer[];
+    fsgid?: number;
+    gids?: number[];
+    hostAliases?: HostAlias[];
+    hostIPC?: boolean;
+    hostNetwork?: boolean;
+    hostPID?: boolean;
+    hostname?: string;
+    imagePullSecrets?: LocalObjectReference[];
+    nodeId?: string;
+    metadata?: ObjectMeta;
+    overhead?: { [key: string]: string };
+    preemptionPolicy?: string;
+    readinessGates?: PodReadinessGate[];
+    restartPolicy?: string;
+    runAsGroup?: number;
+    runAsNonRoot?: boolean;
+    runtimeClassName?: string;
+    scheduling?: Scheduling;
+    selector?: LabelSelector;
+    serviceAccountName?: string;
+    shareProcessNamespace?: boolean;
+    subdomain?: string;
+    sysctls?: Sysctl[];
+    terminationGracePeriodSeconds?: number;
+    topologySpreadConstraints?: TopologySpreadConstraint[];
+    uid?: number;
+    volumes?: Volume[];
+    windowsOptions?: WindowsSecurityContextOptions;
+}
+
+export const DaemonSetConditionType = "daemonSetCondition";
+export const DaemonSetConditionFieldLastTransitionTime = "lastTransitionTime";
+export const DaemonSetConditionFieldMessage = "message";
+export const DaemonSetConditionFieldReason = "reason";
+export const DaemonSetConditionFieldStatus = "status";
+export const DaemonSetConditionFieldType = "type";
+
+export interface DaemonSetCondition {
+    lastTransitionTime?: string;
+    message?: string;
+    reason?: string;
+    status?: string;
+    type?: string;
+}
+
+export const DaemonSetStatusType = "daemonSetStatus";
+export const DaemonSetStatusFieldCollisionCount = "collisionCount";
+export const DaemonSetStatusFieldConditions = "conditions";
+export const DaemonSetStatusFieldCurrentNumberScheduled = "currentNumberScheduled";
+export const DaemonSetStatusFieldDesiredNumberScheduled = "desiredNumberScheduled";
+export const DaemonSetStatusFieldNumberAvailable = "numberAvailable";
+export const DaemonSetStatusFieldNumberMisscheduled = "numberMisscheduled";
+export const DaemonSetStatusFieldNumberReady = "numberReady";
+export const DaemonSetStatusFieldNumberUnavailable = "numberUnavailable";
+export const DaemonSetStatusFieldObservedGeneration = "observedGeneration";
+export const DaemonSetStatusFieldUpdatedNumberScheduled = "updatedNumberScheduled";
+
+export interface DaemonSetStatus {
+    collisionCount?: number;
+    conditions?: DaemonSetCondition[];
+    currentNumberScheduled?: number;
+    desiredNumberScheduled?: number;
+    numberAvailable?: number;
+    numberMisscheduled?: number;
+    numberReady?: number;
+    numberUnavailable?: number;
+    observedGeneration?: number;
+    updatedNumberScheduled?: number;
+}
+
+export const DaemonSetType = "daemonSet";
+export const DaemonSetFieldActiveDeadlineSeconds = "activeDeadlineSeconds";
+export const DaemonSetFieldAnnotations = "annotations";
+export const DaemonSetFieldAutomountServiceAccountToken = "automountServiceAccountToken";
+export const DaemonSetFieldContainers = "containers";
+export const DaemonSetFieldCreated = "created";
+export const DaemonSetFieldCreatorID = "creatorId";
+export const DaemonSetFieldDNSConfig = "dnsConfig";
+export const DaemonSetFieldDNSPolicy = "dnsPolicy";
+export const DaemonSetFieldDaemonSetConfig = "daemonSetConfig";
+export const DaemonSetFieldDaemonSetStatus = "daemonSetStatus";
+export const DaemonSetFieldEnableServiceLinks = "enableServiceLinks";
+export const DaemonSetFieldEphemeralContainers = "ephemeralContainers";
+export const DaemonSetFieldFsgid = "fsgid";
+export const DaemonSetFieldGids = "gids";
+export const DaemonSetFieldHostAliases = "hostAliases";
+export const DaemonSetFieldHostIPC = "hostIPC";
+export const DaemonSetFieldHostNetwork = "hostNetwork";
+export const DaemonSetFieldHostPID = "hostPID";
+export const DaemonSetFieldHostname = "hostname";
+export const DaemonSetFieldImagePullSecrets = "imagePullSecrets";
+export const DaemonSetFieldLabels = "labels";
+export const DaemonSetFieldName = "name";
+export const DaemonSetFieldNamespaceId = "namespaceId";
+export const DaemonSetFieldNodeID = "nodeId";
+export const DaemonSetFieldOverhead = "overhead";
+export const DaemonSetFieldOwnerReferences = "ownerReferences";
+export const DaemonSetFieldPreemptionPolicy = "preemptionPolicy";
+export const DaemonSetFieldProjectID = "projectId";
+export const DaemonSetFieldPublicEndpoints = "publicEndpoints";
+export const DaemonSetFieldReadinessGates = "readinessGates";
+export const DaemonSetFieldRemoved = "removed";
+export const DaemonSetFieldRestartPolicy = "restartPolicy";
+export const DaemonSetFieldRunAsGroup = "runAsGroup";
+export const DaemonSetFieldRunAsNonRoot = "runAsNonRoot";
+export const DaemonSetFieldRuntimeClassName = "runtimeClassName";
+export const DaemonSetFieldScheduling = "scheduling";
+export const DaemonSetFieldSelector = "selector";
+export const DaemonSetFieldServiceAccountName = "serviceAccountName";
+export const DaemonSetFieldShareProcessNamespace = "shareProcessNamespace";
+export const DaemonSetFieldState = "state";
+export const DaemonSetFieldSubdomain = "subdomain";
+export const DaemonSetFieldSysctls = "sysctls";
+export const DaemonSetFieldTerminationGracePeriodSeconds = "terminationGracePeriodSeconds";
+export const DaemonSetFieldTopologySpreadConstraints = "topologySpreadConstraints";
+export const DaemonSetFieldTransitioning = "transitioning";
+export const DaemonSetFieldTransitioningMessage = "transitioningMessage";
+export const DaemonSetFieldUUID = "uuid";
+export const DaemonSetFieldUid = "uid";
+export const DaemonSetFieldVolumes = "volumes";
+export const DaemonSetFieldWindowsOptions = "windowsOptions";
+export const DaemonSetFieldWorkloadAnnotations = "workloadAnnotations";
+export const DaemonSetFieldWorkloadLabels = "workloadLabels";
+export const DaemonSetFieldWorkloadMetrics = "workloadMetrics";
+
+export interface DaemonSet extends ApiResource {
+    activeDeadlineSeconds?: number;
+    annotations?: { [key: string]: string };
+    automountServiceAccountToken?: boolean;
+    containers?: Container[];
+    created?: string;
+    creatorId?: string;
+    dnsConfig?: PodDNSConfig;
+    dnsPolicy?: string;
+    daemonSetConfig?: DaemonSetConfig;
+    daemonSetStatus?: DaemonSetStatus;
+    enableServiceLinks?: boolean;
+    ephemeralContainers?: EphemeralContainer[];
+    fsgid?: number;
+    gids?: number[];
+    hostAliases?: HostAlias[];
+    hostIPC?: boolean;
+    hostNetwork?: boolean;
+    hostPID?: boolean;
+    hostname?: string;
+    imagePullSecrets?: LocalObjectReference[];
+    labels?: { [key: string]: string };
+    name?: string;
+    namespaceId?: string;
+    nodeId?: string;
+    overhead?: { [key: string]: string };
+    ownerReferences?: OwnerReference[];
+    preemptionPolicy?: string;
+    projectId?: string;
+    publicEndpoints?: PublicEndpoint[];
+    readinessGates?: PodReadinessGate[];
+    removed?: string;
+    restartPolicy?: string;
+    runAsGroup?: number;
+    runAsNonRoot?: boolean;
+    runtimeClassName?: string;
+    scheduling?: Scheduling;
+    selector?: LabelSelector;
+    serviceAccountName?: string;
+    shareProcessNamespace?: boolean;
+    state?: string;
+    subdomain?: string;
+    sysctls?: Sysctl[];
+    terminationGracePeriodSeconds?: number;
+    topologySpreadConstraints?: TopologySpreadConstraint[];
+    transitioning?: "yes" | "no" | "error";
+    transitioningMessage?: string;
+    uuid?: string;
+    uid?: number;
+    volumes?: Volume[];
+    windowsOptions?: WindowsSecurityContextOptions;
+    workloadAnnotations?: { [key: string]: string };
+    workloadLabels?: { [key: string]: string };
+    workloadMetrics?: WorkloadMetric[];
+}
+
+export interface DaemonSetCollection extends ApiCollection {
+    data?: DaemonSet[];
+}
+
+export class DaemonSetClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<DaemonSetCollection> {
+        return this.ops.DoList(DaemonSetType, opts);
+    }
+
+    Next(collection: DaemonSetCollection): Promise<DaemonSetCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: DaemonSet): Promise<DaemonSet> {
+        return this.ops.DoCreate(DaemonSetType, container);
+    }
+
+    Update(existing: DaemonSet, updates: object): Promise<DaemonSet> {
+        return this.ops.DoUpdate(DaemonSetType, existing, updates);
+    }
+
+    Replace(obj: DaemonSet): Promise<DaemonSet> {
+        return this.ops.DoReplace(DaemonSetType, obj, obj);
+    }
+
+    ByID(id: string): Promise<DaemonSet> {
+        return this.ops.DoByID(DaemonSetType, id);
+    }
+
+    Delete(container: DaemonSet): Promise<void> {
+        return this.ops.DoResourceDelete(DaemonSetType, container);
+    }
+}
+
+export const JobConfigType = "jobConfig";
+export const JobConfigFieldActiveDeadlineSeconds = "activeDeadlineSeconds";
+export const JobConfigFieldBackoffLimit = "backoffLimit";
+export const JobConfigFieldCompletions = "completions";
+export const JobConfigFieldManualSelector = "manualSelector";
+export const JobConfigFieldParallelism = "parallelism";
+
+export interface JobConfig {
+    activeDeadlineSeconds?: number;
+    backoffLimit?: number;
+    completions?: number;
+    manualSelector?: boolean;
+    parallelism?: number;
+}
+
+export const JobSpecType = "jobSpec";
+export const JobSpecFieldActiveDeadlineSeconds = "activeDeadlineSeconds";
+export const JobSpecFieldAutomountServiceAccountToken = "automountServiceAccountToken";
+export const JobSpecFieldContainers = "containers";
+export const JobSpecFieldDNSConfig = "dnsConfig";
+export const JobSpecFieldDNSPolicy = "dnsPolicy";
+export const JobSpecFieldEnableServiceLinks = "enableServiceLinks";
+export const JobSpecFieldEphemeralContainers = "ephemeralContainers";
+export const JobSpecFieldFsgid = "fsgid";
+export const JobSpecFieldGids = "gids";
+export const JobSpecFieldHostAliases = "hostAliases";
+export const JobSpecFieldHostIPC = "hostIPC";
+export const JobSpecFieldHostNetwork = "hostNetwork";
+export const JobSpecFieldHostPID = "hostPID";
+export const JobSpecFieldHostname = "hostname";
+export const JobSpecFieldImagePullSecrets = "imagePullSecrets";
+export const JobSpecFieldJobConfig = "jobConfig";
+export const JobSpecFieldNodeID = "nodeId";
+export const JobSpecFieldObjectMeta = "metadata";
+export const JobSpecFieldOverhead = "overhead";
+export const JobSpecFieldPreemptionPolicy = "preemptionPolicy";
+export const JobSpecFieldReadinessGates = "readinessGates";
+export const JobSpecFieldRestartPolicy = "restartPolicy";
+export const JobSpecFieldRunAsGroup = "runAsGroup";
+export const JobSpecFieldRunAsNonRoot = "runAsNonRoot";
+export const JobSpecFieldRuntimeClassName = "runtimeClassName";
+export const JobSpecFieldScheduling = "scheduling";
+export const JobSpecFieldSelector = "selector";
+export const JobSpecFieldServiceAccountName = "serviceAccountName";
+export const JobSpecFieldShareProcessNamespace = "shareProcessNamespace";
+export const JobSpecFieldSubdomain = "subdomain";
+export const JobSpecFieldSysctls = "sysctls";
+export const JobSpecFieldTTLSecondsAfterFinished = "ttlSecondsAfterFinished";
+export const JobSpecFieldTerminationGracePeriodSeconds = "terminationGracePeriodSeconds";
+export const JobSpecFieldTopologySpreadConstraints = "topologySpreadConstraints";
+export const JobSpecFieldUid = "uid";
+export const JobSpecFieldVolumes = "volumes";
+export const JobSpecFieldWindowsOptions = "windowsOptions";
+
+export interface JobSpec {
+    activeDeadlineSeconds?: number;
+    automountServiceAccountToken?: boolean;
+    containers?: Container[];
+    dnsConfig?: PodDNSConfig;
+    dnsPolicy?: string;
+    enableServiceLinks?: boolean;
+    ephemeralContainers?: EphemeralContainer[];
+    fsgid?: number;
+    gids?: number[];
+    hostAliases?: HostAlias[];
+    hostIPC?: boolean;
+    hostNetwork?: boolean;
+    hostPID?: boolean;
+    hostname?: string;
+    imagePullSecrets?: LocalObjectReference[];
+    jobConfig?: JobConfig;
+    nodeId?: string;
+    metadata?: ObjectMeta;
+    overhead?: { [key: string]: string };
+    preemptionPolicy?: string;
+    readinessGates?: PodReadinessGate[];
+    restartPolicy?: string;
+    runAsGroup?: number;
+    runAsNonRoot?: boolean;
+    runtimeClassName?: string;
+    scheduling?: Scheduling;
+    selector?: LabelSelector;
+    serviceAccountName?: string;
+    shareProcessNamespace?: boolean;
+    subdomain?: string;
+    sysctls?: Sysctl[];
+    ttlSecondsAfterFinished?: number;
+    terminationGracePeriodSeconds?: number;
+    topologySpreadConstraints?: TopologySpreadConstraint[];
+    uid?: number;
+    volumes?: Volume[];
+    windowsOptions?: WindowsSecurityContextOptions;
+}
+
+export const JobConditionType = "jobCondition";
+export const JobConditionFieldLastProbeTime = "lastProbeTime";
+export const JobConditionFieldLastTransitionTime = "lastTransitionTime";
+export const JobConditionFieldMessage = "message";
+export const JobConditionFieldReason = "reason";
+export const JobConditionFieldStatus = "status";
+export const JobConditionFieldType = "type";
+
+export interface JobCondition {
+    lastProbeTime?: string;
+    lastTransitionTime?: string;
+    message?: string;
+    reason?: string;
+    status?: string;
+    type?: string;
+}
+
+export const JobStatusType = "jobStatus";
+export const JobStatusFieldActive = "active";
+export const JobStatusFieldCompletionTime = "completionTime";
+export const JobStatusFieldConditions = "conditions";
+export const JobStatusFieldFailed = "failed";
+export const JobStatusFieldStartTime = "startTime";
+export const JobStatusFieldSucceeded = "succeeded";
+
+export interface JobStatus {
+    active?: number;
+    completionTime?: string;
+    conditions?: JobCondition[];
+    failed?: number;
+    startTime?: string;
+    succeeded?: number;
+}
+
+export const JobType = "job";
+export const JobFieldActiveDeadlineSeconds = "activeDeadlineSeconds";
+export const JobFieldAnnotations = "annotations";
+export const JobFieldAutomountServiceAccountToken = "automountServiceAccountToken";
+export const JobFieldContainers = "containers";
+export const JobFieldCreated = "created";
+export const JobFieldCreatorID = "creatorId";
+export const JobFieldDNSConfig = "dnsConfig";
+export const JobFieldDNSPolicy = "dnsPolicy";
+export const JobFieldEnableServiceLinks = "enableServiceLinks";
+export const JobFieldEphemeralContainers = "ephemeralContainers";
+export const JobFieldFsgid = "fsgid";
+export const JobFieldGids = "gids";
+export const JobFieldHostAliases = "hostAliases";
+export const JobFieldHostIPC = "hostIPC";
+export const JobFieldHostNetwork = "hostNetwork";
+export const JobFieldHostPID = "hostPID";
+export const JobFieldHostname = "hostname";
+export const JobFieldImagePullSecrets = "imagePullSecrets";
+export const JobFieldJobConfig = "jobConfig";
+export const JobFieldJobStatus = "jobStatus";
+export const JobFieldLabels = "labels";
+export const JobFieldName = "name";
+export const JobFieldNamespaceId = "namespaceId";
+export const JobFieldNodeID = "nodeId";
+export const JobFieldOverhead = "overhead";
+export const JobFieldOwnerReferences = "ownerReferences";
+export const JobFieldPreemptionPolicy = "preemptionPolicy";
+export const JobFieldProjectID = "projectId";
+export const JobFieldPublicEndpoints = "publicEndpoints";
+export const JobFieldReadinessGates = "readinessGates";
+export const JobFieldRemoved = "removed";
+export const JobFieldRestartPolicy = "restartPolicy";
+export const JobFieldRunAsGroup = "runAsGroup";
+export const JobFieldRunAsNonRoot = "runAsNonRoot";
+export const JobFieldRuntimeClassName = "runtimeClassName";
+export const JobFieldScheduling = "scheduling";
+export const JobFieldSelector = "selector";
+export const JobFieldServiceAccountName = "serviceAccountName";
+export const JobFieldShareProcessNamespace = "shareProcessNamespace";
+export const JobFieldState = "state";
+export const JobFieldSubdomain = "subdomain";
+export const JobFieldSysctls = "sysctls";
+export const JobFieldTTLSecondsAfterFinished = "ttlSecondsAfterFinished";
+export const JobFieldTerminationGracePeriodSeconds = "terminationGracePeriodSeconds";
+export const JobFieldTopologySpreadConstraints = "topologySpreadConstraints";
+export const JobFieldTransitioning = "transitioning";
+export const JobFieldTransitioningMessage = "transitioningMessage";
+export const JobFieldUUID = "uuid";
+export const JobFieldUid = "uid";
+export const JobFieldVolumes = "volumes";
+export const JobFieldWindowsOptions = "windowsOptions";
+export const JobFieldWorkloadAnnotations = "workloadAnnotations";
+export const JobFieldWorkloadLabels = "workloadLabels";
+export const JobFieldWorkloadMetrics = "workloadMetrics";
+
+export interface Job extends ApiResource {
+    activeDeadlineSeconds?: number;
+    annotations?: { [key: string]: string };
+    automountServiceAccountToken?: boolean;
+    containers?: Container[];
+    created?: string;
+    creatorId?: string;
+    dnsConfig?: PodDNSConfig;
+    dnsPolicy?: string;
+    enableServiceLinks?: boolean;
+    ephemeralContainers?: EphemeralContainer[];
+    fsgid?: number;
+    gids?: number[];
+    hostAliases?: HostAlias[];
+    hostIPC?: boolean;
+    hostNetwork?: boolean;
+    hostPID?: boolean;
+    hostname?: string;
+    imagePullSecrets?: LocalObjectReference[];
+    jobConfig?: JobConfig;
+    jobStatus?: JobStatus;
+    labels?: { [key: string]: string };
+    name?: string;
+    namespaceId?: string;
+    nodeId?: string;
+    overhead?: { [key: string]: string };
+    ownerReferences?: OwnerReference[];
+    preemptionPolicy?: string;
+    projectId?: string;
+    publicEndpoints?: PublicEndpoint[];
+    readinessGates?: PodReadinessGate[];
+    removed?: string;
+    restartPolicy?: string;
+    runAsGroup?: number;
+    runAsNonRoot?: boolean;
+    runtimeClassName?: string;
+    scheduling?: Scheduling;
+    selector?: LabelSelector;
+    serviceAccountName?: string;
+    shareProcessNamespace?: boolean;
+    state?: string;
+    subdomain?: string;
+    sysctls?: Sysctl[];
+    ttlSecondsAfterFinished?: number;
+    terminationGracePeriodSeconds?: number;
+    topologySpreadConstraints?: TopologySpreadConstraint[];
+    transitioning?: "yes" | "no" | "error";
+    transitioningMessage?: string;
+    uuid?: string;
+    uid?: number;
+    volumes?: Volume[];
+    windowsOptions?: WindowsSecurityContextOptions;
+    workloadAnnotations?: { [key: string]: string };
+    workloadLabels?: { [key: string]: string };
+    workloadMetrics?: WorkloadMetric[];
+}
+
+export interface JobCollection extends ApiCollection {
+    data?: Job[];
+}
+
+export class JobClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<JobCollection> {
+        return this.ops.DoList(JobType, opts);
+    }
+
+    Next(collection: JobCollection): Promise<JobCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: Job): Promise<Job> {
+        return this.ops.DoCreate(JobType, container);
+    }
+
+    Update(existing: Job, updates: object): Promise<Job> {
+        return this.ops.DoUpdate(JobType, existing, updates);
+    }
+
+    Replace(obj: Job): Promise<Job> {
+        return this.ops.DoReplace(JobType, obj, obj);
+    }
+
+    ByID(id: string): Promise<Job> {
+        return this.ops.DoByID(JobType, id);
+    }
+
+    Delete(container: Job): Promise<void> {
+        return this.ops.DoResourceDelete(JobType, container);
+    }
+}
+
+export const JobTemplateSpecType = "jobTemplateSpec";
+export const JobTemplateSpecFieldActiveDeadlineSeconds = "activeDeadlineSeconds";
+export const JobTemplateSpecFieldAutomountServiceAccountToken = "automountServiceAccountToken";
+export const JobTemplateSpecFieldContainers = "containers";
+export const JobTemplateSpecFieldDNSConfig = "dnsConfig";
+export const JobTemplateSpecFieldDNSPolicy = "dnsPolicy";
+export const JobTemplateSpecFieldEnableServiceLinks = "enableServiceLinks";
+export const JobTemplateSpecFieldEphemeralContainers = "ephemeralContainers";
+export const JobTemplateSpecFieldFsgid = "fsgid";
+export const JobTemplateSpecFieldGids = "gids";
+export const JobTemplateSpecFieldHostAliases = "hostAliases";
+export const JobTemplateSpecFieldHostIPC = "hostIPC";
+export const JobTemplateSpecFieldHostNetwork = "hostNetwork";
+export const JobTemplateSpecFieldHostPID = "hostPID";
+export const JobTemplateSpecFieldHostname = "hostname";
+export const JobTemplateSpecFieldImagePullSecrets = "imagePullSecrets";
+export const JobTemplateSpecFieldJobConfig = "jobConfig";
+export const JobTemplateSpecFieldJobMetadata = "jobMetadata";
+export const JobTemplateSpecFieldNodeID = "nodeId";
+export const JobTemplateSpecFieldObjectMeta = "metadata";
+export const JobTemplateSpecFieldOverhead = "overhead";
+export const JobTemplateSpecFieldPreemptionPolicy = "preemptionPolicy";
+export const JobTemplateSpecFieldReadinessGates = "readinessGates";
+export const JobTemplateSpecFieldRestartPolicy = "restartPolicy";
+export const JobTemplateSpecFieldRunAsGroup = "runAsGroup";
+export const JobTemplateSpecFieldRunAsNonRoot = "runAsNonRoot";
+export const JobTemplateSpecFieldRuntimeClassName = "runtimeClassName";
+export const JobTemplateSpecFieldScheduling = "scheduling";
+export const JobTemplateSpecFieldSelector = "selector";
+export const JobTemplateSpecFieldServiceAccountName = "serviceAccountName";
+export const JobTemplateSpecFieldShareProcessNamespace = "shareProcessNamespace";
+export const JobTemplateSpecFieldSubdomain = "subdomain";
+export const JobTemplateSpecFieldSysctls = "sysctls";
+export const JobTemplateSpecFieldTTLSecondsAfterFinished = "ttlSecondsAfterFinished";
+export const JobTemplateSpecFieldTerminationGracePeriodSeconds = "terminationGracePeriodSeconds";
+export const JobTemplateSpecFieldTopologySpreadConstraints = "topologySpreadConstraints";
+export const JobTemplateSpecFieldUid = "uid";
+export const JobTemplateSpecFieldVolumes = "volumes";
+export const JobTemplateSpecFieldWindowsOptions = "windowsOptions";
+
+export interface JobTemplateSpec {
+    activeDeadlineSeconds?: number;
+    automountServiceAccountToken?: boolean;
+    containers?: Container[];
+    dnsConfig?: PodDNSConfig;
+    dnsPolicy?: string;
+    enableServiceLinks?: boolean;
+    ephemeralContainers?: EphemeralContainer[];
+    fsgid?: number;
+    gids?: number[];
+    hostAliases?: HostAlias[];
+    hostIPC?: boolean;
+    hostNetwork?: boolean;
+    hostPID?: boolean;
+    hostname?: string;
+    imagePullSecrets?: LocalObjectReference[];
+    jobConfig?: JobConfig;
+    jobMetadata?: ObjectMeta;
+    nodeId?: string;
+    metadata?: ObjectMeta;
+    overhead?: { [key: string]: string };
+    preemptionPolicy?: string;
+    readinessGates?: PodReadinessGate[];
+    restartPolicy?: string;
+    runAsGroup?: number;
+    runAsNonRoot?: boolean;
+    runtimeClassName?: string;
+    scheduling?: Scheduling;
+    selector?: LabelSelector;
+    serviceAccountName?: string;
+    shareProcessNamespace?: boolean;
+    subdomain?: string;
+    sysctls?: Sysctl[];
+    ttlSecondsAfterFinished?: number;
+    terminationGracePeriodSeconds?: number;
+    topologySpreadConstraints?: TopologySpreadConstraint[];
+    uid?: number;
+    volumes?: Volume[];
+    windowsOptions?: WindowsSecurityContextOptions;
+}
+
+export const CronJobConfigType = "cronJobConfig";
+export const CronJobConfigFieldConcurrencyPolicy = "concurrencyPolicy";
+export const CronJobConfigFieldFailedJobsHistoryLimit = "failedJobsHistoryLimit";
+export const CronJobConfigFieldJobAnnotations = "jobAnnotations";
+export const CronJobConfigFieldJobConfig = "jobConfig";
+export const CronJobConfigFieldJobLabels = "jobLabels";
+export const CronJobConfigFieldSchedule = "schedule";
+export const CronJobConfigFieldStartingDeadlineSeconds = "startingDeadlineSeconds";
+export const CronJobConfigFieldSuccessfulJobsHistoryLimit = "successfulJobsHistoryLimit";
+export const CronJobConfigFieldSuspend = "suspend";
+
+export interface CronJobConfig {
+    concurrencyPolicy?: "Allow" | "Forbid" | "Replace";
+    failedJobsHistoryLimit?: number;
+    jobAnnotations?: { [key: string]: string };
+    jobConfig?: JobConfig;
+    jobLabels?: { [key: string]: string };
+    schedule?: string;
+    startingDeadlineSeconds?: number;
+    successfulJobsHistoryLimit?: number;
+    suspend?: boolean;
+}
+
+export const CronJobSpecType = "cronJobSpec";
+export const CronJobSpecFieldActiveDeadlineSeconds = "activeDeadlineSeconds";
+export const CronJobSpecFieldAutomountServiceAccountToken = "automountServiceAccountToken";
+export const CronJobSpecFieldContainers = "containers";
+export const CronJobSpecFieldCronJobConfig = "cronJobConfig";
+export const CronJobSpecFieldDNSConfig = "dnsConfig";
+export const CronJobSpecFieldDNSPolicy = "dnsPolicy";
+export const CronJobSpecFieldEnableServiceLinks = "enableServiceLinks";
+export const CronJobSpecFieldEphemeralContainers = "ephemeralContainers";
+export const CronJobSpecFieldFsgid = "fsgid";
+export const CronJobSpecFieldGids = "gids";
+export const CronJobSpecFieldHostAliases = "hostAliases";
+export const CronJobSpecFieldHostIPC = "hostIPC";
+export const CronJobSpecFieldHostNetwork = "hostNetwork";
+export const CronJobSpecFieldHostPID = "hostPID";
+export const CronJobSpecFieldHostname = "hostname";
+export const CronJobSpecFieldImagePullSecrets = "imagePullSecrets";
+export const CronJobSpecFieldNodeID = "nodeId";
+export const CronJobSpecFieldObjectMeta = "metadata";
+export const CronJobSpecFieldOverhead = "overhead";
+export const CronJobSpecFieldPreemptionPolicy = "preemptionPolicy";
+export const CronJobSpecFieldReadinessGates = "readinessGates";
+export const CronJobSpecFieldRestartPolicy = "restartPolicy";
+export const CronJobSpecFieldRunAsGroup = "runAsGroup";
+export const CronJobSpecFieldRunAsNonRoot = "runAsNonRoot";
+export const CronJobSpecFieldRuntimeClassName = "runtimeClassName";
+export const CronJobSpecFieldScheduling = "scheduling";
+export const CronJobSpecFieldSelector = "selector";
+export const CronJobSpecFieldServiceAccountName = "serviceAccountName";
+export const CronJobSpecFieldShareProcessNamespace = "shareProcessNamespace";
+export const CronJobSpecFieldSubdomain = "subdomain";
+export const CronJobSpecFieldSysctls = "sysctls";
+export const CronJobSpecFieldTTLSecondsAfterFinished = "ttlSecondsAfterFinished";
+export const CronJobSpecFieldTerminationGracePeriodSeconds = "terminationGracePeriodSeconds";
+export const CronJobSpecFieldTopologySpreadConstraints = "topologySpreadConstraints";
+export const CronJobSpecFieldUid = "uid";
+export const CronJobSpecFieldVolumes = "volumes";
+export const CronJobSpecFieldWindowsOptions = "windowsOptions";
+
+export interface CronJobSpec {
+    activeDeadlineSeconds?: number;
+    automountServiceAccountToken?: boolean;
+    containers?: Container[];
+    cronJobConfig?: CronJobConfig;
+    dnsConfig?: PodDNSConfig;
+    dnsPolicy?: string;
+    enableServiceLinks?: boolean;
+    ephemeralContainers?: EphemeralContainer[];
+    fsgid?: number;
+    gids?: number[];
+    hostAliases?: HostAlias[];
+    hostIPC?: boolean;
+    hostNetwork?: boolean;
+    hostPID?: boolean;
+    hostname?: string;
+    imagePullSecrets?: LocalObjectReference[];
+    nodeId?: string;
+    metadata?: ObjectMeta;
+    overhead?: { [key: string]: string };
+    preemptionPolicy?: string;
+    readinessGates?: PodReadinessGate[];
+    restartPolicy?: string;
+    runAsGroup?: number;
+    runAsNonRoot?: boolean;
+    runtimeClassName?: string;
+    scheduling?: Scheduling;
+    selector?: LabelSelector;
+    serviceAccountName?: string;
+    shareProcessNamespace?: boolean;
+    subdomain?: string;
+    sysctls?: Sysctl[];
+    ttlSecondsAfterFinished?: number;
+    terminationGracePeriodSeconds?: number;
+    topologySpreadConstraints?: TopologySpreadConstraint[];
+    uid?: number;
+    volumes?: Volume[];
+    windowsOptions?: WindowsSecurityContextOptions;
+}
+
+export const CronJobStatusType = "cronJobStatus";
+export const CronJobStatusFieldActive = "active";
+export const CronJobStatusFieldLastScheduleTime = "lastScheduleTime";
+
+export interface CronJobStatus {
+    active?: ObjectReference[];
+    lastScheduleTime?: string;
+}
+
+export const CronJobType = "cronJob";
+export const CronJobFieldActiveDeadlineSeconds = "activeDeadlineSeconds";
+export const CronJobFieldAnnotations = "annotations";
+export const CronJobFieldAutomountServiceAccountToken = "automountServiceAccountToken";
+export const CronJobFieldContainers = "containers";
+export const CronJobFieldCreated = "created";
+export const CronJobFieldCreatorID = "creatorId";
+export const CronJobFieldCronJobConfig = "cronJobConfig";
+export const CronJobFieldCronJobStatus = "cronJobStatus";
+export const CronJobFieldDNSConfig = "dnsConfig";
+export const CronJobFieldDNSPolicy = "dnsPolicy";
+export const CronJobFieldEnableServiceLinks = "enableServiceLinks";
+export const CronJobFieldEphemeralContainers = "ephemeralContainers";
+export const CronJobFieldFsgid = "fsgid";
+export const CronJobFieldGids = "gids";
+export const CronJobFieldHostAliases = "hostAliases";
+export const CronJobFieldHostIPC = "hostIPC";
+export const CronJobFieldHostNetwork = "hostNetwork";
+export const CronJobFieldHostPID = "hostPID";
+export const CronJobFieldHostname = "hostname";
+export const CronJobFieldImagePullSecrets = "imagePullSecrets";
+export const CronJobFieldLabels = "labels";
+export const CronJobFieldName = "name";
+export const CronJobFieldNamespaceId = "namespaceId";
+export const CronJobFieldNodeID = "nodeId";
+export const CronJobFieldOverhead = "overhead";
+export const CronJobFieldOwnerReferences = "ownerReferences";
+export const CronJobFieldPreemptionPolicy = "preemptionPolicy";
+export const CronJobFieldProjectID = "projectId";
+export const CronJobFieldPublicEndpoints = "publicEndpoints";
+export const CronJobFieldReadinessGates = "readinessGates";
+export const CronJobFieldRemoved = "removed";
+export const CronJobFieldRestartPolicy = "restartPolicy";
+export const CronJobFieldRunAsGroup = "runAsGroup";
+export const CronJobFieldRunAsNonRoot = "runAsNonRoot";
+export const CronJobFieldRuntimeClassName = "runtimeClassName";
+export const CronJobFieldScheduling = "scheduling";
+export const CronJobFieldSelector = "selector";
+export const CronJobFieldServiceAccountName = "serviceAccountName";
+export const CronJobFieldShareProcessNamespace = "shareProcessNamespace";
+export const CronJobFieldState = "state";
+export const CronJobFieldSubdomain = "subdomain";
+export const CronJobFieldSysctls = "sysctls";
+export const CronJobFieldTTLSecondsAfterFinished = "ttlSecondsAfterFinished";
+export const CronJobFieldTerminationGracePeriodSeconds = "terminationGracePeriodSeconds";
+export const CronJobFieldTopologySpreadConstraints = "topologySpreadConstraints";
+export const CronJobFieldTransitioning = "transitioning";
+export const CronJobFieldTransitioningMessage = "transitioningMessage";
+export const CronJobFieldUUID = "uuid";
+export const CronJobFieldUid = "uid";
+export const CronJobFieldVolumes = "volumes";
+export const CronJobFieldWindowsOptions = "windowsOptions";
+export const CronJobFieldWorkloadAnnotations = "workloadAnnotations";
+export const CronJobFieldWorkloadLabels = "workloadLabels";
+export const CronJobFieldWorkloadMetrics = "workloadMetrics";
+
+export interface CronJob extends ApiResource {
+    activeDeadlineSeconds?: number;
+    annotations?: { [key: string]: string };
+    automountServiceAccountToken?: boolean;
+    containers?: Container[];
+    created?: string;
+    creatorId?: string;
+    cronJobConfig?: CronJobConfig;
+    cronJobStatus?: CronJobStatus;
+    dnsConfig?: PodDNSConfig;
+    dnsPolicy?: string;
+    enableServiceLinks?: boolean;
+    ephemeralContainers?: EphemeralContainer[];
+    fsgid?: number;
+    gids?: number[];
+    hostAliases?: HostAlias[];
+    hostIPC?: boolean;
+    hostNetwork?: boolean;
+    hostPID?: boolean;
+    hostname?: string;
+    imagePullSecrets?: LocalObjectReference[];
+    labels?: { [key: string]: string };
+    name?: string;
+    namespaceId?: string;
+    nodeId?: string;
+    overhead?: { [key: string]: string };
+    ownerReferences?: OwnerReference[];
+    preemptionPolicy?: string;
+    projectId?: string;
+    publicEndpoints?: PublicEndpoint[];
+    readinessGates?: PodReadinessGate[];
+    removed?: string;
+    restartPolicy?: string;
+    runAsGroup?: number;
+    runAsNonRoot?: boolean;
+    runtimeClassName?: string;
+    scheduling?: Scheduling;
+    selector?: LabelSelector;
+    serviceAccountName?: string;
+    shareProcessNamespace?: boolean;
+    state?: string;
+    subdomain?: string;
+    sysctls?: Sysctl[];
+    ttlSecondsAfterFinished?: number;
+    terminationGracePeriodSeconds?: number;
+    topologySpreadConstraints?: TopologySpreadConstraint[];
+    transitioning?: "yes" | "no" | "error";
+    transitioningMessage?: string;
+    uuid?: string;
+    uid?: number;
+    volumes?: Volume[];
+    windowsOptions?: WindowsSecurityContextOptions;
+    workloadAnnotations?: { [key: string]: string };
+    workloadLabels?: { [key: string]: string };
+    workloadMetrics?: WorkloadMetric[];
+}
+
+export interface CronJobCollection extends ApiCollection {
+    data?: CronJob[];
+}
+
+export class CronJobClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<CronJobCollection> {
+        return this.ops.DoList(CronJobType, opts);
+    }
+
+    Next(collection: CronJobCollection): Promise<CronJobCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: CronJob): Promise<CronJob> {
+        return this.ops.DoCreate(CronJobType, container);
+    }
+
+    Update(existing: CronJob, updates: object): Promise<CronJob> {
+        return this.ops.DoUpdate(CronJobType, existing, updates);
+    }
+
+    Replace(obj: CronJob): Promise<CronJob> {
+        return this.ops.DoReplace(CronJobType, obj, obj);
+    }
+
+    ByID(id: string): Promise<CronJob> {
+        return this.ops.DoByID(CronJobType, id);
+    }
+
+    Delete(container: CronJob): Promise<void> {
+        return this.ops.DoResourceDelete(CronJobType, container);
+    }
+}
+
+export const WorkloadType = "workload";
+export const WorkloadFieldActiveDeadlineSeconds = "activeDeadlineSeconds";
+export const WorkloadFieldAnnotations = "annotations";
+export const WorkloadFieldAutomountServiceAccountToken = "automountServiceAccountToken";
+export const WorkloadFieldContainers = "containers";
+export const WorkloadFieldCreated = "created";
+export const WorkloadFieldCreatorID = "creatorId";
+export const WorkloadFieldCronJobConfig = "cronJobConfig";
+export const WorkloadFieldCronJobStatus = "cronJobStatus";
+export const WorkloadFieldDNSConfig = "dnsConfig";
+export const WorkloadFieldDNSPolicy = "dnsPolicy";
+export const WorkloadFieldDaemonSetConfig = "daemonSetConfig";
+export const WorkloadFieldDaemonSetStatus = "daemonSetStatus";
+export const WorkloadFieldDeploymentConfig = "deploymentConfig";
+export const WorkloadFieldDeploymentStatus = "deploymentStatus";
+export const WorkloadFieldEnableServiceLinks = "enableServiceLinks";
+export const WorkloadFieldEphemeralContainers = "ephemeralContainers";
+export const WorkloadFieldFsgid = "fsgid";
+export const WorkloadFieldGids = "gids";
+export const WorkloadFieldHostAliases = "hostAliases";
+export const WorkloadFieldHostIPC = "hostIPC";
+export const WorkloadFieldHostNetwork = "hostNetwork";
+export const WorkloadFieldHostPID = "hostPID";
+export const WorkloadFieldHostname = "hostname";
+export const WorkloadFieldImagePullSecrets = "imagePullSecrets";
+export const WorkloadFieldJobConfig = "jobConfig";
+export const WorkloadFieldJobStatus = "jobStatus";
+export const WorkloadFieldLabels = "labels";
+export const WorkloadFieldName = "name";
+export const WorkloadFieldNamespaceId = "namespaceId";
+export const WorkloadFieldNodeID = "nodeId";
+export const WorkloadFieldOverhead = "overhead";
+export const WorkloadFieldOwnerReferences = "ownerReferences";
+export const WorkloadFieldPaused = "paused";
+export const WorkloadFieldPreemptionPolicy = "preemptionPolicy";
+export const WorkloadFieldProjectID = "projectId";
+export const WorkloadFieldPublicEndpoints = "publicEndpoints";
+export const WorkloadFieldReadinessGates = "readinessGates";
+export const WorkloadFieldRemoved = "removed";
+export const WorkloadFieldReplicaSetConfig = "replicaSetConfig";
+export const WorkloadFieldReplicaSetStatus = "replicaSetStatus";
+export const WorkloadFieldReplicationControllerConfig = "replicationControllerConfig";
+export const WorkloadFieldReplicationControllerStatus = "replicationControllerStatus";
+export const WorkloadFieldRestartPolicy = "restartPolicy";
+export const WorkloadFieldRunAsGroup = "runAsGroup";
+export const WorkloadFieldRunAsNonRoot = "runAsNonRoot";
+export const WorkloadFieldRuntimeClassName = "runtimeClassName";
+export const WorkloadFieldScale = "scale";
+export const WorkloadFieldScheduling = "scheduling";
+export const WorkloadFieldSelector = "selector";
+export const WorkloadFieldServiceAccountName = "serviceAccountName";
+export const WorkloadFieldShareProcessNamespace = "shareProcessNamespace";
+export const WorkloadFieldState = "state";
+export const WorkloadFieldStatefulSetConfig = "statefulSetConfig";
+export const WorkloadFieldStatefulSetStatus = "statefulSetStatus";
+export const WorkloadFieldSubdomain = "subdomain";
+export const WorkloadFieldSysctls = "sysctls";
+export const WorkloadFieldTTLSecondsAfterFinished = "ttlSecondsAfterFinished";
+export const WorkloadFieldTerminationGracePeriodSeconds = "terminationGracePeriodSeconds";
+export const WorkloadFieldTopologySpreadConstraints = "topologySpreadConstraints";
+export const WorkloadFieldTransitioning = "transitioning";
+export const WorkloadFieldTransitioningMessage = "transitioningMessage";
+export const WorkloadFieldUUID = "uuid";
+export const WorkloadFieldUid = "uid";
+export const WorkloadFieldVolumes = "volumes";
+export const WorkloadFieldWindowsOptions = "windowsOptions";
+export const WorkloadFieldWorkloadAnnotations = "workloadAnnotations";
+export const WorkloadFieldWorkloadLabels = "workloadLabels";
+export const WorkloadFieldWorkloadMetrics = "workloadMetrics";
+export const WorkloadActionPause = "pause";
+export const WorkloadActionResume = "resume";
+export const WorkloadActionRollback = "rollback";
+
+export interface Workload extends ApiResource {
+    activeDeadlineSeconds?: number;
+    annotations?: { [key: string]: string };
+    automountServiceAccountToken?: boolean;
+    containers?: Container[];
+    created?: string;
+    creatorId?: string;
+    cronJobConfig?: CronJobConfig;
+    cronJobStatus?: CronJobStatus;
+    dnsConfig?: PodDNSConfig;
+    dnsPolicy?: string;
+    daemonSetConfig?: DaemonSetConfig;
+    daemonSetStatus?: DaemonSetStatus;
+    deploymentConfig?: DeploymentConfig;
+    deploymentStatus?: DeploymentStatus;
+    enableServiceLinks?: boolean;
+    ephemeralContainers?: EphemeralContainer[];
+    fsgid?: number;
+    gids?: number[];
+    hostAliases?: HostAlias[];
+    hostIPC?: boolean;
+    hostNetwork?: boolean;
+    hostPID?: boolean;
+    hostname?: string;
+    imagePullSecrets?: LocalObjectReference[];
+    jobConfig?: JobConfig;
+    jobStatus?: JobStatus;
+    labels?: { [key: string]: string };
+    name?: string;
+    namespaceId?: string;
+    nodeId?: string;
+    overhead?: { [key: string]: string };
+    ownerReferences?: OwnerReference[];
+    paused?: boolean;
+    preemptionPolicy?: string;
+    projectId?: string;
+    publicEndpoints?: PublicEndpoint[];
+    readinessGates?: PodReadinessGate[];
+    removed?: string;
+    replicaSetConfig?: ReplicaSetConfig;
+    replicaSetStatus?: ReplicaSetStatus;
+    replicationControllerConfig?: ReplicationControllerConfig;
+    replicationControllerStatus?: ReplicationControllerStatus;
+    restartPolicy?: string;
+    runAsGroup?: number;
+    runAsNonRoot?: boolean;
+    runtimeClassName?: string;
+    scale?: number;
+    scheduling?: Scheduling;
+    selector?: LabelSelector;
+    serviceAccountName?: string;
+    shareProcessNamespace?: boolean;
+    state?: string;
+    statefulSetConfig?: StatefulSetConfig;
+    statefulSetStatus?: StatefulSetStatus;
+    subdomain?: string;
+    sysctls?: Sysctl[];
+    ttlSecondsAfterFinished?: number;
+    terminationGracePeriodSeconds?: number;
+    topologySpreadConstraints?: TopologySpreadConstraint[];
+    transitioning?: "yes" | "no" | "error";
+    transitioningMessage?: string;
+    uuid?: string;
+    uid?: number;
+    volumes?: Volume[];
+    windowsOptions?: WindowsSecurityContextOptions;
+    workloadAnnotations?: { [key: string]: string };
+    workloadLabels?: { [key: string]: string };
+    workloadMetrics?: WorkloadMetric[];
+}
+
+export interface WorkloadCollection extends ApiCollection {
+    data?: Workload[];
+}
+
+export class WorkloadClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<WorkloadCollection> {
+        return this.ops.DoList(WorkloadType, opts);
+    }
+
+    Next(collection: WorkloadCollection): Promise<WorkloadCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: Workload): Promise<Workload> {
+        return this.ops.DoCreate(WorkloadType, container);
+    }
+
+    Update(existing: Workload, updates: object): Promise<Workload> {
+        return this.ops.DoUpdate(WorkloadType, existing, updates);
+    }
+
+    Replace(obj: Workload): Promise<Workload> {
+        return this.ops.DoReplace(WorkloadType, obj, obj);
+    }
+
+    ByID(id: string): Promise<Workload> {
+        return this.ops.DoByID(WorkloadType, id);
+    }
+
+    Delete(container: Workload): Promise<void> {
+        return this.ops.DoResourceDelete(WorkloadType, container);
+    }
+
+    ActionPause(resource: Workload): Promise<void> {
+        return this.ops.DoAction(WorkloadType, WorkloadActionPause, resource);
+    }
+
+    ActionResume(resource: Workload): Promise<void> {
+        return this.ops.DoAction(WorkloadType, WorkloadActionResume, resource);
+    }
+
+    ActionRollback(resource: Workload, input: RollbackRevision): Promise<void> {
+        return this.ops.DoAction(WorkloadType, WorkloadActionRollback, resource, input);
+    }
+}
+
+export const AppUpgradeConfigType = "appUpgradeConfig";
+export const AppUpgradeConfigFieldAnswers = "answers";
+export const AppUpgradeConfigFieldExternalID = "externalId";
+export const AppUpgradeConfigFieldFiles = "files";
+export const AppUpgradeConfigFieldForceUpgrade = "forceUpgrade";
+export const AppUpgradeConfigFieldValuesYaml = "valuesYaml";
+
+export interface AppUpgradeConfig {
+    answers?: { [key: string]: string };
+    externalId?: string;
+    files?: { [key: string]: string };
+    forceUpgrade?: boolean;
+    valuesYaml?: string;
+}
+
+export const RollbackRevisionType = "rollbackRevision";
+export const RollbackRevisionFieldForceUpgrade = "forceUpgrade";
+export const RollbackRevisionFieldRevisionID = "revisionId";
+
+export interface RollbackRevision {
+    forceUpgrade?: boolean;
+    revisionId?: string;
+}
+
+export const AppSpecType = "appSpec";
+export const AppSpecFieldAnswers = "answers";
+export const AppSpecFieldAppRevisionID = "appRevisionId";
+export const AppSpecFieldDescription = "description";
+export const AppSpecFieldExternalID = "externalId";
+export const AppSpecFieldFiles = "files";
+export const AppSpecFieldMultiClusterAppID = "multiClusterAppId";
+export const AppSpecFieldProjectID = "projectId";
+export const AppSpecFieldPrune = "prune";
+export const AppSpecFieldTargetNamespace = "targetNamespace";
+export const AppSpecFieldTimeout = "timeout";
+export const AppSpecFieldValuesYaml = "valuesYaml";
+export const AppSpecFieldWait = "wait";
+
+export interface AppSpec {
+    answers?: { [key: string]: string };
+    appRevisionId?: string;
+    description?: string;
+    externalId?: string;
+    files?: { [key: string]: string };
+    multiClusterAppId?: string;
+    projectId?: string;
+    prune?: boolean;
+    targetNamespace?: string;
+    timeout?: number;
+    valuesYaml?: string;
+    wait?: boolean;
+}
+
+export const AppConditionType = "appCondition";
+export const AppConditionFieldLastTransitionTime = "lastTransitionTime";
+export const AppConditionFieldLastUpdateTime = "lastUpdateTime";
+export const AppConditionFieldMessage = "message";
+export const AppConditionFieldReason = "reason";
+export const AppConditionFieldStatus = "status";
+export const AppConditionFieldType = "type";
+
+export interface AppCondition {
+    lastTransitionTime?: string;
+    lastUpdateTime?: string;
+    message?: string;
+    reason?: string;
+    status?: string;
+    type?: string;
+}
+
+export const AppStatusType = "appStatus";
+export const AppStatusFieldAppliedFiles = "appliedFiles";
+export const AppStatusFieldConditions = "conditions";
+export const AppStatusFieldLastAppliedTemplates = "lastAppliedTemplate";
+export const AppStatusFieldNotes = "notes";
+
+export interface AppStatus {
+    appliedFiles?: { [key: string]: string };
+    conditions?: AppCondition[];
+    lastAppliedTemplate?: string;
+    notes?: string;
+}
+
+export const AppType = "app";
+export const AppFieldAnnotations = "annotations";
+export const AppFieldAnswers = "answers";
+export const AppFieldAppRevisionID = "appRevisionId";
+export const AppFieldAppliedFiles = "appliedFiles";
+export const AppFieldConditions = "conditions";
+export const AppFieldCreated = "created";
+export const AppFieldCreatorID = "creatorId";
+export const AppFieldDescription = "description";
+export const AppFieldExternalID = "externalId";
+export const AppFieldFiles = "files";
+export const AppFieldLabels = "labels";
+export const AppFieldLastAppliedTemplates = "lastAppliedTemplate";
+export const AppFieldMultiClusterAppID = "multiClusterAppId";
+export const AppFieldName = "name";
+export const AppFieldNamespaceId = "namespaceId";
+export const AppFieldNotes = "notes";
+export const AppFieldOwnerReferences = "ownerReferences";
+export const AppFieldProjectID = "projectId";
+export const AppFieldPrune = "prune";
+export const AppFieldRemoved = "removed";
+export const AppFieldState = "state";
+export const AppFieldTargetNamespace = "targetNamespace";
+export const AppFieldTimeout = "timeout";
+export const AppFieldTransitioning = "transitioning";
+export const AppFieldTransitioningMessage = "transitioningMessage";
+export const AppFieldUUID = "uuid";
+export const AppFieldValuesYaml = "valuesYaml";
+export const AppFieldWait = "wait";
+export const AppActionRollback = "rollback";
+export const AppActionUpgrade = "upgrade";
+
+export interface App extends ApiResource {
+    annotations?: { [key: string]: string };
+    answers?: { [key: string]: string };
+    appRevisionId?: string;
+    appliedFiles?: { [key: string]: string };
+    conditions?: AppCondition[];
+    created?: string;
+    creatorId?: string;
+    description?: string;
+    externalId?: string;
+    files?: { [key: string]: string };
+    labels?: { [key: string]: string };
+    lastAppliedTemplate?: string;
+    multiClusterAppId?: string;
+    name?: string;
+    namespaceId?: string;
+    notes?: string;
+    ownerReferences?: OwnerReference[];
+    projectId?: string;
+    prune?: boolean;
+    removed?: string;
+    state?: string;
+    targetNamespace?: string;
+    timeout?: number;
+    transitioning?: "yes" | "no" | "error";
+    transitioningMessage?: string;
+    uuid?: string;
+    valuesYaml?: string;
+    wait?: boolean;
+}
+
+export interface AppCollection extends ApiCollection {
+    data?: App[];
+}
+
+export class AppClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<AppCollection> {
+        return this.ops.DoList(AppType, opts);
+    }
+
+    Next(collection: AppCollection): Promise<AppCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: App): Promise<App> {
+        return this.ops.DoCreate(AppType, container);
+    }
+
+    Update(existing: App, updates: object): Promise<App> {
+        return this.ops.DoUpdate(AppType, existing, updates);
+    }
+
+    Replace(obj: App): Promise<App> {
+        return this.ops.DoReplace(AppType, obj, obj);
+    }
+
+    ByID(id: string): Promise<App> {
+        return this.ops.DoByID(AppType, id);
+    }
+
+    Delete(container: App): Promise<void> {
+        return this.ops.DoResourceDelete(AppType, container);
+    }
+
+    ActionRollback(resource: App, input: RollbackRevision): Promise<void> {
+        return this.ops.DoAction(AppType, AppActionRollback, resource, input);
+    }
+
+    ActionUpgrade(resource: App, input: AppUpgradeConfig): Promise<void> {
+        return this.ops.DoAction(AppType, AppActionUpgrade, resource, input);
+    }
+}
+
+export const AppRevisionSpecType = "appRevisionSpec";
+export const AppRevisionSpecFieldProjectID = "projectId";
+
+export interface AppRevisionSpec {
+    projectId?: string;
+}
+
+export const AppRevisionStatusType = "appRevisionStatus";
+export const AppRevisionStatusFieldAnswers = "answers";
+export const AppRevisionStatusFieldDigest = "digest";
+export const AppRevisionStatusFieldExternalID = "externalId";
+export const AppRevisionStatusFieldFiles = "files";
+export const AppRevisionStatusFieldProjectID = "projectId";
+export const AppRevisionStatusFieldValuesYaml = "valuesYaml";
+
+export interface AppRevisionStatus {
+    answers?: { [key: string]: string };
+    digest?: string;
+    externalId?: string;
+    files?: { [key: string]: string };
+    projectId?: string;
+    valuesYaml?: string;
+}
+
+export const AppRevisionType = "appRevision";
+export const AppRevisionFieldAnnotations = "annotations";
+export const AppRevisionFieldCreated = "created";
+export const AppRevisionFieldCreatorID = "creatorId";
+export const AppRevisionFieldLabels = "labels";
+export const AppRevisionFieldName = "name";
+export const AppRevisionFieldNamespaceId = "namespaceId";
+export const AppRevisionFieldOwnerReferences = "ownerReferences";
+export const AppRevisionFieldProjectID = "projectId";
+export const AppRevisionFieldRemoved = "removed";
+export const AppRevisionFieldState = "state";
+export const AppRevisionFieldStatus = "status";
+export const AppRevisionFieldTransitioning = "transitioning";
+export const AppRevisionFieldTransitioningMessage = "transitioningMessage";
+export const AppRevisionFieldUUID = "uuid";
+
+export interface AppRevision extends ApiResource {
+    annotations?: { [key: string]: string };
+    created?: string;
+    creatorId?: string;
+    labels?: { [key: string]: string };
+    name?: string;
+    namespaceId?: string;
+    ownerReferences?: OwnerReference[];
+    projectId?: string;
+    removed?: string;
+    state?: string;
+    status?: AppRevisionStatus;
+    transitioning?: "yes" | "no" | "error";
+    transitioningMessage?: string;
+    uuid?: string;
+}
+
+export interface AppRevisionCollection extends ApiCollection {
+    data?: AppRevision[];
+}
+
+export class AppRevisionClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<AppRevisionCollection> {
+        return this.ops.DoList(AppRevisionType, opts);
+    }
+
+    Next(collection: AppRevisionCollection): Promise<AppRevisionCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: AppRevision): Promise<AppRevision> {
+        return this.ops.DoCreate(AppRevisionType, container);
+    }
+
+    Update(existing: AppRevision, updates: object): Promise<AppRevision> {
+        return this.ops.DoUpdate(AppRevisionType, existing, updates);
+    }
+
+    Replace(obj: AppRevision): Promise<AppRevision> {
+        return this.ops.DoReplace(AppRevisionType, obj, obj);
+    }
+
+    ByID(id: string): Promise<AppRevision> {
+        return this.ops.DoByID(AppRevisionType, id);
+    }
+
+    Delete(container: AppRevision): Promise<void> {
+        return this.ops.DoResourceDelete(AppRevisionType, container);
+    }
+}
+
+export const AuthAppInputType = "authAppInput";
+export const AuthAppInputFieldClientID = "clientId";
+export const AuthAppInputFieldClientSecret = "clientSecret";
+export const AuthAppInputFieldCode = "code";
+export const AuthAppInputFieldHost = "host";
+export const AuthAppInputFieldInheritGlobal = "inheritGlobal";
+export const AuthAppInputFieldRedirectURL = "redirectUrl";
+export const AuthAppInputFieldSourceCodeType = "sourceCodeType";
+export const AuthAppInputFieldTLS = "tls";
+
+export interface AuthAppInput {
+    clientId?: string;
+    clientSecret?: string;
+    code?: string;
+    host?: string;
+    inheritGlobal?: boolean;
+    redirectUrl?: string;
+    sourceCodeType?: string;
+    tls?: boolean;
+}
+
+export const AuthUserInputType = "authUserInput";
+export const AuthUserInputFieldCode = "code";
+export const AuthUserInputFieldRedirectURL = "redirectUrl";
+export const AuthUserInputFieldSourceCodeType = "sourceCodeType";
+
+export interface AuthUserInput {
+    code?: string;
+    redirectUrl?: string;
+    sourceCodeType?: string;
+}
+
+export const RunPipelineInputType = "runPipelineInput";
+export const RunPipelineInputFieldBranch = "branch";
+
+export interface RunPipelineInput {
+    branch?: string;
+}
+
+export const SourceCodeConfigType = "sourceCodeConfig";
+
+export interface SourceCodeConfig {
+}
+
+export const RunScriptConfigType = "runScriptConfig";
+export const RunScriptConfigFieldImage = "image";
+export const RunScriptConfigFieldShellScript = "shellScript";
+
+export interface RunScriptConfig {
+    image?: string;
+    shellScript?: string;
+}
+
+export const PublishImageConfigType = "publishImageConfig";
+export const PublishImageConfigFieldBuildContext = "buildContext";
+export const PublishImageConfigFieldDockerfilePath = "dockerfilePath";
+export const PublishImageConfigFieldPushRemote = "pushRemote";
+export const PublishImageConfigFieldRegistry = "registry";
+export const PublishImageConfigFieldTag = "tag";
+
+export interface PublishImageConfig {
+    buildContext?: string;
+    dockerfilePath?: string;
+    pushRemote?: boolean;
+    registry?: string;
+    tag?: string;
+}
+
+export const ApplyYamlConfigType = "applyYamlConfig";
+export const ApplyYamlConfigFieldContent = "content";
+export const ApplyYamlConfigFieldNamespace = "namespace";
+export const ApplyYamlConfigFieldPath = "path";
+
+export interface ApplyYamlConfig {
+    content?: string;
+    namespace?: string;
+    path?: string;
+}
+
+export const PublishCatalogConfigType = "publishCatalogConfig";
+export const PublishCatalogConfigFieldCatalogTemplate = "catalogTemplate";
+export const PublishCatalogConfigFieldGitAuthor = "gitAuthor";
+export const PublishCatalogConfigFieldGitBranch = "gitBranch";
+export const PublishCatalogConfigFieldGitEmail = "gitEmail";
+export const PublishCatalogConfigFieldGitURL = "gitUrl";
+export const PublishCatalogConfigFieldPath = "path";
+export const PublishCatalogConfigFieldVersion = "version";
+
+export interface PublishCatalogConfig {
+    catalogTemplate?: string;
+    gitAuthor?: string;
+    gitBranch?: string;
+    gitEmail?: string;
+    gitUrl?: string;
+    path?: string;
+    version?: string;
+}
+
+export const ApplyAppConfigType = "applyAppConfig";
+export const ApplyAppConfigFieldAnswers = "answers";
+export const ApplyAppConfigFieldCatalogTemplate = "catalogTemplate";
+export const ApplyAppConfigFieldName = "name";
+export const ApplyAppConfigFieldTargetNamespace = "targetNamespace";
+export const ApplyAppConfigFieldVersion = "version";
+
+export interface ApplyAppConfig {
+    answers?: { [key: string]: string };
+    catalogTemplate?: string;
+    name?: string;
+    targetNamespace?: string;
+    version?: string;
+}
+
+export const EnvFromType = "envFrom";
+export const EnvFromFieldSourceKey = "sourceKey";
+export const EnvFromFieldSourceName = "sourceName";
+export const EnvFromFieldTargetKey = "targetKey";
+
+export interface EnvFrom {
+    sourceKey?: string;
+    sourceName?: string;
+    targetKey?: string;
+}
+
+export const ConstraintType = "constraint";
+export const ConstraintFieldExclude = "exclude";
+export const ConstraintFieldInclude = "include";
+
+export interface Constraint {
+    exclude?: string[];
+    include?: string[];
+}
+
+export const ConstraintsType = "constraints";
+export const ConstraintsFieldBranch = "branch";
+export const ConstraintsFieldEvent = "event";
+
+export interface Constraints {
+    branch?: Constraint;
+    event?: Constraint;
+}
+
+export const StepType = "step";
+export const StepFieldApplyAppConfig = "applyAppConfig";
+export const StepFieldApplyYamlConfig = "applyYamlConfig";
+export const StepFieldCPULimit = "cpuLimit";
+export const StepFieldCPURequest = "cpuRequest";
+export const StepFieldEnv = "env";
+export const StepFieldEnvFrom = "envFrom";
+export const StepFieldMemoryLimit = "memoryLimit";
+export const StepFieldMemoryRequest = "memoryRequest";
+export const StepFieldPrivileged = "privileged";
+export const StepFieldPublishCatalogConfig = "publishCatalogConfig";
+export const StepFieldPublishImageConfig = "publishImageConfig";
+export const StepFieldRunScriptConfig = "runScriptConfig";
+export const StepFieldSourceCodeConfig = "sourceCodeConfig";
+export const StepFieldWhen = "when";
+
+export interface Step {
+    applyAppConfig?: ApplyAppConfig;
+    applyYamlConfig?: ApplyYamlConfig;
+    cpuLimit?: string;
+    cpuRequest?: string;
+    env?: { [key: string]: string };
+    envFrom?: EnvFrom[];
+    memoryLimit?: string;
+    memoryRequest?: string;
+    privileged?: boolean;
+    publishCatalogConfig?: PublishCatalogConfig;
+    publishImageConfig?: PublishImageConfig;
+    runScriptConfig?: RunScriptConfig;
+    sourceCodeConfig?: SourceCodeConfig;
+    when?: Constraints;
+}
+
+export const StageType = "stage";
+export const StageFieldName = "name";
+export const StageFieldSteps = "steps";
+export const StageFieldWhen = "when";
+
+export interface Stage {
+    name?: string;
+    steps?: Step[];
+    when?: Constraints;
+}
+
+export const RecipientType = "recipient";
+export const RecipientFieldNotifier = "notifier";
+export const RecipientFieldRecipient = "recipient";
+
+export interface Recipient {
+    notifier?: string;
+    recipient?: string;
+}
+
+export const PipelineNotificationType = "pipelineNotification";
+export const PipelineNotificationFieldCondition = "condition";
+export const PipelineNotificationFieldMessage = "message";
+export const PipelineNotificationFieldRecipients = "recipients";
+
+export interface PipelineNotification {
+    condition?: string[];
+    message?: string;
+    recipients?: Recipient[];
+}
+
+export const PipelineConfigType = "pipelineConfig";
+export const PipelineConfigFieldBranch = "branch";
+export const PipelineConfigFieldNotification = "notification";
+export const PipelineConfigFieldStages = "stages";
+export const PipelineConfigFieldTimeout = "timeout";
+
+export interface PipelineConfig {
+    branch?: Constraint;
+    notification?: PipelineNotification;
+    stages?: Stage[];
+    timeout?: number;
+}
+
+export const PushPipelineConfigInputType = "pushPipelineConfigInput";
+export const PushPipelineConfigInputFieldConfigs = "configs";
+
+export interface PushPipelineConfigInput {
+    configs?: { [key: string]: PipelineConfig };
+}
+
+export const GithubApplyInputType = "githubApplyInput";
+export const GithubApplyInputFieldClientID = "clientId";
+export const GithubApplyInputFieldClientSecret = "clientSecret";
+export const GithubApplyInputFieldCode = "code";
+export const GithubApplyInputFieldHostname = "hostname";
+export const GithubApplyInputFieldInheritAuth = "inheritAuth";
+export const GithubApplyInputFieldRedirectURL = "redirectUrl";
+export const GithubApplyInputFieldTLS = "tls";
+
+export interface GithubApplyInput {
+    clientId?: string;
+    clientSecret?: string;
+    code?: string;
+    hostname?: string;
+    inheritAuth?: boolean;
+    redirectUrl?: string;
+    tls?: boolean;
+}
+
+export const GitlabApplyInputType = "gitlabApplyInput";
+export const GitlabApplyInputFieldClientID = "clientId";
+export const GitlabApplyInputFieldClientSecret = "clientSecret";
+export const GitlabApplyInputFieldCode = "code";
+export const GitlabApplyInputFieldHostname = "hostname";
+export const GitlabApplyInputFieldRedirectURL = "redirectUrl";
+export const GitlabApplyInputFieldTLS = "tls";
+
+export interface GitlabApplyInput {
+    clientId?: string;
+    clientSecret?: string;
+    code?: string;
+    hostname?: string;
+    redirectUrl?: string;
+    tls?: boolean;
+}
+
+export const BitbucketCloudApplyInputType = "bitbucketCloudApplyInput";
+export const BitbucketCloudApplyInputFieldClientID = "clientId";
+export const BitbucketCloudApplyInputFieldClientSecret = "clientSecret";
+export const BitbucketCloudApplyInputFieldCode = "code";
+export const BitbucketCloudApplyInputFieldHostname = "hostname";
+export const BitbucketCloudApplyInputFieldRedirectURL = "redirectUrl";
+export const BitbucketCloudApplyInputFieldTLS = "tls";
+
+export interface BitbucketCloudApplyInput {
+    clientId?: string;
+    clientSecret?: string;
+    code?: string;
+    hostname?: string;
+    redirectUrl?: string;
+    tls?: boolean;
+}
+
+export const BitbucketServerApplyInputType = "bitbucketServerApplyInput";
+export const BitbucketServerApplyInputFieldHostname = "hostname";
+export const BitbucketServerApplyInputFieldOAuthToken = "oauthToken";
+export const BitbucketServerApplyInputFieldOAuthVerifier = "oauthVerifier";
+export const BitbucketServerApplyInputFieldRedirectURL = "redirectUrl";
+export const BitbucketServerApplyInputFieldTLS = "tls";
+
+export interface BitbucketServerApplyInput {
+    hostname?: string;
+    oauthToken?: string;
+    oauthVerifier?: string;
+    redirectUrl?: string;
+    tls?: boolean;
+}
+
+export const BitbucketServerRequestLoginInputType = "bitbucketServerRequestLoginInput";
+export const BitbucketServerRequestLoginInputFieldHostname = "hostname";
+export const BitbucketServerRequestLoginInputFieldRedirectURL = "redirectUrl";
+export const BitbucketServerRequestLoginInputFieldTLS = "tls";
+
+export interface BitbucketServerRequestLoginInput {
+    hostname?: string;
+    redirectUrl?: string;
+    tls?: boolean;
+}
+
+export const BitbucketServerRequestLoginOutputType = "bitbucketServerRequestLoginOutput";
+export const BitbucketServerRequestLoginOutputFieldLoginURL = "loginUrl";
+
+export interface BitbucketServerRequestLoginOutput {
+    loginUrl?: string;
+}
+
+export const SourceCodeProviderType = "sourceCodeProvider";
+export const SourceCodeProviderFieldAnnotations = "annotations";
+export const SourceCodeProviderFieldCreated = "created";
+export const SourceCodeProviderFieldCreatorID = "creatorId";
+export const SourceCodeProviderFieldLabels = "labels";
+export const SourceCodeProviderFieldName = "name";
+export const SourceCodeProviderFieldOwnerReferences = "ownerReferences";
+export const SourceCodeProviderFieldProjectID = "projectId";
+export const SourceCodeProviderFieldRemoved = "removed";
+export const SourceCodeProviderFieldType = "type";
+export const SourceCodeProviderFieldUUID = "uuid";
+
+export interface SourceCodeProvider extends ApiResource {
+    annotations?: { [key: string]: string };
+    created?: string;
+    creatorId?: string;
+    labels?: { [key: string]: string };
+    name?: string;
+    ownerReferences?: OwnerReference[];
+    projectId?: string;
+    removed?: string;
+    type?: "github" | "gitlab" | "bitbucketcloud" | "bitbucketserver";
+    uuid?: string;
+}
+
+export interface SourceCodeProviderCollection extends ApiCollection {
+    data?: SourceCodeProvider[];
+}
+
+export class SourceCodeProviderClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<SourceCodeProviderCollection> {
+        return this.ops.DoList(SourceCodeProviderType, opts);
+    }
+
+    Next(collection: SourceCodeProviderCollection): Promise<SourceCodeProviderCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: SourceCodeProvider): Promise<SourceCodeProvider> {
+        return this.ops.DoCreate(SourceCodeProviderType, container);
+    }
+
+    Update(existing: SourceCodeProvider, updates: object): Promise<SourceCodeProvider> {
+        return this.ops.DoUpdate(SourceCodeProviderType, existing, updates);
+    }
+
+    Replace(obj: SourceCodeProvider): Promise<SourceCodeProvider> {
+        return this.ops.DoReplace(SourceCodeProviderType, obj, obj);
+    }
+
+    ByID(id: string): Promise<SourceCodeProvider> {
+        return this.ops.DoByID(SourceCodeProviderType, id);
+    }
+
+    Delete(container: SourceCodeProvider): Promise<void> {
+        return this.ops.DoResourceDelete(SourceCodeProviderType, container);
+    }
+}
+
+export const GithubProviderType = "githubProvider";
+export const GithubProviderFieldAnnotations = "annotations";
+export const GithubProviderFieldCreated = "created";
+export const GithubProviderFieldCreatorID = "creatorId";
+export const GithubProviderFieldLabels = "labels";
+export const GithubProviderFieldName = "name";
+export const GithubProviderFieldOwnerReferences = "ownerReferences";
+export const GithubProviderFieldProjectID = "projectId";
+export const GithubProviderFieldRedirectURL = "redirectUrl";
+export const GithubProviderFieldRemoved = "removed";
+export const GithubProviderFieldType = "type";
+export const GithubProviderFieldUUID = "uuid";
+
+export interface GithubProvider {
+    annotations?: { [key: string]: string };
+    created?: string;
+    creatorId?: string;
+    labels?: { [key: string]: string };
+    name?: string;
+    ownerReferences?: OwnerReference[];
+    projectId?: string;
+    redirectUrl?: string;
+    removed?: string;
+    type?: "github" | "gitlab" | "bitbucketcloud" | "bitbucketserver";
+    uuid?: string;
+}
+
+export const GitlabProviderType = "gitlabProvider";
+export const GitlabProviderFieldAnnotations = "annotations";
+export const GitlabProviderFieldCreated = "created";
+export const GitlabProviderFieldCreatorID = "creatorId";
+export const GitlabProviderFieldLabels = "labels";
+export const GitlabProviderFieldName = "name";
+export const GitlabProviderFieldOwnerReferences = "ownerReferences";
+export const GitlabProviderFieldProjectID = "projectId";
+export const GitlabProviderFieldRedirectURL = "redirectUrl";
+export const GitlabProviderFieldRemoved = "removed";
+export const GitlabProviderFieldType = "type";
+export const GitlabProviderFieldUUID = "uuid";
+
+export interface GitlabProvider {
+    annotations?: { [key: string]: string };
+    created?: string;
+    creatorId?: string;
+    labels?: { [key: string]: string };
+    name?: string;
+    ownerReferences?: OwnerReference[];
+    projectId?: string;
+    redirectUrl?: string;
+    removed?: string;
+    type?: "github" | "gitlab" | "bitbucketcloud" | "bitbucketserver";
+    uuid?: string;
+}
+
+export const BitbucketCloudProviderType = "bitbucketCloudProvider";
+export const BitbucketCloudProviderFieldAnnotations = "annotations";
+export const BitbucketCloudProviderFieldCreated = "created";
+export const BitbucketCloudProviderFieldCreatorID = "creatorId";
+export const BitbucketCloudProviderFieldLabels = "labels";
+export const BitbucketCloudProviderFieldName = "name";
+export const BitbucketCloudProviderFieldOwnerReferences = "ownerReferences";
+export const BitbucketCloudProviderFieldProjectID = "projectId";
+export const BitbucketCloudProviderFieldRedirectURL = "redirectUrl";
+export const BitbucketCloudProviderFieldRemoved = "removed";
+export const BitbucketCloudProviderFieldType = "type";
+export const BitbucketCloudProviderFieldUUID = "uuid";
+
+export interface BitbucketCloudProvider {
+    annotations?: { [key: string]: string };
+    created?: string;
+    creatorId?: string;
+    labels?: { [key: string]: string };
+    name?: string;
+    ownerReferences?: OwnerReference[];
+    projectId?: string;
+    redirectUrl?: string;
+    removed?: string;
+    type?: "github" | "gitlab" | "bitbucketcloud" | "bitbucketserver";
+    uuid?: string;
+}
+
+export const BitbucketServerProviderType = "bitbucketServerProvider";
+export const BitbucketServerProviderFieldAnnotations = "annotations";
+export const BitbucketServerProviderFieldCreated = "created";
+export const BitbucketServerProviderFieldCreatorID = "creatorId";
+export const BitbucketServerProviderFieldLabels = "labels";
+export const BitbucketServerProviderFieldName = "name";
+export const BitbucketServerProviderFieldOwnerReferences = "ownerReferences";
+export const BitbucketServerProviderFieldProjectID = "projectId";
+export const BitbucketServerProviderFieldRedirectURL = "redirectUrl";
+export const BitbucketServerProviderFieldRemoved = "removed";
+export const BitbucketServerProviderFieldType = "type";
+export const BitbucketServerProviderFieldUUID = "uuid";
+
+export interface BitbucketServerProvider {
+    annotations?: { [key: string]: string };
+    created?: string;
+    creatorId?: string;
+    labels?: { [key: string]: string };
+    name?: string;
+    ownerReferences?: OwnerReference[];
+    projectId?: string;
+    redirectUrl?: string;
+    removed?: string;
+    type?: "github" | "gitlab" | "bitbucketcloud" | "bitbucketserver";
+    uuid?: string;
+}
+
+export const SourceCodeProviderConfigType = "sourceCodeProviderConfig";
+export const SourceCodeProviderConfigFieldAnnotations = "annotations";
+export const SourceCodeProviderConfigFieldCreated = "created";
+export const SourceCodeProviderConfigFieldCreatorID = "creatorId";
+export const SourceCodeProviderConfigFieldEnabled = "enabled";
+export const SourceCodeProviderConfigFieldLabels = "labels";
+export const SourceCodeProviderConfigFieldName = "name";
+export const SourceCodeProviderConfigFieldNamespaceId = "namespaceId";
+export const SourceCodeProviderConfigFieldOwnerReferences = "ownerReferences";
+export const SourceCodeProviderConfigFieldProjectID = "projectId";
+export const SourceCodeProviderConfigFieldRemoved = "removed";
+export const SourceCodeProviderConfigFieldType = "type";
+export const SourceCodeProviderConfigFieldUUID = "uuid";
+
+export interface SourceCodeProviderConfig extends ApiResource {
+    annotations?: { [key: string]: string };
+    created?: string;
+    creatorId?: string;
+    enabled?: boolean;
+    labels?: { [key: string]: string };
+    name?: string;
+    namespaceId?: string;
+    ownerReferences?: OwnerReference[];
+    projectId?: string;
+    removed?: string;
+    type?: "github" | "gitlab" | "bitbucketcloud" | "bitbucketserver";
+    uuid?: string;
+}
+
+export interface SourceCodeProviderConfigCollection extends ApiCollection {
+    data?: SourceCodeProviderConfig[];
+}
+
+export class SourceCodeProviderConfigClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<SourceCodeProviderConfigCollection> {
+        return this.ops.DoList(SourceCodeProviderConfigType, opts);
+    }
+
+    Next(collection: SourceCodeProviderConfigCollection): Promise<SourceCodeProviderConfigCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: SourceCodeProviderConfig): Promise<SourceCodeProviderConfig> {
+        return this.ops.DoCreate(SourceCodeProviderConfigType, container);
+    }
+
+    Update(existing: SourceCodeProviderConfig, updates: object): Promise<SourceCodeProviderConfig> {
+        return this.ops.DoUpdate(SourceCodeProviderConfigType, existing, updates);
+    }
+
+    Replace(obj: SourceCodeProviderConfig): Promise<SourceCodeProviderConfig> {
+        return this.ops.DoReplace(SourceCodeProviderConfigType, obj, obj);
+    }
+
+    ByID(id: string): Promise<SourceCodeProviderConfig> {
+        return this.ops.DoByID(SourceCodeProviderConfigType, id);
+    }
+
+    Delete(container: SourceCodeProviderConfig): Promise<void> {
+        return this.ops.DoResourceDelete(SourceCodeProviderConfigType, container);
+    }
+}
+
+export const GithubPipelineConfigType = "githubPipelineConfig";
+export const GithubPipelineConfigFieldAnnotations = "annotations";
+export const GithubPipelineConfigFieldClientID = "clientId";
+export const GithubPipelineConfigFieldClientSecret = "clientSecret";
+export const GithubPipelineConfigFieldCreated = "created";
+export const GithubPipelineConfigFieldCreatorID = "creatorId";
+export const GithubPipelineConfigFieldEnabled = "enabled";
+export const GithubPipelineConfigFieldHostname = "hostname";
+export const GithubPipelineConfigFieldInherit = "inherit";
+export const GithubPipelineConfigFieldLabels = "labels";
+export const GithubPipelineConfigFieldName = "name";
+export const GithubPipelineConfigFieldNamespaceId = "namespaceId";
+export const GithubPipelineConfigFieldOwnerReferences = "ownerReferences";
+export const GithubPipelineConfigFieldProjectID = "projectId";
+export const GithubPipelineConfigFieldRemoved = "removed";
+export const GithubPipelineConfigFieldTLS = "tls";
+export const GithubPipelineConfigFieldType = "type";
+export const GithubPipelineConfigFieldUUID = "uuid";
+
+export interface GithubPipelineConfig {
+    annotations?: { [key: string]: string };
+    clientId?: string;
+    clientSecret?: string;
+    created?: string;
+    creatorId?: string;
+    enabled?: boolean;
+    hostname?: string;
+    inherit?: boolean;
+    labels?: { [key: string]: string };
+    name?: string;
+    namespaceId?: string;
+    ownerReferences?: OwnerReference[];
+    projectId?: string;
+    removed?: string;
+    tls?: boolean;
+    type?: "github" | "gitlab" | "bitbucketcloud" | "bitbucketserver";
+    uuid?: string;
+}
+
+export const GitlabPipelineConfigType = "gitlabPipelineConfig";
+export const GitlabPipelineConfigFieldAnnotations = "annotations";
+export const GitlabPipelineConfigFieldClientID = "clientId";
+export const GitlabPipelineConfigFieldClientSecret = "clientSecret";
+export const GitlabPipelineConfigFieldCreated = "created";
+export const GitlabPipelineConfigFieldCreatorID = "creatorId";
+export const GitlabPipelineConfigFieldEnabled = "enabled";
+export const GitlabPipelineConfigFieldHostname = "hostname";
+export const GitlabPipelineConfigFieldLabels = "labels";
+export const GitlabPipelineConfigFieldName = "name";
+export const GitlabPipelineConfigFieldNamespaceId = "namespaceId";
+export const GitlabPipelineConfigFieldOwnerReferences = "ownerReferences";
+export const GitlabPipelineConfigFieldProjectID = "projectId";
+export const GitlabPipelineConfigFieldRedirectURL = "redirectUrl";
+export const GitlabPipelineConfigFieldRemoved = "removed";
+export const GitlabPipelineConfigFieldTLS = "tls";
+export const GitlabPipelineConfigFieldType = "type";
+export const GitlabPipelineConfigFieldUUID = "uuid";
+
+export interface GitlabPipelineConfig {
+    annotations?: { [key: string]: string };
+    clientId?: string;
+    clientSecret?: string;
+    created?: string;
+    creatorId?: string;
+    enabled?: boolean;
+    hostname?: string;
+    labels?: { [key: string]: string };
+    name?: string;
+    namespaceId?: string;
+    ownerReferences?: OwnerReference[];
+    projectId?: string;
+    redirectUrl?: string;
+    removed?: string;
+    tls?: boolean;
+    type?: "github" | "gitlab" | "bitbucketcloud" | "bitbucketserver";
+    uuid?: string;
+}
+
+export const BitbucketCloudPipelineConfigType = "bitbucketCloudPipelineConfig";
+export const BitbucketCloudPipelineConfigFieldAnnotations = "annotations";
+export const BitbucketCloudPipelineConfigFieldClientID = "clientId";
+export const BitbucketCloudPipelineConfigFieldClientSecret = "clientSecret";
+export const BitbucketCloudPipelineConfigFieldCreated = "created";
+export const BitbucketCloudPipelineConfigFieldCreatorID = "creatorId";
+export const BitbucketCloudPipelineConfigFieldEnabled = "enabled";
+export const BitbucketCloudPipelineConfigFieldLabels = "labels";
+export const BitbucketCloudPipelineConfigFieldName = "name";
+export const BitbucketCloudPipelineConfigFieldNamespaceId = "namespaceId";
+export const BitbucketCloudPipelineConfigFieldOwnerReferences = "ownerReferences";
+export const BitbucketCloudPipelineConfigFieldProjectID = "projectId";
+export const BitbucketCloudPipelineConfigFieldRedirectURL = "redirectUrl";
+export const BitbucketCloudPipelineConfigFieldRemoved = "removed";
+export const BitbucketCloudPipelineConfigFieldType = "type";
+export const BitbucketCloudPipelineConfigFieldUUID = "uuid";
+
+export interface BitbucketCloudPipelineConfig {
+    annotations?: { [key: string]: string };
+    clientId?: string;
+    clientSecret?: string;
+    created?: string;
+    creatorId?: string;
+    enabled?: boolean;
+    labels?: { [key: string]: string };
+    name?: string;
+    namespaceId?: string;
+    ownerReferences?: OwnerReference[];
+    projectId?: string;
+    redirectUrl?: string;
+    removed?: string;
+    type?: "github" | "gitlab" | "bitbucketcloud" | "bitbucketserver";
+    uuid?: string;
+}
+
+export const BitbucketServerPipelineConfigType = "bitbucketServerPipelineConfig";
+export const BitbucketServerPipelineConfigFieldAnnotations = "annotations";
+export const BitbucketServerPipelineConfigFieldConsumerKey = "consumerKey";
+export const BitbucketServerPipelineConfigFieldCreated = "created";
+export const BitbucketServerPipelineConfigFieldCreatorID = "creatorId";
+export const BitbucketServerPipelineConfigFieldEnabled = "enabled";
+export const BitbucketServerPipelineConfigFieldHostname = "hostname";
+export const BitbucketServerPipelineConfigFieldLabels = "labels";
+export const BitbucketServerPipelineConfigFieldName = "name";
+export const BitbucketServerPipelineConfigFieldNamespaceId = "namespaceId";
+export const BitbucketServerPipelineConfigFieldOwnerReferences = "ownerReferences";
+export const BitbucketServerPipelineConfigFieldPrivateKey = "privateKey";
+export const BitbucketServerPipelineConfigFieldProjectID = "projectId";
+export const BitbucketServerPipelineConfigFieldPublicKey = "publicKey";
+export const BitbucketServerPipelineConfigFieldRedirectURL = "redirectUrl";
+export const BitbucketServerPipelineConfigFieldRemoved = "removed";
+export const BitbucketServerPipelineConfigFieldTLS = "tls";
+export const BitbucketServerPipelineConfigFieldType = "type";
+export const BitbucketServerPipelineConfigFieldUUID = "uuid";
+
+export interface BitbucketServerPipelineConfig {
+    annotations?: { [key: string]: string };
+    consumerKey?: string;
+    created?: string;
+    creatorId?: string;
+    enabled?: boolean;
+    hostname?: string;
+    labels?: { [key: string]: string };
+    name?: string;
+    namespaceId?: string;
+    ownerReferences?: OwnerReference[];
+    privateKey?: string;
+    projectId?: string;
+    publicKey?: string;
+    redirectUrl?: string;
+    removed?: string;
+    tls?: boolean;
+    type?: "github" | "gitlab" | "bitbucketcloud" | "bitbucketserver";
+    uuid?: string;
+}
+
+export const PipelineSpecType = "pipelineSpec";
+export const PipelineSpecFieldDisplayName = "displayName";
+export const PipelineSpecFieldProjectID = "projectId";
+export const PipelineSpecFieldRepositoryURL = "repositoryUrl";
+export const PipelineSpecFieldSourceCodeCredentialID = "sourceCodeCredentialId";
+export const PipelineSpecFieldTriggerWebhookPr = "triggerWebhookPr";
+export const PipelineSpecFieldTriggerWebhookPush = "triggerWebhookPush";
+export const PipelineSpecFieldTriggerWebhookTag = "triggerWebhookTag";
+
+export interface PipelineSpec {
+    displayName?: string;
+    projectId?: string;
+    repositoryUrl?: string;
+    sourceCodeCredentialId?: string;
+    triggerWebhookPr?: boolean;
+    triggerWebhookPush?: boolean;
+    triggerWebhookTag?: boolean;
+}
+
+export const SourceCodeCredentialSpecType = "sourceCodeCredentialSpec";
+export const SourceCodeCredentialSpecFieldAccessToken = "accessToken";
+export const SourceCodeCredentialSpecFieldAvatarURL = "avatarUrl";
+export const SourceCodeCredentialSpecFieldDisplayName = "displayName";
+export const SourceCodeCredentialSpecFieldExpiry = "expiry";
+export const SourceCodeCredentialSpecFieldGitCloneToken = "gitCloneToken";
+export const SourceCodeCredentialSpecFieldGitLoginName = "gitLoginName";
+export const SourceCodeCredentialSpecFieldHTMLURL = "htmlUrl";
+export const SourceCodeCredentialSpecFieldLoginName = "loginName";
+export const SourceCodeCredentialSpecFieldProjectID = "projectId";
+export const SourceCodeCredentialSpecFieldRefreshToken = "refreshToken";
+export const SourceCodeCredentialSpecFieldSourceCodeType = "sourceCodeType";
+export const SourceCodeCredentialSpecFieldUserID = "userId";
+
+export interface SourceCodeCredentialSpec {
+    accessToken?: string;
+    avatarUrl?: string;
+    displayName?: string;
+    expiry?: string;
+    gitCloneToken?: string;
+    gitLoginName?: string;
+    htmlUrl?: string;
+    loginName?: string;
+    projectId?: string;
+    refreshToken?: string;
+    sourceCodeType?: "github" | "gitlab" | "bitbucketcloud" | "bitbucketserver";
+    userId?: string;
+}
+
+export const SourceCodeCredentialStatusType = "sourceCodeCredentialStatus";
+export const SourceCodeCredentialStatusFieldLogout = "logout";
+
+export interface SourceCodeCredentialStatus {
+    logout?: boolean;
+}
+
+export const SourceCodeCredentialType = "sourceCodeCredential";
+export const SourceCodeCredentialFieldAccessToken = "accessToken";
+export const SourceCodeCredentialFieldAnnotations = "annotations";
+export const SourceCodeCredentialFieldAvatarURL = "avatarUrl";
+export const SourceCodeCredentialFieldCreated = "created";
+export const SourceCodeCredentialFieldCreatorID = "creatorId";
+export const SourceCodeCredentialFieldDisplayName = "displayName";
+export const SourceCodeCredentialFieldExpiry = "expiry";
+export const SourceCodeCredentialFieldGitCloneToken = "gitCloneToken";
+export const SourceCodeCredentialFieldGitLoginName = "gitLoginName";
+export const SourceCodeCredentialFieldHTMLURL = "htmlUrl";
+export const SourceCodeCredentialFieldLabels = "labels";
+export const SourceCodeCredentialFieldLoginName = "loginName";
+export const SourceCodeCredentialFieldLogout = "logout";
+export const SourceCodeCredentialFieldName = "name";
+export const SourceCodeCredentialFieldOwnerReferences = "ownerReferences";
+export const SourceCodeCredentialFieldProjectID = "projectId";
+export const SourceCodeCredentialFieldRefreshToken = "refreshToken";
+export const SourceCodeCredentialFieldRemoved = "removed";
+export const SourceCodeCredentialFieldSourceCodeType = "sourceCodeType";
+export const SourceCodeCredentialFieldState = "state";
+export const SourceCodeCredentialFieldTransitioning = "transitioning";
+export const SourceCodeCredentialFieldTransitioningMessage = "transitioningMessage";
+export const SourceCodeCredentialFieldUUID = "uuid";
+export const SourceCodeCredentialFieldUserID = "userId";
+export const SourceCodeCredentialActionLogout = "logout";
+export const SourceCodeCredentialActionRefreshrepos = "refreshrepos";
+
+export interface SourceCodeCredential extends ApiResource {
+    accessToken?: string;
+    annotations?: { [key: string]: string };
+    avatarUrl?: string;
+    created?: string;
+    creatorId?: string;
+    displayName?: string;
+    expiry?: string;
+    gitCloneToken?: string;
+    gitLoginName?: string;
+    htmlUrl?: string;
+    labels?: { [key: string]: string };
+    loginName?: string;
+    logout?: boolean;
+    name?: string;
+    ownerReferences?: OwnerReference[];
+    projectId?: string;
+    refreshToken?: string;
+    removed?: string;
+    sourceCodeType?: "github" | "gitlab" | "bitbucketcloud" | "bitbucketserver";
+    state?: string;
+    transitioning?: "yes" | "no" | "error";
+    transitioningMessage?: string;
+    uuid?: string;
+    userId?: string;
+}
+
+export interface SourceCodeCredentialCollection extends ApiCollection {
+    data?: SourceCodeCredential[];
+}
+
+export class SourceCodeCredentialClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<SourceCodeCredentialCollection> {
+        return this.ops.DoList(SourceCodeCredentialType, opts);
+    }
+
+    Next(collection: SourceCodeCredentialCollection): Promise<SourceCodeCredentialCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: SourceCodeCredential): Promise<SourceCodeCredential> {
+        return this.ops.DoCreate(SourceCodeCredentialType, container);
+    }
+
+    Update(existing: SourceCodeCredential, updates: object): Promise<SourceCodeCredential> {
+        return this.ops.DoUpdate(SourceCodeCredentialType, existing, updates);
+    }
+
+    Replace(obj: SourceCodeCredential): Promise<SourceCodeCredential> {
+        return this.ops.DoReplace(SourceCodeCredentialType, obj, obj);
+    }
+
+    ByID(id: string): Promise<SourceCodeCredential> {
+        return this.ops.DoByID(SourceCodeCredentialType, id);
+    }
+
+    Delete(container: SourceCodeCredential): Promise<void> {
+        return this.ops.DoResourceDelete(SourceCodeCredentialType, container);
+    }
+
+    ActionLogout(resource: SourceCodeCredential): Promise<void> {
+        return this.ops.DoAction(SourceCodeCredentialType, SourceCodeCredentialActionLogout, resource);
+    }
+
+    ActionRefreshrepos(resource: SourceCodeCredential): Promise<void> {
+        return this.ops.DoAction(SourceCodeCredentialType, SourceCodeCredentialActionRefreshrepos, resource);
+    }
+}
+
+export const PipelineStatusType = "pipelineStatus";
+export const PipelineStatusFieldLastExecutionID = "lastExecutionId";
+export const PipelineStatusFieldLastRunState = "lastRunState";
+export const PipelineStatusFieldLastStarted = "lastStarted";
+export const PipelineStatusFieldNextRun = "nextRun";
+export const PipelineStatusFieldNextStart = "nextStart";
+export const PipelineStatusFieldPipelineState = "pipelineState";
+export const PipelineStatusFieldSourceCodeCredential = "sourceCodeCredential";
+export const PipelineStatusFieldToken = "token";
+export const PipelineStatusFieldWebHookID = "webhookId";
+
+export interface PipelineStatus {
+    lastExecutionId?: string;
+    lastRunState?: string;
+    lastStarted?: string;
+    nextRun?: number;
+    nextStart?: string;
+    pipelineState?: "active" | "inactive";
+    sourceCodeCredential?: SourceCodeCredential;
+    token?: string;
+    webhookId?: string;
+}
+
+export const PipelineType = "pipeline";
+export const PipelineFieldAnnotations = "annotations";
+export const PipelineFieldCreated = "created";
+export const PipelineFieldCreatorID = "creatorId";
+export const PipelineFieldLabels = "labels";
+export const PipelineFieldLastExecutionID = "lastExecutionId";
+export const PipelineFieldLastRunState = "lastRunState";
+export const PipelineFieldLastStarted = "lastStarted";
+export const PipelineFieldName = "name";
+export const PipelineFieldNamespaceId = "namespaceId";
+export const PipelineFieldNextRun = "nextRun";
+export const PipelineFieldNextStart = "nextStart";
+export const PipelineFieldOwnerReferences = "ownerReferences";
+export const PipelineFieldPipelineState = "pipelineState";
+export const PipelineFieldProjectID = "projectId";
+export const PipelineFieldRemoved = "removed";
+export const PipelineFieldRepositoryURL = "repositoryUrl";
+export const PipelineFieldSourceCodeCredential = "sourceCodeCredential";
+export const PipelineFieldSourceCodeCredentialID = "sourceCodeCredentialId";
+export const PipelineFieldState = "state";
+export const PipelineFieldToken = "token";
+export const PipelineFieldTransitioning = "transitioning";
+export const PipelineFieldTransitioningMessage = "transitioningMessage";
+export const PipelineFieldTriggerWebhookPr = "triggerWebhookPr";
+export const PipelineFieldTriggerWebhookPush = "triggerWebhookPush";
+export const PipelineFieldTriggerWebhookTag = "triggerWebhookTag";
+export const PipelineFieldUUID = "uuid";
+export const PipelineFieldWebHookID = "webhookId";
+export const PipelineActionActivate = "activate";
+export const PipelineActionDeactivate = "deactivate";
+export const PipelineActionPushconfig = "pushconfig";
+export const PipelineActionRun = "run";
+
+export interface Pipeline extends ApiResource {
+    annotations?: { [key: string]: string };
+    created?: string;
+    creatorId?: string;
+    labels?: { [key: string]: string };
+    lastExecutionId?: string;
+    lastRunState?: string;
+    lastStarted?: string;
+    name?: string;
+    namespaceId?: string;
+    nextRun?: number;
+    nextStart?: string;
+    ownerReferences?: OwnerReference[];
+    pipelineState?: "active" | "inactive";
+    projectId?: string;
+    removed?: string;
+    repositoryUrl?: string;
+    sourceCodeCredential?: SourceCodeCredential;
+    sourceCodeCredentialId?: string;
+    state?: string;
+    token?: string;
+    transitioning?: "yes" | "no" | "error";
+    transitioningMessage?: string;
+    triggerWebhookPr?: boolean;
+    triggerWebhookPush?: boolean;
+    triggerWebhookTag?: boolean;
+    uuid?: string;
+    webhookId?: string;
+}
+
+export interface PipelineCollection extends ApiCollection {
+    data?: Pipeline[];
+}
+
+export class PipelineClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<PipelineCollection> {
+        return this.ops.DoList(PipelineType, opts);
+    }
+
+    Next(collection: PipelineCollection): Promise<PipelineCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: Pipeline): Promise<Pipeline> {
+        return this.ops.DoCreate(PipelineType, container);
+    }
+
+    Update(existing: Pipeline, updates: object): Promise<Pipeline> {
+        return this.ops.DoUpdate(PipelineType, existing, updates);
+    }
+
+    Replace(obj: Pipeline): Promise<Pipeline> {
+        return this.ops.DoReplace(PipelineType, obj, obj);
+    }
+
+    ByID(id: string): Promise<Pipeline> {
+        return this.ops.DoByID(PipelineType, id);
+    }
+
+    Delete(container: Pipeline): Promise<void> {
+        return this.ops.DoResourceDelete(PipelineType, container);
+    }
+
+    ActionActivate(resource: Pipeline): Promise<void> {
+        return this.ops.DoAction(PipelineType, PipelineActionActivate, resource);
+    }
+
+    ActionDeactivate(resource: Pipeline): Promise<void> {
+        return this.ops.DoAction(PipelineType, PipelineActionDeactivate, resource);
+    }
+
+    ActionPushconfig(resource: Pipeline, input: PushPipelineConfigInput): Promise<void> {
+        return this.ops.DoAction(PipelineType, PipelineActionPushconfig, resource, input);
+    }
+
+    ActionRun(resource: Pipeline, input: RunPipelineInput): Promise<void> {
+        return this.ops.DoAction(PipelineType, PipelineActionRun, resource, input);
+    }
+}
+
+export const PipelineExecutionSpecType = "pipelineExecutionSpec";
+export const PipelineExecutionSpecFieldAuthor = "author";
+export const PipelineExecutionSpecFieldAvatarURL = "avatarUrl";
+export const PipelineExecutionSpecFieldBranch = "branch";
+export const PipelineExecutionSpecFieldCommit = "commit";
+export const PipelineExecutionSpecFieldEmail = "email";
+export const PipelineExecutionSpecFieldEvent = "event";
+export const PipelineExecutionSpecFieldHTMLLink = "htmlLink";
+export const PipelineExecutionSpecFieldMessage = "message";
+export const PipelineExecutionSpecFieldPipelineConfig = "pipelineConfig";
+export const PipelineExecutionSpecFieldPipelineID = "pipelineId";
+export const PipelineExecutionSpecFieldProjectID = "projectId";
+export const PipelineExecutionSpecFieldRef = "ref";
+export const PipelineExecutionSpecFieldRepositoryURL = "repositoryUrl";
+export const PipelineExecutionSpecFieldRun = "run";
+export const PipelineExecutionSpecFieldTitle = "title";
+export const PipelineExecutionSpecFieldTriggerUserID = "triggerUserId";
+export const PipelineExecutionSpecFieldTriggeredBy = "triggeredBy";
+
+export interface PipelineExecutionSpec {
+    author?: string;
+    avatarUrl?: string;
+    branch?: string;
+    commit?: string;
+    email?: string;
+    event?: string;
+    htmlLink?: string;
+    message?: string;
+    pipelineConfig?: PipelineConfig;
+    pipelineId?: string;
+    projectId?: string;
+    ref?: string;
+    repositoryUrl?: string;
+    run?: number;
+    title?: string;
+    triggerUserId?: string;
+    triggeredBy?: "user" | "cron" | "webhook";
+}
+
+export const PipelineConditionType = "pipelineCondition";
+export const PipelineConditionFieldLastTransitionTime = "lastTransitionTime";
+export const PipelineConditionFieldLastUpdateTime = "lastUpdateTime";
+export const PipelineConditionFieldMessage = "message";
+export const PipelineConditionFieldReason = "reason";
+export const PipelineConditionFieldStatus = "status";
+export const PipelineConditionFieldType = "type";
+
+export interface PipelineCondition {
+    lastTransitionTime?: string;
+    lastUpdateTime?: string;
+    message?: string;
+    reason?: string;
+    status?: string;
+    type?: string;
+}
+
+export const StepStatusType = "stepStatus";
+export const StepStatusFieldEnded = "ended";
+export const StepStatusFieldStarted = "started";
+export const StepStatusFieldState = "state";
+
+export interface StepStatus {
+    ended?: string;
+    started?: string;
+    state?: string;
+}
+
+export const StageStatusType = "stageStatus";
+export const StageStatusFieldEnded = "ended";
+export const StageStatusFieldStarted = "started";
+export const StageStatusFieldState = "state";
+export const StageStatusFieldSteps = "steps";
+
+export interface StageStatus {
+    ended?: string;
+    started?: string;
+    state?: string;
+    steps?: StepStatus[];
+}
+
+export const PipelineExecutionStatusType = "pipelineExecutionStatus";
+export const PipelineExecutionStatusFieldConditions = "conditions";
+export const PipelineExecutionStatusFieldEnded = "ended";
+export const PipelineExecutionStatusFieldExecutionState = "executionState";
+export const PipelineExecutionStatusFieldStages = "stages";
+export const PipelineExecutionStatusFieldStarted = "started";
+
+export interface PipelineExecutionStatus {
+    conditions?: PipelineCondition[];
+    ended?: string;
+    executionState?: string;
+    stages?: StageStatus[];
+    started?: string;
+}
+
+export const PipelineExecutionType = "pipelineExecution";
+export const PipelineExecutionFieldAnnotations = "annotations";
+export const PipelineExecutionFieldAuthor = "author";
+export const PipelineExecutionFieldAvatarURL = "avatarUrl";
+export const PipelineExecutionFieldBranch = "branch";
+export const PipelineExecutionFieldCommit = "commit";
+export const PipelineExecutionFieldConditions = "conditions";
+export const PipelineExecutionFieldCreated = "created";
+export const PipelineExecutionFieldCreatorID = "creatorId";
+export const PipelineExecutionFieldEmail = "email";
+export const PipelineExecutionFieldEnded = "ended";
+export const PipelineExecutionFieldEvent = "event";
+export const PipelineExecutionFieldExecutionState = "executionState";
+export const PipelineExecutionFieldHTMLLink = "htmlLink";
+export const PipelineExecutionFieldLabels = "labels";
+export const PipelineExecutionFieldMessage = "message";
+export const PipelineExecutionFieldName = "name";
+export const PipelineExecutionFieldNamespaceId = "namespaceId";
+export const PipelineExecutionFieldOwnerReferences = "ownerReferences";
+export const PipelineExecutionFieldPipelineConfig = "pipelineConfig";
+export const PipelineExecutionFieldPipelineID = "pipelineId";
+export const PipelineExecutionFieldProjectID = "projectId";
+export const PipelineExecutionFieldRef = "ref";
+export const PipelineExecutionFieldRemoved = "removed";
+export const PipelineExecutionFieldRepositoryURL = "repositoryUrl";
+export const PipelineExecutionFieldRun = "run";
+export const PipelineExecutionFieldStages = "stages";
+export const PipelineExecutionFieldStarted = "started";
+export const PipelineExecutionFieldState = "state";
+export const PipelineExecutionFieldTitle = "title";
+export const PipelineExecutionFieldTransitioning = "transitioning";
+export const PipelineExecutionFieldTransitioningMessage = "transitioningMessage";
+export const PipelineExecutionFieldTriggerUserID = "triggerUserId";
+export const PipelineExecutionFieldTriggeredBy = "triggeredBy";
+export const PipelineExecutionFieldUUID = "uuid";
+export const PipelineExecutionActionRerun = "rerun";
+export const PipelineExecutionActionStop = "stop";
+
+export interface PipelineExecution extends ApiResource {
+    annotations?: { [key: string]: string };
+    author?: string;
+    avatarUrl?: string;
+    branch?: string;
+    commit?: string;
+    conditions?: PipelineCondition[];
+    created?: string;
+    creatorId?: string;
+    email?: string;
+    ended?: string;
+    event?: string;
+    executionState?: string;
+    htmlLink?: string;
+    labels?: { [key: string]: string };
+    message?: string;
+    name?: string;
+    namespaceId?: string;
+    ownerReferences?: OwnerReference[];
+    pipelineConfig?: PipelineConfig;
+    pipelineId?: string;
+    projectId?: string;
+    ref?: string;
+    removed?: string;
+    repositoryUrl?: string;
+    run?: number;
+    stages?: StageStatus[];
+    started?: string;
+    state?: string;
+    title?: string;
+    transitioning?: "yes" | "no" | "error";
+    transitioningMessage?: string;
+    triggerUserId?: string;
+    triggeredBy?: "user" | "cron" | "webhook";
+    uuid?: string;
+}
+
+export interface PipelineExecutionCollection extends ApiCollection {
+    data?: PipelineExecution[];
+}
+
+export class PipelineExecutionClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<PipelineExecutionCollection> {
+        return this.ops.DoList(PipelineExecutionType, opts);
+    }
+
+    Next(collection: PipelineExecutionCollection): Promise<PipelineExecutionCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: PipelineExecution): Promise<PipelineExecution> {
+        return this.ops.DoCreate(PipelineExecutionType, container);
+    }
+
+    Update(existing: PipelineExecution, updates: object): Promise<PipelineExecution> {
+        return this.ops.DoUpdate(PipelineExecutionType, existing, updates);
+    }
+
+    Replace(obj: PipelineExecution): Promise<PipelineExecution> {
+        return this.ops.DoReplace(PipelineExecutionType, obj, obj);
+    }
+
+    ByID(id: string): Promise<PipelineExecution> {
+        return this.ops.DoByID(PipelineExecutionType, id);
+    }
+
+    Delete(container: PipelineExecution): Promise<void> {
+        return this.ops.DoResourceDelete(PipelineExecutionType, container);
+    }
+
+    ActionRerun(resource: PipelineExecution): Promise<void> {
+        return this.ops.DoAction(PipelineExecutionType, PipelineExecutionActionRerun, resource);
+    }
+
+    ActionStop(resource: PipelineExecution): Promise<void> {
+        return this.ops.DoAction(PipelineExecutionType, PipelineExecutionActionStop, resource);
+    }
+}
+
+export const PipelineSettingType = "pipelineSetting";
+export const PipelineSettingFieldAnnotations = "annotations";
+export const PipelineSettingFieldCreated = "created";
+export const PipelineSettingFieldCreatorID = "creatorId";
+export const PipelineSettingFieldCustomized = "customized";
+export const PipelineSettingFieldDefault = "default";
+export const PipelineSettingFieldLabels = "labels";
+export const PipelineSettingFieldName = "name";
+export const PipelineSettingFieldNamespaceId = "namespaceId";
+export const PipelineSettingFieldOwnerReferences = "ownerReferences";
+export const PipelineSettingFieldProjectID = "projectId";
+export const PipelineSettingFieldRemoved = "removed";
+export const PipelineSettingFieldUUID = "uuid";
+export const PipelineSettingFieldValue = "value";
+
+export interface PipelineSetting extends ApiResource {
+    annotations?: { [key: string]: string };
+    created?: string;
+    creatorId?: string;
+    customized?: boolean;
+    default?: string;
+    labels?: { [key: string]: string };
+    name?: string;
+    namespaceId?: string;
+    ownerReferences?: OwnerReference[];
+    projectId?: string;
+    removed?: string;
+    uuid?: string;
+    value?: string;
+}
+
+export interface PipelineSettingCollection extends ApiCollection {
+    data?: PipelineSetting[];
+}
+
+export class PipelineSettingClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<PipelineSettingCollection> {
+        return this.ops.DoList(PipelineSettingType, opts);
+    }
+
+    Next(collection: PipelineSettingCollection): Promise<PipelineSettingCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: PipelineSetting): Promise<PipelineSetting> {
+        return this.ops.DoCreate(PipelineSettingType, container);
+    }
+
+    Update(existing: PipelineSetting, updates: object): Promise<PipelineSetting> {
+        return this.ops.DoUpdate(PipelineSettingType, existing, updates);
+    }
+
+    Replace(obj: PipelineSetting): Promise<PipelineSetting> {
+        return this.ops.DoReplace(PipelineSettingType, obj, obj);
+    }
+
+    ByID(id: string): Promise<PipelineSetting> {
+        return this.ops.DoByID(PipelineSettingType, id);
+    }
+
+    Delete(container: PipelineSetting): Promise<void> {
+        return this.ops.DoResourceDelete(PipelineSettingType, container);
+    }
+}
+
+export const RepoPermType = "repoPerm";
+export const RepoPermFieldAdmin = "admin";
+export const RepoPermFieldPull = "pull";
+export const RepoPermFieldPush = "push";
+
+export interface RepoPerm {
+    admin?: boolean;
+    pull?: boolean;
+    push?: boolean;
+}
+
+export const SourceCodeRepositorySpecType = "sourceCodeRepositorySpec";
+export const SourceCodeRepositorySpecFieldDefaultBranch = "defaultBranch";
+export const SourceCodeRepositorySpecFieldLanguage = "language";
+export const SourceCodeRepositorySpecFieldPermissions = "permissions";
+export const SourceCodeRepositorySpecFieldProjectID = "projectId";
+export const SourceCodeRepositorySpecFieldSourceCodeCredentialID = "sourceCodeCredentialId";
+export const SourceCodeRepositorySpecFieldSourceCodeType = "sourceCodeType";
+export const SourceCodeRepositorySpecFieldURL = "url";
+export const SourceCodeRepositorySpecFieldUserID = "userId";
+
+export interface SourceCodeRepositorySpec {
+    defaultBranch?: string;
+    language?: string;
+    permissions?: RepoPerm;
+    projectId?: string;
+    sourceCodeCredentialId?: string;
+    sourceCodeType?: "github" | "gitlab" | "bitbucketcloud" | "bitbucketserver";
+    url?: string;
+    userId?: string;
+}
+
+export const SourceCodeRepositoryStatusType = "sourceCodeRepositoryStatus";
+
+export interface SourceCodeRepositoryStatus {
+}
+
+export const SourceCodeRepositoryType = "sourceCodeRepository";
+export const SourceCodeRepositoryFieldAnnotations = "annotations";
+export const SourceCodeRepositoryFieldCreated = "created";
+export const SourceCodeRepositoryFieldCreatorID = "creatorId";
+export const SourceCodeRepositoryFieldDefaultBranch = "defaultBranch";
+export const SourceCodeRepositoryFieldLabels = "labels";
+export const SourceCodeRepositoryFieldLanguage = "language";
+export const SourceCodeRepositoryFieldName = "name";
+export const SourceCodeRepositoryFieldOwnerReferences = "ownerReferences";
+export const SourceCodeRepositoryFieldPermissions = "permissions";
+export const SourceCodeRepositoryFieldProjectID = "projectId";
+export const SourceCodeRepositoryFieldRemoved = "removed";
+export const SourceCodeRepositoryFieldSourceCodeCredentialID = "sourceCodeCredentialId";
+export const SourceCodeRepositoryFieldSourceCodeType = "sourceCodeType";
+export const SourceCodeRepositoryFieldState = "state";
+export const SourceCodeRepositoryFieldStatus = "status";
+export const SourceCodeRepositoryFieldTransitioning = "transitioning";
+export const SourceCodeRepositoryFieldTransitioningMessage = "transitioningMessage";
+export const SourceCodeRepositoryFieldURL = "url";
+export const SourceCodeRepositoryFieldUUID = "uuid";
+export const SourceCodeRepositoryFieldUserID = "userId";
+
+export interface SourceCodeRepository extends ApiResource {
+    annotations?: { [key: string]: string };
+    created?: string;
+    creatorId?: string;
+    defaultBranch?: string;
+    labels?: { [key: string]: string };
+    language?: string;
+    name?: string;
+    ownerReferences?: OwnerReference[];
+    permissions?: RepoPerm;
+    projectId?: string;
+    removed?: string;
+    sourceCodeCredentialId?: string;
+    sourceCodeType?: "github" | "gitlab" | "bitbucketcloud" | "bitbucketserver";
+    state?: string;
+    status?: SourceCodeRepositoryStatus;
+    transitioning?: "yes" | "no" | "error";
+    transitioningMessage?: string;
+    url?: string;
+    uuid?: string;
+    userId?: string;
+}
+
+export interface SourceCodeRepositoryCollection extends ApiCollection {
+    data?: SourceCodeRepository[];
+}
+
+export class SourceCodeRepositoryClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<SourceCodeRepositoryCollection> {
+        return this.ops.DoList(SourceCodeRepositoryType, opts);
+    }
+
+    Next(collection: SourceCodeRepositoryCollection): Promise<SourceCodeRepositoryCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: SourceCodeRepository): Promise<SourceCodeRepository> {
+        return this.ops.DoCreate(SourceCodeRepositoryType, container);
+    }
+
+    Update(existing: SourceCodeRepository, updates: object): Promise<SourceCodeRepository> {
+        return this.ops.DoUpdate(SourceCodeRepositoryType, existing, updates);
+    }
+
+    Replace(obj: SourceCodeRepository): Promise<SourceCodeRepository> {
+        return this.ops.DoReplace(SourceCodeRepositoryType, obj, obj);
+    }
+
+    ByID(id: string): Promise<SourceCodeRepository> {
+        return this.ops.DoByID(SourceCodeRepositoryType, id);
+    }
+
+    Delete(container: SourceCodeRepository): Promise<void> {
+        return this.ops.DoResourceDelete(SourceCodeRepositoryType, container);
+    }
+}
+
+export const RulesAlertType = "rulesAlert";
+export const RulesAlertFieldForGracePeriod = "forGracePeriod";
+export const RulesAlertFieldForOutageTolerance = "forOutageTolerance";
+export const RulesAlertFieldResendDelay = "resendDelay";
+
+export interface RulesAlert {
+    forGracePeriod?: string;
+    forOutageTolerance?: string;
+    resendDelay?: string;
+}
+
+export const RulesType = "rules";
+export const RulesFieldAlert = "alert";
+
+export interface Rules {
+    alert?: RulesAlert;
+}
+
+export const QuerySpecType = "querySpec";
+export const QuerySpecFieldLookbackDelta = "lookbackDelta";
+export const QuerySpecFieldMaxConcurrency = "maxConcurrency";
+export const QuerySpecFieldMaxSamples = "maxSamples";
+export const QuerySpecFieldTimeout = "timeout";
+
+export interface QuerySpec {
+    lookbackDelta?: string;
+    maxConcurrency?: number;
+    maxSamples?: number;
+    timeout?: string;
+}
+
+export const StorageSpecType = "storageSpec";
+export const StorageSpecFieldEmptyDir = "emptyDir";
+export const StorageSpecFieldVolumeClaimTemplate = "volumeClaimTemplate";
+
+export interface StorageSpec {
+    emptyDir?: EmptyDirVolumeSource;
+    volumeClaimTemplate?: PersistentVolumeClaim;
+}
+
+export const TLSConfigType = "tlsConfig";
+export const TLSConfigFieldCAFile = "caFile";
+export const TLSConfigFieldCertFile = "certFile";
+export const TLSConfigFieldInsecureSkipVerify = "insecureSkipVerify";
+export const TLSConfigFieldKeyFile = "keyFile";
+export const TLSConfigFieldServerName = "serverName";
+
+export interface TLSConfig {
+    caFile?: string;
+    certFile?: string;
+    insecureSkipVerify?: boolean;
+    keyFile?: string;
+    serverName?: string;
+}
+
+export const AlertmanagerEndpointsType = "alertmanagerEndpoints";
+export const AlertmanagerEndpointsFieldBearerTokenFile = "bearerTokenFile";
+export const AlertmanagerEndpointsFieldName = "name";
+export const AlertmanagerEndpointsFieldNamespace = "namespace";
+export const AlertmanagerEndpointsFieldPathPrefix = "pathPrefix";
+export const AlertmanagerEndpointsFieldPort = "port";
+export const AlertmanagerEndpointsFieldScheme = "scheme";
+export const AlertmanagerEndpointsFieldTLSConfig = "tlsConfig";
+
+export interface AlertmanagerEndpoints {
+    bearerTokenFile?: string;
+    name?: string;
+    namespace?: string;
+    pathPrefix?: string;
+    port?: number | string;
+    scheme?: string;
+    tlsConfig?: TLSConfig;
+}
+
+export const AlertingSpecType = "alertingSpec";
+export const AlertingSpecFieldAlertmanagers = "alertmanagers";
+
+export interface AlertingSpec {
+    alertmanagers?: AlertmanagerEndpoints[];
+}
+
+export const RelabelConfigType = "relabelConfig";
+export const RelabelConfigFieldAction = "action";
+export const RelabelConfigFieldModulus = "modulus";
+export const RelabelConfigFieldRegex = "regex";
+export const RelabelConfigFieldReplacement = "replacement";
+export const RelabelConfigFieldSeparator = "separator";
+export const RelabelConfigFieldSourceLabels = "sourceLabels";
+export const RelabelConfigFieldTargetLabel = "targetLabel";
+
+export interface RelabelConfig {
+    action?: string;
+    modulus?: number;
+    regex?: string;
+    replacement?: string;
+    separator?: string;
+    sourceLabels?: string[];
+    targetLabel?: string;
+}
+
+export const QueueConfigType = "queueConfig";
+export const QueueConfigFieldBatchSendDeadline = "batchSendDeadline";
+export const QueueConfigFieldCapacity = "capacity";
+export const QueueConfigFieldMaxBackoff = "maxBackoff";
+export const QueueConfigFieldMaxRetries = "maxRetries";
+export const QueueConfigFieldMaxSamplesPerSend = "maxSamplesPerSend";
+export const QueueConfigFieldMaxShards = "maxShards";
+export const QueueConfigFieldMinBackoff = "minBackoff";
+export const QueueConfigFieldMinShards = "minShards";
+
+export interface QueueConfig {
+    batchSendDeadline?: string;
+    capacity?: number;
+    maxBackoff?: string;
+    maxRetries?: number;
+    maxSamplesPerSend?: number;
+    maxShards?: number;
+    minBackoff?: string;
+    minShards?: number;
+}
+
+export const RemoteWriteSpecType = "remoteWriteSpec";
+export const RemoteWriteSpecFieldBasicAuth = "basicAuth";
+export const RemoteWriteSpecFieldBearerToken = "bearerToken";
+export const RemoteWriteSpecFieldBearerTokenFile = "bearerTokenFile";
+export const RemoteWriteSpecFieldProxyURL = "proxyUrl";
+export const RemoteWriteSpecFieldQueueConfig = "queueConfig";
+export const RemoteWriteSpecFieldRemoteTimeout = "remoteTimeout";
+export const RemoteWriteSpecFieldTLSConfig = "tlsConfig";
+export const RemoteWriteSpecFieldURL = "url";
+export const RemoteWriteSpecFieldWriteRelabelConfigs = "writeRelabelConfigs";
+
+export interface RemoteWriteSpec {
+    basicAuth?: BasicAuth;
+    bearerToken?: string;
+    bearerTokenFile?: string;
+    proxyUrl?: string;
+    queueConfig?: QueueConfig;
+    remoteTimeout?: string;
+    tlsConfig?: TLSConfig;
+    url?: string;
+    writeRelabelConfigs?: RelabelConfig[];
+}
+
+export const RemoteReadSpecType = "remoteReadSpec";
+export const RemoteReadSpecFieldBasicAuth = "basicAuth";
+export const RemoteReadSpecFieldBearerToken = "bearerToken";
+export const RemoteReadSpecFieldBearerTokenFile = "bearerTokenFile";
+export const RemoteReadSpecFieldProxyURL = "proxyUrl";
+export const RemoteReadSpecFieldReadRecent = "readRecent";
+export const RemoteReadSpecFieldRemoteTimeout = "remoteTimeout";
+export const RemoteReadSpecFieldRequiredMatchers = "requiredMatchers";
+export const RemoteReadSpecFieldTLSConfig = "tlsConfig";
+export const RemoteReadSpecFieldURL = "url";
+
+export interface RemoteReadSpec {
+    basicAuth?: BasicAuth;
+    bearerToken?: string;
+    bearerTokenFile?: string;
+    proxyUrl?: string;
+    readRecent?: boolean;
+    remoteTimeout?: string;
+    requiredMatchers?: { [key: string]: string };
+    tlsConfig?: TLSConfig;
+    url?: string;
+}
+
+export const APIServerConfigType = "apiServerConfig";
+export const APIServerConfigFieldBasicAuth = "basicAuth";
+export const APIServerConfigFieldBearerToken = "bearerToken";
+export const APIServerConfigFieldBearerTokenFile = "bearerTokenFile";
+export const APIServerConfigFieldHost = "host";
+export const APIServerConfigFieldTLSConfig = "tlsConfig";
+
+export interface APIServerConfig {
+    basicAuth?: BasicAuth;
+    bearerToken?: string;
+    bearerTokenFile?: string;
+    host?: string;
+    tlsConfig?: TLSConfig;
+}
+
+export const ThanosSpecType = "thanosSpec";
+export const ThanosSpecFieldBaseImage = "baseImage";
+export const ThanosSpecFieldImage = "image";
+export const ThanosSpecFieldObjectStorageConfig = "objectStorageConfig";
+export const ThanosSpecFieldResources = "resources";
+export const ThanosSpecFieldSHA = "sha";
+export const ThanosSpecFieldTag = "tag";
+export const ThanosSpecFieldVersion = "version";
+
+export interface ThanosSpec {
+    baseImage?: string;
+    image?: string;
+    objectStorageConfig?: SecretKeySelector;
+    resources?: ResourceRequirements;
+    sha?: string;
+    tag?: string;
+    version?: string;
+}
+
+export const PrometheusSpecType = "prometheusSpec";
+export const PrometheusSpecFieldAdditionalAlertManagerConfigs = "additionalAlertManagerConfigs";
+export const PrometheusSpecFieldAdditionalAlertRelabelConfigs = "additionalAlertRelabelConfigs";
+export const PrometheusSpecFieldAdditionalScrapeConfigs = "additionalScrapeConfigs";
+export const PrometheusSpecFieldAffinity = "affinity";
+export const PrometheusSpecFieldAlerting = "alerting";
+export const PrometheusSpecFieldBaseImage = "baseImage";
+export const PrometheusSpecFieldConfigMaps = "configMaps";
+export const PrometheusSpecFieldContainers = "containers";
+export const PrometheusSpecFieldEnableAdminAPI = "enableAdminAPI";
+export const PrometheusSpecFieldEvaluationInterval = "evaluationInterval";
+export const PrometheusSpecFieldExternalLabels = "externalLabels";
+export const PrometheusSpecFieldExternalURL = "externalUrl";
+export const PrometheusSpecFieldImage = "image";
+export const PrometheusSpecFieldImagePullSecrets = "imagePullSecrets";
+export const PrometheusSpecFieldInitContainers = "initContainers";
+export const PrometheusSpecFieldListenLocal = "listenLocal";
+export const PrometheusSpecFieldLogFormat = "logFormat";
+export const PrometheusSpecFieldLogLevel = "logLevel";
+export const PrometheusSpecFieldNodeSelector = "nodeSelector";
+export const PrometheusSpecFieldPodMetadata = "podMetadata";
+export const PrometheusSpecFieldPodMonitorNamespaceSelector = "podMonitorNamespaceSelector";
+export const PrometheusSpecFieldPodMonitorSelector = "podMonitorSelector";
+export const PrometheusSpecFieldPortName = "portName";
+export const PrometheusSpecFieldPriorityClassName = "priorityClassName";
+export const PrometheusSpecFieldPrometheusExternalLabelName = "prometheusExternalLabelName";
+export const PrometheusSpecFieldQuery = "query";
+export const PrometheusSpecFieldRemoteRead = "remoteRead";
+export const PrometheusSpecFieldRemoteWrite = "remoteWrite";
+export const PrometheusSpecFieldReplicaExternalLabelName = "replicaExternalLabelName";
+export const PrometheusSpecFieldReplicas = "replicas";
+export const PrometheusSpecFieldResources = "resources";
+export const PrometheusSpecFieldRetention = "retention";
+export const PrometheusSpecFieldRetentionSize = "retentionSize";
+export const PrometheusSpecFieldRoutePrefix = "routePrefix";
+export const PrometheusSpecFieldRuleSelector = "ruleSelector";
+export const PrometheusSpecFieldRules = "rules";
+export const PrometheusSpecFieldSHA = "sha";
+export const PrometheusSpecFieldScrapeInterval = "scrapeInterval";
+export const PrometheusSpecFieldSecrets = "secrets";
+export const PrometheusSpecFieldSecurityContext = "securityContext";
+export const PrometheusSpecFieldServiceAccountName = "serviceAccountName";
+export const PrometheusSpecFieldServiceMonitorSelector = "serviceMonitorSelector";
+export const PrometheusSpecFieldStorage = "storage";
+export const PrometheusSpecFieldTag = "tag";
+export const PrometheusSpecFieldTolerations = "tolerations";
+export const PrometheusSpecFieldVersion = "version";
+export const PrometheusSpecFieldVolumes = "volumes";
+export const PrometheusSpecFieldWALCompression = "walCompression";
+
+export interface PrometheusSpec {
+    additionalAlertManagerConfigs?: SecretKeySelector;
+    additionalAlertRelabelConfigs?: SecretKeySelector;
+    additionalScrapeConfigs?: SecretKeySelector;
+    affinity?: Affinity;
+    alerting?: AlertingSpec;
+    baseImage?: string;
+    configMaps?: string[];
+    containers?: Container[];
+    enableAdminAPI?: boolean;
+    evaluationInterval?: string;
+    externalLabels?: { [key: string]: string };
+    externalUrl?: string;
+    image?: string;
+    imagePullSecrets?: LocalObjectReference[];
+    initContainers?: Container[];
+    listenLocal?: boolean;
+    logFormat?: string;
+    logLevel?: "all" | "debug" | "info" | "warn" | "error" | "none";
+    nodeSelector?: { [key: string]: string };
+    podMetadata?: ObjectMeta;
+    podMonitorNamespaceSelector?: LabelSelector;
+    podMonitorSelector?: LabelSelector;
+    portName?: string;
+    priorityClassName?: string;
+    prometheusExternalLabelName?: string;
+    query?: QuerySpec;
+    remoteRead?: RemoteReadSpec[];
+    remoteWrite?: RemoteWriteSpec[];
+    replicaExternalLabelName?: string;
+    replicas?: number;
+    resources?: ResourceRequirements;
+    retention?: string;
+    retentionSize?: string;
+    routePrefix?: string;
+    ruleSelector?: LabelSelector;
+    rules?: Rules;
+    sha?: string;
+    scrapeInterval?: string;
+    secrets?: string[];
+    securityContext?: PodSecurityContext;
+    serviceAccountName?: string;
+    serviceMonitorSelector?: LabelSelector;
+    storage?: StorageSpec;
+    tag?: string;
+    tolerations?: Toleration[];
+    version?: string;
+    volumes?: Volume[];
+    walCompression?: boolean;
+}
+
+export const PrometheusStatusType = "prometheusStatus";
+export const PrometheusStatusFieldAvailableReplicas = "availableReplicas";
+export const PrometheusStatusFieldPaused = "paused";
+export const PrometheusStatusFieldReplicas = "replicas";
+export const PrometheusStatusFieldUnavailableReplicas = "unavailableReplicas";
+export const PrometheusStatusFieldUpdatedReplicas = "updatedReplicas";
+
+export interface PrometheusStatus {
+    availableReplicas?: number;
+    paused?: boolean;
+    replicas?: number;
+    unavailableReplicas?: number;
+    updatedReplicas?: number;
+}
+
+export const PrometheusType = "prometheus";
+export const PrometheusFieldAdditionalAlertManagerConfigs = "additionalAlertManagerConfigs";
+export const PrometheusFieldAdditionalAlertRelabelConfigs = "additionalAlertRelabelConfigs";
+export const PrometheusFieldAdditionalScrapeConfigs = "additionalScrapeConfigs";
+export const PrometheusFieldAffinity = "affinity";
+export const PrometheusFieldAlerting = "alerting";
+export const PrometheusFieldAnnotations = "annotations";
+export const PrometheusFieldBaseImage = "baseImage";
+export const PrometheusFieldConfigMaps = "configMaps";
+export const PrometheusFieldContainers = "containers";
+export const PrometheusFieldCreated = "created";
+export const PrometheusFieldCreatorID = "creatorId";
+export const PrometheusFieldDescription = "description";
+export const PrometheusFieldEnableAdminAPI = "enableAdminAPI";
+export const PrometheusFieldEvaluationInterval = "evaluationInterval";
+export const PrometheusFieldExternalLabels = "externalLabels";
+export const PrometheusFieldExternalURL = "externalUrl";
+export const PrometheusFieldImage = "image";
+export const PrometheusFieldImagePullSecrets = "imagePullSecrets";
+export const PrometheusFieldInitContainers = "initContainers";
+export const PrometheusFieldLabels = "labels";
+export const PrometheusFieldListenLocal = "listenLocal";
+export const PrometheusFieldLogFormat = "logFormat";
+export const PrometheusFieldLogLevel = "logLevel";
+export const PrometheusFieldName = "name";
+export const PrometheusFieldNamespaceId = "namespaceId";
+export const PrometheusFieldNodeSelector = "nodeSelector";
+export const PrometheusFieldOwnerReferences = "ownerReferences";
+export const PrometheusFieldPodMetadata = "podMetadata";
+export const PrometheusFieldPodMonitorNamespaceSelector = "podMonitorNamespaceSelector";
+export const PrometheusFieldPodMonitorSelector = "podMonitorSelector";
+export const PrometheusFieldPortName = "portName";
+export const PrometheusFieldPriorityClassName = "priorityClassName";
+export const PrometheusFieldProjectID = "projectId";
+export const PrometheusFieldPrometheusExternalLabelName = "prometheusExternalLabelName";
+export const PrometheusFieldQuery = "query";
+export const PrometheusFieldRemoteRead = "remoteRead";
+export const PrometheusFieldRemoteWrite = "remoteWrite";
+export const PrometheusFieldRemoved = "removed";
+export const PrometheusFieldReplicaExternalLabelName = "replicaExternalLabelName";
+export const PrometheusFieldReplicas = "replicas";
+export const PrometheusFieldResources = "resources";
+export const PrometheusFieldRetention = "retention";
+export const PrometheusFieldRetentionSize = "retentionSize";
+export const PrometheusFieldRoutePrefix = "routePrefix";
+export const PrometheusFieldRuleSelector = "ruleSelector";
+export const PrometheusFieldRules = "rules";
+export const PrometheusFieldSHA = "sha";
+export const PrometheusFieldScrapeInterval = "scrapeInterval";
+export const PrometheusFieldSecrets = "secrets";
+export const PrometheusFieldSecurityContext = "securityContext";
+export const PrometheusFieldServiceAccountName = "serviceAccountName";
+export const PrometheusFieldServiceMonitorSelector = "serviceMonitorSelector";
+export const PrometheusFieldState = "state";
+export const PrometheusFieldStorage = "storage";
+export const PrometheusFieldTag = "tag";
+export const PrometheusFieldTolerations = "tolerations";
+export const PrometheusFieldTransitioning = "transitioning";
+export const PrometheusFieldTransitioningMessage = "transitioningMessage";
+export const PrometheusFieldUUID = "uuid";
+export const PrometheusFieldVersion = "version";
+export const PrometheusFieldVolumes = "volumes";
+export const PrometheusFieldWALCompression = "walCompression";
+
+export interface Prometheus extends ApiResource {
+    additionalAlertManagerConfigs?: SecretKeySelector;
+    additionalAlertRelabelConfigs?: SecretKeySelector;
+    additionalScrapeConfigs?: SecretKeySelector;
+    affinity?: Affinity;
+    alerting?: AlertingSpec;
+    annotations?: { [key: string]: string };
+    baseImage?: string;
+    configMaps?: string[];
+    containers?: Container[];
+    created?: string;
+    creatorId?: string;
+    description?: string;
+    enableAdminAPI?: boolean;
+    evaluationInterval?: string;
+    externalLabels?: { [key: string]: string };
+    externalUrl?: string;
+    image?: string;
+    imagePullSecrets?: LocalObjectReference[];
+    initContainers?: Container[];
+    labels?: { [key: string]: string };
+    listenLocal?: boolean;
+    logFormat?: string;
+    logLevel?: "all" | "debug" | "info" | "warn" | "error" | "none";
+    name?: string;
+    namespaceId?: string;
+    nodeSelector?: { [key: string]: string };
+    ownerReferences?: OwnerReference[];
+    podMetadata?: ObjectMeta;
+    podMonitorNamespaceSelector?: LabelSelector;
+    podMonitorSelector?: LabelSelector;
+    portName?: string;
+    priorityClassName?: string;
+    projectId?: string;
+    prometheusExternalLabelName?: string;
+    query?: QuerySpec;
+    remoteRead?: RemoteReadSpec[];
+    remoteWrite?: RemoteWriteSpec[];
+    removed?: string;
+    replicaExternalLabelName?: string;
+    replicas?: number;
+    resources?: ResourceRequirements;
+    retention?: string;
+    retentionSize?: string;
+    routePrefix?: string;
+    ruleSelector?: LabelSelector;
+    rules?: Rules;
+    sha?: string;
+    scrapeInterval?: string;
+    secrets?: string[];
+    securityContext?: PodSecurityContext;
+    serviceAccountName?: string;
+    serviceMonitorSelector?: LabelSelector;
+    state?: string;
+    storage?: StorageSpec;
+    tag?: string;
+    tolerations?: Toleration[];
+    transitioning?: "yes" | "no" | "error";
+    transitioningMessage?: string;
+    uuid?: string;
+    version?: string;
+    volumes?: Volume[];
+    walCompression?: boolean;
+}
+
+export interface PrometheusCollection extends ApiCollection {
+    data?: Prometheus[];
+}
+
+export class PrometheusClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<PrometheusCollection> {
+        return this.ops.DoList(PrometheusType, opts);
+    }
+
+    Next(collection: PrometheusCollection): Promise<PrometheusCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: Prometheus): Promise<Prometheus> {
+        return this.ops.DoCreate(PrometheusType, container);
+    }
+
+    Update(existing: Prometheus, updates: object): Promise<Prometheus> {
+        return this.ops.DoUpdate(PrometheusType, existing, updates);
+    }
+
+    Replace(obj: Prometheus): Promise<Prometheus> {
+        return this.ops.DoReplace(PrometheusType, obj, obj);
+    }
+
+    ByID(id: string): Promise<Prometheus> {
+        return this.ops.DoByID(PrometheusType, id);
+    }
+
+    Delete(container: Prometheus): Promise<void> {
+        return this.ops.DoResourceDelete(PrometheusType, container);
+    }
+}
+
+export const EndpointType = "endpoint";
+export const EndpointFieldInterval = "interval";
+export const EndpointFieldParams = "params";
+export const EndpointFieldPath = "path";
+export const EndpointFieldRelabelConfigs = "relabelings";
+export const EndpointFieldScheme = "scheme";
+export const EndpointFieldScrapeTimeout = "scrapeTimeout";
+export const EndpointFieldTargetPort = "targetPort";
+
+export interface Endpoint {
+    interval?: string;
+    params?: { [key: string]: string[] };
+    path?: string;
+    relabelings?: RelabelConfig[];
+    scheme?: string;
+    scrapeTimeout?: string;
+    targetPort?: number | string;
+}
+
+export const NamespaceSelectorType = "namespaceSelector";
+export const NamespaceSelectorFieldAny = "any";
+export const NamespaceSelectorFieldMatchNames = "matchNames";
+
+export interface NamespaceSelector {
+    any?: boolean;
+    matchNames?: string[];
+}
+
+export const ServiceMonitorSpecType = "serviceMonitorSpec";
+export const ServiceMonitorSpecFieldEndpoints = "endpoints";
+export const ServiceMonitorSpecFieldJobLabel = "jobLabel";
+export const ServiceMonitorSpecFieldNamespaceSelector = "namespaceSelector";
+export const ServiceMonitorSpecFieldPodTargetLabels = "podTargetLabels";
+export const ServiceMonitorSpecFieldSampleLimit = "sampleLimit";
+export const ServiceMonitorSpecFieldSelector = "selector";
+export const ServiceMonitorSpecFieldTargetLabels = "targetLabels";
+
+export interface ServiceMonitorSpec {
+    endpoints?: Endpoint[];
+    jobLabel?: string;
+    namespaceSelector?: string[];
+    podTargetLabels?: string[];
+    sampleLimit?: number;
+    selector?: LabelSelector;
+    targetLabels?: string[];
+}
+
+export const ServiceMonitorType = "serviceMonitor";
+export const ServiceMonitorFieldAnnotations = "annotations";
+export const ServiceMonitorFieldCreated = "created";
+export const ServiceMonitorFieldCreatorID = "creatorId";
+export const ServiceMonitorFieldEndpoints = "endpoints";
+export const ServiceMonitorFieldJobLabel = "jobLabel";
+export const ServiceMonitorFieldLabels = "labels";
+export const ServiceMonitorFieldName = "name";
+export const ServiceMonitorFieldNamespaceId = "namespaceId";
+export const ServiceMonitorFieldNamespaceSelector = "namespaceSelector";
+export const ServiceMonitorFieldOwnerReferences = "ownerReferences";
+export const ServiceMonitorFieldPodTargetLabels = "podTargetLabels";
+export const ServiceMonitorFieldProjectID = "projectId";
+export const ServiceMonitorFieldRemoved = "removed";
+export const ServiceMonitorFieldSampleLimit = "sampleLimit";
+export const ServiceMonitorFieldSelector = "selector";
+export const ServiceMonitorFieldTargetLabels = "targetLabels";
+export const ServiceMonitorFieldTargetService = "targetService";
+export const ServiceMonitorFieldTargetWorkload = "targetWorkload";
+export const ServiceMonitorFieldUUID = "uuid";
+
+export interface ServiceMonitor extends ApiResource {
+    annotations?: { [key: string]: string };
+    created?: string;
+    creatorId?: string;
+    endpoints?: Endpoint[];
+    jobLabel?: string;
+    labels?: { [key: string]: string };
+    name?: string;
+    namespaceId?: string;
+    namespaceSelector?: string[];
+    ownerReferences?: OwnerReference[];
+    podTargetLabels?: string[];
+    projectId?: string;
+    removed?: string;
+    sampleLimit?: number;
+    selector?: LabelSelector;
+    targetLabels?: string[];
+    targetService?: string;
+    targetWorkload?: string;
+    uuid?: string;
+}
+
+export interface ServiceMonitorCollection extends ApiCollection {
+    data?: ServiceMonitor[];
+}
+
+export class ServiceMonitorClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<ServiceMonitorCollection> {
+        return this.ops.DoList(ServiceMonitorType, opts);
+    }
+
+    Next(collection: ServiceMonitorCollection): Promise<ServiceMonitorCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: ServiceMonitor): Promise<ServiceMonitor> {
+        return this.ops.DoCreate(ServiceMonitorType, container);
+    }
+
+    Update(existing: ServiceMonitor, updates: object): Promise<ServiceMonitor> {
+        return this.ops.DoUpdate(ServiceMonitorType, existing, updates);
+    }
+
+    Replace(obj: ServiceMonitor): Promise<ServiceMonitor> {
+        return this.ops.DoReplace(ServiceMonitorType, obj, obj);
+    }
+
+    ByID(id: string): Promise<ServiceMonitor> {
+        return this.ops.DoByID(ServiceMonitorType, id);
+    }
+
+    Delete(container: ServiceMonitor): Promise<void> {
+        return this.ops.DoResourceDelete(ServiceMonitorType, container);
+    }
+}
+
+export const RuleType = "rule";
+export const RuleFieldAlert = "alert";
+export const RuleFieldAnnotations = "annotations";
+export const RuleFieldExpr = "expr";
+export const RuleFieldFor = "for";
+export const RuleFieldLabels = "labels";
+export const RuleFieldRecord = "record";
+
+export interface Rule {
+    alert?: string;
+    annotations?: { [key: string]: string };
+    expr?: number | string;
+    for?: string;
+    labels?: { [key: string]: string };
+    record?: string;
+}
+
+export const RuleGroupType = "ruleGroup";
+export const RuleGroupFieldInterval = "interval";
+export const RuleGroupFieldName = "name";
+export const RuleGroupFieldRules = "rules";
+
+export interface RuleGroup {
+    interval?: string;
+    name?: string;
+    rules?: Rule[];
+}
+
+export const PrometheusRuleSpecType = "prometheusRuleSpec";
+export const PrometheusRuleSpecFieldGroups = "groups";
+
+export interface PrometheusRuleSpec {
+    groups?: RuleGroup[];
+}
+
+export const PrometheusRuleType = "prometheusRule";
+export const PrometheusRuleFieldAnnotations = "annotations";
+export const PrometheusRuleFieldCreated = "created";
+export const PrometheusRuleFieldCreatorID = "creatorId";
+export const PrometheusRuleFieldGroups = "groups";
+export const PrometheusRuleFieldLabels = "labels";
+export const PrometheusRuleFieldName = "name";
+export const PrometheusRuleFieldNamespaceId = "namespaceId";
+export const PrometheusRuleFieldOwnerReferences = "ownerReferences";
+export const PrometheusRuleFieldProjectID = "projectId";
+export const PrometheusRuleFieldRemoved = "removed";
+export const PrometheusRuleFieldUUID = "uuid";
+
+export interface PrometheusRule extends ApiResource {
+    annotations?: { [key: string]: string };
+    created?: string;
+    creatorId?: string;
+    groups?: RuleGroup[];
+    labels?: { [key: string]: string };
+    name?: string;
+    namespaceId?: string;
+    ownerReferences?: OwnerReference[];
+    projectId?: string;
+    removed?: string;
+    uuid?: string;
+}
+
+export interface PrometheusRuleCollection extends ApiCollection {
+    data?: PrometheusRule[];
+}
+
+export class PrometheusRuleClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<PrometheusRuleCollection> {
+        return this.ops.DoList(PrometheusRuleType, opts);
+    }
+
+    Next(collection: PrometheusRuleCollection): Promise<PrometheusRuleCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: PrometheusRule): Promise<PrometheusRule> {
+        return this.ops.DoCreate(PrometheusRuleType, container);
+    }
+
+    Update(existing: PrometheusRule, updates: object): Promise<PrometheusRule> {
+        return this.ops.DoUpdate(PrometheusRuleType, existing, updates);
+    }
+
+    Replace(obj: PrometheusRule): Promise<PrometheusRule> {
+        return this.ops.DoReplace(PrometheusRuleType, obj, obj);
+    }
+
+    ByID(id: string): Promise<PrometheusRule> {
+        return this.ops.DoByID(PrometheusRuleType, id);
+    }
+
+    Delete(container: PrometheusRule): Promise<void> {
+        return this.ops.DoResourceDelete(PrometheusRuleType, container);
+    }
+}
+
+export const AlertmanagerSpecType = "alertmanagerSpec";
+export const AlertmanagerSpecFieldAdditionalPeers = "additionalPeers";
+export const AlertmanagerSpecFieldAffinity = "affinity";
+export const AlertmanagerSpecFieldBaseImage = "baseImage";
+export const AlertmanagerSpecFieldConfigMaps = "configMaps";
+export const AlertmanagerSpecFieldContainers = "containers";
+export const AlertmanagerSpecFieldExternalURL = "externalUrl";
+export const AlertmanagerSpecFieldImage = "image";
+export const AlertmanagerSpecFieldImagePullSecrets = "imagePullSecrets";
+export const AlertmanagerSpecFieldInitContainers = "initContainers";
+export const AlertmanagerSpecFieldListenLocal = "listenLocal";
+export const AlertmanagerSpecFieldLogFormat = "logFormat";
+export const AlertmanagerSpecFieldLogLevel = "logLevel";
+export const AlertmanagerSpecFieldNodeSelector = "nodeSelector";
+export const AlertmanagerSpecFieldPaused = "paused";
+export const AlertmanagerSpecFieldPodMetadata = "podMetadata";
+export const AlertmanagerSpecFieldPortName = "portName";
+export const AlertmanagerSpecFieldPriorityClassName = "priorityClassName";
+export const AlertmanagerSpecFieldReplicas = "replicas";
+export const AlertmanagerSpecFieldResources = "resources";
+export const AlertmanagerSpecFieldRetention = "retention";
+export const AlertmanagerSpecFieldRoutePrefix = "routePrefix";
+export const AlertmanagerSpecFieldSHA = "sha";
+export const AlertmanagerSpecFieldSecrets = "secrets";
+export const AlertmanagerSpecFieldSecurityContext = "securityContext";
+export const AlertmanagerSpecFieldServiceAccountName = "serviceAccountName";
+export const AlertmanagerSpecFieldStorage = "storage";
+export const AlertmanagerSpecFieldTag = "tag";
+export const AlertmanagerSpecFieldTolerations = "tolerations";
+export const AlertmanagerSpecFieldVersion = "version";
+export const AlertmanagerSpecFieldVolumeMounts = "volumeMounts";
+export const AlertmanagerSpecFieldVolumes = "volumes";
+
+export interface AlertmanagerSpec {
+    additionalPeers?: string[];
+    affinity?: Affinity;
+    baseImage?: string;
+    configMaps?: string[];
+    containers?: Container[];
+    externalUrl?: string;
+    image?: string;
+    imagePullSecrets?: LocalObjectReference[];
+    initContainers?: Container[];
+    listenLocal?: boolean;
+    logFormat?: string;
+    logLevel?: string;
+    nodeSelector?: { [key: string]: string };
+    paused?: boolean;
+    podMetadata?: ObjectMeta;
+    portName?: string;
+    priorityClassName?: string;
+    replicas?: number;
+    resources?: ResourceRequirements;
+    retention?: string;
+    routePrefix?: string;
+    sha?: string;
+    secrets?: string[];
+    securityContext?: PodSecurityContext;
+    serviceAccountName?: string;
+    storage?: StorageSpec;
+    tag?: string;
+    tolerations?: Toleration[];
+    version?: string;
+    volumeMounts?: VolumeMount[];
+    volumes?: Volume[];
+}
+
+export const AlertmanagerStatusType = "alertmanagerStatus";
+export const AlertmanagerStatusFieldAvailableReplicas = "availableReplicas";
+export const AlertmanagerStatusFieldPaused = "paused";
+export const AlertmanagerStatusFieldReplicas = "replicas";
+export const AlertmanagerStatusFieldUnavailableReplicas = "unavailableReplicas";
+export const AlertmanagerStatusFieldUpdatedReplicas = "updatedReplicas";
+
+export interface AlertmanagerStatus {
+    availableReplicas?: number;
+    paused?: boolean;
+    replicas?: number;
+    unavailableReplicas?: number;
+    updatedReplicas?: number;
+}
+
+export const AlertmanagerType = "alertmanager";
+export const AlertmanagerFieldAdditionalPeers = "additionalPeers";
+export const AlertmanagerFieldAffinity = "affinity";
+export const AlertmanagerFieldAnnotations = "annotations";
+export const AlertmanagerFieldBaseImage = "baseImage";
+export const AlertmanagerFieldConfigMaps = "configMaps";
+export const AlertmanagerFieldContainers = "containers";
+export const AlertmanagerFieldCreated = "created";
+export const AlertmanagerFieldCreatorID = "creatorId";
+export const AlertmanagerFieldExternalURL = "externalUrl";
+export const AlertmanagerFieldImage = "image";
+export const AlertmanagerFieldImagePullSecrets = "imagePullSecrets";
+export const AlertmanagerFieldInitContainers = "initContainers";
+export const AlertmanagerFieldLabels = "labels";
+export const AlertmanagerFieldListenLocal = "listenLocal";
+export const AlertmanagerFieldLogFormat = "logFormat";
+export const AlertmanagerFieldLogLevel = "logLevel";
+export const AlertmanagerFieldName = "name";
+export const AlertmanagerFieldNamespaceId = "namespaceId";
+export const AlertmanagerFieldNodeSelector = "nodeSelector";
+export const AlertmanagerFieldOwnerReferences = "ownerReferences";
+export const AlertmanagerFieldPaused = "paused";
+export const AlertmanagerFieldPodMetadata = "podMetadata";
+export const AlertmanagerFieldPortName = "portName";
+export const AlertmanagerFieldPriorityClassName = "priorityClassName";
+export const AlertmanagerFieldProjectID = "projectId";
+export const AlertmanagerFieldRemoved = "removed";
+export const AlertmanagerFieldReplicas = "replicas";
+export const AlertmanagerFieldResources = "resources";
+export const AlertmanagerFieldRetention = "retention";
+export const AlertmanagerFieldRoutePrefix = "routePrefix";
+export const AlertmanagerFieldSHA = "sha";
+export const AlertmanagerFieldSecrets = "secrets";
+export const AlertmanagerFieldSecurityContext = "securityContext";
+export const AlertmanagerFieldServiceAccountName = "serviceAccountName";
+export const AlertmanagerFieldState = "state";
+export const AlertmanagerFieldStorage = "storage";
+export const AlertmanagerFieldTag = "tag";
+export const AlertmanagerFieldTolerations = "tolerations";
+export const AlertmanagerFieldTransitioning = "transitioning";
+export const AlertmanagerFieldTransitioningMessage = "transitioningMessage";
+export const AlertmanagerFieldUUID = "uuid";
+export const AlertmanagerFieldVersion = "version";
+export const AlertmanagerFieldVolumeMounts = "volumeMounts";
+export const AlertmanagerFieldVolumes = "volumes";
+
+export interface Alertmanager extends ApiResource {
+    additionalPeers?: string[];
+    affinity?: Affinity;
+    annotations?: { [key: string]: string };
+    baseImage?: string;
+    configMaps?: string[];
+    containers?: Container[];
+    created?: string;
+    creatorId?: string;
+    externalUrl?: string;
+    image?: string;
+    imagePullSecrets?: LocalObjectReference[];
+    initContainers?: Container[];
+    labels?: { [key: string]: string };
+    listenLocal?: boolean;
+    logFormat?: string;
+    logLevel?: string;
+    name?: string;
+    namespaceId?: string;
+    nodeSelector?: { [key: string]: string };
+    ownerReferences?: OwnerReference[];
+    paused?: boolean;
+    podMetadata?: ObjectMeta;
+    portName?: string;
+    priorityClassName?: string;
+    projectId?: string;
+    removed?: string;
+    replicas?: number;
+    resources?: ResourceRequirements;
+    retention?: string;
+    routePrefix?: string;
+    sha?: string;
+    secrets?: string[];
+    securityContext?: PodSecurityContext;
+    serviceAccountName?: string;
+    state?: string;
+    storage?: StorageSpec;
+    tag?: string;
+    tolerations?: Toleration[];
+    transitioning?: "yes" | "no" | "error";
+    transitioningMessage?: string;
+    uuid?: string;
+    version?: string;
+    volumeMounts?: VolumeMount[];
+    volumes?: Volume[];
+}
+
+export interface AlertmanagerCollection extends ApiCollection {
+    data?: Alertmanager[];
+}
+
+export class AlertmanagerClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<AlertmanagerCollection> {
+        return this.ops.DoList(AlertmanagerType, opts);
+    }
+
+    Next(collection: AlertmanagerCollection): Promise<AlertmanagerCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: Alertmanager): Promise<Alertmanager> {
+        return this.ops.DoCreate(AlertmanagerType, container);
+    }
+
+    Update(existing: Alertmanager, updates: object): Promise<Alertmanager> {
+        return this.ops.DoUpdate(AlertmanagerType, existing, updates);
+    }
+
+    Replace(obj: Alertmanager): Promise<Alertmanager> {
+        return this.ops.DoReplace(AlertmanagerType, obj, obj);
+    }
+
+    ByID(id: string): Promise<Alertmanager> {
+        return this.ops.DoByID(AlertmanagerType, id);
+    }
+
+    Delete(container: Alertmanager): Promise<void> {
+        return this.ops.DoResourceDelete(AlertmanagerType, container);
+    }
+}
+
+export const CrossVersionObjectReferenceType = "crossVersionObjectReference";
+export const CrossVersionObjectReferenceFieldAPIVersion = "apiVersion";
+export const CrossVersionObjectReferenceFieldKind = "kind";
+export const CrossVersionObjectReferenceFieldName = "name";
+
+export interface CrossVersionObjectReference {
+    apiVersion?: string;
+    kind?: string;
+    name?: string;
+}
+
+export const MetricTargetType = "metricTarget";
+export const MetricTargetFieldAverageValue = "averageValue";
+export const MetricTargetFieldType = "type";
+export const MetricTargetFieldUtilization = "utilization";
+export const MetricTargetFieldValue = "value";
+
+export interface MetricTarget {
+    averageValue?: string;
+    type?: "Utilization" | "Value" | "AverageValue";
+    utilization?: number;
+    value?: string;
+}
+
+export const MetricIdentifierType = "metricIdentifier";
+export const MetricIdentifierFieldName = "name";
+export const MetricIdentifierFieldSelector = "selector";
+
+export interface MetricIdentifier {
+    name?: string;
+    selector?: LabelSelector;
+}
+
+export const ObjectMetricSourceType = "objectMetricSource";
+export const ObjectMetricSourceFieldDescribedObject = "describedObject";
+export const ObjectMetricSourceFieldMetric = "metric";
+export const ObjectMetricSourceFieldTarget = "target";
+
+export interface ObjectMetricSource {
+    describedObject?: CrossVersionObjectReference;
+    metric?: MetricIdentifier;
+    target?: MetricTarget;
+}
+
+export const PodsMetricSourceType = "podsMetricSource";
+export const PodsMetricSourceFieldMetric = "metric";
+export const PodsMetricSourceFieldTarget = "target";
+
+export interface PodsMetricSource {
+    metric?: MetricIdentifier;
+    target?: MetricTarget;
+}
+
+export const ResourceMetricSourceType = "resourceMetricSource";
+export const ResourceMetricSourceFieldName = "name";
+export const ResourceMetricSourceFieldTarget = "target";
+
+export interface ResourceMetricSource {
+    name?: string;
+    target?: MetricTarget;
+}
+
+export const ExternalMetricSourceType = "externalMetricSource";
+export const ExternalMetricSourceFieldMetric = "metric";
+export const ExternalMetricSourceFieldTarget = "target";
+
+export interface ExternalMetricSource {
+    metric?: MetricIdentifier;
+    target?: MetricTarget;
+}
+
+export const MetricValueStatusType = "metricValueStatus";
+export const MetricValueStatusFieldAverageValue = "averageValue";
+export const MetricValueStatusFieldUtilization = "utilization";
+export const MetricValueStatusFieldValue = "value";
+
+export interface MetricValueStatus {
+    averageValue?: string;
+    utilization?: number;
+    value?: string;
+}
+
+export const MetricType = "metric";
+export const MetricFieldCurrent = "current";
+export const MetricFieldDescribedObject = "describedObject";
+export const MetricFieldName = "name";
+export const MetricFieldSelector = "selector";
+export const MetricFieldTarget = "target";
+export const MetricFieldType = "type";
+
+export interface Metric {
+    current?: MetricValueStatus;
+    describedObject?: CrossVersionObjectReference;
+    name?: string;
+    selector?: LabelSelector;
+    target?: MetricTarget;
+    type?: "Object" | "Pods" | "Resource" | "External";
+}
+
+export const HorizontalPodAutoscalerSpecType = "horizontalPodAutoscalerSpec";
+export const HorizontalPodAutoscalerSpecFieldMaxReplicas = "maxReplicas";
+export const HorizontalPodAutoscalerSpecFieldMetrics = "metrics";
+export const HorizontalPodAutoscalerSpecFieldMinReplicas = "minReplicas";
+export const HorizontalPodAutoscalerSpecFieldScaleTargetRef = "scaleTargetRef";
+
+export interface HorizontalPodAutoscalerSpec {
+    maxReplicas?: number;
+    metrics?: Metric[];
+    minReplicas?: number;
+    scaleTargetRef?: CrossVersionObjectReference;
+}
+
+export const ObjectMetricStatusType = "objectMetricStatus";
+export const ObjectMetricStatusFieldCurrent = "current";
+export const ObjectMetricStatusFieldDescribedObject = "describedObject";
+export const ObjectMetricStatusFieldMetric = "metric";
+
+export interface ObjectMetricStatus {
+    current?: MetricValueStatus;
+    describedObject?: CrossVersionObjectReference;
+    metric?: MetricIdentifier;
+}
+
+export const PodsMetricStatusType = "podsMetricStatus";
+export const PodsMetricStatusFieldCurrent = "current";
+export const PodsMetricStatusFieldMetric = "metric";
+
+export interface PodsMetricStatus {
+    current?: MetricValueStatus;
+    metric?: MetricIdentifier;
+}
+
+export const ResourceMetricStatusType = "resourceMetricStatus";
+export const ResourceMetricStatusFieldCurrent = "current";
+export const ResourceMetricStatusFieldName = "name";
+
+export interface ResourceMetricStatus {
+    current?: MetricValueStatus;
+    name?: string;
+}
+
+export const ExternalMetricStatusType = "externalMetricStatus";
+export const ExternalMetricStatusFieldCurrent = "current";
+export const ExternalMetricStatusFieldMetric = "metric";
+
+export interface ExternalMetricStatus {
+    current?: MetricValueStatus;
+    metric?: MetricIdentifier;
+}
+
+export const MetricStatusType = "metricStatus";
+export const MetricStatusFieldCurrent = "current";
+export const MetricStatusFieldExternal = "external";
+export const MetricStatusFieldObject = "object";
+export const MetricStatusFieldPods = "pods";
+export const MetricStatusFieldResource = "resource";
+export const MetricStatusFieldType = "type";
+
+export interface MetricStatus {
+    current?: MetricValueStatus;
+    external?: ExternalMetricStatus;
+    object?: ObjectMetricStatus;
+    pods?: PodsMetricStatus;
+    resource?: ResourceMetricStatus;
+    type?: string;
+}
+
+export const HorizontalPodAutoscalerConditionType = "horizontalPodAutoscalerCondition";
+export const HorizontalPodAutoscalerConditionFieldLastTransitionTime = "lastTransitionTime";
+export const HorizontalPodAutoscalerConditionFieldMessage = "message";
+export const HorizontalPodAutoscalerConditionFieldReason = "reason";
+export const HorizontalPodAutoscalerConditionFieldStatus = "status";
+export const HorizontalPodAutoscalerConditionFieldType = "type";
+
+export interface HorizontalPodAutoscalerCondition {
+    lastTransitionTime?: string;
+    message?: string;
+    reason?: string;
+    status?: string;
+    type?: string;
+}
+
+export const HorizontalPodAutoscalerStatusType = "horizontalPodAutoscalerStatus";
+export const HorizontalPodAutoscalerStatusFieldConditions = "conditions";
+export const HorizontalPodAutoscalerStatusFieldCurrentMetrics = "currentMetrics";
+export const HorizontalPodAutoscalerStatusFieldCurrentReplicas = "currentReplicas";
+export const HorizontalPodAutoscalerStatusFieldDesiredReplicas = "desiredReplicas";
+export const HorizontalPodAutoscalerStatusFieldLastScaleTime = "lastScaleTime";
+export const HorizontalPodAutoscalerStatusFieldObservedGeneration = "observedGeneration";
+
+export interface HorizontalPodAutoscalerStatus {
+    conditions?: HorizontalPodAutoscalerCondition[];
+    currentMetrics?: MetricStatus[];
+    currentReplicas?: number;
+    desiredReplicas?: number;
+    lastScaleTime?: string;
+    observedGeneration?: number;
+}
+
+export const HorizontalPodAutoscalerType = "horizontalPodAutoscaler";
+export const HorizontalPodAutoscalerFieldAnnotations = "annotations";
+export const HorizontalPodAutoscalerFieldConditions = "conditions";
+export const HorizontalPodAutoscalerFieldCreated = "created";
+export const HorizontalPodAutoscalerFieldCreatorID = "creatorId";
+export const HorizontalPodAutoscalerFieldCurrentReplicas = "currentReplicas";
+export const HorizontalPodAutoscalerFieldDescription = "description";
+export const HorizontalPodAutoscalerFieldDesiredReplicas = "desiredReplicas";
+export const HorizontalPodAutoscalerFieldLabels = "labels";
+export const HorizontalPodAutoscalerFieldLastScaleTime = "lastScaleTime";
+export const HorizontalPodAutoscalerFieldMaxReplicas = "maxReplicas";
+export const HorizontalPodAutoscalerFieldMetrics = "metrics";
+export const HorizontalPodAutoscalerFieldMinReplicas = "minReplicas";
+export const HorizontalPodAutoscalerFieldName = "name";
+export const HorizontalPodAutoscalerFieldNamespaceId = "namespaceId";
+export const HorizontalPodAutoscalerFieldObservedGeneration = "observedGeneration";
+export const HorizontalPodAutoscalerFieldOwnerReferences = "ownerReferences";
+export const HorizontalPodAutoscalerFieldProjectID = "projectId";
+export const HorizontalPodAutoscalerFieldRemoved = "removed";
+export const HorizontalPodAutoscalerFieldState = "state";
+export const HorizontalPodAutoscalerFieldTransitioning = "transitioning";
+export const HorizontalPodAutoscalerFieldTransitioningMessage = "transitioningMessage";
+export const HorizontalPodAutoscalerFieldUUID = "uuid";
+export const HorizontalPodAutoscalerFieldWorkloadId = "workloadId";
+
+export interface HorizontalPodAutoscaler extends ApiResource {
+    annotations?: { [key: string]: string };
+    conditions?: HorizontalPodAutoscalerCondition[];
+    created?: string;
+    creatorId?: string;
+    currentReplicas?: number;
+    description?: string;
+    desiredReplicas?: number;
+    labels?: { [key: string]: string };
+    lastScaleTime?: string;
+    maxReplicas?: number;
+    metrics?: Metric[];
+    minReplicas?: number;
+    name?: string;
+    namespaceId?: string;
+    observedGeneration?: number;
+    ownerReferences?: OwnerReference[];
+    projectId?: string;
+    removed?: string;
+    state?: string;
+    transitioning?: "yes" | "no" | "error";
+    transitioningMessage?: string;
+    uuid?: string;
+    workloadId?: string;
+}
+
+export interface HorizontalPodAutoscalerCollection extends ApiCollection {
+    data?: HorizontalPodAutoscaler[];
+}
+
+export class HorizontalPodAutoscalerClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<HorizontalPodAutoscalerCollection> {
+        return this.ops.DoList(HorizontalPodAutoscalerType, opts);
+    }
+
+    Next(collection: HorizontalPodAutoscalerCollection): Promise<HorizontalPodAutoscalerCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: HorizontalPodAutoscaler): Promise<HorizontalPodAutoscaler> {
+        return this.ops.DoCreate(HorizontalPodAutoscalerType, container);
+    }
+
+    Update(existing: HorizontalPodAutoscaler, updates: object): Promise<HorizontalPodAutoscaler> {
+        return this.ops.DoUpdate(HorizontalPodAutoscalerType, existing, updates);
+    }
+
+    Replace(obj: HorizontalPodAutoscaler): Promise<HorizontalPodAutoscaler> {
+        return this.ops.DoReplace(HorizontalPodAutoscalerType, obj, obj);
+    }
+
+    ByID(id: string): Promise<HorizontalPodAutoscaler> {
+        return this.ops.DoByID(HorizontalPodAutoscalerType, id);
+    }
+
+    Delete(container: HorizontalPodAutoscaler): Promise<void> {
+        return this.ops.DoResourceDelete(HorizontalPodAutoscalerType, container);
+    }
+}
+
+export const StringMatchType = "stringMatch";
+export const StringMatchFieldExact = "exact";
+export const StringMatchFieldPrefix = "prefix";
+export const StringMatchFieldRegex = "regex";
+export const StringMatchFieldSuffix = "suffix";
+
+export interface StringMatch {
+    exact?: string;
+    prefix?: string;
+    regex?: string;
+    suffix?: string;
+}
+
+export const HTTPMatchRequestType = "httpMatchRequest";
+export const HTTPMatchRequestFieldAuthority = "authority";
+export const HTTPMatchRequestFieldGateways = "gateways";
+export const HTTPMatchRequestFieldHeaders = "headers";
+export const HTTPMatchRequestFieldMethod = "method";
+export const HTTPMatchRequestFieldPort = "port";
+export const HTTPMatchRequestFieldScheme = "scheme";
+export const HTTPMatchRequestFieldSourceLabels = "sourceLabels";
+export const HTTPMatchRequestFieldURI = "uri";
+
+export interface HTTPMatchRequest {
+    authority?: StringMatch;
+    gateways?: string[];
+    headers?: { [key: string]: StringMatch };
+    method?: StringMatch;
+    port?: number;
+    scheme?: StringMatch;
+    sourceLabels?: { [key: string]: string };
+    uri?: StringMatch;
+}
+
+export const PortSelectorType = "portSelector";
+export const PortSelectorFieldName = "name";
+export const PortSelectorFieldNumber = "number";
+
+export interface PortSelector {
+    name?: string;
+    number?: number;
+}
+
+export const DestinationType = "destination";
+export const DestinationFieldHost = "host";
+export const DestinationFieldPort = "port";
+export const DestinationFieldSubset = "subset";
+
+export interface Destination {
+    host?: string;
+    port?: PortSelector;
+    subset?: string;
+}
+
+export const HeaderOperationsType = "headerOperations";
+export const HeaderOperationsFieldAdd = "add";
+export const HeaderOperationsFieldRemove = "remove";
+export const HeaderOperationsFieldSet = "set";
+
+export interface HeaderOperations {
+    add?: { [key: string]: string };
+    remove?: string[];
+    set?: { [key: string]: string };
+}
+
+export const HeadersType = "headers";
+export const HeadersFieldRequest = "request";
+export const HeadersFieldResponse = "response";
+
+export interface Headers {
+    request?: HeaderOperations;
+    response?: HeaderOperations;
+}
+
+export const HTTPRouteDestinationType = "httpRouteDestination";
+export const HTTPRouteDestinationFieldDestination = "destination";
+export const HTTPRouteDestinationFieldHeaders = "headers";
+export const HTTPRouteDestinationFieldWeight = "weight";
+
+export interface HTTPRouteDestination {
+    destination?: Destination;
+    headers?: Headers;
+    weight?: number;
+}
+
+export const HTTPRedirectType = "httpRedirect";
+export const HTTPRedirectFieldAuthority = "authority";
+export const HTTPRedirectFieldURI = "uri";
+
+export interface HTTPRedirect {
+    authority?: string;
+    uri?: string;
+}
+
+export const HTTPRewriteType = "httpRewrite";
+export const HTTPRewriteFieldAuthority = "authority";
+export const HTTPRewriteFieldURI = "uri";
+
+export interface HTTPRewrite {
+    authority?: string;
+    uri?: string;
+}
+
+export const HTTPRetryType = "httpRetry";
+export const HTTPRetryFieldAttempts = "attempts";
+export const HTTPRetryFieldPerTryTimeout = "perTryTimeout";
+
+export interface HTTPRetry {
+    attempts?: number;
+    perTryTimeout?: string;
+}
+
+export const InjectDelayType = "injectDelay";
+export const InjectDelayFieldExponentialDelay = "exponentialDelay";
+export const InjectDelayFieldFixedDelay = "fixedDelay";
+export const InjectDelayFieldPercent = "percent";
+
+export interface InjectDelay {
+    exponentialDelay?: string;
+    fixedDelay?: string;
+    percent?: number;
+}
+
+export const InjectAbortType = "injectAbort";
+export const InjectAbortFieldHTTPStatus = "httpStatus";
+export const InjectAbortFieldPercent = "percent";
+
+export interface InjectAbort {
+    httpStatus?: number;
+    percent?: number;
+}
+
+export const HTTPFaultInjectionType = "httpFaultInjection";
+export const HTTPFaultInjectionFieldAbort = "abort";
+export const HTTPFaultInjectionFieldDelay = "delay";
+
+export interface HTTPFaultInjection {
+    abort?: InjectAbort;
+    delay?: InjectDelay;
+}
+
+export const CorsPolicyType = "corsPolicy";
+export const CorsPolicyFieldAllowCredentials = "allowCredentials";
+export const CorsPolicyFieldAllowHeaders = "allowHeaders";
+export const CorsPolicyFieldAllowMethods = "allowMethods";
+export const CorsPolicyFieldAllowOrigin = "allowOrigin";
+export const CorsPolicyFieldExposeHeaders = "exposeHeaders";
+export const CorsPolicyFieldMaxAge = "maxAge";
+
+export interface CorsPolicy {
+    allowCredentials?: boolean;
+    allowHeaders?: string[];
+    allowMethods?: string[];
+    allowOrigin?: string[];
+    exposeHeaders?: string[];
+    maxAge?: string;
+}
+
+export const HTTPRouteType = "httpRoute";
+export const HTTPRouteFieldCorsPolicy = "corsPolicy";
+export const HTTPRouteFieldDeprecatedAppendHeaders = "appendHeaders";
+export const HTTPRouteFieldFault = "fault";
+export const HTTPRouteFieldHeaders = "headers";
+export const HTTPRouteFieldMatch = "match";
+export const HTTPRouteFieldMirror = "mirror";
+export const HTTPRouteFieldRedirect = "redirect";
+export const HTTPRouteFieldRemoveResponseHeaders = "removeResponseHeaders";
+export const HTTPRouteFieldRetries = "retries";
+export const HTTPRouteFieldRewrite = "rewrite";
+export const HTTPRouteFieldRoute = "route";
+export const HTTPRouteFieldTimeout = "timeout";
+export const HTTPRouteFieldWebsocketUpgrade = "websocketUpgrade";
+
+export interface HTTPRoute {
+    corsPolicy?: CorsPolicy;
+    appendHeaders?: { [key: string]: string };
+    fault?: HTTPFaultInjection;
+    headers?: Headers;
+    match?: HTTPMatchRequest[];
+    mirror?: Destination;
+    redirect?: HTTPRedirect;
+    removeResponseHeaders?: { [key: string]: string };
+    retries?: HTTPRetry;
+    rewrite?: HTTPRewrite;
+    route?: HTTPRouteDestination[];
+    timeout?: string;
+    websocketUpgrade?: boolean;
+}
+
+export const L4MatchAttributesType = "l4MatchAttributes";
+export const L4MatchAttributesFieldDestinationSubnets = "destinationSubnets";
+export const L4MatchAttributesFieldGateways = "gateways";
+export const L4MatchAttributesFieldPort = "port";
+export const L4MatchAttributesFieldSourceLabels = "sourceLabels";
+
+export interface L4MatchAttributes {
+    destinationSubnets?: string[];
+    gateways?: string[];
+    port?: number;
+    sourceLabels?: { [key: string]: string };
+}
+
+export const TCPRouteType = "tcpRoute";
+export const TCPRouteFieldMatch = "match";
+export const TCPRouteFieldRoute = "route";
+
+export interface TCPRoute {
+    match?: L4MatchAttributes[];
+    route?: HTTPRouteDestination[];
+}
+
+export const TLSMatchAttributesType = "tlsMatchAttributes";
+export const TLSMatchAttributesFieldDestinationSubnets = "destinationSubnets";
+export const TLSMatchAttributesFieldGateways = "gateways";
+export const TLSMatchAttributesFieldPort = "port";
+export const TLSMatchAttributesFieldSniHosts = "sniHosts";
+export const TLSMatchAttributesFieldSourceLabels = "sourceLabels";
+
+export interface TLSMatchAttributes {
+    destinationSubnets?: string[];
+    gateways?: string[];
+    port?: number;
+    sniHosts?: string[];
+    sourceLabels?: { [key: string]: string };
+}
+
+export const TLSRouteType = "tlsRoute";
+export const TLSRouteFieldMatch = "match";
+export const TLSRouteFieldRoute = "route";
+
+export interface TLSRoute {
+    match?: TLSMatchAttributes[];
+    route?: HTTPRouteDestination[];
+}
+
+export const VirtualServiceSpecType = "virtualServiceSpec";
+export const VirtualServiceSpecFieldGateways = "gateways";
+export const VirtualServiceSpecFieldHTTP = "http";
+export const VirtualServiceSpecFieldHosts = "hosts";
+export const VirtualServiceSpecFieldTCP = "tcp";
+export const VirtualServiceSpecFieldTLS = "tls";
+
+export interface VirtualServiceSpec {
+    gateways?: string[];
+    http?: HTTPRoute[];
+    hosts?: string[];
+    tcp?: TCPRoute[];
+    tls?: TLSRoute[];
+}
+
+export const VirtualServiceType = "virtualService";
+export const VirtualServiceFieldAnnotations = "annotations";
+export const VirtualServiceFieldCreated = "created";
+export const VirtualServiceFieldCreatorID = "creatorId";
+export const VirtualServiceFieldGateways = "gateways";
+export const VirtualServiceFieldHTTP = "http";
+export const VirtualServiceFieldHosts = "hosts";
+export const VirtualServiceFieldLabels = "labels";
+export const VirtualServiceFieldName = "name";
+export const VirtualServiceFieldNamespaceId = "namespaceId";
+export const VirtualServiceFieldOwnerReferences = "ownerReferences";
+export const VirtualServiceFieldProjectID = "projectId";
+export const VirtualServiceFieldRemoved = "removed";
+export const VirtualServiceFieldState = "state";
+export const VirtualServiceFieldStatus = "status";
+export const VirtualServiceFieldTCP = "tcp";
+export const VirtualServiceFieldTLS = "tls";
+export const VirtualServiceFieldTransitioning = "transitioning";
+export const VirtualServiceFieldTransitioningMessage = "transitioningMessage";
+export const VirtualServiceFieldUUID = "uuid";
+
+export interface VirtualService extends ApiResource {
+    annotations?: { [key: string]: string };
+    created?: string;
+    creatorId?: string;
+    gateways?: string[];
+    http?: HTTPRoute[];
+    hosts?: string[];
+    labels?: { [key: string]: string };
+    name?: string;
+    namespaceId?: string;
+    ownerReferences?: OwnerReference[];
+    projectId?: string;
+    removed?: string;
+    state?: string;
+    status?: any;
+    tcp?: TCPRoute[];
+    tls?: TLSRoute[];
+    transitioning?: "yes" | "no" | "error";
+    transitioningMessage?: string;
+    uuid?: string;
+}
+
+export interface VirtualServiceCollection extends ApiCollection {
+    data?: VirtualService[];
+}
+
+export class VirtualServiceClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<VirtualServiceCollection> {
+        return this.ops.DoList(VirtualServiceType, opts);
+    }
+
+    Next(collection: VirtualServiceCollection): Promise<VirtualServiceCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: VirtualService): Promise<VirtualService> {
+        return this.ops.DoCreate(VirtualServiceType, container);
+    }
+
+    Update(existing: VirtualService, updates: object): Promise<VirtualService> {
+        return this.ops.DoUpdate(VirtualServiceType, existing, updates);
+    }
+
+    Replace(obj: VirtualService): Promise<VirtualService> {
+        return this.ops.DoReplace(VirtualServiceType, obj, obj);
+    }
+
+    ByID(id: string): Promise<VirtualService> {
+        return this.ops.DoByID(VirtualServiceType, id);
+    }
+
+    Delete(container: VirtualService): Promise<void> {
+        return this.ops.DoResourceDelete(VirtualServiceType, container);
+    }
+}
+
+export const HTTPCookieType = "httpCookie";
+export const HTTPCookieFieldName = "name";
+export const HTTPCookieFieldPath = "path";
+export const HTTPCookieFieldTTL = "ttl";
+
+export interface HTTPCookie {
+    name?: string;
+    path?: string;
+    ttl?: string;
+}
+
+export const ConsistentHashLBType = "consistentHashLB";
+export const ConsistentHashLBFieldHTTPCookie = "httpCookie";
+export const ConsistentHashLBFieldHTTPHeaderName = "httpHeaderName";
+export const ConsistentHashLBFieldMinimumRingSize = "minimumRingSize";
+export const ConsistentHashLBFieldUseSourceIP = "useSourceIp";
+
+export interface ConsistentHashLB {
+    httpCookie?: HTTPCookie;
+    httpHeaderName?: string;
+    minimumRingSize?: number;
+    useSourceIp?: boolean;
+}
+
+export const LoadBalancerSettingsType = "loadBalancerSettings";
+export const LoadBalancerSettingsFieldConsistentHash = "consistentHash";
+export const LoadBalancerSettingsFieldSimple = "simple";
+
+export interface LoadBalancerSettings {
+    consistentHash?: ConsistentHashLB;
+    simple?: string;
+}
+
+export const TCPSettingsType = "tcpSettings";
+export const TCPSettingsFieldConnectTimeout = "connectTimeout";
+export const TCPSettingsFieldMaxConnections = "maxConnections";
+
+export interface TCPSettings {
+    connectTimeout?: string;
+    maxConnections?: number;
+}
+
+export const HTTPSettingsType = "httpSettings";
+export const HTTPSettingsFieldHTTP1MaxPendingRequests = "http1MaxPendingRequests";
+export const HTTPSettingsFieldHTTP2MaxRequests = "http2MaxRequests";
+export const HTTPSettingsFieldMaxRequestsPerConnection = "maxRequestsPerConnection";
+export const HTTPSettingsFieldMaxRetries = "maxRetries";
+
+export interface HTTPSettings {
+    http1MaxPendingRequests?: number;
+    http2MaxRequests?: number;
+    maxRequestsPerConnection?: number;
+    maxRetries?: number;
+}
+
+export const ConnectionPoolSettingsType = "connectionPoolSettings";
+export const ConnectionPoolSettingsFieldHTTP = "http";
+export const ConnectionPoolSettingsFieldTCP = "tcp";
+
+export interface ConnectionPoolSettings {
+    http?: HTTPSettings;
+    tcp?: TCPSettings;
+}
+
+export const OutlierDetectionType = "outlierDetection";
+export const OutlierDetectionFieldBaseEjectionTime = "baseEjectionTime";
+export const OutlierDetectionFieldConsecutiveErrors = "consecutiveErrors";
+export const OutlierDetectionFieldInterval = "interval";
+export const OutlierDetectionFieldMaxEjectionPercent = "maxEjectionPercent";
+
+export interface OutlierDetection {
+    baseEjectionTime?: string;
+    consecutiveErrors?: number;
+    interval?: string;
+    maxEjectionPercent?: number;
+}
+
+export const TLSSettingsType = "tlsSettings";
+export const TLSSettingsFieldCaCertificates = "caCertificates";
+export const TLSSettingsFieldClientCertificate = "clientCertificate";
+export const TLSSettingsFieldMode = "mode";
+export const TLSSettingsFieldPrivateKey = "privateKey";
+export const TLSSettingsFieldSni = "sni";
+export const TLSSettingsFieldSubjectAltNames = "subjectAltNames";
+
+export interface TLSSettings {
+    caCertificates?: string;
+    clientCertificate?: string;
+    mode?: string;
+    privateKey?: string;
+    sni?: string;
+    subjectAltNames?: string[];
+}
+
+export const PortTrafficPolicyType = "portTrafficPolicy";
+export const PortTrafficPolicyFieldConnectionPool = "connectionPool";
+export const PortTrafficPolicyFieldLoadBalancer = "loadBalancer";
+export const PortTrafficPolicyFieldOutlierDetection = "outlierDetection";
+export const PortTrafficPolicyFieldPort = "port";
+export const PortTrafficPolicyFieldTLS = "tls";
+
+export interface PortTrafficPolicy {
+    connectionPool?: ConnectionPoolSettings;
+    loadBalancer?: LoadBalancerSettings;
+    outlierDetection?: OutlierDetection;
+    port?: PortSelector;
+    tls?: TLSSettings;
+}
+
+export const TrafficPolicyType = "trafficPolicy";
+export const TrafficPolicyFieldConnectionPool = "connectionPool";
+export const TrafficPolicyFieldLoadBalancer = "loadBalancer";
+export const TrafficPolicyFieldOutlierDetection = "outlierDetection";
+export const TrafficPolicyFieldPortLevelSettings = "portLevelSettings";
+export const TrafficPolicyFieldTLS = "tls";
+
+export interface TrafficPolicy {
+    connectionPool?: ConnectionPoolSettings;
+    loadBalancer?: LoadBalancerSettings;
+    outlierDetection?: OutlierDetection;
+    portLevelSettings?: PortTrafficPolicy[];
+    tls?: TLSSettings;
+}
+
+export const SubsetType = "subset";
+export const SubsetFieldLabels = "labels";
+export const SubsetFieldName = "name";
+export const SubsetFieldTrafficPolicy = "trafficPolicy";
+
+export interface Subset {
+    labels?: { [key: string]: string };
+    name?: string;
+    trafficPolicy?: TrafficPolicy;
+}
+
+export const DestinationRuleSpecType = "destinationRuleSpec";
+export const DestinationRuleSpecFieldHost = "host";
+export const DestinationRuleSpecFieldSubsets = "subsets";
+export const DestinationRuleSpecFieldTrafficPolicy = "trafficPolicy";
+
+export interface DestinationRuleSpec {
+    host?: string;
+    subsets?: Subset[];
+    trafficPolicy?: TrafficPolicy;
+}
+
+export const DestinationRuleType = "destinationRule";
+export const DestinationRuleFieldAnnotations = "annotations";
+export const DestinationRuleFieldCreated = "created";
+export const DestinationRuleFieldCreatorID = "creatorId";
+export const DestinationRuleFieldHost = "host";
+export const DestinationRuleFieldLabels = "labels";
+export const DestinationRuleFieldName = "name";
+export const DestinationRuleFieldNamespaceId = "namespaceId";
+export const DestinationRuleFieldOwnerReferences = "ownerReferences";
+export const DestinationRuleFieldProjectID = "projectId";
+export const DestinationRuleFieldRemoved = "removed";
+export const DestinationRuleFieldState = "state";
+export const DestinationRuleFieldStatus = "status";
+export const DestinationRuleFieldSubsets = "subsets";
+export const DestinationRuleFieldTrafficPolicy = "trafficPolicy";
+export const DestinationRuleFieldTransitioning = "transitioning";
+export const DestinationRuleFieldTransitioningMessage = "transitioningMessage";
+export const DestinationRuleFieldUUID = "uuid";
+
+export interface DestinationRule extends ApiResource {
+    annotations?: { [key: string]: string };
+    created?: string;
+    creatorId?: string;
+    host?: string;
+    labels?: { [key: string]: string };
+    name?: string;
+    namespaceId?: string;
+    ownerReferences?: OwnerReference[];
+    projectId?: string;
+    removed?: string;
+    state?: string;
+    status?: any;
+    subsets?: Subset[];
+    trafficPolicy?: TrafficPolicy;
+    transitioning?: "yes" | "no" | "error";
+    transitioningMessage?: string;
+    uuid?: string;
+}
+
+export interface DestinationRuleCollection extends ApiCollection {
+    data?: DestinationRule[];
+}
+
+export class DestinationRuleClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<DestinationRuleCollection> {
+        return this.ops.DoList(DestinationRuleType, opts);
+    }
+
+    Next(collection: DestinationRuleCollection): Promise<DestinationRuleCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: DestinationRule): Promise<DestinationRule> {
+        return this.ops.DoCreate(DestinationRuleType, container);
+    }
+
+    Update(existing: DestinationRule, updates: object): Promise<DestinationRule> {
+        return this.ops.DoUpdate(DestinationRuleType, existing, updates);
+    }
+
+    Replace(obj: DestinationRule): Promise<DestinationRule> {
+        return this.ops.DoReplace(DestinationRuleType, obj, obj);
+    }
+
+    ByID(id: string): Promise<DestinationRule> {
+        return this.ops.DoByID(DestinationRuleType, id);
+    }
+
+    Delete(container: DestinationRule): Promise<void> {
+        return this.ops.DoResourceDelete(DestinationRuleType, container);
+    }
+}
+
+export const PortType = "port";
+export const PortFieldName = "name";
+export const PortFieldNumber = "number";
+export const PortFieldProtocol = "protocol";
+
+export interface Port {
+    name?: string;
+    number?: number;
+    protocol?: string;
+}
+
+export const TLSOptionsType = "tlsOptions";
+export const TLSOptionsFieldCaCertificates = "caCertificates";
+export const TLSOptionsFieldCredentialName = "credentialName";
+export const TLSOptionsFieldHTTPSRedirect = "httpsRedirect";
+export const TLSOptionsFieldMode = "mode";
+export const TLSOptionsFieldPrivateKey = "privateKey";
+export const TLSOptionsFieldServerCertificate = "serverCertificate";
+export const TLSOptionsFieldSubjectAltNames = "subjectAltNames";
+
+export interface TLSOptions {
+    caCertificates?: string;
+    credentialName?: string;
+    httpsRedirect?: boolean;
+    mode?: string;
+    privateKey?: string;
+    serverCertificate?: string;
+    subjectAltNames?: string[];
+}
+
+export const ServerType = "server";
+export const ServerFieldHosts = "hosts";
+export const ServerFieldPort = "port";
+export const ServerFieldTLS = "tls";
+
+export interface Server {
+    hosts?: string[];
+    port?: Port;
+    tls?: TLSOptions;
+}
+
+export const GatewaySpecType = "gatewaySpec";
+export const GatewaySpecFieldSelector = "selector";
+export const GatewaySpecFieldServers = "servers";
+
+export interface GatewaySpec {
+    selector?: { [key: string]: string };
+    servers?: Server[];
+}
+
+export const GatewayType = "gateway";
+export const GatewayFieldAnnotations = "annotations";
+export const GatewayFieldCreated = "created";
+export const GatewayFieldCreatorID = "creatorId";
+export const GatewayFieldLabels = "labels";
+export const GatewayFieldName = "name";
+export const GatewayFieldNamespaceId = "namespaceId";
+export const GatewayFieldOwnerReferences = "ownerReferences";
+export const GatewayFieldProjectID = "projectId";
+export const GatewayFieldRemoved = "removed";
+export const GatewayFieldSelector = "selector";
+export const GatewayFieldServers = "servers";
+export const GatewayFieldState = "state";
+export const GatewayFieldStatus = "status";
+export const GatewayFieldTransitioning = "transitioning";
+export const GatewayFieldTransitioningMessage = "transitioningMessage";
+export const GatewayFieldUUID = "uuid";
+
+export interface Gateway extends ApiResource {
+    annotations?: { [key: string]: string };
+    created?: string;
+    creatorId?: string;
+    labels?: { [key: string]: string };
+    name?: string;
+    namespaceId?: string;
+    ownerReferences?: OwnerReference[];
+    projectId?: string;
+    removed?: string;
+    selector?: { [key: string]: string };
+    servers?: Server[];
+    state?: string;
+    status?: any;
+    transitioning?: "yes" | "no" | "error";
+    transitioningMessage?: string;
+    uuid?: string;
+}
+
+export interface GatewayCollection extends ApiCollection {
+    data?: Gateway[];
+}
+
+export class GatewayClient {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<GatewayCollection> {
+        return this.ops.DoList(GatewayType, opts);
+    }
+
+    Next(collection: GatewayCollection): Promise<GatewayCollection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: Gateway): Promise<Gateway> {
+        return this.ops.DoCreate(GatewayType, container);
+    }
+
+    Update(existing: Gateway, updates: object): Promise<Gateway> {
+        return this.ops.DoUpdate(GatewayType, existing, updates);
+    }
+
+    Replace(obj: Gateway): Promise<Gateway> {
+        return this.ops.DoReplace(GatewayType, obj, obj);
+    }
+
+    ByID(id: string): Promise<Gateway> {
+        return this.ops.DoByID(GatewayType, id);
+    }
+
+    Delete(container: Gateway): Promise<void> {
+        return this.ops.DoResourceDelete(GatewayType, container);
+    }
+}
+
+export class Client {
+    PersistentVolumeClaim: PersistentVolumeClaimClient;
+    ConfigMap: ConfigMapClient;
+    Ingress: IngressClient;
+    Secret: SecretClient;
+    ServiceAccountToken: ServiceAccountTokenClient;
+    DockerCredential: DockerCredentialClient;
+    Certificate: CertificateClient;
+    BasicAuth: BasicAuthClient;
+    SSHAuth: SSHAuthClient;
+    NamespacedSecret: NamespacedSecretClient;
+    NamespacedServiceAccountToken: NamespacedServiceAccountTokenClient;
+    NamespacedDockerCredential: NamespacedDockerCredentialClient;
+    NamespacedCertificate: NamespacedCertificateClient;
+    NamespacedBasicAuth: NamespacedBasicAuthClient;
+    NamespacedSSHAuth: NamespacedSSHAuthClient;
+    Service: ServiceClient;
+    DNSRecord: DNSRecordClient;
+    Pod: PodClient;
+    Deployment: DeploymentClient;
+    ReplicationController: ReplicationControllerClient;
+    ReplicaSet: ReplicaSetClient;
+    StatefulSet: StatefulSetClient;
+    DaemonSet: DaemonSetClient;
+    Job: JobClient;
+    CronJob: CronJobClient;
+    Workload: WorkloadClient;
+    App: AppClient;
+    AppRevision: AppRevisionClient;
+    SourceCodeProvider: SourceCodeProviderClient;
+    SourceCodeProviderConfig: SourceCodeProviderConfigClient;
+    SourceCodeCredential: SourceCodeCredentialClient;
+    Pipeline: PipelineClient;
+    PipelineExecution: PipelineExecutionClient;
+    PipelineSetting: PipelineSettingClient;
+    SourceCodeRepository: SourceCodeRepositoryClient;
+    Prometheus: PrometheusClient;
+    ServiceMonitor: ServiceMonitorClient;
+    PrometheusRule: PrometheusRuleClient;
+    Alertmanager: AlertmanagerClient;
+    HorizontalPodAutoscaler: HorizontalPodAutoscalerClient;
+    VirtualService: VirtualServiceClient;
+    DestinationRule: DestinationRuleClient;
+    Gateway: GatewayClient;
+
+    constructor(ops: ApiOps) {
+        this.PersistentVolumeClaim = new PersistentVolumeClaimClient(ops);
+        this.ConfigMap = new ConfigMapClient(ops);
+        this.Ingress = new IngressClient(ops);
+        this.Secret = new SecretClient(ops);
+        this.ServiceAccountToken = new ServiceAccountTokenClient(ops);
+        this.DockerCredential = new DockerCredentialClient(ops);
+        this.Certificate = new CertificateClient(ops);
+        this.BasicAuth = new BasicAuthClient(ops);
+        this.SSHAuth = new SSHAuthClient(ops);
+        this.NamespacedSecret = new NamespacedSecretClient(ops);
+        this.NamespacedServiceAccountToken = new NamespacedServiceAccountTokenClient(ops);
+        this.NamespacedDockerCredential = new NamespacedDockerCredentialClient(ops);
+        this.NamespacedCertificate = new NamespacedCertificateClient(ops);
+        this.NamespacedBasicAuth = new NamespacedBasicAuthClient(ops);
+        this.NamespacedSSHAuth = new NamespacedSSHAuthClient(ops);
+        this.Service = new ServiceClient(ops);
+        this.DNSRecord = new DNSRecordClient(ops);
+        this.Pod = new PodClient(ops);
+        this.Deployment = new DeploymentClient(ops);
+        this.ReplicationController = new ReplicationControllerClient(ops);
+        this.ReplicaSet = new ReplicaSetClient(ops);
+        this.StatefulSet = new StatefulSetClient(ops);
+        this.DaemonSet = new DaemonSetClient(ops);
+        this.Job = new JobClient(ops);
+        this.CronJob = new CronJobClient(ops);
+        this.Workload = new WorkloadClient(ops);
+        this.App = new AppClient(ops);
+        this.AppRevision = new AppRevisionClient(ops);
+        this.SourceCodeProvider = new SourceCodeProviderClient(ops);
+        this.SourceCodeProviderConfig = new SourceCodeProviderConfigClient(ops);
+        this.SourceCodeCredential = new SourceCodeCredentialClient(ops);
+        this.Pipeline = new PipelineClient(ops);
+        this.PipelineExecution = new PipelineExecutionClient(ops);
+        this.PipelineSetting = new PipelineSettingClient(ops);
+        this.SourceCodeRepository = new SourceCodeRepositoryClient(ops);
+        this.Prometheus = new PrometheusClient(ops);
+        this.ServiceMonitor = new ServiceMonitorClient(ops);
+        this.PrometheusRule = new PrometheusRuleClient(ops);
+        this.Alertmanager = new AlertmanagerClient(ops);
+        this.HorizontalPodAutoscaler = new HorizontalPodAutoscalerClient(ops);
+        this.VirtualService = new VirtualServiceClient(ops);
+        this.DestinationRule = new DestinationRuleClient(ops);
+        this.Gateway = new GatewayClient(ops);
+    }
+}
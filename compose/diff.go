@@ -0,0 +1,228 @@
+package compose
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/rancher/norman/types"
+	"github.com/rancher/norman/types/convert"
+	"github.com/rancher/norman/types/definition"
+)
+
+type ChangeType string
+
+const (
+	ChangeAdded   ChangeType = "added"
+	ChangeRemoved ChangeType = "removed"
+	ChangeUpdated ChangeType = "updated"
+)
+
+var changeSymbols = map[ChangeType]string{
+	ChangeAdded:   "+",
+	ChangeRemoved: "-",
+	ChangeUpdated: "~",
+}
+
+// FieldChange is the change of one field of an entry, Path is made of the
+// field names of the schemas and the keys of maps.
+type FieldChange struct {
+	Path string      `json:"path"`
+	Old  interface{} `json:"old,omitempty"`
+	New  interface{} `json:"new,omitempty"`
+	// Rejected is set for fields the api does not update, the object has to
+	// be recreated to change them
+	Rejected bool `json:"rejected,omitempty"`
+}
+
+type EntryChange struct {
+	Collection string        `json:"collection"`
+	Name       string        `json:"name"`
+	Type       ChangeType    `json:"type"`
+	Fields     []FieldChange `json:"fields,omitempty"`
+}
+
+// Rejected reports if the entry can not be updated in place.
+func (e *EntryChange) Rejected() bool {
+	for _, field := range e.Fields {
+		if field.Rejected {
+			return true
+		}
+	}
+	return false
+}
+
+// Diff is the difference between two Configs, entries are in the order of
+// the fields of Config and by name.
+type Diff struct {
+	Changes []EntryChange `json:"changes"`
+}
+
+func (d *Diff) Empty() bool {
+	return len(d.Changes) == 0
+}
+
+func (d *Diff) String() string {
+	buf := &bytes.Buffer{}
+	for _, change := range d.Changes {
+		fmt.Fprintf(buf, "%s %s/%s\n", changeSymbols[change.Type], change.Collection, change.Name)
+		for _, field := range change.Fields {
+			fmt.Fprintf(buf, "    %s: %s -> %s", field.Path, diffValue(field.Old), diffValue(field.New))
+			if field.Rejected {
+				buf.WriteString(" (rejected, noupdate)")
+			}
+			buf.WriteString("\n")
+		}
+	}
+	return buf.String()
+}
+
+// DiffConfigs compares the entries of two Configs field by field. Missing
+// fields equal their defaults and empty values, fields that the server
+// owns are ignored.
+func DiffConfigs(from, to *Config) (*Diff, error) {
+	schemas := newSchemas()
+
+	fromData, err := convert.EncodeToMap(from)
+	if err != nil {
+		return nil, err
+	}
+	toData, err := convert.EncodeToMap(to)
+	if err != nil {
+		return nil, err
+	}
+
+	diff := &Diff{}
+	for _, c := range configCollections(schemas) {
+		fromEntries := convert.ToMapInterface(fromData[c.name])
+		toEntries := convert.ToMapInterface(toData[c.name])
+
+		var names []string
+		for name := range fromEntries {
+			names = append(names, name)
+		}
+		for name := range toEntries {
+			if _, ok := fromEntries[name]; !ok {
+				names = append(names, name)
+			}
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			change := EntryChange{
+				Collection: c.name,
+				Name:       name,
+			}
+
+			fromEntry, inFrom := fromEntries[name].(map[string]interface{})
+			toEntry, inTo := toEntries[name].(map[string]interface{})
+			switch {
+			case !inFrom:
+				change.Type = ChangeAdded
+			case !inTo:
+				change.Type = ChangeRemoved
+			default:
+				change.Type = ChangeUpdated
+				change.Fields = diffFields(schemas, c.schema, fromEntry, toEntry, "", false)
+				if len(change.Fields) == 0 {
+					continue
+				}
+			}
+			diff.Changes = append(diff.Changes, change)
+		}
+	}
+
+	return diff, nil
+}
+
+func diffFields(schemas *types.Schemas, schema *types.Schema, from, to map[string]interface{}, prefix string, rejected bool) []FieldChange {
+	var names []string
+	for name := range schema.ResourceFields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var changes []FieldChange
+	for _, name := range names {
+		field := schema.ResourceFields[name]
+		if !field.Create && !field.Update {
+			continue
+		}
+
+		fromValue, toValue := withDefault(field, from[name]), withDefault(field, to[name])
+		changes = append(changes, diffValues(schemas, schema, field.Type, fromValue, toValue, prefix+name, rejected || !field.Update)...)
+	}
+	return changes
+}
+
+func diffValues(schemas *types.Schemas, schema *types.Schema, fieldType string, from, to interface{}, path string, rejected bool) []FieldChange {
+	fromMap, fromIsMap := from.(map[string]interface{})
+	toMap, toIsMap := to.(map[string]interface{})
+
+	if definition.IsMapType(fieldType) && (fromIsMap || isEmpty(from)) && (toIsMap || isEmpty(to)) {
+		keys := map[string]bool{}
+		for key := range fromMap {
+			keys[key] = true
+		}
+		for key := range toMap {
+			keys[key] = true
+		}
+		var sorted []string
+		for key := range keys {
+			sorted = append(sorted, key)
+		}
+		sort.Strings(sorted)
+
+		var changes []FieldChange
+		for _, key := range sorted {
+			changes = append(changes, diffValues(schemas, schema, definition.SubType(fieldType), fromMap[key], toMap[key], path+"."+key, rejected)...)
+		}
+		return changes
+	}
+
+	if subSchema := schemas.Schema(&schema.Version, fieldType); subSchema != nil &&
+		(fromIsMap || isEmpty(from)) && (toIsMap || isEmpty(to)) {
+		return diffFields(schemas, subSchema, fromMap, toMap, path+".", rejected)
+	}
+
+	if equal(from, to) {
+		return nil
+	}
+	return []FieldChange{{
+		Path:     path,
+		Old:      from,
+		New:      to,
+		Rejected: rejected,
+	}}
+}
+
+func withDefault(field types.Field, value interface{}) interface{} {
+	if isEmpty(value) && field.Default != nil {
+		return field.Default
+	}
+	return value
+}
+
+// equal compares the json of the values, it does not tell apart the types
+// of numbers and nil from empty values.
+func equal(a, b interface{}) bool {
+	if isEmpty(a) && isEmpty(b) {
+		return true
+	}
+	aJSON, aErr := json.Marshal(a)
+	bJSON, bErr := json.Marshal(b)
+	return aErr == nil && bErr == nil && bytes.Equal(aJSON, bJSON)
+}
+
+func diffValue(value interface{}) string {
+	if isEmpty(value) {
+		return "<none>"
+	}
+	content, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return strings.TrimSpace(string(content))
+}
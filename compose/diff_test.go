@@ -0,0 +1,68 @@
+package compose
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	managementClient "github.com/rancher/types/client/management/v3"
+)
+
+func TestDiffConfigs(t *testing.T) {
+	from := &Config{
+		NodePools: map[string]managementClient.NodePool{
+			"workers": {ClusterID: "prod", NodeTemplateID: "large"},
+		},
+		Projects: map[string]managementClient.Project{
+			"web": {Name: "web", ClusterID: "prod", Labels: map[string]string{"team": "a"}},
+			"old": {Name: "old", ClusterID: "prod"},
+		},
+		ClusterAlertRules: map[string]managementClient.ClusterAlertRule{
+			"nodes": {ClusterID: "prod", GroupID: "ops"},
+		},
+	}
+	to := &Config{
+		NodePools: map[string]managementClient.NodePool{
+			"workers": {ClusterID: "dev", NodeTemplateID: "large"},
+		},
+		Projects: map[string]managementClient.Project{
+			"web": {Name: "web", ClusterID: "prod", Description: "site", Labels: map[string]string{"team": "b"}},
+			"api": {Name: "api", ClusterID: "prod"},
+		},
+		ClusterAlertRules: map[string]managementClient.ClusterAlertRule{
+			"nodes": {ClusterID: "prod", GroupID: "ops", Severity: "critical", RepeatIntervalSeconds: 3600},
+		},
+	}
+
+	diff, err := DiffConfigs(from, to)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `~ nodePools/workers
+    clusterId: "prod" -> "dev" (rejected, noupdate)
++ projects/api
+- projects/old
+~ projects/web
+    description: <none> -> "site"
+    labels.team: "a" -> "b"
+`
+	if diff.String() != expected {
+		t.Errorf("expected\n%s\ngot\n%s", expected, diff.String())
+	}
+	if !diff.Changes[0].Rejected() || diff.Changes[3].Rejected() {
+		t.Error("expected only the change of the node pool to be rejected")
+	}
+
+	content, err := json.Marshal(diff)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(content), `{"collection":"projects","name":"api","type":"added"}`) {
+		t.Errorf("expected api to be added in the json, got %s", content)
+	}
+
+	if diff, err := DiffConfigs(to, to); err != nil || !diff.Empty() {
+		t.Errorf("expected no changes, got %v %v", diff, err)
+	}
+}
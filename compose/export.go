@@ -0,0 +1,348 @@
+package compose
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/rancher/norman/types"
+	"github.com/rancher/norman/types/convert"
+	"github.com/rancher/norman/types/definition"
+	managementv3 "github.com/rancher/types/apis/management.cattle.io/v3"
+	managementSchema "github.com/rancher/types/apis/management.cattle.io/v3/schema"
+	projectv3 "github.com/rancher/types/apis/project.cattle.io/v3"
+	projectSchema "github.com/rancher/types/apis/project.cattle.io/v3/schema"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// Redacted replaces the values of password fields in exported documents.
+const Redacted = "REDACTED"
+
+var (
+	// serverOwned are the kinds in the namespaces of clusters and projects
+	// that rancher creates itself, replaying them would duplicate them.
+	serverOwned = map[string]bool{
+		"appRevision":              true,
+		"clusterMonitorGraph":      true,
+		"clusterRegistrationToken": true,
+		"etcdBackup":               true,
+		"node":                     true,
+		"pipelineExecution":        true,
+		"projectMonitorGraph":      true,
+		"sourceCodeCredential":     true,
+		"sourceCodeRepository":     true,
+	}
+
+	serverOwnedLabels = []string{
+		"cattle.io/creator",
+	}
+
+	serverOwnedAnnotations = []string{
+		"field.cattle.io/creatorId",
+		"lifecycle.cattle.io/create.",
+	}
+)
+
+// Exporter captures the objects of a cluster or a project in a Config that
+// the Planner can apply elsewhere.
+type Exporter struct {
+	management managementv3.Interface
+	project    projectv3.Interface
+	schemas    *types.Schemas
+	// the namespaced collections of the management and project api
+	collections []collection
+}
+
+func NewExporter(management managementv3.Interface, project projectv3.Interface) *Exporter {
+	schemas := newSchemas()
+
+	var collections []collection
+	for _, c := range configCollections(schemas) {
+		path := c.schema.Version.Path
+		if (path == managementSchema.Version.Path || path == projectSchema.Version.Path) &&
+			c.schema.Scope == types.NamespaceScope && !serverOwned[c.schema.ID] {
+			collections = append(collections, c)
+		}
+	}
+
+	return &Exporter{
+		management:  management,
+		project:     project,
+		schemas:     schemas,
+		collections: collections,
+	}
+}
+
+type exported struct {
+	collection collection
+	id         string
+	data       map[string]interface{}
+}
+
+// ExportCluster returns the cluster clusterID with the objects in its
+// namespace, its projects included, and their subtrees.
+func (e *Exporter) ExportCluster(clusterID string) (*Config, error) {
+	cluster, err := e.management.Clusters("").Get(clusterID, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	obj, err := e.export(e.collectionFor("cluster"), cluster)
+	if err != nil {
+		return nil, err
+	}
+	objects := []exported{obj}
+
+	namespaced, err := e.exportNamespace(clusterID)
+	if err != nil {
+		return nil, err
+	}
+	objects = append(objects, namespaced...)
+
+	for _, obj := range namespaced {
+		if obj.collection.schema.ID != "project" {
+			continue
+		}
+		projectObjects, err := e.exportNamespace(strings.TrimPrefix(obj.id, clusterID+":"))
+		if err != nil {
+			return nil, err
+		}
+		objects = append(objects, projectObjects...)
+	}
+
+	return e.config(objects)
+}
+
+// ExportProject returns the project projectID, in the form
+// <cluster>:<project>, with the objects in its namespace.
+func (e *Exporter) ExportProject(projectID string) (*Config, error) {
+	parts := strings.SplitN(projectID, ":", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid project id %s", projectID)
+	}
+
+	project, err := e.management.Projects(parts[0]).Get(parts[1], metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	obj, err := e.export(e.collectionFor("project"), project)
+	if err != nil {
+		return nil, err
+	}
+
+	namespaced, err := e.exportNamespace(parts[1])
+	if err != nil {
+		return nil, err
+	}
+
+	return e.config(append([]exported{obj}, namespaced...))
+}
+
+func (e *Exporter) exportNamespace(namespace string) ([]exported, error) {
+	var result []exported
+	for _, c := range e.collections {
+		client := interface{}(e.management)
+		if c.schema.Version.Path == projectSchema.Version.Path {
+			client = e.project
+		}
+
+		objs, err := list(client, c.schema, namespace)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, obj := range objs {
+			exported, err := e.export(c, obj)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, exported)
+		}
+	}
+	return result, nil
+}
+
+// list calls List on the client of the kind of schema, the generated
+// interfaces name their getters after the plural code name.
+func list(client interface{}, schema *types.Schema, namespace string) ([]runtime.Object, error) {
+	getter := reflect.ValueOf(client).MethodByName(schema.CodeNamePlural)
+	if !getter.IsValid() {
+		return nil, nil
+	}
+
+	kindClient := getter.Call([]reflect.Value{reflect.ValueOf(namespace)})[0]
+	out := kindClient.MethodByName("List").Call([]reflect.Value{reflect.ValueOf(metav1.ListOptions{})})
+	if err, _ := out[1].Interface().(error); err != nil {
+		return nil, err
+	}
+
+	var objs []runtime.Object
+	items := out[0].Elem().FieldByName("Items")
+	for i := 0; i < items.Len(); i++ {
+		if obj, ok := items.Index(i).Addr().Interface().(runtime.Object); ok {
+			objs = append(objs, obj)
+		}
+	}
+	return objs, nil
+}
+
+func (e *Exporter) collectionFor(schemaID string) collection {
+	return collection{
+		name:   e.schemas.Schema(&managementSchema.Version, schemaID).PluralName,
+		schema: e.schemas.Schema(&managementSchema.Version, schemaID),
+	}
+}
+
+// export returns obj as the api returns it.
+func (e *Exporter) export(c collection, obj runtime.Object) (exported, error) {
+	data, err := convert.EncodeToMap(obj)
+	if err != nil {
+		return exported{}, err
+	}
+	if c.schema.Mapper != nil {
+		c.schema.Mapper.FromInternal(data)
+	}
+	return exported{
+		collection: c,
+		id:         convert.ToString(data["id"]),
+		data:       data,
+	}, nil
+}
+
+func (e *Exporter) config(objects []exported) (*Config, error) {
+	sort.SliceStable(objects, func(i, j int) bool {
+		return objects[i].collection.name < objects[j].collection.name ||
+			objects[i].collection.name == objects[j].collection.name && objects[i].id < objects[j].id
+	})
+
+	// entries are named after the name of their object while it is unique
+	names := map[Key]string{}
+	taken := map[Key]bool{}
+	for _, obj := range objects {
+		name := convert.ToString(obj.data["name"])
+		if name == "" || taken[Key{Type: obj.collection.schema.ID, Name: name}] {
+			name = obj.id
+		}
+		taken[Key{Type: obj.collection.schema.ID, Name: name}] = true
+		names[Key{Type: obj.collection.schema.ID, Name: obj.id}] = name
+	}
+
+	collections := map[string]interface{}{}
+	for _, obj := range objects {
+		schema := obj.collection.schema
+		delete(obj.data, "namespaceId")
+		e.clean(schema, obj.data)
+
+		var errs []error
+		walkReferences(e.schemas, schema, obj.data, nil, func(refType, value string, path []string) {
+			target := e.schemas.Schema(&schema.Version, refType)
+			if target == nil {
+				return
+			}
+			if name, ok := names[Key{Type: target.ID, Name: value}]; ok {
+				if err := setPath(obj.data, path, name); err != nil {
+					errs = append(errs, err)
+				}
+			}
+		})
+		if err := types.NewErrors(errs...); err != nil {
+			return nil, err
+		}
+
+		entries, _ := collections[obj.collection.name].(map[string]interface{})
+		if entries == nil {
+			entries = map[string]interface{}{}
+			collections[obj.collection.name] = entries
+		}
+		entries[names[Key{Type: schema.ID, Name: obj.id}]] = obj.data
+	}
+
+	config := &Config{}
+	return config, convert.ToObj(collections, config)
+}
+
+// clean drops the fields of data that the server owns and the empty ones and
+// redacts passwords.
+func (e *Exporter) clean(schema *types.Schema, data map[string]interface{}) {
+	for name, value := range data {
+		field, ok := schema.ResourceFields[name]
+		if !ok || (!field.Create && !field.Update) {
+			delete(data, name)
+			continue
+		}
+
+		switch name {
+		case "labels":
+			value = withoutKeys(value, serverOwnedLabels)
+		case "annotations":
+			value = withoutKeys(value, serverOwnedAnnotations)
+		}
+
+		if field.Type == "password" && convert.ToString(value) != "" {
+			value = Redacted
+		} else {
+			value = e.cleanValue(schema, field.Type, value)
+		}
+
+		if isEmpty(value) {
+			delete(data, name)
+		} else {
+			data[name] = value
+		}
+	}
+}
+
+func (e *Exporter) cleanValue(schema *types.Schema, fieldType string, value interface{}) interface{} {
+	switch {
+	case definition.IsArrayType(fieldType):
+		if items, ok := value.([]interface{}); ok {
+			for i, item := range items {
+				items[i] = e.cleanValue(schema, definition.SubType(fieldType), item)
+			}
+		}
+	case definition.IsMapType(fieldType):
+		if data, ok := value.(map[string]interface{}); ok {
+			for key, item := range data {
+				data[key] = e.cleanValue(schema, definition.SubType(fieldType), item)
+			}
+		}
+	default:
+		subSchema := e.schemas.Schema(&schema.Version, fieldType)
+		if data, ok := value.(map[string]interface{}); ok && subSchema != nil {
+			e.clean(subSchema, data)
+		}
+	}
+	return value
+}
+
+func withoutKeys(value interface{}, prefixes []string) interface{} {
+	data, ok := value.(map[string]interface{})
+	if !ok {
+		return value
+	}
+	for key := range data {
+		for _, prefix := range prefixes {
+			if strings.HasPrefix(key, prefix) {
+				delete(data, key)
+			}
+		}
+	}
+	return data
+}
+
+func isEmpty(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case map[string]interface{}:
+		return len(v) == 0
+	case []interface{}:
+		return len(v) == 0
+	}
+	return false
+}
@@ -0,0 +1,82 @@
+package compose
+
+import (
+	"testing"
+
+	managementv3 "github.com/rancher/types/apis/management.cattle.io/v3"
+	managementfakes "github.com/rancher/types/apis/management.cattle.io/v3/fakes"
+	projectv3 "github.com/rancher/types/apis/project.cattle.io/v3"
+	projectfakes "github.com/rancher/types/apis/project.cattle.io/v3/fakes"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestExportCluster(t *testing.T) {
+	management := managementfakes.NewClientset(
+		&managementv3.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "c-1"},
+			Spec:       managementv3.ClusterSpec{DisplayName: "prod"},
+		},
+		&managementv3.Project{
+			ObjectMeta: metav1.ObjectMeta{Name: "p-1", Namespace: "c-1", Annotations: map[string]string{"field.cattle.io/creatorId": "u-1"}},
+			Spec:       managementv3.ProjectSpec{DisplayName: "web", ClusterName: "c-1"},
+		},
+		&managementv3.ProjectRoleTemplateBinding{
+			ObjectMeta:       metav1.ObjectMeta{Name: "prtb-1", Namespace: "p-1"},
+			ProjectName:      "c-1:p-1",
+			RoleTemplateName: "project-owner",
+			UserName:         "u-1",
+		},
+		&managementv3.Notifier{
+			ObjectMeta: metav1.ObjectMeta{Name: "n-1", Namespace: "c-1"},
+			Spec: managementv3.NotifierSpec{
+				ClusterName:  "c-1",
+				DisplayName:  "ops",
+				WechatConfig: &managementv3.WechatConfig{Secret: "secret", Agent: "agent"},
+			},
+		},
+		&managementv3.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: "m-1", Namespace: "c-1"},
+		},
+	)
+	project := projectfakes.NewClientset(&projectv3.App{
+		ObjectMeta: metav1.ObjectMeta{Name: "wordpress", Namespace: "p-1"},
+		Spec:       projectv3.AppSpec{ProjectName: "c-1:p-1", TargetNamespace: "wordpress"},
+	})
+
+	config, err := NewExporter(management, project).ExportCluster("c-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := config.Clusters["prod"]; !ok || len(config.Clusters) != 1 {
+		t.Errorf("expected the cluster prod, got %v", config.Clusters)
+	}
+	web, ok := config.Projects["web"]
+	if !ok || web.ClusterID != "prod" || web.ID != "" || web.CreatorID != "" || web.NamespaceId != "" {
+		t.Errorf("expected the project web in prod without server fields, got %+v", config.Projects)
+	}
+	binding, ok := config.ProjectRoleTemplateBindings["prtb-1"]
+	if !ok || binding.ProjectID != "web" || binding.RoleTemplateID != "project-owner" || binding.UserID != "u-1" {
+		t.Errorf("expected the binding to reference web, got %+v", config.ProjectRoleTemplateBindings)
+	}
+	notifier, ok := config.Notifiers["ops"]
+	if !ok || notifier.WechatConfig == nil || notifier.WechatConfig.Secret != Redacted || notifier.ClusterID != "prod" {
+		t.Errorf("expected the notifier secret to be redacted, got %+v", config.Notifiers)
+	}
+	if app, ok := config.Apps["wordpress"]; !ok || app.ProjectID != "web" || app.TargetNamespace != "wordpress" {
+		t.Errorf("expected the app wordpress in web, got %+v", config.Apps)
+	}
+	if len(config.Nodes) != 0 {
+		t.Errorf("expected the nodes to be left to the server, got %v", config.Nodes)
+	}
+
+	plan, err := NewPlanner(&resolver{
+		existing: map[string]bool{"roleTemplate/project-owner": true, "user/u-1": true},
+	}).Plan(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(plan.Steps) != 5 || plan.Steps[0].Key != (Key{Type: "cluster", Name: "prod"}) {
+		t.Errorf("expected the export to plan from the cluster, got %v", plan.Steps)
+	}
+}
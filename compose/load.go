@@ -0,0 +1,397 @@
+package compose
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/rancher/norman/types"
+	"github.com/rancher/norman/types/convert"
+	"github.com/rancher/norman/types/definition"
+)
+
+// patchDelete removes the item of a list with the same merge key when set as
+// $patch of an item in an overlay, as in strategic merge patches.
+const patchDelete = "delete"
+
+// mergeKeys are the fields that identify the items of the lists of a kind.
+// Overlays merge items with the same values of the keys and append the
+// others. Lists of kinds with a name are merged by name, all other lists
+// are replaced.
+var mergeKeys = map[string][]string{
+	"answer": {"projectId", "clusterId"},
+	"member": {"userPrincipalId", "groupPrincipalId"},
+	"target": {"projectId"},
+}
+
+// Loader reads compose documents. Variables are written ${VAR}, ${VAR:-default}
+// for a default when unset or empty or ${VAR-default} for a default when
+// unset, $$ is a literal $. They are substituted in the string values of the
+// documents after they are parsed, as in docker-compose.
+type Loader struct {
+	// Lookup returns the value of a variable, os.LookupEnv when nil
+	Lookup func(name string) (string, bool)
+}
+
+// MapLookup returns a Lookup for the variables of vars.
+func MapLookup(vars map[string]string) func(name string) (string, bool) {
+	return func(name string) (string, bool) {
+		value, ok := vars[name]
+		return value, ok
+	}
+}
+
+// LoadFiles loads the documents of the files base and overlays.
+func (l *Loader) LoadFiles(base string, overlays ...string) (*Config, error) {
+	var documents [][]byte
+	for _, file := range append([]string{base}, overlays...) {
+		content, err := ioutil.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		documents = append(documents, content)
+	}
+
+	names := append([]string{base}, overlays...)
+	return l.load(documents, names)
+}
+
+// Load returns the Config of the document base with the overlays merged into
+// it in order. Entries are merged by collection and name, a null entry or
+// field removes it. The merged document is validated against the schemas.
+func (l *Loader) Load(base []byte, overlays ...[]byte) (*Config, error) {
+	names := []string{"base"}
+	for i := range overlays {
+		names = append(names, fmt.Sprintf("overlay %d", i+1))
+	}
+	return l.load(append([][]byte{base}, overlays...), names)
+}
+
+func (l *Loader) load(documents [][]byte, names []string) (*Config, error) {
+	schemas := newSchemas()
+	collections := map[string]*types.Schema{}
+	for _, c := range configCollections(schemas) {
+		collections[c.name] = c.schema
+	}
+
+	var merged map[string]interface{}
+	for i, document := range documents {
+		data, err := l.parse(document)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", names[i], err)
+		}
+		if merged == nil {
+			merged = data
+			continue
+		}
+		mergeDocuments(schemas, collections, merged, data)
+	}
+
+	cast(schemas, collections, merged)
+	if err := validate(schemas, collections, merged).ToAggregate(); err != nil {
+		return nil, err
+	}
+
+	config := &Config{}
+	return config, convert.ToObj(merged, config)
+}
+
+func (l *Loader) parse(document []byte) (map[string]interface{}, error) {
+	lookup := l.Lookup
+	if lookup == nil {
+		lookup = os.LookupEnv
+	}
+
+	jsonContent, err := yaml.YAMLToJSON(document)
+	if err != nil {
+		return nil, err
+	}
+
+	data := map[string]interface{}{}
+	dec := json.NewDecoder(bytes.NewReader(jsonContent))
+	dec.UseNumber()
+	if err := dec.Decode(&data); err != nil {
+		return nil, err
+	}
+
+	missing := map[string]bool{}
+	if _, err := interpolate(data, lookup, missing); err != nil {
+		return nil, err
+	}
+	if len(missing) > 0 {
+		var names []string
+		for name := range missing {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		return nil, fmt.Errorf("variables %s are not set", strings.Join(names, ", "))
+	}
+	return data, nil
+}
+
+// interpolate substitutes the variables of the string values of value, the
+// names of unset variables are added to missing. The values stay strings,
+// cast converts them for the fields that are numbers or booleans.
+func interpolate(value interface{}, lookup func(string) (string, bool), missing map[string]bool) (interface{}, error) {
+	switch value := value.(type) {
+	case string:
+		return interpolateString(value, lookup, missing)
+	case map[string]interface{}:
+		for key, item := range value {
+			result, err := interpolate(item, lookup, missing)
+			if err != nil {
+				return nil, err
+			}
+			value[key] = result
+		}
+	case []interface{}:
+		for i, item := range value {
+			result, err := interpolate(item, lookup, missing)
+			if err != nil {
+				return nil, err
+			}
+			value[i] = result
+		}
+	}
+	return value, nil
+}
+
+func interpolateString(content string, lookup func(string) (string, bool), missing map[string]bool) (string, error) {
+	var result strings.Builder
+
+	for i := 0; i < len(content); i++ {
+		c := content[i]
+		if c != '$' || i+1 == len(content) {
+			result.WriteByte(c)
+			continue
+		}
+
+		switch content[i+1] {
+		case '$':
+			result.WriteByte('$')
+			i++
+			continue
+		case '{':
+		default:
+			result.WriteByte(c)
+			continue
+		}
+
+		end := strings.IndexByte(content[i:], '}')
+		if end < 0 {
+			return "", fmt.Errorf("unterminated variable %s", content[i:])
+		}
+		expr := content[i+2 : i+end]
+		i += end
+
+		name, def, hasDefault, defaultEmpty := expr, "", false, false
+		if j := strings.Index(expr, ":-"); j >= 0 {
+			name, def, hasDefault, defaultEmpty = expr[:j], expr[j+2:], true, true
+		} else if j := strings.IndexByte(expr, '-'); j >= 0 {
+			name, def, hasDefault = expr[:j], expr[j+1:], true
+		}
+		if name == "" {
+			return "", fmt.Errorf("invalid variable ${%s}", expr)
+		}
+
+		value, ok := lookup(name)
+		switch {
+		case ok && (value != "" || !defaultEmpty):
+			result.WriteString(value)
+		case hasDefault:
+			result.WriteString(def)
+		default:
+			missing[name] = true
+		}
+	}
+
+	return result.String(), nil
+}
+
+// cast converts the strings of number and boolean fields, such as
+// substituted variables, to the type of the field.
+func cast(schemas *types.Schemas, collections map[string]*types.Schema, data map[string]interface{}) {
+	for name, value := range data {
+		schema, ok := collections[name]
+		entries, isMap := value.(map[string]interface{})
+		if !ok || !isMap {
+			continue
+		}
+		for _, entry := range entries {
+			if entryData, ok := entry.(map[string]interface{}); ok {
+				castFields(schemas, schema, entryData)
+			}
+		}
+	}
+}
+
+func castFields(schemas *types.Schemas, schema *types.Schema, data map[string]interface{}) {
+	for name, value := range data {
+		if field, ok := schema.ResourceFields[name]; ok {
+			data[name] = castValue(schemas, schema, field.Type, value)
+		}
+	}
+}
+
+func castValue(schemas *types.Schemas, schema *types.Schema, fieldType string, value interface{}) interface{} {
+	switch value := value.(type) {
+	case []interface{}:
+		if definition.IsArrayType(fieldType) {
+			for i, item := range value {
+				value[i] = castValue(schemas, schema, definition.SubType(fieldType), item)
+			}
+		}
+	case map[string]interface{}:
+		if definition.IsMapType(fieldType) {
+			for key, item := range value {
+				value[key] = castValue(schemas, schema, definition.SubType(fieldType), item)
+			}
+		} else if subSchema := schemas.Schema(&schema.Version, fieldType); subSchema != nil {
+			castFields(schemas, subSchema, value)
+		}
+	case string:
+		switch fieldType {
+		case "int", "float":
+			if _, err := strconv.ParseFloat(value, 64); err == nil {
+				return json.Number(value)
+			}
+		case "boolean":
+			if b, err := strconv.ParseBool(value); err == nil {
+				return b
+			}
+		}
+	}
+	return value
+}
+
+func mergeDocuments(schemas *types.Schemas, collections map[string]*types.Schema, base, overlay map[string]interface{}) {
+	for name, value := range overlay {
+		schema, ok := collections[name]
+		entries, isMap := value.(map[string]interface{})
+		baseEntries, baseIsMap := base[name].(map[string]interface{})
+		if !ok || !isMap || !baseIsMap {
+			setOrDelete(base, name, value)
+			continue
+		}
+
+		for entryName, entry := range entries {
+			entryData, isMap := entry.(map[string]interface{})
+			baseEntry, baseIsMap := baseEntries[entryName].(map[string]interface{})
+			if isMap && baseIsMap {
+				mergeFields(schemas, schema, baseEntry, entryData)
+			} else {
+				setOrDelete(baseEntries, entryName, entry)
+			}
+		}
+	}
+}
+
+func mergeFields(schemas *types.Schemas, schema *types.Schema, base, overlay map[string]interface{}) {
+	for name, value := range overlay {
+		field, ok := schema.ResourceFields[name]
+		if !ok || value == nil {
+			setOrDelete(base, name, value)
+			continue
+		}
+		base[name] = mergeValue(schemas, schema, field.Type, base[name], value)
+	}
+}
+
+func mergeValue(schemas *types.Schemas, schema *types.Schema, fieldType string, base, overlay interface{}) interface{} {
+	baseMap, baseIsMap := base.(map[string]interface{})
+	overlayMap, overlayIsMap := overlay.(map[string]interface{})
+	baseList, baseIsList := base.([]interface{})
+	overlayList, overlayIsList := overlay.([]interface{})
+
+	switch {
+	case definition.IsMapType(fieldType) && baseIsMap && overlayIsMap:
+		for key, value := range overlayMap {
+			if value == nil {
+				delete(baseMap, key)
+				continue
+			}
+			baseMap[key] = mergeValue(schemas, schema, definition.SubType(fieldType), baseMap[key], value)
+		}
+		return baseMap
+	case definition.IsArrayType(fieldType) && baseIsList && overlayIsList:
+		subSchema := schemas.Schema(&schema.Version, definition.SubType(fieldType))
+		if keys := listMergeKeys(subSchema); len(keys) > 0 {
+			return mergeList(schemas, subSchema, keys, baseList, overlayList)
+		}
+	case baseIsMap && overlayIsMap:
+		if subSchema := schemas.Schema(&schema.Version, fieldType); subSchema != nil {
+			mergeFields(schemas, subSchema, baseMap, overlayMap)
+			return baseMap
+		}
+	}
+	return overlay
+}
+
+func listMergeKeys(schema *types.Schema) []string {
+	if schema == nil {
+		return nil
+	}
+	if keys, ok := mergeKeys[schema.ID]; ok {
+		return keys
+	}
+	if _, ok := schema.ResourceFields["name"]; ok {
+		return []string{"name"}
+	}
+	return nil
+}
+
+func mergeList(schemas *types.Schemas, schema *types.Schema, keys []string, base, overlay []interface{}) []interface{} {
+	result := append([]interface{}(nil), base...)
+	for _, item := range overlay {
+		itemData, ok := item.(map[string]interface{})
+		if !ok {
+			result = append(result, item)
+			continue
+		}
+		key := itemKey(keys, itemData)
+
+		patch := convert.ToString(itemData["$patch"])
+		delete(itemData, "$patch")
+
+		found := false
+		for i, baseItem := range result {
+			baseData, ok := baseItem.(map[string]interface{})
+			if !ok || itemKey(keys, baseData) != key {
+				continue
+			}
+			found = true
+			if patch == patchDelete {
+				result = append(result[:i], result[i+1:]...)
+			} else {
+				mergeFields(schemas, schema, baseData, itemData)
+			}
+			break
+		}
+		if !found && patch != patchDelete {
+			result = append(result, itemData)
+		}
+	}
+	return result
+}
+
+func itemKey(keys []string, data map[string]interface{}) string {
+	var parts []string
+	for _, key := range keys {
+		parts = append(parts, key+"="+convert.ToString(data[key]))
+	}
+	return strings.Join(parts, ",")
+}
+
+func setOrDelete(data map[string]interface{}, key string, value interface{}) {
+	if value == nil {
+		delete(data, key)
+	} else {
+		data[key] = value
+	}
+}
@@ -0,0 +1,123 @@
+package compose
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	managementClient "github.com/rancher/types/client/management/v3"
+)
+
+const (
+	baseDocument = `
+version: v3
+# the cluster is set with ${CLUSTER}, ${UNSET} is only in a comment
+projects:
+  web:
+    name: web
+    clusterId: ${CLUSTER}
+    description: ${DESCRIPTION:-the web project}
+  old:
+    name: old
+    clusterId: ${CLUSTER}
+multiClusterApps:
+  wordpress:
+    name: wordpress
+    templateVersionId: cattle-global-data:library-wordpress-7.3.8
+    roles:
+    - project-member
+    targets:
+    - projectId: c-1:p-1
+    - projectId: c-2:p-1
+    members:
+    - userPrincipalId: local://u-1
+      accessType: owner
+`
+	overlayDocument = `
+projects:
+  old: null
+  web:
+    description: costs $$${COST}
+clusterAlertRules:
+  nodes:
+    clusterId: ${CLUSTER}
+    groupId: ops
+    repeatIntervalSeconds: ${INTERVAL}
+    name: ${COST}
+multiClusterApps:
+  wordpress:
+    targets:
+    - projectId: c-2:p-1
+      $patch: delete
+    - projectId: c-3:p-1
+    members:
+    - userPrincipalId: local://u-1
+      accessType: member
+`
+)
+
+func TestLoad(t *testing.T) {
+	loader := &Loader{
+		Lookup: MapLookup(map[string]string{"CLUSTER": "prod", "COST": "5", "INTERVAL": "3600"}),
+	}
+
+	config, err := loader.Load([]byte(baseDocument), []byte(overlayDocument))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rule := config.ClusterAlertRules["nodes"]
+	if rule.RepeatIntervalSeconds != 3600 || rule.Name != "5" {
+		t.Errorf("expected the interval to be a number and the name a string, got %+v", rule)
+	}
+	if len(config.Projects) != 1 || config.Projects["web"].ClusterID != "prod" || config.Projects["web"].Description != "costs $5" {
+		t.Errorf("expected the overlay to patch web and remove old, got %+v", config.Projects)
+	}
+	app := config.MultiClusterApps["wordpress"]
+	expectedTargets := []managementClient.Target{{ProjectID: "c-1:p-1"}, {ProjectID: "c-3:p-1"}}
+	if !reflect.DeepEqual(app.Targets, expectedTargets) {
+		t.Errorf("expected targets %v, got %v", expectedTargets, app.Targets)
+	}
+	expectedMembers := []managementClient.Member{{UserPrincipalID: "local://u-1", AccessType: "member"}}
+	if !reflect.DeepEqual(app.Members, expectedMembers) {
+		t.Errorf("expected members %v, got %v", expectedMembers, app.Members)
+	}
+
+	config, err = loader.Load([]byte(baseDocument))
+	if err != nil || config.Projects["web"].Description != "the web project" {
+		t.Errorf("expected the default description, got %v %v", config, err)
+	}
+}
+
+func TestLoadErrors(t *testing.T) {
+	loader := &Loader{Lookup: MapLookup(nil)}
+	if _, err := loader.Load([]byte(baseDocument)); err == nil || !strings.Contains(err.Error(), "variables CLUSTER are not set") {
+		t.Errorf("expected CLUSTER to be missing, got %v", err)
+	}
+
+	_, err := loader.Load([]byte(`
+projects:
+  web:
+    clusterId: prod
+    colour: blue
+multiClusterApps:
+  wordpress:
+    name: wordpress
+    templateVersionId: library-wordpress-7.3.8
+    roles:
+    - project-member
+    targets:
+    - projectId: c-1:p-1
+    members:
+    - accessType: admin
+`))
+	for _, expected := range []string{
+		`projects[web].colour: Invalid value: "blue": unknown field`,
+		`projects[web].name: Required value`,
+		`multiClusterApps[wordpress].members[0].accessType: Unsupported value: "admin"`,
+	} {
+		if err == nil || !strings.Contains(err.Error(), expected) {
+			t.Errorf("expected %s, got %v", expected, err)
+		}
+	}
+}
@@ -0,0 +1,368 @@
+package compose
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/rancher/norman/types"
+	"github.com/rancher/norman/types/convert"
+)
+
+type Action string
+
+const (
+	ActionCreate Action = "create"
+	ActionUpdate Action = "update"
+)
+
+// Key identifies an entry of a Config by the schema ID of its collection and
+// its name in the collection.
+type Key struct {
+	Type string
+	Name string
+}
+
+func (k Key) String() string {
+	return k.Type + "/" + k.Name
+}
+
+// Resolver tells the Planner about the objects that already exist.
+type Resolver interface {
+	// ID returns the ID of the existing object the entry name of schema
+	// stands for, or "" if it still has to be created.
+	ID(schema *types.Schema, name string) (string, error)
+	// Exists reports if id is the ID of an existing object of schema.
+	// References that do not name an entry of the document have to be one.
+	Exists(schema *types.Schema, id string) (bool, error)
+}
+
+// Reference is a field of an entry that names another entry of the document
+// and has to be set to the ID of its object.
+type Reference struct {
+	// Path of the field in the object, indexes of arrays included
+	Path   []string
+	Target Key
+}
+
+type Step struct {
+	Action Action
+	Key    Key
+	Schema *types.Schema
+	// ID of the existing object, empty for creates
+	ID         string
+	Object     map[string]interface{}
+	References []Reference
+	DependsOn  []Key
+}
+
+// Plan is the list of steps to apply a Config, every step comes after the
+// steps of the entries it references.
+type Plan struct {
+	Steps []*Step
+	// IDs of the objects of the entries, known for the existing ones
+	IDs map[Key]string
+}
+
+// Apply runs apply on the steps in order. The object passed to apply has its
+// references set to IDs, the ID apply returns is used for later references
+// to the entry.
+func (p *Plan) Apply(apply func(step *Step, object map[string]interface{}) (string, error)) error {
+	for _, step := range p.Steps {
+		object, err := step.Resolve(p.IDs)
+		if err != nil {
+			return err
+		}
+		id, err := apply(step, object)
+		if err != nil {
+			return fmt.Errorf("failed to %s %s: %v", step.Action, step.Key, err)
+		}
+		if id == "" {
+			id = step.ID
+		}
+		p.IDs[step.Key] = id
+	}
+	return nil
+}
+
+// Resolve returns a copy of the object of the step with its references set to
+// the IDs of their entries.
+func (s *Step) Resolve(ids map[Key]string) (map[string]interface{}, error) {
+	object, _ := copyValue(s.Object).(map[string]interface{})
+	for _, ref := range s.References {
+		id, ok := ids[ref.Target]
+		if !ok || id == "" {
+			return nil, fmt.Errorf("%s references %s before it has an ID", s.Key, ref.Target)
+		}
+		if err := setPath(object, ref.Path, id); err != nil {
+			return nil, fmt.Errorf("%s: %v", s.Key, err)
+		}
+	}
+	return object, nil
+}
+
+type Planner struct {
+	schemas  *types.Schemas
+	resolver Resolver
+	// collections by the json name of their field in Config
+	collections map[string]*types.Schema
+}
+
+// NewPlanner returns a Planner for the schemas of the management, cluster and
+// project APIs. Without a resolver every entry is created and references
+// have to name entries of the document.
+func NewPlanner(resolver Resolver) *Planner {
+	schemas := newSchemas()
+
+	collections := map[string]*types.Schema{}
+	for _, c := range configCollections(schemas) {
+		collections[c.name] = c.schema
+	}
+
+	return &Planner{
+		schemas:     schemas,
+		resolver:    resolver,
+		collections: collections,
+	}
+}
+
+// Plan orders the entries of config so that every entry comes after the
+// entries it references. It fails on references to neither entries nor
+// existing objects and on reference cycles.
+func (p *Planner) Plan(config *Config) (*Plan, error) {
+	plan := &Plan{
+		IDs: map[Key]string{},
+	}
+
+	steps, err := p.steps(config, plan.IDs)
+	if err != nil {
+		return nil, err
+	}
+
+	byKey := map[Key]*Step{}
+	for _, step := range steps {
+		byKey[step.Key] = step
+	}
+
+	var errs []error
+	for _, step := range steps {
+		errs = append(errs, p.references(step, byKey)...)
+	}
+	if err := types.NewErrors(errs...); err != nil {
+		return nil, err
+	}
+
+	plan.Steps, err = order(steps, byKey)
+	return plan, err
+}
+
+func (p *Planner) steps(config *Config, ids map[Key]string) ([]*Step, error) {
+	var steps []*Step
+
+	configValue := reflect.ValueOf(config).Elem()
+	for i := 0; i < configValue.NumField(); i++ {
+		field := configValue.Type().Field(i)
+		schema := p.collections[jsonName(field)]
+		if schema == nil {
+			continue
+		}
+
+		entries := configValue.Field(i)
+		var names []string
+		for _, name := range entries.MapKeys() {
+			names = append(names, name.String())
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			object, err := convert.EncodeToMap(entries.MapIndex(reflect.ValueOf(name)).Interface())
+			if err != nil {
+				return nil, err
+			}
+
+			step := &Step{
+				Action: ActionCreate,
+				Key:    Key{Type: schema.ID, Name: name},
+				Schema: schema,
+				Object: object,
+			}
+			if p.resolver != nil {
+				step.ID, err = p.resolver.ID(schema, name)
+				if err != nil {
+					return nil, err
+				}
+			}
+			if step.ID != "" {
+				step.Action = ActionUpdate
+				ids[step.Key] = step.ID
+			}
+			steps = append(steps, step)
+		}
+	}
+
+	return steps, nil
+}
+
+func (p *Planner) references(step *Step, byKey map[Key]*Step) []error {
+	var errs []error
+	walkReferences(p.schemas, step.Schema, step.Object, nil, func(refType, value string, path []string) {
+		target := p.schemas.Schema(&step.Schema.Version, refType)
+		if target == nil {
+			return
+		}
+
+		key := Key{Type: target.ID, Name: value}
+		if _, ok := byKey[key]; ok {
+			step.References = append(step.References, Reference{Path: path, Target: key})
+			step.DependsOn = appendKey(step.DependsOn, key)
+			return
+		}
+
+		exists := false
+		if p.resolver != nil {
+			var err error
+			if exists, err = p.resolver.Exists(target, value); err != nil {
+				errs = append(errs, err)
+				return
+			}
+		}
+		if !exists {
+			errs = append(errs, fmt.Errorf("%s: %s references %s %q which is neither in the document nor an existing object",
+				step.Key, strings.Join(path, "."), target.ID, value))
+		}
+	})
+	return errs
+}
+
+// order sorts the steps topologically, keeping the document order among the
+// steps that are ready at the same time.
+func order(steps []*Step, byKey map[Key]*Step) ([]*Step, error) {
+	pending := map[Key]int{}
+	dependents := map[Key][]*Step{}
+	for _, step := range steps {
+		pending[step.Key] = len(step.DependsOn)
+		for _, dep := range step.DependsOn {
+			dependents[dep] = append(dependents[dep], step)
+		}
+	}
+
+	index := map[Key]int{}
+	for i, step := range steps {
+		index[step.Key] = i
+	}
+
+	var ready, result []*Step
+	for _, step := range steps {
+		if pending[step.Key] == 0 {
+			ready = append(ready, step)
+		}
+	}
+	for len(ready) > 0 {
+		sort.Slice(ready, func(i, j int) bool {
+			return index[ready[i].Key] < index[ready[j].Key]
+		})
+		step := ready[0]
+		ready = ready[1:]
+		result = append(result, step)
+		for _, dependent := range dependents[step.Key] {
+			pending[dependent.Key]--
+			if pending[dependent.Key] == 0 {
+				ready = append(ready, dependent)
+			}
+		}
+	}
+
+	if len(result) == len(steps) {
+		return result, nil
+	}
+	for _, step := range steps {
+		if pending[step.Key] > 0 {
+			if cycle := findCycle(step.Key, byKey, pending, nil); cycle != nil {
+				return nil, fmt.Errorf("reference cycle %s", strings.Join(cycle, " -> "))
+			}
+		}
+	}
+	return nil, fmt.Errorf("reference cycle among the entries")
+}
+
+func findCycle(key Key, byKey map[Key]*Step, pending map[Key]int, seen []Key) []string {
+	for i, k := range seen {
+		if k == key {
+			var cycle []string
+			for _, k := range append(seen[i:], key) {
+				cycle = append(cycle, k.String())
+			}
+			return cycle
+		}
+	}
+	seen = append(seen, key)
+	for _, dep := range byKey[key].DependsOn {
+		if pending[dep] == 0 {
+			continue
+		}
+		if cycle := findCycle(dep, byKey, pending, seen); cycle != nil {
+			return cycle
+		}
+	}
+	return nil
+}
+
+func setPath(data interface{}, path []string, value string) error {
+	for i, part := range path {
+		last := i == len(path)-1
+		switch v := data.(type) {
+		case map[string]interface{}:
+			if last {
+				v[part] = value
+				return nil
+			}
+			data = v[part]
+		case []interface{}:
+			index, err := strconv.Atoi(part)
+			if err != nil || index >= len(v) {
+				return fmt.Errorf("invalid index %s of %s", part, strings.Join(path, "."))
+			}
+			if last {
+				v[index] = value
+				return nil
+			}
+			data = v[index]
+		default:
+			return fmt.Errorf("no field %s", strings.Join(path, "."))
+		}
+	}
+	return nil
+}
+
+func copyValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		result := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			result[key] = copyValue(item)
+		}
+		return result
+	case []interface{}:
+		result := make([]interface{}, len(v))
+		for i, item := range v {
+			result[i] = copyValue(item)
+		}
+		return result
+	}
+	return value
+}
+
+func appendKey(keys []Key, key Key) []Key {
+	for _, k := range keys {
+		if k == key {
+			return keys
+		}
+	}
+	return append(keys, key)
+}
+
+func appendPath(path []string, part string) []string {
+	return append(append([]string(nil), path...), part)
+}
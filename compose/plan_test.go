@@ -0,0 +1,98 @@
+package compose
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/rancher/norman/types"
+	managementClient "github.com/rancher/types/client/management/v3"
+)
+
+type resolver struct {
+	ids      map[string]string
+	existing map[string]bool
+}
+
+func (r *resolver) ID(schema *types.Schema, name string) (string, error) {
+	return r.ids[schema.ID+"/"+name], nil
+}
+
+func (r *resolver) Exists(schema *types.Schema, id string) (bool, error) {
+	return r.existing[schema.ID+"/"+id], nil
+}
+
+func TestPlan(t *testing.T) {
+	config := &Config{
+		ProjectRoleTemplateBindings: map[string]managementClient.ProjectRoleTemplateBinding{
+			"owner": {ProjectID: "web", RoleTemplateID: "project-owner", UserID: "admin"},
+		},
+		Projects: map[string]managementClient.Project{
+			"web": {Name: "web", ClusterID: "prod"},
+		},
+		Clusters: map[string]managementClient.Cluster{
+			"prod": {Name: "prod"},
+		},
+		Users: map[string]managementClient.User{
+			"admin": {Username: "admin"},
+		},
+	}
+
+	plan, err := NewPlanner(&resolver{
+		ids:      map[string]string{"user/admin": "user-abc"},
+		existing: map[string]bool{"roleTemplate/project-owner": true},
+	}).Plan(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var order []string
+	for _, step := range plan.Steps {
+		order = append(order, string(step.Action)+" "+step.Key.String())
+	}
+	expected := []string{"create cluster/prod", "create project/web", "update user/admin", "create projectRoleTemplateBinding/owner"}
+	if !reflect.DeepEqual(order, expected) {
+		t.Errorf("expected %v, got %v", expected, order)
+	}
+
+	var bindings []map[string]interface{}
+	err = plan.Apply(func(step *Step, object map[string]interface{}) (string, error) {
+		if step.Key.Type == "projectRoleTemplateBinding" {
+			bindings = append(bindings, object)
+		}
+		return step.ID + "id-" + step.Key.Name, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(bindings) != 1 || bindings[0]["projectId"] != "id-web" ||
+		bindings[0]["userId"] != "user-abcid-admin" || bindings[0]["roleTemplateId"] != "project-owner" {
+		t.Errorf("expected the references to be resolved, got %v", bindings)
+	}
+	if config.ProjectRoleTemplateBindings["owner"].ProjectID != "web" {
+		t.Error("expected the config to be left alone")
+	}
+}
+
+func TestPlanErrors(t *testing.T) {
+	_, err := NewPlanner(nil).Plan(&Config{
+		Projects: map[string]managementClient.Project{
+			"web": {Name: "web", ClusterID: "local"},
+		},
+	})
+	if err == nil || !strings.Contains(err.Error(), `project/web: clusterId references cluster "local"`) {
+		t.Errorf("expected a dangling reference, got %v", err)
+	}
+
+	_, err = NewPlanner(nil).Plan(&Config{
+		ClusterTemplates: map[string]managementClient.ClusterTemplate{
+			"base": {DefaultRevisionID: "v1"},
+		},
+		ClusterTemplateRevisions: map[string]managementClient.ClusterTemplateRevision{
+			"v1": {ClusterTemplateID: "base"},
+		},
+	})
+	if err == nil || !strings.Contains(err.Error(), "reference cycle clusterTemplate/base -> clusterTemplateRevision/v1 -> clusterTemplate/base") {
+		t.Errorf("expected a reference cycle, got %v", err)
+	}
+}
@@ -0,0 +1,109 @@
+package compose
+
+import (
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/rancher/norman/types"
+	"github.com/rancher/norman/types/convert"
+	"github.com/rancher/norman/types/definition"
+	clusterSchema "github.com/rancher/types/apis/cluster.cattle.io/v3/schema"
+	managementSchema "github.com/rancher/types/apis/management.cattle.io/v3/schema"
+	projectSchema "github.com/rancher/types/apis/project.cattle.io/v3/schema"
+)
+
+// collection is a map of entries of Config.
+type collection struct {
+	// json name of the field in Config
+	name   string
+	schema *types.Schema
+}
+
+func newSchemas() *types.Schemas {
+	return types.NewSchemas().
+		AddSchemas(managementSchema.Schemas).
+		AddSchemas(clusterSchema.Schemas).
+		AddSchemas(projectSchema.Schemas)
+}
+
+// configCollections returns the collections of Config in the order of its
+// fields.
+func configCollections(schemas *types.Schemas) []collection {
+	versions := map[string]*types.APIVersion{
+		"management": &managementSchema.Version,
+		"cluster":    &clusterSchema.Version,
+		"project":    &projectSchema.Version,
+	}
+
+	var collections []collection
+	configType := reflect.TypeOf(Config{})
+	for i := 0; i < configType.NumField(); i++ {
+		field := configType.Field(i)
+		if field.Type.Kind() != reflect.Map {
+			continue
+		}
+		name := jsonName(field)
+		if schema := schemas.Schema(versions[clientAPI(field.Type.Elem())], name); schema != nil {
+			collections = append(collections, collection{name: name, schema: schema})
+		}
+	}
+	return collections
+}
+
+func walkReferences(schemas *types.Schemas, schema *types.Schema, data map[string]interface{}, path []string, f func(refType, value string, path []string)) {
+	var names []string
+	for name := range schema.ResourceFields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if value, ok := data[name]; ok {
+			walkReference(schemas, schema, schema.ResourceFields[name].Type, value, appendPath(path, name), f)
+		}
+	}
+}
+
+func walkReference(schemas *types.Schemas, schema *types.Schema, fieldType string, value interface{}, path []string, f func(refType, value string, path []string)) {
+	switch {
+	case definition.IsReferenceType(fieldType):
+		if s, ok := value.(string); ok && s != "" {
+			f(definition.SubType(fieldType), s, path)
+		}
+	case definition.IsArrayType(fieldType):
+		for i, item := range convert.ToInterfaceSlice(value) {
+			walkReference(schemas, schema, definition.SubType(fieldType), item, appendPath(path, strconv.Itoa(i)), f)
+		}
+	case definition.IsMapType(fieldType):
+		data := convert.ToMapInterface(value)
+		var keys []string
+		for key := range data {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			walkReference(schemas, schema, definition.SubType(fieldType), data[key], appendPath(path, key), f)
+		}
+	default:
+		subSchema := schemas.Schema(&schema.Version, fieldType)
+		if data, ok := value.(map[string]interface{}); ok && subSchema != nil {
+			walkReferences(schemas, subSchema, data, path, f)
+		}
+	}
+}
+
+func jsonName(field reflect.StructField) string {
+	return strings.Split(field.Tag.Get("json"), ",")[0]
+}
+
+// clientAPI returns the api of a client type, its package is
+// github.com/rancher/types/client/<api>/v3.
+func clientAPI(t reflect.Type) string {
+	parts := strings.Split(t.PkgPath(), "/")
+	if len(parts) < 2 {
+		return ""
+	}
+	return parts[len(parts)-2]
+}
@@ -0,0 +1,172 @@
+package compose
+
+import (
+	"encoding/json"
+	"sort"
+
+	"github.com/rancher/norman/types"
+	"github.com/rancher/norman/types/convert"
+	"github.com/rancher/norman/types/definition"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// validate checks the entries of a document against the rules of the fields
+// of their schemas, as the api checks the input of creates.
+func validate(schemas *types.Schemas, collections map[string]*types.Schema, data map[string]interface{}) field.ErrorList {
+	var allErrs field.ErrorList
+	for _, name := range sortedKeys(data) {
+		if name == "version" {
+			continue
+		}
+		schema, ok := collections[name]
+		if !ok {
+			allErrs = append(allErrs, field.NotSupported(field.NewPath(name), name, nil))
+			continue
+		}
+
+		entries, ok := data[name].(map[string]interface{})
+		if !ok {
+			allErrs = append(allErrs, field.Invalid(field.NewPath(name), data[name], "must be a map of entries"))
+			continue
+		}
+		for _, entryName := range sortedKeys(entries) {
+			fldPath := field.NewPath(name).Key(entryName)
+			entry, ok := entries[entryName].(map[string]interface{})
+			if !ok {
+				allErrs = append(allErrs, field.Invalid(fldPath, entries[entryName], "must be an object"))
+				continue
+			}
+			allErrs = append(allErrs, validateFields(schemas, schema, entry, fldPath)...)
+		}
+	}
+	return allErrs
+}
+
+func validateFields(schemas *types.Schemas, schema *types.Schema, data map[string]interface{}, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for _, name := range sortedKeys(data) {
+		if _, ok := schema.ResourceFields[name]; !ok {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child(name), data[name], "unknown field"))
+		}
+	}
+
+	var names []string
+	for name := range schema.ResourceFields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		f := schema.ResourceFields[name]
+		value, ok := data[name]
+		if !ok || value == nil {
+			// the namespaces of the management and project api are derived
+			// from the cluster or project of the object
+			if f.Required && f.Create && f.Default == nil && name != "namespaceId" {
+				allErrs = append(allErrs, field.Required(fldPath.Child(name), ""))
+			}
+			continue
+		}
+		allErrs = append(allErrs, validateValue(schemas, schema, f, f.Type, value, fldPath.Child(name))...)
+	}
+	return allErrs
+}
+
+func validateValue(schemas *types.Schemas, schema *types.Schema, f types.Field, fieldType string, value interface{}, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	switch {
+	case definition.IsArrayType(fieldType):
+		items, ok := value.([]interface{})
+		if !ok {
+			return append(allErrs, field.Invalid(fldPath, value, "must be a list"))
+		}
+		allErrs = append(allErrs, validateLength(f, len(items), value, fldPath)...)
+		itemField := f
+		itemField.MinLength, itemField.MaxLength = nil, nil
+		for i, item := range items {
+			allErrs = append(allErrs, validateValue(schemas, schema, itemField, definition.SubType(fieldType), item, fldPath.Index(i))...)
+		}
+		return allErrs
+	case definition.IsMapType(fieldType):
+		data, ok := value.(map[string]interface{})
+		if !ok {
+			return append(allErrs, field.Invalid(fldPath, value, "must be a map"))
+		}
+		for _, key := range sortedKeys(data) {
+			allErrs = append(allErrs, validateValue(schemas, schema, f, definition.SubType(fieldType), data[key], fldPath.Key(key))...)
+		}
+		return allErrs
+	}
+
+	switch fieldType {
+	case "int", "float":
+		number, ok := value.(json.Number)
+		if !ok {
+			return append(allErrs, field.Invalid(fldPath, value, "must be a number"))
+		}
+		if fieldType == "int" {
+			n, err := number.Int64()
+			if err != nil {
+				return append(allErrs, field.Invalid(fldPath, value, "must be an integer"))
+			}
+			if f.Min != nil && n < *f.Min {
+				allErrs = append(allErrs, field.Invalid(fldPath, n, "must be greater than or equal to "+convert.ToString(*f.Min)))
+			}
+			if f.Max != nil && n > *f.Max {
+				allErrs = append(allErrs, field.Invalid(fldPath, n, "must be less than or equal to "+convert.ToString(*f.Max)))
+			}
+		}
+		return allErrs
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			allErrs = append(allErrs, field.Invalid(fldPath, value, "must be a boolean"))
+		}
+		return allErrs
+	case "json", "intOrString":
+		return allErrs
+	}
+
+	if data, ok := value.(map[string]interface{}); ok {
+		if subSchema := schemas.Schema(&schema.Version, fieldType); subSchema != nil {
+			return validateFields(schemas, subSchema, data, fldPath)
+		}
+		return allErrs
+	}
+
+	s, ok := value.(string)
+	if !ok {
+		return allErrs
+	}
+	allErrs = append(allErrs, validateLength(f, len(s), value, fldPath)...)
+	if len(f.Options) > 0 && s != "" && !definition.IsReferenceType(fieldType) {
+		supported := false
+		for _, option := range f.Options {
+			supported = supported || option == s
+		}
+		if !supported {
+			allErrs = append(allErrs, field.NotSupported(fldPath, s, f.Options))
+		}
+	}
+	return allErrs
+}
+
+func validateLength(f types.Field, length int, value interface{}, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if f.MinLength != nil && int64(length) < *f.MinLength {
+		allErrs = append(allErrs, field.Invalid(fldPath, value, "must be at least "+convert.ToString(*f.MinLength)+" long"))
+	}
+	if f.MaxLength != nil && int64(length) > *f.MaxLength {
+		allErrs = append(allErrs, field.TooLong(fldPath, value, int(*f.MaxLength)))
+	}
+	return allErrs
+}
+
+func sortedKeys(data map[string]interface{}) []string {
+	var keys []string
+	for key := range data {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
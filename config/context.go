@@ -61,6 +61,10 @@ type ScaledContext struct {
 	RBAC       rbacv1.Interface
 	Core       corev1.Interface
 	Storage    storagev1.Interface
+
+	// metrics is set by EnableMetrics
+	metrics bool
+	tracker *controllerTracker
 }
 
 func (c *ScaledContext) controllers() []controller.Starter {
@@ -79,6 +83,9 @@ func (c *ScaledContext) NewManagementContext() (*ManagementContext, error) {
 	}
 	mgmt.Dialer = c.Dialer
 	mgmt.UserManager = c.UserManager
+	if c.metrics {
+		mgmt.measure()
+	}
 	return mgmt, nil
 }
 
@@ -137,12 +144,17 @@ func NewScaledContext(config rest.Config) (*ScaledContext, error) {
 		AddSchemas(clusterSchema.Schemas).
 		AddSchemas(projectSchema.Schemas)
 
+	context.TrackControllers()
 	return context, err
 }
 
 func (c *ScaledContext) Start(ctx context.Context) error {
 	logrus.Info("Starting API controllers")
-	return controller.SyncThenStart(ctx, 5, c.controllers()...)
+	if err := controller.SyncThenStart(ctx, 5, c.controllers()...); err != nil {
+		return err
+	}
+	c.tracker.started()
+	return nil
 }
 
 type ManagementContext struct {
@@ -162,6 +174,10 @@ type ManagementContext struct {
 	Project    projectv3.Interface
 	RBAC       rbacv1.Interface
 	Core       corev1.Interface
+
+	// metrics is set once the clients are measured
+	metrics bool
+	tracker *controllerTracker
 }
 
 func (c *ManagementContext) controllers() []controller.Starter {
@@ -196,6 +212,8 @@ type UserContext struct {
 	Istio          istiov1alpha3.Interface
 	Storage        storagev1.Interface
 	Policy         policyv1beta1.Interface
+
+	tracker *controllerTracker
 }
 
 func (w *UserContext) controllers() []controller.Starter {
@@ -237,6 +255,8 @@ func (w *UserContext) UserOnlyContext() *UserOnlyContext {
 		Istio:        w.Istio,
 		Storage:      w.Storage,
 		Policy:       w.Policy,
+
+		tracker: w.tracker,
 	}
 }
 
@@ -261,6 +281,8 @@ type UserOnlyContext struct {
 	Istio           istiov1alpha3.Interface
 	Storage         storagev1.Interface
 	Policy          policyv1beta1.Interface
+
+	tracker *controllerTracker
 }
 
 func (w *UserOnlyContext) controllers() []controller.Starter {
@@ -344,13 +366,18 @@ func NewManagementContext(config rest.Config) (*ManagementContext, error) {
 	managementv3.AddToScheme(context.Scheme)
 	projectv3.AddToScheme(context.Scheme)
 
+	context.TrackControllers()
 	return context, err
 }
 
 func (c *ManagementContext) Start(ctx context.Context) error {
 	logrus.Info("Starting management controllers")
 
-	return controller.SyncThenStart(ctx, 50, c.controllers()...)
+	if err := controller.SyncThenStart(ctx, 50, c.controllers()...); err != nil {
+		return err
+	}
+	c.tracker.started()
+	return nil
 }
 
 func NewUserContext(scaledContext *ScaledContext, config rest.Config, clusterName string) (*UserContext, error) {
@@ -460,6 +487,7 @@ func NewUserContext(scaledContext *ScaledContext, config rest.Config, clusterNam
 		return nil, err
 	}
 
+	context.TrackControllers()
 	return context, err
 }
 
@@ -467,7 +495,12 @@ func (w *UserContext) Start(ctx context.Context) error {
 	logrus.Info("Starting cluster controllers for ", w.ClusterName)
 	controllers := w.Management.controllers()
 	controllers = append(controllers, w.controllers()...)
-	return controller.SyncThenStart(ctx, 5, controllers...)
+	if err := controller.SyncThenStart(ctx, 5, controllers...); err != nil {
+		return err
+	}
+	w.Management.tracker.started()
+	w.tracker.started()
+	return nil
 }
 
 func NewUserOnlyContext(config rest.Config) (*UserOnlyContext, error) {
@@ -566,10 +599,15 @@ func NewUserOnlyContext(config rest.Config) (*UserOnlyContext, error) {
 		AddSchemas(clusterSchema.Schemas).
 		AddSchemas(projectSchema.Schemas)
 
+	context.TrackControllers()
 	return context, err
 }
 
 func (w *UserOnlyContext) Start(ctx context.Context) error {
 	logrus.Info("Starting workload controllers")
-	return controller.SyncThenStart(ctx, 5, w.controllers()...)
+	if err := controller.SyncThenStart(ctx, 5, w.controllers()...); err != nil {
+		return err
+	}
+	w.tracker.started()
+	return nil
 }
@@ -0,0 +1,26 @@
+package dialer
+
+import (
+	"net"
+)
+
+// Hop is a step of a dialer chain, it returns a dialer that connects through
+// parent.
+type Hop func(parent Dialer) (Dialer, error)
+
+// Direct dials without any tunnel.
+var Direct Dialer = net.Dial
+
+// Chain returns a dialer that connects through hops in order, the first hop
+// is dialed directly. Without hops it returns Direct.
+func Chain(hops ...Hop) (Dialer, error) {
+	d := Direct
+	for _, hop := range hops {
+		next, err := hop(d)
+		if err != nil {
+			return nil, err
+		}
+		d = next
+	}
+	return d, nil
+}
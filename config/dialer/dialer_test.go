@@ -0,0 +1,327 @@
+package dialer
+
+import (
+	"bufio"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/binary"
+	"encoding/pem"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/agent"
+)
+
+// listen serves the connections of a local listener with serve until the
+// listener is closed.
+func listen(t *testing.T, serve func(conn net.Conn)) net.Listener {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go serve(conn)
+		}
+	}()
+	return l
+}
+
+func echo(conn net.Conn) {
+	defer conn.Close()
+	io.Copy(conn, conn)
+}
+
+// sshServer serves direct-tcpip channels for clients with the key of
+// userKey, it counts the clients on logins. It returns the listener and the
+// host key of the server.
+func sshServer(t *testing.T, userKey ssh.PublicKey, logins chan<- string) (net.Listener, ssh.PublicKey) {
+	hostKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	hostSigner, err := ssh.NewSignerFromKey(hostKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	config := &ssh.ServerConfig{
+		PublicKeyCallback: func(meta ssh.ConnMetadata, key ssh.PublicKey) (*ssh.Permissions, error) {
+			if string(key.Marshal()) != string(userKey.Marshal()) {
+				return nil, io.EOF
+			}
+			logins <- meta.User()
+			return nil, nil
+		},
+	}
+	config.AddHostKey(hostSigner)
+
+	return listen(t, func(conn net.Conn) {
+		_, chans, reqs, err := ssh.NewServerConn(conn, config)
+		if err != nil {
+			return
+		}
+		go ssh.DiscardRequests(reqs)
+		for newChannel := range chans {
+			if newChannel.ChannelType() != "direct-tcpip" {
+				newChannel.Reject(ssh.UnknownChannelType, "unsupported")
+				continue
+			}
+			// host, port of the target come first in the payload
+			payload := newChannel.ExtraData()
+			hostLength := binary.BigEndian.Uint32(payload)
+			host := string(payload[4 : 4+hostLength])
+			port := binary.BigEndian.Uint32(payload[4+hostLength:])
+
+			target, err := net.Dial("tcp", net.JoinHostPort(host, strconv.Itoa(int(port))))
+			if err != nil {
+				newChannel.Reject(ssh.ConnectionFailed, err.Error())
+				continue
+			}
+			channel, requests, err := newChannel.Accept()
+			if err != nil {
+				target.Close()
+				continue
+			}
+			go ssh.DiscardRequests(requests)
+			go func() {
+				defer channel.Close()
+				defer target.Close()
+				go io.Copy(target, channel)
+				io.Copy(channel, target)
+			}()
+		}
+	}), hostSigner.PublicKey()
+}
+
+// httpProxy serves CONNECT requests.
+func httpProxy(t *testing.T, connects chan<- string) net.Listener {
+	return listen(t, func(conn net.Conn) {
+		defer conn.Close()
+		req, err := http.ReadRequest(bufio.NewReader(conn))
+		if err != nil || req.Method != http.MethodConnect {
+			return
+		}
+		connects <- req.Host
+		target, err := net.Dial("tcp", req.Host)
+		if err != nil {
+			io.WriteString(conn, "HTTP/1.1 502 Bad Gateway\r\n\r\n")
+			return
+		}
+		defer target.Close()
+		io.WriteString(conn, "HTTP/1.1 200 Connection established\r\n\r\n")
+		go io.Copy(target, conn)
+		io.Copy(conn, target)
+	})
+}
+
+func roundTrip(t *testing.T, d Dialer, address string) {
+	conn, err := d("tcp", address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	if _, err := io.WriteString(conn, "ping"); err != nil {
+		t.Fatal(err)
+	}
+	buf := make([]byte, 4)
+	if _, err := io.ReadFull(conn, buf); err != nil {
+		t.Fatal(err)
+	}
+	if string(buf) != "ping" {
+		t.Errorf("expected ping, got %q", buf)
+	}
+}
+
+func TestChain(t *testing.T) {
+	userKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	userSigner, err := ssh.NewSignerFromKey(userKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyPEM := pem.EncodeToMemory(&pem.Block{
+		Type:  "RSA PRIVATE KEY",
+		Bytes: x509.MarshalPKCS1PrivateKey(userKey),
+	})
+
+	logins := make(chan string, 10)
+	connects := make(chan string, 10)
+	echoServer := listen(t, echo)
+	defer echoServer.Close()
+	bastionServer, hostKey := sshServer(t, userSigner.PublicKey(), logins)
+	defer bastionServer.Close()
+	proxy := httpProxy(t, connects)
+	defer proxy.Close()
+
+	echoAddress := echoServer.Addr().String()
+	bastionHost, bastionPort, _ := net.SplitHostPort(bastionServer.Addr().String())
+
+	bastion := SSHConfig{
+		Address:         bastionHost,
+		Port:            bastionPort,
+		User:            "bastion",
+		SSHKey:          string(keyPEM),
+		HostKeyCallback: ssh.FixedHostKey(hostKey),
+	}
+
+	d, err := Chain(SSHHop(bastion))
+	if err != nil {
+		t.Fatal(err)
+	}
+	roundTrip(t, d, echoAddress)
+	if user := <-logins; user != "bastion" {
+		t.Errorf("expected a login of bastion, got %s", user)
+	}
+
+	factory := NewChainFactory()
+	factory.SetCluster("c-1", HTTPConnectHop(proxy.Addr().String(), "", ""), SSHHop(bastion))
+	d, err = factory.NodeDialer("c-1", "m-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	roundTrip(t, d, echoAddress)
+	if host := <-connects; host != net.JoinHostPort(bastionHost, bastionPort) {
+		t.Errorf("expected the proxy to connect to the bastion host, got %s", host)
+	}
+	<-logins
+	if _, err := factory.DockerDialer("c-1", "m-1"); err != nil {
+		t.Errorf("expected a docker dialer through the ssh host, got %v", err)
+	}
+
+	factory.SetMachine("c-1", "direct")
+	if _, err := factory.DockerDialer("c-1", "direct"); err == nil {
+		t.Error("expected no docker dialer without an ssh host")
+	}
+	factory.SetMachine("c-1", "proxied", SSHHop(bastion), HTTPConnectHop(proxy.Addr().String(), "", ""))
+	if _, err := factory.DockerDialer("c-1", "proxied"); err == nil {
+		t.Error("expected no docker dialer of a chain that ends with a proxy")
+	}
+
+	factory.RemoveCluster("c-1")
+	if _, err := factory.ClusterDialer("c-1"); err == nil {
+		t.Error("expected no dialer for a removed cluster")
+	}
+
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherSigner, err := ssh.NewSignerFromKey(otherKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	unknownHost := bastion
+	unknownHost.HostKeyCallback = ssh.FixedHostKey(otherSigner.PublicKey())
+	if d, err = Chain(SSHHop(unknownHost)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := d("tcp", echoAddress); err == nil {
+		t.Error("expected a host with an unknown key to fail")
+	}
+
+	noHostKey := bastion
+	noHostKey.HostKeyCallback = nil
+	if _, err := Chain(SSHHop(noHostKey)); err == nil {
+		t.Error("expected a hop without host key callback to fail")
+	}
+
+	bastion.SSHKey = ""
+	if _, err := Chain(SSHHop(bastion)); err == nil {
+		t.Error("expected a hop without credentials to fail")
+	}
+}
+
+func TestSSHAgent(t *testing.T) {
+	userKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	userSigner, err := ssh.NewSignerFromKey(userKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyring := agent.NewKeyring()
+	if err := keyring.Add(agent.AddedKey{PrivateKey: userKey}); err != nil {
+		t.Fatal(err)
+	}
+
+	dir, err := ioutil.TempDir("", "ssh-agent")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	agentListener, err := net.Listen("unix", filepath.Join(dir, "agent.sock"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer agentListener.Close()
+
+	// open counts the connections to the agent that were not closed yet
+	var open int32
+	go func() {
+		for {
+			conn, err := agentListener.Accept()
+			if err != nil {
+				return
+			}
+			atomic.AddInt32(&open, 1)
+			go func() {
+				defer atomic.AddInt32(&open, -1)
+				agent.ServeAgent(keyring, conn)
+			}()
+		}
+	}()
+
+	socket := os.Getenv("SSH_AUTH_SOCK")
+	defer os.Setenv("SSH_AUTH_SOCK", socket)
+	os.Setenv("SSH_AUTH_SOCK", agentListener.Addr().String())
+
+	logins := make(chan string, 10)
+	echoServer := listen(t, echo)
+	defer echoServer.Close()
+	sshListener, hostKey := sshServer(t, userSigner.PublicKey(), logins)
+	defer sshListener.Close()
+	host, port, _ := net.SplitHostPort(sshListener.Addr().String())
+
+	d, err := Chain(SSHHop(SSHConfig{
+		Address:         host,
+		Port:            port,
+		User:            "agent",
+		SSHAgentAuth:    true,
+		HostKeyCallback: ssh.FixedHostKey(hostKey),
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 3; i++ {
+		roundTrip(t, d, echoServer.Addr().String())
+		if user := <-logins; user != "agent" {
+			t.Errorf("expected a login of agent, got %s", user)
+		}
+	}
+
+	for i := 0; atomic.LoadInt32(&open) > 0; i++ {
+		if i == 100 {
+			t.Fatalf("expected the connections to the agent to be closed, %d are open", atomic.LoadInt32(&open))
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
@@ -0,0 +1,116 @@
+package dialer
+
+import (
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+)
+
+// DockerSocket is the socket the dialers of DockerDialer connect to.
+const DockerSocket = "/var/run/docker.sock"
+
+// ChainFactory is a Factory of the dialer chains set for clusters and their
+// machines. Machines without a chain of their own use the chain of their
+// cluster.
+type ChainFactory struct {
+	sync.RWMutex
+	clusters map[string][]Hop
+	machines map[string][]Hop
+}
+
+var _ Factory = &ChainFactory{}
+
+func NewChainFactory() *ChainFactory {
+	return &ChainFactory{
+		clusters: map[string][]Hop{},
+		machines: map[string][]Hop{},
+	}
+}
+
+// SetCluster sets the chain of clusterName, an empty chain dials directly.
+func (f *ChainFactory) SetCluster(clusterName string, hops ...Hop) {
+	f.Lock()
+	defer f.Unlock()
+	f.clusters[clusterName] = hops
+}
+
+// SetMachine sets the chain of machineName of clusterName, see NodeHops for
+// the chains of RKE nodes.
+func (f *ChainFactory) SetMachine(clusterName, machineName string, hops ...Hop) {
+	f.Lock()
+	defer f.Unlock()
+	f.machines[machineKey(clusterName, machineName)] = hops
+}
+
+// RemoveCluster removes the chains of clusterName and its machines.
+func (f *ChainFactory) RemoveCluster(clusterName string) {
+	f.Lock()
+	defer f.Unlock()
+
+	delete(f.clusters, clusterName)
+	prefix := machineKey(clusterName, "")
+	for key := range f.machines {
+		if strings.HasPrefix(key, prefix) {
+			delete(f.machines, key)
+		}
+	}
+}
+
+func (f *ChainFactory) ClusterDialer(clusterName string) (Dialer, error) {
+	f.RLock()
+	hops, ok := f.clusters[clusterName]
+	f.RUnlock()
+
+	if !ok {
+		return nil, fmt.Errorf("no dialer for cluster %s", clusterName)
+	}
+	return Chain(hops...)
+}
+
+// DockerDialer returns a dialer that connects to DockerSocket of the machine
+// whatever address it is asked for. The socket is only reached through SSH,
+// the chain of the machine has to end with an SSHHop.
+func (f *ChainFactory) DockerDialer(clusterName, machineName string) (Dialer, error) {
+	hops, err := f.nodeHops(clusterName, machineName)
+	if err != nil {
+		return nil, err
+	}
+	if len(hops) == 0 || !isSSHHop(hops[len(hops)-1]) {
+		return nil, fmt.Errorf("no ssh host to dial docker of machine %s of cluster %s", machineName, clusterName)
+	}
+
+	d, err := Chain(hops...)
+	if err != nil {
+		return nil, err
+	}
+	return func(network, address string) (net.Conn, error) {
+		return d("unix", DockerSocket)
+	}, nil
+}
+
+func (f *ChainFactory) NodeDialer(clusterName, machineName string) (Dialer, error) {
+	hops, err := f.nodeHops(clusterName, machineName)
+	if err != nil {
+		return nil, err
+	}
+	return Chain(hops...)
+}
+
+func (f *ChainFactory) nodeHops(clusterName, machineName string) ([]Hop, error) {
+	f.RLock()
+	defer f.RUnlock()
+
+	hops, ok := f.machines[machineKey(clusterName, machineName)]
+	if !ok {
+		hops, ok = f.clusters[clusterName]
+	}
+	if !ok {
+		return nil, fmt.Errorf("no dialer for machine %s of cluster %s", machineName, clusterName)
+	}
+	return hops, nil
+}
+
+func machineKey(clusterName, machineName string) string {
+	return clusterName + "/" + machineName
+}
@@ -0,0 +1,95 @@
+package dialer
+
+import (
+	"bufio"
+	"encoding/base64"
+	"fmt"
+	"net"
+	"net/http"
+
+	"golang.org/x/net/proxy"
+)
+
+// SOCKS5Hop returns a Hop that tunnels through the SOCKS5 proxy at address.
+// The credentials are only sent if username is set.
+func SOCKS5Hop(address, username, password string) Hop {
+	return func(parent Dialer) (Dialer, error) {
+		var auth *proxy.Auth
+		if username != "" {
+			auth = &proxy.Auth{
+				User:     username,
+				Password: password,
+			}
+		}
+
+		d, err := proxy.SOCKS5("tcp", address, auth, forward(parent))
+		if err != nil {
+			return nil, err
+		}
+		return d.Dial, nil
+	}
+}
+
+type forward Dialer
+
+func (f forward) Dial(network, address string) (net.Conn, error) {
+	return f(network, address)
+}
+
+// HTTPConnectHop returns a Hop that tunnels through the HTTP proxy at address
+// with CONNECT requests. The credentials are only sent if username is set.
+func HTTPConnectHop(address, username, password string) Hop {
+	return func(parent Dialer) (Dialer, error) {
+		return func(network, target string) (net.Conn, error) {
+			if network != "tcp" && network != "tcp4" && network != "tcp6" {
+				return nil, fmt.Errorf("http proxy %s can not dial network %s", address, network)
+			}
+
+			conn, err := parent("tcp", address)
+			if err != nil {
+				return nil, fmt.Errorf("failed to dial http proxy %s: %v", address, err)
+			}
+
+			connected, err := httpConnect(conn, target, username, password)
+			if err != nil {
+				conn.Close()
+				return nil, fmt.Errorf("http proxy %s failed to connect to %s: %v", address, target, err)
+			}
+			return connected, nil
+		}, nil
+	}
+}
+
+func httpConnect(conn net.Conn, target, username, password string) (net.Conn, error) {
+	req := "CONNECT " + target + " HTTP/1.1\r\nHost: " + target + "\r\n"
+	if username != "" {
+		credentials := base64.StdEncoding.EncodeToString([]byte(username + ":" + password))
+		req += "Proxy-Authorization: Basic " + credentials + "\r\n"
+	}
+	if _, err := conn.Write([]byte(req + "\r\n")); err != nil {
+		return nil, err
+	}
+
+	reader := bufio.NewReader(conn)
+	resp, err := http.ReadResponse(reader, &http.Request{Method: http.MethodConnect})
+	if err != nil {
+		return nil, err
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+
+	return &bufferedConn{Conn: conn, reader: reader}, nil
+}
+
+// bufferedConn reads what the reader of the CONNECT response buffered before
+// reading from the connection.
+type bufferedConn struct {
+	net.Conn
+	reader *bufio.Reader
+}
+
+func (c *bufferedConn) Read(b []byte) (int, error) {
+	return c.reader.Read(b)
+}
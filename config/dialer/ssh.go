@@ -0,0 +1,266 @@
+package dialer
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"reflect"
+	"time"
+
+	v3 "github.com/rancher/types/apis/management.cattle.io/v3"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/agent"
+)
+
+const (
+	defaultSSHPort    = "22"
+	defaultSSHTimeout = 30 * time.Second
+)
+
+// SSHConfig is an SSH host and the credentials to log into it, see
+// BastionHostConfig and NodeConfig. Hops fail without a HostKeyCallback,
+// ssh.InsecureIgnoreHostKey has to be set to skip the verification.
+type SSHConfig struct {
+	Address      string
+	Port         string
+	User         string
+	SSHAgentAuth bool
+	SSHKey       string
+	SSHKeyPath   string
+	SSHCert      string
+	SSHCertPath  string
+	// HostKeyCallback verifies the key of the host
+	HostKeyCallback ssh.HostKeyCallback
+	// Timeout of the SSH handshake, 30 seconds if zero
+	Timeout time.Duration
+}
+
+// BastionHostConfig returns the SSHConfig of a bastion host, its host keys
+// are verified with hostKeyCallback.
+func BastionHostConfig(bastion v3.BastionHost, hostKeyCallback ssh.HostKeyCallback) SSHConfig {
+	return SSHConfig{
+		Address:         bastion.Address,
+		Port:            bastion.Port,
+		User:            bastion.User,
+		SSHAgentAuth:    bastion.SSHAgentAuth,
+		SSHKey:          bastion.SSHKey,
+		SSHKeyPath:      bastion.SSHKeyPath,
+		SSHCert:         bastion.SSHCert,
+		SSHCertPath:     bastion.SSHCertPath,
+		HostKeyCallback: hostKeyCallback,
+	}
+}
+
+// NodeConfig returns the SSHConfig of a node of an RKE cluster, its host keys
+// are verified with hostKeyCallback.
+func NodeConfig(node v3.RKEConfigNode, hostKeyCallback ssh.HostKeyCallback) SSHConfig {
+	return SSHConfig{
+		Address:         node.Address,
+		Port:            node.Port,
+		User:            node.User,
+		SSHAgentAuth:    node.SSHAgentAuth,
+		SSHKey:          node.SSHKey,
+		SSHKeyPath:      node.SSHKeyPath,
+		SSHCert:         node.SSHCert,
+		SSHCertPath:     node.SSHCertPath,
+		HostKeyCallback: hostKeyCallback,
+	}
+}
+
+// NodeHops returns the hops to node of rkeConfig, through its bastion host if
+// it has one. The host keys of both are verified with hostKeyCallback.
+func NodeHops(rkeConfig *v3.RancherKubernetesEngineConfig, node v3.RKEConfigNode, hostKeyCallback ssh.HostKeyCallback) []Hop {
+	var hops []Hop
+	if rkeConfig != nil && rkeConfig.BastionHost.Address != "" {
+		hops = append(hops, SSHHop(BastionHostConfig(rkeConfig.BastionHost, hostKeyCallback)))
+	}
+	return append(hops, SSHHop(NodeConfig(node, hostKeyCallback)))
+}
+
+// SSHHop returns a Hop that tunnels through the SSH host of config. Every
+// connection of the dialer has its own SSH connection, closed with it. With
+// SSHAgentAuth every login connects to the agent of SSH_AUTH_SOCK, the
+// connection is closed once the login is done.
+func SSHHop(config SSHConfig) Hop {
+	return func(parent Dialer) (Dialer, error) {
+		clientConfig, keys, err := config.clientConfig()
+		if err != nil {
+			return nil, err
+		}
+
+		port := config.Port
+		if port == "" {
+			port = defaultSSHPort
+		}
+		hostAddress := net.JoinHostPort(config.Address, port)
+
+		return func(network, address string) (net.Conn, error) {
+			login := *clientConfig
+			var agentConn net.Conn
+			if config.SSHAgentAuth {
+				conn, err := dialAgent()
+				if err != nil && len(keys) == 0 {
+					return nil, err
+				}
+				agentConn = conn
+			}
+			login.Auth = []ssh.AuthMethod{publicKeys(keys, agentConn)}
+
+			client, err := sshClient(parent, hostAddress, &login)
+			if agentConn != nil {
+				agentConn.Close()
+			}
+			if err != nil {
+				return nil, err
+			}
+			conn, err := client.Dial(network, address)
+			if err != nil {
+				client.Close()
+				return nil, fmt.Errorf("failed to dial %s through ssh host %s: %v", address, hostAddress, err)
+			}
+			return &sshConn{Conn: conn, client: client}, nil
+		}, nil
+	}
+}
+
+// sshHop is the code of the hops of SSHHop, they are closures of the same
+// function.
+var sshHop = reflect.ValueOf(SSHHop(SSHConfig{})).Pointer()
+
+// isSSHHop returns whether hop was returned by SSHHop.
+func isSSHHop(hop Hop) bool {
+	return hop != nil && reflect.ValueOf(hop).Pointer() == sshHop
+}
+
+func sshClient(parent Dialer, address string, config *ssh.ClientConfig) (*ssh.Client, error) {
+	conn, err := parent("tcp", address)
+	if err != nil {
+		return nil, fmt.Errorf("failed to dial ssh host %s: %v", address, err)
+	}
+
+	conn.SetDeadline(time.Now().Add(config.Timeout))
+	c, chans, reqs, err := ssh.NewClientConn(conn, address, config)
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to log into ssh host %s: %v", address, err)
+	}
+	conn.SetDeadline(time.Time{})
+
+	return ssh.NewClient(c, chans, reqs), nil
+}
+
+// clientConfig returns the ssh.ClientConfig of c without its Auth and the
+// signers of the key of c.
+func (c SSHConfig) clientConfig() (*ssh.ClientConfig, []ssh.Signer, error) {
+	if c.HostKeyCallback == nil {
+		return nil, nil, fmt.Errorf("no host key callback for ssh host %s", c.Address)
+	}
+
+	var signers []ssh.Signer
+
+	key := c.SSHKey
+	if key == "" && c.SSHKeyPath != "" {
+		content, err := ioutil.ReadFile(c.SSHKeyPath)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to read the ssh key of %s: %v", c.Address, err)
+		}
+		key = string(content)
+	}
+	if key != "" {
+		signer, err := ssh.ParsePrivateKey([]byte(key))
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to parse the ssh key of %s: %v", c.Address, err)
+		}
+
+		cert := c.SSHCert
+		if cert == "" && c.SSHCertPath != "" {
+			content, err := ioutil.ReadFile(c.SSHCertPath)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to read the ssh certificate of %s: %v", c.Address, err)
+			}
+			cert = string(content)
+		}
+		if cert != "" {
+			if signer, err = certSigner(signer, cert); err != nil {
+				return nil, nil, fmt.Errorf("failed to parse the ssh certificate of %s: %v", c.Address, err)
+			}
+		}
+		signers = append(signers, signer)
+	}
+
+	if len(signers) == 0 {
+		if !c.SSHAgentAuth {
+			return nil, nil, fmt.Errorf("no ssh key or agent for %s", c.Address)
+		}
+		if os.Getenv("SSH_AUTH_SOCK") == "" {
+			return nil, nil, fmt.Errorf("no ssh key for %s and SSH_AUTH_SOCK is not set", c.Address)
+		}
+	}
+
+	timeout := c.Timeout
+	if timeout == 0 {
+		timeout = defaultSSHTimeout
+	}
+
+	return &ssh.ClientConfig{
+		User:            c.User,
+		HostKeyCallback: c.HostKeyCallback,
+		Timeout:         timeout,
+	}, signers, nil
+}
+
+func certSigner(signer ssh.Signer, cert string) (ssh.Signer, error) {
+	key, _, _, _, err := ssh.ParseAuthorizedKey([]byte(cert))
+	if err != nil {
+		return nil, err
+	}
+	sshCert, ok := key.(*ssh.Certificate)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a certificate", key.Type())
+	}
+	return ssh.NewCertSigner(sshCert, signer)
+}
+
+func dialAgent() (net.Conn, error) {
+	socket := os.Getenv("SSH_AUTH_SOCK")
+	if socket == "" {
+		return nil, fmt.Errorf("SSH_AUTH_SOCK is not set")
+	}
+	conn, err := net.Dial("unix", socket)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to the ssh agent: %v", err)
+	}
+	return conn, nil
+}
+
+// publicKeys authenticates with keys and the keys of the agent on agentConn,
+// if any. The agent signs through agentConn, it has to be open until the
+// login is done.
+func publicKeys(keys []ssh.Signer, agentConn net.Conn) ssh.AuthMethod {
+	return ssh.PublicKeysCallback(func() ([]ssh.Signer, error) {
+		if agentConn == nil {
+			return keys, nil
+		}
+		agentSigners, err := agent.NewClient(agentConn).Signers()
+		if err != nil && len(keys) == 0 {
+			return nil, fmt.Errorf("failed to list the keys of the ssh agent: %v", err)
+		}
+		return append(append([]ssh.Signer(nil), keys...), agentSigners...), nil
+	})
+}
+
+// sshConn is a connection through an SSH client, it closes the client with
+// it.
+type sshConn struct {
+	net.Conn
+	client *ssh.Client
+}
+
+func (c *sshConn) Close() error {
+	err := c.Conn.Close()
+	if clientErr := c.client.Close(); err == nil {
+		err = clientErr
+	}
+	return err
+}
@@ -0,0 +1,166 @@
+// Package fake assembles the contexts of package config from in-memory
+// clients, so that handlers can be registered and started without a cluster.
+package fake
+
+import (
+	"github.com/rancher/norman/types"
+	apiregistrationv1 "github.com/rancher/types/apis/apiregistration.k8s.io/v1/fakes"
+	appsv1 "github.com/rancher/types/apis/apps/v1/fakes"
+	autoscaling "github.com/rancher/types/apis/autoscaling/v2beta2/fakes"
+	batchv1 "github.com/rancher/types/apis/batch/v1/fakes"
+	batchv1beta1 "github.com/rancher/types/apis/batch/v1beta1/fakes"
+	clusterv3 "github.com/rancher/types/apis/cluster.cattle.io/v3/fakes"
+	clusterSchema "github.com/rancher/types/apis/cluster.cattle.io/v3/schema"
+	corev1 "github.com/rancher/types/apis/core/v1/fakes"
+	extv1beta1 "github.com/rancher/types/apis/extensions/v1beta1/fakes"
+	managementv3 "github.com/rancher/types/apis/management.cattle.io/v3"
+	managementv3fakes "github.com/rancher/types/apis/management.cattle.io/v3/fakes"
+	managementSchema "github.com/rancher/types/apis/management.cattle.io/v3/schema"
+	monitoringv1 "github.com/rancher/types/apis/monitoring.coreos.com/v1/fakes"
+	istiov1alpha3 "github.com/rancher/types/apis/networking.istio.io/v1alpha3/fakes"
+	knetworkingv1 "github.com/rancher/types/apis/networking.k8s.io/v1/fakes"
+	policyv1beta1 "github.com/rancher/types/apis/policy/v1beta1/fakes"
+	projectv3 "github.com/rancher/types/apis/project.cattle.io/v3"
+	projectv3fakes "github.com/rancher/types/apis/project.cattle.io/v3/fakes"
+	projectSchema "github.com/rancher/types/apis/project.cattle.io/v3/schema"
+	rbacv1 "github.com/rancher/types/apis/rbac.authorization.k8s.io/v1/fakes"
+	storagev1 "github.com/rancher/types/apis/storage.k8s.io/v1/fakes"
+	"github.com/rancher/types/config"
+	"github.com/rancher/types/fakeclient"
+	apiextfake "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset/fake"
+	"k8s.io/apimachinery/pkg/runtime"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+)
+
+// Cluster is the in-memory state of one cluster. The clients of the rancher
+// api groups share its Tracker, the clientsets of client-go are separate
+// stores and see none of the objects of the Tracker.
+type Cluster struct {
+	*fakeclient.Tracker
+
+	K8sClient     *k8sfake.Clientset
+	APIExtClient  *apiextfake.Clientset
+	DynamicClient *dynamicfake.FakeDynamicClient
+}
+
+func NewCluster() *Cluster {
+	return &Cluster{
+		Tracker:       fakeclient.NewTracker(),
+		K8sClient:     k8sfake.NewSimpleClientset(),
+		APIExtClient:  apiextfake.NewSimpleClientset(),
+		DynamicClient: dynamicfake.NewSimpleDynamicClient(runtime.NewScheme()),
+	}
+}
+
+// NewScaledContext returns a ScaledContext on the objects of management. The
+// UnversionedClient, ClientGetter and AccessControl are left nil.
+func NewScaledContext(management *Cluster) *config.ScaledContext {
+	context := &config.ScaledContext{
+		K8sClient:    management.K8sClient,
+		APIExtClient: management.APIExtClient,
+		Schemas:      schemas(),
+		Dialer:       &DialerFactory{},
+		UserManager:  &UserManager{},
+		PeerManager:  NewPeerManager("self"),
+
+		Management: managementv3fakes.NewClientsetForTracker(management.Tracker),
+		Project:    projectv3fakes.NewClientsetForTracker(management.Tracker),
+		RBAC:       rbacv1.NewClientsetForTracker(management.Tracker),
+		Core:       corev1.NewClientsetForTracker(management.Tracker),
+		Storage:    storagev1.NewClientsetForTracker(management.Tracker),
+	}
+
+	context.TrackControllers()
+	return context
+}
+
+// NewManagementContext returns a ManagementContext on the objects of
+// management. The UnversionedClient and ClientGetter are left nil.
+func NewManagementContext(management *Cluster) *config.ManagementContext {
+	context := &config.ManagementContext{
+		DynamicClient: management.DynamicClient,
+		K8sClient:     management.K8sClient,
+		APIExtClient:  management.APIExtClient,
+		Schemas:       schemas(),
+		Scheme:        runtime.NewScheme(),
+		Dialer:        &DialerFactory{},
+		UserManager:   &UserManager{},
+
+		Management: managementv3fakes.NewClientsetForTracker(management.Tracker),
+		Project:    projectv3fakes.NewClientsetForTracker(management.Tracker),
+		RBAC:       rbacv1.NewClientsetForTracker(management.Tracker),
+		Core:       corev1.NewClientsetForTracker(management.Tracker),
+	}
+
+	managementv3.AddToScheme(context.Scheme)
+	projectv3.AddToScheme(context.Scheme)
+
+	context.TrackControllers()
+	return context
+}
+
+// NewUserContext returns the UserContext of the cluster clusterName, its
+// Management context is on the objects of management and the rest on the
+// objects of user. The UnversionedClient is left nil.
+func NewUserContext(management, user *Cluster, clusterName string) *config.UserContext {
+	context := &config.UserContext{
+		Management:   NewManagementContext(management),
+		ClusterName:  clusterName,
+		APIExtClient: user.APIExtClient,
+		K8sClient:    user.K8sClient,
+
+		APIAggregation: apiregistrationv1.NewClientsetForTracker(user.Tracker),
+		Apps:           appsv1.NewClientsetForTracker(user.Tracker),
+		Autoscaling:    autoscaling.NewClientsetForTracker(user.Tracker),
+		Project:        projectv3fakes.NewClientsetForTracker(user.Tracker),
+		Core:           corev1.NewClientsetForTracker(user.Tracker),
+		RBAC:           rbacv1.NewClientsetForTracker(user.Tracker),
+		Extensions:     extv1beta1.NewClientsetForTracker(user.Tracker),
+		BatchV1:        batchv1.NewClientsetForTracker(user.Tracker),
+		BatchV1Beta1:   batchv1beta1.NewClientsetForTracker(user.Tracker),
+		Networking:     knetworkingv1.NewClientsetForTracker(user.Tracker),
+		Monitoring:     monitoringv1.NewClientsetForTracker(user.Tracker),
+		Cluster:        clusterv3.NewClientsetForTracker(user.Tracker),
+		Istio:          istiov1alpha3.NewClientsetForTracker(user.Tracker),
+		Storage:        storagev1.NewClientsetForTracker(user.Tracker),
+		Policy:         policyv1beta1.NewClientsetForTracker(user.Tracker),
+	}
+
+	context.TrackControllers()
+	return context
+}
+
+// NewUserOnlyContext returns a UserOnlyContext on the objects of user. The
+// UnversionedClient is left nil.
+func NewUserOnlyContext(user *Cluster) *config.UserOnlyContext {
+	context := &config.UserOnlyContext{
+		Schemas:   schemas(),
+		K8sClient: user.K8sClient,
+
+		APIRegistration: apiregistrationv1.NewClientsetForTracker(user.Tracker),
+		Apps:            appsv1.NewClientsetForTracker(user.Tracker),
+		Autoscaling:     autoscaling.NewClientsetForTracker(user.Tracker),
+		Project:         projectv3fakes.NewClientsetForTracker(user.Tracker),
+		Core:            corev1.NewClientsetForTracker(user.Tracker),
+		RBAC:            rbacv1.NewClientsetForTracker(user.Tracker),
+		Extensions:      extv1beta1.NewClientsetForTracker(user.Tracker),
+		BatchV1:         batchv1.NewClientsetForTracker(user.Tracker),
+		BatchV1Beta1:    batchv1beta1.NewClientsetForTracker(user.Tracker),
+		Monitoring:      monitoringv1.NewClientsetForTracker(user.Tracker),
+		Cluster:         clusterv3.NewClientsetForTracker(user.Tracker),
+		Istio:           istiov1alpha3.NewClientsetForTracker(user.Tracker),
+		Storage:         storagev1.NewClientsetForTracker(user.Tracker),
+		Policy:          policyv1beta1.NewClientsetForTracker(user.Tracker),
+	}
+
+	context.TrackControllers()
+	return context
+}
+
+func schemas() *types.Schemas {
+	return types.NewSchemas().
+		AddSchemas(managementSchema.Schemas).
+		AddSchemas(clusterSchema.Schemas).
+		AddSchemas(projectSchema.Schemas)
+}
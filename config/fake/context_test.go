@@ -0,0 +1,237 @@
+package fake
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	v3 "github.com/rancher/types/apis/management.cattle.io/v3"
+	"github.com/rancher/types/peermanager"
+	"github.com/rancher/types/sharding"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestUserContextStart(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	management, user := NewCluster(), NewCluster()
+	userContext := NewUserContext(management, user, "c-1")
+
+	synced := make(chan string, 10)
+	userContext.Management.Management.Clusters("").AddHandler(ctx, "test", func(key string, obj *v3.Cluster) (runtime.Object, error) {
+		if obj != nil {
+			synced <- obj.Name
+		}
+		return obj, nil
+	})
+
+	if err := userContext.Start(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := userContext.Management.Management.Clusters("").Create(&v3.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "c-1"},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case name := <-synced:
+		if name != "c-1" {
+			t.Errorf("expected c-1 to be synced, got %s", name)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("expected the handler to run after start")
+	}
+
+	if _, err := user.K8sClient.CoreV1().Namespaces().List(metav1.ListOptions{}); err != nil {
+		t.Errorf("expected the k8s client to be usable, got %v", err)
+	}
+	if _, err := userContext.Management.Dialer.ClusterDialer("c-1"); err != nil {
+		t.Errorf("expected a cluster dialer, got %v", err)
+	}
+}
+
+func TestPeerManager(t *testing.T) {
+	peers := NewPeerManager("a")
+	l := make(chan peermanager.Peers, 1)
+	peers.AddListener(l)
+
+	peers.Leader()
+	if !peers.IsLeader() || !(<-l).Leader {
+		t.Error("expected a to become the leader")
+	}
+
+	peers.RemoveListener(l)
+	peers.SetPeers(peermanager.Peers{SelfID: "a", IDs: []string{"a", "b"}})
+	if peers.IsLeader() || len(l) != 0 {
+		t.Error("expected a to lose the lead without telling removed listeners")
+	}
+}
+
+func TestSharded(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	scaledContext := NewScaledContext(NewCluster())
+	// without ready peers the sharder owns no keys
+	sharded := scaledContext.Sharded(sharding.NewSharder())
+
+	handled := make(chan string, 10)
+	sharded.Management.Clusters("").AddHandler(ctx, "sharded", func(key string, obj *v3.Cluster) (runtime.Object, error) {
+		if obj != nil {
+			handled <- "sharded"
+		}
+		return obj, nil
+	})
+	scaledContext.Management.Clusters("").AddHandler(ctx, "everywhere", func(key string, obj *v3.Cluster) (runtime.Object, error) {
+		if obj != nil {
+			handled <- "everywhere"
+		}
+		return obj, nil
+	})
+
+	if err := scaledContext.Start(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := scaledContext.Management.Clusters("").Create(&v3.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "c-1"},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	// the handlers of a key run in the order they were added
+	select {
+	case name := <-handled:
+		if name != "everywhere" {
+			t.Errorf("expected only the handler that is not sharded to run, got %s", name)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("expected the handler to run after start")
+	}
+}
+
+func TestEnableMetrics(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	management := NewCluster()
+	managementContext := NewManagementContext(management)
+	registry := prometheus.NewRegistry()
+	if err := managementContext.EnableMetrics(registry); err != nil {
+		t.Fatal(err)
+	}
+
+	done := make(chan struct{}, 10)
+	managementContext.Management.Clusters("").AddHandler(ctx, "failing", func(key string, obj *v3.Cluster) (runtime.Object, error) {
+		if obj == nil {
+			return nil, nil
+		}
+		defer func() { done <- struct{}{} }()
+		return nil, errors.New("failed")
+	})
+
+	if err := managementContext.Start(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := managementContext.Management.Clusters("").Create(&v3.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "c-1"},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		t.Fatal("expected the handler to run after start")
+	}
+
+	// the counters are incremented after the handler returns
+	for i := 0; i < 50 && counter(t, registry, "rancher_controller_handler_failures_total", "failing") == 0; i++ {
+		time.Sleep(100 * time.Millisecond)
+	}
+	if executions := counter(t, registry, "rancher_controller_handler_executions_total", "failing"); executions == 0 {
+		t.Error("expected the executions of the handler to be counted")
+	}
+	if failures := counter(t, registry, "rancher_controller_handler_failures_total", "failing"); failures == 0 {
+		t.Error("expected the failures of the handler to be counted")
+	}
+}
+
+func TestEnableMetricsOnce(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	management := NewCluster()
+	scaledContext := NewScaledContext(management)
+	registry := prometheus.NewRegistry()
+	if err := scaledContext.EnableMetrics(registry); err != nil {
+		t.Fatal(err)
+	}
+
+	// measured like the contexts of ScaledContext.NewManagementContext
+	userContext := NewUserContext(management, NewCluster(), "c-1")
+	if err := userContext.Management.EnableMetrics(registry); err != nil {
+		t.Fatal(err)
+	}
+	if err := userContext.EnableMetrics(registry); err != nil {
+		t.Fatal(err)
+	}
+
+	done := make(chan struct{}, 10)
+	userContext.Management.Management.Clusters("").AddHandler(ctx, "once", func(key string, obj *v3.Cluster) (runtime.Object, error) {
+		if obj != nil {
+			done <- struct{}{}
+		}
+		return obj, nil
+	})
+
+	if err := userContext.Start(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := userContext.Management.Management.Clusters("").Create(&v3.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "c-1"},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		t.Fatal("expected the handler to run after start")
+	}
+
+	for i := 0; i < 50 && counter(t, registry, "rancher_controller_handler_executions_total", "once") == 0; i++ {
+		time.Sleep(100 * time.Millisecond)
+	}
+	if executions := counter(t, registry, "rancher_controller_handler_executions_total", "once"); executions != 1 {
+		t.Errorf("expected 1 execution of the handler to be counted, got %v", executions)
+	}
+}
+
+// counter returns the value of the counter name of the handler handler of the
+// ClusterController.
+func counter(t *testing.T, registry *prometheus.Registry, name, handler string) float64 {
+	families, err := registry.Gather()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, family := range families {
+		if family.GetName() != name {
+			continue
+		}
+		for _, metric := range family.GetMetric() {
+			labels := map[string]string{}
+			for _, label := range metric.GetLabel() {
+				labels[label.GetName()] = label.GetValue()
+			}
+			if labels["controller"] == "ClusterController" && labels["handler"] == handler {
+				return metric.GetCounter().GetValue()
+			}
+		}
+	}
+	return 0
+}
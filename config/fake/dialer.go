@@ -0,0 +1,35 @@
+package fake
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/rancher/types/config/dialer"
+)
+
+// DialerFactory is a dialer.Factory without any tunnels, its dialers use Dial
+// when set and fail otherwise.
+type DialerFactory struct {
+	Dial dialer.Dialer
+}
+
+func (d *DialerFactory) ClusterDialer(clusterName string) (dialer.Dialer, error) {
+	return d.dialer(clusterName), nil
+}
+
+func (d *DialerFactory) DockerDialer(clusterName, machineName string) (dialer.Dialer, error) {
+	return d.dialer(clusterName + "/" + machineName), nil
+}
+
+func (d *DialerFactory) NodeDialer(clusterName, machineName string) (dialer.Dialer, error) {
+	return d.dialer(clusterName + "/" + machineName), nil
+}
+
+func (d *DialerFactory) dialer(target string) dialer.Dialer {
+	if d.Dial != nil {
+		return d.Dial
+	}
+	return func(network, address string) (net.Conn, error) {
+		return nil, fmt.Errorf("can not dial %s %s of %s without a tunnel", network, address, target)
+	}
+}
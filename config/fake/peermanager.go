@@ -0,0 +1,76 @@
+package fake
+
+import (
+	"sync"
+
+	"github.com/rancher/types/peermanager"
+)
+
+// PeerManager is a peermanager.PeerManager whose peers are set by the test,
+// every change is sent to the listeners before SetPeers returns.
+type PeerManager struct {
+	sync.Mutex
+	peers     peermanager.Peers
+	listeners map[chan<- peermanager.Peers]bool
+}
+
+// NewPeerManager returns a PeerManager that is the only, ready peer but not
+// the leader.
+func NewPeerManager(selfID string) *PeerManager {
+	return &PeerManager{
+		peers: peermanager.Peers{
+			SelfID: selfID,
+			IDs:    []string{selfID},
+			Ready:  true,
+		},
+		listeners: map[chan<- peermanager.Peers]bool{},
+	}
+}
+
+func (p *PeerManager) IsLeader() bool {
+	p.Lock()
+	defer p.Unlock()
+	return p.peers.Leader
+}
+
+// Leader makes this peer the leader.
+func (p *PeerManager) Leader() {
+	peers := p.Peers()
+	peers.Leader = true
+	p.SetPeers(peers)
+}
+
+func (p *PeerManager) Peers() peermanager.Peers {
+	p.Lock()
+	defer p.Unlock()
+	peers := p.peers
+	peers.IDs = append([]string(nil), p.peers.IDs...)
+	return peers
+}
+
+// SetPeers replaces the peers and blocks until every listener received them.
+func (p *PeerManager) SetPeers(peers peermanager.Peers) {
+	p.Lock()
+	p.peers = peers
+	var listeners []chan<- peermanager.Peers
+	for l := range p.listeners {
+		listeners = append(listeners, l)
+	}
+	p.Unlock()
+
+	for _, l := range listeners {
+		l <- peers
+	}
+}
+
+func (p *PeerManager) AddListener(l chan<- peermanager.Peers) {
+	p.Lock()
+	defer p.Unlock()
+	p.listeners[l] = true
+}
+
+func (p *PeerManager) RemoveListener(l chan<- peermanager.Peers) {
+	p.Lock()
+	defer p.Unlock()
+	delete(p.listeners, l)
+}
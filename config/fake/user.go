@@ -0,0 +1,82 @@
+package fake
+
+import (
+	"strconv"
+
+	"github.com/rancher/norman/types"
+	v3 "github.com/rancher/types/apis/management.cattle.io/v3"
+	apitypes "k8s.io/apimachinery/pkg/types"
+)
+
+// UserManager is a user.Manager that knows a fixed set of users. Tokens are
+// named after their arguments and every principal has access.
+type UserManager struct {
+	// Users by name, GetUser returns CurrentUser
+	Users       map[string]*v3.User
+	CurrentUser string
+}
+
+func (m *UserManager) SetPrincipalOnCurrentUser(apiContext *types.APIContext, principal v3.Principal) (*v3.User, error) {
+	return m.SetPrincipalOnCurrentUserByUserID(m.CurrentUser, principal)
+}
+
+func (m *UserManager) GetUser(apiContext *types.APIContext) string {
+	return m.CurrentUser
+}
+
+func (m *UserManager) EnsureToken(tokenName, description, kind, userName string) (string, error) {
+	return tokenName + ":" + userName, nil
+}
+
+func (m *UserManager) EnsureClusterToken(clusterName, tokenName, description, kind, userName string) (string, error) {
+	return clusterName + "-" + tokenName + ":" + userName, nil
+}
+
+func (m *UserManager) EnsureUser(principalName, displayName string) (*v3.User, error) {
+	if user, err := m.GetUserByPrincipalID(principalName); user != nil || err != nil {
+		return user, err
+	}
+	if m.Users == nil {
+		m.Users = map[string]*v3.User{}
+	}
+	user := &v3.User{
+		DisplayName:  displayName,
+		PrincipalIDs: []string{principalName},
+	}
+	user.Name = "u-" + strconv.Itoa(len(m.Users)+1)
+	m.Users[user.Name] = user
+	return user, nil
+}
+
+func (m *UserManager) CheckAccess(accessMode string, allowedPrincipalIDs []string, userPrincipalID string, groups []v3.Principal) (bool, error) {
+	return true, nil
+}
+
+func (m *UserManager) SetPrincipalOnCurrentUserByUserID(userID string, principal v3.Principal) (*v3.User, error) {
+	user, ok := m.Users[userID]
+	if !ok {
+		return nil, nil
+	}
+	for _, id := range user.PrincipalIDs {
+		if id == principal.Name {
+			return user, nil
+		}
+	}
+	user.PrincipalIDs = append(user.PrincipalIDs, principal.Name)
+	return user, nil
+}
+
+func (m *UserManager) CreateNewUserClusterRoleBinding(userName string, userUID apitypes.UID) error {
+	return nil
+}
+
+func (m *UserManager) GetUserByPrincipalID(principalName string) (*v3.User, error) {
+	for _, user := range m.Users {
+		for _, id := range user.PrincipalIDs {
+			if id == principalName {
+				return user, nil
+			}
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,76 @@
+package config
+
+import (
+	"github.com/rancher/norman/controller"
+	apiregistrationv1 "github.com/rancher/types/apis/apiregistration.k8s.io/v1"
+	appsv1 "github.com/rancher/types/apis/apps/v1"
+	autoscaling "github.com/rancher/types/apis/autoscaling/v2beta2"
+	batchv1 "github.com/rancher/types/apis/batch/v1"
+	batchv1beta1 "github.com/rancher/types/apis/batch/v1beta1"
+	clusterv3 "github.com/rancher/types/apis/cluster.cattle.io/v3"
+	corev1 "github.com/rancher/types/apis/core/v1"
+	extv1beta1 "github.com/rancher/types/apis/extensions/v1beta1"
+	managementv3 "github.com/rancher/types/apis/management.cattle.io/v3"
+	monitoringv1 "github.com/rancher/types/apis/monitoring.coreos.com/v1"
+	istiov1alpha3 "github.com/rancher/types/apis/networking.istio.io/v1alpha3"
+	knetworkingv1 "github.com/rancher/types/apis/networking.k8s.io/v1"
+	policyv1beta1 "github.com/rancher/types/apis/policy/v1beta1"
+	projectv3 "github.com/rancher/types/apis/project.cattle.io/v3"
+	rbacv1 "github.com/rancher/types/apis/rbac.authorization.k8s.io/v1"
+	storagev1 "github.com/rancher/types/apis/storage.k8s.io/v1"
+)
+
+// instrumentFunc wraps the generic controllers of the clients of a context,
+// see the NewInstrumented functions of the api groups.
+type instrumentFunc func(controllerName string, generic controller.GenericController) controller.GenericController
+
+func (c *ScaledContext) instrument(f instrumentFunc) {
+	c.Management = managementv3.NewInstrumented(c.Management, f)
+	c.Project = projectv3.NewInstrumented(c.Project, f)
+	c.RBAC = rbacv1.NewInstrumented(c.RBAC, f)
+	c.Core = corev1.NewInstrumented(c.Core, f)
+	c.Storage = storagev1.NewInstrumented(c.Storage, f)
+}
+
+func (c *ManagementContext) instrument(f instrumentFunc) {
+	c.Management = managementv3.NewInstrumented(c.Management, f)
+	c.Project = projectv3.NewInstrumented(c.Project, f)
+	c.RBAC = rbacv1.NewInstrumented(c.RBAC, f)
+	c.Core = corev1.NewInstrumented(c.Core, f)
+}
+
+// instrument wraps the clients of the cluster, not of its Management context.
+func (w *UserContext) instrument(f instrumentFunc) {
+	w.APIAggregation = apiregistrationv1.NewInstrumented(w.APIAggregation, f)
+	w.Apps = appsv1.NewInstrumented(w.Apps, f)
+	w.Autoscaling = autoscaling.NewInstrumented(w.Autoscaling, f)
+	w.Project = projectv3.NewInstrumented(w.Project, f)
+	w.Core = corev1.NewInstrumented(w.Core, f)
+	w.RBAC = rbacv1.NewInstrumented(w.RBAC, f)
+	w.Extensions = extv1beta1.NewInstrumented(w.Extensions, f)
+	w.BatchV1 = batchv1.NewInstrumented(w.BatchV1, f)
+	w.BatchV1Beta1 = batchv1beta1.NewInstrumented(w.BatchV1Beta1, f)
+	w.Networking = knetworkingv1.NewInstrumented(w.Networking, f)
+	w.Monitoring = monitoringv1.NewInstrumented(w.Monitoring, f)
+	w.Cluster = clusterv3.NewInstrumented(w.Cluster, f)
+	w.Istio = istiov1alpha3.NewInstrumented(w.Istio, f)
+	w.Storage = storagev1.NewInstrumented(w.Storage, f)
+	w.Policy = policyv1beta1.NewInstrumented(w.Policy, f)
+}
+
+func (w *UserOnlyContext) instrument(f instrumentFunc) {
+	w.APIRegistration = apiregistrationv1.NewInstrumented(w.APIRegistration, f)
+	w.Apps = appsv1.NewInstrumented(w.Apps, f)
+	w.Autoscaling = autoscaling.NewInstrumented(w.Autoscaling, f)
+	w.Project = projectv3.NewInstrumented(w.Project, f)
+	w.Core = corev1.NewInstrumented(w.Core, f)
+	w.RBAC = rbacv1.NewInstrumented(w.RBAC, f)
+	w.Extensions = extv1beta1.NewInstrumented(w.Extensions, f)
+	w.BatchV1 = batchv1.NewInstrumented(w.BatchV1, f)
+	w.BatchV1Beta1 = batchv1beta1.NewInstrumented(w.BatchV1Beta1, f)
+	w.Monitoring = monitoringv1.NewInstrumented(w.Monitoring, f)
+	w.Cluster = clusterv3.NewInstrumented(w.Cluster, f)
+	w.Istio = istiov1alpha3.NewInstrumented(w.Istio, f)
+	w.Storage = storagev1.NewInstrumented(w.Storage, f)
+	w.Policy = policyv1beta1.NewInstrumented(w.Policy, f)
+}
@@ -0,0 +1,68 @@
+package config
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/rancher/types/metrics"
+)
+
+// EnableMetrics registers the controller metrics with registerer and measures
+// the handlers added through the clients of the context from then on. It has
+// to be called once, before the handlers are added. The ManagementContexts
+// of NewManagementContext are measured as well.
+func (c *ScaledContext) EnableMetrics(registerer prometheus.Registerer) error {
+	if err := metrics.Register(registerer); err != nil {
+		return err
+	}
+
+	c.metrics = true
+	c.instrument(metrics.Instrument)
+	return nil
+}
+
+// EnableMetrics registers the controller metrics with registerer and measures
+// the handlers added through the clients of the context from then on. It has
+// to be called before the handlers are added, contexts of
+// ScaledContext.NewManagementContext are already measured.
+func (c *ManagementContext) EnableMetrics(registerer prometheus.Registerer) error {
+	if err := metrics.Register(registerer); err != nil {
+		return err
+	}
+
+	c.measure()
+	return nil
+}
+
+// measure instruments the clients of the context unless they already are, so
+// that no handler is counted twice.
+func (c *ManagementContext) measure() {
+	if c.metrics {
+		return
+	}
+	c.metrics = true
+	c.instrument(metrics.Instrument)
+}
+
+// EnableMetrics registers the controller metrics with registerer and measures
+// the handlers added through the clients of the context and its Management
+// context from then on. It has to be called once, before the handlers are
+// added.
+func (w *UserContext) EnableMetrics(registerer prometheus.Registerer) error {
+	if err := w.Management.EnableMetrics(registerer); err != nil {
+		return err
+	}
+
+	w.instrument(metrics.Instrument)
+	return nil
+}
+
+// EnableMetrics registers the controller metrics with registerer and measures
+// the handlers added through the clients of the context from then on. It has
+// to be called once, before the handlers are added.
+func (w *UserOnlyContext) EnableMetrics(registerer prometheus.Registerer) error {
+	if err := metrics.Register(registerer); err != nil {
+		return err
+	}
+
+	w.instrument(metrics.Instrument)
+	return nil
+}
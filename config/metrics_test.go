@@ -0,0 +1,22 @@
+package config
+
+import (
+	"testing"
+
+	"k8s.io/client-go/rest"
+)
+
+func TestNewManagementContextMeasured(t *testing.T) {
+	scaledContext := &ScaledContext{
+		RESTConfig: rest.Config{Host: "https://127.0.0.1:6443"},
+		metrics:    true,
+	}
+
+	mgmt, err := scaledContext.NewManagementContext()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !mgmt.metrics {
+		t.Error("expected the management context of a measured scaled context to be measured")
+	}
+}
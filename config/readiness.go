@@ -0,0 +1,269 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rancher/norman/controller"
+	"github.com/rancher/types/metrics"
+)
+
+var cacheSyncPollInterval = 100 * time.Millisecond
+
+// ControllerStatus is the state of one controller of a context.
+type ControllerStatus struct {
+	Name string `json:"name"`
+	// Synced is set once the cache of the controller has been filled
+	Synced bool `json:"synced"`
+	// Started is set once the context was started with the controller
+	Started bool `json:"started"`
+	// LastError is the last error of a handler of the controller, it is
+	// cleared when that handler succeeds again
+	LastError     string    `json:"lastError,omitempty"`
+	LastErrorTime time.Time `json:"lastErrorTime,omitempty"`
+}
+
+// controllerTracker records the controllers created through the clients of a
+// context and the errors of their handlers.
+type controllerTracker struct {
+	sync.Mutex
+	controllers []*trackedController
+}
+
+type trackedController struct {
+	name    string
+	generic controller.GenericController
+	started bool
+
+	lastError        error
+	lastErrorTime    time.Time
+	lastErrorHandler string
+}
+
+// track is the instrumentFunc of the tracker. Every call to Controller
+// passes the generic controller of the client again, only the first one is
+// recorded.
+func (t *controllerTracker) track(controllerName string, generic controller.GenericController) controller.GenericController {
+	t.Lock()
+	defer t.Unlock()
+
+	for _, c := range t.controllers {
+		if c.generic == generic {
+			return &trackingController{GenericController: generic, tracker: t, tracked: c}
+		}
+	}
+
+	c := &trackedController{
+		name:    controllerName,
+		generic: generic,
+	}
+	t.controllers = append(t.controllers, c)
+	return &trackingController{GenericController: generic, tracker: t, tracked: c}
+}
+
+// started marks the controllers tracked so far as started.
+func (t *controllerTracker) started() {
+	if t == nil {
+		return
+	}
+
+	t.Lock()
+	defer t.Unlock()
+
+	for _, c := range t.controllers {
+		c.started = true
+	}
+}
+
+// startedHandled marks the controllers tracked so far that have handlers as
+// started.
+func (t *controllerTracker) startedHandled() {
+	t.Lock()
+	defer t.Unlock()
+
+	for _, c := range t.controllers {
+		if c.generic.HandlerCount() > 0 {
+			c.started = true
+		}
+	}
+}
+
+// generics returns the generic controllers tracked so far.
+func (t *controllerTracker) generics() []controller.GenericController {
+	t.Lock()
+	defer t.Unlock()
+
+	var result []controller.GenericController
+	for _, c := range t.controllers {
+		result = append(result, c.generic)
+	}
+	return result
+}
+
+func (t *controllerTracker) statuses() []ControllerStatus {
+	if t == nil {
+		return nil
+	}
+
+	t.Lock()
+	defer t.Unlock()
+
+	var result []ControllerStatus
+	for _, c := range t.controllers {
+		status := ControllerStatus{
+			Name:    c.name,
+			Synced:  c.generic.Informer().HasSynced(),
+			Started: c.started,
+		}
+		if c.lastError != nil {
+			status.LastError = c.lastError.Error()
+			status.LastErrorTime = c.lastErrorTime
+		}
+		result = append(result, status)
+	}
+	return result
+}
+
+type trackingController struct {
+	controller.GenericController
+	tracker *controllerTracker
+	tracked *trackedController
+}
+
+func (c *trackingController) AddHandler(ctx context.Context, name string, handler controller.HandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		result, err := handler(key, obj)
+
+		c.tracker.Lock()
+		defer c.tracker.Unlock()
+		if err != nil && !metrics.IgnoreError(err) {
+			c.tracked.lastError = fmt.Errorf("%s %s: %v", name, key, err)
+			c.tracked.lastErrorTime = time.Now()
+			c.tracked.lastErrorHandler = name
+		} else if err == nil && c.tracked.lastErrorHandler == name {
+			c.tracked.lastError = nil
+			c.tracked.lastErrorTime = time.Time{}
+			c.tracked.lastErrorHandler = ""
+		}
+		return result, err
+	})
+}
+
+// waitForCacheSync waits until the caches of the controllers of statuses are
+// synced. On timeout the error names the controllers that are not.
+func waitForCacheSync(ctx context.Context, timeout time.Duration, statuses func() []ControllerStatus) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(cacheSyncPollInterval)
+	defer ticker.Stop()
+
+	for {
+		var pending []string
+		for _, status := range statuses() {
+			if !status.Synced {
+				pending = append(pending, status.Name)
+			}
+		}
+		if len(pending) == 0 {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			sort.Strings(pending)
+			return fmt.Errorf("caches of %s are not synced: %v", strings.Join(pending, ", "), ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// TrackControllers records the controllers created through the clients of the
+// context for Readiness from then on. The New functions of the contexts call
+// it, contexts built otherwise have to call it before the handlers are added.
+func (c *ScaledContext) TrackControllers() {
+	if c.tracker == nil {
+		c.tracker = &controllerTracker{}
+		c.instrument(c.tracker.track)
+	}
+}
+
+// Readiness returns the status of the controllers of the context.
+func (c *ScaledContext) Readiness() []ControllerStatus {
+	return c.tracker.statuses()
+}
+
+// WaitForCacheSync waits up to timeout for the caches of the controllers of
+// the context to be synced.
+func (c *ScaledContext) WaitForCacheSync(ctx context.Context, timeout time.Duration) error {
+	return waitForCacheSync(ctx, timeout, c.Readiness)
+}
+
+// TrackControllers records the controllers created through the clients of the
+// context for Readiness from then on. The New functions of the contexts call
+// it, contexts built otherwise have to call it before the handlers are added.
+func (c *ManagementContext) TrackControllers() {
+	if c.tracker == nil {
+		c.tracker = &controllerTracker{}
+		c.instrument(c.tracker.track)
+	}
+}
+
+// Readiness returns the status of the controllers of the context.
+func (c *ManagementContext) Readiness() []ControllerStatus {
+	return c.tracker.statuses()
+}
+
+// WaitForCacheSync waits up to timeout for the caches of the controllers of
+// the context to be synced.
+func (c *ManagementContext) WaitForCacheSync(ctx context.Context, timeout time.Duration) error {
+	return waitForCacheSync(ctx, timeout, c.Readiness)
+}
+
+// TrackControllers records the controllers created through the clients of the
+// context, not of its Management context, for Readiness from then on. The New
+// functions of the contexts call it, contexts built otherwise have to call
+// it before the handlers are added.
+func (w *UserContext) TrackControllers() {
+	if w.tracker == nil {
+		w.tracker = &controllerTracker{}
+		w.instrument(w.tracker.track)
+	}
+}
+
+// Readiness returns the status of the controllers of the context, those of
+// its Management context included.
+func (w *UserContext) Readiness() []ControllerStatus {
+	return append(w.Management.Readiness(), w.tracker.statuses()...)
+}
+
+// WaitForCacheSync waits up to timeout for the caches of the controllers of
+// the context and its Management context to be synced.
+func (w *UserContext) WaitForCacheSync(ctx context.Context, timeout time.Duration) error {
+	return waitForCacheSync(ctx, timeout, w.Readiness)
+}
+
+// TrackControllers records the controllers created through the clients of the
+// context for Readiness from then on. The New functions of the contexts call
+// it, contexts built otherwise have to call it before the handlers are added.
+func (w *UserOnlyContext) TrackControllers() {
+	if w.tracker == nil {
+		w.tracker = &controllerTracker{}
+		w.instrument(w.tracker.track)
+	}
+}
+
+// Readiness returns the status of the controllers of the context.
+func (w *UserOnlyContext) Readiness() []ControllerStatus {
+	return w.tracker.statuses()
+}
+
+// WaitForCacheSync waits up to timeout for the caches of the controllers of
+// the context to be synced.
+func (w *UserOnlyContext) WaitForCacheSync(ctx context.Context, timeout time.Duration) error {
+	return waitForCacheSync(ctx, timeout, w.Readiness)
+}
@@ -0,0 +1,79 @@
+package config
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rancher/norman/controller"
+	"k8s.io/client-go/tools/cache"
+)
+
+type stubInformer struct {
+	cache.SharedIndexInformer
+	synced bool
+}
+
+func (i *stubInformer) HasSynced() bool {
+	return i.synced
+}
+
+type stubController struct {
+	controller.GenericController
+	informer *stubInformer
+	handlers []controller.HandlerFunc
+}
+
+func (c *stubController) Informer() cache.SharedIndexInformer {
+	return c.informer
+}
+
+func (c *stubController) AddHandler(ctx context.Context, name string, handler controller.HandlerFunc) {
+	c.handlers = append(c.handlers, handler)
+}
+
+func TestReadiness(t *testing.T) {
+	tracker := &controllerTracker{}
+	clusters := &stubController{informer: &stubInformer{synced: true}}
+	nodes := &stubController{informer: &stubInformer{}}
+
+	tracker.track("ClusterController", clusters)
+	tracker.track("ClusterController", clusters).AddHandler(context.Background(), "sync", func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return nil, nil
+		}
+		return nil, errors.New("failed")
+	})
+	tracker.track("NodeController", nodes)
+
+	clusters.handlers[0]("c-1", struct{}{})
+	tracker.started()
+
+	statuses := tracker.statuses()
+	if len(statuses) != 2 {
+		t.Fatalf("expected 2 controllers, got %v", statuses)
+	}
+	if s := statuses[0]; !s.Synced || !s.Started || s.LastError != "sync c-1: failed" {
+		t.Errorf("unexpected status of the cluster controller %+v", s)
+	}
+	if s := statuses[1]; s.Synced || s.LastError != "" {
+		t.Errorf("unexpected status of the node controller %+v", s)
+	}
+
+	clusters.handlers[0]("c-1", nil)
+	if s := tracker.statuses()[0]; s.LastError != "" || !s.LastErrorTime.IsZero() {
+		t.Errorf("expected the error to be cleared by a success, got %+v", s)
+	}
+
+	err := waitForCacheSync(context.Background(), 50*time.Millisecond, tracker.statuses)
+	if err == nil || !strings.Contains(err.Error(), "caches of NodeController are not synced") {
+		t.Errorf("expected NodeController to block the sync, got %v", err)
+	}
+
+	nodes.informer.synced = true
+	if err := waitForCacheSync(context.Background(), time.Second, tracker.statuses); err != nil {
+		t.Errorf("expected the caches to be synced, got %v", err)
+	}
+}
@@ -0,0 +1,25 @@
+package config
+
+import (
+	"github.com/rancher/types/sharding"
+)
+
+// Sharded returns a copy of the context whose clients shard the handlers
+// added through them across the peers of sharder. Handlers added through the
+// clients of c run on every peer, so every handler opts in on its own. Both
+// share the controllers started by c.Start.
+func (c *ScaledContext) Sharded(sharder *sharding.Sharder) *ScaledContext {
+	sharded := *c
+	sharded.instrument(sharder.Instrument)
+	return &sharded
+}
+
+// Sharded returns a copy of the context whose clients shard the handlers
+// added through them across the peers of sharder. Handlers added through the
+// clients of c run on every peer, so every handler opts in on its own. Both
+// share the controllers started by c.Start.
+func (c *ManagementContext) Sharded(sharder *sharding.Sharder) *ManagementContext {
+	sharded := *c
+	sharded.instrument(sharder.Instrument)
+	return &sharded
+}
@@ -0,0 +1,400 @@
+package config
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/rancher/norman/controller"
+	"github.com/rancher/norman/types"
+	v3 "github.com/rancher/types/apis/management.cattle.io/v3"
+	"github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/rest"
+)
+
+const (
+	defaultIdleTimeout = 10 * time.Minute
+	defaultSyncTimeout = time.Minute
+	userThreadiness    = 5
+)
+
+// RegisterFunc adds the handlers of a consumer of a UserContext. The handlers
+// have to be added with ctx, it is done when the consumer is released or the
+// context is replaced.
+type RegisterFunc func(ctx context.Context, userContext *UserContext) error
+
+// UserContextManager shares the UserContexts of the downstream clusters among
+// their consumers. A context is created and started by its first consumer,
+// stopped once it had no consumers for IdleTimeout and replaced when the
+// APIEndpoint or CACert of its cluster change.
+type UserContextManager struct {
+	// NewUserContext creates the UserContext of a cluster, by default a
+	// context on the APIEndpoint, CACert and ServiceAccountToken of the
+	// cluster through the ClusterDialer of the ScaledContext
+	NewUserContext func(cluster *v3.Cluster) (*UserContext, error)
+	// IdleTimeout is the time contexts without consumers are kept. It,
+	// SyncTimeout and NewUserContext have to be set before the first Acquire.
+	IdleTimeout time.Duration
+	// SyncTimeout is the time Acquire and the restart of a context wait for
+	// the caches of the controllers, the controllers are still started once
+	// they are synced
+	SyncTimeout time.Duration
+
+	scaledContext *ScaledContext
+	ctx           context.Context
+
+	lock      sync.Mutex
+	contexts  map[string]*pooledUserContext
+	evictOnce sync.Once
+}
+
+type pooledUserContext struct {
+	sync.Mutex
+	clusterName string
+	endpoint    string
+	caCert      string
+
+	userContext *UserContext
+	ctx         context.Context
+	cancel      context.CancelFunc
+
+	consumers map[*userContextConsumer]bool
+	idleSince time.Time
+}
+
+type userContextConsumer struct {
+	register RegisterFunc
+	cancel   context.CancelFunc
+}
+
+// NewUserContextManager returns a UserContextManager for the clusters of
+// scaledContext. It watches the clusters with a handler of scaledContext, so
+// it has to be created before scaledContext is started. Contexts are stopped
+// when ctx is done.
+func NewUserContextManager(ctx context.Context, scaledContext *ScaledContext) *UserContextManager {
+	m := &UserContextManager{
+		IdleTimeout:   defaultIdleTimeout,
+		SyncTimeout:   defaultSyncTimeout,
+		scaledContext: scaledContext,
+		ctx:           ctx,
+		contexts:      map[string]*pooledUserContext{},
+	}
+	m.NewUserContext = m.newUserContext
+
+	scaledContext.Management.Clusters("").AddHandler(ctx, "user-context-manager", m.sync)
+	return m
+}
+
+// Acquire adds a consumer to the UserContext of clusterName and starts the
+// controllers it added handlers to, waiting at most SyncTimeout for their
+// caches. register is called with the context and
+// again with every context that replaces it. The handlers of the consumer
+// are removed by release, which has to be called once.
+func (m *UserContextManager) Acquire(clusterName string, register RegisterFunc) (release func(), err error) {
+	pooled, err := m.pooled(clusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	pooled.Lock()
+	consumer := &userContextConsumer{
+		register: register,
+	}
+	err = pooled.add(consumer)
+	userContext, ctx := pooled.userContext, pooled.ctx
+	pooled.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	release = func() {
+		pooled.Lock()
+		defer pooled.Unlock()
+
+		consumer.cancel()
+		delete(pooled.consumers, consumer)
+		if len(pooled.consumers) == 0 {
+			pooled.idleSince = time.Now()
+		}
+	}
+	if err := m.start(ctx, clusterName, userContext); err != nil {
+		release()
+		return nil, err
+	}
+	return release, nil
+}
+
+// Get returns the current UserContext of clusterName, nil if it has none.
+func (m *UserContextManager) Get(clusterName string) *UserContext {
+	m.lock.Lock()
+	pooled := m.contexts[clusterName]
+	m.lock.Unlock()
+
+	if pooled == nil {
+		return nil
+	}
+
+	pooled.Lock()
+	defer pooled.Unlock()
+	return pooled.userContext
+}
+
+// pooled returns the entry of clusterName, creating its context if needed.
+// The manager is not locked while the context is created.
+func (m *UserContextManager) pooled(clusterName string) (*pooledUserContext, error) {
+	m.lock.Lock()
+	if pooled, ok := m.contexts[clusterName]; ok {
+		m.lock.Unlock()
+		return pooled, nil
+	}
+
+	pooled := &pooledUserContext{
+		clusterName: clusterName,
+		consumers:   map[*userContextConsumer]bool{},
+		idleSince:   time.Now(),
+	}
+	pooled.Lock()
+	defer pooled.Unlock()
+	m.contexts[clusterName] = pooled
+	m.lock.Unlock()
+
+	m.evictOnce.Do(func() {
+		go m.evictIdle()
+	})
+
+	cluster, err := m.scaledContext.Management.Clusters("").Get(clusterName, metav1.GetOptions{})
+	if err == nil {
+		err = m.replace(pooled, cluster)
+	}
+	if err != nil {
+		m.remove(clusterName, pooled)
+		return nil, err
+	}
+	return pooled, nil
+}
+
+// remove drops pooled from the entries if it is still the entry of
+// clusterName.
+func (m *UserContextManager) remove(clusterName string, pooled *pooledUserContext) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if m.contexts[clusterName] == pooled {
+		delete(m.contexts, clusterName)
+	}
+}
+
+// replace stops the context of pooled, if any, and creates a new one for
+// cluster with the handlers of the consumers of pooled. The new context is
+// started by the caller with start, once pooled is unlocked.
+func (m *UserContextManager) replace(pooled *pooledUserContext, cluster *v3.Cluster) error {
+	if pooled.cancel != nil {
+		pooled.cancel()
+	}
+	pooled.userContext, pooled.ctx, pooled.cancel = nil, nil, nil
+
+	userContext, err := m.NewUserContext(cluster)
+	if err != nil {
+		return fmt.Errorf("failed to create the context of cluster %s: %v", cluster.Name, err)
+	}
+
+	pooled.userContext = userContext
+	pooled.ctx, pooled.cancel = context.WithCancel(m.ctx)
+	pooled.endpoint = cluster.Status.APIEndpoint
+	pooled.caCert = cluster.Status.CACert
+
+	consumers := pooled.consumers
+	pooled.consumers = map[*userContextConsumer]bool{}
+
+	var errs []error
+	for consumer := range consumers {
+		if err := pooled.add(consumer); err != nil {
+			errs = append(errs, err)
+			consumer.cancel = func() {}
+			pooled.consumers[consumer] = true
+		}
+	}
+	if err := types.NewErrors(errs...); err != nil {
+		// replaced again when the cluster is synced again
+		pooled.endpoint = ""
+		return err
+	}
+	return nil
+}
+
+// add registers the handlers of consumer with the context of p, the context
+// has to be started afterwards.
+func (p *pooledUserContext) add(consumer *userContextConsumer) error {
+	if p.userContext == nil {
+		return fmt.Errorf("cluster %s has no context", p.clusterName)
+	}
+
+	ctx, cancel := context.WithCancel(p.ctx)
+	if err := consumer.register(ctx, p.userContext); err != nil {
+		cancel()
+		return err
+	}
+	consumer.cancel = cancel
+	p.consumers[consumer] = true
+	return nil
+}
+
+// start syncs and starts the controllers with handlers of userContext. It is
+// called without locks, as syncing waits for the downstream cluster, and
+// gives up waiting after SyncTimeout.
+func (m *UserContextManager) start(ctx context.Context, clusterName string, userContext *UserContext) error {
+	timeout := m.SyncTimeout
+	if timeout <= 0 {
+		timeout = defaultSyncTimeout
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- userContext.startHandled(ctx)
+	}()
+
+	select {
+	case err := <-done:
+		return err
+	case <-time.After(timeout):
+		return fmt.Errorf("timed out after %v waiting for the caches of cluster %s", timeout, clusterName)
+	}
+}
+
+func (m *UserContextManager) sync(key string, cluster *v3.Cluster) (runtime.Object, error) {
+	m.lock.Lock()
+	pooled := m.contexts[key]
+	if pooled != nil && (cluster == nil || cluster.DeletionTimestamp != nil) {
+		delete(m.contexts, key)
+	}
+	m.lock.Unlock()
+
+	if pooled == nil {
+		return cluster, nil
+	}
+
+	pooled.Lock()
+	if cluster == nil || cluster.DeletionTimestamp != nil {
+		pooled.stop()
+		pooled.Unlock()
+		return cluster, nil
+	}
+	if pooled.userContext != nil &&
+		pooled.endpoint == cluster.Status.APIEndpoint && pooled.caCert == cluster.Status.CACert {
+		pooled.Unlock()
+		return cluster, nil
+	}
+
+	logrus.Infof("Restarting the context of cluster %s", cluster.Name)
+	err := m.replace(pooled, cluster)
+	userContext, ctx, consumers := pooled.userContext, pooled.ctx, len(pooled.consumers)
+	pooled.Unlock()
+	if err != nil || consumers == 0 {
+		return cluster, err
+	}
+	return cluster, m.start(ctx, cluster.Name, userContext)
+}
+
+func (p *pooledUserContext) stop() {
+	for consumer := range p.consumers {
+		consumer.cancel()
+	}
+	if p.cancel != nil {
+		p.cancel()
+	}
+	p.userContext, p.ctx, p.cancel = nil, nil, nil
+}
+
+func (m *UserContextManager) evictIdle() {
+	timeout := m.IdleTimeout
+	if timeout <= 0 {
+		timeout = defaultIdleTimeout
+	}
+
+	for {
+		select {
+		case <-m.ctx.Done():
+			return
+		case <-time.After(timeout / 2):
+		}
+
+		m.lock.Lock()
+		contexts := map[string]*pooledUserContext{}
+		for name, pooled := range m.contexts {
+			contexts[name] = pooled
+		}
+		m.lock.Unlock()
+
+		for name, pooled := range contexts {
+			pooled.Lock()
+			if len(pooled.consumers) == 0 && time.Since(pooled.idleSince) >= timeout {
+				logrus.Debugf("Stopping the idle context of cluster %s", name)
+				m.remove(name, pooled)
+				pooled.stop()
+			}
+			pooled.Unlock()
+		}
+	}
+}
+
+func (m *UserContextManager) newUserContext(cluster *v3.Cluster) (*UserContext, error) {
+	if cluster.Status.APIEndpoint == "" || cluster.Status.CACert == "" {
+		return nil, fmt.Errorf("cluster %s has no API endpoint and CA certificate yet", cluster.Name)
+	}
+
+	caCert, err := base64.StdEncoding.DecodeString(cluster.Status.CACert)
+	if err != nil {
+		return nil, err
+	}
+
+	dial, err := m.scaledContext.Dialer.ClusterDialer(cluster.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	config := rest.Config{
+		Host:        cluster.Status.APIEndpoint,
+		BearerToken: cluster.Status.ServiceAccountToken,
+		TLSClientConfig: rest.TLSClientConfig{
+			CAData: caCert,
+		},
+		Dial: func(ctx context.Context, network, address string) (net.Conn, error) {
+			return dial(network, address)
+		},
+	}
+	return NewUserContext(m.scaledContext, config, cluster.Name)
+}
+
+// startHandled syncs the caches of the controllers of the context and its
+// Management context and starts those that have handlers, contexts without
+// tracked controllers are started as a whole.
+func (w *UserContext) startHandled(ctx context.Context) error {
+	if w.tracker == nil || w.Management.tracker == nil {
+		return w.Start(ctx)
+	}
+
+	var synced, started []controller.Starter
+	for _, tracker := range []*controllerTracker{w.Management.tracker, w.tracker} {
+		for _, generic := range tracker.generics() {
+			synced = append(synced, generic)
+			if generic.HandlerCount() > 0 {
+				started = append(started, generic)
+			}
+		}
+	}
+
+	if err := controller.Sync(ctx, synced...); err != nil {
+		return err
+	}
+	if err := controller.Start(ctx, userThreadiness, started...); err != nil {
+		return err
+	}
+	w.Management.tracker.startedHandled()
+	w.tracker.startedHandled()
+	return nil
+}
@@ -0,0 +1,123 @@
+package config_test
+
+import (
+	"context"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	v3 "github.com/rancher/types/apis/management.cattle.io/v3"
+	"github.com/rancher/types/config"
+	"github.com/rancher/types/config/fake"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestUserContextManager(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	management := fake.NewCluster()
+	scaledContext := fake.NewScaledContext(management)
+	clusters := scaledContext.Management.Clusters("")
+	if _, err := clusters.Create(&v3.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "c-1"},
+		Status:     v3.ClusterStatus{APIEndpoint: "https://a"},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	var created, registered int32
+	manager := config.NewUserContextManager(ctx, scaledContext)
+	manager.IdleTimeout = 100 * time.Millisecond
+	manager.NewUserContext = func(cluster *v3.Cluster) (*config.UserContext, error) {
+		atomic.AddInt32(&created, 1)
+		return fake.NewUserContext(management, fake.NewCluster(), cluster.Name), nil
+	}
+	if err := scaledContext.Start(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	release, err := manager.Acquire("c-1", func(ctx context.Context, userContext *config.UserContext) error {
+		atomic.AddInt32(&registered, 1)
+		userContext.Core.Namespaces("").AddHandler(ctx, "test", func(key string, obj *corev1.Namespace) (runtime.Object, error) {
+			return obj, nil
+		})
+		// tracked without handlers
+		userContext.Core.Pods("").Controller()
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	userContext := manager.Get("c-1")
+	if userContext == nil {
+		t.Fatal("expected a context for c-1")
+	}
+	statuses := map[string]config.ControllerStatus{}
+	for _, status := range userContext.Readiness() {
+		statuses[status.Name] = status
+	}
+	if status, ok := statuses["NamespaceController"]; !ok || !status.Started {
+		t.Errorf("expected the controller with a handler to be started, got %+v", statuses)
+	}
+	if status, ok := statuses["PodController"]; !ok || status.Started {
+		t.Errorf("expected the controller without handlers to stay unstarted, got %+v", statuses)
+	}
+
+	cluster, err := clusters.Get("c-1", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	cluster.Status.APIEndpoint = "https://b"
+	if _, err := clusters.Update(cluster); err != nil {
+		t.Fatal(err)
+	}
+	waitFor(t, "the context to be replaced", func() bool {
+		return atomic.LoadInt32(&created) == 2 && atomic.LoadInt32(&registered) == 2
+	})
+	if manager.Get("c-1") == userContext {
+		t.Error("expected a new context after the endpoint changed")
+	}
+
+	release()
+	waitFor(t, "the idle context to be evicted", func() bool {
+		return manager.Get("c-1") == nil
+	})
+}
+
+func TestUserContextManagerNoEndpoint(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	management := fake.NewCluster()
+	scaledContext := fake.NewScaledContext(management)
+	if _, err := scaledContext.Management.Clusters("").Create(&v3.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "c-1"},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	manager := config.NewUserContextManager(ctx, scaledContext)
+	if _, err := manager.Acquire("c-1", func(ctx context.Context, userContext *config.UserContext) error {
+		return nil
+	}); err == nil || !strings.Contains(err.Error(), "has no API endpoint") {
+		t.Errorf("expected an error for a cluster without endpoint, got %v", err)
+	}
+	if manager.Get("c-1") != nil {
+		t.Error("expected no context for a cluster without endpoint")
+	}
+}
+
+func waitFor(t *testing.T, what string, done func() bool) {
+	for i := 0; i < 100; i++ {
+		if done() {
+			return
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+	t.Fatalf("timed out waiting for %s", what)
+}
@@ -0,0 +1,84 @@
+package fakeclient
+
+import (
+	"errors"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+// Client has the methods of objectclient.ObjectClient for the objects of a
+// Resource, limited to one namespace unless ns is empty.
+type Client struct {
+	resource *Resource
+	ns       string
+}
+
+// Create stores o, labeled as created by norman like the object client does.
+func (c *Client) Create(o runtime.Object) (runtime.Object, error) {
+	o = o.DeepCopyObject()
+	metadata, err := meta.Accessor(o)
+	if err != nil {
+		return nil, err
+	}
+	labels := map[string]string{}
+	for k, v := range metadata.GetLabels() {
+		labels[k] = v
+	}
+	labels["cattle.io/creator"] = "norman"
+	metadata.SetLabels(labels)
+	return c.resource.Create(c.ns, o)
+}
+
+func (c *Client) Get(name string, opts metav1.GetOptions) (runtime.Object, error) {
+	return c.resource.Get(c.ns, name)
+}
+
+func (c *Client) GetNamespaced(namespace, name string, opts metav1.GetOptions) (runtime.Object, error) {
+	return c.resource.Get(namespace, name)
+}
+
+func (c *Client) Update(name string, o runtime.Object) (runtime.Object, error) {
+	if len(name) == 0 {
+		return nil, errors.New("object missing name")
+	}
+	metadata, err := meta.Accessor(o)
+	if err != nil {
+		return nil, err
+	}
+	if metadata.GetName() != name {
+		o = o.DeepCopyObject()
+		metadata, _ = meta.Accessor(o)
+		metadata.SetName(name)
+	}
+	return c.resource.Update(c.ns, o)
+}
+
+func (c *Client) Delete(name string, opts *metav1.DeleteOptions) error {
+	return c.resource.Delete(c.ns, name)
+}
+
+func (c *Client) DeleteNamespaced(namespace, name string, opts *metav1.DeleteOptions) error {
+	return c.resource.Delete(namespace, name)
+}
+
+// List returns the matching objects and the resource version they were listed
+// at.
+func (c *Client) List(opts metav1.ListOptions) ([]runtime.Object, string, error) {
+	return c.resource.List(c.ns, opts)
+}
+
+func (c *Client) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return c.resource.Watch(c.ns, opts)
+}
+
+func (c *Client) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return c.resource.DeleteCollection(c.ns, listOpts)
+}
+
+// Controller returns the controller of the namespace of the client.
+func (c *Client) Controller() *Controller {
+	return c.resource.Controller(c.ns)
+}
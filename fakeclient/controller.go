@@ -0,0 +1,315 @@
+package fakeclient
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strconv"
+	"sync"
+	"time"
+
+	errors2 "github.com/pkg/errors"
+	"github.com/rancher/norman/controller"
+	"github.com/rancher/norman/types"
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+)
+
+type handlerDef struct {
+	name    string
+	handler controller.HandlerFunc
+}
+
+// Controller implements controller.GenericController on a Resource. Its cache
+// is updated as soon as the tracker changes, and its queue is worked off by
+// Start, or by Drain of the tracker.
+type Controller struct {
+	sync.Mutex
+	resource            *Resource
+	namespace           string
+	indexer             cache.Indexer
+	queue               workqueue.RateLimitingInterface
+	handlers            []*handlerDef
+	eventHandlers       []cache.ResourceEventHandler
+	threadinessOverride int
+	synced              bool
+	running             bool
+}
+
+var _ controller.GenericController = &Controller{}
+
+func newController(r *Resource, ns string) *Controller {
+	return &Controller{
+		resource:  r,
+		namespace: ns,
+		indexer: cache.NewIndexer(cache.DeletionHandlingMetaNamespaceKeyFunc, cache.Indexers{
+			cache.NamespaceIndex: cache.MetaNamespaceIndexFunc,
+		}),
+		queue: workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), r.gvk.Kind+"Controller"),
+	}
+}
+
+func (c *Controller) SetThreadinessOverride(count int) {
+	c.Lock()
+	defer c.Unlock()
+	c.threadinessOverride = count
+}
+
+func (c *Controller) Informer() cache.SharedIndexInformer {
+	return &informer{
+		controller: c,
+	}
+}
+
+func (c *Controller) AddHandler(ctx context.Context, name string, handler controller.HandlerFunc) {
+	c.Lock()
+	h := &handlerDef{
+		name:    name,
+		handler: handler,
+	}
+	c.handlers = append(c.handlers, h)
+	c.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		c.Lock()
+		var handlers []*handlerDef
+		for _, handler := range c.handlers {
+			if handler != h {
+				handlers = append(handlers, handler)
+			}
+		}
+		c.handlers = handlers
+		c.Unlock()
+	}()
+}
+
+func (c *Controller) HandlerCount() int {
+	c.Lock()
+	defer c.Unlock()
+	return len(c.handlers)
+}
+
+func (c *Controller) Enqueue(namespace, name string) {
+	if namespace == "" {
+		c.queue.Add(name)
+	} else {
+		c.queue.Add(namespace + "/" + name)
+	}
+}
+
+// Sync queues every cached object once, like the initial list of an
+// informer.
+func (c *Controller) Sync(ctx context.Context) error {
+	c.sync()
+	return nil
+}
+
+func (c *Controller) sync() {
+	c.Lock()
+	defer c.Unlock()
+	if c.synced {
+		return
+	}
+	c.synced = true
+	for _, key := range c.indexer.ListKeys() {
+		c.queue.Add(key)
+	}
+}
+
+func (c *Controller) Start(ctx context.Context, threadiness int) error {
+	c.Lock()
+	defer c.Unlock()
+
+	if c.running {
+		return nil
+	}
+	c.running = true
+	if c.threadinessOverride > 0 {
+		threadiness = c.threadinessOverride
+	}
+	for i := 0; i < threadiness; i++ {
+		go wait.Until(c.runWorker, time.Second, ctx.Done())
+	}
+	go func() {
+		<-ctx.Done()
+		c.queue.ShutDown()
+	}()
+	return nil
+}
+
+func (c *Controller) runWorker() {
+	for c.processNextWorkItem() {
+	}
+}
+
+func (c *Controller) processNextWorkItem() bool {
+	key, quit := c.queue.Get()
+	if quit {
+		return false
+	}
+	defer c.queue.Done(key)
+
+	err := c.syncHandler(key.(string))
+	if err == nil || isForgetError(err) {
+		c.queue.Forget(key)
+		return true
+	}
+	if !isConflict(err) {
+		logrus.Errorf("%sController %v %v", c.resource.gvk.Kind, key, err)
+	}
+	c.queue.AddRateLimited(key)
+	return true
+}
+
+// drain works off the queued keys without retrying failed ones.
+func (c *Controller) drain() (int, []error) {
+	var (
+		errs []error
+		n    int
+	)
+	for c.queue.Len() > 0 {
+		key, quit := c.queue.Get()
+		if quit {
+			break
+		}
+		n++
+		if err := c.syncHandler(key.(string)); err != nil && !isForgetError(err) {
+			errs = append(errs, fmt.Errorf("%sController %v: %v", c.resource.gvk.Kind, key, err))
+		}
+		c.queue.Forget(key)
+		c.queue.Done(key)
+	}
+	return n, errs
+}
+
+func (c *Controller) syncHandler(key string) error {
+	obj, exists, err := c.indexer.GetByKey(key)
+	if err != nil {
+		return err
+	} else if !exists {
+		obj = nil
+	}
+
+	c.Lock()
+	handlers := c.handlers
+	c.Unlock()
+
+	var errs []error
+	for _, handler := range handlers {
+		newObj, err := handler.handler(key, obj)
+		if err != nil {
+			errs = append(errs, &handlerError{
+				name: handler.name,
+				err:  err,
+			})
+		} else if newObj != nil && !reflect.ValueOf(newObj).IsNil() {
+			obj = newObj
+		}
+	}
+	return types.NewErrors(errs...)
+}
+
+type handlerError struct {
+	name string
+	err  error
+}
+
+func (h *handlerError) Error() string {
+	return fmt.Sprintf("[%s] failed with : %v", h.name, h.err)
+}
+
+func (h *handlerError) Cause() error {
+	return h.err
+}
+
+func isForgetError(err error) bool {
+	_, ok := errors2.Cause(err).(*controller.ForgetError)
+	return ok
+}
+
+func isConflict(err error) bool {
+	return errors.IsConflict(errors2.Cause(err))
+}
+
+// observe applies e to the cache and queues its key, the tracker is locked.
+func (c *Controller) observe(e event) {
+	switch e.eventType {
+	case watch.Deleted:
+		c.indexer.Delete(e.obj)
+	default:
+		c.indexer.Update(e.obj)
+	}
+	c.queue.Add(e.key)
+}
+
+func (c *Controller) callEventHandlers(e event) {
+	c.Lock()
+	handlers := c.eventHandlers
+	c.Unlock()
+
+	for _, handler := range handlers {
+		switch {
+		case e.eventType == watch.Deleted:
+			handler.OnDelete(e.obj)
+		case e.old == nil:
+			handler.OnAdd(e.obj)
+		default:
+			handler.OnUpdate(e.old, e.obj)
+		}
+	}
+}
+
+// informer exposes the cache of a Controller, it has nothing to run.
+type informer struct {
+	controller *Controller
+}
+
+func (i *informer) AddEventHandler(handler cache.ResourceEventHandler) {
+	i.controller.Lock()
+	i.controller.eventHandlers = append(i.controller.eventHandlers, handler)
+	i.controller.Unlock()
+
+	for _, obj := range i.controller.indexer.List() {
+		handler.OnAdd(obj)
+	}
+}
+
+func (i *informer) AddEventHandlerWithResyncPeriod(handler cache.ResourceEventHandler, resyncPeriod time.Duration) {
+	i.AddEventHandler(handler)
+}
+
+func (i *informer) GetStore() cache.Store {
+	return i.controller.indexer
+}
+
+func (i *informer) GetController() cache.Controller {
+	return nil
+}
+
+func (i *informer) Run(stopCh <-chan struct{}) {
+	<-stopCh
+}
+
+func (i *informer) HasSynced() bool {
+	return true
+}
+
+func (i *informer) LastSyncResourceVersion() string {
+	tracker := i.controller.resource.tracker
+	tracker.Lock()
+	defer tracker.Unlock()
+	return strconv.FormatInt(tracker.resourceVersion, 10)
+}
+
+func (i *informer) AddIndexers(indexers cache.Indexers) error {
+	return i.controller.indexer.AddIndexers(indexers)
+}
+
+func (i *informer) GetIndexer() cache.Indexer {
+	return i.controller.indexer
+}
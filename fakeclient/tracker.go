@@ -0,0 +1,567 @@
+// Package fakeclient is the in-memory backend of the fake clientsets generated
+// into the fakes packages of the api groups. Objects are kept per kind in a
+// Tracker, the controllers of a kind see every change of the tracker like an
+// informer would, and lifecycles keep the annotations and finalizers of the
+// norman lifecycle adapter.
+package fakeclient
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/rancher/norman/types"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	utilrand "k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+// maxDrainRounds bounds Drain, handlers that keep changing the objects they
+// handle never settle.
+const maxDrainRounds = 1000
+
+// Tracker stores the objects of the resources of one or more fake
+// clientsets.
+type Tracker struct {
+	sync.Mutex
+	resourceVersion int64
+	resources       []*Resource
+	started         *startedContext
+}
+
+type startedContext struct {
+	ctx         context.Context
+	threadiness int
+}
+
+func NewTracker() *Tracker {
+	return &Tracker{}
+}
+
+// Resource returns the store of the kind gvk, creating it on first use.
+func (t *Tracker) Resource(gvk schema.GroupVersionKind, apiResource metav1.APIResource) *Resource {
+	t.Lock()
+	defer t.Unlock()
+
+	for _, r := range t.resources {
+		if r.gvk == gvk {
+			return r
+		}
+	}
+
+	r := &Resource{
+		tracker:     t,
+		gvk:         gvk,
+		apiResource: apiResource,
+		objects:     map[string]runtime.Object{},
+		watchers:    map[*watcher]bool{},
+		controllers: map[string]*Controller{},
+	}
+	t.resources = append(t.resources, r)
+	return r
+}
+
+// Sync lists the existing objects into the controllers of all resources, like
+// the initial list of their informers.
+func (t *Tracker) Sync(ctx context.Context) error {
+	for _, c := range t.controllers() {
+		if err := c.Sync(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Start starts the workers of all controllers, controllers created afterwards
+// are started on creation.
+func (t *Tracker) Start(ctx context.Context, threadiness int) error {
+	t.Lock()
+	t.started = &startedContext{
+		ctx:         ctx,
+		threadiness: threadiness,
+	}
+	t.Unlock()
+
+	for _, c := range t.controllers() {
+		if err := c.Start(ctx, threadiness); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Drain runs the handlers of all controllers on their queued keys until no
+// keys are left, in the calling goroutine. It is meant for tests that do not
+// Start the controllers. Failed keys are not retried, their errors are
+// returned once everything else settled.
+func (t *Tracker) Drain() error {
+	var errs []error
+	for i := 0; i < maxDrainRounds; i++ {
+		processed := false
+		for _, c := range t.controllers() {
+			c.sync()
+			n, err := c.drain()
+			if n > 0 {
+				processed = true
+			}
+			errs = append(errs, err...)
+		}
+		if !processed {
+			return types.NewErrors(errs...)
+		}
+	}
+	return fmt.Errorf("handlers did not settle after %d rounds", maxDrainRounds)
+}
+
+func (t *Tracker) controllers() []*Controller {
+	t.Lock()
+	defer t.Unlock()
+
+	var result []*Controller
+	for _, r := range t.resources {
+		var namespaces []string
+		for ns := range r.controllers {
+			namespaces = append(namespaces, ns)
+		}
+		sort.Strings(namespaces)
+		for _, ns := range namespaces {
+			result = append(result, r.controllers[ns])
+		}
+	}
+	return result
+}
+
+func (t *Tracker) nextResourceVersion() string {
+	t.resourceVersion++
+	return strconv.FormatInt(t.resourceVersion, 10)
+}
+
+// Resource is the store of one kind.
+type Resource struct {
+	tracker     *Tracker
+	gvk         schema.GroupVersionKind
+	apiResource metav1.APIResource
+	objects     map[string]runtime.Object
+	watchers    map[*watcher]bool
+	controllers map[string]*Controller
+}
+
+// event is a change of an object.
+type event struct {
+	eventType watch.EventType
+	key       string
+	namespace string
+	old       runtime.Object
+	obj       runtime.Object
+}
+
+func (r *Resource) groupResource() schema.GroupResource {
+	return schema.GroupResource{
+		Group:    r.gvk.Group,
+		Resource: r.apiResource.Name,
+	}
+}
+
+func (r *Resource) namespace(ns string) string {
+	if !r.apiResource.Namespaced {
+		return ""
+	}
+	return ns
+}
+
+func (r *Resource) key(ns, name string) string {
+	if ns = r.namespace(ns); ns != "" {
+		return ns + "/" + name
+	}
+	return name
+}
+
+// Add stores objects without running the checks of Create, for seeding a
+// fake clientset with existing objects.
+func (r *Resource) Add(objects ...runtime.Object) error {
+	var events []event
+	r.tracker.Lock()
+	for _, obj := range objects {
+		obj = obj.DeepCopyObject()
+		metadata, err := meta.Accessor(obj)
+		if err != nil {
+			r.tracker.Unlock()
+			return err
+		}
+		r.setTypeMeta(obj)
+		metadata.SetNamespace(r.namespace(metadata.GetNamespace()))
+		if metadata.GetResourceVersion() == "" {
+			metadata.SetResourceVersion(r.tracker.nextResourceVersion())
+		}
+		events = append(events, r.store(metadata, obj))
+	}
+	r.tracker.Unlock()
+
+	r.dispatch(events)
+	return nil
+}
+
+func (r *Resource) Create(ns string, obj runtime.Object) (runtime.Object, error) {
+	obj = obj.DeepCopyObject()
+	metadata, err := meta.Accessor(obj)
+	if err != nil {
+		return nil, err
+	}
+	if metadata.GetNamespace() == "" {
+		metadata.SetNamespace(ns)
+	}
+	metadata.SetNamespace(r.namespace(metadata.GetNamespace()))
+	if metadata.GetName() == "" && metadata.GetGenerateName() != "" {
+		metadata.SetName(metadata.GetGenerateName() + utilrand.String(5))
+	}
+	if metadata.GetName() == "" {
+		return nil, errors.NewInvalid(r.gvk.GroupKind(), "", field.ErrorList{
+			field.Required(field.NewPath("metadata", "name"), "name or generateName is required"),
+		})
+	}
+	if r.apiResource.Namespaced && metadata.GetNamespace() == "" {
+		return nil, errors.NewBadRequest("the server could not find the requested resource")
+	}
+
+	r.tracker.Lock()
+	key := r.key(metadata.GetNamespace(), metadata.GetName())
+	if _, ok := r.objects[key]; ok {
+		r.tracker.Unlock()
+		return nil, errors.NewAlreadyExists(r.groupResource(), metadata.GetName())
+	}
+	r.setTypeMeta(obj)
+	metadata.SetUID(newUID())
+	metadata.SetCreationTimestamp(metav1.Now())
+	metadata.SetDeletionTimestamp(nil)
+	metadata.SetResourceVersion(r.tracker.nextResourceVersion())
+	e := r.store(metadata, obj)
+	r.tracker.Unlock()
+
+	r.dispatch([]event{e})
+	return obj.DeepCopyObject(), nil
+}
+
+func (r *Resource) Get(ns, name string) (runtime.Object, error) {
+	r.tracker.Lock()
+	defer r.tracker.Unlock()
+
+	obj, ok := r.objects[r.key(ns, name)]
+	if !ok {
+		return nil, errors.NewNotFound(r.groupResource(), name)
+	}
+	return obj.DeepCopyObject(), nil
+}
+
+// Update replaces the object, it fails with a conflict if the resource
+// version of obj is set and stale. The object is removed if it is deleted
+// and obj has no finalizers left.
+func (r *Resource) Update(ns string, obj runtime.Object) (runtime.Object, error) {
+	obj = obj.DeepCopyObject()
+	metadata, err := meta.Accessor(obj)
+	if err != nil {
+		return nil, err
+	}
+	if metadata.GetNamespace() == "" {
+		metadata.SetNamespace(ns)
+	}
+	metadata.SetNamespace(r.namespace(metadata.GetNamespace()))
+
+	r.tracker.Lock()
+	key := r.key(metadata.GetNamespace(), metadata.GetName())
+	existing, ok := r.objects[key]
+	if !ok {
+		r.tracker.Unlock()
+		return nil, errors.NewNotFound(r.groupResource(), metadata.GetName())
+	}
+	existingMeta, err := meta.Accessor(existing)
+	if err != nil {
+		r.tracker.Unlock()
+		return nil, err
+	}
+	if rv := metadata.GetResourceVersion(); rv != "" && rv != existingMeta.GetResourceVersion() {
+		r.tracker.Unlock()
+		return nil, errors.NewConflict(r.groupResource(), metadata.GetName(),
+			fmt.Errorf("the object has been modified; please apply your changes to the latest version and try again"))
+	}
+
+	r.setTypeMeta(obj)
+	metadata.SetUID(existingMeta.GetUID())
+	metadata.SetCreationTimestamp(existingMeta.GetCreationTimestamp())
+	metadata.SetDeletionTimestamp(existingMeta.GetDeletionTimestamp())
+	metadata.SetResourceVersion(r.tracker.nextResourceVersion())
+
+	var e event
+	if metadata.GetDeletionTimestamp() != nil && len(metadata.GetFinalizers()) == 0 {
+		e = r.remove(key, obj)
+	} else {
+		e = r.store(metadata, obj)
+	}
+	r.tracker.Unlock()
+
+	r.dispatch([]event{e})
+	return obj.DeepCopyObject(), nil
+}
+
+// Delete removes the object, or only marks it deleted while it has
+// finalizers.
+func (r *Resource) Delete(ns, name string) error {
+	r.tracker.Lock()
+	key := r.key(ns, name)
+	existing, ok := r.objects[key]
+	if !ok {
+		r.tracker.Unlock()
+		return errors.NewNotFound(r.groupResource(), name)
+	}
+	obj := existing.DeepCopyObject()
+	metadata, err := meta.Accessor(obj)
+	if err != nil {
+		r.tracker.Unlock()
+		return err
+	}
+
+	var e event
+	if len(metadata.GetFinalizers()) == 0 {
+		e = r.remove(key, obj)
+	} else if metadata.GetDeletionTimestamp() == nil {
+		now := metav1.Now()
+		metadata.SetDeletionTimestamp(&now)
+		metadata.SetResourceVersion(r.tracker.nextResourceVersion())
+		e = r.store(metadata, obj)
+	}
+	r.tracker.Unlock()
+
+	if e.eventType != "" {
+		r.dispatch([]event{e})
+	}
+	return nil
+}
+
+// List returns the objects in ns, all namespaces if ns is empty, matching the
+// label and field selectors of opts, sorted by namespace and name.
+func (r *Resource) List(ns string, opts metav1.ListOptions) ([]runtime.Object, string, error) {
+	matches, err := r.matcher(ns, opts)
+	if err != nil {
+		return nil, "", err
+	}
+
+	r.tracker.Lock()
+	defer r.tracker.Unlock()
+
+	var keys []string
+	for key, obj := range r.objects {
+		if matches(obj) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	var result []runtime.Object
+	for _, key := range keys {
+		result = append(result, r.objects[key].DeepCopyObject())
+	}
+	return result, strconv.FormatInt(r.tracker.resourceVersion, 10), nil
+}
+
+// Watch returns the changes to the objects List would return for the same
+// arguments, from now on.
+func (r *Resource) Watch(ns string, opts metav1.ListOptions) (watch.Interface, error) {
+	matches, err := r.matcher(ns, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	r.tracker.Lock()
+	defer r.tracker.Unlock()
+
+	w := newWatcher(matches, func(w *watcher) {
+		r.tracker.Lock()
+		delete(r.watchers, w)
+		r.tracker.Unlock()
+	})
+	r.watchers[w] = true
+	return w, nil
+}
+
+func (r *Resource) DeleteCollection(ns string, listOpts metav1.ListOptions) error {
+	objs, _, err := r.List(ns, listOpts)
+	if err != nil {
+		return err
+	}
+	for _, obj := range objs {
+		metadata, err := meta.Accessor(obj)
+		if err != nil {
+			return err
+		}
+		if err := r.Delete(metadata.GetNamespace(), metadata.GetName()); err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *Resource) matcher(ns string, opts metav1.ListOptions) (func(runtime.Object) bool, error) {
+	ns = r.namespace(ns)
+	labelSelector := labels.Everything()
+	if opts.LabelSelector != "" {
+		selector, err := labels.Parse(opts.LabelSelector)
+		if err != nil {
+			return nil, errors.NewBadRequest(err.Error())
+		}
+		labelSelector = selector
+	}
+	fieldSelector := fields.Everything()
+	if opts.FieldSelector != "" {
+		selector, err := fields.ParseSelector(opts.FieldSelector)
+		if err != nil {
+			return nil, errors.NewBadRequest(err.Error())
+		}
+		fieldSelector = selector
+	}
+
+	return func(obj runtime.Object) bool {
+		metadata, err := meta.Accessor(obj)
+		if err != nil {
+			return false
+		}
+		if ns != "" && metadata.GetNamespace() != ns {
+			return false
+		}
+		return labelSelector.Matches(labels.Set(metadata.GetLabels())) &&
+			fieldSelector.Matches(fields.Set{
+				"metadata.name":      metadata.GetName(),
+				"metadata.namespace": metadata.GetNamespace(),
+			})
+	}, nil
+}
+
+func (r *Resource) setTypeMeta(obj runtime.Object) {
+	obj.GetObjectKind().SetGroupVersionKind(r.gvk)
+}
+
+// store saves obj, the tracker must be locked.
+func (r *Resource) store(metadata metav1.Object, obj runtime.Object) event {
+	key := r.key(metadata.GetNamespace(), metadata.GetName())
+	e := event{
+		eventType: watch.Added,
+		key:       key,
+		namespace: metadata.GetNamespace(),
+		old:       r.objects[key],
+		obj:       obj.DeepCopyObject(),
+	}
+	if e.old != nil {
+		e.eventType = watch.Modified
+	}
+	r.objects[key] = e.obj
+	r.notify(e)
+	return e
+}
+
+// remove deletes the object at key, the tracker must be locked.
+func (r *Resource) remove(key string, obj runtime.Object) event {
+	metadata, _ := meta.Accessor(obj)
+	e := event{
+		eventType: watch.Deleted,
+		key:       key,
+		namespace: metadata.GetNamespace(),
+		old:       r.objects[key],
+		obj:       obj.DeepCopyObject(),
+	}
+	delete(r.objects, key)
+	r.notify(e)
+	return e
+}
+
+// notify sends e to the watchers and the caches of the controllers, the
+// tracker must be locked so they see the changes in order.
+func (r *Resource) notify(e event) {
+	for w := range r.watchers {
+		w.send(watch.Event{
+			Type:   e.eventType,
+			Object: e.obj,
+		})
+	}
+	for _, c := range r.controllers {
+		if c.namespace == "" || c.namespace == e.namespace {
+			c.observe(e)
+		}
+	}
+}
+
+// dispatch calls the event handlers of the informers of the controllers with
+// events, outside of the lock of the tracker as they may use the clients.
+func (r *Resource) dispatch(events []event) {
+	r.tracker.Lock()
+	var controllers []*Controller
+	for _, c := range r.controllers {
+		controllers = append(controllers, c)
+	}
+	r.tracker.Unlock()
+
+	for _, e := range events {
+		for _, c := range controllers {
+			if c.namespace == "" || c.namespace == e.namespace {
+				c.callEventHandlers(e)
+			}
+		}
+	}
+}
+
+// Controller returns the controller of the objects in ns, all namespaces if ns
+// is empty.
+func (r *Resource) Controller(ns string) *Controller {
+	ns = r.namespace(ns)
+
+	r.tracker.Lock()
+	c, ok := r.controllers[ns]
+	if ok {
+		r.tracker.Unlock()
+		return c
+	}
+
+	c = newController(r, ns)
+	for _, obj := range r.objects {
+		metadata, _ := meta.Accessor(obj)
+		if ns == "" || metadata.GetNamespace() == ns {
+			c.indexer.Add(obj)
+		}
+	}
+	r.controllers[ns] = c
+	started := r.tracker.started
+	r.tracker.Unlock()
+
+	if started != nil {
+		c.Sync(started.ctx)
+		c.Start(started.ctx, started.threadiness)
+	}
+	return c
+}
+
+// Client returns a client of the objects in ns, all namespaces if ns is empty.
+func (r *Resource) Client(ns string) *Client {
+	return &Client{
+		resource: r,
+		ns:       r.namespace(ns),
+	}
+}
+
+func newUID() k8stypes.UID {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return k8stypes.UID(strconv.FormatInt(time.Now().UnixNano(), 16))
+	}
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+	return k8stypes.UID(fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:]))
+}
@@ -0,0 +1,91 @@
+package fakeclient
+
+import (
+	"sync"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+// watcher buffers the events of a Watch without bound, so changes to the
+// tracker never block on a slow consumer.
+type watcher struct {
+	sync.Mutex
+	matches func(runtime.Object) bool
+	onStop  func(*watcher)
+	pending []watch.Event
+	stopped bool
+	wake    chan struct{}
+	stop    chan struct{}
+	result  chan watch.Event
+}
+
+func newWatcher(matches func(runtime.Object) bool, onStop func(*watcher)) *watcher {
+	w := &watcher{
+		matches: matches,
+		onStop:  onStop,
+		wake:    make(chan struct{}, 1),
+		stop:    make(chan struct{}),
+		result:  make(chan watch.Event),
+	}
+	go w.run()
+	return w
+}
+
+func (w *watcher) send(e watch.Event) {
+	if !w.matches(e.Object) {
+		return
+	}
+	e.Object = e.Object.DeepCopyObject()
+
+	w.Lock()
+	if !w.stopped {
+		w.pending = append(w.pending, e)
+	}
+	w.Unlock()
+
+	select {
+	case w.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (w *watcher) run() {
+	defer close(w.result)
+	for {
+		w.Lock()
+		pending := w.pending
+		w.pending = nil
+		w.Unlock()
+
+		for _, e := range pending {
+			select {
+			case w.result <- e:
+			case <-w.stop:
+				return
+			}
+		}
+
+		select {
+		case <-w.wake:
+		case <-w.stop:
+			return
+		}
+	}
+}
+
+func (w *watcher) Stop() {
+	w.Lock()
+	defer w.Unlock()
+	if w.stopped {
+		return
+	}
+	w.stopped = true
+	w.pending = nil
+	close(w.stop)
+	go w.onStop(w)
+}
+
+func (w *watcher) ResultChan() <-chan watch.Event {
+	return w.result
+}
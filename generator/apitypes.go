@@ -0,0 +1,255 @@
+package generator
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/gengo/args"
+)
+
+// apiTypeSet is the set of named struct types declared in the api packages of
+// this repo, the registered kinds map to true.
+type apiTypeSet map[reflect.Type]bool
+
+// apiTypes returns the struct types under basePackage/apis reachable from the
+// kinds registered by addToSchemes.
+func apiTypes(addToSchemes ...func(*runtime.Scheme) error) (apiTypeSet, error) {
+	scheme := runtime.NewScheme()
+	for _, addToScheme := range addToSchemes {
+		if err := addToScheme(scheme); err != nil {
+			return nil, err
+		}
+	}
+
+	result := apiTypeSet{}
+	for _, t := range scheme.AllKnownTypes() {
+		result.collect(t)
+		if isAPIType(t) {
+			result[t] = true
+		}
+	}
+	return result, nil
+}
+
+func isAPIType(t reflect.Type) bool {
+	return t.Kind() == reflect.Struct && t.Name() != "" &&
+		strings.HasPrefix(t.PkgPath(), basePackage+"/"+baseK8s+"/")
+}
+
+func (s apiTypeSet) collect(t reflect.Type) {
+	switch t.Kind() {
+	case reflect.Ptr, reflect.Slice, reflect.Array, reflect.Map:
+		s.collect(t.Elem())
+		return
+	}
+	if _, ok := s[t]; ok || !isAPIType(t) {
+		return
+	}
+	s[t] = false
+	for i := 0; i < t.NumField(); i++ {
+		if name, _ := jsonField(t.Field(i)); name != "-" {
+			s.collect(t.Field(i).Type)
+		}
+	}
+}
+
+func (s apiTypeSet) packages() []string {
+	seen := map[string]bool{}
+	var result []string
+	for t := range s {
+		if !seen[t.PkgPath()] {
+			seen[t.PkgPath()] = true
+			result = append(result, t.PkgPath())
+		}
+	}
+	sort.Strings(result)
+	return result
+}
+
+// kinds returns the registered kinds of pkg.
+func (s apiTypeSet) kinds(pkg string) []reflect.Type {
+	var result []reflect.Type
+	for _, t := range s.byPackage(pkg) {
+		if s[t] {
+			result = append(result, t)
+		}
+	}
+	return result
+}
+
+func (s apiTypeSet) byPackage(pkg string) []reflect.Type {
+	var result []reflect.Type
+	for t := range s {
+		if t.PkgPath() == pkg {
+			result = append(result, t)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name() < result[j].Name()
+	})
+	return result
+}
+
+// goFile is a generated Go source file of one of the api packages.
+type goFile struct {
+	bytes.Buffer
+	pkg     string
+	imports map[string]string
+}
+
+func newGoFile(pkg string) *goFile {
+	return &goFile{
+		pkg:     pkg,
+		imports: map[string]string{},
+	}
+}
+
+// use records an import and returns the name to reference it by.
+func (w *goFile) use(pkg string) string {
+	if alias, ok := w.imports[pkg]; ok {
+		return alias
+	}
+	alias := filepath.Base(pkg)
+	if strings.HasPrefix(pkg, basePackage+"/") {
+		// the api packages are all named after their version
+		alias = strings.Replace(filepath.Base(filepath.Dir(pkg)), ".", "", -1) + alias
+	}
+	w.imports[pkg] = alias
+	return alias
+}
+
+// typeName returns how t is referenced from the package of the file.
+func (w *goFile) typeName(t reflect.Type) string {
+	if t.Name() == "" {
+		// only used for unnamed slices of builtin types
+		return t.String()
+	}
+	if t.PkgPath() == "" || t.PkgPath() == w.pkg {
+		return t.Name()
+	}
+	return w.use(t.PkgPath()) + "." + t.Name()
+}
+
+// funcName returns how the function name generated for t is referenced from
+// the package of the file.
+func (w *goFile) funcName(prefix string, t reflect.Type) string {
+	if t.PkgPath() == w.pkg {
+		return prefix + t.Name()
+	}
+	return w.use(t.PkgPath()) + "." + prefix + t.Name()
+}
+
+func (w *goFile) write(fileName string) error {
+	var std, imports []string
+	for pkg := range w.imports {
+		if strings.Contains(strings.Split(pkg, "/")[0], ".") {
+			imports = append(imports, pkg)
+		} else {
+			std = append(std, pkg)
+		}
+	}
+	sort.Strings(std)
+	sort.Strings(imports)
+	if len(std) > 0 {
+		std = append(std, "")
+	}
+
+	out := &bytes.Buffer{}
+	fmt.Fprintf(out, "package %s\n\nimport (\n", filepath.Base(w.pkg))
+	for _, pkg := range append(std, imports...) {
+		if pkg == "" {
+			fmt.Fprintln(out)
+			continue
+		}
+		if alias := w.imports[pkg]; alias != filepath.Base(pkg) {
+			fmt.Fprintf(out, "%s ", alias)
+		}
+		fmt.Fprintf(out, "%q\n", pkg)
+	}
+	fmt.Fprintf(out, ")\n\n")
+	out.Write(w.Bytes())
+
+	content, err := format.Source(out.Bytes())
+	if err != nil {
+		return fmt.Errorf("failed to format %s in %s: %v", fileName, w.pkg, err)
+	}
+
+	outputDir := filepath.Join(args.DefaultSourceTree(), w.pkg)
+	return ioutil.WriteFile(filepath.Join(outputDir, fileName), content, 0644)
+}
+
+// markedTypes are the api types with a field of interest, directly or
+// through one of their fields.
+type markedTypes map[reflect.Type]bool
+
+// markTypes marks the types of types that have a field for which marked
+// returns true.
+func markTypes(types apiTypeSet, marked func(reflect.StructField, normanTag) bool) (markedTypes, error) {
+	result := markedTypes{}
+	for t := range types {
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			if name, inline := jsonField(field); name == "-" || inline {
+				continue
+			}
+			tag, err := parseNormanTag(field)
+			if err != nil {
+				return nil, err
+			}
+			if marked(field, tag) {
+				result[t] = true
+				break
+			}
+		}
+	}
+
+	for changed := true; changed; {
+		changed = false
+		for t := range types {
+			if result[t] {
+				continue
+			}
+			for i := 0; i < t.NumField(); i++ {
+				if name, _ := jsonField(t.Field(i)); name != "-" && result.nested(t.Field(i).Type) != nil {
+					result[t] = true
+					changed = true
+					break
+				}
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// nested returns the marked struct type held by t, if any.
+func (m markedTypes) nested(t reflect.Type) reflect.Type {
+	switch t.Kind() {
+	case reflect.Ptr:
+		t = t.Elem()
+	case reflect.Slice:
+		t = t.Elem()
+		if t.Kind() == reflect.Ptr {
+			t = t.Elem()
+		}
+	case reflect.Map:
+		if t.Key().Kind() != reflect.String {
+			return nil
+		}
+		t = t.Elem()
+		if t.Kind() == reflect.Ptr {
+			t = t.Elem()
+		}
+	}
+	if m[t] {
+		return t
+	}
+	return nil
+}
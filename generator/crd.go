@@ -0,0 +1,485 @@
+package generator
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/rancher/norman/types"
+	apiextv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8sschema "k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/gengo/args"
+)
+
+var (
+	baseCRDs = "crds"
+
+	timeType        = reflect.TypeOf(metav1.Time{})
+	microTimeType   = reflect.TypeOf(metav1.MicroTime{})
+	durationType    = reflect.TypeOf(metav1.Duration{})
+	objectMetaType  = reflect.TypeOf(metav1.ObjectMeta{})
+	intOrStringType = reflect.TypeOf(intstr.IntOrString{})
+	quantityType    = reflect.TypeOf(resource.Quantity{})
+	rawExtension    = reflect.TypeOf(runtime.RawExtension{})
+	byteSliceType   = reflect.TypeOf([]byte{})
+)
+
+// GenerateCRDs writes a CustomResourceDefinition manifest for every schema
+// in schemas whose type is registered by addToScheme to
+// crds/<group>/<plural>.yaml.
+func GenerateCRDs(schemas *types.Schemas, addToScheme func(*runtime.Scheme) error) {
+	if err := generateCRDs(filepath.Join(basePackage, baseCRDs), schemas, addToScheme); err != nil {
+		panic(err)
+	}
+}
+
+func generateCRDs(baseCRDs string, schemas *types.Schemas, addToScheme func(*runtime.Scheme) error) error {
+	crds, err := CRDs(schemas, addToScheme)
+	if err != nil {
+		return err
+	}
+
+	version := getVersion(schemas)
+	outputDir := filepath.Join(args.DefaultSourceTree(), baseCRDs, version.Group)
+	if err := os.MkdirAll(outputDir, 0755); err != nil {
+		return err
+	}
+
+	for _, crd := range crds {
+		content, err := crdYAML(crd)
+		if err != nil {
+			return err
+		}
+		filePath := crd.Spec.Names.Plural + ".yaml"
+		if err := ioutil.WriteFile(path.Join(outputDir, filePath), content, 0644); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// crdYAML marshals a CRD leaving out the status and the empty creation
+// timestamp.
+func crdYAML(crd *apiextv1.CustomResourceDefinition) ([]byte, error) {
+	content, err := json.Marshal(crd)
+	if err != nil {
+		return nil, err
+	}
+	data := map[string]interface{}{}
+	if err := json.Unmarshal(content, &data); err != nil {
+		return nil, err
+	}
+	delete(data, "status")
+	if metadata, ok := data["metadata"].(map[string]interface{}); ok {
+		delete(metadata, "creationTimestamp")
+	}
+	return yaml.Marshal(data)
+}
+
+// CRDs builds the CustomResourceDefinitions of every schema in schemas whose
+// type is registered by addToScheme, sorted by name.
+func CRDs(schemas *types.Schemas, addToScheme func(*runtime.Scheme) error) ([]*apiextv1.CustomResourceDefinition, error) {
+	scheme := runtime.NewScheme()
+	if err := addToScheme(scheme); err != nil {
+		return nil, err
+	}
+
+	version := getVersion(schemas)
+	knownTypes := scheme.KnownTypes(k8sschema.GroupVersion{
+		Group:   version.Group,
+		Version: version.Version,
+	})
+
+	var result []*apiextv1.CustomResourceDefinition
+	for _, schema := range schemas.Schemas() {
+		t, ok := knownTypes[schema.CodeName]
+		if !ok || !hasObjectMeta(t) {
+			continue
+		}
+		crd, err := newCRD(version, schema, t)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, crd)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+	return result, nil
+}
+
+func hasObjectMeta(t reflect.Type) bool {
+	for i := 0; i < t.NumField(); i++ {
+		if t.Field(i).Type == objectMetaType {
+			return true
+		}
+	}
+	return false
+}
+
+func newCRD(version *types.APIVersion, schema *types.Schema, t reflect.Type) (*apiextv1.CustomResourceDefinition, error) {
+	plural := strings.ToLower(schema.PluralName)
+	scope := apiextv1.ClusterScoped
+	if schema.Scope == types.NamespaceScope {
+		scope = apiextv1.NamespaceScoped
+	}
+
+	props, err := newStructuralSchema().root(t)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build schema for %s: %v", schema.CodeName, err)
+	}
+
+	crd := &apiextv1.CustomResourceDefinition{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: apiextv1.SchemeGroupVersion.String(),
+			Kind:       "CustomResourceDefinition",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: plural + "." + version.Group,
+		},
+		Spec: apiextv1.CustomResourceDefinitionSpec{
+			Group: version.Group,
+			Names: apiextv1.CustomResourceDefinitionNames{
+				Plural:   plural,
+				Singular: strings.ToLower(schema.CodeName),
+				Kind:     schema.CodeName,
+				ListKind: schema.CodeName + "List",
+			},
+			Scope: scope,
+			Versions: []apiextv1.CustomResourceDefinitionVersion{
+				{
+					Name:    version.Version,
+					Served:  true,
+					Storage: true,
+					Schema: &apiextv1.CustomResourceValidation{
+						OpenAPIV3Schema: props,
+					},
+					AdditionalPrinterColumns: printerColumns(props),
+				},
+			},
+		},
+	}
+
+	return crd, nil
+}
+
+func printerColumns(props *apiextv1.JSONSchemaProps) []apiextv1.CustomResourceColumnDefinition {
+	var columns []apiextv1.CustomResourceColumnDefinition
+
+	for _, jsonPath := range [][]string{{"spec", "displayName"}, {"displayName"}} {
+		if hasProperty(props, jsonPath...) {
+			columns = append(columns, apiextv1.CustomResourceColumnDefinition{
+				Name:     "Display Name",
+				Type:     "string",
+				JSONPath: "." + strings.Join(jsonPath, "."),
+			})
+			break
+		}
+	}
+
+	state := false
+	for _, jsonPath := range [][]string{{"status", "phase"}, {"status", "state"}, {"status", "alertState"}, {"status", "executionState"}, {"spec", "state"}} {
+		if hasProperty(props, jsonPath...) {
+			columns = append(columns, apiextv1.CustomResourceColumnDefinition{
+				Name:     "State",
+				Type:     "string",
+				JSONPath: "." + strings.Join(jsonPath, "."),
+			})
+			state = true
+			break
+		}
+	}
+	// the state of most types is computed by the API from their conditions
+	// and not stored, their Ready condition is the closest to it
+	if !state && hasProperty(props, "status", "conditions") {
+		columns = append(columns, apiextv1.CustomResourceColumnDefinition{
+			Name:     "Ready",
+			Type:     "string",
+			JSONPath: `.status.conditions[?(@.type=="Ready")].status`,
+		})
+	}
+
+	return append(columns, apiextv1.CustomResourceColumnDefinition{
+		Name:     "Age",
+		Type:     "date",
+		JSONPath: ".metadata.creationTimestamp",
+	})
+}
+
+func hasProperty(props *apiextv1.JSONSchemaProps, path ...string) bool {
+	for _, name := range path {
+		next, ok := props.Properties[name]
+		if !ok {
+			return false
+		}
+		props = &next
+	}
+	return true
+}
+
+// structuralSchema converts Go types into structural OpenAPI v3 schemas,
+// applying norman tags to the fields.
+type structuralSchema struct {
+	visiting map[reflect.Type]bool
+}
+
+func newStructuralSchema() *structuralSchema {
+	return &structuralSchema{
+		visiting: map[reflect.Type]bool{},
+	}
+}
+
+func preserveUnknown() apiextv1.JSONSchemaProps {
+	t := true
+	return apiextv1.JSONSchemaProps{
+		Type:                   "object",
+		XPreserveUnknownFields: &t,
+	}
+}
+
+func (s *structuralSchema) root(t reflect.Type) (*apiextv1.JSONSchemaProps, error) {
+	props, err := s.structProps(t, true, true)
+	if err != nil {
+		return nil, err
+	}
+	return &props, nil
+}
+
+// structProps returns the schema of the struct t. The required fields of t are
+// only required if withRequired is set, since Go sends structs that are not
+// pointers even when they are empty.
+func (s *structuralSchema) structProps(t reflect.Type, root, withRequired bool) (apiextv1.JSONSchemaProps, error) {
+	if s.visiting[t] {
+		return preserveUnknown(), nil
+	}
+	s.visiting[t] = true
+	defer delete(s.visiting, t)
+
+	props := apiextv1.JSONSchemaProps{
+		Type:       "object",
+		Properties: map[string]apiextv1.JSONSchemaProps{},
+	}
+	if err := s.fields(t, root, withRequired, &props); err != nil {
+		return props, err
+	}
+	sort.Strings(props.Required)
+	return props, nil
+}
+
+func (s *structuralSchema) fields(t reflect.Type, root, withRequired bool, props *apiextv1.JSONSchemaProps) error {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name, inline := jsonField(field)
+		if name == "-" {
+			continue
+		}
+
+		if inline {
+			ft := field.Type
+			if ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				if err := s.fields(ft, root, withRequired, props); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
+		if root && field.Type == objectMetaType {
+			props.Properties[name] = apiextv1.JSONSchemaProps{Type: "object"}
+			continue
+		}
+
+		tag, err := parseNormanTag(field)
+		if err != nil {
+			return err
+		}
+
+		// the server defaults the same fields as the generated defaults
+		tag.HasDefault = hasDefault(field, tag)
+		// strings without omitempty are always sent, empty when not set
+		if field.Type.Kind() == reflect.String && len(tag.Options) > 0 &&
+			!strings.Contains(field.Tag.Get("json"), ",omitempty") && !contains(tag.Options, "") {
+			tag.Options = append(append([]string(nil), tag.Options...), "")
+		}
+
+		fieldProps, err := s.typeProps(field.Type)
+		if err != nil {
+			return fmt.Errorf("field %s: %v", field.Name, err)
+		}
+		if err := applyNormanTag(&fieldProps, tag); err != nil {
+			return fmt.Errorf("field %s: %v", field.Name, err)
+		}
+
+		props.Properties[name] = fieldProps
+		if tag.Required && withRequired {
+			props.Required = append(props.Required, name)
+		}
+	}
+	return nil
+}
+
+func (s *structuralSchema) typeProps(t reflect.Type) (apiextv1.JSONSchemaProps, error) {
+	nullable := false
+	if t.Kind() == reflect.Ptr {
+		nullable = true
+		t = t.Elem()
+	}
+
+	var (
+		props apiextv1.JSONSchemaProps
+		err   error
+	)
+
+	switch {
+	case t == timeType || t == microTimeType:
+		props = apiextv1.JSONSchemaProps{Type: "string", Format: "date-time"}
+		nullable = true
+	case t == durationType:
+		props = apiextv1.JSONSchemaProps{Type: "string"}
+	case t == intOrStringType || t == quantityType:
+		props = apiextv1.JSONSchemaProps{XIntOrString: true}
+	case t == rawExtension || t == objectMetaType:
+		props = preserveUnknown()
+	case t == byteSliceType:
+		props = apiextv1.JSONSchemaProps{Type: "string", Format: "byte"}
+	default:
+		switch t.Kind() {
+		case reflect.String:
+			props = apiextv1.JSONSchemaProps{Type: "string"}
+		case reflect.Bool:
+			props = apiextv1.JSONSchemaProps{Type: "boolean"}
+		case reflect.Int, reflect.Int64, reflect.Uint, reflect.Uint64:
+			props = apiextv1.JSONSchemaProps{Type: "integer", Format: "int64"}
+		case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint8, reflect.Uint16, reflect.Uint32:
+			props = apiextv1.JSONSchemaProps{Type: "integer", Format: "int32"}
+		case reflect.Float32, reflect.Float64:
+			props = apiextv1.JSONSchemaProps{Type: "number"}
+		case reflect.Interface:
+			t := true
+			props = apiextv1.JSONSchemaProps{XPreserveUnknownFields: &t}
+		case reflect.Slice, reflect.Array:
+			var items apiextv1.JSONSchemaProps
+			items, err = s.typeProps(t.Elem())
+			props = apiextv1.JSONSchemaProps{
+				Type: "array",
+				Items: &apiextv1.JSONSchemaPropsOrArray{
+					Schema: &items,
+				},
+			}
+			nullable = true
+		case reflect.Map:
+			var values apiextv1.JSONSchemaProps
+			values, err = s.typeProps(t.Elem())
+			if values.XPreserveUnknownFields != nil && values.Type == "" {
+				props = preserveUnknown()
+			} else {
+				props = apiextv1.JSONSchemaProps{
+					Type: "object",
+					AdditionalProperties: &apiextv1.JSONSchemaPropsOrBool{
+						Allows: true,
+						Schema: &values,
+					},
+				}
+			}
+			nullable = true
+		case reflect.Struct:
+			// only structs behind pointers are left out when they are not set
+			props, err = s.structProps(t, false, nullable)
+		default:
+			err = fmt.Errorf("unsupported type %s", t)
+		}
+	}
+
+	props.Nullable = nullable && props.Type != ""
+	return props, err
+}
+
+func applyNormanTag(props *apiextv1.JSONSchemaProps, tag normanTag) error {
+	target := props
+	if props.Type == "array" && props.Items != nil && props.Items.Schema != nil {
+		// options and lengths on arrays apply to the items
+		target = props.Items.Schema
+	}
+
+	if len(tag.Options) > 0 && target.Type == "string" {
+		for _, option := range tag.Options {
+			content, err := json.Marshal(option)
+			if err != nil {
+				return err
+			}
+			target.Enum = append(target.Enum, apiextv1.JSON{Raw: content})
+		}
+	}
+	if tag.Min != nil {
+		f := float64(*tag.Min)
+		props.Minimum = &f
+	}
+	if tag.Max != nil {
+		f := float64(*tag.Max)
+		props.Maximum = &f
+	}
+	if tag.MinLength != nil && target.Type == "string" {
+		target.MinLength = tag.MinLength
+	}
+	if tag.MaxLength != nil && target.Type == "string" {
+		target.MaxLength = tag.MaxLength
+	}
+	if tag.Nullable != nil && props.Type != "" {
+		props.Nullable = *tag.Nullable
+	}
+	if tag.HasDefault {
+		value, err := defaultJSON(props.Type, tag.Default)
+		if err != nil {
+			return err
+		}
+		if value != nil {
+			props.Default = &apiextv1.JSON{Raw: value}
+		}
+	}
+
+	return nil
+}
+
+func defaultJSON(propsType, value string) ([]byte, error) {
+	switch propsType {
+	case "string":
+		return json.Marshal(value)
+	case "boolean":
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(b)
+	case "integer":
+		i, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(i)
+	case "number":
+		f, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(f)
+	}
+	// defaults on objects and arrays can not be expressed by a norman tag
+	return nil, nil
+}
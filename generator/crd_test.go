@@ -0,0 +1,89 @@
+package generator
+
+import (
+	"reflect"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type testAnswer struct {
+	Values map[string]string `json:"values,omitempty" norman:"required"`
+}
+
+type testSpec struct {
+	DisplayName string      `json:"displayName" norman:"required"`
+	Source      string      `json:"source" norman:"options=db|env"`
+	Mode        string      `json:"mode,omitempty" norman:"options=a|b"`
+	Enabled     bool        `json:"enabled,omitempty" norman:"default=true"`
+	Always      bool        `json:"always" norman:"default=true"`
+	Timeout     int         `json:"timeout,omitempty" norman:"default=30"`
+	Answers     testAnswer  `json:"answers,omitempty"`
+	Override    *testAnswer `json:"override,omitempty"`
+}
+
+type testObject struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Name string   `json:"name" norman:"required"`
+	Spec testSpec `json:"spec"`
+}
+
+func TestStructuralSchemaRequired(t *testing.T) {
+	props, err := newStructuralSchema().root(reflect.TypeOf(testObject{}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(props.Required, []string{"name"}) {
+		t.Errorf("expected the top level field to be required, got %v", props.Required)
+	}
+	spec := props.Properties["spec"]
+	if len(spec.Required) != 0 {
+		t.Errorf("expected no required fields in a struct that is always sent, got %v", spec.Required)
+	}
+	if answers := spec.Properties["answers"]; len(answers.Required) != 0 {
+		t.Errorf("expected no required fields in an empty struct, got %v", answers.Required)
+	}
+	if override := spec.Properties["override"]; !reflect.DeepEqual(override.Required, []string{"values"}) {
+		t.Errorf("expected the fields of a pointer to be required, got %v", override.Required)
+	}
+}
+
+func TestStructuralSchemaTags(t *testing.T) {
+	props, err := newStructuralSchema().root(reflect.TypeOf(testObject{}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	spec := props.Properties["spec"]
+
+	tests := []struct {
+		field string
+		enum  []string
+		dflt  string
+	}{
+		{field: "source", enum: []string{`"db"`, `"env"`, `""`}},
+		{field: "mode", enum: []string{`"a"`, `"b"`}},
+		{field: "enabled", dflt: "true"},
+		{field: "always"},
+		{field: "timeout", dflt: "30"},
+	}
+	for _, test := range tests {
+		fieldProps := spec.Properties[test.field]
+		var enum []string
+		for _, value := range fieldProps.Enum {
+			enum = append(enum, string(value.Raw))
+		}
+		if !reflect.DeepEqual(enum, test.enum) {
+			t.Errorf("%s: expected enum %v, got %v", test.field, test.enum, enum)
+		}
+		dflt := ""
+		if fieldProps.Default != nil {
+			dflt = string(fieldProps.Default.Raw)
+		}
+		if dflt != test.dflt {
+			t.Errorf("%s: expected default %q, got %q", test.field, test.dflt, dflt)
+		}
+	}
+}
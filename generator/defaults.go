@@ -0,0 +1,227 @@
+package generator
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// GenerateDefaults writes a zz_generated_defaults.go file with a
+// SetDefaults_<Type> function for every type reachable from the kinds
+// registered by addToSchemes that has defaults in its norman tags. The kinds
+// are registered with their SchemeBuilder so scheme.Default applies them.
+func GenerateDefaults(addToSchemes ...func(*runtime.Scheme) error) {
+	if err := generateDefaults(addToSchemes...); err != nil {
+		panic(err)
+	}
+}
+
+func generateDefaults(addToSchemes ...func(*runtime.Scheme) error) error {
+	types, err := apiTypes(addToSchemes...)
+	if err != nil {
+		return err
+	}
+
+	defaults, err := markTypes(types, hasDefault)
+	if err != nil {
+		return err
+	}
+
+	for _, pkg := range types.packages() {
+		w := newGoFile(pkg)
+		var kinds []reflect.Type
+		for _, t := range types.kinds(pkg) {
+			if defaults[t] {
+				kinds = append(kinds, t)
+			}
+		}
+		if len(kinds) > 0 {
+			writeRegisterDefaults(w, kinds)
+		}
+
+		for _, t := range types.byPackage(pkg) {
+			if !defaults[t] {
+				continue
+			}
+			if err := writeSetDefaults(w, defaults, t); err != nil {
+				return err
+			}
+		}
+		if w.Len() == 0 {
+			continue
+		}
+		if err := w.write("zz_generated_defaults.go"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// hasDefault returns whether the default in tag is applied to field. Zero
+// values of omitempty fields are left out on the wire like missing ones, so
+// they are defaulted as the api does. Bools and numbers without omitempty are
+// always sent, their zero value was set by the user unless it breaks the min
+// of a number or a required number has no min.
+func hasDefault(field reflect.StructField, tag normanTag) bool {
+	if !tag.HasDefault {
+		return false
+	}
+	if !strings.Contains(field.Tag.Get("json"), ",omitempty") {
+		switch field.Type.Kind() {
+		case reflect.Bool:
+			return false
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+			reflect.Float32, reflect.Float64:
+			zeroValid := tag.Min != nil && *tag.Min <= 0 || tag.Min == nil && !tag.Required
+			if zeroValid {
+				return false
+			}
+		}
+	}
+	_, ok := defaultLiteral(field.Type, tag.Default)
+	return ok
+}
+
+// defaultLiteral returns the Go literal of value for a field of type t, ok is
+// false if there is nothing to default.
+func defaultLiteral(t reflect.Type, value string) (string, bool) {
+	pointer := t.Kind() == reflect.Ptr
+	if pointer {
+		t = t.Elem()
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		return strconv.Quote(value), value != "" || pointer
+	case reflect.Bool:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return "", false
+		}
+		return strconv.FormatBool(b), b || pointer
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		i, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return "", false
+		}
+		return strconv.FormatInt(i, 10), i != 0 || pointer
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return "", false
+		}
+		return strconv.FormatFloat(f, 'g', -1, 64), f != 0 || pointer
+	case reflect.Slice:
+		if pointer || t.Elem().Kind() != reflect.String || value == "" {
+			return "", false
+		}
+		return strconv.Quote(value), true
+	}
+
+	return "", false
+}
+
+func writeRegisterDefaults(w *goFile, kinds []reflect.Type) {
+	w.use("k8s.io/apimachinery/pkg/runtime")
+	fmt.Fprintf(w, "func init() {\nSchemeBuilder.Register(RegisterDefaults)\n}\n\n")
+	fmt.Fprintf(w, "// RegisterDefaults adds defaulters functions to the given scheme.\n")
+	fmt.Fprintf(w, "// Public to allow building arbitrary schemes.\n")
+	fmt.Fprintf(w, "// All generated defaulters are covering - they call all nested defaulters.\n")
+	fmt.Fprintf(w, "func RegisterDefaults(scheme *runtime.Scheme) error {\n")
+	for _, t := range kinds {
+		fmt.Fprintf(w, "scheme.AddTypeDefaultingFunc(&%s{}, func(obj interface{}) { SetDefaults_%s(obj.(*%s)) })\n",
+			t.Name(), t.Name(), t.Name())
+	}
+	fmt.Fprintf(w, "return nil\n}\n\n")
+}
+
+func writeSetDefaults(w *goFile, defaults markedTypes, t reflect.Type) error {
+	fmt.Fprintf(w, "// SetDefaults_%s is an autogenerated defaulting function, filling the zero valued fields of in with the defaults of its norman tags.\n", t.Name())
+	fmt.Fprintf(w, "func SetDefaults_%s(in *%s) {\n", t.Name(), w.typeName(t))
+	if err := writeDefaultFields(w, defaults, t, "in"); err != nil {
+		return err
+	}
+	fmt.Fprintf(w, "}\n\n")
+	return nil
+}
+
+func writeDefaultFields(w *goFile, defaults markedTypes, t reflect.Type, recv string) error {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name, inline := jsonField(field)
+		if name == "-" {
+			continue
+		}
+
+		expr := recv + "." + field.Name
+		if nested := defaults.nested(field.Type); nested != nil {
+			writeNestedDefaults(w, field.Type, nested, expr)
+			continue
+		}
+		if inline {
+			continue
+		}
+
+		tag, err := parseNormanTag(field)
+		if err != nil {
+			return err
+		}
+		if !hasDefault(field, tag) {
+			continue
+		}
+
+		literal, _ := defaultLiteral(field.Type, tag.Default)
+		switch field.Type.Kind() {
+		case reflect.Ptr:
+			elem := field.Type.Elem()
+			value := literal
+			if (elem.Kind() != reflect.Bool && elem.Kind() != reflect.String) || elem.PkgPath() != "" {
+				value = w.typeName(elem) + "(" + literal + ")"
+			}
+			fmt.Fprintf(w, "if %s == nil {\nv := %s\n%s = &v\n}\n", expr, value, expr)
+		case reflect.Slice:
+			fmt.Fprintf(w, "if len(%s) == 0 {\n%s = %s{%s}\n}\n", expr, expr, w.typeName(field.Type), literal)
+		case reflect.Bool:
+			fmt.Fprintf(w, "if !%s {\n%s = %s\n}\n", expr, expr, literal)
+		default:
+			fmt.Fprintf(w, "if %s == %s {\n%s = %s\n}\n", expr, zeroLiteral(field.Type), expr, literal)
+		}
+	}
+	return nil
+}
+
+func writeNestedDefaults(w *goFile, t, nested reflect.Type, expr string) {
+	fn := w.funcName("SetDefaults_", nested)
+	switch t.Kind() {
+	case reflect.Ptr:
+		fmt.Fprintf(w, "if %s != nil {\n%s(%s)\n}\n", expr, fn, expr)
+	case reflect.Slice:
+		if t.Elem().Kind() == reflect.Ptr {
+			fmt.Fprintf(w, "for i := range %s {\nif %s[i] != nil {\n%s(%s[i])\n}\n}\n", expr, expr, fn, expr)
+		} else {
+			fmt.Fprintf(w, "for i := range %s {\n%s(&%s[i])\n}\n", expr, fn, expr)
+		}
+	case reflect.Map:
+		if t.Elem().Kind() == reflect.Ptr {
+			fmt.Fprintf(w, "for _, v := range %s {\nif v != nil {\n%s(v)\n}\n}\n", expr, fn)
+		} else {
+			fmt.Fprintf(w, "for k, v := range %s {\n%s(&v)\n%s[k] = v\n}\n", expr, fn, expr)
+		}
+	default:
+		fmt.Fprintf(w, "%s(&%s)\n", fn, expr)
+	}
+}
+
+func zeroLiteral(t reflect.Type) string {
+	switch t.Kind() {
+	case reflect.String:
+		return `""`
+	}
+	return "0"
+}
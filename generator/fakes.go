@@ -0,0 +1,136 @@
+package generator
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"net/http"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/rancher/norman/types"
+	"k8s.io/gengo/args"
+)
+
+// GenerateFakeClientset writes zz_generated_clientset.go into the fakes
+// package of the api group of schemas, an in-memory implementation of its
+// Interface. It covers the same kinds as the generated controllers, schemas
+// in privateTypes included.
+func GenerateFakeClientset(schemas *types.Schemas, privateTypes map[string]bool) {
+	version := getVersion(schemas)
+	k8sOutputPackage := path.Join(basePackage, baseK8s, version.Group, version.Version)
+
+	if err := generateFakeClientset(k8sOutputPackage, controllerSchemas(schemas, privateTypes)); err != nil {
+		panic(err)
+	}
+}
+
+// controllerSchemas returns the schemas of schemas that have generated
+// controllers.
+func controllerSchemas(schemas *types.Schemas, privateTypes map[string]bool) []*types.Schema {
+	// the types of other modules have no controllers in the api packages, the
+	// vendor check of the norman generator only catches them in a GOPATH
+	var controllers []*types.Schema
+	for _, schema := range schemas.Schemas() {
+		if privateTypes[schema.ID] ||
+			(contains(schema.CollectionMethods, http.MethodGet) &&
+				strings.HasPrefix(schema.PkgName, basePackage+"/")) {
+			controllers = append(controllers, schema)
+		}
+	}
+	return controllers
+}
+
+// nativeSchemas returns the schemas of the kinds of a native api group.
+func nativeSchemas(version *types.APIVersion, nsObjs []interface{}, objs []interface{}) ([]*types.Schema, error) {
+	schemas := types.NewSchemas()
+	var controllers []*types.Schema
+	for _, obj := range append(objs, nsObjs...) {
+		schema, err := schemas.Import(version, obj)
+		if err != nil {
+			return nil, err
+		}
+		controllers = append(controllers, schema)
+	}
+	return controllers, nil
+}
+
+func generateFakeClientset(k8sOutputPackage string, controllers []*types.Schema) error {
+	if len(controllers) == 0 {
+		return nil
+	}
+
+	objectsPackage := controllers[0].PkgName
+	if i := strings.Index(objectsPackage, "/vendor/"); i >= 0 {
+		objectsPackage = objectsPackage[i+len("/vendor/"):]
+	}
+	for _, schema := range controllers {
+		if !strings.HasSuffix(schema.PkgName, objectsPackage) {
+			return fmt.Errorf("the kinds of %s are in more than one package", k8sOutputPackage)
+		}
+	}
+
+	// the aliases moq uses in the mocks of the same package
+	local := path.Base(k8sOutputPackage)
+	objects := local
+	imports := []string{
+		strconv.Quote("github.com/rancher/norman/controller"),
+		strconv.Quote("github.com/rancher/norman/lifecycle"),
+		strconv.Quote("github.com/rancher/norman/objectclient"),
+		strconv.Quote(basePackage + "/fakeclient"),
+		"metav1 " + strconv.Quote("k8s.io/apimachinery/pkg/apis/meta/v1"),
+		strconv.Quote("k8s.io/apimachinery/pkg/api/errors"),
+		strconv.Quote("k8s.io/apimachinery/pkg/labels"),
+		strconv.Quote("k8s.io/apimachinery/pkg/runtime"),
+		strconv.Quote("k8s.io/apimachinery/pkg/runtime/schema"),
+		strconv.Quote("k8s.io/apimachinery/pkg/watch"),
+		strconv.Quote("k8s.io/client-go/rest"),
+		strconv.Quote("k8s.io/client-go/tools/cache"),
+	}
+	if objectsPackage == k8sOutputPackage {
+		imports = append(imports, local+" "+strconv.Quote(k8sOutputPackage))
+	} else {
+		objects = path.Base(objectsPackage)
+		local = objects + "a"
+		imports = append(imports,
+			local+" "+strconv.Quote(k8sOutputPackage),
+			objects+" "+strconv.Quote(objectsPackage))
+	}
+	sort.Slice(imports, func(i, j int) bool {
+		return importPath(imports[i]) < importPath(imports[j])
+	})
+
+	fakesTemplate, err := template.New("fakes.template").
+		Funcs(funcs()).
+		Parse(fakeClientsetTemplate)
+	if err != nil {
+		return err
+	}
+
+	out := &bytes.Buffer{}
+	if err := fakesTemplate.Execute(out, map[string]interface{}{
+		"imports": imports,
+		"local":   local,
+		"objects": objects,
+		"schemas": controllers,
+	}); err != nil {
+		return err
+	}
+
+	content, err := format.Source(out.Bytes())
+	if err != nil {
+		return fmt.Errorf("failed to format the fake clientset of %s: %v", k8sOutputPackage, err)
+	}
+
+	fakesDir := filepath.Join(args.DefaultSourceTree(), k8sOutputPackage, "fakes")
+	return ioutil.WriteFile(filepath.Join(fakesDir, "zz_generated_clientset.go"), content, 0644)
+}
+
+func importPath(spec string) string {
+	return spec[strings.Index(spec, `"`):]
+}
@@ -0,0 +1,332 @@
+package generator
+
+var fakeClientsetTemplate = `package fakes
+
+import (
+	"context"
+	"fmt"
+{{range .imports}}
+	{{.}}
+{{- end}}
+)
+
+// Clientset is an in-memory {{.local}}.Interface. Its objects are kept in a
+// fakeclient.Tracker, which can be shared with the clientsets of other groups
+// to run their controllers together.
+type Clientset struct {
+	*fakeclient.Tracker
+}
+
+var _ {{.local}}.Interface = &Clientset{}
+
+// NewClientset returns a Clientset with a tracker of its own, holding
+// objects. It panics if an object is not a kind of the group.
+func NewClientset(objects ...runtime.Object) *Clientset {
+	return NewClientsetForTracker(fakeclient.NewTracker(), objects...)
+}
+
+// NewClientsetForTracker returns a Clientset storing its objects in tracker.
+// It panics if an object is not a kind of the group.
+func NewClientsetForTracker(tracker *fakeclient.Tracker, objects ...runtime.Object) *Clientset {
+	c := &Clientset{
+		Tracker: tracker,
+	}
+	if err := c.Add(objects...); err != nil {
+		panic(err)
+	}
+	return c
+}
+
+// RESTClient returns nil, the objects of a Clientset are not served.
+func (c *Clientset) RESTClient() rest.Interface {
+	return nil
+}
+
+// Add stores objects as they are, without the checks of Create.
+func (c *Clientset) Add(objects ...runtime.Object) error {
+	for _, obj := range objects {
+		var resource *fakeclient.Resource
+		switch obj.(type) {
+		{{- range .schemas}}
+		case *{{$.objects}}.{{.CodeName}}:
+			resource = c.Resource({{$.local}}.{{.CodeName}}GroupVersionKind, {{$.local}}.{{.CodeName}}Resource)
+		{{- end}}
+		default:
+			return fmt.Errorf("%T is not a kind of %s", obj, {{.local}}.GroupName)
+		}
+		if err := resource.Add(obj); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+{{range .schemas}}
+func (c *Clientset) {{.CodeNamePlural}}(namespace string) {{$.local}}.{{.CodeName}}Interface {
+	resource := c.Resource({{$.local}}.{{.CodeName}}GroupVersionKind, {{$.local}}.{{.CodeName}}Resource)
+	return &{{.ID}}Client{
+		client: resource.Client(namespace),
+	}
+}
+{{end}}
+{{- range .schemas}}
+type {{.ID}}Client struct {
+	client *fakeclient.Client
+}
+
+// ObjectClient returns nil, the objects of a Clientset are not served.
+func (s *{{.ID}}Client) ObjectClient() *objectclient.ObjectClient {
+	return nil
+}
+
+func (s *{{.ID}}Client) Create(o *{{$.objects}}.{{.CodeName}}) (*{{$.objects}}.{{.CodeName}}, error) {
+	obj, err := s.client.Create(o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*{{$.objects}}.{{.CodeName}}), nil
+}
+
+func (s *{{.ID}}Client) Get(name string, opts metav1.GetOptions) (*{{$.objects}}.{{.CodeName}}, error) {
+	obj, err := s.client.Get(name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*{{$.objects}}.{{.CodeName}}), nil
+}
+
+func (s *{{.ID}}Client) GetNamespaced(namespace, name string, opts metav1.GetOptions) (*{{$.objects}}.{{.CodeName}}, error) {
+	obj, err := s.client.GetNamespaced(namespace, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*{{$.objects}}.{{.CodeName}}), nil
+}
+
+func (s *{{.ID}}Client) Update(o *{{$.objects}}.{{.CodeName}}) (*{{$.objects}}.{{.CodeName}}, error) {
+	obj, err := s.client.Update(o.Name, o)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*{{$.objects}}.{{.CodeName}}), nil
+}
+
+func (s *{{.ID}}Client) Delete(name string, options *metav1.DeleteOptions) error {
+	return s.client.Delete(name, options)
+}
+
+func (s *{{.ID}}Client) DeleteNamespaced(namespace, name string, options *metav1.DeleteOptions) error {
+	return s.client.DeleteNamespaced(namespace, name, options)
+}
+
+func (s *{{.ID}}Client) List(opts metav1.ListOptions) (*{{$.local}}.{{.CodeName}}List, error) {
+	objs, resourceVersion, err := s.client.List(opts)
+	if err != nil {
+		return nil, err
+	}
+	list := &{{$.local}}.{{.CodeName}}List{}
+	list.APIVersion = {{$.local}}.{{.CodeName}}GroupVersionKind.GroupVersion().String()
+	list.Kind = {{$.local}}.{{.CodeName}}GroupVersionKind.Kind + "List"
+	list.ResourceVersion = resourceVersion
+	for _, obj := range objs {
+		list.Items = append(list.Items, *obj.(*{{$.objects}}.{{.CodeName}}))
+	}
+	return list, nil
+}
+
+func (s *{{.ID}}Client) Watch(opts metav1.ListOptions) (watch.Interface, error) {
+	return s.client.Watch(opts)
+}
+
+func (s *{{.ID}}Client) DeleteCollection(deleteOpts *metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	return s.client.DeleteCollection(deleteOpts, listOpts)
+}
+
+func (s *{{.ID}}Client) Controller() {{$.local}}.{{.CodeName}}Controller {
+	return &{{.ID}}Controller{
+		GenericController: s.client.Controller(),
+	}
+}
+
+func (s *{{.ID}}Client) AddHandler(ctx context.Context, name string, sync {{$.local}}.{{.CodeName}}HandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *{{.ID}}Client) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync {{$.local}}.{{.CodeName}}HandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *{{.ID}}Client) AddLifecycle(ctx context.Context, name string, lifecycle {{$.local}}.{{.CodeName}}Lifecycle) {
+	sync := new{{.CodeName}}LifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *{{.ID}}Client) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle {{$.local}}.{{.CodeName}}Lifecycle) {
+	sync := new{{.CodeName}}LifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *{{.ID}}Client) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync {{$.local}}.{{.CodeName}}HandlerFunc) {
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *{{.ID}}Client) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync {{$.local}}.{{.CodeName}}HandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+func (s *{{.ID}}Client) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle {{$.local}}.{{.CodeName}}Lifecycle) {
+	sync := new{{.CodeName}}LifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(ctx, name, clusterName, sync)
+}
+
+func (s *{{.ID}}Client) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle {{$.local}}.{{.CodeName}}Lifecycle) {
+	sync := new{{.CodeName}}LifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(ctx, enabled, name, clusterName, sync)
+}
+
+type {{.ID}}Controller struct {
+	controller.GenericController
+}
+
+func (c *{{.ID}}Controller) Generic() controller.GenericController {
+	return c.GenericController
+}
+
+func (c *{{.ID}}Controller) Lister() {{$.local}}.{{.CodeName}}Lister {
+	return &{{.ID}}Lister{
+		controller: c,
+	}
+}
+
+func (c *{{.ID}}Controller) AddHandler(ctx context.Context, name string, handler {{$.local}}.{{.CodeName}}HandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*{{$.objects}}.{{.CodeName}}); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *{{.ID}}Controller) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, handler {{$.local}}.{{.CodeName}}HandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*{{$.objects}}.{{.CodeName}}); ok {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *{{.ID}}Controller) AddClusterScopedHandler(ctx context.Context, name, cluster string, handler {{$.local}}.{{.CodeName}}HandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*{{$.objects}}.{{.CodeName}}); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+func (c *{{.ID}}Controller) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, cluster string, handler {{$.local}}.{{.CodeName}}HandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		if !enabled() {
+			return nil, nil
+		} else if obj == nil {
+			return handler(key, nil)
+		} else if v, ok := obj.(*{{$.objects}}.{{.CodeName}}); ok && controller.ObjectInCluster(cluster, obj) {
+			return handler(key, v)
+		} else {
+			return nil, nil
+		}
+	})
+}
+
+type {{.ID}}Lister struct {
+	controller *{{.ID}}Controller
+}
+
+func (l *{{.ID}}Lister) List(namespace string, selector labels.Selector) (ret []*{{$.objects}}.{{.CodeName}}, err error) {
+	err = cache.ListAllByNamespace(l.controller.Informer().GetIndexer(), namespace, selector, func(obj interface{}) {
+		ret = append(ret, obj.(*{{$.objects}}.{{.CodeName}}))
+	})
+	return
+}
+
+func (l *{{.ID}}Lister) Get(namespace, name string) (*{{$.objects}}.{{.CodeName}}, error) {
+	var key string
+	if namespace != "" {
+		key = namespace + "/" + name
+	} else {
+		key = name
+	}
+	obj, exists, err := l.controller.Informer().GetIndexer().GetByKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(schema.GroupResource{
+			Group:    {{$.local}}.{{.CodeName}}GroupVersionKind.Group,
+			Resource: "{{.ID}}",
+		}, key)
+	}
+	return obj.(*{{$.objects}}.{{.CodeName}}), nil
+}
+
+type {{.ID}}LifecycleAdapter struct {
+	lifecycle {{$.local}}.{{.CodeName}}Lifecycle
+}
+
+func (w *{{.ID}}LifecycleAdapter) HasCreate() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasCreate()
+}
+
+func (w *{{.ID}}LifecycleAdapter) HasFinalize() bool {
+	o, ok := w.lifecycle.(lifecycle.ObjectLifecycleCondition)
+	return !ok || o.HasFinalize()
+}
+
+func (w *{{.ID}}LifecycleAdapter) Create(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Create(obj.(*{{$.objects}}.{{.CodeName}}))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *{{.ID}}LifecycleAdapter) Finalize(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Remove(obj.(*{{$.objects}}.{{.CodeName}}))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func (w *{{.ID}}LifecycleAdapter) Updated(obj runtime.Object) (runtime.Object, error) {
+	o, err := w.lifecycle.Updated(obj.(*{{$.objects}}.{{.CodeName}}))
+	if o == nil {
+		return nil, err
+	}
+	return o, err
+}
+
+func new{{.CodeName}}LifecycleAdapter(name string, clusterScoped bool, client *{{.ID}}Client, l {{$.local}}.{{.CodeName}}Lifecycle) {{$.local}}.{{.CodeName}}HandlerFunc {
+	adapter := &{{.ID}}LifecycleAdapter{lifecycle: l}
+	syncFn := fakeclient.NewObjectLifecycleAdapter(name, clusterScoped, adapter, client.client)
+	return func(key string, obj *{{$.objects}}.{{.CodeName}}) (runtime.Object, error) {
+		newObj, err := syncFn(key, obj)
+		if o, ok := newObj.(runtime.Object); ok {
+			return o, err
+		}
+		return nil, err
+	}
+}
+{{end}}`
@@ -121,11 +121,23 @@ func GenerateNativeTypes(gv schema.GroupVersion, nsObjs []interface{}, objs []in
 
 	k8sOutputPackage := path.Join(basePackage, baseK8s, groupPath, version)
 
-	if err := generator.GenerateControllerForTypes(&types.APIVersion{
+	apiVersion := &types.APIVersion{
 		Version: version,
 		Group:   group,
 		Path:    fmt.Sprintf("/k8s/%s-%s", groupPath, version),
-	}, k8sOutputPackage, nsObjs, objs); err != nil {
+	}
+	if err := generator.GenerateControllerForTypes(apiVersion, k8sOutputPackage, nsObjs, objs); err != nil {
+		panic(err)
+	}
+
+	controllers, err := nativeSchemas(apiVersion, nsObjs, objs)
+	if err != nil {
+		panic(err)
+	}
+	if err := generateFakeClientset(k8sOutputPackage, controllers); err != nil {
+		panic(err)
+	}
+	if err := generateInstrumented(k8sOutputPackage, controllers); err != nil {
 		panic(err)
 	}
 }
@@ -0,0 +1,55 @@
+package generator
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"path"
+	"path/filepath"
+	"text/template"
+
+	"github.com/rancher/norman/types"
+	"k8s.io/gengo/args"
+)
+
+// GenerateInstrumented writes zz_generated_instrumented.go into the api
+// package of schemas, a decorator of its Interface that passes the generic
+// controllers of its kinds through a function, to observe their handlers.
+func GenerateInstrumented(schemas *types.Schemas, privateTypes map[string]bool) {
+	version := getVersion(schemas)
+	k8sOutputPackage := path.Join(basePackage, baseK8s, version.Group, version.Version)
+
+	if err := generateInstrumented(k8sOutputPackage, controllerSchemas(schemas, privateTypes)); err != nil {
+		panic(err)
+	}
+}
+
+func generateInstrumented(k8sOutputPackage string, controllers []*types.Schema) error {
+	if len(controllers) == 0 {
+		return nil
+	}
+
+	instrumented, err := template.New("instrumented.template").
+		Funcs(funcs()).
+		Parse(instrumentedTemplate)
+	if err != nil {
+		return err
+	}
+
+	out := &bytes.Buffer{}
+	if err := instrumented.Execute(out, map[string]interface{}{
+		"local":   path.Base(k8sOutputPackage),
+		"schemas": controllers,
+	}); err != nil {
+		return err
+	}
+
+	content, err := format.Source(out.Bytes())
+	if err != nil {
+		return fmt.Errorf("failed to format the instrumented clients of %s: %v", k8sOutputPackage, err)
+	}
+
+	outputDir := filepath.Join(args.DefaultSourceTree(), k8sOutputPackage)
+	return ioutil.WriteFile(filepath.Join(outputDir, "zz_generated_instrumented.go"), content, 0644)
+}
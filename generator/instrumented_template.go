@@ -0,0 +1,83 @@
+package generator
+
+var instrumentedTemplate = `package {{.local}}
+
+import (
+	"context"
+
+	"github.com/rancher/norman/controller"
+	"github.com/rancher/types/scope"
+)
+
+// NewInstrumented returns client with the generic controllers of its kinds
+// passed through instrument, which can wrap them to observe their handlers.
+// Cluster scoped handlers are added with the cluster name in their context,
+// see scope.WithClusterName.
+func NewInstrumented(client Interface, instrument func(controllerName string, generic controller.GenericController) controller.GenericController) Interface {
+	return &instrumentedClient{
+		Interface:  client,
+		instrument: instrument,
+	}
+}
+
+type instrumentedClient struct {
+	Interface
+	instrument func(controllerName string, generic controller.GenericController) controller.GenericController
+}
+{{range .schemas}}
+func (c *instrumentedClient) {{.CodeNamePlural}}(namespace string) {{.CodeName}}Interface {
+	return &instrumented{{.CodeName}}Client{
+		{{.CodeName}}Interface: c.Interface.{{.CodeNamePlural}}(namespace),
+		instrument:            c.instrument,
+	}
+}
+
+type instrumented{{.CodeName}}Client struct {
+	{{.CodeName}}Interface
+	instrument func(controllerName string, generic controller.GenericController) controller.GenericController
+}
+
+func (s *instrumented{{.CodeName}}Client) Controller() {{.CodeName}}Controller {
+	return &{{.ID}}Controller{
+		GenericController: s.instrument({{.CodeName}}GroupVersionKind.Kind+"Controller",
+			s.{{.CodeName}}Interface.Controller().Generic()),
+	}
+}
+
+func (s *instrumented{{.CodeName}}Client) AddHandler(ctx context.Context, name string, sync {{.CodeName}}HandlerFunc) {
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *instrumented{{.CodeName}}Client) AddFeatureHandler(ctx context.Context, enabled func() bool, name string, sync {{.CodeName}}HandlerFunc) {
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *instrumented{{.CodeName}}Client) AddLifecycle(ctx context.Context, name string, lifecycle {{.CodeName}}Lifecycle) {
+	sync := New{{.CodeName}}LifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddHandler(ctx, name, sync)
+}
+
+func (s *instrumented{{.CodeName}}Client) AddFeatureLifecycle(ctx context.Context, enabled func() bool, name string, lifecycle {{.CodeName}}Lifecycle) {
+	sync := New{{.CodeName}}LifecycleAdapter(name, false, s, lifecycle)
+	s.Controller().AddFeatureHandler(ctx, enabled, name, sync)
+}
+
+func (s *instrumented{{.CodeName}}Client) AddClusterScopedHandler(ctx context.Context, name, clusterName string, sync {{.CodeName}}HandlerFunc) {
+	s.Controller().AddClusterScopedHandler(scope.WithClusterName(ctx, clusterName), name, clusterName, sync)
+}
+
+func (s *instrumented{{.CodeName}}Client) AddClusterScopedFeatureHandler(ctx context.Context, enabled func() bool, name, clusterName string, sync {{.CodeName}}HandlerFunc) {
+	s.Controller().AddClusterScopedFeatureHandler(scope.WithClusterName(ctx, clusterName), enabled, name, clusterName, sync)
+}
+
+func (s *instrumented{{.CodeName}}Client) AddClusterScopedLifecycle(ctx context.Context, name, clusterName string, lifecycle {{.CodeName}}Lifecycle) {
+	sync := New{{.CodeName}}LifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedHandler(scope.WithClusterName(ctx, clusterName), name, clusterName, sync)
+}
+
+func (s *instrumented{{.CodeName}}Client) AddClusterScopedFeatureLifecycle(ctx context.Context, enabled func() bool, name, clusterName string, lifecycle {{.CodeName}}Lifecycle) {
+	sync := New{{.CodeName}}LifecycleAdapter(name+"_"+clusterName, true, s, lifecycle)
+	s.Controller().AddClusterScopedFeatureHandler(scope.WithClusterName(ctx, clusterName), enabled, name, clusterName, sync)
+}
+{{- end}}
+`
@@ -0,0 +1,532 @@
+package generator
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/rancher/norman/types"
+	"github.com/rancher/norman/types/convert"
+	"k8s.io/gengo/args"
+)
+
+var (
+	baseOpenAPI = "openapi"
+
+	openAPIBlackList = map[string]bool{
+		"schema":     true,
+		"resource":   true,
+		"collection": true,
+	}
+)
+
+// GenerateOpenAPI writes an OpenAPI 3 document describing schemas to
+// openapi/<group>-<version>.json.
+func GenerateOpenAPI(schemas *types.Schemas) {
+	if err := generateOpenAPI(filepath.Join(basePackage, baseOpenAPI), schemas); err != nil {
+		panic(err)
+	}
+}
+
+func generateOpenAPI(baseOpenAPI string, schemas *types.Schemas) error {
+	version := getVersion(schemas)
+	doc, err := OpenAPI(schemas)
+	if err != nil {
+		return err
+	}
+
+	content, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	outputDir := filepath.Join(args.DefaultSourceTree(), baseOpenAPI)
+	if err := os.MkdirAll(outputDir, 0755); err != nil {
+		return err
+	}
+
+	filePath := fmt.Sprintf("%s-%s.json", version.Group, version.Version)
+	return ioutil.WriteFile(path.Join(outputDir, filePath), append(content, '\n'), 0644)
+}
+
+// OpenAPI converts a set of schemas sharing one APIVersion into an OpenAPI 3
+// document. It fails if the input or output of an action is not in schemas.
+func OpenAPI(schemas *types.Schemas) (map[string]interface{}, error) {
+	version := getVersion(schemas)
+	o := openAPI{
+		version: version,
+		schemas: schemas,
+	}
+
+	components := map[string]interface{}{}
+	paths := map[string]interface{}{}
+	var errs []error
+
+	for _, schema := range schemas.Schemas() {
+		if openAPIBlackList[schema.ID] {
+			continue
+		}
+		components[schema.CodeName] = o.object(schema)
+		if len(schema.CollectionMethods) > 0 {
+			components[schema.CodeName+"Collection"] = o.collection(schema)
+		}
+		if err := o.paths(schema, paths); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if err := types.NewErrors(errs...); err != nil {
+		return nil, err
+	}
+
+	components["Pagination"] = map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"limit":    map[string]interface{}{"type": "integer", "format": "int64"},
+			"total":    map[string]interface{}{"type": "integer", "format": "int64"},
+			"marker":   map[string]interface{}{"type": "string"},
+			"first":    map[string]interface{}{"type": "string"},
+			"previous": map[string]interface{}{"type": "string"},
+			"next":     map[string]interface{}{"type": "string"},
+			"last":     map[string]interface{}{"type": "string"},
+			"partial":  map[string]interface{}{"type": "boolean"},
+		},
+	}
+
+	return map[string]interface{}{
+		"openapi": "3.0.0",
+		"info": map[string]interface{}{
+			"title":   version.Group,
+			"version": version.Version,
+		},
+		"servers": []interface{}{
+			map[string]interface{}{"url": version.Path},
+		},
+		"paths": paths,
+		"components": map[string]interface{}{
+			"schemas": components,
+		},
+	}, nil
+}
+
+type openAPI struct {
+	version *types.APIVersion
+	schemas *types.Schemas
+}
+
+func (o openAPI) ref(schema *types.Schema) map[string]interface{} {
+	return map[string]interface{}{
+		"$ref": "#/components/schemas/" + schema.CodeName,
+	}
+}
+
+func (o openAPI) schema(name string) *types.Schema {
+	schema := o.schemas.Schema(o.version, name)
+	if schema == nil || openAPIBlackList[schema.ID] {
+		return nil
+	}
+	return schema
+}
+
+func (o openAPI) object(schema *types.Schema) map[string]interface{} {
+	properties := map[string]interface{}{}
+	var required []string
+
+	for name, field := range schema.ResourceFields {
+		properties[name] = o.field(field)
+		if field.Required {
+			required = append(required, name)
+		}
+	}
+
+	result := map[string]interface{}{
+		"type":       "object",
+		"properties": properties,
+	}
+	if len(required) > 0 {
+		sort.Strings(required)
+		result["required"] = required
+	}
+	return result
+}
+
+func (o openAPI) collection(schema *types.Schema) map[string]interface{} {
+	return map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"type":         map[string]interface{}{"type": "string"},
+			"resourceType": map[string]interface{}{"type": "string"},
+			"links": map[string]interface{}{
+				"type":                 "object",
+				"additionalProperties": map[string]interface{}{"type": "string"},
+			},
+			"pagination": map[string]interface{}{
+				"$ref": "#/components/schemas/Pagination",
+			},
+			"data": map[string]interface{}{
+				"type":  "array",
+				"items": o.ref(schema),
+			},
+		},
+	}
+}
+
+func (o openAPI) field(field types.Field) map[string]interface{} {
+	result := o.fieldType(field.Type, field.Options)
+
+	if field.Description != "" {
+		result["description"] = field.Description
+	}
+	if field.Nullable {
+		result["nullable"] = true
+	}
+	if field.Default != nil {
+		result["default"] = field.Default
+	}
+	if field.Min != nil {
+		result["minimum"] = *field.Min
+	}
+	if field.Max != nil {
+		result["maximum"] = *field.Max
+	}
+	if field.MinLength != nil {
+		result["minLength"] = *field.MinLength
+	}
+	if field.MaxLength != nil {
+		result["maxLength"] = *field.MaxLength
+	}
+	if field.ValidChars != "" {
+		result["x-valid-chars"] = field.ValidChars
+	}
+	if field.InvalidChars != "" {
+		result["x-invalid-chars"] = field.InvalidChars
+	}
+	if field.WriteOnly {
+		result["writeOnly"] = true
+	}
+	if !field.Create && !field.Update {
+		result["readOnly"] = true
+	} else if !field.Update {
+		result["x-noupdate"] = true
+	} else if !field.Create {
+		result["x-nocreate"] = true
+	}
+
+	if _, ref := result["$ref"]; ref && len(result) > 1 {
+		// siblings of $ref are ignored in OpenAPI 3.0, wrap the reference
+		ref := result["$ref"]
+		delete(result, "$ref")
+		result["allOf"] = []interface{}{
+			map[string]interface{}{"$ref": ref},
+		}
+	}
+
+	return result
+}
+
+func (o openAPI) fieldType(typeName string, options []string) map[string]interface{} {
+	switch {
+	case strings.HasPrefix(typeName, "reference["):
+		return map[string]interface{}{
+			"type":        "string",
+			"x-reference": typeName[len("reference[") : len(typeName)-1],
+		}
+	case strings.HasPrefix(typeName, "map["):
+		return map[string]interface{}{
+			"type":                 "object",
+			"additionalProperties": o.fieldType(typeName[len("map["):len(typeName)-1], options),
+		}
+	case strings.HasPrefix(typeName, "array["):
+		return map[string]interface{}{
+			"type":  "array",
+			"items": o.fieldType(typeName[len("array["):len(typeName)-1], options),
+		}
+	}
+
+	switch typeName {
+	case "string", "multiline", "dnsLabel", "dnsLabelRestricted":
+		return map[string]interface{}{"type": "string"}
+	case "hostname":
+		return map[string]interface{}{"type": "string", "format": "hostname"}
+	case "password", "masked":
+		return map[string]interface{}{"type": "string", "format": "password"}
+	case "base64":
+		return map[string]interface{}{"type": "string", "format": "byte"}
+	case "date":
+		return map[string]interface{}{"type": "string", "format": "date-time"}
+	case "enum":
+		result := map[string]interface{}{"type": "string"}
+		if len(options) > 0 {
+			result["enum"] = options
+		}
+		return result
+	case "boolean":
+		return map[string]interface{}{"type": "boolean"}
+	case "int":
+		return map[string]interface{}{"type": "integer", "format": "int64"}
+	case "float":
+		return map[string]interface{}{"type": "number", "format": "double"}
+	case "intOrString":
+		return map[string]interface{}{
+			"x-kubernetes-int-or-string": true,
+			"oneOf": []interface{}{
+				map[string]interface{}{"type": "integer"},
+				map[string]interface{}{"type": "string"},
+			},
+		}
+	case "json":
+		return map[string]interface{}{}
+	}
+
+	if schema := o.schema(typeName); schema != nil {
+		return o.ref(schema)
+	}
+	return map[string]interface{}{
+		"x-type": typeName,
+	}
+}
+
+func (o openAPI) paths(schema *types.Schema, paths map[string]interface{}) error {
+	if len(schema.CollectionMethods) == 0 && len(schema.ResourceMethods) == 0 {
+		return nil
+	}
+
+	prefix := ""
+	var parameters []interface{}
+	if o.version.SubContext {
+		context := path.Base(o.version.SubContextSchema)
+		prefix = "/{" + context + "Id}"
+		parameters = append(parameters, pathParameter(context+"Id"))
+	}
+
+	collectionPath := prefix + "/" + schema.PluralName
+	resourcePath := collectionPath + "/{id}"
+
+	collection := map[string]interface{}{}
+	if contains(schema.CollectionMethods, http.MethodGet) {
+		collection["get"] = operation("list"+schema.CodeNamePlural, schema, nil, o.refName(schema.CodeName+"Collection"))
+	}
+	if contains(schema.CollectionMethods, http.MethodPost) {
+		collection["post"] = operation("create"+schema.CodeName, schema, o.ref(schema), o.ref(schema))
+	}
+	collectionActions, err := o.actions(schema, schema.CollectionActions, true)
+	if err != nil {
+		return err
+	}
+	if len(collectionActions) > 0 {
+		collection["post"] = o.actionOperation("collectionAction"+schema.CodeName, schema, collectionActions, collection["post"])
+	}
+
+	resource := map[string]interface{}{}
+	if contains(schema.ResourceMethods, http.MethodGet) {
+		resource["get"] = operation("get"+schema.CodeName, schema, nil, o.ref(schema))
+	}
+	if contains(schema.ResourceMethods, http.MethodPut) {
+		resource["put"] = operation("update"+schema.CodeName, schema, o.ref(schema), o.ref(schema))
+	}
+	if contains(schema.ResourceMethods, http.MethodDelete) {
+		resource["delete"] = operation("delete"+schema.CodeName, schema, nil, nil)
+	}
+	resourceActions, err := o.actions(schema, schema.ResourceActions, false)
+	if err != nil {
+		return err
+	}
+	if len(resourceActions) > 0 {
+		resource["post"] = o.actionOperation("action"+schema.CodeName, schema, resourceActions, nil)
+	}
+
+	if len(collection) > 0 {
+		if len(parameters) > 0 {
+			collection["parameters"] = parameters
+		}
+		paths[collectionPath] = collection
+	}
+	if len(resource) > 0 {
+		resource["parameters"] = append(append([]interface{}{}, parameters...), pathParameter("id"))
+		paths[resourcePath] = resource
+	}
+	return nil
+}
+
+func (o openAPI) refName(name string) map[string]interface{} {
+	return map[string]interface{}{
+		"$ref": "#/components/schemas/" + name,
+	}
+}
+
+type openAPIAction struct {
+	input  map[string]interface{}
+	output map[string]interface{}
+}
+
+// actions returns the inputs and outputs of actions, it fails if any of them
+// is not a schema of the document.
+func (o openAPI) actions(schema *types.Schema, actions map[string]types.Action, collection bool) (map[string]openAPIAction, error) {
+	result := map[string]openAPIAction{}
+	for name, action := range actions {
+		a := openAPIAction{}
+		if action.Input != "" {
+			input := o.schema(action.Input)
+			if input == nil {
+				return nil, fmt.Errorf("input %s of action %s of %s is not a schema of %s", action.Input, name, schema.ID, o.version.Path)
+			}
+			a.input = o.ref(input)
+		}
+		if action.Output != "" {
+			output := action.Output
+			if collection && output == "collection" {
+				a.output = o.refName(schema.CodeName + "Collection")
+			} else if outputSchema := o.schema(convert.Uncapitalize(output)); outputSchema != nil {
+				a.output = o.ref(outputSchema)
+			} else {
+				return nil, fmt.Errorf("output %s of action %s of %s is not a schema of %s", action.Output, name, schema.ID, o.version.Path)
+			}
+		}
+		result[name] = a
+	}
+	return result, nil
+}
+
+// actionOperation describes the actions of a resource or collection. Norman
+// selects the action with the action query parameter, so all actions share
+// one POST operation, x-actions maps each action to its input and output.
+func (o openAPI) actionOperation(id string, schema *types.Schema, actions map[string]openAPIAction, create interface{}) map[string]interface{} {
+	var (
+		names   []string
+		inputs  []interface{}
+		outputs []interface{}
+	)
+	xActions := map[string]interface{}{}
+	noInput := false
+
+	for name := range actions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		action := actions[name]
+		x := map[string]interface{}{}
+		if action.input != nil {
+			x["input"] = action.input
+			inputs = append(inputs, action.input)
+		} else {
+			noInput = true
+		}
+		if action.output != nil {
+			x["output"] = action.output
+			outputs = append(outputs, action.output)
+		}
+		xActions[name] = x
+	}
+
+	result := operation(id, schema, oneOf(inputs), oneOf(outputs))
+	result["x-actions"] = xActions
+	result["parameters"] = []interface{}{
+		map[string]interface{}{
+			"name":     "action",
+			"in":       "query",
+			"required": create == nil,
+			"schema": map[string]interface{}{
+				"type": "string",
+				"enum": names,
+			},
+		},
+	}
+
+	if create, ok := create.(map[string]interface{}); ok {
+		result["operationId"] = create["operationId"]
+		result["description"] = "Creates a " + schema.CodeName + ", or runs an action if the action query parameter is set"
+		if body, ok := create["requestBody"].(map[string]interface{}); ok {
+			inputs = append([]interface{}{bodySchema(body)}, inputs...)
+			result["requestBody"] = requestBody(oneOf(inputs))
+		}
+	}
+
+	if body, ok := result["requestBody"].(map[string]interface{}); ok && noInput {
+		// some actions take no input
+		body["required"] = false
+	}
+
+	return result
+}
+
+func bodySchema(body map[string]interface{}) interface{} {
+	content := body["content"].(map[string]interface{})
+	return content["application/json"].(map[string]interface{})["schema"]
+}
+
+func oneOf(schemas []interface{}) map[string]interface{} {
+	seen := map[string]bool{}
+	var unique []interface{}
+	for _, s := range schemas {
+		key := fmt.Sprint(s)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		unique = append(unique, s)
+	}
+
+	switch len(unique) {
+	case 0:
+		return nil
+	case 1:
+		return unique[0].(map[string]interface{})
+	}
+	return map[string]interface{}{
+		"oneOf": unique,
+	}
+}
+
+func operation(id string, schema *types.Schema, input, output map[string]interface{}) map[string]interface{} {
+	result := map[string]interface{}{
+		"operationId": id,
+		"tags":        []string{schema.CodeName},
+	}
+	if input != nil {
+		result["requestBody"] = requestBody(input)
+	}
+
+	response := map[string]interface{}{
+		"description": "OK",
+	}
+	if output != nil {
+		response["content"] = map[string]interface{}{
+			"application/json": map[string]interface{}{
+				"schema": output,
+			},
+		}
+	}
+	result["responses"] = map[string]interface{}{
+		"200": response,
+	}
+	return result
+}
+
+func requestBody(schema map[string]interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"required": true,
+		"content": map[string]interface{}{
+			"application/json": map[string]interface{}{
+				"schema": schema,
+			},
+		},
+	}
+}
+
+func pathParameter(name string) map[string]interface{} {
+	return map[string]interface{}{
+		"name":     name,
+		"in":       "path",
+		"required": true,
+		"schema": map[string]interface{}{
+			"type": "string",
+		},
+	}
+}
@@ -0,0 +1,96 @@
+package generator
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/rancher/norman/types"
+)
+
+func testOpenAPISchemas(action types.Action) *types.Schemas {
+	version := types.APIVersion{Group: "test.cattle.io", Version: "v3", Path: "/v3"}
+	return types.NewSchemas().
+		MustImportAndCustomize(&version, testCondition{}, func(schema *types.Schema) {
+			schema.ID = "condition"
+			schema.CodeName = "Condition"
+		}).
+		MustImportAndCustomize(&version, testClusterTemplate{}, func(schema *types.Schema) {
+			schema.ID = "clusterTemplate"
+			schema.CodeName = "ClusterTemplate"
+			schema.PluralName = "clusterTemplates"
+			schema.CollectionMethods = []string{http.MethodGet}
+			schema.ResourceMethods = []string{http.MethodGet, http.MethodDelete}
+			schema.CollectionActions = map[string]types.Action{
+				"refresh": {Output: "collection"},
+			}
+			schema.ResourceActions = map[string]types.Action{
+				"check": action,
+			}
+		})
+}
+
+func TestOpenAPI(t *testing.T) {
+	doc, err := OpenAPI(testOpenAPISchemas(types.Action{Input: "condition", Output: "clusterTemplate"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	content, err := json.Marshal(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	output := string(content)
+
+	paths := doc["paths"].(map[string]interface{})
+	for _, path := range []string{"/clusterTemplates", "/clusterTemplates/{id}"} {
+		if paths[path] == nil {
+			t.Errorf("expected the path %s, got %v", path, paths)
+		}
+	}
+	components := doc["components"].(map[string]interface{})["schemas"].(map[string]interface{})
+	for _, name := range []string{"Condition", "ClusterTemplate", "ClusterTemplateCollection", "Pagination"} {
+		if components[name] == nil {
+			t.Errorf("expected the component %s", name)
+		}
+	}
+
+	tests := []struct {
+		name     string
+		contains string
+	}{
+		{
+			name:     "field",
+			contains: `"conditions":{"items":{"$ref":"#/components/schemas/Condition"},"nullable":true,"type":"array"}`,
+		},
+		{
+			name:     "collection action",
+			contains: `"refresh":{"output":{"$ref":"#/components/schemas/ClusterTemplateCollection"}}`,
+		},
+		{
+			name:     "resource action",
+			contains: `"check":{"input":{"$ref":"#/components/schemas/Condition"},"output":{"$ref":"#/components/schemas/ClusterTemplate"}}`,
+		},
+	}
+
+	for _, test := range tests {
+		if !strings.Contains(output, test.contains) {
+			t.Errorf("%s: expected %s in %s", test.name, test.contains, output)
+		}
+	}
+
+	const prefix = `"$ref":"#/components/schemas/`
+	for _, ref := range strings.Split(output, prefix)[1:] {
+		if name := ref[:strings.Index(ref, `"`)]; components[name] == nil {
+			t.Errorf("expected the reference to %s to resolve", name)
+		}
+	}
+}
+
+func TestOpenAPIUnresolvedAction(t *testing.T) {
+	for _, action := range []types.Action{{Input: "missing"}, {Output: "missing"}} {
+		if _, err := OpenAPI(testOpenAPISchemas(action)); err == nil {
+			t.Errorf("expected the action %+v to fail", action)
+		}
+	}
+}
@@ -0,0 +1,139 @@
+package generator
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// normanTag is the parsed form of a norman struct tag, it mirrors how norman
+// applies the tag to a schema field.
+type normanTag struct {
+	Type         string
+	Default      string
+	HasDefault   bool
+	Required     bool
+	Nullable     *bool
+	NoCreate     bool
+	NoUpdate     bool
+	WriteOnly    bool
+	MinLength    *int64
+	MaxLength    *int64
+	Min          *int64
+	Max          *int64
+	Options      []string
+	ValidChars   string
+	InvalidChars string
+}
+
+func parseNormanTag(field reflect.StructField) (normanTag, error) {
+	var tag normanTag
+	for _, part := range strings.Split(field.Tag.Get("norman"), ",") {
+		if part == "" {
+			continue
+		}
+
+		var err error
+		key, value := part, ""
+		if i := strings.Index(part, "="); i >= 0 {
+			key, value = part[:i], part[i+1:]
+		}
+
+		switch key {
+		case "type":
+			tag.Type = value
+		case "codeName":
+		case "default":
+			tag.Default = value
+			tag.HasDefault = true
+		case "nullable":
+			t := true
+			tag.Nullable = &t
+		case "notnullable":
+			f := false
+			tag.Nullable = &f
+		case "nocreate":
+			tag.NoCreate = true
+		case "writeOnly":
+			tag.WriteOnly = true
+		case "required":
+			tag.Required = true
+		case "noupdate":
+			tag.NoUpdate = true
+		case "minLength":
+			tag.MinLength, err = tagInt(value, field)
+		case "maxLength":
+			tag.MaxLength, err = tagInt(value, field)
+		case "min":
+			tag.Min, err = tagInt(value, field)
+		case "max":
+			tag.Max, err = tagInt(value, field)
+		case "options":
+			tag.Options = tagOptions(value)
+			if tag.Type == "" {
+				tag.Type = "enum"
+			}
+		case "validChars":
+			tag.ValidChars = value
+		case "invalidChars":
+			tag.InvalidChars = value
+		default:
+			return tag, fmt.Errorf("invalid tag %s on field %s", key, field.Name)
+		}
+
+		if err != nil {
+			return tag, err
+		}
+	}
+
+	return tag, nil
+}
+
+func tagInt(value string, field reflect.StructField) (*int64, error) {
+	i, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid number on field %s: %v", field.Name, err)
+	}
+	return &i, nil
+}
+
+func tagOptions(input string) []string {
+	var result []string
+	for _, i := range strings.Split(input, "|") {
+		for _, part := range strings.Split(i, " ") {
+			part = strings.TrimSpace(part)
+			if len(part) > 0 {
+				result = append(result, part)
+			}
+		}
+	}
+	return result
+}
+
+// jsonField returns the JSON name of a struct field and whether its fields
+// are inlined into the parent, name is "-" for fields that are not
+// serialized.
+func jsonField(field reflect.StructField) (string, bool) {
+	if field.PkgPath != "" {
+		return "-", false
+	}
+	tag := field.Tag.Get("json")
+	parts := strings.Split(tag, ",")
+	name := parts[0]
+	if name == "-" && len(parts) == 1 {
+		return "-", false
+	}
+	for _, opt := range parts[1:] {
+		if opt == "inline" {
+			return "", true
+		}
+	}
+	if name == "" {
+		if field.Anonymous {
+			return "", true
+		}
+		return field.Name, false
+	}
+	return name, false
+}
@@ -0,0 +1,229 @@
+package generator
+
+import (
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/rancher/norman/types"
+	"github.com/rancher/norman/types/convert"
+	"k8s.io/gengo/args"
+)
+
+var (
+	tsIdentifier = regexp.MustCompile(`^[A-Za-z_$][A-Za-z0-9_$]*$`)
+	tsBlackList  = map[string]bool{
+		"schema":     true,
+		"resource":   true,
+		"collection": true,
+	}
+)
+
+type tsSchema struct {
+	*types.Schema
+	HasGet            bool
+	Fields            []tsField
+	ResourceActions   []tsAction
+	CollectionActions []tsAction
+}
+
+type tsField struct {
+	CodeName string
+	Name     string
+	Property string
+	Type     string
+}
+
+type tsAction struct {
+	Name   string
+	Method string
+	Input  string
+	Output string
+}
+
+// GenerateTypeScript writes zz_generated_client.ts next to the Go client of
+// schemas, with the same types, field and action names as the Go client.
+// Schemas in privateTypes get no client, like in Generate.
+func GenerateTypeScript(schemas *types.Schemas, privateTypes map[string]bool) {
+	version := getVersion(schemas)
+	group := strings.Split(version.Group, ".")[0]
+
+	cattleOutputPackage := path.Join(basePackage, baseCattle, group, version.Version)
+	if err := generateTypeScript(cattleOutputPackage, schemas, privateTypes); err != nil {
+		panic(err)
+	}
+}
+
+func generateTypeScript(outputPackage string, schemas *types.Schemas, privateTypes map[string]bool) error {
+	outputDir := filepath.Join(args.DefaultSourceTree(), outputPackage)
+	if err := os.MkdirAll(outputDir, 0755); err != nil {
+		return err
+	}
+
+	output, err := os.Create(path.Join(outputDir, "zz_generated_client.ts"))
+	if err != nil {
+		return err
+	}
+	defer output.Close()
+
+	return writeTypeScript(output, schemas, privateTypes)
+}
+
+func writeTypeScript(output io.Writer, schemas *types.Schemas, privateTypes map[string]bool) error {
+	typeTemplate, err := template.New("typescript.template").
+		Funcs(funcs()).
+		Parse(typescriptTemplate)
+	if err != nil {
+		return err
+	}
+
+	var (
+		tsSchemas []tsSchema
+		clients   []tsSchema
+	)
+	for _, schema := range schemas.Schemas() {
+		if tsBlackList[schema.ID] {
+			continue
+		}
+		s := newTSSchema(schema, schemas)
+		tsSchemas = append(tsSchemas, s)
+		if s.HasGet && !privateTypes[schema.ID] {
+			clients = append(clients, s)
+		}
+	}
+
+	return typeTemplate.Execute(output, map[string]interface{}{
+		"version": getVersion(schemas),
+		"schemas": tsSchemas,
+		"clients": clients,
+	})
+}
+
+func newTSSchema(schema *types.Schema, schemas *types.Schemas) tsSchema {
+	result := tsSchema{
+		Schema: schema,
+		HasGet: contains(schema.CollectionMethods, http.MethodGet),
+	}
+
+	for name, field := range schema.ResourceFields {
+		if strings.EqualFold(name, "id") {
+			continue
+		}
+		property := name
+		if !tsIdentifier.MatchString(name) {
+			property = strconv.Quote(name)
+		}
+		result.Fields = append(result.Fields, tsField{
+			CodeName: field.CodeName,
+			Name:     name,
+			Property: property,
+			Type:     tsFieldType(field, schema, schemas),
+		})
+	}
+	sort.Slice(result.Fields, func(i, j int) bool {
+		return result.Fields[i].CodeName < result.Fields[j].CodeName
+	})
+
+	if !result.HasGet {
+		return result
+	}
+
+	for name, action := range schema.ResourceActions {
+		if action.Output != "" && schemas.Schema(&schema.Version, action.Output) == nil {
+			continue
+		}
+		result.ResourceActions = append(result.ResourceActions, newTSAction(name, action, action.Output))
+	}
+
+	for name, action := range schema.CollectionActions {
+		output := action.Output
+		if output == "collection" {
+			output = convert.Uncapitalize(schema.CodeName)
+		}
+		if output != "" && schemas.Schema(&schema.Version, output) == nil {
+			continue
+		}
+		a := newTSAction(name, action, output)
+		if action.Output == "collection" {
+			a.Output = schema.CodeName + "Collection"
+		}
+		result.CollectionActions = append(result.CollectionActions, a)
+	}
+
+	sort.Slice(result.ResourceActions, func(i, j int) bool {
+		return result.ResourceActions[i].Name < result.ResourceActions[j].Name
+	})
+	sort.Slice(result.CollectionActions, func(i, j int) bool {
+		return result.CollectionActions[i].Name < result.CollectionActions[j].Name
+	})
+
+	return result
+}
+
+func newTSAction(name string, action types.Action, output string) tsAction {
+	result := tsAction{
+		Name:   name,
+		Method: convert.Capitalize(name),
+	}
+	if action.Input != "" {
+		result.Input = convert.Capitalize(action.Input)
+	}
+	if output != "" {
+		result.Output = convert.Capitalize(output)
+	}
+	return result
+}
+
+func tsFieldType(field types.Field, schema *types.Schema, schemas *types.Schemas) string {
+	if field.Type == "enum" && len(field.Options) > 0 {
+		var options []string
+		for _, option := range field.Options {
+			options = append(options, strconv.Quote(option))
+		}
+		return strings.Join(options, " | ")
+	}
+	return tsType(field.Type, schema, schemas)
+}
+
+// tsType mirrors how the Go client maps norman types, see getTypeString in
+// the norman generator.
+func tsType(typeName string, schema *types.Schema, schemas *types.Schemas) string {
+	switch {
+	case strings.HasPrefix(typeName, "reference["):
+		return "string"
+	case strings.HasPrefix(typeName, "map["):
+		return "{ [key: string]: " + tsType(typeName[len("map["):len(typeName)-1], schema, schemas) + " }"
+	case strings.HasPrefix(typeName, "array["):
+		elem := tsType(typeName[len("array["):len(typeName)-1], schema, schemas)
+		if strings.Contains(elem, " | ") {
+			elem = "(" + elem + ")"
+		}
+		return elem + "[]"
+	}
+
+	switch typeName {
+	case "base64", "multiline", "masked", "password", "date", "string", "enum",
+		"dnsLabel", "dnsLabelRestricted", "hostname":
+		return "string"
+	case "json":
+		return "any"
+	case "boolean":
+		return "boolean"
+	case "float", "int":
+		return "number"
+	case "intOrString":
+		return "number | string"
+	}
+
+	if otherSchema := schemas.Schema(&schema.Version, typeName); otherSchema != nil {
+		return otherSchema.CodeName
+	}
+	return convert.Capitalize(typeName)
+}
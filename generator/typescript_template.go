@@ -0,0 +1,148 @@
+package generator
+
+var typescriptTemplate = `// Code generated from the {{.version.Group}}/{{.version.Version}} schemas. DO NOT EDIT.
+
+// The Api types are the norman API types shared by all schemas, their prefix
+// keeps them apart from schema types of the same name, such as Condition.
+
+export interface ApiResource {
+    id?: string;
+    type?: string;
+    links?: { [key: string]: string };
+    actions?: { [key: string]: string };
+}
+
+export interface ApiPagination {
+    marker?: string;
+    first?: string;
+    previous?: string;
+    next?: string;
+    last?: string;
+    limit?: number;
+    total?: number;
+    partial?: boolean;
+}
+
+export interface ApiSort {
+    name?: string;
+    order?: string;
+    reverse?: string;
+    links?: { [key: string]: string };
+}
+
+export interface ApiCondition {
+    modifier?: string;
+    value?: any;
+}
+
+export interface ApiCollection {
+    type?: string;
+    links?: { [key: string]: string };
+    createTypes?: { [key: string]: string };
+    actions?: { [key: string]: string };
+    pagination?: ApiPagination;
+    sort?: ApiSort;
+    filters?: { [key: string]: ApiCondition[] };
+    resourceType?: string;
+}
+
+export interface ApiListOpts {
+    filters?: { [key: string]: any };
+}
+
+// ApiOps performs the HTTP requests of the clients, it mirrors the
+// APIOperations of the Go client.
+export interface ApiOps {
+    DoList(schemaType: string, opts?: ApiListOpts): Promise<any>;
+    DoNext(nextURL: string): Promise<any>;
+    DoCreate(schemaType: string, createObj: object): Promise<any>;
+    DoReplace(schemaType: string, existing: ApiResource, updates: object): Promise<any>;
+    DoUpdate(schemaType: string, existing: ApiResource, updates: object): Promise<any>;
+    DoByID(schemaType: string, id: string): Promise<any>;
+    DoResourceDelete(schemaType: string, existing: ApiResource): Promise<void>;
+    DoAction(schemaType: string, action: string, existing: ApiResource, input?: object): Promise<any>;
+    DoCollectionAction(schemaType: string, action: string, existing: ApiCollection, input?: object): Promise<any>;
+}
+{{range $schema := .schemas}}
+export const {{.CodeName}}Type = "{{.ID}}";
+{{- range .Fields}}
+export const {{$schema.CodeName}}Field{{.CodeName}} = "{{.Name}}";
+{{- end}}
+{{- range .ResourceActions}}
+export const {{$schema.CodeName}}Action{{.Method}} = "{{.Name}}";
+{{- end}}
+{{- range .CollectionActions}}
+export const {{$schema.CodeName}}CollectionAction{{.Method}} = "{{.Name}}";
+{{- end}}
+
+export interface {{.CodeName}}{{if .HasGet}} extends ApiResource{{end}} {
+{{- range .Fields}}
+    {{.Property}}?: {{.Type}};
+{{- end}}
+}
+{{- if .HasGet}}
+
+export interface {{.CodeName}}Collection extends ApiCollection {
+    data?: {{.CodeName}}[];
+}
+
+export class {{.CodeName}}Client {
+    constructor(private ops: ApiOps) {}
+
+    List(opts?: ApiListOpts): Promise<{{.CodeName}}Collection> {
+        return this.ops.DoList({{.CodeName}}Type, opts);
+    }
+
+    Next(collection: {{.CodeName}}Collection): Promise<{{.CodeName}}Collection | null> {
+        if (collection.pagination && collection.pagination.next) {
+            return this.ops.DoNext(collection.pagination.next);
+        }
+        return Promise.resolve(null);
+    }
+
+    Create(container: {{.CodeName}}): Promise<{{.CodeName}}> {
+        return this.ops.DoCreate({{.CodeName}}Type, container);
+    }
+
+    Update(existing: {{.CodeName}}, updates: object): Promise<{{.CodeName}}> {
+        return this.ops.DoUpdate({{.CodeName}}Type, existing, updates);
+    }
+
+    Replace(obj: {{.CodeName}}): Promise<{{.CodeName}}> {
+        return this.ops.DoReplace({{.CodeName}}Type, obj, obj);
+    }
+
+    ByID(id: string): Promise<{{.CodeName}}> {
+        return this.ops.DoByID({{.CodeName}}Type, id);
+    }
+
+    Delete(container: {{.CodeName}}): Promise<void> {
+        return this.ops.DoResourceDelete({{.CodeName}}Type, container);
+    }
+{{- range .ResourceActions}}
+
+    Action{{.Method}}(resource: {{$schema.CodeName}}{{if .Input}}, input: {{.Input}}{{end}}): Promise<{{if .Output}}{{.Output}}{{else}}void{{end}}> {
+        return this.ops.DoAction({{$schema.CodeName}}Type, {{$schema.CodeName}}Action{{.Method}}, resource{{if .Input}}, input{{end}});
+    }
+{{- end}}
+{{- range .CollectionActions}}
+
+    CollectionAction{{.Method}}(resource: {{$schema.CodeName}}Collection{{if .Input}}, input: {{.Input}}{{end}}): Promise<{{if .Output}}{{.Output}}{{else}}void{{end}}> {
+        return this.ops.DoCollectionAction({{$schema.CodeName}}Type, {{$schema.CodeName}}CollectionAction{{.Method}}, resource{{if .Input}}, input{{end}});
+    }
+{{- end}}
+}
+{{- end}}
+{{end}}
+export class Client {
+{{- range .clients}}
+    {{.CodeName}}: {{.CodeName}}Client;
+{{- end}}
+
+    constructor(ops: ApiOps) {
+{{- range .clients}}
+        this.{{.CodeName}} = new {{.CodeName}}Client(ops);
+{{- end}}
+    }
+}
+`
@@ -0,0 +1,84 @@
+package generator
+
+import (
+	"bytes"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/rancher/norman/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type testCondition struct {
+	Type   string `json:"type"`
+	Status string `json:"status"`
+}
+
+type testClusterTemplate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Conditions []testCondition `json:"conditions,omitempty"`
+}
+
+func TestWriteTypeScript(t *testing.T) {
+	version := types.APIVersion{Group: "test.cattle.io", Version: "v3", Path: "/v3"}
+	schemas := types.NewSchemas().
+		MustImportAndCustomize(&version, testCondition{}, func(schema *types.Schema) {
+			schema.ID = "condition"
+			schema.CodeName = "Condition"
+		}).
+		MustImportAndCustomize(&version, testClusterTemplate{}, func(schema *types.Schema) {
+			schema.ID = "clusterTemplate"
+			schema.CodeName = "ClusterTemplate"
+			schema.CollectionMethods = []string{http.MethodGet}
+			schema.CollectionActions = map[string]types.Action{
+				"refresh": {Output: "collection"},
+			}
+		})
+
+	buf := &bytes.Buffer{}
+	if err := writeTypeScript(buf, schemas, nil); err != nil {
+		t.Fatal(err)
+	}
+	output := buf.String()
+
+	tests := []struct {
+		name     string
+		contains string
+		count    int
+	}{
+		{
+			name:     "built-in condition",
+			contains: "export interface ApiCondition {",
+			count:    1,
+		},
+		{
+			name:     "schema condition",
+			contains: "export interface Condition {",
+			count:    1,
+		},
+		{
+			name:     "schema field",
+			contains: "conditions?: Condition[];",
+			count:    1,
+		},
+		{
+			name:     "collection action",
+			contains: "CollectionActionRefresh(resource: ClusterTemplateCollection): Promise<ClusterTemplateCollection>",
+			count:    1,
+		},
+		{
+			name:     "client",
+			contains: "this.ClusterTemplate = new ClusterTemplateClient(ops);",
+			count:    1,
+		},
+	}
+
+	for _, test := range tests {
+		if count := strings.Count(output, test.contains); count != test.count {
+			t.Errorf("%s: expected %q %d times, got %d", test.name, test.contains, test.count, count)
+		}
+	}
+}
@@ -0,0 +1,282 @@
+package generator
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// GenerateValidation writes a zz_generated_validation.go file with a
+// Validate_<Type> function for every type reachable from the kinds registered
+// by addToSchemes that has rules in its norman tags.
+func GenerateValidation(addToSchemes ...func(*runtime.Scheme) error) {
+	if err := generateValidation(addToSchemes...); err != nil {
+		panic(err)
+	}
+}
+
+func generateValidation(addToSchemes ...func(*runtime.Scheme) error) error {
+	types, err := apiTypes(addToSchemes...)
+	if err != nil {
+		return err
+	}
+
+	rules, err := markTypes(types, func(field reflect.StructField, tag normanTag) bool {
+		return hasValidation(field.Type, tag)
+	})
+	if err != nil {
+		return err
+	}
+	g := &validationGen{
+		rules: rules,
+	}
+
+	for _, pkg := range types.packages() {
+		w := newGoFile(pkg)
+		w.use("k8s.io/apimachinery/pkg/util/validation/field")
+		for _, t := range types.byPackage(pkg) {
+			if !g.rules[t] {
+				continue
+			}
+			if err := g.writeType(w, t); err != nil {
+				return err
+			}
+		}
+		if w.Len() == 0 {
+			continue
+		}
+		if err := w.write("zz_generated_validation.go"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type validationGen struct {
+	rules markedTypes
+}
+
+func hasValidation(t reflect.Type, tag normanTag) bool {
+	if tag.Required && isNillable(t) {
+		return true
+	}
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	switch t.Kind() {
+	case reflect.String:
+		return tag.Required || len(tag.Options) > 0 || tag.MinLength != nil || tag.MaxLength != nil ||
+			tag.Type == "hostname" || tag.ValidChars != "" || tag.InvalidChars != ""
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return tag.Min != nil || tag.Max != nil
+	case reflect.Slice:
+		return tag.MinLength != nil || tag.MaxLength != nil ||
+			(t.Elem().Kind() == reflect.String && (len(tag.Options) > 0 || isReferenceArray(tag)))
+	}
+	return false
+}
+
+func isNillable(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Ptr, reflect.Slice, reflect.Map:
+		return true
+	}
+	return false
+}
+
+func isReferenceArray(tag normanTag) bool {
+	return strings.HasPrefix(tag.Type, "array[reference[")
+}
+
+func (g *validationGen) writeType(w *goFile, t reflect.Type) error {
+	name := w.typeName(t)
+	fmt.Fprintf(w, "// Validate_%s is an autogenerated validation function that checks %s\n// values against the rules of their norman tags.\n", t.Name(), t.Name())
+	fmt.Fprintf(w, "func Validate_%s(in *%s, fldPath *field.Path) field.ErrorList {\n", t.Name(), name)
+	fmt.Fprintf(w, "var allErrs field.ErrorList\n")
+	if err := g.writeFields(w, t, "in", "fldPath"); err != nil {
+		return err
+	}
+	fmt.Fprintf(w, "return allErrs\n}\n\n")
+
+	fmt.Fprintf(w, "// Validate checks the %s against the rules of its norman tags.\n", t.Name())
+	fmt.Fprintf(w, "func (in *%s) Validate() field.ErrorList {\n", name)
+	fmt.Fprintf(w, "return Validate_%s(in, nil)\n}\n\n", t.Name())
+	return nil
+}
+
+func (g *validationGen) writeFields(w *goFile, t reflect.Type, recv, path string) error {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name, inline := jsonField(field)
+		if name == "-" {
+			continue
+		}
+
+		expr := recv + "." + field.Name
+		if inline {
+			if nested := g.rules.nested(field.Type); nested != nil {
+				g.writeNested(w, field.Type, nested, expr, path)
+			}
+			continue
+		}
+
+		tag, err := parseNormanTag(field)
+		if err != nil {
+			return err
+		}
+		g.writeField(w, field, tag, expr, fmt.Sprintf("%s.Child(%q)", path, name))
+	}
+	return nil
+}
+
+func (g *validationGen) writeNested(w *goFile, t, nested reflect.Type, expr, path string) {
+	fn := w.funcName("Validate_", nested)
+	switch t.Kind() {
+	case reflect.Ptr:
+		fmt.Fprintf(w, "if %s != nil {\nallErrs = append(allErrs, %s(%s, %s)...)\n}\n", expr, fn, expr, path)
+	case reflect.Slice:
+		if t.Elem().Kind() == reflect.Ptr {
+			fmt.Fprintf(w, "for i := range %s {\nif %s[i] != nil {\nallErrs = append(allErrs, %s(%s[i], %s.Index(i))...)\n}\n}\n", expr, expr, fn, expr, path)
+		} else {
+			fmt.Fprintf(w, "for i := range %s {\nallErrs = append(allErrs, %s(&%s[i], %s.Index(i))...)\n}\n", expr, fn, expr, path)
+		}
+	case reflect.Map:
+		fmt.Fprintf(w, "for k, v := range %s {\n", expr)
+		if t.Elem().Kind() == reflect.Ptr {
+			fmt.Fprintf(w, "if v != nil {\nallErrs = append(allErrs, %s(v, %s.Key(string(k)))...)\n}\n}\n", fn, path)
+		} else {
+			fmt.Fprintf(w, "v := v\nallErrs = append(allErrs, %s(&v, %s.Key(string(k)))...)\n}\n", fn, path)
+		}
+	default:
+		fmt.Fprintf(w, "allErrs = append(allErrs, %s(&%s, %s)...)\n", fn, expr, path)
+	}
+}
+
+func (g *validationGen) writeField(w *goFile, field reflect.StructField, tag normanTag, expr, path string) {
+	t := field.Type
+	// zero values of omitted or defaulted fields are unset, the api server
+	// would not apply the rules to them
+	unset := tag.HasDefault || strings.Contains(field.Tag.Get("json"), ",omitempty")
+
+	if tag.Required && isNillable(t) {
+		check := expr + " == nil"
+		if t.Kind() != reflect.Ptr {
+			check = "len(" + expr + ") == 0"
+		}
+		fmt.Fprintf(w, "if %s {\nallErrs = append(allErrs, field.Required(%s, \"\"))\n}\n", check, path)
+	}
+
+	if nested := g.rules.nested(t); nested != nil {
+		g.writeNested(w, t, nested, expr, path)
+		return
+	}
+
+	switch t.Kind() {
+	case reflect.Ptr:
+		body := &goFile{pkg: w.pkg, imports: w.imports}
+		if t.Elem().Kind() == reflect.String {
+			writeString(body, t.Elem(), tag, "*"+expr, path, false)
+		} else {
+			writeNumber(body, tag, "*"+expr, path, false)
+		}
+		if body.Len() > 0 {
+			fmt.Fprintf(w, "if %s != nil {\n%s}\n", expr, body.String())
+		}
+	case reflect.String:
+		writeString(w, t, tag, expr, path, tag.Required)
+	case reflect.Slice:
+		if tag.MinLength != nil {
+			fmt.Fprintf(w, "if len(%s) > 0 && len(%s) < %d {\nallErrs = append(allErrs, field.Invalid(%s, %s, \"must have at least %d items\"))\n}\n",
+				expr, expr, *tag.MinLength, path, expr, *tag.MinLength)
+		}
+		if tag.MaxLength != nil {
+			fmt.Fprintf(w, "if len(%s) > %d {\nallErrs = append(allErrs, field.TooMany(%s, len(%s), %d))\n}\n",
+				expr, *tag.MaxLength, path, expr, *tag.MaxLength)
+		}
+		if t.Elem().Kind() != reflect.String {
+			return
+		}
+		body := &goFile{pkg: w.pkg, imports: w.imports}
+		itemTag := normanTag{
+			Options: tag.Options,
+		}
+		writeString(body, t.Elem(), itemTag, "v", path+".Index(i)", isReferenceArray(tag))
+		if body.Len() > 0 {
+			fmt.Fprintf(w, "for i, v := range %s {\n%s}\n", expr, body.String())
+		}
+	default:
+		writeNumber(w, tag, expr, path, unset)
+	}
+}
+
+func writeString(w *goFile, t reflect.Type, tag normanTag, expr, path string, required bool) {
+	str := expr
+	if t.Name() != "string" {
+		str = "string(" + expr + ")"
+	}
+	value := expr
+	if tag.Type == "password" {
+		value = `""`
+	}
+
+	body := &goFile{pkg: w.pkg, imports: w.imports}
+	if len(tag.Options) > 0 {
+		var options []string
+		for _, option := range tag.Options {
+			options = append(options, fmt.Sprintf("%q", option))
+		}
+		fmt.Fprintf(body, "switch %s {\ncase %s:\ndefault:\nallErrs = append(allErrs, field.NotSupported(%s, %s, []string{%s}))\n}\n",
+			expr, strings.Join(options, ", "), path, value, strings.Join(options, ", "))
+	}
+	if tag.MinLength != nil {
+		fmt.Fprintf(body, "if len(%s) < %d {\nallErrs = append(allErrs, field.Invalid(%s, %s, \"must be at least %d characters\"))\n}\n",
+			expr, *tag.MinLength, path, value, *tag.MinLength)
+	}
+	if tag.MaxLength != nil {
+		fmt.Fprintf(body, "if len(%s) > %d {\nallErrs = append(allErrs, field.TooLong(%s, %s, %d))\n}\n",
+			expr, *tag.MaxLength, path, value, *tag.MaxLength)
+	}
+	if tag.Type == "hostname" {
+		fmt.Fprintf(body, "for _, msg := range %s.IsDNS1123Subdomain(%s.ToLower(%s)) {\nallErrs = append(allErrs, field.Invalid(%s, %s, msg))\n}\n",
+			body.use("k8s.io/apimachinery/pkg/util/validation"), body.use("strings"), str, path, value)
+	}
+	if tag.ValidChars != "" {
+		fmt.Fprintf(body, "if %s.Trim(%s, %q) != \"\" {\nallErrs = append(allErrs, field.Invalid(%s, %s, \"must only contain characters in %s\"))\n}\n",
+			body.use("strings"), str, tag.ValidChars, path, value, strings.Replace(tag.ValidChars, `"`, `\"`, -1))
+	}
+	if tag.InvalidChars != "" {
+		fmt.Fprintf(body, "if %s.ContainsAny(%s, %q) {\nallErrs = append(allErrs, field.Invalid(%s, %s, \"must not contain characters in %s\"))\n}\n",
+			body.use("strings"), str, tag.InvalidChars, path, value, strings.Replace(tag.InvalidChars, `"`, `\"`, -1))
+	}
+
+	switch {
+	case required && body.Len() > 0:
+		fmt.Fprintf(w, "if %s == \"\" {\nallErrs = append(allErrs, field.Required(%s, \"\"))\n} else {\n%s}\n", expr, path, body.String())
+	case required:
+		fmt.Fprintf(w, "if %s == \"\" {\nallErrs = append(allErrs, field.Required(%s, \"\"))\n}\n", expr, path)
+	case body.Len() > 0:
+		fmt.Fprintf(w, "if %s != \"\" {\n%s}\n", expr, body.String())
+	}
+}
+
+func writeNumber(w *goFile, tag normanTag, expr, path string, unset bool) {
+	body := &goFile{pkg: w.pkg, imports: w.imports}
+	if tag.Min != nil {
+		fmt.Fprintf(body, "if %s < %d {\nallErrs = append(allErrs, field.Invalid(%s, %s, \"must be greater than or equal to %d\"))\n}\n",
+			expr, *tag.Min, path, expr, *tag.Min)
+	}
+	if tag.Max != nil {
+		fmt.Fprintf(body, "if %s > %d {\nallErrs = append(allErrs, field.Invalid(%s, %s, \"must be less than or equal to %d\"))\n}\n",
+			expr, *tag.Max, path, expr, *tag.Max)
+	}
+
+	if unset && body.Len() > 0 {
+		fmt.Fprintf(w, "if %s != 0 {\n%s}\n", expr, body.String())
+	} else {
+		w.Write(body.Bytes())
+	}
+}
@@ -31,10 +31,14 @@ replace (
 
 require (
 	github.com/coreos/prometheus-operator v0.33.0
+	github.com/ghodss/yaml v1.0.0
 	github.com/knative/pkg v0.0.0-20190817231834-12ee58e32cc8
 	github.com/pkg/errors v0.8.1
+	github.com/prometheus/client_golang v0.9.3-0.20190127221311-3c4408c8b829
 	github.com/rancher/norman v0.0.0-20191126011629-6269ccdbeace
 	github.com/sirupsen/logrus v1.4.2
+	golang.org/x/crypto v0.0.0-20190611184440-5c40567a22f8
+	golang.org/x/net v0.0.0-20190812203447-cdfb69ac37fc
 	golang.org/x/tools v0.0.0-20191029041327-9cc4af7d6b2c // indirect
 	k8s.io/api v0.0.0
 	k8s.io/apiextensions-apiserver v0.0.0
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/rancher/types/image/inventory"
+)
+
+var (
+	data     = flag.String("data", "", "JSON or YAML file with K8sVersionRKESystemImages and K8sVersionWindowsSystemImages keyed by kubernetes version")
+	versions = flag.String("versions", "", "comma separated kubernetes versions, defaults to every version in the data file")
+	osName   = flag.String("os", "", "only list images for this OS (linux or windows)")
+	format   = flag.String("format", string(inventory.Text), "output format (text, json, mirrors, save-script or load-script)")
+	tools    = flag.Bool("tools", true, "include tool and other mirrored system images")
+	output   = flag.String("output", "", "output file, defaults to stdout")
+)
+
+func main() {
+	flag.Parse()
+	if err := run(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run() error {
+	source := inventory.Source{
+		Tools: *tools,
+	}
+	if *data != "" {
+		content, err := ioutil.ReadFile(*data)
+		if err != nil {
+			return err
+		}
+		if err := yaml.Unmarshal(content, &source); err != nil {
+			return fmt.Errorf("failed to parse %s: %v", *data, err)
+		}
+	}
+
+	var k8sVersions []string
+	if *versions != "" {
+		k8sVersions = strings.Split(*versions, ",")
+	}
+
+	inv, err := inventory.Generate(source, k8sVersions, inventory.OS(*osName))
+	if err != nil {
+		return err
+	}
+
+	out := os.Stdout
+	if *output != "" {
+		out, err = os.Create(*output)
+		if err != nil {
+			return err
+		}
+		defer out.Close()
+	}
+
+	return inv.Write(out, inventory.Format(*format))
+}
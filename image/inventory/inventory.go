@@ -0,0 +1,184 @@
+package inventory
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/rancher/types/apis/management.cattle.io/v3"
+	"github.com/rancher/types/image"
+)
+
+type OS string
+
+const (
+	All     OS = ""
+	Linux   OS = "linux"
+	Windows OS = "windows"
+)
+
+// Source holds the system image catalogs to walk. RKESystemImages and
+// WindowsSystemImages are keyed by Kubernetes version, K8sSystemImages are
+// keyed by their object name which is the Kubernetes version.
+type Source struct {
+	RKESystemImages     map[string]v3.RKESystemImages     `json:"K8sVersionRKESystemImages,omitempty"`
+	WindowsSystemImages map[string]v3.WindowsSystemImages `json:"K8sVersionWindowsSystemImages,omitempty"`
+	K8sSystemImages     []v3.RKEK8sSystemImage            `json:"-"`
+	// Tools includes v3.ToolsSystemImages and every image recorded by
+	// image.Mirror
+	Tools bool `json:"-"`
+}
+
+// Image is one image of the inventory, Origin is the upstream name the image
+// was mirrored from and is empty if it was not mirrored. OS is empty for
+// images used by both Linux and Windows.
+type Image struct {
+	Name    string   `json:"name"`
+	Origin  string   `json:"origin,omitempty"`
+	OS      OS       `json:"os"`
+	Sources []string `json:"sources"`
+}
+
+type Inventory struct {
+	Images []Image `json:"images"`
+}
+
+// Generate walks source for the given Kubernetes versions, or all versions in
+// source if none are given, and returns the deduplicated images for os.
+func Generate(source Source, versions []string, os OS) (*Inventory, error) {
+	rkeImages := map[string]v3.RKESystemImages{}
+	for k, v := range source.RKESystemImages {
+		rkeImages[k] = v
+	}
+	for _, obj := range source.K8sSystemImages {
+		rkeImages[obj.Name] = obj.SystemImages
+	}
+
+	if len(versions) == 0 {
+		versions = allVersions(rkeImages, source.WindowsSystemImages)
+	}
+
+	c := collector{
+		os:     os,
+		images: map[string]*Image{},
+	}
+
+	for _, version := range versions {
+		rke, rkeOK := rkeImages[version]
+		windows, windowsOK := source.WindowsSystemImages[version]
+		if !rkeOK && !windowsOK {
+			return nil, fmt.Errorf("no system images for kubernetes version %s", version)
+		}
+		if rkeOK {
+			c.walk(version+"/rkeSystemImages", rke, Linux, map[string]OS{
+				"windowsPodInfraContainer": Windows,
+			})
+		}
+		if windowsOK {
+			c.walk(version+"/windowsSystemImages", windows, Windows, nil)
+		}
+	}
+
+	if source.Tools {
+		c.walk("tools/pipelineSystemImages", v3.ToolsSystemImages.PipelineSystemImages, Linux, nil)
+		c.walk("tools/authSystemImages", v3.ToolsSystemImages.AuthSystemImages, Linux, nil)
+		for _, mirror := range image.MirroredImages() {
+			if _, ok := c.images[mirror]; !ok {
+				c.add(mirror, "mirrors", Linux)
+			}
+		}
+	}
+
+	return c.inventory(), nil
+}
+
+func allVersions(rke map[string]v3.RKESystemImages, windows map[string]v3.WindowsSystemImages) []string {
+	seen := map[string]bool{}
+	var result []string
+	for version := range rke {
+		seen[version] = true
+		result = append(result, version)
+	}
+	for version := range windows {
+		if !seen[version] {
+			result = append(result, version)
+		}
+	}
+	sort.Strings(result)
+	return result
+}
+
+type collector struct {
+	os     OS
+	images map[string]*Image
+}
+
+func (c *collector) walk(prefix string, obj interface{}, os OS, fieldOS map[string]OS) {
+	v := reflect.ValueOf(obj)
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		if v.Field(i).Kind() != reflect.String {
+			continue
+		}
+		name := strings.Split(t.Field(i).Tag.Get("json"), ",")[0]
+		imageOS := os
+		if o, ok := fieldOS[name]; ok {
+			imageOS = o
+		}
+		c.add(v.Field(i).String(), prefix+"."+name, imageOS)
+	}
+}
+
+func (c *collector) add(name, source string, os OS) {
+	if name == "" || (c.os != All && c.os != os) {
+		return
+	}
+
+	img, ok := c.images[name]
+	if !ok {
+		img = &Image{
+			Name: name,
+			OS:   os,
+		}
+		if origin, ok := image.Origin(name); ok && origin != name {
+			img.Origin = origin
+		}
+		c.images[name] = img
+	} else if img.OS != os {
+		img.OS = All
+	}
+	img.Sources = append(img.Sources, source)
+}
+
+func (c *collector) inventory() *Inventory {
+	result := &Inventory{}
+	for _, img := range c.images {
+		sort.Strings(img.Sources)
+		result.Images = append(result.Images, *img)
+	}
+	sort.Slice(result.Images, func(i, j int) bool {
+		return result.Images[i].Name < result.Images[j].Name
+	})
+	return result
+}
+
+// Names returns the sorted image names.
+func (i *Inventory) Names() []string {
+	var result []string
+	for _, img := range i.Images {
+		result = append(result, img.Name)
+	}
+	return result
+}
+
+// Mirrors returns the origin to mirror mapping of every mirrored image.
+func (i *Inventory) Mirrors() map[string]string {
+	result := map[string]string{}
+	for _, img := range i.Images {
+		if img.Origin != "" {
+			result[img.Origin] = img.Name
+		}
+	}
+	return result
+}
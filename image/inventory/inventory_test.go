@@ -0,0 +1,137 @@
+package inventory
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/rancher/types/apis/management.cattle.io/v3"
+	"github.com/rancher/types/image"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func testSource() Source {
+	return Source{
+		RKESystemImages: map[string]v3.RKESystemImages{
+			"v1.15.5-rancher1-2": {
+				Etcd:                     image.Mirror("quay.io/coreos/etcd:v3.3.10"),
+				Kubernetes:               "rancher/hyperkube:v1.15.5-rancher1",
+				WindowsPodInfraContainer: "rancher/kubelet-pause:v0.1.3",
+			},
+		},
+		WindowsSystemImages: map[string]v3.WindowsSystemImages{
+			"v1.15.5-rancher1-2": {
+				NginxProxy: "rancher/nginx-proxy:v0.1",
+				// shared with the linux images
+				KubernetesBinaries: "rancher/hyperkube:v1.15.5-rancher1",
+			},
+		},
+		K8sSystemImages: []v3.RKEK8sSystemImage{{
+			ObjectMeta:   metav1.ObjectMeta{Name: "v1.16.2-rancher1-1"},
+			SystemImages: v3.RKESystemImages{Kubernetes: "rancher/hyperkube:v1.16.2-rancher1"},
+		}},
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	for _, test := range []struct {
+		name     string
+		versions []string
+		os       OS
+		expected []Image
+	}{
+		{
+			name:     "linux of one version",
+			versions: []string{"v1.15.5-rancher1-2"},
+			os:       Linux,
+			expected: []Image{
+				{Name: "rancher/coreos-etcd:v3.3.10", Origin: "quay.io/coreos/etcd:v3.3.10", OS: Linux, Sources: []string{"v1.15.5-rancher1-2/rkeSystemImages.etcd"}},
+				{Name: "rancher/hyperkube:v1.15.5-rancher1", OS: Linux, Sources: []string{"v1.15.5-rancher1-2/rkeSystemImages.kubernetes"}},
+			},
+		},
+		{
+			name:     "windows of one version",
+			versions: []string{"v1.15.5-rancher1-2"},
+			os:       Windows,
+			expected: []Image{
+				{Name: "rancher/hyperkube:v1.15.5-rancher1", OS: Windows, Sources: []string{"v1.15.5-rancher1-2/windowsSystemImages.kubernetesBinaries"}},
+				{Name: "rancher/kubelet-pause:v0.1.3", OS: Windows, Sources: []string{"v1.15.5-rancher1-2/rkeSystemImages.windowsPodInfraContainer"}},
+				{Name: "rancher/nginx-proxy:v0.1", OS: Windows, Sources: []string{"v1.15.5-rancher1-2/windowsSystemImages.nginxProxy"}},
+			},
+		},
+		{
+			name: "all versions",
+			os:   All,
+			expected: []Image{
+				{Name: "rancher/coreos-etcd:v3.3.10", Origin: "quay.io/coreos/etcd:v3.3.10", OS: Linux, Sources: []string{"v1.15.5-rancher1-2/rkeSystemImages.etcd"}},
+				{Name: "rancher/hyperkube:v1.15.5-rancher1", OS: All, Sources: []string{"v1.15.5-rancher1-2/rkeSystemImages.kubernetes", "v1.15.5-rancher1-2/windowsSystemImages.kubernetesBinaries"}},
+				{Name: "rancher/hyperkube:v1.16.2-rancher1", OS: Linux, Sources: []string{"v1.16.2-rancher1-1/rkeSystemImages.kubernetes"}},
+				{Name: "rancher/kubelet-pause:v0.1.3", OS: Windows, Sources: []string{"v1.15.5-rancher1-2/rkeSystemImages.windowsPodInfraContainer"}},
+				{Name: "rancher/nginx-proxy:v0.1", OS: Windows, Sources: []string{"v1.15.5-rancher1-2/windowsSystemImages.nginxProxy"}},
+			},
+		},
+	} {
+		inventory, err := Generate(testSource(), test.versions, test.os)
+		if err != nil {
+			t.Errorf("%s: %v", test.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(inventory.Images, test.expected) {
+			t.Errorf("%s: expected %+v, got %+v", test.name, test.expected, inventory.Images)
+		}
+	}
+
+	if _, err := Generate(testSource(), []string{"v1.10.0-rancher1-1"}, All); err == nil {
+		t.Error("expected an unknown version to fail")
+	}
+}
+
+func TestWrite(t *testing.T) {
+	inventory, err := Generate(testSource(), []string{"v1.15.5-rancher1-2"}, Linux)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, test := range []struct {
+		format   Format
+		contains []string
+	}{
+		{Text, []string{"rancher/coreos-etcd:v3.3.10\nrancher/hyperkube:v1.15.5-rancher1\n"}},
+		{MirrorsText, []string{"quay.io/coreos/etcd:v3.3.10 rancher/coreos-etcd:v3.3.10\n"}},
+		{SaveScript, []string{"#!/bin/bash", `  "rancher/hyperkube:v1.15.5-rancher1"`, "docker save"}},
+		{LoadScript, []string{"#!/bin/bash", `  "rancher/coreos-etcd:v3.3.10"`, "docker push"}},
+		{JSON, []string{`"mirrors": {`}},
+	} {
+		buf := &bytes.Buffer{}
+		if err := inventory.Write(buf, test.format); err != nil {
+			t.Errorf("%s: %v", test.format, err)
+			continue
+		}
+		for _, s := range test.contains {
+			if !strings.Contains(buf.String(), s) {
+				t.Errorf("%s: expected %q in %s", test.format, s, buf.String())
+			}
+		}
+	}
+
+	buf := &bytes.Buffer{}
+	if err := inventory.WriteJSON(buf); err != nil {
+		t.Fatal(err)
+	}
+	var decoded struct {
+		Images  []Image           `json:"images"`
+		Mirrors map[string]string `json:"mirrors"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(decoded.Images, inventory.Images) || decoded.Mirrors["quay.io/coreos/etcd:v3.3.10"] != "rancher/coreos-etcd:v3.3.10" {
+		t.Errorf("unexpected json %s", buf.String())
+	}
+
+	if err := inventory.Write(buf, Format("yaml")); err == nil {
+		t.Error("expected an unknown format to fail")
+	}
+}
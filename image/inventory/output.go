@@ -0,0 +1,132 @@
+package inventory
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"text/template"
+)
+
+type Format string
+
+const (
+	Text        Format = "text"
+	JSON        Format = "json"
+	SaveScript  Format = "save-script"
+	LoadScript  Format = "load-script"
+	MirrorsText Format = "mirrors"
+)
+
+var Formats = []Format{Text, JSON, SaveScript, LoadScript, MirrorsText}
+
+// Write renders the inventory in the given format.
+func (i *Inventory) Write(w io.Writer, format Format) error {
+	switch format {
+	case Text:
+		return i.WriteText(w)
+	case JSON:
+		return i.WriteJSON(w)
+	case SaveScript:
+		return i.WriteSaveScript(w)
+	case LoadScript:
+		return i.WriteLoadScript(w)
+	case MirrorsText:
+		return i.WriteMirrors(w)
+	}
+	return fmt.Errorf("unknown format %s", format)
+}
+
+// WriteText writes one image name per line.
+func (i *Inventory) WriteText(w io.Writer) error {
+	for _, name := range i.Names() {
+		if _, err := fmt.Fprintln(w, name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WriteMirrors writes one "origin mirror" pair per line.
+func (i *Inventory) WriteMirrors(w io.Writer) error {
+	mirrors := i.Mirrors()
+	var origins []string
+	for origin := range mirrors {
+		origins = append(origins, origin)
+	}
+	sort.Strings(origins)
+	for _, origin := range origins {
+		if _, err := fmt.Fprintf(w, "%s %s\n", origin, mirrors[origin]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WriteJSON writes the images and the origin to mirror mapping.
+func (i *Inventory) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(map[string]interface{}{
+		"images":  i.Images,
+		"mirrors": i.Mirrors(),
+	})
+}
+
+// WriteSaveScript writes a shell script that pulls every image and saves
+// them into a single compressed archive.
+func (i *Inventory) WriteSaveScript(w io.Writer) error {
+	return saveTemplate.Execute(w, i.Names())
+}
+
+// WriteLoadScript writes a shell script that loads the archive written by
+// the save script and pushes every image to a private registry.
+func (i *Inventory) WriteLoadScript(w io.Writer) error {
+	return loadTemplate.Execute(w, i.Names())
+}
+
+var saveTemplate = template.Must(template.New("save").Parse(`#!/bin/bash
+set -e
+
+archive=${1:-rancher-images.tar.gz}
+images=(
+{{- range .}}
+  "{{.}}"
+{{- end}}
+)
+
+for image in "${images[@]}"; do
+  docker pull "${image}"
+done
+
+docker save "${images[@]}" | gzip --stdout > "${archive}"
+`))
+
+var loadTemplate = template.Must(template.New("load").Parse(`#!/bin/bash
+set -e
+
+if [ -z "$1" ]; then
+  echo "usage: $0 <registry> [archive]"
+  exit 1
+fi
+
+registry=$1
+archive=${2:-rancher-images.tar.gz}
+images=(
+{{- range .}}
+  "{{.}}"
+{{- end}}
+)
+
+docker load --input "${archive}"
+
+for image in "${images[@]}"; do
+  name=${image}
+  case ${name} in
+    */*) ;;
+    *) name=library/${name} ;;
+  esac
+  docker tag "${image}" "${registry}/${name}"
+  docker push "${registry}/${name}"
+done
+`))
@@ -1,28 +1,251 @@
 package image
 
-import "strings"
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
 
+	"github.com/ghodss/yaml"
+)
+
+type RuleType string
+
+const (
+	// PrefixRule replaces Match when the image starts with it
+	PrefixRule RuleType = "prefix"
+	// SubstringRule replaces the first occurrence of Match anywhere in the image
+	SubstringRule RuleType = "substring"
+	// RegexRule replaces every match of the regular expression Match, Replace
+	// may refer to submatches as $1
+	RegexRule RuleType = "regex"
+)
+
+type Rule struct {
+	Type    RuleType `json:"type,omitempty"`
+	Match   string   `json:"match"`
+	Replace string   `json:"replace"`
+}
+
+// RuleSet rewrites image names to their mirrors. Rules are applied in order
+// and every matching rule is applied to the output of the previous one.
+// Images starting with any of the Exclude prefixes are never rewritten.
+type RuleSet struct {
+	Rules   []Rule   `json:"rules,omitempty"`
+	Exclude []string `json:"exclude,omitempty"`
+
+	regexps []*regexp.Regexp
+}
+
+var (
+	defaultRules = []Rule{
+		{Type: SubstringRule, Match: "gcr.io/google_containers", Replace: "rancher"},
+		{Type: SubstringRule, Match: "quay.io/coreos/", Replace: "rancher/coreos-"},
+		{Type: SubstringRule, Match: "quay.io/calico/", Replace: "rancher/calico-"},
+		{Type: SubstringRule, Match: "k8s.gcr.io/", Replace: "rancher/nginx-ingress-controller-"},
+		{Type: SubstringRule, Match: "plugins/docker", Replace: "rancher/plugins-docker"},
+		{Type: SubstringRule, Match: "kibana", Replace: "rancher/kibana"},
+		{Type: SubstringRule, Match: "jenkins/", Replace: "rancher/jenkins-"},
+		{Type: SubstringRule, Match: "alpine/git", Replace: "rancher/alpine-git"},
+		{Type: SubstringRule, Match: "prom/", Replace: "rancher/prom-"},
+		{Type: SubstringRule, Match: "quay.io/pires", Replace: "rancher"},
+		{Type: SubstringRule, Match: "coredns/", Replace: "rancher/coredns-"},
+		{Type: SubstringRule, Match: "minio/", Replace: "rancher/minio-"},
+	}
+	defaultExclude = []string{
+		"weaveworks",
+	}
+
+	current = mustRuleSet(defaultRules, defaultExclude)
+	lock    sync.RWMutex
+)
+
+// Mirrors maps every image rewritten by Mirror to its origin. It is written
+// by Mirror, use RecordedMirrors or Origin to read it while images may still
+// be mirrored.
 var Mirrors = map[string]string{}
 
+// NewRuleSet validates the rules and returns a RuleSet ready to use.
+func NewRuleSet(rules []Rule, exclude []string) (*RuleSet, error) {
+	rs := &RuleSet{
+		Rules:   rules,
+		Exclude: exclude,
+	}
+	return rs, rs.compile()
+}
+
+func mustRuleSet(rules []Rule, exclude []string) *RuleSet {
+	rs, err := NewRuleSet(rules, exclude)
+	if err != nil {
+		panic(err)
+	}
+	return rs
+}
+
+// ParseRuleSet loads a RuleSet from YAML or JSON, such as the value of a
+// Setting.
+func ParseRuleSet(data []byte) (*RuleSet, error) {
+	rs := &RuleSet{}
+	if err := yaml.Unmarshal(data, rs); err != nil {
+		return nil, err
+	}
+	return rs, rs.compile()
+}
+
+// DefaultRuleSet returns a copy of the rules built into Mirror.
+func DefaultRuleSet() *RuleSet {
+	return mustRuleSet(append([]Rule{}, defaultRules...), append([]string{}, defaultExclude...))
+}
+
+func (r *RuleSet) compile() error {
+	r.regexps = make([]*regexp.Regexp, len(r.Rules))
+	for i, rule := range r.Rules {
+		if rule.Match == "" {
+			return fmt.Errorf("mirror rule %d has no match", i)
+		}
+		switch rule.Type {
+		case "", PrefixRule, SubstringRule:
+		case RegexRule:
+			re, err := regexp.Compile(rule.Match)
+			if err != nil {
+				return fmt.Errorf("mirror rule %d: %v", i, err)
+			}
+			r.regexps[i] = re
+		default:
+			return fmt.Errorf("mirror rule %d has unknown type %s", i, rule.Type)
+		}
+	}
+	return nil
+}
+
+// Rewrite returns the mirror of image.
+func (r *RuleSet) Rewrite(image string) string {
+	for _, exclude := range r.Exclude {
+		if strings.HasPrefix(image, exclude) {
+			return image
+		}
+	}
+
+	for i, rule := range r.Rules {
+		switch rule.Type {
+		case "", PrefixRule:
+			if strings.HasPrefix(image, rule.Match) {
+				image = rule.Replace + strings.TrimPrefix(image, rule.Match)
+			}
+		case SubstringRule:
+			image = strings.Replace(image, rule.Match, rule.Replace, 1)
+		case RegexRule:
+			if re := r.regexp(i); re != nil {
+				image = re.ReplaceAllString(image, rule.Replace)
+			}
+		}
+	}
+
+	return image
+}
+
+// regexp returns the compiled Match of the rule i. Rule sets that were not
+// built by NewRuleSet or ParseRuleSet compile it on every call, invalid
+// expressions match nothing.
+func (r *RuleSet) regexp(i int) *regexp.Regexp {
+	if i < len(r.regexps) && r.regexps[i] != nil {
+		return r.regexps[i]
+	}
+	re, err := regexp.Compile(r.Rules[i].Match)
+	if err != nil {
+		return nil
+	}
+	return re
+}
+
+// Excluded reports whether image is left alone by the rule set.
+func (r *RuleSet) Excluded(image string) bool {
+	for _, exclude := range r.Exclude {
+		if strings.HasPrefix(image, exclude) {
+			return true
+		}
+	}
+	return false
+}
+
+// SetRuleSet replaces the rules used by Mirror. Images that were already
+// mirrored, such as the system image defaults computed at init, keep their
+// recorded mirror and their mirror under rs is added to Mirrors. Invalid rules
+// are rejected and leave the rules of Mirror unchanged.
+func SetRuleSet(rs *RuleSet) error {
+	if rs == nil {
+		return fmt.Errorf("no mirror rule set")
+	}
+
+	lock.Lock()
+	defer lock.Unlock()
+
+	if err := rs.compile(); err != nil {
+		return err
+	}
+	current = rs
+	var origins []string
+	for _, origin := range Mirrors {
+		origins = append(origins, origin)
+	}
+	for _, origin := range origins {
+		if !rs.Excluded(origin) {
+			Mirrors[rs.Rewrite(origin)] = origin
+		}
+	}
+	return nil
+}
+
+// CurrentRuleSet returns the rules used by Mirror.
+func CurrentRuleSet() *RuleSet {
+	lock.RLock()
+	defer lock.RUnlock()
+	return current
+}
+
 func Mirror(image string) string {
-	orig := image
-	if strings.HasPrefix(image, "weaveworks") {
+	lock.Lock()
+	defer lock.Unlock()
+
+	if current.Excluded(image) {
 		return image
 	}
 
-	image = strings.Replace(image, "gcr.io/google_containers", "rancher", 1)
-	image = strings.Replace(image, "quay.io/coreos/", "rancher/coreos-", 1)
-	image = strings.Replace(image, "quay.io/calico/", "rancher/calico-", 1)
-	image = strings.Replace(image, "k8s.gcr.io/", "rancher/nginx-ingress-controller-", 1)
-	image = strings.Replace(image, "plugins/docker", "rancher/plugins-docker", 1)
-	image = strings.Replace(image, "kibana", "rancher/kibana", 1)
-	image = strings.Replace(image, "jenkins/", "rancher/jenkins-", 1)
-	image = strings.Replace(image, "alpine/git", "rancher/alpine-git", 1)
-	image = strings.Replace(image, "prom/", "rancher/prom-", 1)
-	image = strings.Replace(image, "quay.io/pires", "rancher", 1)
-	image = strings.Replace(image, "coredns/", "rancher/coredns-", 1)
-	image = strings.Replace(image, "minio/", "rancher/minio-", 1)
-
-	Mirrors[image] = orig
-	return image
+	mirror := current.Rewrite(image)
+	Mirrors[mirror] = image
+	return mirror
+}
+
+// RecordedMirrors returns a copy of Mirrors.
+func RecordedMirrors() map[string]string {
+	lock.RLock()
+	defer lock.RUnlock()
+
+	result := make(map[string]string, len(Mirrors))
+	for k, v := range Mirrors {
+		result[k] = v
+	}
+	return result
+}
+
+// MirroredImages returns the mirrors recorded by Mirror sorted by name.
+func MirroredImages() []string {
+	lock.RLock()
+	defer lock.RUnlock()
+
+	result := make([]string, 0, len(Mirrors))
+	for k := range Mirrors {
+		result = append(result, k)
+	}
+	sort.Strings(result)
+	return result
+}
+
+// Origin returns the image that Mirror rewrote to mirror.
+func Origin(mirror string) (string, bool) {
+	lock.RLock()
+	defer lock.RUnlock()
+	orig, ok := Mirrors[mirror]
+	return orig, ok
 }
@@ -0,0 +1,100 @@
+package image
+
+import (
+	"testing"
+)
+
+func TestDefaultRuleSet(t *testing.T) {
+	rs := DefaultRuleSet()
+	for orig, expected := range map[string]string{
+		"quay.io/coreos/flannel:v0.11.0":                "rancher/coreos-flannel:v0.11.0",
+		"k8s.gcr.io/defaultbackend:1.4":                 "rancher/nginx-ingress-controller-defaultbackend:1.4",
+		"gcr.io/google_containers/k8s-dns-kube-dns:1.0": "rancher/k8s-dns-kube-dns:1.0",
+		"weaveworks/weave-kube:2.5.0":                   "weaveworks/weave-kube:2.5.0",
+		"rancher/pipeline-tools:v0.1.14":                "rancher/pipeline-tools:v0.1.14",
+	} {
+		if actual := rs.Rewrite(orig); actual != expected {
+			t.Errorf("expected %s to mirror to %s, got %s", orig, expected, actual)
+		}
+	}
+}
+
+func TestParseRuleSet(t *testing.T) {
+	rs, err := ParseRuleSet([]byte(`
+rules:
+- match: rancher/
+  replace: registry.example.com/rancher/
+- type: regex
+  match: ^quay\.io/([^/]+)/
+  replace: registry.example.com/quay-$1/
+exclude:
+- registry.example.com/
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for orig, expected := range map[string]string{
+		"rancher/rke-tools:v0.1.52":                   "registry.example.com/rancher/rke-tools:v0.1.52",
+		"quay.io/calico/node:v3.10.2":                 "registry.example.com/quay-calico/node:v3.10.2",
+		"registry.example.com/rancher/rke-tools:v0.1": "registry.example.com/rancher/rke-tools:v0.1",
+		"busybox": "busybox",
+	} {
+		if actual := rs.Rewrite(orig); actual != expected {
+			t.Errorf("expected %s to mirror to %s, got %s", orig, expected, actual)
+		}
+	}
+
+	if _, err := ParseRuleSet([]byte(`rules: [{type: regex, match: "(", replace: x}]`)); err == nil {
+		t.Fatal("expected invalid regex to fail")
+	}
+}
+
+func TestMirrorOrigin(t *testing.T) {
+	mirror := Mirror("minio/minio:RELEASE.2019-09-25T18-25-51Z")
+	if mirror != "rancher/minio-minio:RELEASE.2019-09-25T18-25-51Z" {
+		t.Fatalf("unexpected mirror %s", mirror)
+	}
+	if orig, ok := Origin(mirror); !ok || orig != "minio/minio:RELEASE.2019-09-25T18-25-51Z" {
+		t.Fatalf("unexpected origin %s", orig)
+	}
+}
+
+func TestMirrorsVariable(t *testing.T) {
+	mirror := Mirror("prom/prometheus:v2.11.1")
+	if Mirrors[mirror] != "prom/prometheus:v2.11.1" {
+		t.Fatalf("expected Mirrors to record %s, got %v", mirror, Mirrors[mirror])
+	}
+	if RecordedMirrors()[mirror] != "prom/prometheus:v2.11.1" {
+		t.Fatal("expected RecordedMirrors to copy Mirrors")
+	}
+
+	rs, err := NewRuleSet([]Rule{{Match: "prom/", Replace: "mirror.example.com/prom/"}}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := SetRuleSet(rs); err != nil {
+		t.Fatal(err)
+	}
+	defer SetRuleSet(DefaultRuleSet())
+	if Mirrors["mirror.example.com/prom/prometheus:v2.11.1"] != "prom/prometheus:v2.11.1" || Mirrors[mirror] == "" {
+		t.Errorf("expected the old and new mirror to be recorded, got %v", Mirrors)
+	}
+
+	if err := SetRuleSet(nil); err == nil {
+		t.Error("expected a nil rule set to be rejected")
+	}
+	if err := SetRuleSet(&RuleSet{Rules: []Rule{{Type: RegexRule, Match: "("}}}); err == nil {
+		t.Error("expected an invalid rule set to be rejected")
+	}
+	if CurrentRuleSet() != rs {
+		t.Error("expected rejected rule sets to leave the rules unchanged")
+	}
+}
+
+func TestRuleSetLiteral(t *testing.T) {
+	rs := &RuleSet{Rules: []Rule{{Type: RegexRule, Match: `^prom/(.*)$`, Replace: "mirror.example.com/prom-$1"}}}
+	if actual := rs.Rewrite("prom/prometheus:v2.11.1"); actual != "mirror.example.com/prom-prometheus:v2.11.1" {
+		t.Errorf("expected the regex rule of a literal rule set to apply, got %s", actual)
+	}
+}
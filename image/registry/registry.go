@@ -0,0 +1,179 @@
+package registry
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/rancher/types/apis/management.cattle.io/v3"
+)
+
+// Options controls how image references are rewritten to a private registry.
+type Options struct {
+	// Registry is the registry URL images are rewritten to, if empty the
+	// default registry of Registries is used
+	Registry   string
+	Registries []v3.PrivateRegistry
+	// Exclude maps a registry URL to image prefixes that are never rewritten
+	// to that registry
+	Exclude map[string][]string
+	// ReplaceHost replaces a registry host already present in an image
+	// instead of nesting the full reference under the private registry
+	ReplaceHost bool
+}
+
+// Change is a field whose image was rewritten, Field is the JSON path of the
+// field.
+type Change struct {
+	Field string
+	From  string
+	To    string
+}
+
+// Default returns the registry flagged IsDefault, or nil if there is none.
+func Default(registries []v3.PrivateRegistry) *v3.PrivateRegistry {
+	for i := range registries {
+		if registries[i].IsDefault {
+			return &registries[i]
+		}
+	}
+	return nil
+}
+
+func (o Options) registry() string {
+	if o.Registry != "" {
+		return o.Registry
+	}
+	if r := Default(o.Registries); r != nil {
+		return r.URL
+	}
+	return ""
+}
+
+// Host returns the registry host of image and the remainder of the
+// reference. The host is empty for images on Docker Hub.
+func Host(image string) (string, string) {
+	parts := strings.SplitN(image, "/", 2)
+	if len(parts) == 2 && (strings.ContainsAny(parts[0], ".:") || parts[0] == "localhost") {
+		return parts[0], parts[1]
+	}
+	return "", image
+}
+
+// Image rewrites a single image reference to the registry configured in
+// opts. Images that are empty, excluded or already on the registry are
+// returned unchanged.
+func Image(image string, opts Options) string {
+	registry := strings.TrimSuffix(opts.registry(), "/")
+	if image == "" || registry == "" {
+		return image
+	}
+	if strings.HasPrefix(image, registry+"/") {
+		return image
+	}
+	for _, prefix := range opts.Exclude[registry] {
+		if strings.HasPrefix(image, prefix) {
+			return image
+		}
+	}
+
+	if opts.ReplaceHost {
+		_, image = Host(image)
+	}
+	return registry + "/" + image
+}
+
+// RKESystemImages returns a copy of images with every image rewritten.
+func RKESystemImages(images v3.RKESystemImages, opts Options) (v3.RKESystemImages, []Change) {
+	var changes []Change
+	rewriteStrings(reflect.ValueOf(&images).Elem(), "systemImages", opts, &changes)
+	return images, changes
+}
+
+// WindowsSystemImages returns a copy of images with every image rewritten.
+func WindowsSystemImages(images v3.WindowsSystemImages, opts Options) (v3.WindowsSystemImages, []Change) {
+	var changes []Change
+	rewriteStrings(reflect.ValueOf(&images).Elem(), "windowsSystemImages", opts, &changes)
+	return images, changes
+}
+
+// Services returns a copy of services with the BaseService.Image of every
+// service rewritten.
+func Services(services v3.RKEConfigServices, opts Options) (v3.RKEConfigServices, []Change) {
+	var changes []Change
+	rewriteBaseServices(reflect.ValueOf(&services).Elem(), "services", opts, &changes)
+	return services, changes
+}
+
+// Config returns a copy of config with SystemImages and the service images
+// rewritten to the default registry of config.PrivateRegistries, or to
+// opts.Registry if set.
+func Config(config *v3.RancherKubernetesEngineConfig, opts Options) (*v3.RancherKubernetesEngineConfig, []Change) {
+	result := config.DeepCopy()
+	if opts.Registries == nil {
+		opts.Registries = result.PrivateRegistries
+	}
+
+	var changes, c []Change
+	result.SystemImages, c = RKESystemImages(result.SystemImages, opts)
+	changes = append(changes, c...)
+	result.Services, c = Services(result.Services, opts)
+	changes = append(changes, c...)
+
+	return result, changes
+}
+
+func rewriteStrings(v reflect.Value, path string, opts Options, changes *[]Change) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := v.Field(i)
+		if field.Kind() != reflect.String || !field.CanSet() {
+			continue
+		}
+		rewriteField(field, path+"."+jsonName(t.Field(i)), opts, changes)
+	}
+}
+
+var baseServiceType = reflect.TypeOf(v3.BaseService{})
+
+func rewriteBaseServices(v reflect.Value, path string, opts Options, changes *[]Change) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := v.Field(i)
+		if field.Kind() != reflect.Struct {
+			continue
+		}
+
+		fieldPath := path
+		if !t.Field(i).Anonymous {
+			fieldPath = path + "." + jsonName(t.Field(i))
+		}
+
+		if field.Type() == baseServiceType {
+			rewriteField(field.FieldByName("Image"), fieldPath+".image", opts, changes)
+			continue
+		}
+		rewriteBaseServices(field, fieldPath, opts, changes)
+	}
+}
+
+func rewriteField(field reflect.Value, path string, opts Options, changes *[]Change) {
+	from := field.String()
+	to := Image(from, opts)
+	if from == to {
+		return
+	}
+	field.SetString(to)
+	*changes = append(*changes, Change{
+		Field: path,
+		From:  from,
+		To:    to,
+	})
+}
+
+func jsonName(f reflect.StructField) string {
+	name := strings.Split(f.Tag.Get("json"), ",")[0]
+	if name == "" {
+		return f.Name
+	}
+	return name
+}
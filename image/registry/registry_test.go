@@ -0,0 +1,50 @@
+package registry
+
+import (
+	"testing"
+
+	"github.com/rancher/types/apis/management.cattle.io/v3"
+)
+
+func TestConfig(t *testing.T) {
+	config := &v3.RancherKubernetesEngineConfig{
+		PrivateRegistries: []v3.PrivateRegistry{
+			{URL: "other.example.com"},
+			{URL: "registry.example.com", IsDefault: true},
+		},
+		SystemImages: v3.RKESystemImages{
+			Etcd:       "rancher/coreos-etcd:v3.3.15-rancher1",
+			CalicoNode: "quay.io/calico/node:v3.10.2",
+			Kubernetes: "registry.example.com/rancher/hyperkube:v1.16.3-rancher1",
+			Alpine:     "rancher/rke-tools:v0.1.52",
+		},
+	}
+	config.Services.KubeAPI.Image = "rancher/hyperkube:v1.16.3-rancher1"
+
+	result, changes := Config(config, Options{
+		ReplaceHost: true,
+		Exclude: map[string][]string{
+			"registry.example.com": {"rancher/rke-tools"},
+		},
+	})
+
+	expected := map[string]string{
+		"systemImages.etcd":       "registry.example.com/rancher/coreos-etcd:v3.3.15-rancher1",
+		"systemImages.calicoNode": "registry.example.com/calico/node:v3.10.2",
+		"services.kubeApi.image":  "registry.example.com/rancher/hyperkube:v1.16.3-rancher1",
+	}
+	if len(changes) != len(expected) {
+		t.Fatalf("expected %d changes, got %+v", len(expected), changes)
+	}
+	for _, change := range changes {
+		if expected[change.Field] != change.To {
+			t.Errorf("unexpected change %+v", change)
+		}
+	}
+	if result.SystemImages.Alpine != "rancher/rke-tools:v0.1.52" {
+		t.Errorf("excluded image was rewritten to %s", result.SystemImages.Alpine)
+	}
+	if config.SystemImages.Etcd != "rancher/coreos-etcd:v3.3.15-rancher1" {
+		t.Errorf("input config was modified")
+	}
+}
@@ -7,9 +7,12 @@ import (
 	monitoring "github.com/coreos/prometheus-operator/pkg/apis/monitoring"
 	monitoringv1 "github.com/coreos/prometheus-operator/pkg/apis/monitoring/v1"
 	istiov1alpha3 "github.com/knative/pkg/apis/istio/v1alpha3"
+	clusterv3 "github.com/rancher/types/apis/cluster.cattle.io/v3"
 	clusterSchema "github.com/rancher/types/apis/cluster.cattle.io/v3/schema"
+	managementv3 "github.com/rancher/types/apis/management.cattle.io/v3"
 	managementSchema "github.com/rancher/types/apis/management.cattle.io/v3/schema"
 	publicSchema "github.com/rancher/types/apis/management.cattle.io/v3public/schema"
+	projectv3 "github.com/rancher/types/apis/project.cattle.io/v3"
 	projectSchema "github.com/rancher/types/apis/project.cattle.io/v3/schema"
 	"github.com/rancher/types/generator"
 
@@ -38,6 +41,40 @@ func main() {
 		"clusterAuthToken":     true,
 	})
 	generator.Generate(projectSchema.Schemas, nil)
+	generator.GenerateFakeClientset(managementSchema.Schemas, map[string]bool{
+		"userAttribute": true,
+	})
+	generator.GenerateFakeClientset(clusterSchema.Schemas, map[string]bool{
+		"clusterUserAttribute": true,
+		"clusterAuthToken":     true,
+	})
+	generator.GenerateFakeClientset(projectSchema.Schemas, nil)
+	generator.GenerateInstrumented(managementSchema.Schemas, map[string]bool{
+		"userAttribute": true,
+	})
+	generator.GenerateInstrumented(clusterSchema.Schemas, map[string]bool{
+		"clusterUserAttribute": true,
+		"clusterAuthToken":     true,
+	})
+	generator.GenerateInstrumented(projectSchema.Schemas, nil)
+	generator.GenerateTypeScript(managementSchema.Schemas, map[string]bool{
+		"userAttribute": true,
+	})
+	generator.GenerateTypeScript(publicSchema.PublicSchemas, nil)
+	generator.GenerateTypeScript(clusterSchema.Schemas, map[string]bool{
+		"clusterUserAttribute": true,
+		"clusterAuthToken":     true,
+	})
+	generator.GenerateTypeScript(projectSchema.Schemas, nil)
+	generator.GenerateOpenAPI(managementSchema.Schemas)
+	generator.GenerateOpenAPI(publicSchema.PublicSchemas)
+	generator.GenerateOpenAPI(clusterSchema.Schemas)
+	generator.GenerateOpenAPI(projectSchema.Schemas)
+	generator.GenerateCRDs(managementSchema.Schemas, managementv3.AddToScheme)
+	generator.GenerateCRDs(clusterSchema.Schemas, clusterv3.AddToScheme)
+	generator.GenerateCRDs(projectSchema.Schemas, projectv3.AddToScheme)
+	generator.GenerateValidation(managementv3.AddToScheme, clusterv3.AddToScheme, projectv3.AddToScheme)
+	generator.GenerateDefaults(managementv3.AddToScheme, clusterv3.AddToScheme, projectv3.AddToScheme)
 	generator.GenerateNativeTypes(v1.SchemeGroupVersion, []interface{}{
 		v1.Endpoints{},
 		v1.PersistentVolumeClaim{},
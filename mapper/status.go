@@ -2,14 +2,26 @@ package mapper
 
 import (
 	"github.com/rancher/norman/types"
+	"github.com/rancher/norman/types/convert"
 	"github.com/rancher/types/status"
 )
 
 type Status struct {
+	// Explain adds a stateExplanation field describing which rule produced
+	// the state
+	Explain bool
 }
 
 func (s Status) FromInternal(data map[string]interface{}) {
-	status.Set(data)
+	if !s.Explain {
+		status.Set(data)
+		return
+	}
+
+	explanation, err := convert.EncodeToMap(status.Explain(data))
+	if err == nil {
+		data["stateExplanation"] = explanation
+	}
 }
 
 func (s Status) ToInternal(data map[string]interface{}) error {
@@ -41,5 +53,11 @@ func (s Status) ModifySchema(schema *types.Schema, schemas *types.Schemas) error
 		CodeName: "TransitioningMessage",
 		Type:     "string",
 	}
+	if s.Explain {
+		schema.ResourceFields["stateExplanation"] = types.Field{
+			CodeName: "StateExplanation",
+			Type:     "json",
+		}
+	}
 	return nil
 }
@@ -0,0 +1,206 @@
+// Package metrics measures the handlers and work queues of the generated
+// controllers for Prometheus.
+package metrics
+
+import (
+	"context"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/rancher/norman/controller"
+	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/util/workqueue"
+)
+
+const (
+	namespace = "rancher"
+	subsystem = "controller"
+)
+
+var (
+	HandlerExecutions = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "handler_executions_total",
+			Help:      "Total count of handler executions",
+		},
+		[]string{"controller", "handler"},
+	)
+
+	HandlerFailures = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "handler_failures_total",
+			Help:      "Total count of handler executions that failed, conflicts excluded",
+		},
+		[]string{"controller", "handler"},
+	)
+
+	HandlerDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "handler_duration_seconds",
+			Help:      "Duration of handler executions",
+			Buckets:   prometheus.DefBuckets,
+		},
+		[]string{"controller", "handler"},
+	)
+
+	QueueDepth = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "queue_depth",
+			Help:      "Number of keys waiting in the work queue of a controller",
+		},
+		[]string{"controller"},
+	)
+
+	QueueRequeues = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: subsystem,
+			Name:      "queue_requeues_total",
+			Help:      "Total count of keys requeued after their handlers failed",
+		},
+		[]string{"controller"},
+	)
+
+	collectors = []prometheus.Collector{
+		HandlerExecutions,
+		HandlerFailures,
+		HandlerDuration,
+	}
+
+	queueCollectors = []prometheus.Collector{
+		QueueDepth,
+		QueueRequeues,
+	}
+
+	warnQueueMetrics sync.Once
+)
+
+// Register registers the metrics with registerer, registering them again is
+// not an error. It must run before the controllers are created.
+//
+// The queue metrics are only registered when NORMAN_QUEUE_METRICS is "true",
+// otherwise norman disables the metrics of all work queues on init and they
+// would never be reported.
+func Register(registerer prometheus.Registerer) error {
+	if err := register(registerer, collectors); err != nil {
+		return err
+	}
+
+	if os.Getenv(controller.MetricsQueueEnv) != "true" {
+		warnQueueMetrics.Do(func() {
+			logrus.Warnf("Work queue metrics are disabled, set %s=true to report %s_%s_queue_depth and %s_%s_queue_requeues_total",
+				controller.MetricsQueueEnv, namespace, subsystem, namespace, subsystem)
+		})
+		return nil
+	}
+	workqueue.SetProvider(queueMetricsProvider{})
+	return register(registerer, queueCollectors)
+}
+
+func register(registerer prometheus.Registerer, collectors []prometheus.Collector) error {
+	for _, collector := range collectors {
+		if err := registerer.Register(collector); err != nil {
+			if _, ok := err.(prometheus.AlreadyRegisteredError); !ok {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Instrument returns generic with the handlers added to it measured under
+// controllerName.
+func Instrument(controllerName string, generic controller.GenericController) controller.GenericController {
+	return &instrumentedController{
+		GenericController: generic,
+		name:              controllerName,
+	}
+}
+
+// InstrumentHandler returns handler measured as the handler handlerName of
+// the controller controllerName.
+func InstrumentHandler(controllerName, handlerName string, handler controller.HandlerFunc) controller.HandlerFunc {
+	executions := HandlerExecutions.WithLabelValues(controllerName, handlerName)
+	failures := HandlerFailures.WithLabelValues(controllerName, handlerName)
+	duration := HandlerDuration.WithLabelValues(controllerName, handlerName)
+
+	return func(key string, obj interface{}) (interface{}, error) {
+		start := time.Now()
+		result, err := handler(key, obj)
+		duration.Observe(time.Since(start).Seconds())
+		executions.Inc()
+		if err != nil && !IgnoreError(err) {
+			failures.Inc()
+		}
+		return result, err
+	}
+}
+
+type instrumentedController struct {
+	controller.GenericController
+	name string
+}
+
+func (c *instrumentedController) AddHandler(ctx context.Context, name string, handler controller.HandlerFunc) {
+	c.GenericController.AddHandler(ctx, name, InstrumentHandler(c.name, name, handler))
+}
+
+// IgnoreError tells the errors of handlers that norman does not log as
+// failures: conflicts and errors that make it forget the key.
+func IgnoreError(err error) bool {
+	err = errors.Cause(err)
+	if apierrors.IsConflict(err) {
+		return true
+	}
+	_, ok := err.(*controller.ForgetError)
+	return ok
+}
+
+type queueMetricsProvider struct{}
+
+func (queueMetricsProvider) NewDepthMetric(name string) workqueue.GaugeMetric {
+	return QueueDepth.WithLabelValues(name)
+}
+
+func (queueMetricsProvider) NewAddsMetric(name string) workqueue.CounterMetric {
+	return noopMetric{}
+}
+
+func (queueMetricsProvider) NewLatencyMetric(name string) workqueue.HistogramMetric {
+	return noopMetric{}
+}
+
+func (queueMetricsProvider) NewWorkDurationMetric(name string) workqueue.HistogramMetric {
+	return noopMetric{}
+}
+
+func (queueMetricsProvider) NewUnfinishedWorkSecondsMetric(name string) workqueue.SettableGaugeMetric {
+	return noopMetric{}
+}
+
+func (queueMetricsProvider) NewLongestRunningProcessorSecondsMetric(name string) workqueue.SettableGaugeMetric {
+	return noopMetric{}
+}
+
+func (queueMetricsProvider) NewRetriesMetric(name string) workqueue.CounterMetric {
+	return QueueRequeues.WithLabelValues(name)
+}
+
+type noopMetric struct{}
+
+func (noopMetric) Inc()            {}
+func (noopMetric) Dec()            {}
+func (noopMetric) Observe(float64) {}
+func (noopMetric) Set(float64)     {}
@@ -0,0 +1,103 @@
+package metrics
+
+import (
+	"errors"
+	"testing"
+
+	pkgerrors "github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/rancher/norman/controller"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestIgnoreError(t *testing.T) {
+	conflict := apierrors.NewConflict(schema.GroupResource{Resource: "clusters"}, "c-1", errors.New("changed"))
+	tests := []struct {
+		name   string
+		err    error
+		ignore bool
+	}{
+		{name: "conflict", err: conflict, ignore: true},
+		{name: "wrapped conflict", err: pkgerrors.Wrap(conflict, "update"), ignore: true},
+		{name: "forget", err: &controller.ForgetError{Err: errors.New("gone")}, ignore: true},
+		{name: "not found", err: apierrors.NewNotFound(schema.GroupResource{Resource: "clusters"}, "c-1")},
+		{name: "other", err: errors.New("failed")},
+	}
+
+	for _, test := range tests {
+		if ignore := IgnoreError(test.err); ignore != test.ignore {
+			t.Errorf("%s: expected %v, got %v", test.name, test.ignore, ignore)
+		}
+	}
+}
+
+func TestInstrumentHandler(t *testing.T) {
+	results := []error{
+		nil,
+		errors.New("failed"),
+		apierrors.NewConflict(schema.GroupResource{Resource: "clusters"}, "c-1", errors.New("changed")),
+	}
+	handler := InstrumentHandler("TestController", "test", func(key string, obj interface{}) (interface{}, error) {
+		err := results[0]
+		results = results[1:]
+		return obj, err
+	})
+
+	for i := 0; i < 3; i++ {
+		if obj, _ := handler("c-1", i); obj != i {
+			t.Errorf("expected the object of the handler to be returned, got %v", obj)
+		}
+	}
+
+	if executions := value(t, HandlerExecutions.WithLabelValues("TestController", "test")); executions != 3 {
+		t.Errorf("expected 3 executions, got %v", executions)
+	}
+	if failures := value(t, HandlerFailures.WithLabelValues("TestController", "test")); failures != 1 {
+		t.Errorf("expected 1 failure without the conflict, got %v", failures)
+	}
+	var m dto.Metric
+	if err := HandlerDuration.WithLabelValues("TestController", "test").(prometheus.Histogram).Write(&m); err != nil {
+		t.Fatal(err)
+	}
+	if count := m.GetHistogram().GetSampleCount(); count != 3 {
+		t.Errorf("expected 3 durations, got %v", count)
+	}
+}
+
+func TestQueueMetricsProvider(t *testing.T) {
+	provider := queueMetricsProvider{}
+
+	depth := provider.NewDepthMetric("TestQueue")
+	depth.Inc()
+	depth.Inc()
+	depth.Dec()
+	if got := value(t, QueueDepth.WithLabelValues("TestQueue")); got != 1 {
+		t.Errorf("expected a depth of 1, got %v", got)
+	}
+
+	provider.NewRetriesMetric("TestQueue").Inc()
+	if got := value(t, QueueRequeues.WithLabelValues("TestQueue")); got != 1 {
+		t.Errorf("expected 1 requeue, got %v", got)
+	}
+
+	// the other metrics of the work queue are not reported
+	provider.NewAddsMetric("TestQueue").Inc()
+	provider.NewLatencyMetric("TestQueue").Observe(1)
+	provider.NewWorkDurationMetric("TestQueue").Observe(1)
+	provider.NewUnfinishedWorkSecondsMetric("TestQueue").Set(1)
+	provider.NewLongestRunningProcessorSecondsMetric("TestQueue").Set(1)
+}
+
+// value returns the value of the counter or gauge metric.
+func value(t *testing.T, metric prometheus.Metric) float64 {
+	var m dto.Metric
+	if err := metric.Write(&m); err != nil {
+		t.Fatal(err)
+	}
+	if m.Counter != nil {
+		return m.GetCounter().GetValue()
+	}
+	return m.GetGauge().GetValue()
+}
@@ -0,0 +1,445 @@
+package peermanager
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+	coordinationclient "k8s.io/client-go/kubernetes/typed/coordination/v1"
+)
+
+const (
+	groupLabel = "peermanager.cattle.io/group"
+	roleLabel  = "peermanager.cattle.io/role"
+	rolePeer   = "peer"
+	roleLeader = "leader"
+)
+
+// LeaseManager is a PeerManager on coordination.k8s.io Leases. Every peer
+// renews a Lease named <Prefix>-<selfID> on each heartbeat, the peers are
+// those with a Lease renewed within LeaseDuration. The leader holds the Lease
+// <Prefix>-leader, peers only compete for it once Leader was called.
+type LeaseManager struct {
+	Namespace string
+	// Prefix of the names of the Leases, peers with the same Prefix in the
+	// same Namespace see each other
+	Prefix            string
+	HeartbeatInterval time.Duration
+	LeaseDuration     time.Duration
+	// RenewDeadline bounds every heartbeat, the peer stops leading when a
+	// heartbeat does not finish within it. It has to be shorter than
+	// LeaseDuration, two thirds of LeaseDuration are used otherwise.
+	RenewDeadline time.Duration
+
+	selfID string
+	client kubernetes.Interface
+	now    func() time.Time
+
+	sync.Mutex
+	peers     Peers
+	candidate bool
+	// stable counts the heartbeats that saw the same peers
+	stable int
+	// renewed and leaderRenewed are the times the Leases of the peer were
+	// last renewed
+	renewed       time.Time
+	leaderRenewed time.Time
+	listeners     map[chan<- Peers]*listener
+}
+
+// NewLeaseManager returns a LeaseManager of the peer selfID with Leases in
+// namespace. The fields can be changed until Run is called.
+func NewLeaseManager(client kubernetes.Interface, namespace, selfID string) *LeaseManager {
+	return &LeaseManager{
+		Namespace:         namespace,
+		Prefix:            "rancher-peer",
+		HeartbeatInterval: 5 * time.Second,
+		LeaseDuration:     15 * time.Second,
+		RenewDeadline:     10 * time.Second,
+		selfID:            selfID,
+		client:            client,
+		now:               time.Now,
+		peers: Peers{
+			SelfID: selfID,
+		},
+		listeners: map[chan<- Peers]*listener{},
+	}
+}
+
+var _ PeerManager = &LeaseManager{}
+
+func (m *LeaseManager) IsLeader() bool {
+	m.Lock()
+	defer m.Unlock()
+	return m.peers.Leader
+}
+
+// Leader makes the peer a candidate for the leader Lease, it becomes the
+// leader on a heartbeat that finds the Lease free or expired.
+func (m *LeaseManager) Leader() {
+	m.Lock()
+	defer m.Unlock()
+	m.candidate = true
+}
+
+// Peers returns the peers seen on the last heartbeat. Ready is set once two
+// heartbeats in a row saw the same peers. Ready and Leader are cleared when
+// the Leases of the peer could not be renewed for LeaseDuration, Leader also
+// when a heartbeat does not finish within RenewDeadline.
+func (m *LeaseManager) Peers() Peers {
+	m.Lock()
+	defer m.Unlock()
+	return m.copyPeers()
+}
+
+// AddListener sends the peers to l whenever they change. A listener that does
+// not keep up only receives the latest peers.
+func (m *LeaseManager) AddListener(l chan<- Peers) {
+	m.Lock()
+	defer m.Unlock()
+
+	if _, ok := m.listeners[l]; ok {
+		return
+	}
+	listener := newListener(l)
+	m.listeners[l] = listener
+	go listener.run()
+}
+
+func (m *LeaseManager) RemoveListener(l chan<- Peers) {
+	m.Lock()
+	defer m.Unlock()
+
+	if listener, ok := m.listeners[l]; ok {
+		close(listener.done)
+		delete(m.listeners, l)
+	}
+}
+
+// Run sends heartbeats until ctx is done, then gives up the Leases of the
+// peer.
+func (m *LeaseManager) Run(ctx context.Context) {
+	ticker := time.NewTicker(m.HeartbeatInterval)
+	defer ticker.Stop()
+
+	for {
+		m.beat()
+
+		select {
+		case <-ctx.Done():
+			m.release()
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// beat sends a heartbeat bounded by RenewDeadline. The clients take no
+// context, a heartbeat that does not finish in time is left running and the
+// peer stops leading, as the other peers may take the leader Lease before the
+// heartbeat returns.
+func (m *LeaseManager) beat() {
+	deadline := m.RenewDeadline
+	if deadline <= 0 || deadline >= m.LeaseDuration {
+		deadline = m.LeaseDuration * 2 / 3
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), deadline)
+	defer cancel()
+
+	done := make(chan error, 1)
+	go func() {
+		done <- m.heartbeat(ctx)
+	}()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			logrus.Errorf("peermanager: heartbeat of %s failed: %v", m.selfID, err)
+		}
+	case <-ctx.Done():
+		logrus.Errorf("peermanager: heartbeat of %s did not finish within %v", m.selfID, deadline)
+		m.abandon()
+	}
+}
+
+// heartbeat renews the Leases of the peer and lists the peers. Nothing is
+// recorded once ctx is done, the results are outdated by then.
+func (m *LeaseManager) heartbeat(ctx context.Context) error {
+	now := metav1.NewMicroTime(m.now())
+
+	selfID := m.selfID
+	m.Lock()
+	candidate := m.candidate
+	m.Unlock()
+
+	if err := m.renew(m.Prefix+"-"+selfID, rolePeer, selfID, now, true); err != nil {
+		m.expire(now.Time)
+		return err
+	}
+	m.Lock()
+	if err := ctx.Err(); err != nil {
+		m.Unlock()
+		return err
+	}
+	m.renewed = now.Time
+	m.Unlock()
+
+	leader := false
+	if candidate {
+		err := m.renew(m.Prefix+"-leader", roleLeader, selfID, now, false)
+		if err != nil && !apierrors.IsConflict(err) {
+			m.expire(now.Time)
+			return err
+		}
+		leader = err == nil
+		m.Lock()
+		if err := ctx.Err(); err != nil {
+			m.Unlock()
+			return err
+		}
+		if leader {
+			m.leaderRenewed = now.Time
+		} else {
+			m.leaderRenewed = time.Time{}
+		}
+		m.Unlock()
+	}
+
+	list, err := m.leases().List(metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(labels.Set{
+			groupLabel: m.Prefix,
+			roleLabel:  rolePeer,
+		}).String(),
+	})
+	if err != nil {
+		m.expire(now.Time)
+		return err
+	}
+	ids := []string{selfID}
+	for _, lease := range list.Items {
+		if holder := holderOf(&lease); holder != selfID && m.live(&lease, now) {
+			ids = append(ids, holder)
+		}
+	}
+	sort.Strings(ids)
+
+	m.Lock()
+	defer m.Unlock()
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if reflect.DeepEqual(ids, m.peers.IDs) {
+		m.stable++
+	} else {
+		m.stable = 0
+	}
+	peers := Peers{
+		SelfID: selfID,
+		IDs:    ids,
+		Ready:  m.stable > 0,
+		Leader: leader,
+	}
+	if !reflect.DeepEqual(peers, m.peers) {
+		m.peers = peers
+		m.publish()
+	}
+	return nil
+}
+
+// expire clears Leader and Ready after a failed heartbeat once the Leases of
+// the peer were not renewed for LeaseDuration, as the other peers consider
+// them expired by then.
+func (m *LeaseManager) expire(now time.Time) {
+	m.Lock()
+	defer m.Unlock()
+
+	peers := m.copyPeers()
+	if now.Sub(m.leaderRenewed) >= m.LeaseDuration {
+		peers.Leader = false
+	}
+	if now.Sub(m.renewed) >= m.LeaseDuration {
+		peers.Ready = false
+		m.stable = 0
+	}
+	if !reflect.DeepEqual(peers, m.peers) {
+		m.peers = peers
+		m.publish()
+	}
+}
+
+// abandon clears Leader after a heartbeat missed its deadline, and Ready once
+// the Lease of the peer was not renewed for LeaseDuration.
+func (m *LeaseManager) abandon() {
+	m.Lock()
+	m.leaderRenewed = time.Time{}
+	m.Unlock()
+	m.expire(m.now())
+}
+
+// renew renews the Lease name for holder. Unless force is set, it fails with
+// a conflict while the Lease is held by a live peer.
+func (m *LeaseManager) renew(name, role, holder string, now metav1.MicroTime, force bool) error {
+	duration := int32(m.LeaseDuration / time.Second)
+
+	lease, err := m.leases().Get(name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		_, err = m.leases().Create(&coordinationv1.Lease{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: m.Namespace,
+				Labels: map[string]string{
+					groupLabel: m.Prefix,
+					roleLabel:  role,
+				},
+			},
+			Spec: coordinationv1.LeaseSpec{
+				HolderIdentity:       &holder,
+				LeaseDurationSeconds: &duration,
+				AcquireTime:          &now,
+				RenewTime:            &now,
+			},
+		})
+		return err
+	} else if err != nil {
+		return err
+	}
+
+	if current := holderOf(lease); current != holder {
+		if !force && current != "" && m.live(lease, now) {
+			return apierrors.NewConflict(coordinationv1.Resource("leases"), name, nil)
+		}
+		transitions := int32(1)
+		if lease.Spec.LeaseTransitions != nil {
+			transitions = *lease.Spec.LeaseTransitions + 1
+		}
+		lease.Spec.HolderIdentity = &holder
+		lease.Spec.AcquireTime = &now
+		lease.Spec.LeaseTransitions = &transitions
+	}
+	lease.Spec.LeaseDurationSeconds = &duration
+	lease.Spec.RenewTime = &now
+
+	_, err = m.leases().Update(lease)
+	return err
+}
+
+// release deletes the Lease of the peer and frees the leader Lease if the
+// peer holds it, so that the others do not wait for them to expire.
+func (m *LeaseManager) release() {
+	selfID := m.selfID
+	m.Lock()
+	leader := m.peers.Leader
+	m.peers.Leader = false
+	m.Unlock()
+
+	if err := m.leases().Delete(m.Prefix+"-"+selfID, &metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+		logrus.Errorf("peermanager: failed to delete the lease of %s: %v", selfID, err)
+	}
+	if !leader {
+		return
+	}
+
+	lease, err := m.leases().Get(m.Prefix+"-leader", metav1.GetOptions{})
+	if err == nil && holderOf(lease) == selfID {
+		empty := ""
+		lease.Spec.HolderIdentity = &empty
+		_, err = m.leases().Update(lease)
+	}
+	if err != nil && !apierrors.IsNotFound(err) {
+		logrus.Errorf("peermanager: failed to release the leader lease of %s: %v", selfID, err)
+	}
+}
+
+// leases returns the client of the Leases in Namespace.
+func (m *LeaseManager) leases() coordinationclient.LeaseInterface {
+	return m.client.CoordinationV1().Leases(m.Namespace)
+}
+
+func (m *LeaseManager) live(lease *coordinationv1.Lease, now metav1.MicroTime) bool {
+	if lease.Spec.RenewTime == nil {
+		return false
+	}
+	duration := m.LeaseDuration
+	if lease.Spec.LeaseDurationSeconds != nil {
+		duration = time.Duration(*lease.Spec.LeaseDurationSeconds) * time.Second
+	}
+	return lease.Spec.RenewTime.Add(duration).After(now.Time)
+}
+
+func holderOf(lease *coordinationv1.Lease) string {
+	if lease.Spec.HolderIdentity == nil {
+		return ""
+	}
+	return *lease.Spec.HolderIdentity
+}
+
+func (m *LeaseManager) copyPeers() Peers {
+	peers := m.peers
+	peers.IDs = append([]string(nil), m.peers.IDs...)
+	return peers
+}
+
+// publish hands the peers to the listeners, it has to be called locked.
+func (m *LeaseManager) publish() {
+	for _, listener := range m.listeners {
+		listener.set(m.copyPeers())
+	}
+}
+
+// listener sends the latest peers to a channel, replacing those it could not
+// send yet.
+type listener struct {
+	sync.Mutex
+	ch      chan<- Peers
+	latest  Peers
+	pending chan struct{}
+	done    chan struct{}
+}
+
+func newListener(ch chan<- Peers) *listener {
+	return &listener{
+		ch:      ch,
+		pending: make(chan struct{}, 1),
+		done:    make(chan struct{}),
+	}
+}
+
+func (l *listener) set(peers Peers) {
+	l.Lock()
+	l.latest = peers
+	l.Unlock()
+
+	select {
+	case l.pending <- struct{}{}:
+	default:
+	}
+}
+
+func (l *listener) run() {
+	for {
+		select {
+		case <-l.done:
+			return
+		case <-l.pending:
+		}
+
+		l.Lock()
+		peers := l.latest
+		l.Unlock()
+
+		select {
+		case <-l.done:
+			return
+		case l.ch <- peers:
+		}
+	}
+}
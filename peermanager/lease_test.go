@@ -0,0 +1,159 @@
+package peermanager
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func TestLeaseManager(t *testing.T) {
+	client := k8sfake.NewSimpleClientset()
+	now := time.Now()
+	clock := func() time.Time { return now }
+
+	a := NewLeaseManager(client, "cattle-system", "a")
+	b := NewLeaseManager(client, "cattle-system", "b")
+	a.now, b.now = clock, clock
+	a.Leader()
+	b.Leader()
+
+	l := make(chan Peers)
+	a.AddListener(l)
+	defer a.RemoveListener(l)
+
+	// a only sees b on the second heartbeat and is ready on the third
+	for i := 0; i < 3; i++ {
+		for _, m := range []*LeaseManager{a, b} {
+			if err := m.heartbeat(context.Background()); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	peers := a.Peers()
+	if !reflect.DeepEqual(peers.IDs, []string{"a", "b"}) || !peers.Ready {
+		t.Errorf("expected a to see a and b and be ready, got %+v", peers)
+	}
+	if !a.IsLeader() || b.IsLeader() {
+		t.Error("expected a to be the only leader")
+	}
+	// the listener did not read yet, it gets at most one outdated update
+	// before the latest
+	for ready := false; !ready; {
+		select {
+		case peers := <-l:
+			ready = peers.Ready
+		case <-time.After(5 * time.Second):
+			t.Fatal("expected the listener to get the latest peers")
+		}
+	}
+
+	// a stops renewing its leases, b takes over once they expire
+	now = now.Add(a.LeaseDuration + time.Second)
+	if err := b.heartbeat(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	peers = b.Peers()
+	if !reflect.DeepEqual(peers.IDs, []string{"b"}) || peers.Ready || !peers.Leader {
+		t.Errorf("expected b to lead alone before it is ready again, got %+v", peers)
+	}
+
+	b.release()
+	if err := a.heartbeat(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if peers := a.Peers(); !reflect.DeepEqual(peers.IDs, []string{"a"}) || !peers.Leader {
+		t.Errorf("expected a to take the released lead, got %+v", peers)
+	}
+}
+
+func TestLeaseManagerRenewFailure(t *testing.T) {
+	client := k8sfake.NewSimpleClientset()
+	now := time.Now()
+
+	a := NewLeaseManager(client, "cattle-system", "a")
+	a.now = func() time.Time { return now }
+	a.Leader()
+	for i := 0; i < 2; i++ {
+		if err := a.heartbeat(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if peers := a.Peers(); !peers.Ready || !peers.Leader {
+		t.Fatalf("expected a to be a ready leader, got %+v", peers)
+	}
+
+	client.PrependReactor("*", "leases", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, errors.New("apiserver unavailable")
+	})
+
+	// the leases are still held until they expire
+	now = now.Add(a.LeaseDuration / 2)
+	if err := a.heartbeat(context.Background()); err == nil {
+		t.Fatal("expected the heartbeat to fail")
+	}
+	if peers := a.Peers(); !peers.Ready || !peers.Leader {
+		t.Errorf("expected a to lead until its lease expires, got %+v", peers)
+	}
+
+	now = now.Add(a.LeaseDuration / 2)
+	if err := a.heartbeat(context.Background()); err == nil {
+		t.Fatal("expected the heartbeat to fail")
+	}
+	if peers := a.Peers(); peers.Ready || peers.Leader || a.IsLeader() {
+		t.Errorf("expected a to step down once its lease expired, got %+v", peers)
+	}
+}
+
+func TestLeaseManagerRenewDeadline(t *testing.T) {
+	client := k8sfake.NewSimpleClientset()
+
+	a := NewLeaseManager(client, "cattle-system", "a")
+	a.RenewDeadline = 100 * time.Millisecond
+	a.Leader()
+	for i := 0; i < 2; i++ {
+		if err := a.heartbeat(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if !a.IsLeader() {
+		t.Fatal("expected a to lead")
+	}
+
+	hung := make(chan struct{})
+	defer close(hung)
+	client.PrependReactor("*", "leases", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		<-hung
+		return false, nil, nil
+	})
+
+	a.beat()
+	if a.IsLeader() {
+		t.Error("expected a to step down once the heartbeat missed its deadline")
+	}
+	if peers := a.Peers(); !peers.Ready {
+		t.Errorf("expected a to stay ready until its lease expires, got %+v", peers)
+	}
+}
+
+func TestLeaseManagerOutdatedHeartbeat(t *testing.T) {
+	client := k8sfake.NewSimpleClientset()
+
+	a := NewLeaseManager(client, "cattle-system", "a")
+	a.Leader()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := a.heartbeat(ctx); err == nil {
+		t.Error("expected a heartbeat past its deadline to fail")
+	}
+	if peers := a.Peers(); peers.Leader || len(peers.IDs) != 0 {
+		t.Errorf("expected a heartbeat past its deadline to record nothing, got %+v", peers)
+	}
+}
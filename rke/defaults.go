@@ -0,0 +1,264 @@
+package rke
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	v3 "github.com/rancher/types/apis/management.cattle.io/v3"
+)
+
+// Metadata is the per Kubernetes version metadata the defaults of RKE configs
+// are resolved from. Entries are looked up by the full Kubernetes version,
+// v1.15.5-rancher1-2, and then by its minor version, v1.15.
+type Metadata struct {
+	SystemImages   map[string]v3.RKESystemImages
+	ServiceOptions map[string]v3.KubernetesServicesOptions
+	// AddonVersions are the names of the templates of every addon by version
+	AddonVersions map[string]map[string]string
+	// AddonTemplates are the templates of the addons by name
+	AddonTemplates map[string]string
+	VersionInfo    map[string]v3.K8sVersionInfo
+}
+
+// NewMetadata returns the Metadata of the objects, which are keyed by their
+// names. AddonVersions and VersionInfo are not kept in objects, they have to
+// be set on the result.
+func NewMetadata(images []*v3.RKEK8sSystemImage, options []*v3.RKEK8sServiceOption, addons []*v3.RKEAddon) *Metadata {
+	m := &Metadata{
+		SystemImages:   map[string]v3.RKESystemImages{},
+		ServiceOptions: map[string]v3.KubernetesServicesOptions{},
+		AddonVersions:  map[string]map[string]string{},
+		AddonTemplates: map[string]string{},
+		VersionInfo:    map[string]v3.K8sVersionInfo{},
+	}
+	for _, image := range images {
+		m.SystemImages[image.Name] = image.SystemImages
+	}
+	for _, option := range options {
+		m.ServiceOptions[option.Name] = option.ServiceOptions
+	}
+	for _, addon := range addons {
+		m.AddonTemplates[addon.Name] = addon.Template
+	}
+	return m
+}
+
+// Resolved is an RKE config with the defaults of its version.
+type Resolved struct {
+	Config *v3.RancherKubernetesEngineConfig
+	// Addons are the templates of the addons of the version by addon name
+	Addons map[string]string
+	// Defaulted are the json paths of the fields set from the defaults
+	Defaulted []string
+}
+
+// Resolve returns a copy of config with the system images and the extra
+// args of the services of version filled in from m, values set in config
+// are kept, along with the addon templates of version. The version of config
+// is used if version is empty, a version that differs from the one of config
+// fails. Versions that rancherVersion does not support according to the
+// VersionInfo of m fail, rancherVersion is not checked if it is empty or not
+// a release.
+func (m *Metadata) Resolve(config *v3.RancherKubernetesEngineConfig, version, rancherVersion string) (*Resolved, error) {
+	result := config.DeepCopy()
+	if version == "" {
+		version = result.Version
+	}
+	if version == "" {
+		return nil, fmt.Errorf("no kubernetes version to resolve the defaults of")
+	}
+	if result.Version != "" && result.Version != version {
+		return nil, fmt.Errorf("kubernetes version %s does not match the version %s of the config", version, result.Version)
+	}
+	if err := m.checkRancherVersion(version, rancherVersion); err != nil {
+		return nil, err
+	}
+
+	images, ok := m.systemImages(version)
+	if !ok {
+		return nil, fmt.Errorf("no system images for kubernetes version %s", version)
+	}
+
+	resolved := &Resolved{
+		Config: result,
+		Addons: map[string]string{},
+	}
+	if result.Version == "" {
+		result.Version = version
+		resolved.Defaulted = append(resolved.Defaulted, "kubernetesVersion")
+	}
+
+	mergeStrings(reflect.ValueOf(&result.SystemImages).Elem(), reflect.ValueOf(images), "systemImages", &resolved.Defaulted)
+
+	if options, ok := m.serviceOptions(version); ok {
+		services := &result.Services
+		for _, service := range []struct {
+			path string
+			base *v3.BaseService
+			args map[string]string
+		}{
+			{"etcd", &services.Etcd.BaseService, options.Etcd},
+			{"kubeApi", &services.KubeAPI.BaseService, options.KubeAPI},
+			{"kubeController", &services.KubeController.BaseService, options.KubeController},
+			{"scheduler", &services.Scheduler.BaseService, options.Scheduler},
+			{"kubelet", &services.Kubelet.BaseService, options.Kubelet},
+			{"kubeproxy", &services.Kubeproxy.BaseService, options.Kubeproxy},
+		} {
+			mergeExtraArgs(service.base, service.args, "services."+service.path+".extraArgs", &resolved.Defaulted)
+		}
+	}
+
+	for addon, versions := range m.AddonVersions {
+		name, ok := addonTemplateName(versions, version)
+		if !ok {
+			continue
+		}
+		template, ok := m.AddonTemplates[name]
+		if !ok {
+			return nil, fmt.Errorf("template %s of addon %s is missing", name, addon)
+		}
+		resolved.Addons[addon] = template
+	}
+
+	return resolved, nil
+}
+
+func (m *Metadata) checkRancherVersion(version, rancherVersion string) error {
+	info, ok := m.versionInfo(version)
+	if !ok {
+		return nil
+	}
+	current, ok := parseVersion(rancherVersion)
+	if !ok {
+		return nil
+	}
+
+	if min, ok := parseVersion(info.MinRancherVersion); ok && compareVersions(current, min) < 0 {
+		return fmt.Errorf("kubernetes version %s needs rancher %s or later, got %s", version, info.MinRancherVersion, rancherVersion)
+	}
+	if max, ok := parseVersion(info.MaxRancherVersion); ok && compareVersions(current, max) > 0 {
+		return fmt.Errorf("kubernetes version %s is not supported after rancher %s, got %s", version, info.MaxRancherVersion, rancherVersion)
+	}
+	return nil
+}
+
+// versionKeys returns the keys of the entries of version by precedence.
+func versionKeys(version string) []string {
+	keys := []string{version}
+	parts := strings.SplitN(version, ".", 3)
+	if len(parts) == 3 {
+		keys = append(keys, parts[0]+"."+parts[1])
+	}
+	return keys
+}
+
+func (m *Metadata) systemImages(version string) (v3.RKESystemImages, bool) {
+	for _, key := range versionKeys(version) {
+		if images, ok := m.SystemImages[key]; ok {
+			return images, true
+		}
+	}
+	return v3.RKESystemImages{}, false
+}
+
+func (m *Metadata) serviceOptions(version string) (v3.KubernetesServicesOptions, bool) {
+	for _, key := range versionKeys(version) {
+		if options, ok := m.ServiceOptions[key]; ok {
+			return options, true
+		}
+	}
+	return v3.KubernetesServicesOptions{}, false
+}
+
+func (m *Metadata) versionInfo(version string) (v3.K8sVersionInfo, bool) {
+	for _, key := range versionKeys(version) {
+		if info, ok := m.VersionInfo[key]; ok {
+			return info, true
+		}
+	}
+	return v3.K8sVersionInfo{}, false
+}
+
+func addonTemplateName(versions map[string]string, version string) (string, bool) {
+	for _, key := range versionKeys(version) {
+		if name, ok := versions[key]; ok {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+// mergeStrings sets the empty string fields of v to those of defaults.
+func mergeStrings(v, defaults reflect.Value, path string, defaulted *[]string) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := v.Field(i)
+		if field.Kind() != reflect.String || field.String() != "" {
+			continue
+		}
+		if value := defaults.Field(i).String(); value != "" {
+			field.SetString(value)
+			*defaulted = append(*defaulted, path+"."+jsonName(t.Field(i)))
+		}
+	}
+}
+
+func mergeExtraArgs(service *v3.BaseService, defaults map[string]string, path string, defaulted *[]string) {
+	var keys []string
+	for key := range defaults {
+		if _, ok := service.ExtraArgs[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if service.ExtraArgs == nil {
+			service.ExtraArgs = map[string]string{}
+		}
+		service.ExtraArgs[key] = defaults[key]
+		*defaulted = append(*defaulted, path+"."+key)
+	}
+}
+
+func jsonName(f reflect.StructField) string {
+	name := strings.Split(f.Tag.Get("json"), ",")[0]
+	if name == "" {
+		return f.Name
+	}
+	return name
+}
+
+// parseVersion parses the major, minor and patch of a release version like
+// v2.3.1 or 2.3.1-rc2, dev builds are not releases.
+func parseVersion(version string) ([3]int, bool) {
+	var result [3]int
+	version = strings.SplitN(strings.TrimPrefix(version, "v"), "-", 2)[0]
+	parts := strings.Split(version, ".")
+	if version == "" || len(parts) > 3 {
+		return result, false
+	}
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil {
+			return result, false
+		}
+		result[i] = n
+	}
+	return result, true
+}
+
+func compareVersions(a, b [3]int) int {
+	for i := range a {
+		if a[i] != b[i] {
+			if a[i] < b[i] {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
@@ -0,0 +1,89 @@
+package rke
+
+import (
+	"reflect"
+	"testing"
+
+	v3 "github.com/rancher/types/apis/management.cattle.io/v3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestResolve(t *testing.T) {
+	metadata := NewMetadata(
+		[]*v3.RKEK8sSystemImage{{
+			ObjectMeta: metav1.ObjectMeta{Name: "v1.15.5-rancher1-2"},
+			SystemImages: v3.RKESystemImages{
+				Etcd:       "rancher/coreos-etcd:v3.3.10-rancher1",
+				Kubernetes: "rancher/hyperkube:v1.15.5-rancher1",
+			},
+		}},
+		[]*v3.RKEK8sServiceOption{{
+			ObjectMeta: metav1.ObjectMeta{Name: "v1.15"},
+			ServiceOptions: v3.KubernetesServicesOptions{
+				KubeAPI: map[string]string{
+					"profiling":               "false",
+					"service-node-port-range": "30000-32767",
+				},
+			},
+		}},
+		[]*v3.RKEAddon{{
+			ObjectMeta: metav1.ObjectMeta{Name: "canal-v1.15"},
+			Template:   "canal template",
+		}},
+	)
+	metadata.AddonVersions["canal"] = map[string]string{"v1.15": "canal-v1.15"}
+	metadata.VersionInfo["v1.15"] = v3.K8sVersionInfo{MinRancherVersion: "2.3.0"}
+
+	config := &v3.RancherKubernetesEngineConfig{
+		Version: "v1.15.5-rancher1-2",
+		SystemImages: v3.RKESystemImages{
+			Etcd: "registry.example.com/etcd:v3.3.10",
+		},
+	}
+	config.Services.KubeAPI.ExtraArgs = map[string]string{"profiling": "true"}
+
+	resolved, err := metadata.Resolve(config, "", "v2.3.2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if images := resolved.Config.SystemImages; images.Etcd != "registry.example.com/etcd:v3.3.10" || images.Kubernetes != "rancher/hyperkube:v1.15.5-rancher1" {
+		t.Errorf("unexpected system images %+v", images)
+	}
+	if args := resolved.Config.Services.KubeAPI.ExtraArgs; args["profiling"] != "true" || args["service-node-port-range"] != "30000-32767" {
+		t.Errorf("unexpected extra args %v", args)
+	}
+	if resolved.Addons["canal"] != "canal template" {
+		t.Errorf("unexpected addons %v", resolved.Addons)
+	}
+	expected := []string{"systemImages.kubernetes", "services.kubeApi.extraArgs.service-node-port-range"}
+	if !reflect.DeepEqual(resolved.Defaulted, expected) {
+		t.Errorf("expected %v to be defaulted, got %v", expected, resolved.Defaulted)
+	}
+	if config.SystemImages.Kubernetes != "" || len(config.Services.KubeAPI.ExtraArgs) != 1 {
+		t.Error("expected config to be left unchanged")
+	}
+
+	if _, err := metadata.Resolve(config, "", "v2.2.9"); err == nil {
+		t.Error("expected the version to need a later rancher")
+	}
+	if _, err := metadata.Resolve(config, "", "master-head"); err != nil {
+		t.Errorf("expected dev builds not to be checked, got %v", err)
+	}
+	if _, err := metadata.Resolve(config, "v1.16.2-rancher1-1", ""); err == nil {
+		t.Error("expected a version other than the one of config to fail")
+	}
+
+	config.Version = ""
+	if _, err := metadata.Resolve(config, "v1.16.2-rancher1-1", ""); err == nil {
+		t.Error("expected a version without system images to fail")
+	}
+
+	metadata.SystemImages["v1.16"] = v3.RKESystemImages{Kubernetes: "rancher/hyperkube:v1.16.2-rancher1"}
+	resolved, err = metadata.Resolve(config, "v1.16.2-rancher1-1", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resolved.Config.Version != "v1.16.2-rancher1-1" || resolved.Config.SystemImages.Kubernetes != "rancher/hyperkube:v1.16.2-rancher1" {
+		t.Errorf("expected the images of the minor version, got %+v", resolved.Config)
+	}
+}
@@ -0,0 +1,147 @@
+// Package rke checks and completes RancherKubernetesEngineConfigs before they
+// are provisioned.
+package rke
+
+import (
+	"net"
+	"strconv"
+	"strings"
+
+	v3 "github.com/rancher/types/apis/management.cattle.io/v3"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var (
+	// NetworkPlugins are the supported values of Network.Plugin.
+	NetworkPlugins = []string{"canal", "flannel", "calico", "weave", "none"}
+	// IngressProviders are the supported values of Ingress.Provider.
+	IngressProviders = []string{"nginx", "none"}
+)
+
+// Validate checks config against the rules of its norman tags and for the
+// mistakes that would only fail the provisioning of the cluster. The errors
+// are field.Errors with the json path of the field they are about.
+func Validate(config *v3.RancherKubernetesEngineConfig) []error {
+	allErrs := config.Validate()
+	allErrs = append(allErrs, validateNodes(config, field.NewPath("nodes"))...)
+	allErrs = append(allErrs, validateServices(&config.Services, field.NewPath("services"))...)
+	allErrs = append(allErrs, validateSupported(config.Network.Plugin, NetworkPlugins, field.NewPath("network", "plugin"))...)
+	allErrs = append(allErrs, validateSupported(config.Ingress.Provider, IngressProviders, field.NewPath("ingress", "provider"))...)
+	if config.Restore.Restore && config.Restore.SnapshotName == "" {
+		allErrs = append(allErrs, field.Required(field.NewPath("restore", "snapshotName"), "required to restore"))
+	}
+
+	var errs []error
+	for _, err := range allErrs {
+		errs = append(errs, err)
+	}
+	return errs
+}
+
+func validateNodes(config *v3.RancherKubernetesEngineConfig, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs   field.ErrorList
+		etcdNodes int
+		addresses = map[string]bool{}
+	)
+
+	for i, node := range config.Nodes {
+		if len(node.Role) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Index(i).Child("role"), "every node needs a role"))
+		}
+		for _, role := range node.Role {
+			if role == "etcd" {
+				etcdNodes++
+				break
+			}
+		}
+
+		if node.Address != "" && addresses[node.Address] {
+			allErrs = append(allErrs, field.Duplicate(fldPath.Index(i).Child("address"), node.Address))
+		}
+		addresses[node.Address] = true
+	}
+
+	if etcdNodes%2 == 0 && etcdNodes > 0 && len(config.Services.Etcd.ExternalURLs) == 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath, etcdNodes,
+			"an even number of etcd members tolerates no more failures than one member less"))
+	}
+	return allErrs
+}
+
+func validateServices(services *v3.RKEConfigServices, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	kubeAPIPath := fldPath.Child("kubeApi")
+	kubeControllerPath := fldPath.Child("kubeController")
+	apiServiceRange, errs := parseCIDR(services.KubeAPI.ServiceClusterIPRange, kubeAPIPath.Child("serviceClusterIpRange"))
+	allErrs = append(allErrs, errs...)
+	controllerServiceRange, errs := parseCIDR(services.KubeController.ServiceClusterIPRange, kubeControllerPath.Child("serviceClusterIpRange"))
+	allErrs = append(allErrs, errs...)
+	clusterCIDR, errs := parseCIDR(services.KubeController.ClusterCIDR, kubeControllerPath.Child("clusterCidr"))
+	allErrs = append(allErrs, errs...)
+
+	if apiServiceRange != nil && controllerServiceRange != nil && apiServiceRange.String() != controllerServiceRange.String() {
+		allErrs = append(allErrs, field.Invalid(kubeControllerPath.Child("serviceClusterIpRange"), services.KubeController.ServiceClusterIPRange,
+			"must match "+kubeAPIPath.Child("serviceClusterIpRange").String()))
+	}
+
+	serviceRange, servicePath := apiServiceRange, kubeAPIPath.Child("serviceClusterIpRange")
+	if serviceRange == nil {
+		serviceRange, servicePath = controllerServiceRange, kubeControllerPath.Child("serviceClusterIpRange")
+	}
+	if serviceRange != nil && clusterCIDR != nil && overlap(serviceRange, clusterCIDR) {
+		allErrs = append(allErrs, field.Invalid(kubeControllerPath.Child("clusterCidr"), services.KubeController.ClusterCIDR,
+			"must not overlap "+servicePath.String()))
+	}
+
+	if nodePortRange := services.KubeAPI.ServiceNodePortRange; nodePortRange != "" && !validPortRange(nodePortRange) {
+		allErrs = append(allErrs, field.Invalid(kubeAPIPath.Child("serviceNodePortRange"), nodePortRange,
+			"must be a range of ports like 30000-32767"))
+	}
+
+	return allErrs
+}
+
+func validateSupported(value string, supported []string, fldPath *field.Path) field.ErrorList {
+	if value == "" {
+		return nil
+	}
+	for _, v := range supported {
+		if value == v {
+			return nil
+		}
+	}
+	return field.ErrorList{field.NotSupported(fldPath, value, supported)}
+}
+
+func parseCIDR(value string, fldPath *field.Path) (*net.IPNet, field.ErrorList) {
+	if value == "" {
+		return nil, nil
+	}
+	_, cidr, err := net.ParseCIDR(value)
+	if err != nil {
+		return nil, field.ErrorList{field.Invalid(fldPath, value, "must be a CIDR like 10.43.0.0/16")}
+	}
+	return cidr, nil
+}
+
+func overlap(a, b *net.IPNet) bool {
+	return a.Contains(b.IP) || b.Contains(a.IP)
+}
+
+func validPortRange(value string) bool {
+	parts := strings.Split(value, "-")
+	if len(parts) != 2 {
+		return false
+	}
+	from, err := strconv.Atoi(strings.TrimSpace(parts[0]))
+	if err != nil {
+		return false
+	}
+	to, err := strconv.Atoi(strings.TrimSpace(parts[1]))
+	if err != nil {
+		return false
+	}
+	return from > 0 && from <= to && to <= 65535
+}
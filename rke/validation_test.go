@@ -0,0 +1,74 @@
+package rke
+
+import (
+	"testing"
+
+	v3 "github.com/rancher/types/apis/management.cattle.io/v3"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func TestValidate(t *testing.T) {
+	config := &v3.RancherKubernetesEngineConfig{
+		Nodes: []v3.RKEConfigNode{
+			{Address: "10.0.0.1", Role: []string{"etcd", "controlplane"}},
+			{Address: "10.0.0.2", Role: []string{"etcd", "worker"}},
+			{Address: "10.0.0.3", Role: []string{"etcd", "worker"}},
+		},
+		Services: v3.RKEConfigServices{
+			KubeAPI: v3.KubeAPIService{
+				ServiceClusterIPRange: "10.43.0.0/16",
+				ServiceNodePortRange:  "30000-32767",
+			},
+			KubeController: v3.KubeControllerService{
+				ServiceClusterIPRange: "10.43.0.0/16",
+				ClusterCIDR:           "10.42.0.0/16",
+			},
+		},
+		Network: v3.NetworkConfig{Plugin: "canal"},
+		Ingress: v3.IngressConfig{Provider: "nginx"},
+	}
+	if errs := Validate(config); len(errs) != 0 {
+		t.Fatalf("unexpected errors %v", errs)
+	}
+
+	config.Nodes[1].Role = nil
+	config.Nodes[2].Address = "10.0.0.1"
+	config.Services.KubeAPI.ServiceNodePortRange = "32767-30000"
+	config.Services.KubeController.ServiceClusterIPRange = "10.42.0.0/24"
+	config.Network.Plugin = "cilium"
+	config.Ingress.Provider = "traefik"
+	config.Restore.Restore = true
+
+	expected := []string{
+		"nodes[1].role",
+		"nodes[2].address",
+		"nodes",
+		"services.kubeController.serviceClusterIpRange",
+		"services.kubeApi.serviceNodePortRange",
+		"network.plugin",
+		"ingress.provider",
+		"restore.snapshotName",
+	}
+	errs := Validate(config)
+	if len(errs) != len(expected) {
+		t.Fatalf("expected %d errors, got %v", len(expected), errs)
+	}
+	for i, path := range expected {
+		if err, ok := errs[i].(*field.Error); !ok || err.Field != path {
+			t.Errorf("expected error %d on %s, got %v", i, path, errs[i])
+		}
+	}
+
+	config = &v3.RancherKubernetesEngineConfig{
+		Services: v3.RKEConfigServices{
+			KubeController: v3.KubeControllerService{
+				ServiceClusterIPRange: "10.42.0.0/16",
+				ClusterCIDR:           "10.42.128.0/17",
+			},
+		},
+	}
+	errs = Validate(config)
+	if len(errs) != 1 || errs[0].(*field.Error).Field != "services.kubeController.clusterCidr" {
+		t.Errorf("expected the overlapping cluster cidr to be invalid, got %v", errs)
+	}
+}
@@ -0,0 +1,21 @@
+// Package scope carries the scope of handlers in their context, so that the
+// wrappers of generic controllers can tell which handlers are cluster scoped
+// without depending on each other.
+package scope
+
+import "context"
+
+type clusterNameKey struct{}
+
+// WithClusterName returns ctx for the handlers of clusterName. The
+// instrumented clients of the api groups use it for cluster scoped handlers.
+func WithClusterName(ctx context.Context, clusterName string) context.Context {
+	return context.WithValue(ctx, clusterNameKey{}, clusterName)
+}
+
+// ClusterName returns the cluster name of WithClusterName, "" for handlers
+// that are not cluster scoped.
+func ClusterName(ctx context.Context) string {
+	clusterName, _ := ctx.Value(clusterNameKey{}).(string)
+	return clusterName
+}
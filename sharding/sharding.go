@@ -0,0 +1,205 @@
+// Package sharding spreads the work of handlers over the peers of a
+// peermanager.PeerManager, every key is handled by the one peer that owns it.
+package sharding
+
+import (
+	"context"
+	"hash/fnv"
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/rancher/norman/controller"
+	"github.com/rancher/types/peermanager"
+	"github.com/rancher/types/scope"
+	"github.com/sirupsen/logrus"
+	"k8s.io/client-go/tools/cache"
+)
+
+// Replicas is the number of points of every peer on a Ring.
+const Replicas = 100
+
+// Ring assigns keys to peers by consistent hashing, adding or removing a peer
+// only moves the keys of that peer.
+type Ring struct {
+	points []uint32
+	owners map[uint32]string
+}
+
+func NewRing(ids []string) *Ring {
+	r := &Ring{
+		owners: map[uint32]string{},
+	}
+	for _, id := range ids {
+		for i := 0; i < Replicas; i++ {
+			point := hash(id + "#" + strconv.Itoa(i))
+			if owner, ok := r.owners[point]; !ok {
+				r.points = append(r.points, point)
+			} else if owner < id {
+				// collisions go to the same peer on every replica
+				continue
+			}
+			r.owners[point] = id
+		}
+	}
+	sort.Slice(r.points, func(i, j int) bool {
+		return r.points[i] < r.points[j]
+	})
+	return r
+}
+
+// Owner returns the peer that owns key, "" if the ring has no peers.
+func (r *Ring) Owner(key string) string {
+	if len(r.points) == 0 {
+		return ""
+	}
+	h := hash(key)
+	i := sort.Search(len(r.points), func(i int) bool {
+		return r.points[i] >= h
+	})
+	if i == len(r.points) {
+		i = 0
+	}
+	return r.owners[r.points[i]]
+}
+
+func hash(s string) uint32 {
+	h := fnv.New32a()
+	h.Write([]byte(s))
+	return h.Sum32()
+}
+
+// Sharder shards the handlers added through the clients it instruments. Until
+// it got ready peers it owns no keys, when the peers change the keys it gains
+// are enqueued again.
+type Sharder struct {
+	sync.Mutex
+	selfID   string
+	ring     *Ring
+	handlers map[*shardedHandler]bool
+}
+
+type shardedHandler struct {
+	generic     controller.GenericController
+	clusterName string
+}
+
+func NewSharder() *Sharder {
+	return &Sharder{
+		handlers: map[*shardedHandler]bool{},
+	}
+}
+
+// Run updates the sharder with the peers of peerManager until ctx is done.
+func (s *Sharder) Run(ctx context.Context, peerManager peermanager.PeerManager) {
+	peers := make(chan peermanager.Peers, 1)
+	peerManager.AddListener(peers)
+	defer peerManager.RemoveListener(peers)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case p := <-peers:
+			s.Update(p)
+		}
+	}
+}
+
+// Update assigns the keys to peers, the sharder owns no keys while peers is
+// not ready.
+func (s *Sharder) Update(peers peermanager.Peers) {
+	var ids []string
+	if peers.Ready {
+		ids = peers.IDs
+	}
+	ring := NewRing(ids)
+
+	s.Lock()
+	old := s.ring
+	s.selfID, s.ring = peers.SelfID, ring
+	var handlers []*shardedHandler
+	for h := range s.handlers {
+		handlers = append(handlers, h)
+	}
+	s.Unlock()
+
+	for _, h := range handlers {
+		s.rebalance(h, old, ring, peers.SelfID)
+	}
+}
+
+// rebalance enqueues the keys of h that selfID owns on ring but did not own on
+// old.
+func (s *Sharder) rebalance(h *shardedHandler, old, ring *Ring, selfID string) {
+	gained := func(key string) bool {
+		return ring.Owner(key) == selfID && (old == nil || old.Owner(key) != selfID)
+	}
+	if h.clusterName != "" && !gained(h.clusterName) {
+		return
+	}
+
+	for _, key := range h.generic.Informer().GetStore().ListKeys() {
+		if h.clusterName != "" || gained(key) {
+			namespace, name, err := cache.SplitMetaNamespaceKey(key)
+			if err != nil {
+				logrus.Errorf("sharding: invalid key %s: %v", key, err)
+				continue
+			}
+			h.generic.Enqueue(namespace, name)
+		}
+	}
+}
+
+// Owns reports if the peer owns key.
+func (s *Sharder) Owns(key string) bool {
+	s.Lock()
+	defer s.Unlock()
+	return s.ring != nil && s.selfID != "" && s.ring.Owner(key) == s.selfID
+}
+
+// Instrument returns generic with the handlers added to it sharded, it can
+// be passed to the NewInstrumented functions of the api groups to get clients
+// of sharded handlers, see config.ScaledContext.Sharded.
+func (s *Sharder) Instrument(controllerName string, generic controller.GenericController) controller.GenericController {
+	return &shardedController{
+		GenericController: generic,
+		sharder:           s,
+	}
+}
+
+type shardedController struct {
+	controller.GenericController
+	sharder *Sharder
+}
+
+// AddHandler adds handler so that it skips the keys of other peers. Cluster
+// scoped handlers, see scope.WithClusterName, run on the peer that owns their
+// cluster.
+func (c *shardedController) AddHandler(ctx context.Context, name string, handler controller.HandlerFunc) {
+	h := &shardedHandler{
+		generic:     c.GenericController,
+		clusterName: scope.ClusterName(ctx),
+	}
+
+	c.sharder.Lock()
+	c.sharder.handlers[h] = true
+	c.sharder.Unlock()
+	go func() {
+		<-ctx.Done()
+		c.sharder.Lock()
+		delete(c.sharder.handlers, h)
+		c.sharder.Unlock()
+	}()
+
+	c.GenericController.AddHandler(ctx, name, func(key string, obj interface{}) (interface{}, error) {
+		shardKey := key
+		if h.clusterName != "" {
+			shardKey = h.clusterName
+		}
+		if !c.sharder.Owns(shardKey) {
+			return nil, nil
+		}
+		return handler(key, obj)
+	})
+}
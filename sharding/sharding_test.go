@@ -0,0 +1,162 @@
+package sharding
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/rancher/norman/controller"
+	"github.com/rancher/types/peermanager"
+	"github.com/rancher/types/scope"
+	"k8s.io/client-go/tools/cache"
+)
+
+func TestRing(t *testing.T) {
+	ring := NewRing([]string{"a", "b", "c"})
+	grown := NewRing([]string{"a", "b", "c", "d"})
+
+	counts := map[string]int{}
+	for i := 0; i < 3000; i++ {
+		key := fmt.Sprintf("ns/obj-%d", i)
+		owner := ring.Owner(key)
+		counts[owner]++
+		if moved := grown.Owner(key); moved != owner && moved != "d" {
+			t.Fatalf("key %s moved from %s to %s", key, owner, moved)
+		}
+	}
+	for _, id := range []string{"a", "b", "c"} {
+		if counts[id] < 500 {
+			t.Errorf("expected the keys to be spread, got %v", counts)
+		}
+	}
+
+	if owner := NewRing(nil).Owner("ns/obj"); owner != "" {
+		t.Errorf("expected no owner on an empty ring, got %s", owner)
+	}
+}
+
+type stubController struct {
+	controller.GenericController
+	store    cache.Store
+	handlers []controller.HandlerFunc
+	enqueued []string
+}
+
+type stubInformer struct {
+	cache.SharedIndexInformer
+	store cache.Store
+}
+
+func (i *stubInformer) GetStore() cache.Store {
+	return i.store
+}
+
+func (c *stubController) Informer() cache.SharedIndexInformer {
+	return &stubInformer{store: c.store}
+}
+
+func (c *stubController) AddHandler(ctx context.Context, name string, handler controller.HandlerFunc) {
+	c.handlers = append(c.handlers, handler)
+}
+
+func (c *stubController) Enqueue(namespace, name string) {
+	c.enqueued = append(c.enqueued, namespace+"/"+name)
+}
+
+func TestSharder(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var keys []string
+	for i := 0; i < 100; i++ {
+		keys = append(keys, fmt.Sprintf("ns/obj-%d", i))
+	}
+	generic := &stubController{store: &keyStore{keys: keys}}
+
+	sharder := NewSharder()
+	handled := map[string]bool{}
+	sharder.Instrument("TestController", generic).AddHandler(ctx, "test", func(key string, obj interface{}) (interface{}, error) {
+		handled[key] = true
+		return nil, nil
+	})
+
+	for _, key := range keys {
+		generic.handlers[0](key, nil)
+	}
+	if len(handled) != 0 {
+		t.Fatalf("expected no keys to be handled without ready peers, got %d", len(handled))
+	}
+
+	sharder.Update(peermanager.Peers{SelfID: "a", IDs: []string{"a", "b"}, Ready: true})
+	ring := NewRing([]string{"a", "b"})
+	var owned []string
+	for _, key := range keys {
+		if ring.Owner(key) == "a" {
+			owned = append(owned, key)
+		}
+		generic.handlers[0](key, nil)
+	}
+	if len(owned) == 0 || len(owned) == len(keys) {
+		t.Fatalf("expected the keys to be split, a owns %d", len(owned))
+	}
+	if len(handled) != len(owned) || len(generic.enqueued) != len(owned) {
+		t.Errorf("expected the %d keys of a to be enqueued and handled, got %d enqueued and %d handled",
+			len(owned), len(generic.enqueued), len(handled))
+	}
+	for _, key := range owned {
+		if !handled[key] {
+			t.Errorf("expected %s to be handled", key)
+		}
+	}
+
+	generic.enqueued = nil
+	sharder.Update(peermanager.Peers{SelfID: "a", IDs: []string{"a"}, Ready: true})
+	if len(generic.enqueued) != len(keys)-len(owned) {
+		t.Errorf("expected the %d keys of b to be enqueued, got %d", len(keys)-len(owned), len(generic.enqueued))
+	}
+}
+
+func TestClusterScopedSharder(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	generic := &stubController{store: &keyStore{keys: []string{"ns/x", "ns/y"}}}
+	sharder := NewSharder()
+	sharder.Update(peermanager.Peers{SelfID: "a", IDs: []string{"a", "b"}, Ready: true})
+
+	ring := NewRing([]string{"a", "b"})
+	var mine, theirs string
+	for i := 0; mine == "" || theirs == ""; i++ {
+		clusterName := fmt.Sprintf("c-%d", i)
+		if ring.Owner(clusterName) == "a" {
+			mine = clusterName
+		} else {
+			theirs = clusterName
+		}
+	}
+
+	var handled []string
+	for _, clusterName := range []string{mine, theirs} {
+		clusterName := clusterName
+		sharder.Instrument("TestController", generic).AddHandler(scope.WithClusterName(ctx, clusterName), "test", func(key string, obj interface{}) (interface{}, error) {
+			handled = append(handled, clusterName)
+			return nil, nil
+		})
+	}
+	for _, handler := range generic.handlers {
+		handler("ns/x", nil)
+	}
+	if len(handled) != 1 || handled[0] != mine {
+		t.Errorf("expected only the handler of %s to run, got %v", mine, handled)
+	}
+}
+
+// keyStore is a store with fixed keys.
+type keyStore struct {
+	cache.Store
+	keys []string
+}
+
+func (s *keyStore) ListKeys() []string {
+	return s.keys
+}
@@ -0,0 +1,98 @@
+package status
+
+const (
+	RuleErrorCondition         = "errorCondition"
+	RuleReverseErrorCondition  = "reverseErrorCondition"
+	RuleTransitioningCondition = "transitioningCondition"
+	RuleDoneCondition          = "doneCondition"
+	RuleProgressCondition      = "progressCondition"
+	RuleSpecActive             = "specActive"
+	RulePhase                  = "phase"
+	RuleInitializing           = "initializing"
+	RuleDefault                = "default"
+	RuleRemoved                = "removed"
+	RuleLoadBalancer           = "loadBalancer"
+
+	SourceConditions = "status.conditions"
+	SourceAnnotation = "cattle.io/status"
+)
+
+// Explanation records how Set arrived at the state, transitioning and
+// transitioningMessage of an object.
+type Explanation struct {
+	State                string                 `json:"state,omitempty"`
+	Transitioning        string                 `json:"transitioning,omitempty"`
+	TransitioningMessage string                 `json:"transitioningMessage,omitempty"`
+	Rule                 string                 `json:"rule,omitempty"`
+	Conditions           []ConditionExplanation `json:"conditions,omitempty"`
+	Messages             []string               `json:"messages,omitempty"`
+	Finalizer            string                 `json:"finalizer,omitempty"`
+}
+
+// ConditionExplanation is a condition that contributed to the result and the
+// rule it matched.
+type ConditionExplanation struct {
+	Rule    string `json:"rule,omitempty"`
+	Source  string `json:"source,omitempty"`
+	Type    string `json:"type,omitempty"`
+	Status  string `json:"status,omitempty"`
+	Reason  string `json:"reason,omitempty"`
+	Message string `json:"message,omitempty"`
+	State   string `json:"state,omitempty"`
+}
+
+// Explain sets the state fields of data exactly like Set and returns the
+// decision trace that produced them.
+func Explain(data map[string]interface{}) *Explanation {
+	e := &Explanation{}
+	set(data, e)
+	return e
+}
+
+func (e *Explanation) contribute(rule string, c condition, state string) {
+	if e == nil {
+		return
+	}
+	e.Conditions = append(e.Conditions, ConditionExplanation{
+		Rule:    rule,
+		Source:  c.source,
+		Type:    c.Type,
+		Status:  c.Status,
+		Reason:  c.Reason,
+		Message: c.Message,
+		State:   state,
+	})
+	if c.Message != "" {
+		e.Messages = append(e.Messages, c.Message)
+	}
+}
+
+func (e *Explanation) decide(rule string) {
+	if e == nil {
+		return
+	}
+	e.Rule = rule
+}
+
+func (e *Explanation) message(msg string) {
+	if e == nil || msg == "" {
+		return
+	}
+	e.Messages = append(e.Messages, msg)
+}
+
+func (e *Explanation) finalizer(f string) {
+	if e == nil {
+		return
+	}
+	e.Finalizer = f
+}
+
+func (e *Explanation) result(data map[string]interface{}) {
+	if e == nil {
+		return
+	}
+	e.State, _ = data["state"].(string)
+	e.Transitioning, _ = data["transitioning"].(string)
+	e.TransitioningMessage, _ = data["transitioningMessage"].(string)
+}
@@ -0,0 +1,205 @@
+package status
+
+import (
+	"sync"
+)
+
+// Rules describes how condition types translate into a state. Each field has
+// the same semantics as the package level map of the same name: Transitioning
+// like transitioningMap, ReverseError like reverseErrorMap, Error like
+// errorMapping, Done like doneMap and Progress like progressMap.
+type Rules struct {
+	Transitioning map[string]string
+	ReverseError  map[string]bool
+	Error         map[string]bool
+	Done          map[string]string
+	Progress      map[string]string
+}
+
+func (r Rules) has(conditionType string) bool {
+	if _, ok := r.Transitioning[conditionType]; ok {
+		return true
+	}
+	if _, ok := r.ReverseError[conditionType]; ok {
+		return true
+	}
+	if _, ok := r.Error[conditionType]; ok {
+		return true
+	}
+	if _, ok := r.Done[conditionType]; ok {
+		return true
+	}
+	_, ok := r.Progress[conditionType]
+	return ok
+}
+
+func (r Rules) copy() Rules {
+	result := Rules{
+		Transitioning: map[string]string{},
+		ReverseError:  map[string]bool{},
+		Error:         map[string]bool{},
+		Done:          map[string]string{},
+		Progress:      map[string]string{},
+	}
+	result.merge(r)
+	return result
+}
+
+func (r Rules) merge(other Rules) {
+	for k, v := range other.Transitioning {
+		r.Transitioning[k] = v
+	}
+	for k, v := range other.ReverseError {
+		r.ReverseError[k] = v
+	}
+	for k, v := range other.Error {
+		r.Error[k] = v
+	}
+	for k, v := range other.Done {
+		r.Done[k] = v
+	}
+	for k, v := range other.Progress {
+		r.Progress[k] = v
+	}
+}
+
+type registry struct {
+	sync.RWMutex
+	kinds map[string]Rules
+}
+
+var rules = &registry{
+	kinds: map[string]Rules{},
+}
+
+func kindKey(apiVersion, kind string) string {
+	return apiVersion + "/" + kind
+}
+
+// RegisterKind sets the condition rules used for objects of the given
+// apiVersion and kind. A condition type that appears anywhere in the kind
+// rules is decided only by them, all other condition types fall back to the
+// global rules. An empty apiVersion matches the kind in every apiVersion.
+// Registering the same apiVersion and kind again merges into the existing
+// rules.
+func RegisterKind(apiVersion, kind string, kindRules Rules) {
+	rules.Lock()
+	defer rules.Unlock()
+
+	key := kindKey(apiVersion, kind)
+	existing, ok := rules.kinds[key]
+	if !ok {
+		existing = Rules{}.copy()
+	}
+	existing.merge(kindRules)
+	rules.kinds[key] = existing
+}
+
+// UnregisterKind removes the rules registered for apiVersion and kind.
+func UnregisterKind(apiVersion, kind string) {
+	rules.Lock()
+	defer rules.Unlock()
+	delete(rules.kinds, kindKey(apiVersion, kind))
+}
+
+// RegisterGlobal merges globalRules into the rules that apply to every kind,
+// replacing the built in meaning of any condition type it mentions.
+func RegisterGlobal(globalRules Rules) {
+	rules.Lock()
+	defer rules.Unlock()
+
+	for _, t := range conditionTypes(globalRules) {
+		delete(transitioningMap, t)
+		delete(reverseErrorMap, t)
+		delete(errorMapping, t)
+		delete(doneMap, t)
+		delete(progressMap, t)
+	}
+	Rules{
+		Transitioning: transitioningMap,
+		ReverseError:  reverseErrorMap,
+		Error:         errorMapping,
+		Done:          doneMap,
+		Progress:      progressMap,
+	}.merge(globalRules)
+}
+
+func conditionTypes(r Rules) []string {
+	var result []string
+	for k := range r.Transitioning {
+		result = append(result, k)
+	}
+	for k := range r.ReverseError {
+		result = append(result, k)
+	}
+	for k := range r.Error {
+		result = append(result, k)
+	}
+	for k := range r.Done {
+		result = append(result, k)
+	}
+	for k := range r.Progress {
+		result = append(result, k)
+	}
+	return result
+}
+
+// ruleSet resolves condition types against the rules of one kind first and
+// the global rules second.
+type ruleSet struct {
+	kind    []Rules
+	globals Rules
+}
+
+// rulesFor must be called with the registry read lock held, the returned
+// ruleSet shares its maps with the registry.
+func rulesFor(apiVersion, kind string) ruleSet {
+	result := ruleSet{
+		globals: Rules{
+			Transitioning: transitioningMap,
+			ReverseError:  reverseErrorMap,
+			Error:         errorMapping,
+			Done:          doneMap,
+			Progress:      progressMap,
+		},
+	}
+	if r, ok := rules.kinds[kindKey(apiVersion, kind)]; ok {
+		result.kind = append(result.kind, r)
+	}
+	if r, ok := rules.kinds[kindKey("", kind)]; ok && apiVersion != "" {
+		result.kind = append(result.kind, r)
+	}
+	return result
+}
+
+func (r ruleSet) lookup(conditionType string) Rules {
+	for _, k := range r.kind {
+		if k.has(conditionType) {
+			return k
+		}
+	}
+	return r.globals
+}
+
+func (r ruleSet) transitioning(conditionType string) (string, bool) {
+	state, ok := r.lookup(conditionType).Transitioning[conditionType]
+	return state, ok
+}
+
+func (r ruleSet) reverseError(conditionType string) bool {
+	return r.lookup(conditionType).ReverseError[conditionType]
+}
+
+func (r ruleSet) error(conditionType string) bool {
+	return r.lookup(conditionType).Error[conditionType]
+}
+
+func (r ruleSet) done(conditionType string) (string, bool) {
+	state, ok := r.lookup(conditionType).Done[conditionType]
+	return state, ok
+}
+
+func (r ruleSet) progress(conditionType string) (string, bool) {
+	state, ok := r.lookup(conditionType).Progress[conditionType]
+	return state, ok
+}
@@ -0,0 +1,211 @@
+package status
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/rancher/norman/types/convert"
+	"github.com/rancher/norman/types/values"
+)
+
+// KeyFunc returns the keys an object is known by for the purpose of a
+// Relation. Objects with no keys never match.
+type KeyFunc func(data map[string]interface{}) []string
+
+// Relation declares that objects of ChildKind roll up into objects of
+// ParentKind. A child belongs to a parent when any of its ChildKeys equals
+// any of the parent's ParentKeys.
+type Relation struct {
+	ParentKind string
+	ChildKind  string
+	ParentKeys KeyFunc
+	ChildKeys  KeyFunc
+}
+
+// Relations are the parent/child relations used by Rollup.
+var Relations = []Relation{
+	{
+		ParentKind: "Cluster",
+		ChildKind:  "Node",
+		ParentKeys: Field("metadata", "name"),
+		ChildKeys:  Field("metadata", "namespace"),
+	},
+	{
+		ParentKind: "Cluster",
+		ChildKind:  "EtcdBackup",
+		ParentKeys: Field("metadata", "name"),
+		ChildKeys:  Field("spec", "clusterId"),
+	},
+	{
+		ParentKind: "NodePool",
+		ChildKind:  "Node",
+		ParentKeys: NamespacedName(),
+		ChildKeys:  Field("spec", "nodePoolName"),
+	},
+	{
+		ParentKind: "MultiClusterApp",
+		ChildKind:  "App",
+		ParentKeys: multiClusterAppTargets,
+		ChildKeys:  NamespacedName(),
+	},
+	{
+		ParentKind: "Project",
+		ChildKind:  "App",
+		ParentKeys: Field("metadata", "name"),
+		ChildKeys:  Field("metadata", "namespace"),
+	},
+}
+
+// Field keys an object by the string value at path.
+func Field(path ...string) KeyFunc {
+	return func(data map[string]interface{}) []string {
+		if v := convert.ToString(values.GetValueN(data, path...)); v != "" {
+			return []string{v}
+		}
+		return nil
+	}
+}
+
+// NamespacedName keys an object by namespace:name, the format used by
+// references such as NodeSpec.NodePoolName.
+func NamespacedName() KeyFunc {
+	return func(data map[string]interface{}) []string {
+		namespace := convert.ToString(values.GetValueN(data, "metadata", "namespace"))
+		name := convert.ToString(values.GetValueN(data, "metadata", "name"))
+		if namespace == "" || name == "" {
+			return nil
+		}
+		return []string{namespace + ":" + name}
+	}
+}
+
+// multiClusterAppTargets keys a multi cluster app by every target app, the
+// project name of a target is clusterID:projectID and the app lives in the
+// projectID namespace.
+func multiClusterAppTargets(data map[string]interface{}) []string {
+	var result []string
+	targets, _ := values.GetSlice(data, "spec", "targets")
+	for _, target := range targets {
+		projectName := convert.ToString(target["projectName"])
+		appName := convert.ToString(target["appName"])
+		if projectName == "" || appName == "" {
+			continue
+		}
+		parts := strings.SplitN(projectName, ":", 2)
+		result = append(result, parts[len(parts)-1]+":"+appName)
+	}
+	return result
+}
+
+// Summary is the health of a parent derived from its children.
+type Summary struct {
+	Total         int            `json:"total"`
+	States        map[string]int `json:"states,omitempty"`
+	Transitioning int            `json:"transitioning"`
+	Error         int            `json:"error"`
+	WorstState    string         `json:"worstState,omitempty"`
+	Message       string         `json:"message,omitempty"`
+}
+
+// Rollup summarizes the children of parent, using Relations to decide which
+// of the given children belong to it. The children must already have been
+// run through Set.
+func Rollup(parent map[string]interface{}, children []map[string]interface{}) *Summary {
+	return RollupWith(Relations, parent, children)
+}
+
+// RollupWith is Rollup using the given relations instead of Relations.
+func RollupWith(relations []Relation, parent map[string]interface{}, children []map[string]interface{}) *Summary {
+	parentKind := convert.ToString(parent["kind"])
+
+	var matched []map[string]interface{}
+	for _, child := range children {
+		childKind := convert.ToString(child["kind"])
+		for _, relation := range relations {
+			if relation.ParentKind == parentKind && relation.ChildKind == childKind && related(relation, parent, child) {
+				matched = append(matched, child)
+				break
+			}
+		}
+	}
+
+	return Summarize(matched)
+}
+
+func related(relation Relation, parent, child map[string]interface{}) bool {
+	parentKeys := map[string]bool{}
+	for _, key := range relation.ParentKeys(parent) {
+		parentKeys[key] = true
+	}
+	for _, key := range relation.ChildKeys(child) {
+		if parentKeys[key] {
+			return true
+		}
+	}
+	return false
+}
+
+// Summarize counts the states of objects that have already been run through
+// Set, regardless of how they are related.
+func Summarize(objects []map[string]interface{}) *Summary {
+	summary := &Summary{
+		States: map[string]int{},
+	}
+
+	worst := -1
+	var messages []string
+	for _, obj := range objects {
+		state := convert.ToString(obj["state"])
+		transitioning := convert.ToString(obj["transitioning"])
+
+		summary.Total++
+		summary.States[state]++
+		switch transitioning {
+		case "error":
+			summary.Error++
+		case "yes":
+			summary.Transitioning++
+		}
+
+		s := severity(state, transitioning)
+		if s > worst || (s == worst && state < summary.WorstState) {
+			worst = s
+			summary.WorstState = state
+		}
+
+		if msg := convert.ToString(obj["transitioningMessage"]); msg != "" && transitioning != "no" {
+			name := convert.ToString(values.GetValueN(obj, "metadata", "name"))
+			if name != "" {
+				msg = name + ": " + msg
+			}
+			messages = append(messages, msg)
+		}
+	}
+
+	sort.Strings(messages)
+	for _, msg := range messages {
+		summary.Message = concat(summary.Message, msg)
+	}
+
+	return summary
+}
+
+var degradedStates = map[string]bool{
+	"unavailable":  true,
+	"inactive":     true,
+	"failed":       true,
+	"disconnected": true,
+	"unhealthy":    true,
+}
+
+func severity(state, transitioning string) int {
+	switch {
+	case transitioning == "error":
+		return 3
+	case transitioning == "yes":
+		return 2
+	case degradedStates[state]:
+		return 1
+	}
+	return 0
+}
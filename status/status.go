@@ -21,6 +21,7 @@ type condition struct {
 	Type    string
 	Status  string
 	Message string
+	source  string
 }
 
 // True ==
@@ -113,22 +114,29 @@ func concat(str, next string) string {
 }
 
 func Set(data map[string]interface{}) {
-	genericStatus(data)
-	loadBalancerStatus(data)
+	set(data, nil)
 }
 
-func loadBalancerStatus(data map[string]interface{}) {
+func set(data map[string]interface{}, e *Explanation) {
+	genericStatus(data, e)
+	loadBalancerStatus(data, e)
+	e.result(data)
+}
+
+func loadBalancerStatus(data map[string]interface{}, e *Explanation) {
 	if data["state"] == "active" && data["kind"] == "Service" && values.GetValueN(data, "spec", "serviceKind") == "LoadBalancer" {
 		addresses, ok := values.GetSlice(data, "status", "loadBalancer", "ingress")
 		if !ok || len(addresses) == 0 {
 			data["state"] = "pending"
 			data["transitioning"] = "yes"
 			data["transitioningMessage"] = "Load balancer is being provisioned"
+			e.decide(RuleLoadBalancer)
+			e.message("Load balancer is being provisioned")
 		}
 	}
 }
 
-func genericStatus(data map[string]interface{}) {
+func genericStatus(data map[string]interface{}, e *Explanation) {
 	if data == nil {
 		return
 	}
@@ -136,6 +144,9 @@ func genericStatus(data map[string]interface{}) {
 	val, conditionsOk := values.GetValue(data, "status", "conditions")
 	var conditions []condition
 	convert.ToObj(val, &conditions)
+	for i := range conditions {
+		conditions[i].source = SourceConditions
+	}
 
 	statusAnn, annOK := values.GetValue(data, "metadata", "annotations", "cattle.io/status")
 	if annOK {
@@ -147,35 +158,47 @@ func genericStatus(data map[string]interface{}) {
 				logrus.Warnf("Unable to unmarshal cattle status %v. Error: %v", s, err)
 			}
 		}
+		for i := range status.Conditions {
+			status.Conditions[i].source = SourceAnnotation
+		}
 		if len(status.Conditions) > 0 {
 			conditions = append(conditions, status.Conditions...)
 		}
 	}
 
+	apiVersion, _ := values.GetValueN(data, "apiVersion").(string)
+	kind, _ := values.GetValueN(data, "kind").(string)
+
+	rules.RLock()
+	ruleSet := rulesFor(apiVersion, kind)
+	defer rules.RUnlock()
+
 	state := ""
 	error := false
 	transitioning := false
 	message := ""
 
 	for _, c := range conditions {
-		if (errorMapping[c.Type] && c.Status == "False") || c.Reason == "Error" {
+		if (ruleSet.error(c.Type) && c.Status == "False") || c.Reason == "Error" {
 			error = true
 			message = c.Message
+			e.contribute(RuleErrorCondition, c, "")
 			break
 		}
 	}
 
 	if !error {
 		for _, c := range conditions {
-			if reverseErrorMap[c.Type] && c.Status == "True" {
+			if ruleSet.reverseError(c.Type) && c.Status == "True" {
 				error = true
 				message = concat(message, c.Message)
+				e.contribute(RuleReverseErrorCondition, c, "")
 			}
 		}
 	}
 
 	for _, c := range conditions {
-		newState, ok := transitioningMap[c.Type]
+		newState, ok := ruleSet.transitioning(c.Type)
 		if !ok {
 			continue
 		}
@@ -184,10 +207,14 @@ func genericStatus(data map[string]interface{}) {
 			error = true
 			state = newState
 			message = concat(message, c.Message)
+			e.contribute(RuleTransitioningCondition, c, newState)
+			e.decide(RuleTransitioningCondition)
 		} else if c.Status == "Unknown" && state == "" {
 			transitioning = true
 			state = newState
 			message = concat(message, c.Message)
+			e.contribute(RuleTransitioningCondition, c, newState)
+			e.decide(RuleTransitioningCondition)
 		}
 	}
 
@@ -195,7 +222,7 @@ func genericStatus(data map[string]interface{}) {
 		if state != "" {
 			break
 		}
-		newState, ok := doneMap[c.Type]
+		newState, ok := ruleSet.done(c.Type)
 		if !ok {
 			continue
 		}
@@ -203,10 +230,14 @@ func genericStatus(data map[string]interface{}) {
 			transitioning = true
 			state = newState
 			message = concat(message, c.Message)
+			e.contribute(RuleDoneCondition, c, newState)
+			e.decide(RuleDoneCondition)
 		} else if c.Status == "Unknown" {
 			error = true
 			state = newState
 			message = concat(message, c.Message)
+			e.contribute(RuleDoneCondition, c, newState)
+			e.decide(RuleDoneCondition)
 		}
 	}
 
@@ -214,7 +245,7 @@ func genericStatus(data map[string]interface{}) {
 		if state != "" {
 			break
 		}
-		newState, ok := progressMap[c.Type]
+		newState, ok := ruleSet.progress(c.Type)
 		if !ok {
 			continue
 		}
@@ -222,6 +253,8 @@ func genericStatus(data map[string]interface{}) {
 			transitioning = true
 			state = newState
 			message = concat(message, c.Message)
+			e.contribute(RuleProgressCondition, c, newState)
+			e.decide(RuleProgressCondition)
 		}
 	}
 
@@ -233,6 +266,7 @@ func genericStatus(data map[string]interface{}) {
 			} else {
 				state = "inactive"
 			}
+			e.decide(RuleSpecActive)
 		}
 	}
 
@@ -241,18 +275,20 @@ func genericStatus(data map[string]interface{}) {
 		if phase == "Succeeded" {
 			state = "succeeded"
 			transitioning = false
+			e.decide(RulePhase)
 		} else if state == "" {
 			state = phase
+			e.decide(RulePhase)
 		}
 	}
 
-	apiVersion, _ := values.GetValueN(data, "apiVersion").(string)
 	if state == "" && conditionsOk && len(conditions) == 0 && strings.Contains(apiVersion, "cattle.io") {
 		if val, ok := values.GetValue(data, "metadata", "created"); ok {
 			if i, err := convert.ToTimestamp(val); err == nil {
 				if time.Unix(i/1000, 0).Add(5 * time.Second).After(time.Now()) {
 					state = "initializing"
 					transitioning = true
+					e.decide(RuleInitializing)
 				}
 			}
 		}
@@ -260,6 +296,7 @@ func genericStatus(data map[string]interface{}) {
 
 	if state == "" {
 		state = "active"
+		e.decide(RuleDefault)
 	}
 
 	if error {
@@ -277,6 +314,7 @@ func genericStatus(data map[string]interface{}) {
 	if ok && val != "" && val != nil {
 		data["state"] = "removing"
 		data["transitioning"] = "yes"
+		e.decide(RuleRemoved)
 
 		finalizers, ok := values.GetStringSlice(data, "metadata", "finalizers")
 		if !ok {
@@ -287,6 +325,7 @@ func genericStatus(data map[string]interface{}) {
 		for _, cond := range conditions {
 			if cond.Type == "Removed" && (cond.Status == "Unknown" || cond.Status == "False") && cond.Message != "" {
 				msg = cond.Message
+				e.contribute(RuleRemoved, cond, "removing")
 			}
 		}
 
@@ -297,6 +336,7 @@ func genericStatus(data map[string]interface{}) {
 			if f == "foregroundDeletion" {
 				f = "object cleanup"
 			}
+			e.finalizer(f)
 
 			if len(msg) > 0 {
 				msg = msg + "; waiting on " + f
@@ -0,0 +1,132 @@
+package status
+
+import (
+	"testing"
+)
+
+func newObject(apiVersion, kind string, conditions ...map[string]interface{}) map[string]interface{} {
+	var conds []interface{}
+	for _, c := range conditions {
+		conds = append(conds, c)
+	}
+	return map[string]interface{}{
+		"apiVersion": apiVersion,
+		"kind":       kind,
+		"status": map[string]interface{}{
+			"conditions": conds,
+		},
+	}
+}
+
+func TestSetKindRules(t *testing.T) {
+	progressing := map[string]interface{}{
+		"type":    "Progressing",
+		"status":  "False",
+		"message": "rolling out",
+	}
+
+	data := newObject("example.cattle.io/v3", "Widget", progressing)
+	Set(data)
+	if data["transitioning"] != "error" {
+		t.Fatalf("expected global rules to report error, got %v", data["transitioning"])
+	}
+
+	RegisterKind("example.cattle.io/v3", "Widget", Rules{
+		Done: map[string]string{
+			"Progressing": "rolling",
+		},
+	})
+	defer UnregisterKind("example.cattle.io/v3", "Widget")
+
+	data = newObject("example.cattle.io/v3", "Widget", progressing)
+	Set(data)
+	if data["state"] != "rolling" || data["transitioning"] != "yes" {
+		t.Fatalf("expected kind rules to win, got state %v transitioning %v", data["state"], data["transitioning"])
+	}
+
+	data = newObject("apps/v1", "Deployment", progressing)
+	Set(data)
+	if data["transitioning"] != "error" {
+		t.Fatalf("expected other kinds to keep global rules, got %v", data["transitioning"])
+	}
+}
+
+func TestSetAnyVersionKindRules(t *testing.T) {
+	RegisterKind("", "Gadget", Rules{
+		Transitioning: map[string]string{
+			"Synced": "syncing",
+		},
+	})
+	defer UnregisterKind("", "Gadget")
+
+	data := newObject("example.cattle.io/v3", "Gadget", map[string]interface{}{
+		"type":   "Synced",
+		"status": "Unknown",
+	})
+	Set(data)
+	if data["state"] != "syncing" || data["transitioning"] != "yes" {
+		t.Fatalf("expected syncing, got state %v transitioning %v", data["state"], data["transitioning"])
+	}
+}
+
+func TestExplainRemoved(t *testing.T) {
+	data := newObject("management.cattle.io/v3", "Cluster", map[string]interface{}{
+		"type":    "Updated",
+		"status":  "Unknown",
+		"message": "upgrading nodes",
+	})
+	data["metadata"] = map[string]interface{}{
+		"removed":    "2019-01-01T00:00:00Z",
+		"finalizers": []interface{}{"controller.cattle.io/cluster-agent-controller-cleanup"},
+	}
+
+	e := Explain(data)
+	if e.Rule != RuleRemoved || e.State != "removing" {
+		t.Fatalf("expected removed rule, got %s with state %s", e.Rule, e.State)
+	}
+	if e.Finalizer != "cluster-agent-controller-cleanup" {
+		t.Fatalf("unexpected finalizer %q", e.Finalizer)
+	}
+	if len(e.Conditions) != 1 || e.Conditions[0].Type != "Updated" || e.Conditions[0].Source != SourceConditions {
+		t.Fatalf("unexpected contributing conditions %+v", e.Conditions)
+	}
+	if data["state"] != e.State || data["transitioningMessage"] != e.TransitioningMessage {
+		t.Fatalf("explanation does not match data: %+v", data)
+	}
+}
+
+func TestRollup(t *testing.T) {
+	mca := map[string]interface{}{
+		"kind": "MultiClusterApp",
+		"spec": map[string]interface{}{
+			"targets": []interface{}{
+				map[string]interface{}{"projectName": "c-1:p-1", "appName": "app-1"},
+				map[string]interface{}{"projectName": "c-2:p-2", "appName": "app-2"},
+			},
+		},
+	}
+	app := func(namespace, name, state, transitioning, message string) map[string]interface{} {
+		return map[string]interface{}{
+			"kind": "App",
+			"metadata": map[string]interface{}{
+				"namespace": namespace,
+				"name":      name,
+			},
+			"state":                state,
+			"transitioning":        transitioning,
+			"transitioningMessage": message,
+		}
+	}
+
+	summary := Rollup(mca, []map[string]interface{}{
+		app("p-1", "app-1", "active", "no", ""),
+		app("p-2", "app-2", "installing", "error", "timed out"),
+		app("p-3", "app-3", "active", "error", "not a target"),
+	})
+	if summary.Total != 2 || summary.Error != 1 || summary.States["active"] != 1 {
+		t.Fatalf("unexpected counts %+v", summary)
+	}
+	if summary.WorstState != "installing" || summary.Message != "app-2: timed out" {
+		t.Fatalf("unexpected worst state or message %+v", summary)
+	}
+}